@@ -9,6 +9,8 @@ import (
 	"io"
 	"log"
 	"os"
+	"os/user"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -17,10 +19,10 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/mr-tron/base58"
 	"github.com/spf13/pflag"
 	"golang.org/x/crypto/sha3"
 
+	"github.com/certusone/wormhole/node/pkg/audit"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/wormhole-foundation/wormhole/sdk"
@@ -31,6 +33,8 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/encoding/prototext"
 
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
@@ -39,6 +43,12 @@ import (
 var (
 	clientSocketPath *string
 	shouldBackfill   *bool
+	auditLogLimit    *uint32
+	auditLogMethod   *string
+	ibcMapRefresh    *bool
+	injectBroadcast  *bool
+	peerStatusJSON   *bool
+	acctModsRefresh  *bool
 )
 
 func init() {
@@ -53,6 +63,17 @@ func init() {
 	shouldBackfill = AdminClientFindMissingMessagesCmd.Flags().Bool(
 		"backfill", false, "backfill missing VAAs from public RPC")
 
+	auditLogLimit = AdminClientAuditLogCmd.Flags().Uint32("limit", audit.DefaultQueryLimit, "maximum number of entries to display")
+	auditLogMethod = AdminClientAuditLogCmd.Flags().String("method", "", "only display entries for this method (e.g. InjectGovernanceVAA)")
+
+	ibcMapRefresh = ClientIbcChannelMapCmd.Flags().Bool("refresh", false, "query the mapping from the contract instead of displaying the cached one")
+
+	injectBroadcast = InjectSignedVAACmd.Flags().Bool("broadcast", false, "also broadcast the VAA to the gossip network")
+
+	peerStatusJSON = ClientPeerStatusCmd.Flags().Bool("json", false, "print the status as JSON")
+
+	acctModsRefresh = ClientAccountantModificationsCmd.Flags().Bool("refresh", false, "query the contract for modifications missing from the local history first")
+
 	AdminClientInjectGuardianSetUpdateCmd.Flags().AddFlagSet(pf)
 	AdminClientFindMissingMessagesCmd.Flags().AddFlagSet(pf)
 	AdminClientListNodes.Flags().AddFlagSet(pf)
@@ -64,9 +85,24 @@ func init() {
 	ClientChainGovernorDropPendingVAACmd.Flags().AddFlagSet(pf)
 	ClientChainGovernorReleasePendingVAACmd.Flags().AddFlagSet(pf)
 	ClientChainGovernorResetReleaseTimerCmd.Flags().AddFlagSet(pf)
+	ClientAccountantStatusCmd.Flags().AddFlagSet(pf)
+	ClientAccountantModificationsCmd.Flags().AddFlagSet(pf)
+	ClientMessageDigestConflictsCmd.Flags().AddFlagSet(pf)
+	ClientDumpStateCmd.Flags().AddFlagSet(pf)
 	PurgePythNetVaasCmd.Flags().AddFlagSet(pf)
+	RefetchSignedVAACmd.Flags().AddFlagSet(pf)
+	InjectSignedVAACmd.Flags().AddFlagSet(pf)
 	SignExistingVaaCmd.Flags().AddFlagSet(pf)
 	SignExistingVaasFromCSVCmd.Flags().AddFlagSet(pf)
+	AdminClientAuditLogCmd.Flags().AddFlagSet(pf)
+	ClientIbcChannelMapCmd.Flags().AddFlagSet(pf)
+	ClientRestartWatcherCmd.Flags().AddFlagSet(pf)
+	ClientPeerStatusCmd.Flags().AddFlagSet(pf)
+	ClientListQuarantinedVAAsCmd.Flags().AddFlagSet(pf)
+	ClientSetLogLevelCmd.Flags().AddFlagSet(pf)
+	ClientGetLogLevelsCmd.Flags().AddFlagSet(pf)
+	ClientBackupDBCmd.Flags().AddFlagSet(pf)
+	ReobserveBatchCmd.Flags().AddFlagSet(pf)
 
 	AdminCmd.AddCommand(AdminClientInjectGuardianSetUpdateCmd)
 	AdminCmd.AddCommand(AdminClientFindMissingMessagesCmd)
@@ -76,15 +112,30 @@ func init() {
 	AdminCmd.AddCommand(DumpVAAByMessageID)
 	AdminCmd.AddCommand(DumpRPCs)
 	AdminCmd.AddCommand(SendObservationRequest)
+	AdminCmd.AddCommand(ReobserveBatchCmd)
 	AdminCmd.AddCommand(ClientChainGovernorStatusCmd)
 	AdminCmd.AddCommand(ClientChainGovernorReloadCmd)
 	AdminCmd.AddCommand(ClientChainGovernorDropPendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorReleasePendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorResetReleaseTimerCmd)
+	AdminCmd.AddCommand(ClientAccountantStatusCmd)
+	AdminCmd.AddCommand(ClientAccountantModificationsCmd)
+	AdminCmd.AddCommand(ClientMessageDigestConflictsCmd)
+	AdminCmd.AddCommand(ClientDumpStateCmd)
 	AdminCmd.AddCommand(PurgePythNetVaasCmd)
+	AdminCmd.AddCommand(RefetchSignedVAACmd)
+	AdminCmd.AddCommand(InjectSignedVAACmd)
 	AdminCmd.AddCommand(SignExistingVaaCmd)
 	AdminCmd.AddCommand(SignExistingVaasFromCSVCmd)
 	AdminCmd.AddCommand(Keccak256Hash)
+	AdminCmd.AddCommand(AdminClientAuditLogCmd)
+	AdminCmd.AddCommand(ClientIbcChannelMapCmd)
+	AdminCmd.AddCommand(ClientRestartWatcherCmd)
+	AdminCmd.AddCommand(ClientPeerStatusCmd)
+	AdminCmd.AddCommand(ClientListQuarantinedVAAsCmd)
+	AdminCmd.AddCommand(ClientSetLogLevelCmd)
+	AdminCmd.AddCommand(ClientGetLogLevelsCmd)
+	AdminCmd.AddCommand(ClientBackupDBCmd)
 }
 
 var AdminCmd = &cobra.Command{
@@ -162,6 +213,34 @@ var ClientChainGovernorResetReleaseTimerCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 }
 
+var ClientAccountantStatusCmd = &cobra.Command{
+	Use:   "accountant-status",
+	Short: "Displays the transfers pending in the accountant, along with their status in the accountant contract",
+	Run:   runAccountantStatus,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ClientAccountantModificationsCmd = &cobra.Command{
+	Use:   "accountant-modifications",
+	Short: "Displays the balance modifications made on the accountant contract by governance",
+	Run:   runAccountantModifications,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ClientMessageDigestConflictsCmd = &cobra.Command{
+	Use:   "message-digest-conflicts",
+	Short: "Displays messages that were not signed because a different message with the same emitter and sequence was observed before",
+	Run:   runMessageDigestConflicts,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ClientDumpStateCmd = &cobra.Command{
+	Use:   "dump-state",
+	Short: "Writes a snapshot of the processor state, governor queues and watcher heights to a file on the guardian host",
+	Run:   runDumpState,
+	Args:  cobra.ExactArgs(0),
+}
+
 var PurgePythNetVaasCmd = &cobra.Command{
 	Use:   "purge-pythnet-vaas [DAYS_OLD] <logonly>",
 	Short: "Deletes PythNet VAAs from the database that are more than [DAYS_OLD] days only (if logonly is specified, doesn't delete anything)",
@@ -169,6 +248,69 @@ var PurgePythNetVaasCmd = &cobra.Command{
 	Args:  cobra.RangeArgs(1, 2),
 }
 
+var RefetchSignedVAACmd = &cobra.Command{
+	Use:   "refetch-vaa [MESSAGE_ID]",
+	Short: "Replaces the local copy of a signed VAA (chain/emitter/seq) with one fetched from the public RPC of other guardians",
+	Run:   runRefetchSignedVAA,
+	Args:  cobra.ExactArgs(1),
+}
+
+var InjectSignedVAACmd = &cobra.Command{
+	Use:   "inject-signed-vaa [VAA]",
+	Short: "Stores a hex encoded VAA signed by a quorum of the current guardian set, such as a missed governance VAA, and optionally broadcasts it",
+	Run:   runInjectSignedVAA,
+	Args:  cobra.ExactArgs(1),
+}
+
+var ClientIbcChannelMapCmd = &cobra.Command{
+	Use:   "ibc-channel-map",
+	Short: "Displays the IBC channel ID to chain ID mapping used by the IBC watcher",
+	Run:   runIbcChannelMap,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ClientRestartWatcherCmd = &cobra.Command{
+	Use:   "restart-watcher [CHAIN]",
+	Short: "Restarts the watchers of a single chain (name or ID) without restarting the node",
+	Run:   runRestartWatcher,
+	Args:  cobra.ExactArgs(1),
+}
+
+var ClientSetLogLevelCmd = &cobra.Command{
+	Use:   "set-log-level [COMPONENT] [LEVEL]",
+	Short: "Changes the log level of a component (e.g. ibcwatch, processor, gacct, p2p) until the node restarts, or resets it to the default if LEVEL is omitted",
+	Run:   runSetLogLevel,
+	Args:  cobra.RangeArgs(1, 2),
+}
+
+var ClientGetLogLevelsCmd = &cobra.Command{
+	Use:   "log-levels",
+	Short: "Displays the default log level and the components whose log level was changed",
+	Run:   runGetLogLevels,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ClientBackupDBCmd = &cobra.Command{
+	Use:   "backup-db [OUT_FILE]",
+	Short: "Writes an unencrypted backup of the database of the running node to OUT_FILE, or to stdout if it is -",
+	Run:   runBackupDB,
+	Args:  cobra.ExactArgs(1),
+}
+
+var ClientPeerStatusCmd = &cobra.Command{
+	Use:   "peer-status",
+	Short: "Displays the most recent heartbeat this node received from each guardian",
+	Run:   runPeerStatus,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ClientListQuarantinedVAAsCmd = &cobra.Command{
+	Use:   "list-quarantined-vaas",
+	Short: "Lists the signed VAA entries moved to the quarantine by the database integrity check",
+	Run:   runListQuarantinedVAAs,
+	Args:  cobra.ExactArgs(0),
+}
+
 var SignExistingVaaCmd = &cobra.Command{
 	Use:   "sign-existing-vaa [VAA] [NEW_GUARDIANS] [NEW_GUARDIAN_SET_INDEX]",
 	Short: "Signs an existing VAA for a new guardian set using the local guardian key. This only works if the new VAA would have quorum.",
@@ -190,6 +332,13 @@ var DumpRPCs = &cobra.Command{
 	Args:  cobra.ExactArgs(0),
 }
 
+var AdminClientAuditLogCmd = &cobra.Command{
+	Use:   "audit-log",
+	Short: "Displays the most recent admin RPC audit log entries, newest first",
+	Run:   runAuditLog,
+	Args:  cobra.ExactArgs(0),
+}
+
 var Keccak256Hash = &cobra.Command{
 	Use:   "keccak256",
 	Short: "Compute legacy keccak256 hash",
@@ -197,8 +346,22 @@ var Keccak256Hash = &cobra.Command{
 	Args:  cobra.ExactArgs(0),
 }
 
+// adminCallerInterceptor attaches the local user and host to every admin call so it shows up in the node's audit log.
+func adminCallerInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	caller := "unknown"
+	if u, err := user.Current(); err == nil {
+		caller = u.Username
+	}
+	if host, err := os.Hostname(); err == nil {
+		caller = caller + "@" + host
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, audit.CallerMetadataKey, caller)
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
 func getAdminClient(ctx context.Context, addr string) (*grpc.ClientConn, nodev1.NodePrivilegedServiceClient, error) {
-	conn, err := grpc.DialContext(ctx, fmt.Sprintf("unix:///%s", addr), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("unix:///%s", addr), grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(adminCallerInterceptor))
 
 	if err != nil {
 		log.Fatalf("failed to connect to %s: %v", addr, err)
@@ -310,6 +473,55 @@ func runFindMissingMessages(cmd *cobra.Command, args []string) {
 		emitterAddress, resp.FirstSequence, resp.LastSequence, len(resp.MissingMessages))
 }
 
+func runRefetchSignedVAA(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.RefetchSignedVAARequest{
+		MessageId:     args[0],
+		BackfillNodes: sdk.PublicRPCEndpoints,
+	}
+	resp, err := c.RefetchSignedVAA(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run RefetchSignedVAA RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runInjectSignedVAA(cmd *cobra.Command, args []string) {
+	signedVAA, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+	if err != nil {
+		log.Fatalf("invalid VAA hex: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.InjectSignedVAARequest{
+		Vaa:       signedVAA,
+		Broadcast: *injectBroadcast,
+	}
+	resp, err := c.InjectSignedVAA(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run InjectSignedVAA RPC: %s", err)
+	}
+
+	fmt.Printf("%s (digest %s)\n", resp.Response, resp.Digest)
+}
+
 // runDumpVAAByMessageID uses GetSignedVAA to request the given message,
 // then decode and dump the VAA.
 func runDumpVAAByMessageID(cmd *cobra.Command, args []string) {
@@ -364,14 +576,9 @@ func runSendObservationRequest(cmd *cobra.Command, args []string) {
 		log.Fatalf("invalid chain ID: %v", err)
 	}
 
-	// Support tx with or without leading 0x so copy / pasta
-	// from monitoring tools is easier.
-	txHash, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+	txHash, err := parseTxHash(args[1])
 	if err != nil {
-		txHash, err = base58.Decode(args[1])
-		if err != nil {
-			log.Fatalf("invalid transaction hash (neither hex nor base58): %v", err)
-		}
+		log.Fatalf("%v", err)
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -433,6 +640,335 @@ func runChainGovernorStatus(cmd *cobra.Command, args []string) {
 	fmt.Println(resp.Response)
 }
 
+func runAccountantStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.AccountantStatus(ctx, &nodev1.AccountantStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to run AccountantStatus RPC: %s", err)
+	}
+
+	fmt.Printf("enforcing: %v, numPending: %d\n", resp.Enforcing, len(resp.PendingTransfers))
+	if resp.ContractQueryError != "" {
+		fmt.Printf("WARNING: failed to query the accountant contract, only the local state is shown: %s\n", resp.ContractQueryError)
+	}
+
+	numMismatched := 0
+	for _, pt := range resp.PendingTransfers {
+		line := fmt.Sprintf("%s digest=%s submitPending=%v lastUpdate=%s", pt.MsgId, pt.Digest, pt.SubmitPending, time.Unix(pt.LastUpdate, 0).UTC().Format(time.RFC3339))
+		if pt.ContractStatus != "" {
+			line += fmt.Sprintf(" contractStatus=%s numObservations=%d", pt.ContractStatus, pt.NumObservations)
+		}
+		if len(pt.MismatchedDigests) != 0 {
+			numMismatched++
+			line += fmt.Sprintf(" MISMATCHED_DIGESTS=%s", strings.Join(pt.MismatchedDigests, ","))
+		}
+		fmt.Println(line)
+	}
+
+	if numMismatched != 0 {
+		fmt.Printf("%d transfers have mismatched digests, indicating that some guardians observed different bytes\n", numMismatched)
+	}
+}
+
+func runAccountantModifications(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.AccountantModifications(ctx, &nodev1.AccountantModificationsRequest{Refresh: *acctModsRefresh})
+	if err != nil {
+		log.Fatalf("failed to run AccountantModifications RPC: %s", err)
+	}
+
+	if resp.ContractQueryError != "" {
+		fmt.Printf("WARNING: failed to query the accountant contract, only the local history is shown: %s\n", resp.ContractQueryError)
+	}
+
+	for _, m := range resp.Modifications {
+		fmt.Printf("%d account=%s/%s/%s kind=%s amount=%s reason=%q\n", m.Sequence, vaa.ChainID(m.ChainId), vaa.ChainID(m.TokenChain), m.TokenAddress,
+			strings.TrimPrefix(m.Kind.String(), "MODIFICATION_KIND_"), m.Amount, m.Reason)
+	}
+	fmt.Printf("%d modifications\n", len(resp.Modifications))
+}
+
+func runIbcChannelMap(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.IbcChannelMap(ctx, &nodev1.IbcChannelMapRequest{Refresh: *ibcMapRefresh})
+	if err != nil {
+		log.Fatalf("failed to run IbcChannelMap RPC: %s", err)
+	}
+
+	for _, e := range resp.Entries {
+		line := fmt.Sprintf("%s -> %s (%d)", e.ChannelId, e.ChainName, e.ChainId)
+		if !e.Monitored {
+			line += " NOT_MONITORED"
+		}
+		fmt.Println(line)
+	}
+}
+
+func runRestartWatcher(cmd *cobra.Command, args []string) {
+	chainID, err := parseChainID(args[0])
+	if err != nil {
+		log.Fatalf("invalid chain: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := c.RestartWatcher(ctx, &nodev1.RestartWatcherRequest{ChainId: uint32(chainID)}); err != nil {
+		log.Fatalf("failed to run RestartWatcher RPC: %s", err)
+	}
+
+	fmt.Printf("restart of the %s watcher requested\n", chainID)
+}
+
+func runSetLogLevel(cmd *cobra.Command, args []string) {
+	req := &nodev1.SetLogLevelRequest{Component: args[0]}
+	if len(args) == 2 {
+		req.Level = args[1]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := c.SetLogLevel(ctx, req); err != nil {
+		log.Fatalf("failed to run SetLogLevel RPC: %s", err)
+	}
+
+	if req.Level == "" {
+		fmt.Printf("log level of %s reset to the default\n", req.Component)
+	} else {
+		fmt.Printf("log level of %s set to %s\n", req.Component, req.Level)
+	}
+}
+
+func runGetLogLevels(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GetLogLevels(ctx, &nodev1.GetLogLevelsRequest{})
+	if err != nil {
+		log.Fatalf("failed to run GetLogLevels RPC: %s", err)
+	}
+
+	fmt.Printf("default: %s\n", resp.DefaultLevel)
+	for _, l := range resp.Components {
+		fmt.Printf("%s: %s\n", l.Component, l.Level)
+	}
+}
+
+func runBackupDB(cmd *cobra.Command, args []string) {
+	// A backup takes as long as the database takes to stream, so there is no timeout.
+	ctx := context.Background()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	stream, err := c.BackupDB(ctx, &nodev1.BackupDBRequest{})
+	if err != nil {
+		log.Fatalf("failed to run BackupDB RPC: %s", err)
+	}
+
+	// The backup is written to a temporary file next to OUT_FILE and only renamed once complete, so that a failed
+	// backup never leaves a truncated file behind that looks like a valid one.
+	var out *os.File
+	if args[0] == "-" {
+		out = os.Stdout
+	} else {
+		out, err = os.CreateTemp(filepath.Dir(args[0]), filepath.Base(args[0])+".tmp-*")
+		if err != nil {
+			log.Fatalf("failed to create backup file: %v", err)
+		}
+		defer os.Remove(out.Name())
+		if err := out.Chmod(0600); err != nil {
+			log.Fatalf("failed to set permissions of backup file: %v", err)
+		}
+	}
+
+	var size int
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("failed to receive backup after %d bytes: %s", size, err)
+		}
+		if _, err := out.Write(resp.Data); err != nil {
+			log.Fatalf("failed to write backup: %v", err)
+		}
+		size += len(resp.Data)
+	}
+
+	if args[0] == "-" {
+		return
+	}
+
+	if err := out.Sync(); err != nil {
+		log.Fatalf("failed to sync backup file: %v", err)
+	}
+	if err := out.Close(); err != nil {
+		log.Fatalf("failed to close backup file: %v", err)
+	}
+	if err := os.Rename(out.Name(), args[0]); err != nil {
+		log.Fatalf("failed to rename backup file: %v", err)
+	}
+
+	fmt.Printf("wrote %d bytes to %s\n", size, args[0])
+}
+
+func runPeerStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GetPeerStatus(ctx, &nodev1.GetPeerStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to run GetPeerStatus RPC: %s", err)
+	}
+
+	if *peerStatusJSON {
+		b, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(resp)
+		if err != nil {
+			log.Fatalf("failed to marshal response: %v", err)
+		}
+		fmt.Println(string(b))
+		return
+	}
+
+	numSeen := 0
+	for _, g := range resp.Guardians {
+		if len(g.Nodes) == 0 {
+			fmt.Printf("%d %s NOT_SEEN\n", g.GuardianIndex, g.GuardianAddr)
+			continue
+		}
+		numSeen++
+		for _, n := range g.Nodes {
+			heights := make([]string, 0, len(n.Networks))
+			for _, network := range n.Networks {
+				heights = append(heights, fmt.Sprintf("%s=%d", vaa.ChainID(network.Id), network.Height))
+			}
+			fmt.Printf("%d %s name=%s version=%s age=%ds nodeId=%s heights=%s\n",
+				g.GuardianIndex, g.GuardianAddr, n.NodeName, n.Version, n.HeartbeatAgeSeconds, n.P2PNodeId, strings.Join(heights, ","))
+		}
+	}
+	fmt.Printf("guardian set %d: heard from %d of %d guardians\n", resp.GuardianSetIndex, numSeen, len(resp.Guardians))
+}
+
+func runListQuarantinedVAAs(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.ListQuarantinedVAAs(ctx, &nodev1.ListQuarantinedVAAsRequest{})
+	if err != nil {
+		log.Fatalf("failed to run ListQuarantinedVAAs RPC: %s", err)
+	}
+
+	for _, q := range resp.Entries {
+		fmt.Printf("%s %s reason=%s detail=%q data=%s\n",
+			time.Unix(q.QuarantinedAt, 0).UTC().Format(time.RFC3339), q.Key, q.Reason, q.Detail, hex.EncodeToString(q.Data))
+	}
+	fmt.Printf("%d quarantined entries\n", len(resp.Entries))
+}
+
+func runMessageDigestConflicts(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GetMessageDigestConflicts(ctx, &nodev1.GetMessageDigestConflictsRequest{})
+	if err != nil {
+		log.Fatalf("failed to run GetMessageDigestConflicts RPC: %s", err)
+	}
+
+	if len(resp.Conflicts) == 0 {
+		fmt.Println("no conflicts detected")
+		return
+	}
+
+	for _, c := range resp.Conflicts {
+		fmt.Printf("%s %s digest=%s txHash=%s conflictingDigest=%s conflictingTxHash=%s\n",
+			time.Unix(c.DetectedAt, 0).UTC().Format(time.RFC3339), c.MessageId, c.Digest, c.TxHash, c.ConflictingDigest, c.ConflictingTxHash)
+	}
+}
+
+func runDumpState(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.DumpState(ctx, &nodev1.DumpStateRequest{})
+	if err != nil {
+		log.Fatalf("failed to run DumpState RPC: %s", err)
+	}
+
+	fmt.Printf("state written to %s\n", resp.FilePath)
+}
+
 func runChainGovernorReload(cmd *cobra.Command, args []string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -708,3 +1244,33 @@ func runKeccak256Hash(cmd *cobra.Command, args []string) {
 	digest := hash.Sum([]byte{})
 	fmt.Printf("%s", hex.EncodeToString(digest))
 }
+
+func runAuditLog(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	method := *auditLogMethod
+	if method != "" && !strings.HasPrefix(method, "/") {
+		method = "/" + nodev1.NodePrivilegedService_ServiceDesc.ServiceName + "/" + method
+	}
+
+	resp, err := c.GetAuditLog(ctx, &nodev1.GetAuditLogRequest{Limit: *auditLogLimit, Method: method})
+	if err != nil {
+		log.Fatalf("failed to run GetAuditLog RPC: %v", err)
+	}
+
+	for _, e := range resp.Entries {
+		line := fmt.Sprintf("%s %s code=%s caller=%q duration=%s request=%s",
+			time.Unix(0, e.Timestamp).UTC().Format(time.RFC3339Nano), e.Method, e.Code, e.Caller, time.Duration(e.Duration), e.Request)
+		if e.Error != "" {
+			line += fmt.Sprintf(" error=%q", e.Error)
+		}
+		fmt.Println(line)
+	}
+}
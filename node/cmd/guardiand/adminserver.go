@@ -1,6 +1,7 @@
 package guardiand
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/ecdsa"
@@ -15,43 +16,65 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
+	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"golang.org/x/exp/slices"
 
+	"github.com/certusone/wormhole/node/pkg/accountant"
+	"github.com/certusone/wormhole/node/pkg/audit"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/certusone/wormhole/node/pkg/lifecycle"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/certusone/wormhole/node/pkg/publicrpc"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers/ibc"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
 type nodePrivilegedService struct {
 	nodev1.UnimplementedNodePrivilegedServiceServer
-	db              *db.Database
-	injectC         chan<- *vaa.VAA
-	obsvReqSendC    chan<- *gossipv1.ObservationRequest
-	logger          *zap.Logger
-	signedInC       chan<- *gossipv1.SignedVAAWithQuorum
-	governor        *governor.ChainGovernor
-	evmConnector    connectors.Connector
-	gsCache         sync.Map
-	gk              *ecdsa.PrivateKey
-	guardianAddress ethcommon.Address
-	testnetMode     bool
+	db               *db.Database
+	injectC          chan<- *vaa.VAA
+	obsvReqSendC     chan<- *gossipv1.ObservationRequest
+	logger           *zap.Logger
+	signedInC        chan<- *gossipv1.SignedVAAWithQuorum
+	gossipSendC      chan<- []byte
+	gst              *common.GuardianSetState
+	governor         *governor.ChainGovernor
+	accountant       *accountant.Accountant
+	digestConflicts  *processor.DigestConflicts
+	stateDumper      *processor.StateDumper
+	stateDumpDir     string
+	evmConnector     connectors.Connector
+	gsCache          sync.Map
+	gk               *ecdsa.PrivateKey
+	guardianAddress  ethcommon.Address
+	testnetMode      bool
+	auditLog         *audit.Log
+	ibcWatcher       *ibc.Watcher
+	watcherRestarter *common.WatcherRestarter
+	logLevels        *common.ComponentLogLevels
 }
 
 // adminGuardianSetUpdateToVAA converts a nodev1.GuardianSetUpdate message to its canonical VAA representation.
@@ -429,6 +452,38 @@ func (s *nodePrivilegedService) fetchMissing(
 	addr string,
 	seq uint64) (bool, error) {
 
+	vaaBytes, _, err := s.fetchSignedVAA(ctx, nodes, c, chain, addr, seq)
+	if err != nil || vaaBytes == nil {
+		return false, err
+	}
+
+	s.logger.Info("backfilled VAA",
+		zap.Uint16("chain", uint16(chain)),
+		zap.String("address", addr),
+		zap.Uint64("sequence", seq),
+		zap.Int("numBytes", len(vaaBytes)),
+	)
+
+	// Inject into the gossip signed VAA receive path.
+	// This has the same effect as if the VAA was received from the network
+	// (verifying signature, publishing to BigTable, storing in local DB...).
+	s.signedInC <- &gossipv1.SignedVAAWithQuorum{
+		Vaa: vaaBytes,
+	}
+
+	return true, nil
+}
+
+// fetchSignedVAA fetches a signed VAA from the public RPC of one of the nodes, tried in random order.
+// Returns nil bytes if none of the nodes has the VAA, or the VAA bytes and the node they were fetched from.
+func (s *nodePrivilegedService) fetchSignedVAA(
+	ctx context.Context,
+	nodes []string,
+	c *http.Client,
+	chain vaa.ChainID,
+	addr string,
+	seq uint64) ([]byte, string, error) {
+
 	// shuffle the list of public RPC endpoints
 	rand.Shuffle(len(nodes), func(i, j int) {
 		nodes[i], nodes[j] = nodes[j], nodes[i]
@@ -441,7 +496,7 @@ func (s *nodePrivilegedService) fetchMissing(
 		req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf(
 			"%s/v1/signed_vaa/%d/%s/%d", node, chain, addr, seq), nil)
 		if err != nil {
-			return false, fmt.Errorf("failed to create request: %w", err)
+			return nil, "", fmt.Errorf("failed to create request: %w", err)
 		}
 
 		resp, err := c.Do(req)
@@ -491,29 +546,15 @@ func (s *nodePrivilegedService) fetchMissing(
 				continue
 			}
 
-			s.logger.Info("backfilled VAA",
-				zap.Uint16("chain", uint16(chain)),
-				zap.String("address", addr),
-				zap.Uint64("sequence", seq),
-				zap.Int("numBytes", len(vaaBytes)),
-			)
-
-			// Inject into the gossip signed VAA receive path.
-			// This has the same effect as if the VAA was received from the network
-			// (verifying signature, publishing to BigTable, storing in local DB...).
-			s.signedInC <- &gossipv1.SignedVAAWithQuorum{
-				Vaa: vaaBytes,
-			}
-
 			resp.Body.Close()
-			return true, nil
+			return vaaBytes, node, nil
 		default:
 			resp.Body.Close()
-			return false, fmt.Errorf("unexpected response status: %d", resp.StatusCode)
+			return nil, "", fmt.Errorf("unexpected response status: %d", resp.StatusCode)
 		}
 	}
 
-	return false, nil
+	return nil, "", nil
 }
 
 func (s *nodePrivilegedService) FindMissingMessages(ctx context.Context, req *nodev1.FindMissingMessagesRequest) (*nodev1.FindMissingMessagesResponse, error) {
@@ -562,14 +603,24 @@ func adminServiceRunnable(
 	socketPath string,
 	injectC chan<- *vaa.VAA,
 	signedInC chan<- *gossipv1.SignedVAAWithQuorum,
+	gossipSendC chan<- []byte,
 	obsvReqSendC chan<- *gossipv1.ObservationRequest,
 	db *db.Database,
 	gst *common.GuardianSetState,
 	gov *governor.ChainGovernor,
+	acct *accountant.Accountant,
+	digestConflicts *processor.DigestConflicts,
+	stateDumper *processor.StateDumper,
+	stateDumpDir string,
 	gk *ecdsa.PrivateKey,
 	ethRpc *string,
 	ethContract *string,
 	testnetMode bool,
+	auditLog *audit.Log,
+	ibcWatcher *ibc.Watcher,
+	watcherRestarter *common.WatcherRestarter,
+	logLevels *common.ComponentLogLevels,
+	standardServices common.GrpcStandardServices,
 ) (supervisor.Runnable, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
@@ -615,23 +666,36 @@ func adminServiceRunnable(
 	}
 
 	nodeService := &nodePrivilegedService{
-		db:              db,
-		injectC:         injectC,
-		obsvReqSendC:    obsvReqSendC,
-		logger:          logger.Named("adminservice"),
-		signedInC:       signedInC,
-		governor:        gov,
-		gk:              gk,
-		guardianAddress: ethcrypto.PubkeyToAddress(gk.PublicKey),
-		evmConnector:    evmConnector,
-		testnetMode:     testnetMode,
+		db:               db,
+		injectC:          injectC,
+		obsvReqSendC:     obsvReqSendC,
+		logger:           logger.Named("adminservice"),
+		signedInC:        signedInC,
+		gossipSendC:      gossipSendC,
+		gst:              gst,
+		governor:         gov,
+		accountant:       acct,
+		digestConflicts:  digestConflicts,
+		stateDumper:      stateDumper,
+		stateDumpDir:     stateDumpDir,
+		gk:               gk,
+		guardianAddress:  ethcrypto.PubkeyToAddress(gk.PublicKey),
+		evmConnector:     evmConnector,
+		testnetMode:      testnetMode,
+		auditLog:         auditLog,
+		ibcWatcher:       ibcWatcher,
+		watcherRestarter: watcherRestarter,
+		logLevels:        logLevels,
 	}
 
 	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
 
-	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailMinimal)
+	// Only calls to the privileged service are audited, the publicrpc service registered below is read-only.
+	auditInterceptor := auditLog.UnaryServerInterceptor("/" + nodev1.NodePrivilegedService_ServiceDesc.ServiceName + "/")
+	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailMinimal, auditInterceptor)
 	nodev1.RegisterNodePrivilegedServiceServer(grpcServer, nodeService)
 	publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, publicrpcService)
+	standardServices.Register(grpcServer)
 	return supervisor.GRPCServer(grpcServer, l, false), nil
 }
 
@@ -659,7 +723,11 @@ func (s *nodePrivilegedService) ChainGovernorReload(ctx context.Context, req *no
 		return nil, fmt.Errorf("chain governor is not enabled")
 	}
 
-	resp, err := s.governor.Reload()
+	var resp string
+	err := lifecycle.Reload("chain governor", func() (err error) {
+		resp, err = s.governor.Reload()
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -739,6 +807,171 @@ func (s *nodePrivilegedService) PurgePythNetVaas(ctx context.Context, req *nodev
 	}, nil
 }
 
+// signedVAAStoreTimeout is how long InjectSignedVAA waits for the processor to store a VAA.
+const signedVAAStoreTimeout = 5 * time.Second
+
+// verifySignedVAA checks that v is signed by a quorum of gs, or of the previous guardian set during a guardian set
+// transition. The guardian set index is not covered by the signatures, so it is checked as well, or a re-indexed VAA
+// would pass.
+func (s *nodePrivilegedService) verifySignedVAA(v *vaa.VAA, gs *common.GuardianSet) error {
+	// During a guardian set transition, VAAs signed by the previous set are still accepted, like on the gossip path.
+	if prev := s.gst.GetPrevious(); prev != nil && v.GuardianSetIndex == prev.Index {
+		gs = prev
+	}
+	if v.GuardianSetIndex != gs.Index {
+		return fmt.Errorf("VAA is signed by guardian set %d, the current guardian set is %d", v.GuardianSetIndex, gs.Index)
+	}
+	return v.Verify(gs.Keys)
+}
+
+func (s *nodePrivilegedService) RefetchSignedVAA(ctx context.Context, req *nodev1.RefetchSignedVAARequest) (*nodev1.RefetchSignedVAAResponse, error) {
+	id, err := db.VaaIDFromString(req.MessageId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid message ID: %v", err)
+	}
+
+	if len(req.BackfillNodes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no nodes to fetch the VAA from")
+	}
+	if id.EmitterChain == vaa.ChainIDPythNet {
+		return nil, status.Error(codes.InvalidArgument, "PythNet VAAs are not stored in the database")
+	}
+
+	gs := s.gst.Get()
+	if gs == nil || len(gs.Keys) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "guardian set is not initialized yet")
+	}
+
+	// Fetch and verify the VAA before touching the local copy, so that we never end up with no copy at all.
+	vaaBytes, node, err := s.fetchSignedVAA(ctx, req.BackfillNodes, &http.Client{}, id.EmitterChain, id.EmitterAddress.String(), id.Sequence)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch VAA: %v", err)
+	}
+	if vaaBytes == nil {
+		return nil, status.Errorf(codes.NotFound, "VAA %s was not found on any of the nodes, the local copy was kept", req.MessageId)
+	}
+
+	v, err := vaa.Unmarshal(vaaBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.DataLoss, "VAA fetched from %s is invalid: %v", node, err)
+	}
+	if *db.VaaIDFromVAA(v) != *id {
+		return nil, status.Errorf(codes.DataLoss, "VAA fetched from %s has message ID %s instead of %s", node, v.MessageID(), req.MessageId)
+	}
+	if err := s.verifySignedVAA(v, gs); err != nil {
+		return nil, status.Errorf(codes.DataLoss, "VAA fetched from %s failed verification: %v", node, err)
+	}
+
+	s.logger.Info("re-fetched VAA, replacing the local copy",
+		zap.String("messageId", req.MessageId),
+		zap.String("node", node),
+		zap.String("digest", v.HexDigest()),
+	)
+
+	// The local copy is overwritten in a single write, rather than deleted and stored again through the processor, which
+	// ignores VAAs it already stores and could fail to store the new copy, leaving us with none at all.
+	if err := s.db.StoreSignedVAA(v); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store re-fetched VAA, the local copy was kept: %v", err)
+	}
+
+	return &nodev1.RefetchSignedVAAResponse{
+		Response: fmt.Sprintf("VAA %s was re-fetched from %s and stored, digest %s", req.MessageId, node, v.HexDigest()),
+	}, nil
+}
+
+// waitForSignedVAA waits until the processor has stored the VAA submitted on signedInC. It returns false if the VAA
+// has not been stored after signedVAAStoreTimeout.
+func (s *nodePrivilegedService) waitForSignedVAA(ctx context.Context, id db.VAAID) (bool, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(signedVAAStoreTimeout)
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := s.db.GetSignedVAABytes(id); err == nil {
+				return true, nil
+			}
+		case <-timeout:
+			return false, nil
+		case <-ctx.Done():
+			return false, status.Error(codes.Canceled, ctx.Err().Error())
+		}
+	}
+}
+
+func (s *nodePrivilegedService) InjectSignedVAA(ctx context.Context, req *nodev1.InjectSignedVAARequest) (*nodev1.InjectSignedVAAResponse, error) {
+	v, err := vaa.Unmarshal(req.Vaa)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal VAA: %v", err)
+	}
+
+	gs := s.gst.Get()
+	if gs == nil || len(gs.Keys) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "guardian set is not initialized yet")
+	}
+	if err := s.verifySignedVAA(v, gs); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "VAA failed verification: %v", err)
+	}
+
+	id := db.VaaIDFromVAA(v)
+	resp := &nodev1.InjectSignedVAAResponse{MessageId: v.MessageID(), Digest: v.HexDigest()}
+
+	existing, err := s.db.GetSignedVAABytes(*id)
+	switch {
+	case err == nil:
+		stored, err := vaa.Unmarshal(existing)
+		if err != nil || stored.SigningDigest() != v.SigningDigest() {
+			return nil, status.Errorf(codes.AlreadyExists, "a different VAA is stored for %s, use refetch-vaa to replace it", v.MessageID())
+		}
+		resp.Response = fmt.Sprintf("VAA %s was already stored", v.MessageID())
+	case errors.Is(err, db.ErrVAANotFound):
+		s.logger.Info("injecting signed VAA",
+			zap.String("messageId", v.MessageID()),
+			zap.String("digest", v.HexDigest()),
+		)
+
+		// Inject into the gossip signed VAA receive path, which verifies the VAA again and stores it.
+		select {
+		case s.signedInC <- &gossipv1.SignedVAAWithQuorum{Vaa: req.Vaa}:
+		case <-ctx.Done():
+			return nil, status.Error(codes.Canceled, ctx.Err().Error())
+		}
+
+		stored, err := s.waitForSignedVAA(ctx, *id)
+		if err != nil {
+			return nil, err
+		}
+		if stored {
+			resp.Response = fmt.Sprintf("VAA %s was stored", v.MessageID())
+		} else {
+			resp.Response = fmt.Sprintf("VAA %s was submitted, but has not been stored yet, check the logs", v.MessageID())
+		}
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to look up VAA: %v", err)
+	}
+
+	if req.Broadcast {
+		b, err := proto.Marshal(&gossipv1.GossipMessage{
+			Message: &gossipv1.GossipMessage_SignedVaaWithQuorum{
+				SignedVaaWithQuorum: &gossipv1.SignedVAAWithQuorum{Vaa: req.Vaa},
+			},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal gossip message: %v", err)
+		}
+
+		select {
+		case s.gossipSendC <- b:
+		case <-ctx.Done():
+			return nil, status.Errorf(codes.Canceled, "%s, but could not be broadcast: %v", resp.Response, ctx.Err())
+		}
+		s.logger.Info("broadcast injected signed VAA", zap.String("messageId", v.MessageID()))
+		resp.Response += " and broadcast"
+	}
+
+	return resp, nil
+}
+
 func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1.SignExistingVAARequest) (*nodev1.SignExistingVAAResponse, error) {
 	v, err := vaa.Unmarshal(req.Vaa)
 	if err != nil {
@@ -898,3 +1131,392 @@ func (s *nodePrivilegedService) DumpRPCs(ctx context.Context, req *nodev1.DumpRP
 		Response: rpcMap,
 	}, nil
 }
+
+func (s *nodePrivilegedService) GetAuditLog(ctx context.Context, req *nodev1.GetAuditLogRequest) (*nodev1.GetAuditLogResponse, error) {
+	if s.auditLog == nil {
+		return nil, status.Error(codes.Unavailable, "admin audit log is not enabled")
+	}
+
+	entries, err := s.auditLog.Entries(int(req.Limit), req.Method)
+	if err != nil {
+		s.logger.Error("failed to read audit log", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &nodev1.GetAuditLogResponse{
+		Entries: make([]*nodev1.AuditLogEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &nodev1.AuditLogEntry{
+			Timestamp: e.Timestamp.UnixNano(),
+			Method:    e.Method,
+			Request:   string(e.Request),
+			Caller:    e.Caller,
+			Code:      e.Code,
+			Error:     e.Error,
+			Duration:  int64(e.Duration),
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) AccountantStatus(ctx context.Context, req *nodev1.AccountantStatusRequest) (*nodev1.AccountantStatusResponse, error) {
+	if s.accountant == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	statuses, err := s.accountant.PendingTransferStatuses()
+	resp := &nodev1.AccountantStatusResponse{
+		Enforcing:        s.accountant.IsEnforcing(),
+		PendingTransfers: make([]*nodev1.AccountantPendingTransfer, 0, len(statuses)),
+	}
+	if err != nil {
+		resp.ContractQueryError = err.Error()
+	}
+
+	for _, st := range statuses {
+		resp.PendingTransfers = append(resp.PendingTransfers, &nodev1.AccountantPendingTransfer{
+			MsgId:             st.MsgId,
+			Digest:            st.Digest,
+			SubmitPending:     st.SubmitPending,
+			LastUpdate:        st.UpdTime.Unix(),
+			ContractStatus:    st.ContractStatus,
+			NumObservations:   uint32(st.NumObservations),
+			MismatchedDigests: st.MismatchedDigests,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) AccountantModifications(ctx context.Context, req *nodev1.AccountantModificationsRequest) (*nodev1.AccountantModificationsResponse, error) {
+	if s.accountant == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	resp := &nodev1.AccountantModificationsResponse{}
+	if req.Refresh {
+		if err := s.accountant.SyncModifications(); err != nil {
+			resp.ContractQueryError = err.Error()
+		}
+	}
+
+	mods := s.accountant.Modifications()
+	resp.Modifications = make([]*nodev1.AccountantModification, 0, len(mods))
+	for _, m := range mods {
+		kind := nodev1.ModificationKind_MODIFICATION_KIND_UNSPECIFIED
+		switch m.Kind {
+		case "add":
+			kind = nodev1.ModificationKind_MODIFICATION_KIND_ADD
+		case "sub":
+			kind = nodev1.ModificationKind_MODIFICATION_KIND_SUBTRACT
+		}
+		amount := ""
+		if m.Amount != nil {
+			amount = m.Amount.String()
+		}
+		resp.Modifications = append(resp.Modifications, &nodev1.AccountantModification{
+			Sequence:     m.Sequence,
+			ChainId:      uint32(m.ChainId),
+			TokenChain:   uint32(m.TokenChain),
+			TokenAddress: m.TokenAddress.String(),
+			Kind:         kind,
+			Amount:       amount,
+			Reason:       m.Reason,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) IbcChannelMap(ctx context.Context, req *nodev1.IbcChannelMapRequest) (*nodev1.IbcChannelMapResponse, error) {
+	if s.ibcWatcher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the IBC watcher is not enabled")
+	}
+
+	entries, err := s.ibcWatcher.ChannelMap(req.Refresh)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to query the IBC channel mapping: %v", err)
+	}
+
+	resp := &nodev1.IbcChannelMapResponse{
+		Entries: make([]*nodev1.IbcChannelMapEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &nodev1.IbcChannelMapEntry{
+			ChannelId: e.ChannelID,
+			ChainId:   uint32(e.ChainID),
+			ChainName: e.ChainID.String(),
+			Monitored: e.Monitored,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) RestartWatcher(ctx context.Context, req *nodev1.RestartWatcherRequest) (*nodev1.RestartWatcherResponse, error) {
+	if req.ChainId > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chain id %d", req.ChainId)
+	}
+	chainID := vaa.ChainID(req.ChainId)
+
+	if err := s.watcherRestarter.Restart(chainID); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	s.logger.Info("restart of watcher requested", zap.Stringer("chain", chainID))
+	return &nodev1.RestartWatcherResponse{}, nil
+}
+
+func (s *nodePrivilegedService) SetLogLevel(ctx context.Context, req *nodev1.SetLogLevelRequest) (*nodev1.SetLogLevelResponse, error) {
+	if s.logLevels == nil {
+		return nil, status.Error(codes.Unavailable, "log levels cannot be changed at runtime")
+	}
+	if req.Component == "" {
+		return nil, status.Error(codes.InvalidArgument, "component is required")
+	}
+
+	// The libp2p subsystems have their own levels in go-log, which filter their entries before they reach our core.
+	isLibp2pSubsystem := false
+	for _, subsystem := range ipfslog.GetSubsystems() {
+		if subsystem == req.Component {
+			isLibp2pSubsystem = true
+			break
+		}
+	}
+
+	if req.Level == "" {
+		s.logLevels.ResetLevel(req.Component)
+		if isLibp2pSubsystem {
+			_ = ipfslog.SetLogLevel(req.Component, s.logLevels.DefaultLevel().String())
+		}
+		s.logger.Info("log level of component reset to the default", zap.String("component", req.Component), zap.Stringer("level", s.logLevels.DefaultLevel()))
+		return &nodev1.SetLogLevelResponse{}, nil
+	}
+
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(req.Level)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid log level %q", req.Level)
+	}
+
+	s.logLevels.SetLevel(req.Component, level)
+	if isLibp2pSubsystem {
+		_ = ipfslog.SetLogLevel(req.Component, level.String())
+	}
+	s.logger.Info("log level of component changed", zap.String("component", req.Component), zap.Stringer("level", level))
+	return &nodev1.SetLogLevelResponse{}, nil
+}
+
+func (s *nodePrivilegedService) GetLogLevels(ctx context.Context, req *nodev1.GetLogLevelsRequest) (*nodev1.GetLogLevelsResponse, error) {
+	if s.logLevels == nil {
+		return nil, status.Error(codes.Unavailable, "log levels cannot be changed at runtime")
+	}
+
+	resp := &nodev1.GetLogLevelsResponse{DefaultLevel: s.logLevels.DefaultLevel().String()}
+	for _, l := range s.logLevels.Levels() {
+		resp.Components = append(resp.Components, &nodev1.ComponentLogLevel{Component: l.Component, Level: l.Level.String()})
+	}
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) GetPeerStatus(ctx context.Context, req *nodev1.GetPeerStatusRequest) (*nodev1.GetPeerStatusResponse, error) {
+	gs := s.gst.Get()
+	if gs == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set not fetched from chain yet")
+	}
+
+	return peerStatus(gs, s.gst.GetAll(), time.Now()), nil
+}
+
+func (s *nodePrivilegedService) ListQuarantinedVAAs(ctx context.Context, req *nodev1.ListQuarantinedVAAsRequest) (*nodev1.ListQuarantinedVAAsResponse, error) {
+	entries, err := s.db.GetQuarantinedVAAs()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read the quarantine: %v", err)
+	}
+
+	resp := &nodev1.ListQuarantinedVAAsResponse{
+		Entries: make([]*nodev1.QuarantinedVAA, 0, len(entries)),
+	}
+	for _, q := range entries {
+		resp.Entries = append(resp.Entries, &nodev1.QuarantinedVAA{
+			Key:           q.Key,
+			Reason:        q.Reason,
+			Detail:        q.Detail,
+			QuarantinedAt: q.QuarantinedAt.Unix(),
+			Data:          q.Data,
+		})
+	}
+
+	return resp, nil
+}
+
+// peerStatus summarizes the heartbeats of the guardians of gs as seen at time now. Heartbeats of guardians outside of
+// gs are ignored.
+func peerStatus(gs *common.GuardianSet, heartbeats map[ethcommon.Address]map[peer.ID]*gossipv1.Heartbeat, now time.Time) *nodev1.GetPeerStatusResponse {
+	resp := &nodev1.GetPeerStatusResponse{
+		GuardianSetIndex: gs.Index,
+		Guardians:        make([]*nodev1.GuardianPeerStatus, 0, len(gs.Keys)),
+	}
+
+	for idx, addr := range gs.Keys {
+		guardian := &nodev1.GuardianPeerStatus{
+			GuardianAddr:  addr.Hex(),
+			GuardianIndex: uint32(idx),
+			Nodes:         []*nodev1.PeerStatus{},
+		}
+
+		for peerId, hb := range heartbeats[addr] {
+			guardian.Nodes = append(guardian.Nodes, &nodev1.PeerStatus{
+				P2PNodeId:           peerId.String(),
+				NodeName:            hb.NodeName,
+				Version:             hb.Version,
+				HeartbeatAgeSeconds: int64(now.Sub(time.Unix(0, hb.Timestamp)).Seconds()),
+				Networks:            hb.Networks,
+			})
+		}
+		sort.Slice(guardian.Nodes, func(i, j int) bool {
+			return guardian.Nodes[i].P2PNodeId < guardian.Nodes[j].P2PNodeId
+		})
+
+		resp.Guardians = append(resp.Guardians, guardian)
+	}
+
+	return resp
+}
+
+func (s *nodePrivilegedService) GetMessageDigestConflicts(ctx context.Context, req *nodev1.GetMessageDigestConflictsRequest) (*nodev1.GetMessageDigestConflictsResponse, error) {
+	resp := &nodev1.GetMessageDigestConflictsResponse{
+		Conflicts: make([]*nodev1.MessageDigestConflict, 0),
+	}
+	if s.digestConflicts == nil {
+		return resp, nil
+	}
+
+	for _, c := range s.digestConflicts.Get() {
+		resp.Conflicts = append(resp.Conflicts, &nodev1.MessageDigestConflict{
+			MessageId:         c.MessageID,
+			Digest:            hex.EncodeToString(c.Digest.Bytes()),
+			TxHash:            hex.EncodeToString(c.TxHash.Bytes()),
+			ConflictingDigest: hex.EncodeToString(c.ConflictingDigest.Bytes()),
+			ConflictingTxHash: hex.EncodeToString(c.ConflictingTxHash.Bytes()),
+			DetectedAt:        c.DetectedAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
+// stateDump is the document written to disk by the DumpState RPC.
+type stateDump struct {
+	Time            time.Time                     `json:"time"`
+	GuardianAddress string                        `json:"guardianAddress"`
+	Processor       *processor.StateSnapshot      `json:"processor,omitempty"`
+	ProcessorError  string                        `json:"processorError,omitempty"`
+	Governor        *governorStateDump            `json:"governor,omitempty"`
+	Watchers        []*gossipv1.Heartbeat_Network `json:"watchers"`
+}
+
+type governorStateDump struct {
+	AvailableNotional []*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry `json:"availableNotional"`
+	Enqueued          []*publicrpcv1.GovernorGetEnqueuedVAAsResponse_Entry             `json:"enqueued"`
+}
+
+func (s *nodePrivilegedService) DumpState(ctx context.Context, req *nodev1.DumpStateRequest) (*nodev1.DumpStateResponse, error) {
+	if s.stateDumpDir == "" {
+		return nil, status.Error(codes.Unavailable, "state dumps are not enabled")
+	}
+
+	dump := stateDump{
+		Time:            time.Now(),
+		GuardianAddress: s.guardianAddress.Hex(),
+		Watchers:        p2p.DefaultRegistry.GetNetworkStats(),
+	}
+
+	if s.stateDumper != nil {
+		// Don't wait forever if the processor is stuck, the rest of the dump is still useful in that case.
+		dumpCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		snapshot, err := s.stateDumper.Dump(dumpCtx)
+		cancel()
+		if err != nil {
+			s.logger.Error("failed to get processor state", zap.Error(err))
+			dump.ProcessorError = err.Error()
+		} else {
+			dump.Processor = snapshot
+		}
+	}
+
+	if s.governor != nil {
+		dump.Governor = &governorStateDump{
+			AvailableNotional: s.governor.GetAvailableNotionalByChain(),
+			Enqueued:          s.governor.GetEnqueuedVAAs(),
+		}
+	}
+
+	b, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal state: %v", err)
+	}
+
+	if err := os.MkdirAll(s.stateDumpDir, 0700); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create state dump directory: %v", err)
+	}
+
+	filePath := path.Join(s.stateDumpDir, fmt.Sprintf("state-%s.json", dump.Time.UTC().Format("20060102T150405.000Z")))
+	if err := os.WriteFile(filePath, b, 0600); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write state dump: %v", err)
+	}
+
+	s.logger.Info("wrote state dump", zap.String("filePath", filePath))
+	return &nodev1.DumpStateResponse{FilePath: filePath}, nil
+}
+
+// backupChunkSize is the size of the chunks a database backup is streamed in, well below the default gRPC message limit.
+const backupChunkSize = 1 << 20
+
+// backupStreamWriter sends everything written to it as the data of BackupDB responses of at most backupChunkSize bytes.
+type backupStreamWriter struct {
+	stream nodev1.NodePrivilegedService_BackupDBServer
+	size   uint64
+}
+
+func (w *backupStreamWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		n := len(p) - written
+		if n > backupChunkSize {
+			n = backupChunkSize
+		}
+		if err := w.stream.Send(&nodev1.BackupDBResponse{Data: p[written : written+n]}); err != nil {
+			return written, err
+		}
+		written += n
+		w.size += uint64(n)
+	}
+	return written, nil
+}
+
+func (s *nodePrivilegedService) BackupDB(req *nodev1.BackupDBRequest, stream nodev1.NodePrivilegedService_BackupDBServer) (err error) {
+	// Streaming calls are not covered by the audit interceptor, so backups are recorded in the audit log here.
+	start := time.Now()
+	w := &backupStreamWriter{stream: stream}
+	defer func() {
+		method := "/" + nodev1.NodePrivilegedService_ServiceDesc.ServiceName + "/BackupDB"
+		s.auditLog.RecordStreamCall(stream.Context(), method, req, start, err, w.size)
+	}()
+
+	s.logger.Info("starting database backup")
+
+	buf := bufio.NewWriterSize(w, backupChunkSize)
+	version, err := s.db.Backup(buf)
+	if err == nil {
+		err = buf.Flush()
+	}
+	if err != nil {
+		s.logger.Error("database backup failed", zap.Uint64("bytesSent", w.size), zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to back up database: %v", err)
+	}
+
+	s.logger.Info("finished database backup", zap.Uint64("version", version), zap.Uint64("size", w.size))
+	return nil
+}
@@ -1,11 +1,22 @@
 package guardiand
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/audit"
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
@@ -14,9 +25,15 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type mockEVMConnector struct {
@@ -255,3 +272,382 @@ func TestSignExistingVAA_Valid(t *testing.T) {
 	v2 := generateMockVAA(1, append(gsKeys, s.gk))
 	require.Equal(t, v2, res.Vaa)
 }
+
+// setupAdminServerForRefetch returns an admin service with a database, and a public RPC server that serves vaaBytes
+// for every request, or 404 if vaaBytes is nil. Signed VAAs injected by the service are stored in the database, like
+// the processor does.
+func setupAdminServerForRefetch(t *testing.T, gsAddrs []common.Address, vaaBytes []byte) (*nodePrivilegedService, string) {
+	t.Helper()
+
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { database.Close() })
+
+	rpc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if vaaBytes == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"vaaBytes": "%s"}`, base64.StdEncoding.EncodeToString(vaaBytes))
+	}))
+	t.Cleanup(rpc.Close)
+
+	gst := node_common.NewGuardianSetState(nil)
+	gst.Set(&node_common.GuardianSet{Keys: gsAddrs})
+
+	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m := <-signedInC:
+				v, err := vaa.Unmarshal(m.Vaa)
+				if err == nil {
+					_ = database.StoreSignedVAA(v)
+				}
+			}
+		}
+	}()
+
+	return &nodePrivilegedService{
+		db:        database,
+		logger:    zap.NewNop(),
+		signedInC: signedInC,
+		gst:       gst,
+	}, rpc.URL
+}
+
+func TestRefetchSignedVAA(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(1)
+	good := generateMockVAA(0, gsKeys)
+	v, err := vaa.Unmarshal(good)
+	require.NoError(t, err)
+	id := db.VaaIDFromVAA(v)
+
+	// Store a copy with a different, unverifiable signature, standing in for a corrupted one.
+	otherKeys, _ := generateGS(1)
+	corrupted, err := vaa.Unmarshal(generateMockVAA(0, otherKeys))
+	require.NoError(t, err)
+
+	s, rpcURL := setupAdminServerForRefetch(t, gsAddrs, good)
+	require.NoError(t, s.db.StoreSignedVAA(corrupted))
+
+	resp, err := s.RefetchSignedVAA(context.Background(), &nodev1.RefetchSignedVAARequest{MessageId: v.MessageID(), BackfillNodes: []string{rpcURL}})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Response, "stored")
+
+	stored, err := s.db.GetSignedVAABytes(*id)
+	require.NoError(t, err)
+	assert.Equal(t, good, stored)
+}
+
+func TestRefetchSignedVAA_KeepsLocalCopy(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(1)
+	local := generateMockVAA(0, gsKeys)
+	v, err := vaa.Unmarshal(local)
+	require.NoError(t, err)
+
+	otherKeys, _ := generateGS(1)
+	tests := []struct {
+		name    string
+		fetched []byte
+		code    codes.Code
+	}{
+		{"not found", nil, codes.NotFound},
+		{"bad signature", generateMockVAA(0, otherKeys), codes.DataLoss},
+		{"other guardian set", generateMockVAA(1, gsKeys), codes.DataLoss},
+		{"garbage", []byte{1, 2, 3}, codes.DataLoss},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, rpcURL := setupAdminServerForRefetch(t, gsAddrs, tc.fetched)
+			require.NoError(t, s.db.StoreSignedVAA(v))
+
+			_, err := s.RefetchSignedVAA(context.Background(), &nodev1.RefetchSignedVAARequest{MessageId: v.MessageID(), BackfillNodes: []string{rpcURL}})
+			assert.Equal(t, tc.code, status.Code(err))
+
+			stored, err := s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+			require.NoError(t, err)
+			assert.Equal(t, local, stored)
+		})
+	}
+}
+
+func TestRefetchSignedVAA_InvalidRequest(t *testing.T) {
+	_, gsAddrs := generateGS(1)
+	s, rpcURL := setupAdminServerForRefetch(t, gsAddrs, nil)
+
+	_, err := s.RefetchSignedVAA(context.Background(), &nodev1.RefetchSignedVAARequest{MessageId: "junk", BackfillNodes: []string{rpcURL}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RefetchSignedVAA(context.Background(), &nodev1.RefetchSignedVAARequest{MessageId: "1/0000000000000000000000000000000000000000000000000000000000000004/1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RefetchSignedVAA(context.Background(), &nodev1.RefetchSignedVAARequest{MessageId: "26/0000000000000000000000000000000000000000000000000000000000000004/1", BackfillNodes: []string{rpcURL}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInjectSignedVAA(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(1)
+	signed := generateMockVAA(0, gsKeys)
+	v, err := vaa.Unmarshal(signed)
+	require.NoError(t, err)
+
+	s, _ := setupAdminServerForRefetch(t, gsAddrs, nil)
+	gossipSendC := make(chan []byte, 1)
+	s.gossipSendC = gossipSendC
+
+	resp, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: signed})
+	require.NoError(t, err)
+	assert.Equal(t, v.MessageID(), resp.MessageId)
+	assert.Equal(t, v.HexDigest(), resp.Digest)
+	assert.Equal(t, fmt.Sprintf("VAA %s was stored", v.MessageID()), resp.Response)
+	assert.Len(t, gossipSendC, 0)
+
+	stored, err := s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+	require.NoError(t, err)
+	assert.Equal(t, signed, stored)
+
+	// Injecting it again is fine, for instance to broadcast it.
+	resp, err = s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: signed, Broadcast: true})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("VAA %s was already stored and broadcast", v.MessageID()), resp.Response)
+
+	var msg gossipv1.GossipMessage
+	require.NoError(t, proto.Unmarshal(<-gossipSendC, &msg))
+	assert.Equal(t, signed, msg.GetSignedVaaWithQuorum().GetVaa())
+
+	// A VAA with the same message ID but a different content is not stored.
+	v.Payload = []byte("other")
+	v.Signatures = nil
+	v.AddSignature(gsKeys[0], 0)
+	other, err := v.Marshal()
+	require.NoError(t, err)
+	_, err = s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: other, Broadcast: true})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Len(t, gossipSendC, 0)
+
+	stored, err = s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+	require.NoError(t, err)
+	assert.Equal(t, signed, stored)
+}
+
+func TestInjectSignedVAA_PreviousGuardianSet(t *testing.T) {
+	prevKeys, prevAddrs := generateGS(1)
+	_, gsAddrs := generateGS(1)
+	s, _ := setupAdminServerForRefetch(t, gsAddrs, nil)
+	s.gst.Set(&node_common.GuardianSet{Keys: gsAddrs, Index: 1})
+	signed := generateMockVAA(0, prevKeys)
+
+	// VAAs of the previous guardian set are only accepted during the transition.
+	s.gst.SetPrevious(&node_common.GuardianSet{Keys: prevAddrs, Index: 0}, time.Now().Add(-time.Second))
+	_, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: signed})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	s.gst.SetPrevious(&node_common.GuardianSet{Keys: prevAddrs, Index: 0}, time.Now().Add(time.Hour))
+	resp, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: signed})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Response, "was stored")
+
+	// The keys of the previous set are checked.
+	otherKeys, _ := generateGS(1)
+	_, err = s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: generateMockVAA(0, otherKeys)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInjectSignedVAA_Invalid(t *testing.T) {
+	gsKeys, gsAddrs := generateGS(1)
+	otherKeys, _ := generateGS(1)
+	s, _ := setupAdminServerForRefetch(t, gsAddrs, nil)
+
+	tests := []struct {
+		name string
+		vaa  []byte
+	}{
+		{"garbage", []byte{1, 2, 3}},
+		{"bad signature", generateMockVAA(0, otherKeys)},
+		{"other guardian set", generateMockVAA(1, gsKeys)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.InjectSignedVAA(context.Background(), &nodev1.InjectSignedVAARequest{Vaa: tc.vaa, Broadcast: true})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			v, err := vaa.Unmarshal(tc.vaa)
+			if err == nil {
+				_, err = s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+				assert.ErrorIs(t, err, db.ErrVAANotFound)
+			}
+		})
+	}
+}
+
+func TestRestartWatcher(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	restarter := node_common.NewWatcherRestarter(zap.NewNop())
+	started := make(chan struct{})
+	watcher := restarter.Wrap(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}, vaa.ChainIDEthereum)
+	errC := make(chan error, 1)
+	go func() { errC <- watcher(ctx) }()
+	<-started
+
+	s := &nodePrivilegedService{logger: zap.NewNop(), watcherRestarter: restarter}
+
+	_, err := s.RestartWatcher(ctx, &nodev1.RestartWatcherRequest{ChainId: uint32(vaa.ChainIDSolana)})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.RestartWatcher(ctx, &nodev1.RestartWatcherRequest{ChainId: math.MaxUint16 + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RestartWatcher(ctx, &nodev1.RestartWatcherRequest{ChainId: uint32(vaa.ChainIDEthereum)})
+	require.NoError(t, err)
+	assert.ErrorIs(t, <-errC, node_common.ErrWatcherRestartRequested)
+}
+
+func TestSetLogLevel(t *testing.T) {
+	ctx := context.Background()
+	s := &nodePrivilegedService{logger: zap.NewNop(), logLevels: node_common.NewComponentLogLevels(zapcore.InfoLevel)}
+
+	_, err := s.SetLogLevel(ctx, &nodev1.SetLogLevelRequest{Component: "ibcwatch", Level: "verbose"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.SetLogLevel(ctx, &nodev1.SetLogLevelRequest{Level: "debug"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.SetLogLevel(ctx, &nodev1.SetLogLevelRequest{Component: "ibcwatch", Level: "debug"})
+	require.NoError(t, err)
+	_, err = s.SetLogLevel(ctx, &nodev1.SetLogLevelRequest{Component: "processor", Level: "WARN"})
+	require.NoError(t, err)
+
+	resp, err := s.GetLogLevels(ctx, &nodev1.GetLogLevelsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "info", resp.DefaultLevel)
+	require.Equal(t, 2, len(resp.Components))
+	assert.Equal(t, "ibcwatch", resp.Components[0].Component)
+	assert.Equal(t, "debug", resp.Components[0].Level)
+	assert.Equal(t, "processor", resp.Components[1].Component)
+	assert.Equal(t, "warn", resp.Components[1].Level)
+
+	// An empty level resets the component to the default.
+	_, err = s.SetLogLevel(ctx, &nodev1.SetLogLevelRequest{Component: "ibcwatch"})
+	require.NoError(t, err)
+	resp, err = s.GetLogLevels(ctx, &nodev1.GetLogLevelsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Components))
+	assert.Equal(t, "processor", resp.Components[0].Component)
+}
+
+type fakeBackupDBServer struct {
+	nodev1.NodePrivilegedService_BackupDBServer
+	chunks [][]byte
+}
+
+func (f *fakeBackupDBServer) Send(resp *nodev1.BackupDBResponse) error {
+	f.chunks = append(f.chunks, append([]byte(nil), resp.Data...))
+	return nil
+}
+
+func (f *fakeBackupDBServer) Context() context.Context {
+	return context.Background()
+}
+
+func TestBackupDBIsAudited(t *testing.T) {
+	dir := t.TempDir()
+	database, err := db.Open(filepath.Join(dir, "db"))
+	require.NoError(t, err)
+	defer database.Close()
+	gsKeys, _ := generateGS(1)
+	v, err := vaa.Unmarshal(generateMockVAA(0, gsKeys))
+	require.NoError(t, err)
+	require.NoError(t, database.StoreSignedVAA(v))
+
+	auditLog, err := audit.Open(zap.NewNop(), filepath.Join(dir, "audit.log"), nil)
+	require.NoError(t, err)
+	defer auditLog.Close()
+
+	s := &nodePrivilegedService{logger: zap.NewNop(), db: database, auditLog: auditLog}
+	stream := &fakeBackupDBServer{}
+	require.NoError(t, s.BackupDB(&nodev1.BackupDBRequest{}, stream))
+
+	entries, err := auditLog.Entries(0, "")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "/node.v1.NodePrivilegedService/BackupDB", entries[0].Method)
+	assert.Equal(t, "OK", entries[0].Code)
+
+	var size uint64
+	for _, c := range stream.chunks {
+		size += uint64(len(c))
+	}
+	assert.NotZero(t, size)
+	assert.Equal(t, size, entries[0].BytesSent)
+}
+
+func TestBackupStreamWriter(t *testing.T) {
+	stream := &fakeBackupDBServer{}
+	w := &backupStreamWriter{stream: stream}
+
+	data := bytes.Repeat([]byte{0x01}, 2*backupChunkSize+10)
+	n, err := w.Write(data)
+	require.NoError(t, err)
+	assert.Equal(t, len(data), n)
+	assert.Equal(t, uint64(len(data)), w.size)
+
+	require.Equal(t, 3, len(stream.chunks))
+	assert.Equal(t, backupChunkSize, len(stream.chunks[0]))
+	assert.Equal(t, backupChunkSize, len(stream.chunks[1]))
+	assert.Equal(t, 10, len(stream.chunks[2]))
+}
+
+func TestGetPeerStatus(t *testing.T) {
+	_, gsAddrs := generateGS(2)
+	gst := node_common.NewGuardianSetState(nil)
+	s := &nodePrivilegedService{logger: zap.NewNop(), gst: gst}
+
+	_, err := s.GetPeerStatus(context.Background(), &nodev1.GetPeerStatusRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	gst.Set(&node_common.GuardianSet{Keys: gsAddrs, Index: 3})
+	networks := []*gossipv1.Heartbeat_Network{{Id: uint32(vaa.ChainIDEthereum), Height: 42}}
+	require.NoError(t, gst.SetHeartbeat(gsAddrs[0], peer.ID("node-b"), &gossipv1.Heartbeat{
+		NodeName: "b", Version: "v2.0.0", Timestamp: time.Now().Add(-30 * time.Second).UnixNano(), Networks: networks,
+	}))
+	require.NoError(t, gst.SetHeartbeat(gsAddrs[0], peer.ID("node-a"), &gossipv1.Heartbeat{
+		NodeName: "a", Version: "v2.0.1", Timestamp: time.Now().UnixNano(),
+	}))
+	// Heartbeats of guardians outside of the current guardian set are ignored.
+	_, otherAddrs := generateGS(1)
+	require.NoError(t, gst.SetHeartbeat(otherAddrs[0], peer.ID("node-c"), &gossipv1.Heartbeat{NodeName: "c"}))
+
+	resp, err := s.GetPeerStatus(context.Background(), &nodev1.GetPeerStatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), resp.GuardianSetIndex)
+	require.Len(t, resp.Guardians, 2)
+
+	g := resp.Guardians[0]
+	assert.Equal(t, gsAddrs[0].Hex(), g.GuardianAddr)
+	assert.Equal(t, uint32(0), g.GuardianIndex)
+	require.Len(t, g.Nodes, 2)
+	assert.Equal(t, peer.ID("node-a").String(), g.Nodes[0].P2PNodeId)
+	assert.Equal(t, "a", g.Nodes[0].NodeName)
+	assert.Equal(t, "v2.0.1", g.Nodes[0].Version)
+	assert.LessOrEqual(t, g.Nodes[0].HeartbeatAgeSeconds, int64(1))
+	assert.Equal(t, "b", g.Nodes[1].NodeName)
+	assert.GreaterOrEqual(t, g.Nodes[1].HeartbeatAgeSeconds, int64(30))
+	assert.Equal(t, int64(42), g.Nodes[1].Networks[0].Height)
+
+	// The second guardian has not been heard from.
+	assert.Equal(t, gsAddrs[1].Hex(), resp.Guardians[1].GuardianAddr)
+	assert.Equal(t, uint32(1), resp.Guardians[1].GuardianIndex)
+	assert.Empty(t, resp.Guardians[1].Nodes)
+}
@@ -8,7 +8,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/btcsuite/btcutil/bech32"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mr-tron/base58"
 	"github.com/spf13/pflag"
@@ -464,7 +464,7 @@ func parseAddress(s string) (string, error) {
 	}
 
 	// try bech32
-	_, b, err = bech32.Decode(s)
+	_, b, err = bech32.DecodeAndConvert(s)
 	if err == nil {
 		return leftPadAddress(b)
 	}
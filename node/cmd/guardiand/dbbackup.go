@@ -0,0 +1,49 @@
+package guardiand
+
+import (
+	"log"
+	"os"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/spf13/cobra"
+)
+
+var restoreDBKeyFile *string
+
+func init() {
+	restoreDBKeyFile = RestoreDBCmd.Flags().String("dbEncryptionKeyFile", "", "Path to the hex encoded key to encrypt the restored database with (optional)")
+}
+
+var RestoreDBCmd = &cobra.Command{
+	Use:   "restore-db [BACKUP_FILE] [OUT_DB_DIR]",
+	Short: "Restore a backup written by \"admin backup-db\" into a new database directory",
+	Run:   runRestoreDB,
+	Args:  cobra.ExactArgs(2),
+}
+
+func runRestoreDB(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+
+	var key []byte
+	if *restoreDBKeyFile != "" {
+		var err error
+		key, err = db.ReadEncryptionKeyFile(*restoreDBKeyFile)
+		if err != nil {
+			log.Fatalf("failed to read key: %v", err)
+		}
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		log.Fatalf("failed to open backup: %v", err)
+	}
+	defer f.Close()
+
+	log.Printf("Restoring backup %s into %s", args[0], args[1])
+	if err := db.RestoreDatabase(f, args[1], key); err != nil {
+		log.Fatalf("failed to restore database: %v", err)
+	}
+
+	log.Print("Done. Stop the node, replace its database directory with the restored one and start it again.")
+}
@@ -0,0 +1,56 @@
+package guardiand
+
+import (
+	"log"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/spf13/cobra"
+)
+
+var encryptDBKeyFile *string
+
+func init() {
+	encryptDBKeyFile = EncryptDBCmd.Flags().String("dbEncryptionKeyFile", "", "Path to the hex encoded key to encrypt the database with")
+}
+
+var EncryptDBCmd = &cobra.Command{
+	Use:   "encrypt-db [IN_DB_DIR] [OUT_DB_DIR]",
+	Short: "Copy an existing plaintext node database to a new directory, encrypted at rest (the node must be stopped)",
+	Run:   runEncryptDB,
+	Args:  cobra.ExactArgs(2),
+}
+
+// openDatabase opens the node database, encrypted at rest if keyFile is set.
+func openDatabase(path string, keyFile string) (*db.Database, error) {
+	if keyFile == "" {
+		return db.Open(path)
+	}
+
+	key, err := db.ReadEncryptionKeyFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.OpenEncrypted(path, key)
+}
+
+func runEncryptDB(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+
+	if *encryptDBKeyFile == "" {
+		log.Fatal("--dbEncryptionKeyFile must be set")
+	}
+
+	key, err := db.ReadEncryptionKeyFile(*encryptDBKeyFile)
+	if err != nil {
+		log.Fatalf("failed to read key: %v", err)
+	}
+
+	log.Printf("Encrypting database %s into %s", args[0], args[1])
+	if err := db.EncryptDatabase(args[0], args[1], key); err != nil {
+		log.Fatalf("failed to encrypt database: %v", err)
+	}
+
+	log.Print("Done. Replace the old database directory with the new one and start the node with --dbEncryptionKeyFile.")
+}
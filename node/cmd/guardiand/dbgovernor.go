@@ -0,0 +1,45 @@
+package guardiand
+
+import (
+	"log"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var downgradeGovernorDBKeyFile *string
+
+func init() {
+	downgradeGovernorDBKeyFile = DowngradeGovernorDBCmd.Flags().String("dbEncryptionKeyFile", "", "Path to the hex encoded key the database is encrypted with (optional)")
+}
+
+var DowngradeGovernorDBCmd = &cobra.Command{
+	Use:   "downgrade-governor-db [DB_DIR]",
+	Short: "Rewrite the governor entries of a node database in the format of older releases before downgrading (the node must be stopped)",
+	Run:   runDowngradeGovernorDB,
+	Args:  cobra.ExactArgs(1),
+}
+
+func runDowngradeGovernorDB(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatalf("failed to create logger: %v", err)
+	}
+
+	database, err := openDatabase(args[0], *downgradeGovernorDBKeyFile)
+	if err != nil {
+		log.Fatalf("failed to open database: %v", err)
+	}
+	defer database.Close()
+
+	numTransfers, numPending, err := database.DowngradeGovernorData(logger)
+	if err != nil {
+		log.Fatalf("failed to downgrade governor entries: %v", err)
+	}
+
+	logger.Info("downgraded governor entries, the node can now be started with the older release",
+		zap.Int("transfers", numTransfers), zap.Int("pending", numPending))
+}
@@ -0,0 +1,79 @@
+package guardiand
+
+import (
+	"context"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// guardianSetQueryTimeout is how long the integrity check waits for a guardian set to be read from the contract.
+const guardianSetQueryTimeout = 10 * time.Second
+
+// guardianSetQuorums returns a db.QuorumFunc reading the guardian sets from source as they are needed. Guardian sets which
+// cannot be read are reported as unknown, so the VAAs signed by them are only checked structurally.
+func guardianSetQuorums(logger *zap.Logger, source guardianSetSource) db.QuorumFunc {
+	type result struct {
+		quorum int
+		known  bool
+	}
+	cache := make(map[uint32]result)
+
+	return func(index uint32) (int, bool) {
+		if r, exists := cache[index]; exists {
+			return r.quorum, r.known
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), guardianSetQueryTimeout)
+		defer cancel()
+
+		var r result
+		gs, err := source.GuardianSet(ctx, index)
+		if err != nil {
+			logger.Warn("failed to read guardian set for the database integrity check, not checking the quorum of its VAAs",
+				zap.Uint32("index", index), zap.Error(err))
+		} else if len(gs.Keys) == 0 {
+			logger.Warn("guardian set of stored VAAs does not exist, not checking their quorum", zap.Uint32("index", index))
+		} else {
+			r = result{quorum: vaa.CalculateQuorum(len(gs.Keys)), known: true}
+		}
+		cache[index] = r
+		return r.quorum, r.known
+	}
+}
+
+// checkDatabaseIntegrity runs the integrity check of the signed VAAs, reading the guardian sets from the core contract on
+// Ethereum. Corrupt entries are moved to the quarantine.
+func checkDatabaseIntegrity(logger *zap.Logger, database *db.Database, ethRPC string, ethContract string) error {
+	var quorum db.QuorumFunc
+	ctx, cancel := context.WithTimeout(context.Background(), guardianSetQueryTimeout)
+	connector, err := connectors.NewEthereumConnector(ctx, "eth", ethRPC, ethcommon.HexToAddress(ethContract), logger)
+	cancel()
+	if err != nil {
+		logger.Warn("failed to connect to Ethereum for the database integrity check, not checking the quorum of stored VAAs", zap.Error(err))
+	} else {
+		quorum = guardianSetQuorums(logger, &evmGuardianSetSource{connector: connector})
+	}
+
+	start := time.Now()
+	report, err := database.CheckSignedVAAIntegrity(quorum)
+	if err != nil {
+		return err
+	}
+
+	for _, q := range report.Quarantined {
+		logger.Error("quarantined corrupt signed VAA entry, use refetch-vaa to fetch it again",
+			zap.String("key", q.Key), zap.String("reason", q.Reason), zap.String("detail", q.Detail))
+	}
+	logger.Info("database integrity check finished",
+		zap.Int("checked", report.Checked),
+		zap.Int("quarantined", len(report.Quarantined)),
+		zap.Int("uncheckedQuorum", report.UncheckedQuorum),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return nil
+}
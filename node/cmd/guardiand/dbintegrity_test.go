@@ -0,0 +1,49 @@
+package guardiand
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// guardianSetSourceMock serves guardian sets of index+1 guardians, except for guardian set 5, which cannot be read.
+type guardianSetSourceMock struct {
+	queries int
+}
+
+func (s *guardianSetSourceMock) CurrentGuardianSetIndex(ctx context.Context) (uint32, error) {
+	return 4, nil
+}
+
+func (s *guardianSetSourceMock) GuardianSet(ctx context.Context, index uint32) (*onChainGuardianSet, error) {
+	s.queries++
+	if index == 5 {
+		return nil, errors.New("rpc error")
+	}
+	return &onChainGuardianSet{Keys: make([]ethcommon.Address, index+1)}, nil
+}
+
+func TestGuardianSetQuorums(t *testing.T) {
+	source := &guardianSetSourceMock{}
+	quorum := guardianSetQuorums(zap.NewNop(), source)
+
+	q, known := quorum(3)
+	assert.True(t, known)
+	assert.Equal(t, 3, q)
+
+	q, known = quorum(0)
+	assert.True(t, known)
+	assert.Equal(t, 1, q)
+
+	_, known = quorum(5)
+	assert.False(t, known)
+
+	// Guardian sets are only read once.
+	quorum(3)
+	quorum(5)
+	assert.Equal(t, 3, source.queries)
+}
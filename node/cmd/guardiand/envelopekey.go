@@ -0,0 +1,233 @@
+package guardiand
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/openpgp/armor" //nolint
+)
+
+const (
+	EnvelopeKeyArmoredBlock = "WORMHOLE GOSSIP ENVELOPE KEY"
+)
+
+var (
+	envelopeKeyPath        *string
+	envelopeNodeKeyPath    *string
+	envelopeNetworkID      *string
+	envelopeBootstrap      *string
+	envelopePort           *uint
+	envelopeConnectTimeout *time.Duration
+)
+
+func init() {
+	envelopeKeyPath = SendEnvelopeObservationRequestCmd.Flags().String("envelopeKey", "", "Path to the Ed25519 key (created with envelope-keygen) signing the envelope")
+	envelopeNodeKeyPath = SendEnvelopeObservationRequestCmd.Flags().String("nodeKey", "", "Path to node key (will be generated if it doesn't exist)")
+	envelopeNetworkID = SendEnvelopeObservationRequestCmd.Flags().String("network", "/wormhole/dev", "P2P network identifier")
+	envelopeBootstrap = SendEnvelopeObservationRequestCmd.Flags().String("bootstrap", "", "P2P bootstrap peers (comma-separated)")
+	envelopePort = SendEnvelopeObservationRequestCmd.Flags().Uint("port", 8999, "P2P UDP listener port")
+	envelopeConnectTimeout = SendEnvelopeObservationRequestCmd.Flags().Duration("connectTimeout", 15*time.Second, "How long to wait for the gossip mesh to form before publishing the request")
+}
+
+var EnvelopeKeygenCmd = &cobra.Command{
+	Use:   "envelope-keygen [OUTFILE]",
+	Short: "Create an Ed25519 key for a non-guardian gossip producer and print its public key for --p2pEnvelopeSigners",
+	Run:   runEnvelopeKeygen,
+	Args:  cobra.ExactArgs(1),
+}
+
+var SendEnvelopeObservationRequestCmd = &cobra.Command{
+	Use:   "send-envelope-observation-request [CHAIN_ID|CHAIN] [TX_HASH_HEX]",
+	Short: "Join the gossip network and publish an observation request in an envelope signed with a non-guardian key",
+	Run:   runSendEnvelopeObservationRequest,
+	Args:  cobra.ExactArgs(2),
+}
+
+func runEnvelopeKeygen(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+
+	pk, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		log.Fatalf("failed to generate key: %v", err)
+	}
+
+	if err := writeEnvelopeKey(key, args[0]); err != nil {
+		log.Fatalf("failed to write key: %v", err)
+	}
+	fmt.Println(hex.EncodeToString(pk))
+}
+
+// loadEnvelopeKey loads an Ed25519 envelope key from disk.
+func loadEnvelopeKey(filename string) (ed25519.PrivateKey, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	p, err := armor.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read armored file: %w", err)
+	}
+	if p.Type != EnvelopeKeyArmoredBlock {
+		return nil, fmt.Errorf("invalid block type: %s", p.Type)
+	}
+
+	seed, err := io.ReadAll(p.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid key length: %d", len(seed))
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// writeEnvelopeKey writes the seed of an Ed25519 envelope key to disk.
+func writeEnvelopeKey(key ed25519.PrivateKey, filename string) error {
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		return errors.New("refusing to override existing key")
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	headers := map[string]string{
+		"PublicKey": hex.EncodeToString(key.Public().(ed25519.PublicKey)),
+	}
+	a, err := armor.Encode(f, EnvelopeKeyArmoredBlock, headers)
+	if err != nil {
+		panic(err)
+	}
+	_, err = a.Write(key.Seed())
+	if err != nil {
+		return fmt.Errorf("failed to write to file: %w", err)
+	}
+	err = a.Close()
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func runSendEnvelopeObservationRequest(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+
+	chainID, err := parseChainID(args[0])
+	if err != nil {
+		log.Fatalf("invalid chain ID: %v", err)
+	}
+	txHash, err := parseTxHash(args[1])
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	if *envelopeKeyPath == "" || *envelopeNodeKeyPath == "" || *envelopeBootstrap == "" {
+		log.Fatal("Please specify --envelopeKey, --nodeKey and --bootstrap")
+	}
+	key, err := loadEnvelopeKey(*envelopeKeyPath)
+	if err != nil {
+		log.Fatalf("failed to load envelope key: %v", err)
+	}
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatalf("failed to create logger: %v", err)
+	}
+	priv, err := common.GetOrCreateNodeKey(logger, *envelopeNodeKeyPath)
+	if err != nil {
+		logger.Fatal("failed to load node key", zap.Error(err))
+	}
+
+	rootCtx, rootCtxCancel := context.WithCancel(context.Background())
+	defer rootCtxCancel()
+
+	// Inbound messages are not needed, they are drained and dropped.
+	obsvC := make(chan *gossipv1.SignedObservation, 50)
+	obsvReqC := make(chan *common.InboundObservationRequest, 50)
+	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, 50)
+	go func() {
+		for {
+			select {
+			case <-rootCtx.Done():
+				return
+			case <-obsvC:
+			case <-obsvReqC:
+			case <-signedInC:
+			}
+		}
+	}()
+
+	supervisor.New(rootCtx, logger, func(ctx context.Context) error {
+		components := p2p.DefaultComponents()
+		components.Port = *envelopePort
+		components.EnvelopeSigningKey = key
+
+		// The request is only handed to the p2p runnable after the connection timeout, so that the node has joined the
+		// mesh by then.
+		sendC := make(chan *gossipv1.ObservationRequest)
+		go func() {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(*envelopeConnectTimeout):
+			}
+			select {
+			case <-ctx.Done():
+			case sendC <- &gossipv1.ObservationRequest{ChainId: uint32(chainID), TxHash: txHash}:
+				// Leave the request some time to propagate before exiting.
+				time.Sleep(5 * time.Second)
+				rootCtxCancel()
+			}
+		}()
+
+		if err := supervisor.Run(ctx, "p2p", p2p.Run(obsvC,
+			obsvReqC,
+			sendC,
+			make(chan []byte),
+			signedInC,
+			priv,
+			nil,
+			common.NewGuardianSetState(nil),
+			*envelopeNetworkID,
+			*envelopeBootstrap,
+			"",
+			false,
+			rootCtxCancel,
+			nil,
+			nil,
+			nil,
+			nil,
+			components,
+			nil, // ibc feature string
+		)); err != nil {
+			return err
+		}
+
+		<-ctx.Done()
+		return nil
+	}, supervisor.WithPropagatePanic)
+
+	<-rootCtx.Done()
+	logger.Info("published observation request",
+		zap.Stringer("chain", chainID),
+		zap.String("tx_hash", hex.EncodeToString(txHash)),
+		zap.String("signer", hex.EncodeToString(key.Public().(ed25519.PublicKey))))
+}
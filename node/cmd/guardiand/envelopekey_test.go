@@ -0,0 +1,25 @@
+package guardiand
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeKeyRoundTrip(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "envelope.key")
+	require.NoError(t, writeEnvelopeKey(key, path))
+	loaded, err := loadEnvelopeKey(path)
+	require.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	// Existing keys are not overwritten.
+	assert.Error(t, writeEnvelopeKey(key, path))
+}
@@ -20,14 +20,25 @@ import (
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
 )
 
-var keyDescription *string
+var (
+	keyDescription *string
+	keyEncrypt     *bool
+
+	// guardianKeyPassphraseFile is the file the passphrase of encrypted keys is read from. If empty, the user is prompted for it.
+	// It is shared by all commands that load or write keys.
+	guardianKeyPassphraseFile string
+)
 
 const (
-	GuardianKeyArmoredBlock = "WORMHOLE GUARDIAN PRIVATE KEY"
+	GuardianKeyArmoredBlock          = "WORMHOLE GUARDIAN PRIVATE KEY"
+	EncryptedGuardianKeyArmoredBlock = "WORMHOLE ENCRYPTED GUARDIAN PRIVATE KEY"
 )
 
 func init() {
 	keyDescription = KeygenCmd.Flags().String("desc", "", "Human-readable key description (optional)")
+	keyEncrypt = KeygenCmd.Flags().Bool("encrypt", false, "Encrypt the key with a passphrase")
+	KeygenCmd.Flags().StringVar(&guardianKeyPassphraseFile, "passphraseFile", "", "File to read the passphrase from when encrypting (prompts if not set)")
+	EncryptGuardianKeyCmd.Flags().StringVar(&guardianKeyPassphraseFile, "passphraseFile", "", "File to read the passphrase from (prompts if not set)")
 }
 
 var KeygenCmd = &cobra.Command{
@@ -37,10 +48,26 @@ var KeygenCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 }
 
+var EncryptGuardianKeyCmd = &cobra.Command{
+	Use:   "encrypt-guardian-key [IN_KEYFILE] [OUT_KEYFILE]",
+	Short: "Encrypt an existing plaintext guardian key with a passphrase, writing it to a new file",
+	Run:   runEncryptGuardianKey,
+	Args:  cobra.ExactArgs(2),
+}
+
 func runKeygen(cmd *cobra.Command, args []string) {
 	common.LockMemory()
 	common.SetRestrictiveUmask()
 
+	var passphrase []byte
+	if *keyEncrypt {
+		var err error
+		passphrase, err = newGuardianKeyPassphrase()
+		if err != nil {
+			log.Fatalf("failed to read passphrase: %v", err)
+		}
+	}
+
 	log.Print("Creating new key at ", args[0])
 
 	gk, err := ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
@@ -48,37 +75,109 @@ func runKeygen(cmd *cobra.Command, args []string) {
 		log.Fatalf("failed to generate key: %v", err)
 	}
 
-	err = writeGuardianKey(gk, *keyDescription, args[0], false)
+	err = writeGuardianKey(gk, *keyDescription, args[0], false, passphrase)
 	if err != nil {
 		log.Fatalf("failed to write key: %v", err)
 	}
 }
 
-// loadGuardianKey loads a serialized guardian key from disk.
-func loadGuardianKey(filename string) (*ecdsa.PrivateKey, error) {
+func runEncryptGuardianKey(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	m, headers, err := readGuardianKeyFile(args[0])
+	if err != nil {
+		log.Fatalf("failed to load key: %v", err)
+	}
+	if headers.encrypted {
+		log.Fatal("key is already encrypted")
+	}
+
+	gk, err := ethcrypto.ToECDSA(m.Data)
+	if err != nil {
+		log.Fatalf("failed to deserialize raw key data: %v", err)
+	}
+
+	passphrase, err := newGuardianKeyPassphrase()
+	if err != nil {
+		log.Fatalf("failed to read passphrase: %v", err)
+	}
+
+	log.Printf("Writing encrypted key for %s to %s", ethcrypto.PubkeyToAddress(gk.PublicKey), args[1])
+
+	err = writeGuardianKey(gk, headers.description, args[1], m.UnsafeDeterministicKey, passphrase)
+	if err != nil {
+		log.Fatalf("failed to write key: %v", err)
+	}
+
+	log.Printf("Verify that the node starts with the new key before deleting %s", args[0])
+}
+
+// guardianKeyHeaders contains the metadata of a key file.
+type guardianKeyHeaders struct {
+	description string
+	encrypted   bool
+}
+
+// readGuardianKeyFile reads a serialized guardian key from disk, decrypting it if necessary.
+func readGuardianKeyFile(filename string) (*nodev1.GuardianKey, *guardianKeyHeaders, error) {
 	f, err := os.Open(filename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
 	}
+	defer f.Close()
 
 	p, err := armor.Decode(f)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read armored file: %w", err)
+		return nil, nil, fmt.Errorf("failed to read armored file: %w", err)
 	}
 
-	if p.Type != GuardianKeyArmoredBlock {
-		return nil, fmt.Errorf("invalid block type: %s", p.Type)
+	if p.Type != GuardianKeyArmoredBlock && p.Type != EncryptedGuardianKeyArmoredBlock {
+		return nil, nil, fmt.Errorf("invalid block type: %s", p.Type)
 	}
 
 	b, err := io.ReadAll(p.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	headers := &guardianKeyHeaders{
+		description: p.Header["Description"],
+		encrypted:   p.Type == EncryptedGuardianKeyArmoredBlock,
+	}
+
+	if headers.encrypted {
+		var e nodev1.EncryptedGuardianKey
+		if err := proto.Unmarshal(b, &e); err != nil {
+			return nil, nil, fmt.Errorf("failed to deserialize protobuf: %w", err)
+		}
+
+		passphrase, err := readGuardianKeyPassphrase(fmt.Sprintf("Passphrase for %s: ", filename))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read passphrase: %w", err)
+		}
+
+		b, err = decryptGuardianKey(&e, passphrase)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	var m nodev1.GuardianKey
 	err = proto.Unmarshal(b, &m)
 	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize protobuf: %w", err)
+		return nil, nil, fmt.Errorf("failed to deserialize protobuf: %w", err)
+	}
+
+	return &m, headers, nil
+}
+
+// loadGuardianKey loads a serialized guardian key from disk. If the key is encrypted, the passphrase is read from
+// guardianKeyPassphraseFile or prompted for.
+func loadGuardianKey(filename string) (*ecdsa.PrivateKey, error) {
+	m, _, err := readGuardianKeyFile(filename)
+	if err != nil {
+		return nil, err
 	}
 
 	if !*unsafeDevMode && m.UnsafeDeterministicKey {
@@ -93,8 +192,8 @@ func loadGuardianKey(filename string) (*ecdsa.PrivateKey, error) {
 	return gk, nil
 }
 
-// writeGuardianKey serializes a guardian key and writes it to disk.
-func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string, unsafe bool) error {
+// writeGuardianKey serializes a guardian key and writes it to disk. If passphrase is not empty, the key is encrypted with it.
+func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string, unsafe bool, passphrase []byte) error {
 	if _, err := os.Stat(filename); !os.IsNotExist(err) {
 		return errors.New("refusing to override existing key")
 	}
@@ -111,6 +210,19 @@ func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string
 		panic(err)
 	}
 
+	blockType := GuardianKeyArmoredBlock
+	if len(passphrase) != 0 {
+		e, err := encryptGuardianKey(b, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt key: %w", err)
+		}
+		b, err = proto.Marshal(e)
+		if err != nil {
+			panic(err)
+		}
+		blockType = EncryptedGuardianKeyArmoredBlock
+	}
+
 	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0600)
 	if err != nil {
 		return fmt.Errorf("failed to open file: %w", err)
@@ -122,7 +234,7 @@ func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string
 	if description != "" {
 		headers["Description"] = description
 	}
-	a, err := armor.Encode(f, GuardianKeyArmoredBlock, headers)
+	a, err := armor.Encode(f, blockType, headers)
 	if err != nil {
 		panic(err)
 	}
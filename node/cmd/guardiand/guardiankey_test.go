@@ -0,0 +1,87 @@
+package guardiand
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withPassphraseFile(t *testing.T, passphrase string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(path, []byte(passphrase), 0600))
+
+	prev := guardianKeyPassphraseFile
+	guardianKeyPassphraseFile = path
+	t.Cleanup(func() { guardianKeyPassphraseFile = prev })
+}
+
+func TestGuardianKeyEncryptionRoundTrip(t *testing.T) {
+	gk, err := ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "guardian.key")
+	require.NoError(t, writeGuardianKey(gk, "test key", path, false, []byte("correct horse")))
+
+	// The key material must not be stored in plaintext.
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), EncryptedGuardianKeyArmoredBlock)
+	assert.Contains(t, string(b), ethcrypto.PubkeyToAddress(gk.PublicKey).String())
+
+	withPassphraseFile(t, "correct horse\n")
+	loaded, err := loadGuardianKey(path)
+	require.NoError(t, err)
+	assert.True(t, gk.Equal(loaded))
+
+	withPassphraseFile(t, "battery staple\n")
+	_, err = loadGuardianKey(path)
+	assert.ErrorContains(t, err, "failed to decrypt key")
+}
+
+func TestEncryptedKeyWithoutPassphraseSource(t *testing.T) {
+	gk, err := ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "guardian.key")
+	require.NoError(t, writeGuardianKey(gk, "", path, false, []byte("correct horse")))
+
+	// stdin is not a terminal when running tests, so there is nothing to prompt.
+	_, err = loadGuardianKey(path)
+	assert.Error(t, err)
+}
+
+func TestMigratePlaintextGuardianKey(t *testing.T) {
+	gk, err := ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	plainPath := filepath.Join(dir, "plain.key")
+	encPath := filepath.Join(dir, "encrypted.key")
+	require.NoError(t, writeGuardianKey(gk, "migrated key", plainPath, false, nil))
+
+	m, headers, err := readGuardianKeyFile(plainPath)
+	require.NoError(t, err)
+	assert.False(t, headers.encrypted)
+	assert.Equal(t, "migrated key", headers.description)
+
+	loaded, err := ethcrypto.ToECDSA(m.Data)
+	require.NoError(t, err)
+	require.NoError(t, writeGuardianKey(loaded, headers.description, encPath, m.UnsafeDeterministicKey, []byte("correct horse")))
+
+	withPassphraseFile(t, "correct horse")
+	_, headers, err = readGuardianKeyFile(encPath)
+	require.NoError(t, err)
+	assert.True(t, headers.encrypted)
+	assert.Equal(t, "migrated key", headers.description)
+
+	loaded, err = loadGuardianKey(encPath)
+	require.NoError(t, err)
+	assert.True(t, gk.Equal(loaded))
+}
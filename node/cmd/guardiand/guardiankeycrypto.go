@@ -0,0 +1,145 @@
+package guardiand
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/term"
+)
+
+const (
+	// argon2id parameters for newly encrypted keys, following the recommendations of RFC 9106 for memory-constrained environments.
+	// The parameters are stored alongside the key so that they can be changed without breaking existing keys.
+	guardianKeyArgon2Time    = 3
+	guardianKeyArgon2Memory  = 64 * 1024 // KiB
+	guardianKeyArgon2Threads = 4
+
+	// Upper bound on the memory parameter accepted when decrypting, to avoid exhausting memory on a malformed key file.
+	guardianKeyArgon2MaxMemory = 4 * 1024 * 1024 // KiB
+
+	guardianKeySaltLen = 16
+	guardianKeyAESLen  = 32
+)
+
+// encryptGuardianKey encrypts a serialized GuardianKey with a key derived from the passphrase.
+func encryptGuardianKey(plaintext []byte, passphrase []byte) (*nodev1.EncryptedGuardianKey, error) {
+	e := &nodev1.EncryptedGuardianKey{
+		Salt:          make([]byte, guardianKeySaltLen),
+		Argon2Time:    guardianKeyArgon2Time,
+		Argon2Memory:  guardianKeyArgon2Memory,
+		Argon2Threads: guardianKeyArgon2Threads,
+	}
+	if _, err := rand.Read(e.Salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	aead, err := guardianKeyAEAD(e, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(e.Nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	e.Ciphertext = aead.Seal(nil, e.Nonce, plaintext, nil)
+	return e, nil
+}
+
+// decryptGuardianKey returns the serialized GuardianKey contained in an encrypted key.
+func decryptGuardianKey(e *nodev1.EncryptedGuardianKey, passphrase []byte) ([]byte, error) {
+	if e.Argon2Time == 0 || e.Argon2Memory == 0 || e.Argon2Threads == 0 || e.Argon2Threads > 255 {
+		return nil, errors.New("invalid key derivation parameters")
+	}
+	if e.Argon2Memory > guardianKeyArgon2MaxMemory {
+		return nil, fmt.Errorf("key derivation memory parameter too large: %d KiB", e.Argon2Memory)
+	}
+
+	aead, err := guardianKeyAEAD(e, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(e.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce length: %d", len(e.Nonce))
+	}
+
+	plaintext, err := aead.Open(nil, e.Nonce, e.Ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("failed to decrypt key, wrong passphrase?")
+	}
+
+	return plaintext, nil
+}
+
+func guardianKeyAEAD(e *nodev1.EncryptedGuardianKey, passphrase []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey(passphrase, e.Salt, e.Argon2Time, e.Argon2Memory, uint8(e.Argon2Threads), guardianKeyAESLen)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// readGuardianKeyPassphrase reads the passphrase from guardianKeyPassphraseFile if set, or prompts for it otherwise.
+func readGuardianKeyPassphrase(prompt string) ([]byte, error) {
+	if guardianKeyPassphraseFile != "" {
+		b, err := os.ReadFile(guardianKeyPassphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read passphrase file: %w", err)
+		}
+
+		passphrase := []byte(strings.TrimRight(string(b), "\r\n"))
+		if len(passphrase) == 0 {
+			return nil, errors.New("passphrase file is empty")
+		}
+		return passphrase, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, errors.New("key is encrypted, but no passphrase file was specified and stdin is not a terminal")
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+
+	return passphrase, nil
+}
+
+// newGuardianKeyPassphrase reads the passphrase used to encrypt a key. When prompting, the passphrase has to be entered twice.
+func newGuardianKeyPassphrase() ([]byte, error) {
+	passphrase, err := readGuardianKeyPassphrase("New passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+
+	if guardianKeyPassphraseFile == "" {
+		confirmation, err := readGuardianKeyPassphrase("Repeat passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if string(passphrase) != string(confirmation) {
+			return nil, errors.New("passphrases do not match")
+		}
+	}
+
+	return passphrase, nil
+}
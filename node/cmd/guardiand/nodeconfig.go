@@ -0,0 +1,221 @@
+package guardiand
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// The node can be configured with a YAML, TOML or JSON file instead of a long list of flags. Each key of the file is the
+// name of a flag, e.g. "ethRPC: ws://eth-devnet:8545". A setting is taken from, in order of precedence, the command
+// line, the environment (GUARDIAND_ followed by the upper case flag name, e.g. GUARDIAND_ETHRPC), the config file and
+// the default value of the flag. The presets of the --profile, if any, come right before the default values.
+
+// nodeConfigEnvPrefix is the prefix of the environment variables overriding the settings of the node.
+const nodeConfigEnvPrefix = "GUARDIAND_"
+
+// nodeConfigSkippedFlags are flags that cannot be set in the config file.
+var nodeConfigSkippedFlags = map[string]bool{
+	"config": true,
+	"help":   true,
+}
+
+var printConfig *bool
+
+// ConfigCmd groups the commands operating on node config files.
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Node config file commands",
+}
+
+var ConfigValidateCmd = &cobra.Command{
+	Use:   "validate [FILE]",
+	Short: "Validate a node config file along with the GUARDIAND_ environment overrides",
+	Run:   runConfigValidate,
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	printConfig = ConfigValidateCmd.Flags().Bool("print", false, "Print the settings that differ from the defaults, sorted by name. They may include secrets like RPC API keys.")
+	ConfigCmd.AddCommand(ConfigValidateCmd)
+}
+
+// loadNodeConfig applies the config file passed with --config, or the default config file if it exists, the
+// environment overrides and the presets of the profile to the flags of the node.
+func loadNodeConfig(cmd *cobra.Command, args []string) error {
+	var path string
+	if f := cmd.Flags().Lookup("config"); f != nil {
+		path = f.Value.String()
+	}
+	if path == "" {
+		// Set by the root command if the default config file exists.
+		path = viper.ConfigFileUsed()
+	}
+
+	settings, err := readNodeConfigFile(path)
+	if err != nil {
+		return err
+	}
+	if err := applyNodeConfig(cmd.Flags(), settings, os.LookupEnv); err != nil {
+		return err
+	}
+	return applyNodeProfile(cmd.Flags(), *nodeProfile)
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) {
+	settings, err := readNodeConfigFile(args[0])
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	flags := NodeCmd.Flags()
+	if err := applyNodeConfig(flags, settings, os.LookupEnv); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if err := applyNodeProfile(flags, *nodeProfile); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if *printConfig {
+		var lines []string
+		flags.VisitAll(func(f *pflag.Flag) {
+			if f.Changed {
+				lines = append(lines, fmt.Sprintf("%s = %s", f.Name, f.Value.String()))
+			}
+		})
+		sort.Strings(lines)
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+	}
+
+	fmt.Printf("%s is valid\n", args[0])
+}
+
+// readNodeConfigFile reads the settings of a YAML, TOML or JSON config file, depending on its extension. An empty path
+// means no config file.
+func readNodeConfigFile(path string) (map[string]interface{}, error) {
+	if path == "" {
+		return map[string]interface{}{}, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	settings := make(map[string]interface{})
+	for _, key := range v.AllKeys() {
+		settings[key] = v.Get(key)
+	}
+	return settings, nil
+}
+
+// applyNodeConfig sets the flags that were not passed on the command line from the environment or, if not set there,
+// from the settings of the config file. Keys of the config file are case insensitive. Unknown keys and values that are
+// invalid for their flag are errors, so typos do not silently fall back to the defaults. All problems are reported at
+// once.
+func applyNodeConfig(flags *pflag.FlagSet, settings map[string]interface{}, lookupEnv func(string) (string, bool)) error {
+	byKey := make(map[string]*pflag.Flag)
+	var problems []string
+	flags.VisitAll(func(f *pflag.Flag) {
+		if nodeConfigSkippedFlags[f.Name] {
+			return
+		}
+		key := strings.ToLower(f.Name)
+		if other, exists := byKey[key]; exists {
+			problems = append(problems, fmt.Sprintf("flags %s and %s cannot be told apart in a config file", other.Name, f.Name))
+		}
+		byKey[key] = f
+	})
+
+	lowerSettings := make(map[string]interface{}, len(settings))
+	keys := make([]string, 0, len(settings))
+	for key, setting := range settings {
+		lowerSettings[strings.ToLower(key)] = setting
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, exists := byKey[strings.ToLower(key)]; !exists {
+			problems = append(problems, fmt.Sprintf("unknown setting %s", key))
+		}
+	}
+
+	// Set the flags after visiting them, since setting a flag while visiting is not supported.
+	flagKeys := make([]string, 0, len(byKey))
+	for key := range byKey {
+		flagKeys = append(flagKeys, key)
+	}
+	sort.Strings(flagKeys)
+	for _, key := range flagKeys {
+		f := byKey[key]
+		if f.Changed {
+			continue
+		}
+
+		envName := nodeConfigEnvPrefix + strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_"))
+		if value, exists := lookupEnv(envName); exists {
+			if err := flags.Set(f.Name, value); err != nil {
+				problems = append(problems, fmt.Sprintf("invalid value of %s: %v", envName, err))
+			}
+			continue
+		}
+
+		setting, exists := lowerSettings[key]
+		if !exists {
+			continue
+		}
+		value, err := nodeConfigValue(setting)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("invalid value of setting %s: %v", f.Name, err))
+			continue
+		}
+		if err := flags.Set(f.Name, value); err != nil {
+			problems = append(problems, fmt.Sprintf("invalid value of setting %s: %v", f.Name, err))
+		}
+	}
+
+	if len(problems) != 0 {
+		return fmt.Errorf("invalid node config:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// nodeConfigValue converts a value of the config file into the string representation of the flag value. Lists are
+// joined with commas, since list settings are comma separated flags.
+func nodeConfigValue(setting interface{}) (string, error) {
+	switch v := setting.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int64, int32, uint, uint64, uint32:
+		return fmt.Sprint(v), nil
+	case float64:
+		// JSON numbers are decoded as floats, which must not be formatted with an exponent.
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			s, err := nodeConfigValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, s)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value of type %T", setting)
+	}
+}
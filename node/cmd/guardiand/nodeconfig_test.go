@@ -0,0 +1,118 @@
+package guardiand
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNodeConfigFlags(t *testing.T) *pflag.FlagSet {
+	t.Helper()
+	flags := pflag.NewFlagSet("node", pflag.ContinueOnError)
+	flags.String("ethRPC", "", "")
+	flags.String("bootstrap", "", "")
+	flags.Uint("publicRpcLogDetail", 2, "")
+	flags.Bool("chainGovernorEnabled", false, "")
+	flags.String("config", "", "")
+	return flags
+}
+
+func noEnv(string) (string, bool) { return "", false }
+
+func TestReadNodeConfigFile(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlPath := filepath.Join(dir, "node.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte("ethRPC: ws://eth:8545\nbootstrap:\n  - /dns4/a/udp/8999/quic/p2p/A\n  - /dns4/b/udp/8999/quic/p2p/B\npublicRpcLogDetail: 1\nchainGovernorEnabled: true\n"), 0600))
+	tomlPath := filepath.Join(dir, "node.toml")
+	require.NoError(t, os.WriteFile(tomlPath, []byte("ethRPC = \"ws://eth:8545\"\nbootstrap = [\"/dns4/a/udp/8999/quic/p2p/A\", \"/dns4/b/udp/8999/quic/p2p/B\"]\npublicRpcLogDetail = 1\nchainGovernorEnabled = true\n"), 0600))
+	jsonPath := filepath.Join(dir, "node.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"ethRPC": "ws://eth:8545", "bootstrap": "/dns4/a/udp/8999/quic/p2p/A,/dns4/b/udp/8999/quic/p2p/B", "publicRpcLogDetail": 1, "chainGovernorEnabled": true}`), 0600))
+
+	for _, path := range []string{yamlPath, tomlPath, jsonPath} {
+		settings, err := readNodeConfigFile(path)
+		require.NoError(t, err, path)
+
+		flags := testNodeConfigFlags(t)
+		require.NoError(t, applyNodeConfig(flags, settings, noEnv), path)
+
+		ethRPC, _ := flags.GetString("ethRPC")
+		assert.Equal(t, "ws://eth:8545", ethRPC, path)
+		bootstrap, _ := flags.GetString("bootstrap")
+		assert.Equal(t, "/dns4/a/udp/8999/quic/p2p/A,/dns4/b/udp/8999/quic/p2p/B", bootstrap, path)
+		logDetail, _ := flags.GetUint("publicRpcLogDetail")
+		assert.Equal(t, uint(1), logDetail, path)
+		governor, _ := flags.GetBool("chainGovernorEnabled")
+		assert.True(t, governor, path)
+	}
+
+	settings, err := readNodeConfigFile("")
+	require.NoError(t, err)
+	assert.Empty(t, settings)
+
+	_, err = readNodeConfigFile(filepath.Join(dir, "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read config file")
+}
+
+func TestApplyNodeConfigPrecedence(t *testing.T) {
+	flags := testNodeConfigFlags(t)
+	require.NoError(t, flags.Parse([]string{"--ethRPC=ws://cli:8545"}))
+
+	env := map[string]string{
+		"GUARDIAND_ETHRPC":    "ws://env:8545",
+		"GUARDIAND_BOOTSTRAP": "/dns4/env/udp/8999/quic/p2p/E",
+	}
+	lookupEnv := func(name string) (string, bool) {
+		v, exists := env[name]
+		return v, exists
+	}
+
+	settings := map[string]interface{}{
+		"ethrpc":             "ws://file:8545",
+		"bootstrap":          "/dns4/file/udp/8999/quic/p2p/F",
+		"publicrpclogdetail": 0,
+	}
+	require.NoError(t, applyNodeConfig(flags, settings, lookupEnv))
+
+	ethRPC, _ := flags.GetString("ethRPC")
+	assert.Equal(t, "ws://cli:8545", ethRPC)
+	bootstrap, _ := flags.GetString("bootstrap")
+	assert.Equal(t, "/dns4/env/udp/8999/quic/p2p/E", bootstrap)
+	logDetail, _ := flags.GetUint("publicRpcLogDetail")
+	assert.Equal(t, uint(0), logDetail)
+	governor, _ := flags.GetBool("chainGovernorEnabled")
+	assert.False(t, governor)
+}
+
+func TestApplyNodeConfigInvalid(t *testing.T) {
+	flags := testNodeConfigFlags(t)
+	settings := map[string]interface{}{
+		"ethrcp":               "ws://eth:8545",
+		"config":               "other.yaml",
+		"publicrpclogdetail":   "high",
+		"chaingovernorenabled": map[string]interface{}{"enabled": true},
+	}
+	lookupEnv := func(name string) (string, bool) {
+		if name == "GUARDIAND_BOOTSTRAP" {
+			return "", true
+		}
+		return "", false
+	}
+
+	err := applyNodeConfig(flags, settings, lookupEnv)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown setting ethrcp")
+	assert.Contains(t, err.Error(), "unknown setting config")
+	assert.Contains(t, err.Error(), "invalid value of setting publicRpcLogDetail")
+	assert.Contains(t, err.Error(), "invalid value of setting chainGovernorEnabled: unsupported value of type map[string]interface {}")
+	assert.NotContains(t, err.Error(), "GUARDIAND_BOOTSTRAP")
+}
+
+func TestNodeFlagsCanBeConfigured(t *testing.T) {
+	// Config file keys are case insensitive, so no two flags of the node may only differ in case.
+	require.NoError(t, applyNodeConfig(NodeCmd.Flags(), map[string]interface{}{}, noEnv))
+}
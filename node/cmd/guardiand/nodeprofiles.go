@@ -0,0 +1,148 @@
+package guardiand
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// A profile presets the settings which are the same for every node of a network, like the P2P network, the bootstrap
+// peers and the contract addresses, so that operators only have to provide their keys and RPC endpoints. The preset
+// values have the lowest precedence: any setting of the command line, the environment or the config file overrides
+// them.
+
+// nodeProfileSetting is a flag preset by a profile. If requires is set, the preset only applies if that flag is set as
+// well, since the node rejects the contract addresses of optional chains it does not watch.
+type nodeProfileSetting struct {
+	flag     string
+	value    string
+	requires string
+}
+
+// nodeProfiles are the profiles which can be selected with --profile. The addresses are the core contracts listed in
+// sdk/js/src/utils/consts.ts and the devnet deployment in the Tiltfile.
+var nodeProfiles = map[string][]nodeProfileSetting{
+	"mainnet": {
+		{flag: "network", value: "/wormhole/mainnet/2"},
+		{flag: "bootstrap", value: "/dns4/wormhole-mainnet-v2-bootstrap.certus.one/udp/8999/quic/p2p/12D3KooWQp644DK27fd3d4Km3jr7gHiuJJ5ZGmy8hH4py7fP4FP7,/dns4/wormhole-v2-mainnet-bootstrap.xlabs.xyz/udp/8999/quic/p2p/12D3KooWNQ9tVrcb64tw6bNs2CaNrUGPM7yRrKvBBheQ5yCyPHKC"},
+		{flag: "solanaContract", value: "worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth"},
+		{flag: "pythnetContract", value: "H3fxXJ86ADW2PNuDDmZJg6mzTtPxkYCpNuQUTgmJ7AjU"},
+		{flag: "ethContract", value: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B"},
+		{flag: "bscContract", value: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B"},
+		{flag: "polygonContract", value: "0x7A4B5a56256163F07b2C80A7cA55aBE66c4ec4d7"},
+		{flag: "avalancheContract", value: "0x54a8e5f9c4CbA08F9943965859F6c34eAF03E26c"},
+		{flag: "oasisContract", value: "0xfE8cD454b4A1CA468B57D79c0cc77Ef5B6f64585"},
+		{flag: "auroraContract", value: "0xa321448d90d4e5b0A732867c18eA198e75CAC48E"},
+		{flag: "fantomContract", value: "0x126783A6Cb203a3E35344528B26ca3a0489a1485"},
+		{flag: "karuraContract", value: "0xa321448d90d4e5b0A732867c18eA198e75CAC48E"},
+		{flag: "acalaContract", value: "0xa321448d90d4e5b0A732867c18eA198e75CAC48E"},
+		{flag: "klaytnContract", value: "0x0C21603c4f3a6387e241c0091A7EA39E43E90bb7"},
+		{flag: "celoContract", value: "0xa321448d90d4e5b0A732867c18eA198e75CAC48E"},
+		{flag: "moonbeamContract", value: "0xC8e2b0cD52Cf01b0Ce87d389Daa3d414d4cE29f3"},
+		{flag: "arbitrumContract", value: "0xa5f208e072434bC67592E4C49C1B991BA79BCA46"},
+		{flag: "optimismContract", value: "0xEe91C335eab126dF5fDB3797EA9d6aD93aeC9722", requires: "optimismRPC"},
+		{flag: "terraContract", value: "terra1dq03ugtd40zu9hcgdzrsq6z2z4hwhc9tqk2uy5"},
+		{flag: "terra2Contract", value: "terra12mrnzvhx3rpej6843uge2yyfppfyd3u9c3uq223q8sl48huz9juqffcnhp"},
+		{flag: "injectiveContract", value: "inj17p9rzwnnfxcjp32un9ug7yhhzgtkhvl9l2q74d"},
+		{flag: "xplaContract", value: "xpla1jn8qmdda5m6f6fqu9qv46rt7ajhklg40ukpqchkejcvy8x7w26cqxamv3w", requires: "xplaWS"},
+		{flag: "algorandAppID", value: "842125965"},
+		{flag: "nearContract", value: "contract.wormhole_crypto.near", requires: "nearRPC"},
+		{flag: "aptosAccount", value: "5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625", requires: "aptosRPC"},
+		{flag: "aptosHandle", value: "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625::state::WormholeMessageHandle", requires: "aptosRPC"},
+	},
+	"testnet": {
+		{flag: "network", value: "/wormhole/testnet/2/1"},
+		{flag: "bootstrap", value: "/dns4/wormhole-testnet-v2-bootstrap.certus.one/udp/8999/quic/p2p/12D3KooWAkB9ynDur1Jtoa97LBUp8RXdhzS5uHgAfdTquJbrbN7i"},
+		{flag: "testnetMode", value: "true"},
+		{flag: "solanaContract", value: "3u8hJUVTA4jH1wYAyUur7FFZVQ8H635K3tSHHF4ssjQ5"},
+		{flag: "pythnetContract", value: "EUrRARh92Cdc54xrDn6qzaqjA77NRrCcfbr8kPwoTL4z"},
+		{flag: "ethContract", value: "0x706abc4E45D419950511e474C7B9Ed348A4a716c"},
+		{flag: "bscContract", value: "0x68605AD7b15c732a30b1BbC62BE8F2A509D74b4D"},
+		{flag: "polygonContract", value: "0x0CBE91CF822c73C2315FB05100C2F714765d5c20"},
+		{flag: "avalancheContract", value: "0x7bbcE28e64B3F8b84d876Ab298393c38ad7aac4C"},
+		{flag: "oasisContract", value: "0xc1C338397ffA53a2Eb12A7038b4eeb34791F8aCb"},
+		{flag: "auroraContract", value: "0xBd07292de7b505a4E803CEe286184f7Acf908F5e"},
+		{flag: "fantomContract", value: "0x1BB3B4119b7BA9dfad76B0545fb3F531383c3bB7"},
+		{flag: "karuraContract", value: "0xE4eacc10990ba3308DdCC72d985f2a27D20c7d03"},
+		{flag: "acalaContract", value: "0x4377B49d559c0a9466477195C6AdC3D433e265c0"},
+		{flag: "klaytnContract", value: "0x1830CC6eE66c84D2F177B94D544967c774E624cA"},
+		{flag: "celoContract", value: "0x88505117CA88e7dd2eC6EA1E13f0948db2D50D56"},
+		{flag: "moonbeamContract", value: "0xa5B7D85a8f27dd7907dc8FdC21FA5657D5E2F901"},
+		{flag: "neonContract", value: "0x268557122Ffd64c85750d630b716471118F323c8"},
+		{flag: "arbitrumContract", value: "0xC7A204bDBFe983FCD8d8E61D02b475D4073fF97e"},
+		{flag: "optimismContract", value: "0x6b9C8671cdDC8dEab9c719bB87cBd3e782bA6a35", requires: "optimismRPC"},
+		{flag: "baseContract", value: "0x23908A62110e21C04F3A4e011d24F901F911744A"},
+		{flag: "sepoliaContract", value: "0x4a8bc80Ed5a4067f1CCf107057b8270E0cC11A78"},
+		{flag: "terraContract", value: "terra1pd65m0q9tl3v8znnz5f5ltsfegyzah7g42cx5v"},
+		{flag: "terra2Contract", value: "terra19nv3xr5lrmmr7egvrk2kqgw4kcn43xrtd5g0mpgwwvhetusk4k7s66jyv0"},
+		{flag: "injectiveContract", value: "inj1xx3aupmgv3ce537c0yce8zzd3sz567syuyedpg"},
+		{flag: "xplaContract", value: "xpla1upkjn4mthr0047kahvn0llqx4qpqfn75lnph4jpxfn8walmm8mqsanyy35", requires: "xplaWS"},
+		{flag: "algorandAppID", value: "86525623"},
+		{flag: "nearContract", value: "wormhole.wormhole.testnet", requires: "nearRPC"},
+		{flag: "aptosAccount", value: "5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625", requires: "aptosRPC"},
+		{flag: "aptosHandle", value: "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625::state::WormholeMessageHandle", requires: "aptosRPC"},
+	},
+	// The local devnet, as deployed by Tilt. The EVM contract addresses are derived in unsafe dev mode.
+	"devnet": {
+		{flag: "network", value: "/wormhole/dev"},
+		{flag: "unsafeDevMode", value: "true"},
+		{flag: "solanaContract", value: "Bridge1p5gheXUvJ6jGWGeCsgPKgnE3YgdGKRVCMY9o"},
+		{flag: "pythnetContract", value: "Bridge1p5gheXUvJ6jGWGeCsgPKgnE3YgdGKRVCMY9o"},
+		{flag: "terraContract", value: "terra18vd8fpwxzck93qlwghaj6arh4p7c5n896xzem5", requires: "terraWS"},
+		{flag: "terra2Contract", value: "terra14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9ssrc8au", requires: "terra2WS"},
+		{flag: "algorandAppID", value: "4", requires: "algorandIndexerRPC"},
+		{flag: "nearContract", value: "wormhole.test.near", requires: "nearRPC"},
+		{flag: "aptosAccount", value: "de0036a9600559e295d5f6802ef6f3f802f510366e0c23912b0655d972166017", requires: "aptosRPC"},
+		{flag: "aptosHandle", value: "0xde0036a9600559e295d5f6802ef6f3f802f510366e0c23912b0655d972166017::state::WormholeMessageHandle", requires: "aptosRPC"},
+		{flag: "suiMoveEventType", value: "0x7f6cebb8a489654d7a759483bd653c4be3e5ccfef17a8b5fd3ba98bd072fabc3::publish_message::WormholeMessage", requires: "suiRPC"},
+		{flag: "accountantContract", value: "wormhole14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9srrg465", requires: "accountantWS"},
+	},
+}
+
+// nodeProfileNames returns the names of the profiles, sorted.
+func nodeProfileNames() []string {
+	names := make([]string, 0, len(nodeProfiles))
+	for name := range nodeProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyNodeProfile sets the flags preset by the profile name which have not been set otherwise. It must be called after
+// applyNodeConfig, so that the command line, the environment and the config file take precedence. An empty name means no
+// profile.
+func applyNodeProfile(flags *pflag.FlagSet, name string) error {
+	if name == "" {
+		return nil
+	}
+	settings, exists := nodeProfiles[name]
+	if !exists {
+		return fmt.Errorf("unknown profile %s, must be one of %s", name, strings.Join(nodeProfileNames(), ", "))
+	}
+
+	for _, s := range settings {
+		f := flags.Lookup(s.flag)
+		if f == nil {
+			return fmt.Errorf("profile %s presets unknown flag %s", name, s.flag)
+		}
+		if f.Changed {
+			continue
+		}
+		if s.requires != "" {
+			r := flags.Lookup(s.requires)
+			if r == nil {
+				return fmt.Errorf("profile %s presets %s depending on unknown flag %s", name, s.flag, s.requires)
+			}
+			if r.Value.String() == "" {
+				continue
+			}
+		}
+		if err := flags.Set(s.flag, s.value); err != nil {
+			return fmt.Errorf("profile %s presets invalid value of %s: %w", name, s.flag, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package guardiand
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyNodeProfile(t *testing.T) {
+	flags := pflag.NewFlagSet("node", pflag.ContinueOnError)
+	flags.String("network", "/wormhole/dev", "")
+	flags.String("bootstrap", "", "")
+	flags.String("ethContract", "", "")
+	flags.String("nearRPC", "", "")
+	flags.String("nearContract", "", "")
+	flags.String("xplaWS", "", "")
+	flags.String("xplaContract", "", "")
+	flags.Bool("testnetMode", false, "")
+	flags.Uint64("algorandAppID", 0, "")
+	for _, name := range []string{"network", "bootstrap", "ethContract", "nearRPC", "nearContract", "xplaWS", "xplaContract", "testnetMode", "algorandAppID"} {
+		require.NotNil(t, NodeCmd.Flags().Lookup(name), name)
+	}
+
+	require.NoError(t, flags.Parse([]string{"--ethContract", "0x0000000000000000000000000000000000000001", "--nearRPC", "http://near:3030"}))
+	require.NoError(t, applyNodeConfig(flags, map[string]interface{}{"algorandAppID": 1}, noEnv))
+	nodeProfiles["test"] = []nodeProfileSetting{
+		{flag: "network", value: "/wormhole/testnet/2/1"},
+		{flag: "bootstrap", value: "/dns4/a/udp/8999/quic/p2p/A"},
+		{flag: "ethContract", value: "0x706abc4E45D419950511e474C7B9Ed348A4a716c"},
+		{flag: "testnetMode", value: "true"},
+		{flag: "algorandAppID", value: "86525623"},
+		{flag: "nearContract", value: "wormhole.wormhole.testnet", requires: "nearRPC"},
+		{flag: "xplaContract", value: "xpla1upkjn4mthr0047kahvn0llqx4qpqfn75lnph4jpxfn8walmm8mqsanyy35", requires: "xplaWS"},
+	}
+	defer delete(nodeProfiles, "test")
+	require.NoError(t, applyNodeProfile(flags, "test"))
+
+	network, _ := flags.GetString("network")
+	assert.Equal(t, "/wormhole/testnet/2/1", network)
+	bootstrap, _ := flags.GetString("bootstrap")
+	assert.Equal(t, "/dns4/a/udp/8999/quic/p2p/A", bootstrap)
+	testnetMode, _ := flags.GetBool("testnetMode")
+	assert.True(t, testnetMode)
+
+	// The command line and the config file take precedence.
+	ethContract, _ := flags.GetString("ethContract")
+	assert.Equal(t, "0x0000000000000000000000000000000000000001", ethContract)
+	algorandAppID, _ := flags.GetUint64("algorandAppID")
+	assert.Equal(t, uint64(1), algorandAppID)
+
+	// Contracts of optional chains are only preset if the chain is watched.
+	nearContract, _ := flags.GetString("nearContract")
+	assert.Equal(t, "wormhole.wormhole.testnet", nearContract)
+	xplaContract, _ := flags.GetString("xplaContract")
+	assert.Empty(t, xplaContract)
+	assert.False(t, flags.Lookup("xplaContract").Changed)
+
+	require.NoError(t, applyNodeProfile(flags, ""))
+	assert.EqualError(t, applyNodeProfile(flags, "staging"), "unknown profile staging, must be one of devnet, mainnet, test, testnet")
+}
+
+func TestNodeProfilesPresetValidFlags(t *testing.T) {
+	for name, settings := range nodeProfiles {
+		// Apply the profile to a copy of the node flags, so that the flags of the node are left untouched.
+		flags := pflag.NewFlagSet(name, pflag.ContinueOnError)
+		NodeCmd.Flags().VisitAll(func(f *pflag.Flag) {
+			switch f.Value.Type() {
+			case "bool":
+				flags.Bool(f.Name, false, "")
+			case "uint64":
+				flags.Uint64(f.Name, 0, "")
+			default:
+				flags.String(f.Name, "", "")
+			}
+		})
+		for _, s := range settings {
+			if s.requires != "" {
+				require.NoError(t, flags.Set(s.requires, "x"), name)
+			}
+		}
+
+		require.NoError(t, applyNodeProfile(flags, name), name)
+		for _, s := range settings {
+			assert.True(t, flags.Lookup(s.flag).Changed, "%s: %s", name, s.flag)
+		}
+	}
+}
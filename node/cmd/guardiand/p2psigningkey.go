@@ -0,0 +1,135 @@
+package guardiand
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/openpgp/armor" //nolint
+	"google.golang.org/protobuf/proto"
+)
+
+var p2pSigningKeyDelegationValidity *time.Duration
+
+const (
+	P2PSigningKeyDelegationArmoredBlock = "WORMHOLE P2P SIGNING KEY DELEGATION"
+)
+
+func init() {
+	p2pSigningKeyDelegationValidity = P2PSigningKeyDelegationCmd.Flags().Duration("validity", 90*24*time.Hour, "How long the delegation remains valid")
+}
+
+var P2PSigningKeyDelegationCmd = &cobra.Command{
+	Use:   "p2p-signing-key-delegation [GUARDIAN_KEYFILE] [SIGNING_KEYFILE] [OUTFILE]",
+	Short: "Authorize a signing key (created with keygen) to sign heartbeats and observation requests on behalf of a guardian",
+	Run:   runP2PSigningKeyDelegation,
+	Args:  cobra.ExactArgs(3),
+}
+
+func runP2PSigningKeyDelegation(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	gk, err := loadGuardianKey(args[0])
+	if err != nil {
+		log.Fatalf("failed to load guardian key: %v", err)
+	}
+	sk, err := loadGuardianKey(args[1])
+	if err != nil {
+		log.Fatalf("failed to load signing key: %v", err)
+	}
+
+	guardianAddr := ethcrypto.PubkeyToAddress(gk.PublicKey)
+	signingAddr := ethcrypto.PubkeyToAddress(sk.PublicKey)
+	if guardianAddr == signingAddr {
+		log.Fatal("the signing key must be different from the guardian key")
+	}
+
+	expiresAt := time.Now().Add(*p2pSigningKeyDelegationValidity)
+	d, err := p2p.CreateSigningKeyDelegation(gk, signingAddr, expiresAt)
+	if err != nil {
+		log.Fatalf("failed to create delegation: %v", err)
+	}
+
+	log.Printf("Delegating from %s to %s until %s", guardianAddr, signingAddr, expiresAt.UTC().Format(time.RFC3339))
+
+	err = writeP2PSigningKeyDelegation(d, guardianAddr.String(), signingAddr.String(), expiresAt, args[2])
+	if err != nil {
+		log.Fatalf("failed to write delegation: %v", err)
+	}
+}
+
+// loadP2PSigningKeyDelegation loads a serialized p2p signing key delegation from disk.
+func loadP2PSigningKeyDelegation(filename string) (*gossipv1.SignedP2PSigningKeyDelegation, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	p, err := armor.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read armored file: %w", err)
+	}
+
+	if p.Type != P2PSigningKeyDelegationArmoredBlock {
+		return nil, fmt.Errorf("invalid block type: %s", p.Type)
+	}
+
+	b, err := io.ReadAll(p.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var m gossipv1.SignedP2PSigningKeyDelegation
+	err = proto.Unmarshal(b, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize protobuf: %w", err)
+	}
+
+	return &m, nil
+}
+
+// writeP2PSigningKeyDelegation serializes a p2p signing key delegation and writes it to disk.
+func writeP2PSigningKeyDelegation(d *gossipv1.SignedP2PSigningKeyDelegation, guardianAddr string, signingAddr string, expiresAt time.Time, filename string) error {
+	if _, err := os.Stat(filename); !os.IsNotExist(err) {
+		return errors.New("refusing to override existing delegation")
+	}
+
+	b, err := proto.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	headers := map[string]string{
+		"GuardianAddress": guardianAddr,
+		"SigningAddress":  signingAddr,
+		"ExpiresAt":       expiresAt.UTC().Format(time.RFC3339),
+	}
+	a, err := armor.Encode(f, P2PSigningKeyDelegationArmoredBlock, headers)
+	if err != nil {
+		panic(err)
+	}
+	_, err = a.Write(b)
+	if err != nil {
+		return fmt.Errorf("failed to write to file: %w", err)
+	}
+	err = a.Close()
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
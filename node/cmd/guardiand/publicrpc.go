@@ -15,7 +15,7 @@ import (
 	"google.golang.org/grpc"
 )
 
-func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRpcLogDetail common.GrpcLogDetail, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor) (supervisor.Runnable, error) {
+func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRpcLogDetail common.GrpcLogDetail, standardServices common.GrpcStandardServices, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor, rateLimiter *publicrpc.RateLimiter) (supervisor.Runnable, error) {
 	l, err := net.Listen("tcp", listenAddr)
 
 	if err != nil {
@@ -25,14 +25,15 @@ func publicrpcTcpServiceRunnable(logger *zap.Logger, listenAddr string, publicRp
 	logger.Info("publicrpc server listening", zap.String("addr", l.Addr().String()))
 
 	rpcServer := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
-	grpcServer := common.NewInstrumentedGRPCServer(logger, publicRpcLogDetail)
+	grpcServer := common.NewInstrumentedGRPCServer(logger, publicRpcLogDetail, publicrpcInterceptors(rateLimiter)...)
 
 	publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, rpcServer)
+	standardServices.Register(grpcServer)
 
 	return supervisor.GRPCServer(grpcServer, l, false), nil
 }
 
-func publicrpcUnixServiceRunnable(logger *zap.Logger, socketPath string, publicRpcLogDetail common.GrpcLogDetail, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor) (supervisor.Runnable, *grpc.Server, error) {
+func publicrpcUnixServiceRunnable(logger *zap.Logger, socketPath string, publicRpcLogDetail common.GrpcLogDetail, standardServices common.GrpcStandardServices, db *db.Database, gst *common.GuardianSetState, gov *governor.ChainGovernor, rateLimiter *publicrpc.RateLimiter) (supervisor.Runnable, *grpc.Server, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
 	if err == nil {
@@ -66,7 +67,17 @@ func publicrpcUnixServiceRunnable(logger *zap.Logger, socketPath string, publicR
 
 	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
 
-	grpcServer := common.NewInstrumentedGRPCServer(logger, publicRpcLogDetail)
+	grpcServer := common.NewInstrumentedGRPCServer(logger, publicRpcLogDetail, publicrpcInterceptors(rateLimiter)...)
 	publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, publicrpcService)
+	standardServices.Register(grpcServer)
 	return supervisor.GRPCServer(grpcServer, l, false), grpcServer, nil
 }
+
+// publicrpcInterceptors returns the interceptors of the publicrpc servers, which enforce the rate limits if rateLimiter
+// is not nil.
+func publicrpcInterceptors(rateLimiter *publicrpc.RateLimiter) []grpc.UnaryServerInterceptor {
+	if rateLimiter == nil {
+		return nil
+	}
+	return []grpc.UnaryServerInterceptor{rateLimiter.UnaryServerInterceptor}
+}
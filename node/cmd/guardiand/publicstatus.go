@@ -0,0 +1,58 @@
+package guardiand
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/certusone/wormhole/node/pkg/publicstatus"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"go.uber.org/zap"
+)
+
+func publicstatusServiceRunnable(
+	logger *zap.Logger,
+	listenAddr string,
+	gst *common.GuardianSetState,
+	gov *governor.ChainGovernor,
+	cacheTTL time.Duration,
+	allowedOrigins []string,
+) supervisor.Runnable {
+	return func(ctx context.Context) error {
+		// Avoid storing a typed nil in the interface.
+		var g publicstatus.Governor
+		if gov != nil {
+			g = gov
+		}
+
+		srv := &http.Server{
+			Handler:           publicstatus.NewServer(logger, gst, g, cacheTTL, allowedOrigins),
+			ReadHeaderTimeout: 3 * time.Second,
+			WriteTimeout:      10 * time.Second,
+		}
+
+		listener, err := listen(logger, listenAddr)
+		if err != nil {
+			return err
+		}
+
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		errC := make(chan error)
+		go func() {
+			logger.Info("publicstatus server listening", zap.String("addr", listener.Addr().String()))
+			errC <- srv.Serve(listener)
+		}()
+		select {
+		case <-ctx.Done():
+			// non-graceful shutdown
+			if err := srv.Close(); err != nil {
+				return err
+			}
+			return ctx.Err()
+		case err := <-errC:
+			return err
+		}
+	}
+}
@@ -111,35 +111,9 @@ func publicwebServiceRunnable(
 			logger.Info("certificate provisioning configured")
 		}
 
-		var listener net.Listener
-
-		// If listenAddr is prefixed by "sd:", look for a matching systemd socket.
-		if strings.HasPrefix(listenAddr, "sd:") {
-			listeners, err := getSDListeners()
-			if err != nil {
-				return fmt.Errorf("failed to get systemd listeners: %w", err)
-			}
-
-			addr := listenAddr[3:]
-			for _, v := range listeners {
-				logger.Debug("found systemd socket", zap.String("addr", v.Addr().String()))
-				if v.Addr().String() == addr {
-					listener = v
-				}
-			}
-
-			if listener == nil {
-				all := make([]string, len(listeners))
-				for i := range listeners {
-					all[i] = listeners[i].Addr().String()
-				}
-				return fmt.Errorf("no valid systemd listeners, got: %s", strings.Join(all, ","))
-			}
-		} else {
-			listener, err = net.Listen("tcp", listenAddr)
-			if err != nil {
-				return fmt.Errorf("failed to listen: %v", err)
-			}
+		listener, err := listen(logger, listenAddr)
+		if err != nil {
+			return err
 		}
 
 		supervisor.Signal(ctx, supervisor.SignalHealthy)
@@ -164,3 +138,34 @@ func publicwebServiceRunnable(
 		}
 	}, nil
 }
+
+// listen returns a TCP listener for listenAddr. If listenAddr is prefixed by "sd:", a matching systemd socket is used instead.
+func listen(logger *zap.Logger, listenAddr string) (net.Listener, error) {
+	// If listenAddr is prefixed by "sd:", look for a matching systemd socket.
+	if strings.HasPrefix(listenAddr, "sd:") {
+		listeners, err := getSDListeners()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get systemd listeners: %w", err)
+		}
+
+		addr := listenAddr[3:]
+		for _, v := range listeners {
+			logger.Debug("found systemd socket", zap.String("addr", v.Addr().String()))
+			if v.Addr().String() == addr {
+				return v, nil
+			}
+		}
+
+		all := make([]string, len(listeners))
+		for i := range listeners {
+			all[i] = listeners[i].Addr().String()
+		}
+		return nil, fmt.Errorf("no valid systemd listeners, got: %s", strings.Join(all, ","))
+	}
+
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %v", err)
+	}
+	return listener, nil
+}
@@ -0,0 +1,229 @@
+package guardiand
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"github.com/mr-tron/base58"
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	reobserveBatchFile    *string
+	reobserveBatchChain   *string
+	reobserveBatchRate    *float64
+	reobserveBatchRetries *uint
+)
+
+// reobserveBatchRetryDelay is how long reobserve-batch waits before retrying a request the node did not accept, usually
+// because its observation request queue is full.
+const reobserveBatchRetryDelay = 2 * time.Second
+
+var ReobserveBatchCmd = &cobra.Command{
+	Use:   "reobserve-batch",
+	Short: "Broadcast observation requests for all the transactions listed in a JSON or CSV file",
+	Long: `Broadcast observation requests for all the transactions listed in a JSON or CSV file, at a limited rate.
+
+Files ending in .csv contain one "chain,tx_hash" line per transaction. Other files contain a JSON array of
+{"chain": ..., "txHash": ...} objects. Chains are given by name or ID, and transaction hashes in hex or base58. If
+--chain is set, the chain may be omitted from the entries (CSV lines then only contain the transaction hash).`,
+	Run:  runReobserveBatch,
+	Args: cobra.ExactArgs(0),
+}
+
+func init() {
+	reobserveBatchFile = ReobserveBatchCmd.Flags().String("file", "", "JSON or CSV file listing the transactions to reobserve")
+	reobserveBatchChain = ReobserveBatchCmd.Flags().String("chain", "", "chain (name or ID) of the entries that do not specify one")
+	reobserveBatchRate = ReobserveBatchCmd.Flags().Float64("rate", 5, "maximum number of observation requests sent per second")
+	reobserveBatchRetries = ReobserveBatchCmd.Flags().Uint("retries", 3, "number of times a request rejected by the node is retried")
+	if err := ReobserveBatchCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+}
+
+// reobservationEntry is an entry of a reobserve-batch file.
+type reobservationEntry struct {
+	Chain  string `json:"chain"`
+	TxHash string `json:"txHash"`
+}
+
+// parseTxHash parses a chain-specific transaction hash, in hex (with or without a leading 0x, so that it can be copied
+// from monitoring tools) or base58.
+func parseTxHash(s string) ([]byte, error) {
+	txHash, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		txHash, err = base58.Decode(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction hash (neither hex nor base58): %w", err)
+		}
+	}
+	return txHash, nil
+}
+
+// readReobservationEntries reads the entries of a reobserve-batch file in the format given by its extension.
+func readReobservationEntries(path string) ([]reobservationEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return parseReobservationCSV(f)
+	}
+
+	var entries []reobservationEntry
+	if err := json.NewDecoder(f).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return entries, nil
+}
+
+// parseReobservationCSV parses "chain,tx_hash" or "tx_hash" lines. Empty lines and lines starting with # are ignored.
+func parseReobservationCSV(r io.Reader) ([]reobservationEntry, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var entries []reobservationEntry
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+
+		switch len(row) {
+		case 1:
+			entries = append(entries, reobservationEntry{TxHash: row[0]})
+		case 2:
+			entries = append(entries, reobservationEntry{Chain: row[0], TxHash: row[1]})
+		default:
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("line %d: expected 1 or 2 fields, got %d", line, len(row))
+		}
+	}
+}
+
+// buildObservationRequests validates all the entries and returns the corresponding observation requests. Entries
+// without a chain get defaultChain, which may be empty to require a chain on every entry.
+func buildObservationRequests(entries []reobservationEntry, defaultChain string) ([]*gossipv1.ObservationRequest, error) {
+	reqs := make([]*gossipv1.ObservationRequest, 0, len(entries))
+	for i, e := range entries {
+		chain := strings.TrimSpace(e.Chain)
+		if chain == "" {
+			chain = defaultChain
+		}
+		if chain == "" {
+			return nil, fmt.Errorf("entry %d: no chain specified and --chain is not set", i+1)
+		}
+		chainID, err := parseChainID(chain)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: invalid chain %s: %w", i+1, chain, err)
+		}
+		if chainID == vaa.ChainIDUnset {
+			return nil, fmt.Errorf("entry %d: invalid chain %s", i+1, chain)
+		}
+
+		txHash, err := parseTxHash(strings.TrimSpace(e.TxHash))
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		if len(txHash) == 0 {
+			return nil, fmt.Errorf("entry %d: empty transaction hash", i+1)
+		}
+
+		reqs = append(reqs, &gossipv1.ObservationRequest{
+			ChainId: uint32(chainID),
+			TxHash:  txHash,
+		})
+	}
+	return reqs, nil
+}
+
+func runReobserveBatch(cmd *cobra.Command, args []string) {
+	if *reobserveBatchRate <= 0 {
+		log.Fatalf("--rate must be positive")
+	}
+
+	entries, err := readReobservationEntries(*reobserveBatchFile)
+	if err != nil {
+		log.Fatalf("failed to read %s: %v", *reobserveBatchFile, err)
+	}
+
+	// Validate the whole file first, so that a typo does not leave a batch half sent.
+	reqs, err := buildObservationRequests(entries, *reobserveBatchChain)
+	if err != nil {
+		log.Fatalf("invalid reobservation file: %v", err)
+	}
+	if len(reqs) == 0 {
+		log.Fatalf("no transactions to reobserve in %s", *reobserveBatchFile)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	interval := time.Duration(float64(time.Second) / *reobserveBatchRate)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	failed := 0
+	for i, req := range reqs {
+		if i != 0 {
+			<-ticker.C
+		}
+
+		err := sendBatchObservationRequest(ctx, c, req, *reobserveBatchRetries)
+		if err != nil {
+			failed++
+			fmt.Printf("[%d/%d] %s %s: failed: %v\n", i+1, len(reqs), vaa.ChainID(req.ChainId), hex.EncodeToString(req.TxHash), err)
+		} else {
+			fmt.Printf("[%d/%d] %s %s: sent\n", i+1, len(reqs), vaa.ChainID(req.ChainId), hex.EncodeToString(req.TxHash))
+		}
+	}
+
+	fmt.Printf("sent %d of %d observation requests\n", len(reqs)-failed, len(reqs))
+	if failed != 0 {
+		os.Exit(1)
+	}
+}
+
+// sendBatchObservationRequest sends an observation request, retrying up to retries times if the node rejects it.
+func sendBatchObservationRequest(ctx context.Context, c nodev1.NodePrivilegedServiceClient, req *gossipv1.ObservationRequest, retries uint) error {
+	var err error
+	for attempt := uint(0); attempt <= retries; attempt++ {
+		if attempt != 0 {
+			time.Sleep(reobserveBatchRetryDelay)
+		}
+
+		reqCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		_, err = c.SendObservationRequest(reqCtx, &nodev1.SendObservationRequestRequest{ObservationRequest: req})
+		cancel()
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
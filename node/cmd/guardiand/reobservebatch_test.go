@@ -0,0 +1,50 @@
+package guardiand
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseReobservationCSV(t *testing.T) {
+	entries, err := parseReobservationCSV(strings.NewReader("# chain,tx_hash\nethereum,0x01\n\n2, 02\n03\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []reobservationEntry{
+		{Chain: "ethereum", TxHash: "0x01"},
+		{Chain: "2", TxHash: "02"},
+		{TxHash: "03"},
+	}, entries)
+
+	_, err = parseReobservationCSV(strings.NewReader("ethereum,0x01,extra\n"))
+	assert.Error(t, err)
+}
+
+func TestBuildObservationRequests(t *testing.T) {
+	entries := []reobservationEntry{
+		{Chain: "ethereum", TxHash: "0x0102"},
+		{Chain: "1", TxHash: "3yZe7d"},
+		{TxHash: "0a"},
+	}
+
+	_, err := buildObservationRequests(entries, "")
+	assert.ErrorContains(t, err, "entry 3")
+
+	reqs, err := buildObservationRequests(entries, "bsc")
+	require.NoError(t, err)
+	require.Len(t, reqs, 3)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), reqs[0].ChainId)
+	assert.Equal(t, []byte{1, 2}, reqs[0].TxHash)
+	assert.Equal(t, uint32(vaa.ChainIDSolana), reqs[1].ChainId)
+	assert.NotEmpty(t, reqs[1].TxHash)
+	assert.Equal(t, uint32(vaa.ChainIDBSC), reqs[2].ChainId)
+
+	_, err = buildObservationRequests([]reobservationEntry{{Chain: "notachain", TxHash: "01"}}, "")
+	assert.Error(t, err)
+	_, err = buildObservationRequests([]reobservationEntry{{Chain: "ethereum", TxHash: "0xzz"}}, "")
+	assert.Error(t, err)
+	_, err = buildObservationRequests([]reobservationEntry{{Chain: "ethereum", TxHash: ""}}, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,295 @@
+package guardiand
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	scanPendingRPC          *string
+	scanPendingCoreContract *string
+	scanPendingTokenBridge  *string
+	scanPendingFromBlock    *uint64
+	scanPendingToBlock      *uint64
+	scanPendingStep         *uint64
+	scanPendingVAAAPIs      *[]string
+	scanPendingTestnet      *bool
+	scanPendingReobserve    *bool
+	scanPendingSocket       *string
+)
+
+// ScanPendingTransfersCmd scans the token bridge of an EVM chain for messages that have no signed VAA.
+var ScanPendingTransfersCmd = &cobra.Command{
+	Use:   "scan-pending-transfers [CHAIN]",
+	Short: "Scan the token bridge of an EVM chain for messages without a signed VAA and list their transactions for reobserve-batch",
+	Long: `Scan the token bridge of an EVM chain for LogMessagePublished events without a signed VAA in the public API of
+the guardians. Their transactions are printed to stdout as a reobserve-batch CSV file. With --reobserve, the
+observation requests are sent directly through the admin socket instead.`,
+	Run:  runScanPendingTransfers,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	scanPendingRPC = ScanPendingTransfersCmd.Flags().String("rpc", "", "JSON-RPC URL of a node of the chain (required)")
+	scanPendingCoreContract = ScanPendingTransfersCmd.Flags().String("coreContract", "", "Address of the core bridge on the chain (required)")
+	scanPendingTokenBridge = ScanPendingTransfersCmd.Flags().String("tokenBridge", "", "Address of the token bridge on the chain (defaults to the known token bridge of the network)")
+	scanPendingFromBlock = ScanPendingTransfersCmd.Flags().Uint64("fromBlock", 0, "First block to scan (required)")
+	scanPendingToBlock = ScanPendingTransfersCmd.Flags().Uint64("toBlock", 0, "Last block to scan (defaults to the latest block)")
+	scanPendingStep = ScanPendingTransfersCmd.Flags().Uint64("step", 1000, "Number of blocks queried at once")
+	scanPendingVAAAPIs = ScanPendingTransfersCmd.Flags().StringSlice("vaaAPI", nil, "Public API URLs of the guardians, queried for the signed VAAs (defaults to the mainnet public APIs, required with --testnet)")
+	scanPendingTestnet = ScanPendingTransfersCmd.Flags().Bool("testnet", false, "Scan a testnet chain")
+	scanPendingReobserve = ScanPendingTransfersCmd.Flags().Bool("reobserve", false, "Send the observation requests through the admin socket instead of printing them")
+	scanPendingSocket = ScanPendingTransfersCmd.Flags().String("socket", "", "Admin socket of the guardian, required with --reobserve")
+}
+
+// pendingTransfer is a token bridge message without a signed VAA.
+type pendingTransfer struct {
+	ID          string
+	TxHash      eth_common.Hash
+	BlockNumber uint64
+}
+
+func runScanPendingTransfers(cmd *cobra.Command, args []string) {
+	chainID, err := vaa.ChainIDFromString(args[0])
+	if err != nil {
+		log.Fatalf("invalid chain: %v", err)
+	}
+	if *scanPendingRPC == "" || *scanPendingCoreContract == "" || *scanPendingFromBlock == 0 {
+		log.Fatal("--rpc, --coreContract and --fromBlock are required")
+	}
+	if *scanPendingReobserve && *scanPendingSocket == "" {
+		log.Fatal("--socket is required with --reobserve")
+	}
+	if *scanPendingStep == 0 {
+		log.Fatal("--step must be positive")
+	}
+
+	knownTokenBridges, apis := sdk.KnownTokenbridgeEmitters, *scanPendingVAAAPIs
+	if *scanPendingTestnet {
+		knownTokenBridges = sdk.KnownTestnetTokenbridgeEmitters
+		if len(apis) == 0 {
+			log.Fatal("--vaaAPI is required with --testnet")
+		}
+	} else if len(apis) == 0 {
+		apis = sdk.PublicRPCEndpoints
+	}
+
+	emitter := *scanPendingTokenBridge
+	if emitter == "" {
+		known, exists := knownTokenBridges[chainID]
+		if !exists {
+			log.Fatalf("no known token bridge on %v, use --tokenBridge", chainID)
+		}
+		emitter = hex.EncodeToString(known)
+	}
+	emitterAddr, err := vaa.StringToAddress(strings.TrimPrefix(emitter, "0x"))
+	if err != nil {
+		log.Fatalf("invalid token bridge address: %v", err)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, *scanPendingRPC)
+	if err != nil {
+		log.Fatalf("failed to connect to %s: %v", *scanPendingRPC, err)
+	}
+	defer client.Close()
+
+	last := *scanPendingToBlock
+	if last == 0 {
+		last, err = client.BlockNumber(ctx)
+		if err != nil {
+			log.Fatalf("failed to get the latest block: %v", err)
+		}
+	}
+
+	events, err := fetchTokenBridgeMessages(ctx, client, eth_common.HexToAddress(*scanPendingCoreContract), emitterAddr, *scanPendingFromBlock, last, *scanPendingStep)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("found %d token bridge messages from block %d to %d", len(events), *scanPendingFromBlock, last)
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	var pending []pendingTransfer
+	for _, ev := range events {
+		id := fmt.Sprintf("%d/%s/%d", chainID, emitterAddr, ev.Sequence)
+		exists, err := signedVAAExists(ctx, httpClient, apis, id)
+		if err != nil {
+			log.Printf("failed to check %s, skipping it: %v", id, err)
+			continue
+		}
+		if !exists {
+			pending = append(pending, pendingTransfer{ID: id, TxHash: ev.Raw.TxHash, BlockNumber: ev.Raw.BlockNumber})
+		}
+	}
+	log.Printf("found %d token bridge messages without a signed VAA", len(pending))
+
+	if !*scanPendingReobserve {
+		if err := writeReobservationCSV(os.Stdout, chainID, pending); err != nil {
+			log.Fatalf("failed to write the reobservation list: %v", err)
+		}
+		return
+	}
+
+	if err := sendPendingObservationRequests(ctx, *scanPendingSocket, chainID, pending); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// logMessagePublishedTopic returns the topic of the LogMessagePublished event of the core bridge.
+func logMessagePublishedTopic() (eth_common.Hash, error) {
+	coreAbi, err := abi.JSON(strings.NewReader(ethabi.AbiABI))
+	if err != nil {
+		return eth_common.Hash{}, fmt.Errorf("failed to parse the core bridge ABI: %w", err)
+	}
+	return coreAbi.Events["LogMessagePublished"].ID, nil
+}
+
+// fetchTokenBridgeMessages returns the LogMessagePublished events emitted by the core bridge for the token bridge in the
+// block range, querying step blocks at once.
+func fetchTokenBridgeMessages(ctx context.Context, client ethereum.LogFilterer, core eth_common.Address, emitter vaa.Address, from, to, step uint64) ([]*ethabi.AbiLogMessagePublished, error) {
+	topic, err := logMessagePublishedTopic()
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := ethabi.NewAbiFilterer(core, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the core bridge filterer: %w", err)
+	}
+
+	var events []*ethabi.AbiLogMessagePublished
+	for start := from; start <= to; start += step {
+		end := start + step - 1
+		if end > to {
+			end = to
+		}
+
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []eth_common.Address{core},
+			Topics:    [][]eth_common.Hash{{topic}, {eth_common.Hash(emitter)}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get logs from block %d to %d: %w", start, end, err)
+		}
+
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			ev, err := filterer.ParseLogMessagePublished(l)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse log %d of tx %s: %w", l.Index, l.TxHash.Hex(), err)
+			}
+			events = append(events, ev)
+		}
+	}
+	return events, nil
+}
+
+// signedVAAExists returns whether one of the public APIs has the signed VAA with the given message ID. An error is
+// returned if none of them could be queried, since the VAA may exist.
+func signedVAAExists(ctx context.Context, client *http.Client, apis []string, id string) (bool, error) {
+	var lastErr error
+	queried := false
+	for _, api := range apis {
+		req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/v1/signed_vaa/%s", strings.TrimSuffix(api, "/"), id), nil)
+		if err != nil {
+			return false, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return true, nil
+		case http.StatusNotFound:
+			queried = true
+		default:
+			lastErr = fmt.Errorf("%s returned status %d", api, resp.StatusCode)
+		}
+	}
+
+	if !queried {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no public API configured")
+		}
+		return false, lastErr
+	}
+	return false, nil
+}
+
+// writeReobservationCSV writes the transaction of each pending transfer as a reobserve-batch CSV file. Transactions
+// emitting several pending transfers are listed once, and the message IDs are written as comments.
+func writeReobservationCSV(w io.Writer, chainID vaa.ChainID, pending []pendingTransfer) error {
+	if _, err := fmt.Fprintf(w, "# Reobservation requests for %d token bridge messages on %v without a signed VAA.\n", len(pending), chainID); err != nil {
+		return err
+	}
+
+	seen := make(map[eth_common.Hash]bool)
+	for _, p := range pending {
+		if _, err := fmt.Fprintf(w, "# %s (block %d)\n", p.ID, p.BlockNumber); err != nil {
+			return err
+		}
+		if seen[p.TxHash] {
+			continue
+		}
+		seen[p.TxHash] = true
+		if _, err := fmt.Fprintf(w, "%s,%s\n", chainID, p.TxHash.Hex()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendPendingObservationRequests sends an observation request for the transaction of each pending transfer through the
+// admin socket.
+func sendPendingObservationRequests(ctx context.Context, socket string, chainID vaa.ChainID, pending []pendingTransfer) error {
+	conn, c, err := getAdminClient(ctx, socket)
+	if err != nil {
+		return fmt.Errorf("failed to get admin client: %w", err)
+	}
+	defer conn.Close()
+
+	seen := make(map[eth_common.Hash]bool)
+	for _, p := range pending {
+		if seen[p.TxHash] {
+			continue
+		}
+		seen[p.TxHash] = true
+
+		req := &gossipv1.ObservationRequest{
+			ChainId: uint32(chainID),
+			TxHash:  p.TxHash.Bytes(),
+		}
+		if err := sendBatchObservationRequest(ctx, c, req, 0); err != nil {
+			return fmt.Errorf("failed to send observation request for %s: %w", p.TxHash.Hex(), err)
+		}
+		log.Printf("sent observation request for %s (%s)", p.TxHash.Hex(), p.ID)
+	}
+	return nil
+}
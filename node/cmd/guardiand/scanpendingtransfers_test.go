@@ -0,0 +1,127 @@
+package guardiand
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+type mockLogFilterer struct {
+	logs    []types.Log
+	queries []ethereum.FilterQuery
+}
+
+func (m *mockLogFilterer) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	m.queries = append(m.queries, q)
+	var logs []types.Log
+	for _, l := range m.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (m *mockLogFilterer) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	panic("not implemented")
+}
+
+func testMessageLog(t *testing.T, emitter vaa.Address, sequence uint64, block uint64, tx eth_common.Hash) types.Log {
+	t.Helper()
+	coreAbi, err := abi.JSON(strings.NewReader(ethabi.AbiABI))
+	require.NoError(t, err)
+	ev := coreAbi.Events["LogMessagePublished"]
+	data, err := ev.Inputs.NonIndexed().Pack(sequence, uint32(0), []byte{0x01}, uint8(1))
+	require.NoError(t, err)
+	return types.Log{
+		Topics:      []eth_common.Hash{ev.ID, eth_common.Hash(emitter)},
+		Data:        data,
+		BlockNumber: block,
+		TxHash:      tx,
+	}
+}
+
+func TestFetchTokenBridgeMessages(t *testing.T) {
+	emitter := vaa.Address{0x01}
+	client := &mockLogFilterer{logs: []types.Log{
+		testMessageLog(t, emitter, 1, 10, eth_common.Hash{0x0a}),
+		testMessageLog(t, emitter, 2, 15, eth_common.Hash{0x0b}),
+		testMessageLog(t, emitter, 3, 25, eth_common.Hash{0x0c}),
+	}}
+
+	events, err := fetchTokenBridgeMessages(context.Background(), client, eth_common.Address{0x02}, emitter, 10, 24, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, uint64(1), events[0].Sequence)
+	assert.Equal(t, uint64(2), events[1].Sequence)
+	assert.Equal(t, eth_common.Hash{0x0b}, events[1].Raw.TxHash)
+
+	require.Len(t, client.queries, 2)
+	assert.Equal(t, uint64(19), client.queries[0].ToBlock.Uint64())
+	assert.Equal(t, uint64(20), client.queries[1].FromBlock.Uint64())
+	assert.Equal(t, uint64(24), client.queries[1].ToBlock.Uint64())
+	assert.Equal(t, eth_common.Hash(emitter), client.queries[0].Topics[1][0])
+}
+
+func TestSignedVAAExists(t *testing.T) {
+	found := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/signed_vaa/2/0000000000000000000000000000000000000000000000000000000000000001/1" {
+			_, _ = w.Write([]byte(`{"vaaBytes": "AQ=="}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer found.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	ctx := context.Background()
+	emitter := "0000000000000000000000000000000000000000000000000000000000000001"
+
+	exists, err := signedVAAExists(ctx, found.Client(), []string{failing.URL, found.URL}, "2/"+emitter+"/1")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = signedVAAExists(ctx, found.Client(), []string{failing.URL, found.URL}, "2/"+emitter+"/2")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = signedVAAExists(ctx, found.Client(), []string{failing.URL}, "2/"+emitter+"/2")
+	assert.ErrorContains(t, err, "returned status 500")
+}
+
+func TestWriteReobservationCSV(t *testing.T) {
+	pending := []pendingTransfer{
+		{ID: "2/0001/1", TxHash: eth_common.Hash{0x0a}, BlockNumber: 10},
+		{ID: "2/0001/2", TxHash: eth_common.Hash{0x0a}, BlockNumber: 10},
+		{ID: "2/0001/3", TxHash: eth_common.Hash{0x0b}, BlockNumber: 12},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeReobservationCSV(&buf, vaa.ChainIDEthereum, pending))
+	assert.Contains(t, buf.String(), "# 2/0001/2 (block 10)\n")
+
+	// The output is a valid reobserve-batch file listing each transaction once.
+	entries, err := parseReobservationCSV(&buf)
+	require.NoError(t, err)
+	reqs, err := buildObservationRequests(entries, "")
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), reqs[0].ChainId)
+	assert.Equal(t, eth_common.Hash{0x0a}.Bytes(), reqs[0].TxHash)
+	assert.Equal(t, eth_common.Hash{0x0b}.Bytes(), reqs[1].TxHash)
+}
@@ -0,0 +1,375 @@
+package guardiand
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	verifyVAAHex          *string
+	verifyVAAEthRPC       *string
+	verifyVAAEthContract  *string
+	verifyVAAWormchainLCD *string
+	verifyVAAJSON         *bool
+)
+
+func init() {
+	verifyVAAHex = VerifyVAACmd.Flags().String("vaa", "", "Hex encoded VAA to verify")
+	verifyVAAEthRPC = VerifyVAACmd.Flags().String("ethRPC", "", "Ethereum RPC URL to read the guardian set from")
+	verifyVAAEthContract = VerifyVAACmd.Flags().String("ethContract", "", "Ethereum core bridge contract address")
+	verifyVAAWormchainLCD = VerifyVAACmd.Flags().String("wormchainLCD", "", "Wormchain LCD URL to read the guardian set from, instead of Ethereum")
+	verifyVAAJSON = VerifyVAACmd.Flags().Bool("json", false, "Print the result as JSON")
+}
+
+// VerifyVAACmd verifies the signatures of a VAA against the guardian set read from a core contract and prints its content.
+var VerifyVAACmd = &cobra.Command{
+	Use:   "verify-vaa",
+	Short: "Verify the signatures of a VAA against the on-chain guardian set and print its content",
+	Run:   runVerifyVAA,
+	Args:  cobra.NoArgs,
+}
+
+// onChainGuardianSet is a guardian set as stored by a core contract.
+type onChainGuardianSet struct {
+	Keys []ethcommon.Address
+	// ExpirationTime is the unix time after which the guardian set is no longer accepted, or zero if it does not expire.
+	ExpirationTime uint64
+}
+
+// guardianSetSource reads guardian sets from a core contract.
+type guardianSetSource interface {
+	CurrentGuardianSetIndex(ctx context.Context) (uint32, error)
+	GuardianSet(ctx context.Context, index uint32) (*onChainGuardianSet, error)
+}
+
+// evmGuardianSetSource reads guardian sets from the core bridge contract on an EVM chain.
+type evmGuardianSetSource struct {
+	connector *connectors.EthereumConnector
+}
+
+func (s *evmGuardianSetSource) CurrentGuardianSetIndex(ctx context.Context) (uint32, error) {
+	return s.connector.GetCurrentGuardianSetIndex(ctx)
+}
+
+func (s *evmGuardianSetSource) GuardianSet(ctx context.Context, index uint32) (*onChainGuardianSet, error) {
+	gs, err := s.connector.GetGuardianSet(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	return &onChainGuardianSet{Keys: gs.Keys, ExpirationTime: uint64(gs.ExpirationTime)}, nil
+}
+
+// wormchainGuardianSetSource reads guardian sets from the wormhole module of wormchain, using the LCD REST API.
+type wormchainGuardianSetSource struct {
+	lcd    string
+	client *http.Client
+}
+
+func (s *wormchainGuardianSetSource) get(ctx context.Context, path string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(s.lcd, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("query %s failed with status %s: %s", path, resp.Status, body)
+	}
+	return json.Unmarshal(body, result)
+}
+
+func (s *wormchainGuardianSetSource) CurrentGuardianSetIndex(ctx context.Context) (uint32, error) {
+	var resp struct {
+		LatestGuardianSetIndex uint32 `json:"latestGuardianSetIndex"`
+	}
+	if err := s.get(ctx, "/wormhole_foundation/wormchain/wormhole/latest_guardian_set_index", &resp); err != nil {
+		return 0, err
+	}
+	return resp.LatestGuardianSetIndex, nil
+}
+
+func (s *wormchainGuardianSetSource) GuardianSet(ctx context.Context, index uint32) (*onChainGuardianSet, error) {
+	var resp struct {
+		GuardianSet struct {
+			Keys [][]byte `json:"keys"`
+			// uint64 values are encoded as strings in the JSON of the LCD.
+			ExpirationTime uint64 `json:"expirationTime,string"`
+		} `json:"GuardianSet"`
+	}
+	if err := s.get(ctx, fmt.Sprintf("/wormhole_foundation/wormchain/wormhole/guardianSet/%d", index), &resp); err != nil {
+		return nil, err
+	}
+
+	gs := &onChainGuardianSet{ExpirationTime: resp.GuardianSet.ExpirationTime}
+	for _, key := range resp.GuardianSet.Keys {
+		if len(key) != ethcommon.AddressLength {
+			return nil, fmt.Errorf("invalid guardian key %x in guardian set %d", key, index)
+		}
+		gs.Keys = append(gs.Keys, ethcommon.BytesToAddress(key))
+	}
+	return gs, nil
+}
+
+// signatureVerification is the result of the verification of a single signature of a VAA.
+type signatureVerification struct {
+	Index uint8 `json:"index"`
+	// Guardian is the address of the guardian at Index in the guardian set, if there is one.
+	Guardian string `json:"guardian,omitempty"`
+	// Signer is the address recovered from the signature.
+	Signer string `json:"signer,omitempty"`
+	Valid  bool   `json:"valid"`
+	Error  string `json:"error,omitempty"`
+}
+
+// transferSummary is the decoded header of a token bridge transfer payload.
+type transferSummary struct {
+	Amount        string `json:"amount"`
+	OriginChain   string `json:"originChain"`
+	OriginAddress string `json:"originAddress"`
+	TargetChain   string `json:"targetChain"`
+	TargetAddress string `json:"targetAddress"`
+}
+
+// vaaVerification is the breakdown of a VAA and of the verification of its signatures.
+type vaaVerification struct {
+	Version          uint8            `json:"version"`
+	GuardianSetIndex uint32           `json:"guardianSetIndex"`
+	Timestamp        time.Time        `json:"timestamp"`
+	Nonce            uint32           `json:"nonce"`
+	Sequence         uint64           `json:"sequence"`
+	ConsistencyLevel uint8            `json:"consistencyLevel"`
+	EmitterChain     string           `json:"emitterChain"`
+	EmitterAddress   string           `json:"emitterAddress"`
+	MessageID        string           `json:"messageId"`
+	Digest           string           `json:"digest"`
+	Payload          string           `json:"payload"`
+	Transfer         *transferSummary `json:"transfer,omitempty"`
+
+	CurrentGuardianSetIndex uint32                  `json:"currentGuardianSetIndex"`
+	GuardianSetSize         int                     `json:"guardianSetSize"`
+	GuardianSetExpired      bool                    `json:"guardianSetExpired"`
+	Signatures              []signatureVerification `json:"signatures"`
+	NumValidSignatures      int                     `json:"numValidSignatures"`
+	Quorum                  int                     `json:"quorum"`
+
+	// Valid is true if the VAA would be accepted by the core contracts.
+	Valid bool `json:"valid"`
+	// Problems lists the reasons why the VAA is not valid.
+	Problems []string `json:"problems,omitempty"`
+	// Warnings lists findings which do not make the VAA invalid.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// verifyVAA verifies the signatures of v against gs, the guardian set v claims to be signed by, with the same rules as
+// the core contracts. currentIndex is the index of the current guardian set and now the time the expiration of gs is
+// checked against.
+func verifyVAA(v *vaa.VAA, gs *onChainGuardianSet, currentIndex uint32, now time.Time) *vaaVerification {
+	digest := v.SigningDigest()
+	result := &vaaVerification{
+		Version:                 v.Version,
+		GuardianSetIndex:        v.GuardianSetIndex,
+		Timestamp:               v.Timestamp.UTC(),
+		Nonce:                   v.Nonce,
+		Sequence:                v.Sequence,
+		ConsistencyLevel:        v.ConsistencyLevel,
+		EmitterChain:            v.EmitterChain.String(),
+		EmitterAddress:          v.EmitterAddress.String(),
+		MessageID:               v.MessageID(),
+		Digest:                  hex.EncodeToString(digest.Bytes()),
+		Payload:                 hex.EncodeToString(v.Payload),
+		CurrentGuardianSetIndex: currentIndex,
+		GuardianSetSize:         len(gs.Keys),
+		Quorum:                  vaa.CalculateQuorum(len(gs.Keys)),
+		Signatures:              make([]signatureVerification, 0, len(v.Signatures)),
+	}
+
+	if vaa.IsTransfer(v.Payload) {
+		if hdr, err := vaa.DecodeTransferPayloadHdr(v.Payload); err == nil {
+			result.Transfer = &transferSummary{
+				Amount:        hdr.Amount.String(),
+				OriginChain:   hdr.OriginChain.String(),
+				OriginAddress: hdr.OriginAddress.String(),
+				TargetChain:   hdr.TargetChain.String(),
+				TargetAddress: hdr.TargetAddress.String(),
+			}
+		}
+	}
+
+	if v.GuardianSetIndex != currentIndex {
+		// A VAA signed by a previous guardian set is still accepted until that guardian set expires.
+		result.Warnings = append(result.Warnings, fmt.Sprintf("signed by guardian set %d, the current guardian set is %d", v.GuardianSetIndex, currentIndex))
+	}
+	if gs.ExpirationTime != 0 && uint64(now.Unix()) > gs.ExpirationTime {
+		result.GuardianSetExpired = true
+		result.Problems = append(result.Problems, fmt.Sprintf("guardian set %d expired at %s", v.GuardianSetIndex, time.Unix(int64(gs.ExpirationTime), 0).UTC()))
+	}
+
+	lastIndex := -1
+	for _, sig := range v.Signatures {
+		s := signatureVerification{Index: sig.Index}
+		if pubKey, err := ethcrypto.Ecrecover(digest.Bytes(), sig.Signature[:]); err != nil {
+			s.Error = fmt.Sprintf("failed to recover signer: %v", err)
+		} else {
+			s.Signer = ethcommon.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:]).Hex()
+		}
+
+		switch {
+		case int(sig.Index) >= len(gs.Keys):
+			s.Error = "guardian index out of range"
+		case int(sig.Index) <= lastIndex:
+			s.Guardian = gs.Keys[sig.Index].Hex()
+			s.Error = "guardian index not in increasing order"
+		case s.Error == "":
+			s.Guardian = gs.Keys[sig.Index].Hex()
+			if s.Signer == s.Guardian {
+				s.Valid = true
+				result.NumValidSignatures++
+			} else {
+				s.Error = "not signed by the guardian"
+			}
+		default:
+			s.Guardian = gs.Keys[sig.Index].Hex()
+		}
+		if int(sig.Index) > lastIndex {
+			lastIndex = int(sig.Index)
+		}
+
+		if !s.Valid {
+			result.Problems = append(result.Problems, fmt.Sprintf("signature %d: %s", sig.Index, s.Error))
+		}
+		result.Signatures = append(result.Signatures, s)
+	}
+
+	if result.NumValidSignatures < result.Quorum {
+		result.Problems = append(result.Problems, fmt.Sprintf("no quorum: %d valid signatures, %d required", result.NumValidSignatures, result.Quorum))
+	}
+
+	result.Valid = !result.GuardianSetExpired && v.Verify(gs.Keys) == nil
+	return result
+}
+
+// printVAAVerification prints the result of verifyVAA in a human readable form.
+func printVAAVerification(w io.Writer, r *vaaVerification) {
+	fmt.Fprintf(w, "Message ID:        %s\n", r.MessageID)
+	fmt.Fprintf(w, "Digest:            %s\n", r.Digest)
+	fmt.Fprintf(w, "Version:           %d\n", r.Version)
+	fmt.Fprintf(w, "Timestamp:         %s\n", r.Timestamp.Format(time.RFC3339))
+	fmt.Fprintf(w, "Nonce:             %d\n", r.Nonce)
+	fmt.Fprintf(w, "Sequence:          %d\n", r.Sequence)
+	fmt.Fprintf(w, "Consistency level: %d\n", r.ConsistencyLevel)
+	fmt.Fprintf(w, "Emitter:           %s %s\n", r.EmitterChain, r.EmitterAddress)
+	fmt.Fprintf(w, "Payload:           %s\n", r.Payload)
+	if r.Transfer != nil {
+		fmt.Fprintf(w, "Transfer:          %s of %s %s to %s %s\n", r.Transfer.Amount,
+			r.Transfer.OriginChain, r.Transfer.OriginAddress, r.Transfer.TargetChain, r.Transfer.TargetAddress)
+	}
+	fmt.Fprintf(w, "Guardian set:      %d (current: %d, %d guardians)\n", r.GuardianSetIndex, r.CurrentGuardianSetIndex, r.GuardianSetSize)
+
+	fmt.Fprintf(w, "Signatures:\n")
+	for _, s := range r.Signatures {
+		status := "OK"
+		if !s.Valid {
+			status = "INVALID: " + s.Error
+		}
+		fmt.Fprintf(w, "  %3d %-42s %s\n", s.Index, s.Guardian, status)
+	}
+	fmt.Fprintf(w, "Quorum:            %d of %d valid signatures required, %d present\n", r.Quorum, r.GuardianSetSize, r.NumValidSignatures)
+
+	for _, warning := range r.Warnings {
+		fmt.Fprintf(w, "Warning:           %s\n", warning)
+	}
+	for _, problem := range r.Problems {
+		fmt.Fprintf(w, "Problem:           %s\n", problem)
+	}
+	if r.Valid {
+		fmt.Fprintf(w, "Result:            VALID\n")
+	} else {
+		fmt.Fprintf(w, "Result:            INVALID\n")
+	}
+}
+
+func runVerifyVAA(cmd *cobra.Command, args []string) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(*verifyVAAHex), "0x"))
+	if err != nil {
+		log.Fatalf("failed to decode --vaa: %v", err)
+	}
+	if len(b) == 0 {
+		log.Fatalf("Please specify --vaa")
+	}
+	v, err := vaa.Unmarshal(b)
+	if err != nil {
+		log.Fatalf("failed to parse VAA: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var source guardianSetSource
+	switch {
+	case *verifyVAAWormchainLCD != "":
+		source = &wormchainGuardianSetSource{lcd: *verifyVAAWormchainLCD, client: &http.Client{Timeout: 15 * time.Second}}
+	case *verifyVAAEthRPC != "":
+		if !ethcommon.IsHexAddress(*verifyVAAEthContract) {
+			log.Fatalf("Please specify a valid --ethContract")
+		}
+		connector, err := connectors.NewEthereumConnector(ctx, "eth", *verifyVAAEthRPC, ethcommon.HexToAddress(*verifyVAAEthContract), zap.NewNop())
+		if err != nil {
+			log.Fatalf("failed to connect to Ethereum: %v", err)
+		}
+		source = &evmGuardianSetSource{connector: connector}
+	default:
+		log.Fatalf("Please specify --ethRPC and --ethContract, or --wormchainLCD")
+	}
+
+	currentIndex, err := source.CurrentGuardianSetIndex(ctx)
+	if err != nil {
+		log.Fatalf("failed to read the current guardian set index: %v", err)
+	}
+	gs, err := source.GuardianSet(ctx, v.GuardianSetIndex)
+	if err != nil {
+		log.Fatalf("failed to read guardian set %d: %v", v.GuardianSetIndex, err)
+	}
+	if len(gs.Keys) == 0 {
+		log.Fatalf("guardian set %d does not exist", v.GuardianSetIndex)
+	}
+
+	result := verifyVAA(v, gs, currentIndex, time.Now())
+
+	if *verifyVAAJSON {
+		out, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			log.Fatalf("failed to marshal result: %v", err)
+		}
+		fmt.Println(string(out))
+	} else {
+		printVAAVerification(cmd.OutOrStdout(), result)
+	}
+
+	if !result.Valid {
+		// Let scripts tell invalid VAAs apart.
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,108 @@
+package guardiand
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/devnet"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestVerifyVAA(t *testing.T) {
+	guardians, err := devnet.NewGuardianSet(4)
+	require.NoError(t, err)
+	gs := &onChainGuardianSet{Keys: guardians.Addresses()}
+	now := time.Now()
+
+	v := devnet.NewVAA(vaa.ChainIDEthereum, vaa.Address{1}, 42, []byte{0xde, 0xad})
+	guardians.SignQuorum(v)
+
+	result := verifyVAA(v, gs, guardians.Index, now)
+	assert.True(t, result.Valid)
+	assert.Empty(t, result.Problems)
+	assert.Empty(t, result.Warnings)
+	assert.Equal(t, v.MessageID(), result.MessageID)
+	assert.Equal(t, "dead", result.Payload)
+	assert.Equal(t, 3, result.Quorum)
+	assert.Equal(t, 3, result.NumValidSignatures)
+	require.Len(t, result.Signatures, 3)
+	for i, s := range result.Signatures {
+		assert.True(t, s.Valid)
+		assert.Equal(t, gs.Keys[i].Hex(), s.Guardian)
+		assert.Equal(t, s.Guardian, s.Signer)
+	}
+
+	var out bytes.Buffer
+	printVAAVerification(&out, result)
+	assert.Contains(t, out.String(), "Result:            VALID")
+
+	// A previous guardian set is accepted until it expires.
+	result = verifyVAA(v, gs, guardians.Index+1, now)
+	assert.True(t, result.Valid)
+	assert.Len(t, result.Warnings, 1)
+	expired := &onChainGuardianSet{Keys: gs.Keys, ExpirationTime: uint64(now.Add(-time.Hour).Unix())}
+	result = verifyVAA(v, expired, guardians.Index+1, now)
+	assert.False(t, result.Valid)
+	assert.True(t, result.GuardianSetExpired)
+
+	// Without quorum.
+	v.Signatures = v.Signatures[:2]
+	result = verifyVAA(v, gs, guardians.Index, now)
+	assert.False(t, result.Valid)
+	assert.Equal(t, []string{"no quorum: 2 valid signatures, 3 required"}, result.Problems)
+
+	// A signature of the wrong guardian.
+	guardians.Sign(v)
+	v.Signatures[3].Index = 2
+	v.Signatures[2].Index = 3
+	result = verifyVAA(v, gs, guardians.Index, now)
+	assert.False(t, result.Valid)
+	assert.Equal(t, 2, result.NumValidSignatures)
+	assert.Equal(t, "not signed by the guardian", result.Signatures[2].Error)
+
+	// A signature of a guardian outside of the guardian set.
+	guardians.Sign(v)
+	v.Signatures[3].Index = 4
+	result = verifyVAA(v, gs, guardians.Index, now)
+	assert.False(t, result.Valid)
+	assert.Equal(t, 3, result.NumValidSignatures)
+	assert.Equal(t, "guardian index out of range", result.Signatures[3].Error)
+
+	out.Reset()
+	printVAAVerification(&out, result)
+	assert.Contains(t, out.String(), "Result:            INVALID")
+}
+
+func TestWormchainGuardianSetSource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wormhole_foundation/wormchain/wormhole/latest_guardian_set_index":
+			_, _ = w.Write([]byte(`{"latestGuardianSetIndex":3}`))
+		case "/wormhole_foundation/wormchain/wormhole/guardianSet/3":
+			_, _ = w.Write([]byte(`{"GuardianSet":{"index":3,"keys":["vvpCnVfNGLf4pNkaLamrSvBdD74="],"expirationTime":"0"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	source := &wormchainGuardianSetSource{lcd: srv.URL + "/", client: srv.Client()}
+	index, err := source.CurrentGuardianSetIndex(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), index)
+
+	gs, err := source.GuardianSet(context.Background(), 3)
+	require.NoError(t, err)
+	require.Len(t, gs.Keys, 1)
+	assert.Equal(t, "0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe", gs.Keys[0].Hex())
+	assert.Equal(t, uint64(0), gs.ExpirationTime)
+
+	_, err = source.GuardianSet(context.Background(), 2)
+	assert.ErrorContains(t, err, "404")
+}
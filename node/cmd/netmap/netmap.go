@@ -0,0 +1,221 @@
+package netmap
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/netmap"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	p2pNetworkID *string
+	p2pPort      *uint
+	p2pBootstrap *string
+
+	nodeKeyPath *string
+
+	logLevel *string
+
+	ethRPC      *string
+	ethContract *string
+
+	guardianSetRefresh *time.Duration
+	staleAfter         *time.Duration
+
+	rpcAddr  *string
+	httpAddr *string
+)
+
+func init() {
+	p2pNetworkID = NetmapCmd.Flags().String("network", "/wormhole/dev", "P2P network identifier")
+	p2pPort = NetmapCmd.Flags().Uint("port", 8999, "P2P UDP listener port")
+	p2pBootstrap = NetmapCmd.Flags().String("bootstrap", "", "P2P bootstrap peers (comma-separated)")
+
+	nodeKeyPath = NetmapCmd.Flags().String("nodeKey", "", "Path to node key (will be generated if it doesn't exist)")
+
+	logLevel = NetmapCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
+
+	ethRPC = NetmapCmd.Flags().String("ethRPC", "", "Ethereum RPC URL, used to read the guardian set the heartbeats are verified against")
+	ethContract = NetmapCmd.Flags().String("ethContract", "", "Ethereum core bridge contract address")
+
+	guardianSetRefresh = NetmapCmd.Flags().Duration("guardianSetRefresh", 10*time.Minute, "How often the guardian set is read from the core bridge contract")
+	staleAfter = NetmapCmd.Flags().Duration("staleAfter", netmap.DefaultStaleAfter, "How long after its last heartbeat a node is reported as stale")
+
+	rpcAddr = NetmapCmd.Flags().String("rpcAddr", "", "Listen address for the gRPC interface serving the heartbeats (disabled if blank)")
+	httpAddr = NetmapCmd.Flags().String("httpAddr", "[::]:6060", "Listen address for the HTTP server serving the network map on /v1/netmap and the metrics")
+}
+
+// NetmapCmd runs a service joining the gossip network and serving a map of the guardian nodes built from their heartbeats.
+var NetmapCmd = &cobra.Command{
+	Use:   "netmap",
+	Short: "Run a service serving a map of the guardian network built from the gossiped heartbeats",
+	Run:   runNetmap,
+}
+
+// nodeRetention is how long a node is kept in the map after its last heartbeat.
+const nodeRetention = 24 * time.Hour
+
+func runNetmap(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+
+	lvl, err := ipfslog.LevelFromString(*logLevel)
+	if err != nil {
+		fmt.Println("Invalid log level")
+		os.Exit(1)
+	}
+
+	logger := ipfslog.Logger("wormhole-netmap").Desugar()
+
+	ipfslog.SetAllLoggers(lvl)
+
+	// Verify flags
+
+	if *nodeKeyPath == "" {
+		logger.Fatal("Please specify --nodeKey")
+	}
+	if *p2pBootstrap == "" {
+		logger.Fatal("Please specify --bootstrap")
+	}
+	if *ethRPC == "" {
+		logger.Fatal("Please specify --ethRPC")
+	}
+	if !ethcommon.IsHexAddress(*ethContract) {
+		logger.Fatal("Please specify a valid --ethContract")
+	}
+	if *httpAddr == "" && *rpcAddr == "" {
+		logger.Fatal("Please specify --httpAddr or --rpcAddr")
+	}
+
+	rootCtx, rootCtxCancel := context.WithCancel(context.Background())
+	defer rootCtxCancel()
+
+	model := netmap.NewModel(*staleAfter)
+
+	// Verified heartbeats, pushed by the guardian set state
+	heartbeatC := make(chan *gossipv1.Heartbeat, 50)
+
+	// Guardian set state, the p2p layer verifies the heartbeats against its guardian set
+	gst := common.NewGuardianSetState(heartbeatC)
+
+	// Inbound messages other than heartbeats are ignored.
+	// Note: without this, the whole program hangs on them
+	obsvC := make(chan *gossipv1.SignedObservation, 50)
+	obsvReqC := make(chan *common.InboundObservationRequest, 50)
+	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, 50)
+	go func() {
+		for {
+			select {
+			case <-rootCtx.Done():
+				return
+			case <-obsvC:
+			case <-obsvReqC:
+			case <-signedInC:
+			}
+		}
+	}()
+
+	if *httpAddr != "" {
+		router := http.NewServeMux()
+		router.Handle("/v1/netmap", model.Handler())
+		router.Handle("/metrics", promhttp.Handler())
+
+		go func() {
+			logger.Info("http server listening", zap.String("addr", *httpAddr))
+			logger.Error("http server crashed", zap.Error(http.ListenAndServe(*httpAddr, router))) // #nosec G114 local status server not vulnerable to DoS attack
+		}()
+	}
+
+	var rpcSvc supervisor.Runnable
+	if *rpcAddr != "" {
+		l, err := net.Listen("tcp", *rpcAddr)
+		if err != nil {
+			logger.Fatal("failed to listen", zap.Error(err))
+		}
+		logger.Info("rpc server listening", zap.String("addr", l.Addr().String()))
+
+		grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailMinimal)
+		publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, netmap.NewPublicRPCServer(model))
+		rpcSvc = supervisor.GRPCServer(grpcServer, l, false)
+	}
+
+	// Load p2p private key
+	priv, err := common.GetOrCreateNodeKey(logger, *nodeKeyPath)
+	if err != nil {
+		logger.Fatal("Failed to load node key", zap.Error(err))
+	}
+
+	ethConn, err := connectors.NewEthereumConnector(rootCtx, "eth", *ethRPC, ethcommon.HexToAddress(*ethContract), logger)
+	if err != nil {
+		logger.Fatal("failed to connect to Ethereum", zap.Error(err))
+	}
+
+	// Run supervisor.
+	supervisor.New(rootCtx, logger, func(ctx context.Context) error {
+		if err := supervisor.Run(ctx, "guardianset", model.GuardianSetFetcher(ethConn, gst, *guardianSetRefresh)); err != nil {
+			return err
+		}
+
+		if err := supervisor.Run(ctx, "heartbeats", model.HeartbeatHandler(heartbeatC, nodeRetention)); err != nil {
+			return err
+		}
+
+		components := p2p.DefaultComponents()
+		components.Port = *p2pPort
+		if err := supervisor.Run(ctx,
+			"p2p",
+			p2p.Run(obsvC,
+				obsvReqC,
+				nil,
+				make(chan []byte),
+				signedInC,
+				priv,
+				nil,
+				gst,
+				*p2pNetworkID,
+				*p2pBootstrap,
+				"",
+				false,
+				rootCtxCancel,
+				nil,
+				nil,
+				nil,
+				nil,
+				components,
+				nil, // ibc feature string
+			)); err != nil {
+			return err
+		}
+
+		if rpcSvc != nil {
+			if err := supervisor.Run(ctx, "netmaprpc", rpcSvc); err != nil {
+				return err
+			}
+		}
+
+		logger.Info("Started internal services")
+
+		<-ctx.Done()
+		return nil
+	},
+		// It's safer to crash and restart the process in case we encounter a panic,
+		// rather than attempting to reschedule the runnable.
+		supervisor.WithPropagatePanic)
+
+	<-rootCtx.Done()
+	logger.Info("root context cancelled, exiting...")
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/certusone/wormhole/node/cmd/debug"
+	"github.com/certusone/wormhole/node/cmd/netmap"
 	"github.com/certusone/wormhole/node/cmd/spy"
 	"github.com/certusone/wormhole/node/pkg/version"
 
@@ -47,12 +48,23 @@ func init() {
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.guardiand.yaml)")
 	rootCmd.AddCommand(guardiand.NodeCmd)
+	rootCmd.AddCommand(guardiand.ConfigCmd)
 	rootCmd.AddCommand(spy.SpyCmd)
 	rootCmd.AddCommand(guardiand.KeygenCmd)
+	rootCmd.AddCommand(guardiand.EncryptGuardianKeyCmd)
+	rootCmd.AddCommand(guardiand.EncryptDBCmd)
+	rootCmd.AddCommand(guardiand.RestoreDBCmd)
+	rootCmd.AddCommand(guardiand.DowngradeGovernorDBCmd)
+	rootCmd.AddCommand(guardiand.P2PSigningKeyDelegationCmd)
+	rootCmd.AddCommand(guardiand.EnvelopeKeygenCmd)
+	rootCmd.AddCommand(guardiand.SendEnvelopeObservationRequestCmd)
 	rootCmd.AddCommand(guardiand.AdminCmd)
 	rootCmd.AddCommand(guardiand.TemplateCmd)
+	rootCmd.AddCommand(guardiand.VerifyVAACmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(debug.DebugCmd)
+	rootCmd.AddCommand(guardiand.ScanPendingTransfersCmd)
+	rootCmd.AddCommand(netmap.NetmapCmd)
 }
 
 // initConfig reads in config file and ENV variables if set.
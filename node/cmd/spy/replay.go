@@ -0,0 +1,179 @@
+package spy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resumeCursors holds, for each emitter a client asked to resume, the sequence of the next VAA to send. Replayed VAAs
+// are only verified if the spy runs with --vaaCacheGuardianSet.
+type resumeCursors map[filterSignedVaa]uint64
+
+// parseGuardianSet parses a guardian set given as its index followed by the comma-separated addresses of its
+// guardians, e.g. "3:0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5,0xfF6CB952589BDE862c25Ef4392132fb9D4A42157".
+func parseGuardianSet(str string) (*common.GuardianSet, error) {
+	indexStr, addrs, ok := strings.Cut(str, ":")
+	if !ok {
+		return nil, errors.New("guardian set must be formatted as <index>:<address>,<address>,...")
+	}
+	index, err := strconv.ParseUint(indexStr, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid guardian set index %q: %w", indexStr, err)
+	}
+
+	gs := &common.GuardianSet{Index: uint32(index)}
+	for _, addr := range strings.Split(addrs, ",") {
+		if !ethcommon.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid guardian address %q", addr)
+		}
+		gs.Keys = append(gs.Keys, ethcommon.HexToAddress(addr))
+	}
+	return gs, nil
+}
+
+// cacheSignedVAA stores a VAA received from the network in the local cache, so it can be replayed to clients resuming
+// their subscription later. If the guardian set is known, VAAs which are not signed by a quorum of it are refused.
+// Otherwise the cache can be poisoned by any peer: an existing entry is never overwritten, but forged VAAs with high
+// sequences still evict the real ones of their emitter once vaaCacheMaxPerEmitter is reached.
+func (s *spyServer) cacheSignedVAA(vaaBytes []byte) error {
+	if s.vaaCache == nil {
+		return nil
+	}
+
+	v, err := vaa.Unmarshal(vaaBytes)
+	if err != nil {
+		return err
+	}
+
+	// The database refuses unsigned VAAs.
+	if len(v.Signatures) == 0 {
+		return fmt.Errorf("VAA %s has no signatures", v.MessageID())
+	}
+
+	// The guardian set index is not covered by the signatures, so it is checked as well.
+	if gs := s.vaaCacheGuardianSet; gs != nil {
+		if v.GuardianSetIndex != gs.Index {
+			return fmt.Errorf("VAA %s is signed by guardian set %d instead of %d", v.MessageID(), v.GuardianSetIndex, gs.Index)
+		}
+		if err := v.Verify(gs.Keys); err != nil {
+			return fmt.Errorf("VAA %s failed verification: %w", v.MessageID(), err)
+		}
+	}
+
+	// The first copy received is kept, so that a forged copy cannot replace it.
+	id := db.VaaIDFromVAA(v)
+	if _, err := s.vaaCache.GetSignedVAABytes(*id); err == nil {
+		return nil
+	} else if !errors.Is(err, db.ErrVAANotFound) {
+		return err
+	}
+
+	if err := s.vaaCache.StoreSignedVAAWithTTL(v, s.vaaCacheTTL); err != nil {
+		return err
+	}
+
+	// Each emitter keeps a ring of its latest VAAs, so that a busy emitter cannot grow the cache without bounds.
+	if s.vaaCacheMaxPerEmitter > 0 {
+		if _, err := s.vaaCache.PruneSignedVAAs(*id, s.vaaCacheMaxPerEmitter); err != nil {
+			return fmt.Errorf("failed to prune VAA cache: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetCachedVAA returns a signed VAA from the local cache. Like replayed VAAs, it is only verified if the spy runs with
+// --vaaCacheGuardianSet.
+func (s *spyServer) GetCachedVAA(ctx context.Context, req *spyv1.GetCachedVAARequest) (*spyv1.GetCachedVAAResponse, error) {
+	if s.vaaCache == nil {
+		return nil, status.Error(codes.FailedPrecondition, "querying cached VAAs requires the spy to run with --vaaCacheDir")
+	}
+
+	if req.ChainId < 0 || req.ChainId > math.MaxUint16 {
+		return nil, status.Error(codes.InvalidArgument, "chain ID must fit in 16 bits")
+	}
+	addr, err := vaa.StringToAddress(req.EmitterAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
+	}
+
+	b, err := s.vaaCache.GetSignedVAABytes(db.VAAID{
+		EmitterChain:   vaa.ChainID(req.ChainId),
+		EmitterAddress: addr,
+		Sequence:       req.Sequence,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrVAANotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.logger.Error("failed to read VAA cache", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to read VAA cache")
+	}
+
+	return &spyv1.GetCachedVAAResponse{VaaBytes: b}, nil
+}
+
+// checkResumeSupported returns an error if a client asks to resume a subscription but the spy has no VAA cache.
+func (s *spyServer) checkResumeSupported(cursors resumeCursors) error {
+	if len(cursors) != 0 && s.vaaCache == nil {
+		return status.Error(codes.FailedPrecondition, "resuming subscriptions requires the spy to run with --vaaCacheDir")
+	}
+	return nil
+}
+
+// replayCachedVAAs sends the cached VAAs of every emitter in cursors, starting at the cursor, and advances the cursors
+// past the VAAs sent.
+func (s *spyServer) replayCachedVAAs(cursors resumeCursors, send func(vaaBytes []byte) error) error {
+	for emitter, next := range cursors {
+		vaas, err := s.vaaCache.GetSignedVAABytesFromSequence(db.VAAID{
+			EmitterChain:   emitter.chainId,
+			EmitterAddress: emitter.emitterAddr,
+			Sequence:       next,
+		})
+		if err != nil {
+			s.logger.Error("failed to read VAA cache", zap.Error(err))
+			return status.Error(codes.Internal, "failed to read VAA cache")
+		}
+
+		for _, b := range vaas {
+			v, err := vaa.Unmarshal(b)
+			if err != nil {
+				return status.Error(codes.Internal, fmt.Sprintf("invalid VAA in cache: %v", err))
+			}
+			if err := send(b); err != nil {
+				return err
+			}
+			cursors[emitter] = v.Sequence + 1
+		}
+	}
+
+	return nil
+}
+
+// alreadyReplayed returns true if a live VAA was already sent to the client while replaying the cache.
+func (cursors resumeCursors) alreadyReplayed(vaaBytes []byte) bool {
+	if len(cursors) == 0 {
+		return false
+	}
+
+	v, err := vaa.Unmarshal(vaaBytes)
+	if err != nil {
+		return false
+	}
+
+	next, ok := cursors[filterSignedVaa{chainId: v.EmitterChain, emitterAddr: v.EmitterAddress}]
+	return ok && v.Sequence < next
+}
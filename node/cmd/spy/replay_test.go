@@ -0,0 +1,275 @@
+package spy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSubscribeStream implements spyv1.SpyRPCService_SubscribeSignedVAAServer, passing the sent VAAs to a channel.
+type fakeSubscribeStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	sentC chan []byte
+}
+
+func (f *fakeSubscribeStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeSubscribeStream) Send(resp *spyv1.SubscribeSignedVAAResponse) error {
+	f.sentC <- resp.VaaBytes
+	return nil
+}
+
+func signedVAABytes(t *testing.T, key *ecdsa.PrivateKey, emitter vaa.Address, sequence uint64) []byte {
+	t.Helper()
+	v := getVAA(vaa.ChainIDEthereum, emitter, vaaNonce)
+	v.Sequence = sequence
+	v.AddSignature(key, 0)
+	b, err := v.Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+func sequenceOf(t *testing.T, b []byte) uint64 {
+	t.Helper()
+	v, err := vaa.Unmarshal(b)
+	require.NoError(t, err)
+	return v.Sequence
+}
+
+func resumeRequest(emitter vaa.Address, sequence uint64) *spyv1.SubscribeSignedVAARequest {
+	return &spyv1.SubscribeSignedVAARequest{Filters: []*spyv1.FilterEntry{{
+		Filter: &spyv1.FilterEntry_EmitterFilter{EmitterFilter: &spyv1.EmitterFilter{
+			ChainId:        publicrpcv1.ChainID(vaa.ChainIDEthereum),
+			EmitterAddress: emitter.String(),
+			ResumeFrom:     &spyv1.ResumeFrom{Sequence: sequence},
+		}},
+	}}}
+}
+
+func TestSpyResumeSubscription(t *testing.T) {
+	cache, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer cache.Close()
+
+	s := newSpyServer(zap.NewNop(), cache, time.Hour)
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	otherEmitter := vaa.Address{1}
+
+	for _, seq := range []uint64{1, 2, 3, 10} {
+		require.NoError(t, s.cacheSignedVAA(signedVAABytes(t, key, govEmitter, seq)))
+	}
+	require.NoError(t, s.cacheSignedVAA(signedVAABytes(t, key, otherEmitter, 5)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeSubscribeStream{ctx: ctx, sentC: make(chan []byte, 10)}
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- s.SubscribeSignedVAA(resumeRequest(govEmitter, 2), stream)
+	}()
+
+	// The cached VAAs are replayed in order, starting at the requested sequence.
+	for _, expected := range []uint64{2, 3, 10} {
+		assert.Equal(t, expected, sequenceOf(t, <-stream.sentC))
+	}
+
+	for {
+		s.subsSignedVaaMu.Lock()
+		subs := len(s.subsSignedVaa)
+		s.subsSignedVaaMu.Unlock()
+		if subs > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Live VAAs that were already replayed are skipped, new ones are forwarded.
+	require.NoError(t, s.PublishSignedVAA(signedVAABytes(t, key, govEmitter, 10)))
+	require.NoError(t, s.PublishSignedVAA(signedVAABytes(t, key, otherEmitter, 6)))
+	require.NoError(t, s.PublishSignedVAA(signedVAABytes(t, key, govEmitter, 11)))
+	assert.Equal(t, uint64(11), sequenceOf(t, <-stream.sentC))
+
+	cancel()
+	assert.ErrorIs(t, <-errC, context.Canceled)
+	assert.Equal(t, 0, len(stream.sentC))
+}
+
+func TestSpyResumeRequiresCache(t *testing.T) {
+	s := newSpyServer(zap.NewNop(), nil, 0)
+
+	stream := &fakeSubscribeStream{ctx: context.Background(), sentC: make(chan []byte, 1)}
+	err := s.SubscribeSignedVAA(resumeRequest(govEmitter, 1), stream)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Caching is a no-op without a cache.
+	assert.NoError(t, s.cacheSignedVAA([]byte{1}))
+}
+
+func TestSpyCacheRejectsUnsignedVAA(t *testing.T) {
+	cache, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer cache.Close()
+
+	s := newSpyServer(zap.NewNop(), cache, time.Hour)
+	b, err := getVAA(vaa.ChainIDEthereum, govEmitter, vaaNonce).Marshal()
+	require.NoError(t, err)
+	assert.Error(t, s.cacheSignedVAA(b))
+}
+
+func TestSpyReplayCachedVAAs(t *testing.T) {
+	cache, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer cache.Close()
+
+	s := newSpyServer(zap.NewNop(), cache, time.Hour)
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	signed := signedVAABytes(t, key, govEmitter, 1)
+	require.NoError(t, s.cacheSignedVAA(signed))
+
+	cursors := resumeCursors{filterSignedVaa{chainId: vaa.ChainIDEthereum, emitterAddr: govEmitter}: 0}
+	var replayed [][]byte
+	require.NoError(t, s.replayCachedVAAs(cursors, func(b []byte) error {
+		replayed = append(replayed, b)
+		return nil
+	}))
+	assert.Equal(t, [][]byte{signed}, replayed)
+	assert.Equal(t, uint64(2), cursors[filterSignedVaa{chainId: vaa.ChainIDEthereum, emitterAddr: govEmitter}])
+	assert.True(t, cursors.alreadyReplayed(signed))
+	assert.False(t, cursors.alreadyReplayed(signedVAABytes(t, key, govEmitter, 2)))
+	assert.False(t, cursors.alreadyReplayed(signedVAABytes(t, key, vaa.Address{1}, 1)))
+}
+
+func cachedVAARequest(emitter vaa.Address, sequence uint64) *spyv1.GetCachedVAARequest {
+	return &spyv1.GetCachedVAARequest{
+		ChainId:        publicrpcv1.ChainID(vaa.ChainIDEthereum),
+		EmitterAddress: emitter.String(),
+		Sequence:       sequence,
+	}
+}
+
+func TestSpyCacheKeepsFirstCopy(t *testing.T) {
+	cache, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer cache.Close()
+
+	s := newSpyServer(zap.NewNop(), cache, time.Hour)
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	first := signedVAABytes(t, key, govEmitter, 1)
+	require.NoError(t, s.cacheSignedVAA(first))
+
+	forgedKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	require.NoError(t, s.cacheSignedVAA(signedVAABytes(t, forgedKey, govEmitter, 1)))
+
+	resp, err := s.GetCachedVAA(context.Background(), cachedVAARequest(govEmitter, 1))
+	require.NoError(t, err)
+	assert.Equal(t, first, resp.VaaBytes)
+}
+
+func TestSpyCacheVerifiesVAAs(t *testing.T) {
+	cache, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer cache.Close()
+
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	s := newSpyServer(zap.NewNop(), cache, time.Hour)
+	s.vaaCacheMaxPerEmitter = 1
+	s.vaaCacheGuardianSet = &common.GuardianSet{Index: 1, Keys: []ethcommon.Address{crypto.PubkeyToAddress(key.PublicKey)}}
+
+	require.NoError(t, s.cacheSignedVAA(signedVAABytes(t, key, govEmitter, 1)))
+
+	// A forged VAA with a higher sequence neither gets cached nor evicts the real one.
+	forgedKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	assert.Error(t, s.cacheSignedVAA(signedVAABytes(t, forgedKey, govEmitter, math.MaxUint64)))
+
+	// Neither does a copy of a real VAA re-indexed to another guardian set.
+	v := getVAA(vaa.ChainIDEthereum, govEmitter, vaaNonce)
+	v.Sequence = 2
+	v.GuardianSetIndex = 2
+	v.AddSignature(key, 0)
+	b, err := v.Marshal()
+	require.NoError(t, err)
+	assert.Error(t, s.cacheSignedVAA(b))
+
+	_, err = s.GetCachedVAA(context.Background(), cachedVAARequest(govEmitter, 1))
+	assert.NoError(t, err)
+	_, err = s.GetCachedVAA(context.Background(), cachedVAARequest(govEmitter, math.MaxUint64))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestParseGuardianSet(t *testing.T) {
+	gs, err := parseGuardianSet("3:0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5,0xfF6CB952589BDE862c25Ef4392132fb9D4A42157")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), gs.Index)
+	assert.Equal(t, []ethcommon.Address{
+		ethcommon.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5"),
+		ethcommon.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157"),
+	}, gs.Keys)
+
+	for _, str := range []string{"", "0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5", "x:0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5", "3:", "3:0x1234"} {
+		_, err := parseGuardianSet(str)
+		assert.Error(t, err, str)
+	}
+}
+
+func TestSpyGetCachedVAA(t *testing.T) {
+	cache, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer cache.Close()
+
+	s := newSpyServer(zap.NewNop(), cache, time.Hour)
+	s.vaaCacheMaxPerEmitter = 2
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	for _, seq := range []uint64{1, 2, 3} {
+		require.NoError(t, s.cacheSignedVAA(signedVAABytes(t, key, govEmitter, seq)))
+	}
+	require.NoError(t, s.cacheSignedVAA(signedVAABytes(t, key, vaa.Address{1}, 1)))
+
+	resp, err := s.GetCachedVAA(context.Background(), cachedVAARequest(govEmitter, 3))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), sequenceOf(t, resp.VaaBytes))
+
+	// The oldest VAA of the emitter was evicted, the other emitter has its own ring.
+	_, err = s.GetCachedVAA(context.Background(), cachedVAARequest(govEmitter, 1))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.GetCachedVAA(context.Background(), cachedVAARequest(govEmitter, 2))
+	assert.NoError(t, err)
+	_, err = s.GetCachedVAA(context.Background(), cachedVAARequest(vaa.Address{1}, 1))
+	assert.NoError(t, err)
+
+	req := cachedVAARequest(govEmitter, 3)
+	req.EmitterAddress = "invalid"
+	_, err = s.GetCachedVAA(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = newSpyServer(zap.NewNop(), nil, 0).GetCachedVAA(context.Background(), cachedVAARequest(govEmitter, 3))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
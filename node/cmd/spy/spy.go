@@ -8,8 +8,10 @@ import (
 	"net/http"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
@@ -37,6 +39,10 @@ var (
 	p2pPort      *uint
 	p2pBootstrap *string
 
+	p2pStaticRelays             *string
+	p2pHolePunching             *bool
+	p2pForcePrivateReachability *bool
+
 	statusAddr *string
 
 	nodeKeyPath *string
@@ -44,6 +50,12 @@ var (
 	logLevel *string
 
 	spyRPC *string
+
+	vaaCacheDir *string
+	vaaCacheTTL *time.Duration
+
+	vaaCacheMaxPerEmitter *int
+	vaaCacheGuardianSet   *string
 )
 
 func init() {
@@ -51,6 +63,10 @@ func init() {
 	p2pPort = SpyCmd.Flags().Uint("port", 8999, "P2P UDP listener port")
 	p2pBootstrap = SpyCmd.Flags().String("bootstrap", "", "P2P bootstrap peers (comma-separated)")
 
+	p2pStaticRelays = SpyCmd.Flags().String("staticRelays", "", "Multiaddrs, including the /p2p/ peer ID, of the circuit relays to reserve a slot on when the spy is not publicly reachable (comma-separated)")
+	p2pHolePunching = SpyCmd.Flags().Bool("holePunching", false, "Try to upgrade relayed P2P connections to direct ones by hole punching (DCUtR)")
+	p2pForcePrivateReachability = SpyCmd.Flags().Bool("forcePrivateReachability", false, "Assume the spy is not publicly reachable, so that it reserves slots on the static relays right away")
+
 	statusAddr = SpyCmd.Flags().String("statusAddr", "[::]:6060", "Listen address for status server (disabled if blank)")
 
 	nodeKeyPath = SpyCmd.Flags().String("nodeKey", "", "Path to node key (will be generated if it doesn't exist)")
@@ -58,6 +74,11 @@ func init() {
 	logLevel = SpyCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
 
 	spyRPC = SpyCmd.Flags().String("spyRPC", "", "Listen address for gRPC interface")
+
+	vaaCacheDir = SpyCmd.Flags().String("vaaCacheDir", "", "Directory of the local cache of signed VAAs replayed to clients resuming their subscription (disabled if blank)")
+	vaaCacheTTL = SpyCmd.Flags().Duration("vaaCacheTTL", 24*time.Hour, "How long signed VAAs are kept in the local cache")
+	vaaCacheMaxPerEmitter = SpyCmd.Flags().Int("vaaCacheMaxPerEmitter", 1000, "Maximum number of signed VAAs kept in the local cache for each emitter, the lowest sequences being evicted first (unbounded if 0)")
+	vaaCacheGuardianSet = SpyCmd.Flags().String("vaaCacheGuardianSet", "", "Current guardian set, as <index>:<address>,<address>,..., which VAAs must be signed by to be cached (VAAs are cached unverified if blank, so any peer can poison the cache)")
 }
 
 // SpyCmd represents the node command
@@ -74,6 +95,13 @@ type spyServer struct {
 	subsSignedVaaMu sync.Mutex
 	subsAllVaa      map[string]*subscriptionAllVaa
 	subsAllVaaMu    sync.Mutex
+	vaaCache        *db.Database
+	vaaCacheTTL     time.Duration
+	// vaaCacheMaxPerEmitter is the maximum number of VAAs cached for each emitter, or 0 if the cache is only bounded by
+	// the TTL.
+	vaaCacheMaxPerEmitter int
+	// vaaCacheGuardianSet is the guardian set the cached VAAs are verified with, or nil if they are not verified.
+	vaaCacheGuardianSet *common.GuardianSet
 }
 
 type message struct {
@@ -217,7 +245,8 @@ func (s *spyServer) HandleGossipBatchVAA(g *gossipv1.SignedBatchVAAWithQuorum) e
 	s.subsAllVaaMu.Lock()
 	defer s.subsAllVaaMu.Unlock()
 
-	b, err := vaa.UnmarshalBatch(g.BatchVaa)
+	// The batch comes from the network, so bytes after its last observation are not ignored.
+	b, err := vaa.UnmarshalBatchWithOptions(g.BatchVaa, vaa.UnmarshalOptions{RejectTrailingBytes: true})
 	if err != nil {
 		s.logger.Error("failed unmarshaing BatchVAA bytes from gossipv1.SignedBatchVAAWithQuorum.",
 			zap.Error(err))
@@ -298,6 +327,7 @@ func (s *spyServer) HandleGossipBatchVAA(g *gossipv1.SignedBatchVAAWithQuorum) e
 
 func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, resp spyv1.SpyRPCService_SubscribeSignedVAAServer) error {
 	var fi []filterSignedVaa
+	cursors := resumeCursors{}
 	if req.Filters != nil {
 		for _, f := range req.Filters {
 			switch t := f.Filter.(type) {
@@ -306,16 +336,30 @@ func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, res
 				if err != nil {
 					return status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
 				}
-				fi = append(fi, filterSignedVaa{
+				f := filterSignedVaa{
 					chainId:     vaa.ChainID(t.EmitterFilter.ChainId),
 					emitterAddr: addr,
-				})
+				}
+				fi = append(fi, f)
+				if t.EmitterFilter.ResumeFrom != nil {
+					cursors[f] = t.EmitterFilter.ResumeFrom.Sequence
+				}
 			default:
 				return status.Error(codes.InvalidArgument, "unsupported filter type")
 			}
 		}
 	}
 
+	send := func(vaaBytes []byte) error {
+		return resp.Send(&spyv1.SubscribeSignedVAAResponse{VaaBytes: vaaBytes})
+	}
+	if err := s.checkResumeSupported(cursors); err != nil {
+		return err
+	}
+	if err := s.replayCachedVAAs(cursors, send); err != nil {
+		return err
+	}
+
 	s.subsSignedVaaMu.Lock()
 	id := subscriptionId()
 	sub := &subscriptionSignedVaa{
@@ -331,14 +375,20 @@ func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, res
 		delete(s.subsSignedVaa, id)
 	}()
 
+	// Catch up on VAAs cached between the first replay and the subscription.
+	if err := s.replayCachedVAAs(cursors, send); err != nil {
+		return err
+	}
+
 	for {
 		select {
 		case <-resp.Context().Done():
 			return resp.Context().Err()
 		case msg := <-sub.ch:
-			if err := resp.Send(&spyv1.SubscribeSignedVAAResponse{
-				VaaBytes: msg.vaaBytes,
-			}); err != nil {
+			if cursors.alreadyReplayed(msg.vaaBytes) {
+				continue
+			}
+			if err := send(msg.vaaBytes); err != nil {
 				return err
 			}
 		}
@@ -349,17 +399,21 @@ func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, res
 // to the map of active subscriptions.
 func (s *spyServer) SubscribeSignedVAAByType(req *spyv1.SubscribeSignedVAAByTypeRequest, resp spyv1.SpyRPCService_SubscribeSignedVAAByTypeServer) error {
 	var fi []*spyv1.FilterEntry
+	cursors := resumeCursors{}
 	if req.Filters != nil {
 		for _, f := range req.Filters {
 			switch t := f.Filter.(type) {
 
 			case *spyv1.FilterEntry_EmitterFilter:
 				// validate the emitter address is valid by decoding it
-				_, err := vaa.StringToAddress(t.EmitterFilter.EmitterAddress)
+				addr, err := vaa.StringToAddress(t.EmitterFilter.EmitterAddress)
 				if err != nil {
 					return status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
 				}
 				fi = append(fi, &spyv1.FilterEntry{Filter: t})
+				if t.EmitterFilter.ResumeFrom != nil {
+					cursors[filterSignedVaa{chainId: vaa.ChainID(t.EmitterFilter.ChainId), emitterAddr: addr}] = t.EmitterFilter.ResumeFrom.Sequence
+				}
 
 			case *spyv1.FilterEntry_BatchFilter,
 				*spyv1.FilterEntry_BatchTransactionFilter:
@@ -370,6 +424,20 @@ func (s *spyServer) SubscribeSignedVAAByType(req *spyv1.SubscribeSignedVAAByType
 		}
 	}
 
+	send := func(vaaBytes []byte) error {
+		return resp.Send(&spyv1.SubscribeSignedVAAByTypeResponse{
+			VaaType: &spyv1.SubscribeSignedVAAByTypeResponse_SignedVaa{
+				SignedVaa: &gossipv1.SignedVAAWithQuorum{Vaa: vaaBytes},
+			},
+		})
+	}
+	if err := s.checkResumeSupported(cursors); err != nil {
+		return err
+	}
+	if err := s.replayCachedVAAs(cursors, send); err != nil {
+		return err
+	}
+
 	s.subsAllVaaMu.Lock()
 	id := subscriptionId()
 	sub := &subscriptionAllVaa{
@@ -385,11 +453,19 @@ func (s *spyServer) SubscribeSignedVAAByType(req *spyv1.SubscribeSignedVAAByType
 		delete(s.subsAllVaa, id)
 	}()
 
+	// Catch up on VAAs cached between the first replay and the subscription.
+	if err := s.replayCachedVAAs(cursors, send); err != nil {
+		return err
+	}
+
 	for {
 		select {
 		case <-resp.Context().Done():
 			return resp.Context().Err()
 		case msg := <-sub.ch:
+			if v, ok := msg.VaaType.(*spyv1.SubscribeSignedVAAByTypeResponse_SignedVaa); ok && cursors.alreadyReplayed(v.SignedVaa.Vaa) {
+				continue
+			}
 			if err := resp.Send(msg); err != nil {
 				return err
 			}
@@ -397,11 +473,13 @@ func (s *spyServer) SubscribeSignedVAAByType(req *spyv1.SubscribeSignedVAAByType
 	}
 }
 
-func newSpyServer(logger *zap.Logger) *spyServer {
+func newSpyServer(logger *zap.Logger, vaaCache *db.Database, vaaCacheTTL time.Duration) *spyServer {
 	return &spyServer{
 		logger:        logger.Named("spyserver"),
 		subsSignedVaa: make(map[string]*subscriptionSignedVaa),
 		subsAllVaa:    make(map[string]*subscriptionAllVaa),
+		vaaCache:      vaaCache,
+		vaaCacheTTL:   vaaCacheTTL,
 	}
 }
 
@@ -446,12 +524,23 @@ func runSpy(cmd *cobra.Command, args []string) {
 
 	// Verify flags
 
+	if *vaaCacheMaxPerEmitter < 0 {
+		logger.Fatal("--vaaCacheMaxPerEmitter must not be negative")
+	}
+
 	if *nodeKeyPath == "" {
 		logger.Fatal("Please specify --nodeKey")
 	}
 	if *p2pBootstrap == "" {
 		logger.Fatal("Please specify --bootstrap")
 	}
+	staticRelays, err := p2p.ParseRelays(*p2pStaticRelays)
+	if err != nil {
+		logger.Fatal("invalid --staticRelays", zap.Error(err))
+	}
+	if *p2pForcePrivateReachability && len(staticRelays) == 0 {
+		logger.Fatal("--forcePrivateReachability requires --staticRelays")
+	}
 
 	// Node's main lifecycle context.
 	rootCtx, rootCtxCancel = context.WithCancel(context.Background())
@@ -464,7 +553,7 @@ func runSpy(cmd *cobra.Command, args []string) {
 	obsvC := make(chan *gossipv1.SignedObservation, 50)
 
 	// Inbound observation requests
-	obsvReqC := make(chan *gossipv1.ObservationRequest, 50)
+	obsvReqC := make(chan *common.InboundObservationRequest, 50)
 
 	// Inbound signed VAAs
 	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, 50)
@@ -472,8 +561,27 @@ func runSpy(cmd *cobra.Command, args []string) {
 	// Guardian set state managed by processor
 	gst := common.NewGuardianSetState(nil)
 
+	// Local cache of signed VAAs, for clients resuming their subscription
+	var vaaCache *db.Database
+	if *vaaCacheDir != "" {
+		vaaCache, err = db.Open(*vaaCacheDir)
+		if err != nil {
+			logger.Fatal("failed to open VAA cache", zap.Error(err))
+		}
+		defer vaaCache.Close()
+	}
+
 	// RPC server
-	s := newSpyServer(logger)
+	s := newSpyServer(logger, vaaCache, *vaaCacheTTL)
+	s.vaaCacheMaxPerEmitter = *vaaCacheMaxPerEmitter
+	if *vaaCacheGuardianSet != "" {
+		s.vaaCacheGuardianSet, err = parseGuardianSet(*vaaCacheGuardianSet)
+		if err != nil {
+			logger.Fatal("invalid --vaaCacheGuardianSet", zap.Error(err))
+		}
+	} else if vaaCache != nil {
+		logger.Warn("VAAs are cached without being verified, any peer can poison the cache, set --vaaCacheGuardianSet to verify them")
+	}
 	rpcSvc, _, err := spyServerRunnable(s, logger, *spyRPC)
 	if err != nil {
 		logger.Fatal("failed to start RPC server", zap.Error(err))
@@ -511,6 +619,10 @@ func runSpy(cmd *cobra.Command, args []string) {
 			case v := <-signedInC:
 				logger.Info("Received signed VAA",
 					zap.Any("vaa", v.Vaa))
+				// Cache the VAA before publishing it, so resuming subscriptions cannot miss it.
+				if err := s.cacheSignedVAA(v.Vaa); err != nil {
+					logger.Warn("failed to cache signed VAA", zap.Error(err))
+				}
 				if err := s.PublishSignedVAA(v.Vaa); err != nil {
 					logger.Error("failed to publish signed VAA", zap.Error(err))
 				}
@@ -532,6 +644,11 @@ func runSpy(cmd *cobra.Command, args []string) {
 	supervisor.New(rootCtx, logger, func(ctx context.Context) error {
 		components := p2p.DefaultComponents()
 		components.Port = *p2pPort
+		components.NATTraversal = p2p.NATTraversal{
+			StaticRelays:             staticRelays,
+			HolePunching:             *p2pHolePunching,
+			ForcePrivateReachability: *p2pForcePrivateReachability,
+		}
 		if err := supervisor.Run(ctx,
 			"p2p",
 			p2p.Run(obsvC,
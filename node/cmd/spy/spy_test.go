@@ -229,7 +229,7 @@ func init() {
 
 	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailFull)
 
-	mockedSpyServer = newSpyServer(logger)
+	mockedSpyServer = newSpyServer(logger, nil, 0)
 	spyv1.RegisterSpyRPCServiceServer(grpcServer, mockedSpyServer)
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
@@ -53,7 +53,7 @@ func main() {
 
 	transactionHash := ethCommon.HexToHash(*flagTx)
 
-	block, msgs, err := evm.MessageEventsForTransaction(ctx, ethIntf, contractAddr, chainID, transactionHash)
+	block, msgs, err := evm.MessageEventsForTransaction(ctx, ethIntf, contractAddr, nil, chainID, transactionHash)
 	if err != nil {
 		log.Fatal(err)
 	}
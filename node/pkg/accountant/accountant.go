@@ -15,7 +15,6 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
-	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -57,6 +56,8 @@ type (
 
 	// tokenBridgeEntry is the payload of the map of the token bridges being monitored
 	tokenBridgeEntry struct {
+		// emitterType selects the digest version of the messages of the emitter, see digest.go.
+		emitterType emitterType
 	}
 
 	// pendingEntry is the payload for each pending transfer
@@ -65,6 +66,9 @@ type (
 		msgId  string
 		digest string
 
+		// digestVersion is the version of the digest function used to compute digest, see digest.go.
+		digestVersion digestVersion
+
 		// stateLock is used to protect the contents of the state struct.
 		stateLock sync.Mutex
 
@@ -76,6 +80,10 @@ type (
 			// submitPending indicates if the observation is either in the channel waiting to be submitted or in an outstanding transaction.
 			// The audit should not resubmit anything where submitPending is set to true.
 			submitPending bool
+
+			// submittedGsIndex is the index of the guardian set used to sign the last submitted observation, if submitted is set to true.
+			submittedGsIndex uint32
+			submitted        bool
 		}
 	}
 )
@@ -85,7 +93,7 @@ type Accountant struct {
 	ctx                  context.Context
 	logger               *zap.Logger
 	db                   db.AccountantDB
-	obsvReqWriteC        chan<- *gossipv1.ObservationRequest
+	obsvReqWriteC        chan<- *common.InboundObservationRequest
 	contract             string
 	wsUrl                string
 	wormchainConn        AccountantWormchainConn
@@ -98,6 +106,9 @@ type Accountant struct {
 	pendingTransfersLock sync.Mutex
 	pendingTransfers     map[string]*pendingEntry // Key is the message ID (emitterChain/emitterAddr/seqNo)
 	subChan              chan *common.MessagePublication
+	shadow               *shadowAccountant // nil unless a shadow contract is configured, see shadow.go
+	modificationsLock    sync.Mutex
+	modifications        map[uint64]*Modification // Key is the sequence number, see modifications.go
 	env                  int
 }
 
@@ -109,7 +120,7 @@ func NewAccountant(
 	ctx context.Context,
 	logger *zap.Logger,
 	db db.AccountantDB,
-	obsvReqWriteC chan<- *gossipv1.ObservationRequest,
+	obsvReqWriteC chan<- *common.InboundObservationRequest,
 	contract string, // the address of the smart contract on wormchain
 	wsUrl string, // the URL of the wormchain websocket interface
 	wormchainConn AccountantWormchainConn, // used for communicating with the smart contract
@@ -134,6 +145,7 @@ func NewAccountant(
 		msgChan:          msgChan,
 		tokenBridges:     make(map[tokenBridgeKey]*tokenBridgeEntry),
 		pendingTransfers: make(map[string]*pendingEntry),
+		modifications:    make(map[uint64]*Modification),
 		subChan:          make(chan *common.MessagePublication, subChanSize),
 		env:              env,
 	}
@@ -165,7 +177,7 @@ func (acct *Accountant) Start(ctx context.Context) error {
 			return fmt.Errorf("detected duplicate token bridge for chain: %v", chainId)
 		}
 
-		tbe := &tokenBridgeEntry{}
+		tbe := &tokenBridgeEntry{emitterType: emitterTypeTokenBridge}
 		acct.tokenBridges[tbk] = tbe
 		acct.logger.Info("will monitor token bridge:", zap.Stringer("emitterChainId", tbk.emitterChainId), zap.Stringer("emitterAddr", tbk.emitterAddr))
 	}
@@ -181,6 +193,12 @@ func (acct *Accountant) Start(ctx context.Context) error {
 		go func() {
 			_ = acct.worker(ctx)
 		}()
+
+		if acct.shadow != nil {
+			go func() {
+				_ = acct.shadowWorker(ctx)
+			}()
+		}
 	} else if acct.env != GoTestMode {
 		if err := supervisor.Run(ctx, "acctworker", common.WrapWithScissors(acct.worker, "acctworker")); err != nil {
 			return fmt.Errorf("failed to start submit observation worker: %w", err)
@@ -193,12 +211,27 @@ func (acct *Accountant) Start(ctx context.Context) error {
 		if err := supervisor.Run(ctx, "acctaudit", common.WrapWithScissors(acct.audit, "acctaudit")); err != nil {
 			return fmt.Errorf("failed to start audit worker: %w", err)
 		}
+
+		if err := supervisor.Run(ctx, "acctgsmonitor", common.WrapWithScissors(acct.guardianSetMonitor, "acctgsmonitor")); err != nil {
+			return fmt.Errorf("failed to start guardian set monitor: %w", err)
+		}
+
+		if acct.shadow != nil {
+			if err := supervisor.Run(ctx, "acctshadow", common.WrapWithScissors(acct.shadowWorker, "acctshadow")); err != nil {
+				return fmt.Errorf("failed to start shadow worker: %w", err)
+			}
+		}
 	}
 
 	return nil
 }
 
 func (acct *Accountant) Close() {
+	if acct.shadow != nil && acct.shadow.wormchainConn != nil {
+		acct.shadow.wormchainConn.Close()
+		acct.shadow.wormchainConn = nil
+	}
+
 	if acct.wormchainConn != nil {
 		acct.wormchainConn.Close()
 		acct.wormchainConn = nil
@@ -212,6 +245,11 @@ func (acct *Accountant) FeatureString() string {
 	return "acct:enforced"
 }
 
+// IsEnforcing returns true if the accountant blocks transfers rather than just logging them.
+func (acct *Accountant) IsEnforcing() bool {
+	return acct.enforceFlag
+}
+
 // IsMessageCoveredByAccountant returns `true` if a message should be processed by the Global Accountant, `false` if not.
 func (acct *Accountant) IsMessageCoveredByAccountant(msg *common.MessagePublication) bool {
 	msgId := msg.MessageIDString()
@@ -246,7 +284,11 @@ func (acct *Accountant) SubmitObservation(msg *common.MessagePublication) (bool,
 		return true, nil
 	}
 
-	digest := msg.CreateDigest()
+	digest, version, err := acct.messageDigest(msg)
+	if err != nil {
+		acct.logger.Error("failed to compute digest, blocking publishing", zap.String("msgID", msgId), zap.Error(err))
+		return false, err
+	}
 
 	acct.pendingTransfersLock.Lock()
 	defer acct.pendingTransfersLock.Unlock()
@@ -268,7 +310,7 @@ func (acct *Accountant) SubmitObservation(msg *common.MessagePublication) (bool,
 	}
 
 	// Add it to the pending map and the database.
-	pe := &pendingEntry{msg: msg, msgId: msgId, digest: digest}
+	pe := &pendingEntry{msg: msg, msgId: msgId, digest: digest, digestVersion: version}
 	if err := acct.addPendingTransferAlreadyLocked(pe); err != nil {
 		acct.logger.Error("failed to persist pending transfer, blocking publishing", zap.String("msgID", msgId), zap.Error(err))
 		return false, err
@@ -343,8 +385,13 @@ func (acct *Accountant) loadPendingTransfers() error {
 		msgId := msg.MessageIDString()
 		acct.logger.Info("reloaded pending transfer", zap.String("msgID", msgId))
 
-		digest := msg.CreateDigest()
-		pe := &pendingEntry{msg: msg, msgId: msgId, digest: digest}
+		digest, version, err := acct.messageDigest(msg)
+		if err != nil {
+			// The emitter is no longer monitored. The transfer was submitted before digests were versioned.
+			acct.logger.Warn("failed to select the digest version of reloaded pending transfer, using the VAA digest", zap.String("msgID", msgId), zap.Error(err))
+			digest, version = msg.CreateDigest(), digestVersionVAA
+		}
+		pe := &pendingEntry{msg: msg, msgId: msgId, digest: digest, digestVersion: version}
 		pe.setUpdTime()
 		acct.pendingTransfers[msgId] = pe
 	}
@@ -361,7 +408,7 @@ func (acct *Accountant) loadPendingTransfers() error {
 
 // submitObservation sends an observation request to the worker so it can be submited to the contract.  If the transfer is already
 // marked as "submit pending", this function returns false without doing anything. Otherwise it returns true. The return value can
-// be used to avoid unnecessary error logging. If writing to the channel would block, this function returns without doing anything,
+// be used to avoid unnecessary error logging. If writing to the channel would block, this function drops the request and returns false,
 // assuming the pending transfer will be handled on the next audit interval. This function grabs the state lock.
 func (acct *Accountant) submitObservation(pe *pendingEntry) bool {
 	pe.stateLock.Lock()
@@ -379,7 +426,9 @@ func (acct *Accountant) submitObservation(pe *pendingEntry) bool {
 		acct.logger.Debug("submitted observation to channel", zap.String("msgId", pe.msgId))
 	default:
 		acct.logger.Error("unable to submit observation because the channel is full, will try next interval", zap.String("msgId", pe.msgId))
+		submissionsDropped.Inc()
 		pe.state.submitPending = false
+		return false
 	}
 
 	return true
@@ -425,3 +474,19 @@ func (pe *pendingEntry) updTime() time.Time {
 	defer pe.stateLock.Unlock()
 	return pe.state.updTime
 }
+
+// setSubmittedGuardianSetIndex records the index of the guardian set used to sign the last submitted observation. It grabs the state lock.
+func (pe *pendingEntry) setSubmittedGuardianSetIndex(gsIndex uint32) {
+	pe.stateLock.Lock()
+	defer pe.stateLock.Unlock()
+	pe.state.submittedGsIndex = gsIndex
+	pe.state.submitted = true
+}
+
+// submittedGuardianSetIndex returns the index of the guardian set used to sign the last submitted observation, and false if no observation
+// has been submitted since the transfer was added or reloaded. It grabs the state lock.
+func (pe *pendingEntry) submittedGuardianSetIndex() (uint32, bool) {
+	pe.stateLock.Lock()
+	defer pe.stateLock.Unlock()
+	return pe.state.submittedGsIndex, pe.state.submitted
+}
@@ -21,7 +21,6 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/devnet"
-	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -94,7 +93,7 @@ func newAccountantForTest(
 	logger *zap.Logger,
 	ctx context.Context,
 	accountantCheckEnabled bool,
-	obsvReqWriteC chan<- *gossipv1.ObservationRequest,
+	obsvReqWriteC chan<- *common.InboundObservationRequest,
 	acctWriteC chan<- *common.MessagePublication,
 	wormchainConn *MockAccountantWormchainConn,
 ) *Accountant {
@@ -173,7 +172,7 @@ func buildMockTransferPayloadBytes(
 func TestVaaFromUninterestingEmitter(t *testing.T) {
 	ctx := context.Background()
 	logger := zap.NewNop()
-	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
 	acctChan := make(chan *common.MessagePublication, 10)
 	acct := newAccountantForTest(t, logger, ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
 	require.NotNil(t, acct)
@@ -201,7 +200,7 @@ func TestVaaFromUninterestingEmitter(t *testing.T) {
 func TestVaaForUninterestingPayloadType(t *testing.T) {
 	ctx := context.Background()
 	logger := zap.NewNop()
-	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
 	acctChan := make(chan *common.MessagePublication, 10)
 	acct := newAccountantForTest(t, logger, ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
 	require.NotNil(t, acct)
@@ -229,7 +228,7 @@ func TestVaaForUninterestingPayloadType(t *testing.T) {
 func TestInterestingTransferShouldNotBeBlockedWhenNotEnforcingAccountant(t *testing.T) {
 	ctx := context.Background()
 	logger := zap.NewNop()
-	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
 	acctChan := make(chan *common.MessagePublication, 10)
 	acct := newAccountantForTest(t, logger, ctx, dontEnforceAccountant, obsvReqWriteC, acctChan, nil)
 	require.NotNil(t, acct)
@@ -273,7 +272,7 @@ func TestInterestingTransferShouldNotBeBlockedWhenNotEnforcingAccountant(t *test
 func TestInterestingTransferShouldBeBlockedWhenEnforcingAccountant(t *testing.T) {
 	ctx := context.Background()
 	logger := zap.NewNop()
-	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
 	acctChan := make(chan *common.MessagePublication, 10)
 	acct := newAccountantForTest(t, logger, ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
 	require.NotNil(t, acct)
@@ -324,7 +323,7 @@ func TestInterestingTransferShouldBeBlockedWhenEnforcingAccountant(t *testing.T)
 func TestForDeadlock(t *testing.T) {
 	ctx := context.Background()
 	logger, _ := zap.NewDevelopment()
-	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
 	acctChan := make(chan *common.MessagePublication, MsgChannelCapacity)
 	wormchainConn := MockAccountantWormchainConn{}
 	acct := newAccountantForTest(t, logger, ctx, enforceAccountant, obsvReqWriteC, acctChan, &wormchainConn)
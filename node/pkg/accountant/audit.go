@@ -8,9 +8,12 @@
 //
 // The second phase consists of requesting the status from the contract for everything that is still in the temporary map. For each returned item, we do the following:
 // - If the contract indicates that the transfer has been committed, we validate the digest, then publish the transfer and delete it from the map.
-// - If the contract indicates that the transfer is pending, we continue to wait for it to be committed.
+// - If the contract indicates that the transfer is pending, we continue to wait for it to be committed, unless all the observations of our digest
+//   were signed by an old guardian set, in which case we resubmit an observation to the contract (see guardian_set.go).
 // - If the contract indicates any other status (most likely meaning it does not know about it), we resubmit an observation to the contract.
 //
+// Finally, the audit brings the local history of the balance modifications up to date with the contract (see modifications.go).
+//
 // Note that any time we are considering resubmitting an observation to the contract, we first check the "submit pending" flag. If that is set, we do not
 // submit the observation to the contract, but continue to wait for it to work its way through the queue.
 
@@ -24,6 +27,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
@@ -116,6 +120,8 @@ func (acct *Accountant) audit(ctx context.Context) error {
 	ticker := time.NewTicker(auditInterval)
 	defer ticker.Stop()
 
+	acct.syncModificationsForAudit()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -131,6 +137,7 @@ func (acct *Accountant) runAudit() {
 	tmpMap := acct.createAuditMap()
 	acct.logger.Debug("in AuditPendingTransfers: starting audit", zap.Int("numPending", len(tmpMap)))
 	acct.performAudit(tmpMap)
+	acct.syncModificationsForAudit()
 	acct.logger.Debug("leaving AuditPendingTransfers")
 }
 
@@ -181,7 +188,7 @@ func (acct *Accountant) performAudit(tmpMap map[string]*pendingEntry) {
 				auditErrors.Inc()
 				acct.logger.Error("contract reported pending observation as missing, resubmitted it", zap.String("msgID", pe.msgId))
 			} else {
-				acct.logger.Info("contract reported pending observation as missing but it is queued up to be submitted, skipping it", zap.String("msgID", pe.msgId))
+				acct.logger.Info("contract reported pending observation as missing but it is already queued up to be submitted or could not be queued, skipping it", zap.String("msgID", pe.msgId))
 			}
 
 			delete(tmpMap, key)
@@ -237,7 +244,14 @@ func (acct *Accountant) performAudit(tmpMap map[string]*pendingEntry) {
 					acct.deletePendingTransfer(pe.msgId)
 				}
 			} else if status.Pending != nil {
-				acct.logger.Debug("contract says transfer is still pending", zap.String("msgId", pe.msgId))
+				if gs := acct.gst.Get(); gs != nil && isPendingUnderOldGuardianSet(*status.Pending, pe.digest, gs.Index) {
+					if acct.submitObservation(pe) {
+						guardianSetResubmissions.Inc()
+						acct.logger.Warn("contract only has observations of pending transfer signed by an old guardian set, resubmitted it", zap.String("msgId", pe.msgId), zap.Uint32("gsIndex", gs.Index))
+					}
+				} else {
+					acct.logger.Debug("contract says transfer is still pending", zap.String("msgId", pe.msgId))
+				}
 			} else {
 				// This is the case when the contract does not know about a transfer. Resubmit it.
 				if acct.submitObservation(pe) {
@@ -259,7 +273,10 @@ func (acct *Accountant) performAudit(tmpMap map[string]*pendingEntry) {
 // handleMissingObservation submits a local reobservation request. It relies on the reobservation code to throttle requests.
 func (acct *Accountant) handleMissingObservation(mo MissingObservation) {
 	acct.logger.Warn("contract reported unknown observation as missing, requesting local reobservation", zap.Stringer("moKey", mo))
-	msg := &gossipv1.ObservationRequest{ChainId: uint32(mo.ChainId), TxHash: mo.TxHash}
+	msg := &common.InboundObservationRequest{
+		Request: &gossipv1.ObservationRequest{ChainId: uint32(mo.ChainId), TxHash: mo.TxHash},
+		Source:  common.ObservationRequestSourceAccountant,
+	}
 
 	select {
 	case acct.obsvReqWriteC <- msg:
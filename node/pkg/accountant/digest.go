@@ -0,0 +1,88 @@
+package accountant
+
+// The accountant contract identifies the observations of a message by their digest: observations of the same message
+// with different digests are never counted together, and the digest of a committed transfer is compared with ours
+// before the transfer is published. The digest of token bridge transfers is the VAA signing digest, but the emitters of
+// other protocols, such as the transceivers of the NTT framework, may be accounted under different digest rules. So the
+// digest of a message is computed by a versioned digest function, selected by the type of its emitter through
+// emitterTypeDigestVersions. A pending transfer keeps the version its digest was computed with, so that the digests
+// reported by the contract are checked under the same rules.
+
+import (
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+)
+
+// emitterType is the type of an emitter covered by the accountant, which determines how its messages are accounted.
+type emitterType uint8
+
+const (
+	emitterTypeUnknown emitterType = iota
+	emitterTypeTokenBridge
+)
+
+func (et emitterType) String() string {
+	switch et {
+	case emitterTypeTokenBridge:
+		return "token_bridge"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(et))
+	}
+}
+
+// digestVersion identifies the rules used to compute the digest of an observation.
+type digestVersion uint8
+
+const (
+	// digestVersionVAA is the VAA signing digest, i.e. the double keccak256 of the VAA body.
+	digestVersionVAA digestVersion = 1
+)
+
+// digestFuncs holds the digest function of each version. Existing versions must never change, since their digests are
+// stored by the contract.
+var digestFuncs = map[digestVersion]func(msg *common.MessagePublication) string{
+	digestVersionVAA: (*common.MessagePublication).CreateDigest,
+}
+
+// emitterTypeDigestVersions is the registry of the digest version used by each emitter type.
+var emitterTypeDigestVersions = map[emitterType]digestVersion{
+	emitterTypeTokenBridge: digestVersionVAA,
+}
+
+// digestVersionForEmitterType returns the digest version of the messages of an emitter type.
+func digestVersionForEmitterType(et emitterType) (digestVersion, error) {
+	version, exists := emitterTypeDigestVersions[et]
+	if !exists {
+		return 0, fmt.Errorf("no digest version registered for emitter type %v", et)
+	}
+	return version, nil
+}
+
+// computeDigest returns the hex encoded digest of a message under the given digest version.
+func computeDigest(version digestVersion, msg *common.MessagePublication) (string, error) {
+	f, exists := digestFuncs[version]
+	if !exists {
+		return "", fmt.Errorf("unsupported digest version %d", version)
+	}
+	return f(msg), nil
+}
+
+// messageDigest returns the digest of a message under the digest version of its emitter, along with the version.
+func (acct *Accountant) messageDigest(msg *common.MessagePublication) (string, digestVersion, error) {
+	et := emitterTypeUnknown
+	if tbe, exists := acct.tokenBridges[tokenBridgeKey{emitterChainId: msg.EmitterChain, emitterAddr: msg.EmitterAddress}]; exists {
+		et = tbe.emitterType
+	}
+
+	version, err := digestVersionForEmitterType(et)
+	if err != nil {
+		return "", 0, err
+	}
+
+	digest, err := computeDigest(version, msg)
+	if err != nil {
+		return "", 0, err
+	}
+	return digest, version, nil
+}
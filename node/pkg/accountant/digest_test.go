@@ -0,0 +1,69 @@
+package accountant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestEmitterTypeDigestVersions(t *testing.T) {
+	for _, et := range []emitterType{emitterTypeTokenBridge} {
+		version, err := digestVersionForEmitterType(et)
+		require.NoError(t, err, et.String())
+		_, exists := digestFuncs[version]
+		assert.True(t, exists, "emitter type %v uses an unknown digest version %d", et, version)
+	}
+
+	_, err := digestVersionForEmitterType(emitterTypeUnknown)
+	assert.Error(t, err)
+}
+
+func TestComputeDigest(t *testing.T) {
+	msg := &common.MessagePublication{
+		Timestamp:      time.Unix(1654543099, 0),
+		Sequence:       1,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: vaa.Address{1},
+		Payload:        []byte{1},
+	}
+
+	digest, err := computeDigest(digestVersionVAA, msg)
+	require.NoError(t, err)
+	assert.Equal(t, msg.CreateDigest(), digest)
+
+	_, err = computeDigest(digestVersion(0), msg)
+	assert.Error(t, err)
+}
+
+func TestSubmitObservationRecordsDigestVersion(t *testing.T) {
+	ctx := context.Background()
+	acct := newAccountantForTest(t, zap.NewNop(), ctx, true, make(chan *common.InboundObservationRequest, 10), make(chan *common.MessagePublication, 10), nil)
+
+	emitterAddr, err := vaa.StringToAddress("0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+	msg := &common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddr,
+		ConsistencyLevel: uint8(32),
+		Payload:          buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8", vaa.ChainIDPolygon, "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8", 1.25),
+	}
+
+	shouldPublish, err := acct.SubmitObservation(msg)
+	require.NoError(t, err)
+	assert.False(t, shouldPublish)
+
+	pe, exists := acct.pendingTransfers[msg.MessageIDString()]
+	require.True(t, exists)
+	assert.Equal(t, digestVersionVAA, pe.digestVersion)
+	assert.Equal(t, msg.CreateDigest(), pe.digest)
+}
@@ -0,0 +1,108 @@
+// This code resubmits the observations of pending transfers after a guardian set change. The accountant contract only counts observations
+// signed by the current guardian set towards quorum, so an observation submitted with an older guardian set no longer helps a transfer to
+// get committed. Unless every guardian resubmits it, the transfer would be stuck in the pending state.
+//
+// The monitor runnable periodically checks the guardian set index. When it changes, every pending transfer last submitted with a different
+// guardian set is resubmitted. The audit also resubmits any transfer the contract reports as pending under an older guardian set, which covers
+// transfers reloaded from the database on startup and resubmissions that were dropped because the channel was full.
+
+package accountant
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+
+	"go.uber.org/zap"
+)
+
+// guardianSetCheckInterval indicates how often the guardian set monitor checks for a guardian set change.
+const guardianSetCheckInterval = time.Minute
+
+// guardianSetMonitor is the runnable that resubmits the pending observations when the guardian set changes.
+func (acct *Accountant) guardianSetMonitor(ctx context.Context) error {
+	ticker := time.NewTicker(guardianSetCheckInterval)
+	defer ticker.Stop()
+
+	var gsIndex uint32
+	known := false
+	if gs := acct.gst.Get(); gs != nil {
+		gsIndex = gs.Index
+		known = true
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			gs := acct.gst.Get()
+			if gs == nil || (known && gs.Index == gsIndex) {
+				continue
+			}
+
+			if known {
+				acct.logger.Info("guardian set changed, resubmitting pending observations", zap.Uint32("oldIndex", gsIndex), zap.Uint32("newIndex", gs.Index))
+				numResubmitted := acct.resubmitForGuardianSet(gs.Index)
+				acct.logger.Info("resubmitted pending observations for the new guardian set", zap.Uint32("gsIndex", gs.Index), zap.Int("numResubmitted", numResubmitted))
+			}
+
+			gsIndex = gs.Index
+			known = true
+		}
+	}
+}
+
+// resubmitForGuardianSet resubmits the observations of all pending transfers that were last submitted with a guardian set other than gsIndex.
+// Transfers that are queued up to be submitted are skipped, since they will be signed by the current guardian set. It returns the number of
+// resubmitted observations. It grabs the pending transfer lock.
+func (acct *Accountant) resubmitForGuardianSet(gsIndex uint32) int {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+
+	numResubmitted := 0
+	for _, pe := range acct.pendingTransfers {
+		submittedIndex, submitted := pe.submittedGuardianSetIndex()
+		if !submitted || submittedIndex == gsIndex {
+			continue
+		}
+
+		if acct.submitObservation(pe) {
+			guardianSetResubmissions.Inc()
+			numResubmitted++
+			acct.logger.Info("resubmitted observation signed by an old guardian set", zap.String("msgId", pe.msgId), zap.Uint32("oldIndex", submittedIndex), zap.Uint32("newIndex", gsIndex))
+		}
+	}
+
+	return numResubmitted
+}
+
+// setSubmittedGuardianSetIndex records the index of the guardian set used to sign the observations of a batch. It grabs the pending transfer lock.
+func (acct *Accountant) setSubmittedGuardianSetIndex(msgs []*common.MessagePublication, gsIndex uint32) {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+	for _, msg := range msgs {
+		if pe, exists := acct.pendingTransfers[msg.MessageIDString()]; exists {
+			pe.setSubmittedGuardianSetIndex(gsIndex)
+		}
+	}
+}
+
+// isPendingUnderOldGuardianSet returns true if the contract only holds observations of our digest signed by a guardian set other than gsIndex.
+// Observations of other digests are ignored, since they are not ours to resubmit.
+func isPendingUnderOldGuardianSet(pending []TransferStatusPending, digest string, gsIndex uint32) bool {
+	found := false
+	for _, p := range pending {
+		if hex.EncodeToString(p.Digest) != digest {
+			continue
+		}
+		if p.GuardianSetIndex == gsIndex {
+			return false
+		}
+		found = true
+	}
+
+	return found
+}
@@ -0,0 +1,109 @@
+package accountant
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// addPendingTransferForTest adds a pending transfer with the given sequence number to the map, without submitting it.
+func addPendingTransferForTest(t *testing.T, acct *Accountant, sequence uint64) *pendingEntry {
+	t.Helper()
+	emitterAddr, err := vaa.StringToAddress("0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	msg := &common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         sequence,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddr,
+		ConsistencyLevel: uint8(32),
+	}
+
+	pe := &pendingEntry{msg: msg, msgId: msg.MessageIDString(), digest: msg.CreateDigest(), digestVersion: digestVersionVAA}
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+	require.NoError(t, acct.addPendingTransferAlreadyLocked(pe))
+	return pe
+}
+
+func TestResubmitForGuardianSet(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, logger, ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+
+	submittedWithOldSet := addPendingTransferForTest(t, acct, 1)
+	submittedWithNewSet := addPendingTransferForTest(t, acct, 2)
+	neverSubmitted := addPendingTransferForTest(t, acct, 3)
+	queuedUp := addPendingTransferForTest(t, acct, 4)
+
+	acct.setSubmittedGuardianSetIndex([]*common.MessagePublication{submittedWithOldSet.msg, queuedUp.msg}, 0)
+	acct.setSubmittedGuardianSetIndex([]*common.MessagePublication{submittedWithNewSet.msg}, 1)
+	queuedUp.setSubmitPending(true)
+
+	assert.Equal(t, 1, acct.resubmitForGuardianSet(1))
+	require.Equal(t, 1, len(acct.subChan))
+	assert.Equal(t, submittedWithOldSet.msg, <-acct.subChan)
+	assert.True(t, submittedWithOldSet.submitPending())
+	assert.False(t, neverSubmitted.submitPending())
+
+	// Resubmitting does not change the recorded index until the batch is actually submitted.
+	assert.Equal(t, 0, acct.resubmitForGuardianSet(1))
+	submittedWithOldSet.setSubmitPending(false)
+	assert.Equal(t, 1, acct.resubmitForGuardianSet(1))
+}
+
+func TestResubmitForGuardianSetChannelFull(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, logger, ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+
+	pe := addPendingTransferForTest(t, acct, 1)
+	acct.setSubmittedGuardianSetIndex([]*common.MessagePublication{pe.msg}, 0)
+	for len(acct.subChan) < cap(acct.subChan) {
+		acct.subChan <- pe.msg
+	}
+
+	resubmissions := testutil.ToFloat64(guardianSetResubmissions)
+	dropped := testutil.ToFloat64(submissionsDropped)
+
+	// A dropped observation is not counted as resubmitted, and is retried later.
+	assert.Equal(t, 0, acct.resubmitForGuardianSet(1))
+	assert.Equal(t, resubmissions, testutil.ToFloat64(guardianSetResubmissions))
+	assert.Equal(t, dropped+1, testutil.ToFloat64(submissionsDropped))
+	assert.False(t, pe.submitPending())
+}
+
+func TestIsPendingUnderOldGuardianSet(t *testing.T) {
+	ourDigest, err := hex.DecodeString("eb986600de086d6f4c067483cd89a0a03ff7cdef85f2293d34678a47fbd0e09e")
+	require.NoError(t, err)
+	otherDigest, err := hex.DecodeString("0a31f1f331319ebe0953c7b002ee7fb5733a6b9432b252ae7c61d9352af4684f")
+	require.NoError(t, err)
+	digest := hex.EncodeToString(ourDigest)
+
+	assert.False(t, isPendingUnderOldGuardianSet([]TransferStatusPending{}, digest, 1))
+	assert.False(t, isPendingUnderOldGuardianSet([]TransferStatusPending{{Digest: ourDigest, GuardianSetIndex: 1}}, digest, 1))
+	assert.True(t, isPendingUnderOldGuardianSet([]TransferStatusPending{{Digest: ourDigest, GuardianSetIndex: 0}}, digest, 1))
+
+	// Observations of other digests do not matter.
+	assert.False(t, isPendingUnderOldGuardianSet([]TransferStatusPending{{Digest: otherDigest, GuardianSetIndex: 0}}, digest, 1))
+	assert.True(t, isPendingUnderOldGuardianSet([]TransferStatusPending{{Digest: otherDigest, GuardianSetIndex: 1}, {Digest: ourDigest, GuardianSetIndex: 0}}, digest, 1))
+	assert.False(t, isPendingUnderOldGuardianSet([]TransferStatusPending{{Digest: ourDigest, GuardianSetIndex: 0}, {Digest: ourDigest, GuardianSetIndex: 1}}, digest, 1))
+}
@@ -47,14 +47,24 @@ var (
 			Name: "global_accountant_total_digest_mismatches",
 			Help: "Total number of digest mismatches on accountant",
 		})
-	connectionErrors = promauto.NewCounter(
-		prometheus.CounterOpts{
-			Name: "global_accountant_connection_errors_total",
-			Help: "Total number of connection errors on accountant",
-		})
 	auditErrors = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "global_accountant_audit_errors_total",
 			Help: "Total number of audit errors detected by accountant",
 		})
+	guardianSetResubmissions = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_guardian_set_resubmissions_total",
+			Help: "Total number of accountant observations resubmitted because they were signed by an old guardian set",
+		})
+	submissionsDropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_submissions_dropped_total",
+			Help: "Total number of accountant observations dropped because the submission channel was full",
+		})
+	modificationsReceived = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_balance_modifications_total",
+			Help: "Total number of balance modifications made by governance on the accountant contract learned by this guardian",
+		})
 )
@@ -0,0 +1,135 @@
+// This code mirrors the balance modifications made on the accountant contract by "modify balance" governance VAAs, which are used to
+// correct the balances of the contract manually. The modifications are learned from the modification events emitted by the contract
+// and, to catch up on events missed while the watcher was not connected, from the "all_modifications" query, which is run when the
+// audit starts and on every audit. The balances themselves are only tracked by the contract, so the modifications are kept for
+// reporting through the admin server.
+
+package accountant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+
+	"go.uber.org/zap"
+)
+
+// maxModificationsPerQuery is the maximum number of modifications requested in a single all_modifications query.
+const maxModificationsPerQuery = 100
+
+type (
+	// Modification is a balance modification made on the contract, as returned by the "all_modifications" query.
+	Modification struct {
+		// Sequence uniquely identifies the modification.
+		Sequence uint64 `json:"sequence"`
+		// ChainId is the chain of the modified account.
+		ChainId uint16 `json:"chain_id"`
+		// TokenChain and TokenAddress identify the token of the modified account on its native chain.
+		TokenChain   uint16      `json:"token_chain"`
+		TokenAddress vaa.Address `json:"token_address"`
+		// Kind is "add" or "sub".
+		Kind   string         `json:"kind"`
+		Amount *cosmossdk.Int `json:"amount"`
+		// Reason is a human-readable reason for the modification.
+		Reason string `json:"reason"`
+	}
+
+	// AllModificationsResponse is the result returned from the "all_modifications" query.
+	AllModificationsResponse struct {
+		Modifications []Modification `json:"modifications"`
+	}
+)
+
+// recordModification adds a modification to the local history, if it is not known yet. It grabs the lock.
+func (acct *Accountant) recordModification(m *Modification, source string) {
+	acct.modificationsLock.Lock()
+	defer acct.modificationsLock.Unlock()
+
+	if _, exists := acct.modifications[m.Sequence]; exists {
+		return
+	}
+
+	acct.modifications[m.Sequence] = m
+	modificationsReceived.Inc()
+	acct.logger.Info("acctwatch: balance modification detected",
+		zap.String("source", source),
+		zap.Uint64("sequence", m.Sequence),
+		zap.Stringer("chainId", vaa.ChainID(m.ChainId)),
+		zap.Stringer("tokenChain", vaa.ChainID(m.TokenChain)),
+		zap.Stringer("tokenAddress", m.TokenAddress),
+		zap.String("kind", m.Kind),
+		zap.Stringer("amount", m.Amount),
+		zap.String("reason", m.Reason),
+	)
+}
+
+// Modifications returns the balance modifications known locally, sorted by sequence.
+func (acct *Accountant) Modifications() []*Modification {
+	acct.modificationsLock.Lock()
+	defer acct.modificationsLock.Unlock()
+
+	mods := make([]*Modification, 0, len(acct.modifications))
+	for _, m := range acct.modifications {
+		mods = append(mods, m)
+	}
+	sort.Slice(mods, func(i, j int) bool { return mods[i].Sequence < mods[j].Sequence })
+	return mods
+}
+
+// SyncModifications queries the contract for all the balance modifications and adds the ones missing from the local history.
+func (acct *Accountant) SyncModifications() error {
+	mods, err := queryAllModificationsWithConn(acct.ctx, acct.logger, acct.wormchainConn, acct.contract)
+	if err != nil {
+		return err
+	}
+
+	for i := range mods {
+		acct.recordModification(&mods[i], "query")
+	}
+	return nil
+}
+
+// syncModificationsForAudit calls SyncModifications on behalf of the audit, which only logs failures.
+func (acct *Accountant) syncModificationsForAudit() {
+	if err := acct.SyncModifications(); err != nil {
+		auditErrors.Inc()
+		acct.logger.Error("failed to sync balance modifications", zap.Error(err))
+	}
+}
+
+// queryAllModificationsWithConn returns all the balance modifications made on the contract, querying them in chunks.
+func queryAllModificationsWithConn(
+	ctx context.Context,
+	logger *zap.Logger,
+	qc queryConn,
+	contract string,
+) ([]Modification, error) {
+	var mods []Modification
+	for {
+		query := fmt.Sprintf(`{"all_modifications":{"limit":%d}}`, maxModificationsPerQuery)
+		if len(mods) != 0 {
+			query = fmt.Sprintf(`{"all_modifications":{"start_after":%d,"limit":%d}}`, mods[len(mods)-1].Sequence, maxModificationsPerQuery)
+		}
+
+		logger.Debug("submitting all_modifications query", zap.String("query", query))
+		respBytes, err := qc.SubmitQuery(ctx, contract, []byte(query))
+		if err != nil {
+			return nil, fmt.Errorf("all_modifications query failed: %w, %s", err, query)
+		}
+
+		var resp AllModificationsResponse
+		if err := json.Unmarshal(respBytes, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse all_modifications response: %w, resp: %s", err, string(respBytes))
+		}
+
+		mods = append(mods, resp.Modifications...)
+		if len(resp.Modifications) < maxModificationsPerQuery {
+			return mods, nil
+		}
+	}
+}
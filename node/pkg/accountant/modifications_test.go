@@ -0,0 +1,128 @@
+package accountant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/certusone/wormhole/node/pkg/wormconn"
+
+	"go.uber.org/zap"
+)
+
+func TestParseWasmModification(t *testing.T) {
+	logger := zap.NewNop()
+
+	// The attributes are JSON encoded by the contract.
+	event := wormconn.ContractEvent{
+		Type: "wasm-Modification",
+		Attributes: []wormconn.TendermintEventAttribute{
+			{Key: "sequence", Value: "3"},
+			{Key: "chain_id", Value: "2"},
+			{Key: "token_chain", Value: "1"},
+			{Key: "token_address", Value: `"069b8857feab8184fb687f634618c035dac439dc1aeb3b5598a0f00000000001"`},
+			{Key: "kind", Value: `"sub"`},
+			{Key: "amount", Value: `"1000000000"`},
+			{Key: "reason", Value: `"rollback of bad transfer"`},
+		},
+	}
+
+	evt, err := parseEvent[WasmModification](logger, event)
+	require.NoError(t, err)
+	require.NotNil(t, evt)
+
+	expectedTokenAddress, err := vaa.StringToAddress("069b8857feab8184fb687f634618c035dac439dc1aeb3b5598a0f00000000001")
+	require.NoError(t, err)
+	expectedAmount := cosmossdk.NewInt(1000000000)
+
+	assert.Equal(t, WasmModification{
+		Sequence:     3,
+		ChainId:      uint16(vaa.ChainIDEthereum),
+		TokenChain:   uint16(vaa.ChainIDSolana),
+		TokenAddress: expectedTokenAddress,
+		Kind:         "sub",
+		Amount:       &expectedAmount,
+		Reason:       "rollback of bad transfer",
+	}, *evt)
+}
+
+// AllModificationsQueryConnMock allows us to mock all_modifications by implementing SubmitQuery. It serves numModifications
+// modifications, with sequences starting at zero.
+type AllModificationsQueryConnMock struct {
+	numModifications int
+	queries          []string
+}
+
+func (qc *AllModificationsQueryConnMock) SubmitQuery(ctx context.Context, contractAddress string, query []byte) ([]byte, error) {
+	qc.queries = append(qc.queries, string(query))
+
+	var req struct {
+		AllModifications struct {
+			StartAfter *uint64 `json:"start_after"`
+			Limit      int     `json:"limit"`
+		} `json:"all_modifications"`
+	}
+	if err := json.Unmarshal(query, &req); err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if req.AllModifications.StartAfter != nil {
+		start = int(*req.AllModifications.StartAfter) + 1
+	}
+
+	resp := AllModificationsResponse{Modifications: []Modification{}}
+	for seq := start; seq < qc.numModifications && len(resp.Modifications) < req.AllModifications.Limit; seq++ {
+		amount := cosmossdk.NewInt(int64(seq + 1))
+		resp.Modifications = append(resp.Modifications, Modification{
+			Sequence: uint64(seq),
+			ChainId:  uint16(vaa.ChainIDEthereum),
+			Kind:     "add",
+			Amount:   &amount,
+			Reason:   fmt.Sprintf("modification %d", seq),
+		})
+	}
+	return json.Marshal(resp)
+}
+
+func TestQueryAllModifications(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	for _, numModifications := range []int{0, 1, maxModificationsPerQuery, 2*maxModificationsPerQuery + 1} {
+		qc := &AllModificationsQueryConnMock{numModifications: numModifications}
+		mods, err := queryAllModificationsWithConn(ctx, logger, qc, "wormhole14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9srrg465")
+		require.NoError(t, err)
+		require.Len(t, mods, numModifications)
+		for i, m := range mods {
+			assert.Equal(t, uint64(i), m.Sequence)
+		}
+		assert.Len(t, qc.queries, numModifications/maxModificationsPerQuery+1)
+	}
+}
+
+func TestRecordModification(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	acct := newAccountantForTest(t, logger, ctx, false, nil, nil, nil)
+
+	amount := cosmossdk.NewInt(42)
+	acct.recordModification(&Modification{Sequence: 7, Kind: "add", Amount: &amount, Reason: "second"}, "event")
+	acct.recordModification(&Modification{Sequence: 2, Kind: "sub", Amount: &amount, Reason: "first"}, "query")
+
+	// A modification seen both as an event and in a query is only recorded once.
+	acct.recordModification(&Modification{Sequence: 7, Kind: "add", Amount: &amount, Reason: "second"}, "query")
+
+	mods := acct.Modifications()
+	require.Len(t, mods, 2)
+	assert.Equal(t, uint64(2), mods[0].Sequence)
+	assert.Equal(t, uint64(7), mods[1].Sequence)
+}
@@ -0,0 +1,127 @@
+package accountant
+
+import (
+	"context"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// The shadow accountant is an optional second accountant contract, possibly on a different wormchain network, that
+// receives a copy of every batch of observations submitted to the accountant contract. It is used to roll out new
+// versions of the contract with production traffic. Submissions to the shadow contract are fire and forget: their
+// results are only logged and counted, and never affect whether a transfer gets published.
+
+// shadowChanSize is the number of batches that may be waiting to be submitted to the shadow contract. If the shadow
+// contract falls behind, further batches are dropped rather than slowing down the accountant.
+const shadowChanSize = 50
+
+var (
+	shadowBatchesDropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_shadow_batches_dropped_total",
+			Help: "Total number of observation batches not submitted to the shadow accountant contract because it fell behind",
+		})
+	shadowSubmitFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "global_accountant_shadow_submit_failures_total",
+			Help: "Total number of observations that could not be submitted to the shadow accountant contract",
+		})
+	shadowObservationResponses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "global_accountant_shadow_observation_responses_total",
+			Help: "Total number of observation responses from the shadow accountant contract by status",
+		}, []string{"status"})
+)
+
+type (
+	// shadowAccountant holds the connection to the shadow accountant contract.
+	shadowAccountant struct {
+		contract      string
+		wormchainConn AccountantWormchainConn
+		subChan       chan *shadowBatch
+	}
+
+	// shadowBatch is a batch of observations submitted to the accountant contract, to be submitted to the shadow contract as well.
+	shadowBatch struct {
+		msgs          []*common.MessagePublication
+		gsIndex       uint32
+		guardianIndex uint32
+	}
+)
+
+// EnableShadowContract configures a shadow accountant contract that gets a copy of all observations submitted to the
+// accountant contract. The connection must not be the one of the accountant, nor use the same key on the same network,
+// so that the two never contend for the connection or the sequence number of the account. The accountant takes
+// ownership of the connection and closes it in Close. It must be called before Start.
+func (acct *Accountant) EnableShadowContract(contract string, wormchainConn AccountantWormchainConn) {
+	acct.shadow = &shadowAccountant{
+		contract:      contract,
+		wormchainConn: wormchainConn,
+		subChan:       make(chan *shadowBatch, shadowChanSize),
+	}
+	acct.logger.Info("will submit observations to the shadow contract", zap.String("shadowContract", contract))
+}
+
+// submitToShadow passes a batch that was submitted to the accountant contract to the shadow worker. It never blocks.
+func (acct *Accountant) submitToShadow(msgs []*common.MessagePublication, gsIndex uint32, guardianIndex uint32) {
+	if acct.shadow == nil {
+		return
+	}
+
+	select {
+	case acct.shadow.subChan <- &shadowBatch{msgs: msgs, gsIndex: gsIndex, guardianIndex: guardianIndex}:
+	default:
+		acct.logger.Warn("unable to submit observations to the shadow contract because the channel is full, dropping them", zap.Int("numMsgs", len(msgs)))
+		shadowBatchesDropped.Inc()
+	}
+}
+
+// shadowWorker submits the batches passed to submitToShadow to the shadow contract.
+func (acct *Accountant) shadowWorker(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case batch := <-acct.shadow.subChan:
+			acct.submitObservationsToShadowContract(ctx, batch)
+		}
+	}
+}
+
+// submitObservationsToShadowContract submits a batch of observations to the shadow contract and records the result.
+func (acct *Accountant) submitObservationsToShadowContract(ctx context.Context, batch *shadowBatch) {
+	logger := acct.logger.With(zap.String("shadowContract", acct.shadow.contract))
+	txResp, err := SubmitObservationsToContract(ctx, logger, acct.gk, batch.gsIndex, batch.guardianIndex, acct.shadow.wormchainConn, acct.shadow.contract, batch.msgs)
+	if err != nil {
+		logger.Error("failed to submit observations to the shadow contract", zap.Int("numMsgs", len(batch.msgs)), zap.Error(err))
+		shadowSubmitFailures.Add(float64(len(batch.msgs)))
+		return
+	}
+
+	responses, err := GetObservationResponses(txResp)
+	if err != nil {
+		logger.Error("failed to get responses from the shadow contract", zap.Int("numMsgs", len(batch.msgs)), zap.Error(err))
+		shadowSubmitFailures.Add(float64(len(batch.msgs)))
+		return
+	}
+
+	for _, msg := range batch.msgs {
+		msgId := msg.MessageIDString()
+		status, exists := responses[msgId]
+		if !exists {
+			logger.Error("did not receive an observation response from the shadow contract", zap.String("msgId", msgId))
+			shadowSubmitFailures.Inc()
+			continue
+		}
+
+		if status.Type == "error" {
+			logger.Warn("shadow contract returned an error for observation", zap.String("msgId", msgId), zap.String("text", status.Data))
+		} else {
+			logger.Debug("shadow contract observation response", zap.String("msgId", msgId), zap.String("status", status.Type))
+		}
+		shadowObservationResponses.WithLabelValues(status.Type).Inc()
+	}
+}
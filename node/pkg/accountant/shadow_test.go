@@ -0,0 +1,79 @@
+package accountant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	wasmdtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// recordingWormchainConn records the contracts that transactions are sent to and fails them.
+type recordingWormchainConn struct {
+	MockAccountantWormchainConn
+	contracts []string
+}
+
+func (c *recordingWormchainConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
+	c.contracts = append(c.contracts, msg.(*wasmdtypes.MsgExecuteContract).Contract)
+	return nil, fmt.Errorf("not connected")
+}
+
+func TestShadowContractGetsCopyOfObservations(t *testing.T) {
+	ctx := context.Background()
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, zap.NewNop(), ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+
+	conn := &recordingWormchainConn{}
+	acct.EnableShadowContract("wormshadow", conn)
+
+	emitterAddr, _ := vaa.StringToAddress("0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16")
+	msg := &common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddr,
+		ConsistencyLevel: uint8(32),
+		Payload:          []byte{1},
+	}
+
+	acct.submitToShadow([]*common.MessagePublication{msg}, 0, 0)
+	require.Equal(t, 1, len(acct.shadow.subChan))
+
+	// A failure of the shadow contract does not affect the accountant.
+	acct.submitObservationsToShadowContract(ctx, <-acct.shadow.subChan)
+	assert.Equal(t, []string{"wormshadow"}, conn.contracts)
+	assert.Equal(t, 0, len(acct.pendingTransfers))
+	assert.Equal(t, 0, len(acctChan))
+}
+
+func TestShadowSubmissionNeverBlocks(t *testing.T) {
+	ctx := context.Background()
+	obsvReqWriteC := make(chan *common.InboundObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, zap.NewNop(), ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+
+	// Without a shadow contract, nothing happens.
+	acct.submitToShadow([]*common.MessagePublication{{}}, 0, 0)
+	assert.Nil(t, acct.shadow)
+
+	acct.EnableShadowContract("wormshadow", &recordingWormchainConn{})
+	for i := 0; i < shadowChanSize+1; i++ {
+		acct.submitToShadow([]*common.MessagePublication{{}}, 0, 0)
+	}
+	assert.Equal(t, shadowChanSize, len(acct.shadow.subChan))
+}
@@ -0,0 +1,140 @@
+// This file contains the code used by the admin server to report the state of the pending transfers, as known locally and as
+// reported by the accountant contract. Unlike the audit, it never modifies any state or resubmits observations.
+
+package accountant
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	ContractStatusCommitted = "committed"
+	ContractStatusPending   = "pending"
+	ContractStatusUnknown   = "unknown"
+)
+
+// PendingTransferStatus describes a single pending transfer for the admin status command.
+type PendingTransferStatus struct {
+	MsgId         string
+	Digest        string
+	SubmitPending bool
+	UpdTime       time.Time
+
+	// ContractStatus is empty if the contract was not queried.
+	ContractStatus string
+
+	// NumObservations is the number of guardian observations the contract has recorded for our digest.
+	NumObservations int
+
+	// MismatchedDigests contains any digests the contract has for this transfer that do not match ours.
+	MismatchedDigests []string
+}
+
+// PendingTransferStatuses returns the status of all pending transfers, sorted by message ID. If the contract query fails, the local
+// state of each transfer is still returned, along with the error.
+func (acct *Accountant) PendingTransferStatuses() ([]*PendingTransferStatus, error) {
+	acct.pendingTransfersLock.Lock()
+	pending := make([]*pendingEntry, 0, len(acct.pendingTransfers))
+	for _, pe := range acct.pendingTransfers {
+		pending = append(pending, pe)
+	}
+	acct.pendingTransfersLock.Unlock()
+
+	if len(pending) == 0 {
+		return []*PendingTransferStatus{}, nil
+	}
+
+	keys := make([]TransferKey, 0, len(pending))
+	for _, pe := range pending {
+		keys = append(keys, TransferKey{EmitterChain: uint16(pe.msg.EmitterChain), EmitterAddress: pe.msg.EmitterAddress, Sequence: pe.msg.Sequence})
+	}
+
+	transferDetails, err := acct.queryBatchTransferStatus(keys)
+	if err != nil {
+		acct.logger.Error("failed to query transfer statuses for status request", zap.Error(err))
+		return buildPendingTransferStatuses(acct.logger, pending, nil), err
+	}
+
+	return buildPendingTransferStatuses(acct.logger, pending, transferDetails), nil
+}
+
+// buildPendingTransferStatuses combines the local state of the pending transfers with the statuses returned by the contract.
+// If transferDetails is nil, only the local state is reported.
+func buildPendingTransferStatuses(logger *zap.Logger, pending []*pendingEntry, transferDetails map[string]*TransferStatus) []*PendingTransferStatus {
+	ret := make([]*PendingTransferStatus, 0, len(pending))
+	for _, pe := range pending {
+		s := &PendingTransferStatus{
+			MsgId:             pe.msgId,
+			Digest:            pe.digest,
+			SubmitPending:     pe.submitPending(),
+			UpdTime:           pe.updTime(),
+			MismatchedDigests: []string{},
+		}
+
+		if transferDetails != nil {
+			status := transferDetails[pe.msgId]
+			switch {
+			case status == nil:
+				s.ContractStatus = ContractStatusUnknown
+			case status.Committed != nil:
+				s.ContractStatus = ContractStatusCommitted
+				if digest := hex.EncodeToString(status.Committed.Digest); digest != pe.digest {
+					s.MismatchedDigests = append(s.MismatchedDigests, digest)
+				}
+			case status.Pending != nil:
+				s.ContractStatus = ContractStatusPending
+				for _, p := range *status.Pending {
+					digest := hex.EncodeToString(p.Digest)
+					if digest != pe.digest {
+						s.MismatchedDigests = append(s.MismatchedDigests, digest)
+						continue
+					}
+
+					num, err := numSignatures(p.Signatures)
+					if err != nil {
+						logger.Error("failed to parse signatures returned by the contract", zap.String("msgId", pe.msgId), zap.String("signatures", p.Signatures), zap.Error(err))
+						continue
+					}
+					s.NumObservations = num
+				}
+			default:
+				s.ContractStatus = ContractStatusUnknown
+			}
+		}
+
+		ret = append(ret, s)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].MsgId < ret[j].MsgId
+	})
+
+	return ret
+}
+
+// numSignatures returns the number of guardians that have signed an observation, given the signature bitmap returned by the
+// contract (a u128 encoded as a decimal string).
+func numSignatures(sigs string) (int, error) {
+	if sigs == "" {
+		return 0, nil
+	}
+
+	bitmap, ok := new(big.Int).SetString(sigs, 10)
+	if !ok || bitmap.Sign() < 0 {
+		return 0, fmt.Errorf("invalid signature bitmap: %s", sigs)
+	}
+
+	num := 0
+	for _, w := range bitmap.Bits() {
+		num += bits.OnesCount(uint(w))
+	}
+
+	return num, nil
+}
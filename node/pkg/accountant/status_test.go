@@ -0,0 +1,91 @@
+package accountant
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/zap"
+)
+
+func TestNumSignatures(t *testing.T) {
+	num, err := numSignatures("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, num)
+
+	num, err = numSignatures("1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, num)
+
+	num, err = numSignatures("7")
+	require.NoError(t, err)
+	assert.Equal(t, 3, num)
+
+	// All 128 bits set.
+	num, err = numSignatures("340282366920938463463374607431768211455")
+	require.NoError(t, err)
+	assert.Equal(t, 128, num)
+
+	_, err = numSignatures("garbage")
+	assert.Error(t, err)
+}
+
+func TestBuildPendingTransferStatuses(t *testing.T) {
+	emitterAddr, err := vaa.StringToAddress("0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	ourDigest, err := hex.DecodeString("eb986600de086d6f4c067483cd89a0a03ff7cdef85f2293d34678a47fbd0e09e")
+	require.NoError(t, err)
+	otherDigest, err := hex.DecodeString("0a31f1f331319ebe0953c7b002ee7fb5733a6b9432b252ae7c61d9352af4684f")
+	require.NoError(t, err)
+
+	newEntry := func(seq uint64) *pendingEntry {
+		msg := &common.MessagePublication{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitterAddr, Sequence: seq}
+		return &pendingEntry{msg: msg, msgId: msg.MessageIDString(), digest: hex.EncodeToString(ourDigest)}
+	}
+
+	pending := []*pendingEntry{newEntry(4), newEntry(3), newEntry(2), newEntry(1)}
+	pending[0].setSubmitPending(true)
+
+	transferDetails := map[string]*TransferStatus{
+		pending[0].msgId: {Pending: &[]TransferStatusPending{
+			{Digest: ourDigest, Signatures: "5"},
+			{Digest: otherDigest, Signatures: "2"},
+		}},
+		pending[1].msgId: {Committed: &TransferStatusCommitted{Digest: ourDigest}},
+		pending[2].msgId: {Committed: &TransferStatusCommitted{Digest: otherDigest}},
+		pending[3].msgId: nil,
+	}
+
+	statuses := buildPendingTransferStatuses(zap.NewNop(), pending, transferDetails)
+	require.Equal(t, 4, len(statuses))
+
+	// Results are sorted by message ID.
+	assert.Equal(t, pending[3].msgId, statuses[0].MsgId)
+	assert.Equal(t, ContractStatusUnknown, statuses[0].ContractStatus)
+	assert.Equal(t, 0, len(statuses[0].MismatchedDigests))
+
+	assert.Equal(t, ContractStatusCommitted, statuses[1].ContractStatus)
+	assert.Equal(t, []string{hex.EncodeToString(otherDigest)}, statuses[1].MismatchedDigests)
+
+	assert.Equal(t, ContractStatusCommitted, statuses[2].ContractStatus)
+	assert.Equal(t, 0, len(statuses[2].MismatchedDigests))
+
+	assert.Equal(t, ContractStatusPending, statuses[3].ContractStatus)
+	assert.Equal(t, true, statuses[3].SubmitPending)
+	assert.Equal(t, 2, statuses[3].NumObservations)
+	assert.Equal(t, hex.EncodeToString(ourDigest), statuses[3].Digest)
+	assert.Equal(t, []string{hex.EncodeToString(otherDigest)}, statuses[3].MismatchedDigests)
+
+	// Without contract data, only the local state is reported.
+	statuses = buildPendingTransferStatuses(zap.NewNop(), pending, nil)
+	require.Equal(t, 4, len(statuses))
+	for _, s := range statuses {
+		assert.Equal(t, "", s.ContractStatus)
+	}
+}
@@ -69,8 +69,10 @@ func (acct *Accountant) handleBatch(ctx context.Context) error {
 		return fmt.Errorf("failed to get guardian index")
 	}
 
+	acct.setSubmittedGuardianSetIndex(msgs, gs.Index)
 	acct.submitObservationsToContract(msgs, gs.Index, uint32(guardianIndex))
 	transfersSubmitted.Add(float64(len(msgs)))
+	acct.submitToShadow(msgs, gs.Index, uint32(guardianIndex))
 	return nil
 }
 
@@ -174,8 +176,6 @@ type (
 	}
 )
 
-var submitObservationPrefix = []byte("acct_sub_obsfig_000000000000000000|")
-
 func (k TransferKey) String() string {
 	return fmt.Sprintf("%v/%v/%v", k.EmitterChain, hex.EncodeToString(k.EmitterAddress[:]), k.Sequence)
 }
@@ -330,10 +330,7 @@ func SubmitObservationsToContract(
 		return nil, fmt.Errorf("failed to marshal accountant observation request: %w", err)
 	}
 
-	digest, err := vaa.MessageSigningDigest(submitObservationPrefix, bytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign accountant Observation request: %w", err)
-	}
+	digest := vaa.AccountantObservationSigningDigest(bytes)
 
 	sigBytes, err := ethCrypto.Sign(digest.Bytes(), gk)
 	if err != nil {
@@ -5,108 +5,57 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"reflect"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/wormconn"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	ethCommon "github.com/ethereum/go-ethereum/common"
 
-	tmAbci "github.com/tendermint/tendermint/abci/types"
-	tmHttp "github.com/tendermint/tendermint/rpc/client/http"
-	tmCoreTypes "github.com/tendermint/tendermint/rpc/core/types"
-	tmTypes "github.com/tendermint/tendermint/types"
-
 	"go.uber.org/zap"
 )
 
 // watcher reads transaction events from the smart contract and publishes them.
 func (acct *Accountant) watcher(ctx context.Context) error {
-	errC := make(chan error)
-
 	acct.logger.Info("acctwatch: creating watcher", zap.String("url", acct.wsUrl), zap.String("contract", acct.contract))
-	tmConn, err := tmHttp.New(acct.wsUrl, "/websocket")
-	if err != nil {
-		connectionErrors.Inc()
-		return fmt.Errorf("failed to establish tendermint connection: %w", err)
-	}
-
-	if err := tmConn.Start(); err != nil {
-		connectionErrors.Inc()
-		return fmt.Errorf("failed to start tendermint connection: %w", err)
-	}
-	defer func() {
-		if err := tmConn.Stop(); err != nil {
-			connectionErrors.Inc()
-			acct.logger.Error("acctwatch: failed to stop tendermint connection", zap.Error(err))
-		}
-	}()
-
-	query := fmt.Sprintf("execute._contract_address='%s'", acct.contract)
-	events, err := tmConn.Subscribe(
-		ctx,
-		"guardiand",
-		query,
-		64, // channel capacity
-	)
-	if err != nil {
-		return fmt.Errorf("failed to subscribe to accountant events: %w", err)
-	}
-	defer func() {
-		if err := tmConn.UnsubscribeAll(ctx, "guardiand"); err != nil {
-			acct.logger.Error("acctwatch: failed to unsubscribe from events", zap.Error(err))
-		}
-	}()
-
-	go acct.handleEvents(ctx, events, errC)
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case err := <-errC:
-		return err
-	}
+	events := wormconn.SubscribeContractEvents(ctx, acct.logger, acct.wsUrl, acct.contract, wormconn.EventTypes("wasm-Observation", "wasm-ObservationError", "wasm-Modification"))
+	acct.handleEvents(events)
+	return ctx.Err()
 }
 
-// handleEvents handles events from the tendermint client library.
-func (acct *Accountant) handleEvents(ctx context.Context, evts <-chan tmCoreTypes.ResultEvent, errC chan error) {
-	defer close(errC)
+// handleEvents handles the events of the smart contract until the subscription ends.
+func (acct *Accountant) handleEvents(events <-chan wormconn.ContractEvent) {
+	for event := range events {
+		switch event.Type {
+		case "wasm-Observation":
+			evt, err := parseEvent[WasmObservation](acct.logger, event)
+			if err != nil {
+				acct.logger.Error("failed to parse wasm transfer event", zap.Error(err), zap.String("txHash", event.TxHash), zap.Any("event", event))
+				continue
+			}
 
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case e := <-evts:
-			tx, ok := e.Data.(tmTypes.EventDataTx)
-			if !ok {
-				acct.logger.Error("unknown data from event subscription", zap.Stringer("e.Data", reflect.TypeOf(e.Data)), zap.Any("event", e))
+			eventsReceived.Inc()
+			acct.processPendingTransfer(evt)
+		case "wasm-ObservationError":
+			evt, err := parseEvent[WasmObservationError](acct.logger, event)
+			if err != nil {
+				acct.logger.Error("failed to parse wasm observation error event", zap.Error(err), zap.String("txHash", event.TxHash), zap.Any("event", event))
 				continue
 			}
 
-			for _, event := range tx.Result.Events {
-				if event.Type == "wasm-Observation" {
-					evt, err := parseEvent[WasmObservation](acct.logger, event, "wasm-Observation", acct.contract)
-					if err != nil {
-						acct.logger.Error("failed to parse wasm transfer event", zap.Error(err), zap.Stringer("e.Data", reflect.TypeOf(e.Data)), zap.Any("event", event))
-						continue
-					}
-
-					eventsReceived.Inc()
-					acct.processPendingTransfer(evt)
-				} else if event.Type == "wasm-ObservationError" {
-					evt, err := parseEvent[WasmObservationError](acct.logger, event, "wasm-ObservationError", acct.contract)
-					if err != nil {
-						acct.logger.Error("failed to parse wasm observation error event", zap.Error(err), zap.Stringer("e.Data", reflect.TypeOf(e.Data)), zap.Any("event", event))
-						continue
-					}
-
-					errorEventsReceived.Inc()
-					acct.handleTransferError(evt.Key.String(), evt.Error, "transfer error event received")
-				} else {
-					acct.logger.Debug("ignoring uninteresting event", zap.String("eventType", event.Type))
-				}
+			errorEventsReceived.Inc()
+			acct.handleTransferError(evt.Key.String(), evt.Error, "transfer error event received")
+		case "wasm-Modification":
+			evt, err := parseEvent[WasmModification](acct.logger, event)
+			if err != nil {
+				acct.logger.Error("failed to parse wasm modification event", zap.Error(err), zap.String("txHash", event.TxHash), zap.Any("event", event))
+				continue
 			}
+
+			acct.recordModification((*Modification)(evt), "event")
+		default:
+			acct.logger.Debug("ignoring uninteresting event", zap.String("eventType", event.Type))
 		}
 	}
 }
@@ -120,29 +69,26 @@ type (
 		Key   TransferKey `json:"key"`
 		Error string      `json:"error"`
 	}
+
+	// WasmModification represents a balance modification event from the smart contract.
+	WasmModification Modification
 )
 
-func parseEvent[T any](logger *zap.Logger, event tmAbci.Event, name string, contractAddress string) (*T, error) {
+func parseEvent[T any](logger *zap.Logger, event wormconn.ContractEvent) (*T, error) {
 	attrs := make(map[string]json.RawMessage)
 	for _, attr := range event.Attributes {
-		if string(attr.Key) == "_contract_address" {
-			if string(attr.Value) != contractAddress {
-				return nil, fmt.Errorf("%s event from unexpected contract: %s", name, string(attr.Value))
-			}
-		} else {
-			logger.Debug("event attribute", zap.String("event", name), zap.String("key", string(attr.Key)), zap.String("value", string(attr.Value)))
-			attrs[string(attr.Key)] = attr.Value
-		}
+		logger.Debug("event attribute", zap.String("event", event.Type), zap.String("key", attr.Key), zap.String("value", attr.Value))
+		attrs[attr.Key] = json.RawMessage(attr.Value)
 	}
 
 	attrBytes, err := json.Marshal(attrs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal %s event attributes: %w", name, err)
+		return nil, fmt.Errorf("failed to marshal %s event attributes: %w", event.Type, err)
 	}
 
 	evt := new(T)
 	if err := json.Unmarshal(attrBytes, evt); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal %s event: %w", name, err)
+		return nil, fmt.Errorf("failed to unmarshal %s event: %w", event.Type, err)
 	}
 
 	return evt, nil
@@ -179,7 +125,12 @@ func (acct *Accountant) processPendingTransfer(xfer *WasmObservation) {
 
 	pe, exists := acct.pendingTransfers[msgId]
 	if exists {
-		digest := msg.CreateDigest()
+		digest, err := computeDigest(pe.digestVersion, msg)
+		if err != nil {
+			acct.logger.Error("acctwatch: failed to compute digest, dropping transfer", zap.String("msgID", msgId), zap.Error(err))
+			acct.deletePendingTransferAlreadyLocked(msgId)
+			return
+		}
 		if pe.digest != digest {
 			digestMismatches.Inc()
 			acct.logger.Error("acctwatch: digest mismatch, dropping transfer",
@@ -0,0 +1,299 @@
+// Package audit implements the admin RPC audit log. Every call to the admin service is recorded as a
+// JSON object on its own line in an append-only file and, optionally, in the node database.
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CallerMetadataKey is the gRPC metadata key the admin client uses to identify the local user issuing a command.
+// It is informational only - the admin socket is authenticated using filesystem permissions.
+const CallerMetadataKey = "x-wormhole-admin-caller"
+
+// DefaultQueryLimit is the number of entries returned by Entries if no limit is specified.
+const DefaultQueryLimit = 100
+
+var auditWriteErrors = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "wormhole_admin_audit_write_errors_total",
+		Help: "Total number of admin audit log entries that could not be persisted",
+	})
+
+// Entry is a single audited admin RPC call.
+type Entry struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Method    string          `json:"method"`
+	Request   json.RawMessage `json:"request,omitempty"`
+	Caller    string          `json:"caller,omitempty"`
+	Code      string          `json:"code"`
+	Error     string          `json:"error,omitempty"`
+	Duration  time.Duration   `json:"duration"`
+	// BytesSent is the number of bytes streamed to the caller by a streaming call.
+	BytesSent uint64 `json:"bytesSent,omitempty"`
+}
+
+// Log is an append-only audit log. A nil *Log is valid and records nothing.
+type Log struct {
+	logger *zap.Logger
+	path   string
+	db     *db.Database
+
+	// mu serializes writes to f so that lines are never interleaved.
+	mu sync.Mutex
+	f  *os.File
+}
+
+// Open opens (or creates) the audit log file at path. If database is not nil, entries are also stored in it.
+func Open(logger *zap.Logger, path string, database *db.Database) (*Log, error) {
+	if path == "" {
+		return nil, errors.New("audit log path must be specified")
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+
+	// If the node crashed in the middle of a write, terminate the partial line so the next entry starts on its own line.
+	if err := terminatePartialLine(path, f); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &Log{
+		logger: logger.Named("audit"),
+		path:   path,
+		db:     database,
+		f:      f,
+	}, nil
+}
+
+func terminatePartialLine(path string, f *os.File) error {
+	r, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+	defer r.Close()
+
+	fi, err := r.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat audit log %s: %w", path, err)
+	}
+	if fi.Size() == 0 {
+		return nil
+	}
+
+	last := make([]byte, 1)
+	if _, err := r.ReadAt(last, fi.Size()-1); err != nil {
+		return fmt.Errorf("failed to read audit log %s: %w", path, err)
+	}
+	if last[0] != '\n' {
+		if _, err := f.Write([]byte{'\n'}); err != nil {
+			return fmt.Errorf("failed to write audit log %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying file.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// Record persists an entry. The file is synced before returning so entries survive a crash right after the call.
+func (l *Log) Record(e *Entry) error {
+	if l == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.f.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	if err := l.f.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+
+	if l.db != nil {
+		if err := l.db.StoreAuditEntry(e.Timestamp, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Entries returns up to limit entries, newest first, optionally filtered by method. Entries are read from
+// the database if enabled, otherwise from the file.
+func (l *Log) Entries(limit int, method string) ([]*Entry, error) {
+	if l == nil {
+		return nil, errors.New("audit log is not enabled")
+	}
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+
+	if l.db != nil {
+		var parseErr error
+		entries := make([]*Entry, 0)
+		_, err := l.db.GetAuditEntries(limit, func(b []byte) bool {
+			var e Entry
+			if err := json.Unmarshal(b, &e); err != nil {
+				parseErr = err
+				return false
+			}
+			if method != "" && e.Method != method {
+				return false
+			}
+			entries = append(entries, &e)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if parseErr != nil {
+			l.logger.Warn("skipped malformed audit entries in database", zap.Error(parseErr))
+		}
+		return entries, nil
+	}
+
+	return l.entriesFromFile(limit, method)
+}
+
+func (l *Log) entriesFromFile(limit int, method string) ([]*Entry, error) {
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	// The file is scanned front to back, keeping the last `limit` matches.
+	entries := make([]*Entry, 0, limit)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			// Lines torn by a crash end up here.
+			l.logger.Warn("skipping malformed audit log line", zap.Error(err))
+			continue
+		}
+		if method != "" && e.Method != method {
+			continue
+		}
+		entries = append(entries, &e)
+		if len(entries) > limit {
+			entries = entries[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	// Newest first.
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries, nil
+}
+
+// UnaryServerInterceptor returns an interceptor that records every unary call whose full method name starts
+// with methodPrefix. Failing to persist an entry is logged but does not fail the call.
+func (l *Log) UnaryServerInterceptor(methodPrefix string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if l == nil || !strings.HasPrefix(info.FullMethod, methodPrefix) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		l.recordCall(newEntry(ctx, info.FullMethod, req, start, err))
+		return resp, err
+	}
+}
+
+// RecordStreamCall records a streaming call, which the unary interceptor does not cover, once it has finished.
+// bytesSent is the number of bytes streamed to the caller. Failing to persist the entry is logged.
+func (l *Log) RecordStreamCall(ctx context.Context, method string, req interface{}, start time.Time, err error, bytesSent uint64) {
+	if l == nil {
+		return
+	}
+	e := newEntry(ctx, method, req, start, err)
+	e.BytesSent = bytesSent
+	l.recordCall(e)
+}
+
+// newEntry returns the entry of a call of method which started at start and returned err.
+func newEntry(ctx context.Context, method string, req interface{}, start time.Time, err error) *Entry {
+	e := &Entry{
+		Timestamp: start,
+		Method:    method,
+		Caller:    callerFromContext(ctx),
+		Code:      status.Code(err).String(),
+		Duration:  time.Since(start),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if p, ok := req.(protoreflect.ProtoMessage); ok {
+		if b, mErr := protojson.Marshal(p); mErr == nil {
+			e.Request = b
+		}
+	}
+	return e
+}
+
+// recordCall records e, logging any failure rather than failing the call.
+func (l *Log) recordCall(e *Entry) {
+	if rErr := l.Record(e); rErr != nil {
+		auditWriteErrors.Inc()
+		l.logger.Error("failed to record admin audit entry", zap.String("method", e.Method), zap.Error(rErr))
+	}
+}
+
+// callerFromContext returns a best-effort description of the caller.
+func callerFromContext(ctx context.Context) string {
+	parts := make([]string, 0, 2)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(CallerMetadataKey); len(v) > 0 && v[0] != "" {
+			parts = append(parts, v[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if addr := p.Addr.String(); addr != "" {
+			parts = append(parts, fmt.Sprintf("%s://%s", p.Addr.Network(), addr))
+		}
+	}
+	return strings.Join(parts, " ")
+}
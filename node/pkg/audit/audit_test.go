@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testPrefix = "/node.v1.NodePrivilegedService/"
+
+func callInterceptor(t *testing.T, l *Log, method string, handlerErr error) {
+	t.Helper()
+	interceptor := l.UnaryServerInterceptor(testPrefix)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CallerMetadataKey, "alice@host"))
+	req := &nodev1.PurgePythNetVaasRequest{DaysOld: 7, LogOnly: true}
+	_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, handlerErr
+	})
+	assert.Equal(t, handlerErr, err)
+}
+
+func testRecordAndQuery(t *testing.T, useDB bool) {
+	dir := t.TempDir()
+	var database *db.Database
+	if useDB {
+		var err error
+		database, err = db.Open(path.Join(dir, "db"))
+		require.NoError(t, err)
+		defer database.Close()
+	}
+
+	l, err := Open(zap.NewNop(), path.Join(dir, "audit.log"), database)
+	require.NoError(t, err)
+	defer l.Close()
+
+	callInterceptor(t, l, testPrefix+"PurgePythNetVaas", nil)
+	callInterceptor(t, l, testPrefix+"ChainGovernorReload", status.Error(codes.Unavailable, "disabled"))
+	callInterceptor(t, l, "/publicrpc.v1.PublicRPCService/GetLastHeartbeats", nil)
+
+	entries, err := l.Entries(0, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+
+	assert.Equal(t, testPrefix+"ChainGovernorReload", entries[0].Method)
+	assert.Equal(t, "Unavailable", entries[0].Code)
+	assert.Contains(t, entries[0].Error, "disabled")
+
+	assert.Equal(t, testPrefix+"PurgePythNetVaas", entries[1].Method)
+	assert.Equal(t, "OK", entries[1].Code)
+	assert.Equal(t, "", entries[1].Error)
+	assert.Equal(t, "alice@host", entries[1].Caller)
+	assert.JSONEq(t, `{"daysOld":"7","logOnly":true}`, string(entries[1].Request))
+
+	entries, err = l.Entries(1, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, testPrefix+"ChainGovernorReload", entries[0].Method)
+
+	entries, err = l.Entries(10, testPrefix+"PurgePythNetVaas")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, testPrefix+"PurgePythNetVaas", entries[0].Method)
+}
+
+func TestRecordAndQueryFile(t *testing.T) {
+	testRecordAndQuery(t, false)
+}
+
+func TestRecordAndQueryDB(t *testing.T) {
+	testRecordAndQuery(t, true)
+}
+
+func TestFileIsAppendOnly(t *testing.T) {
+	p := path.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(zap.NewNop(), p, nil)
+	require.NoError(t, err)
+	callInterceptor(t, l, testPrefix+"DumpRPCs", nil)
+	require.NoError(t, l.Close())
+
+	// Reopening must not truncate, and a torn last line must not break queries.
+	f, err := os.OpenFile(p, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"timestamp":"2023-`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = Open(zap.NewNop(), p, nil)
+	require.NoError(t, err)
+	defer l.Close()
+	callInterceptor(t, l, testPrefix+"DumpRPCs", errors.New("boom"))
+
+	entries, err := l.Entries(0, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, "boom", entries[0].Error)
+}
+
+func TestNilLog(t *testing.T) {
+	var l *Log
+	assert.NoError(t, l.Record(&Entry{}))
+	assert.NoError(t, l.Close())
+	callInterceptor(t, l, testPrefix+"DumpRPCs", nil)
+	_, err := l.Entries(0, "")
+	assert.Error(t, err)
+}
@@ -27,6 +27,11 @@ type MessagePublication struct {
 	// Unreliable indicates if this message can be reobserved. If a message is considered unreliable it cannot be
 	// reobserved.
 	Unreliable bool
+
+	// GatewaySourceChain is the chain a message emitted on wormchain was relayed from over IBC, as determined by the
+	// wormchain watcher from the IBC events of the transaction. It is only used by the governor and is not part of the
+	// VAA or of the marshaled message.
+	GatewaySourceChain vaa.ChainID
 }
 
 func (msg *MessagePublication) MessageID() []byte {
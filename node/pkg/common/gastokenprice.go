@@ -0,0 +1,21 @@
+package common
+
+import (
+	"time"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// GasTokenPrice is the USD price of the native gas token of a chain, as read from an on-chain price oracle by a watcher.
+type GasTokenPrice struct {
+	// ChainID is the chain the price was read on. It is also the origin chain of the token.
+	ChainID vaa.ChainID
+
+	// TokenAddress is the address of the wrapped gas token, which is how the governor identifies the token.
+	TokenAddress vaa.Address
+
+	Price float64
+
+	// UpdatedAt is when the oracle last updated the price.
+	UpdatedAt time.Time
+}
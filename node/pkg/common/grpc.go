@@ -11,7 +11,10 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -85,7 +88,9 @@ func requestPayloadServerInterceptor(ctx context.Context, req interface{}, info
 	return handler(ctx, req)
 }
 
-func NewInstrumentedGRPCServer(logger *zap.Logger, rpcLogDetail GrpcLogDetail) *grpc.Server {
+// NewInstrumentedGRPCServer returns a gRPC server with metrics and logging interceptors installed.
+// Any additional unary interceptors are run after the built-in ones.
+func NewInstrumentedGRPCServer(logger *zap.Logger, rpcLogDetail GrpcLogDetail, extraUnaryInterceptors ...grpc.UnaryServerInterceptor) *grpc.Server {
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		grpc_ctxtags.StreamServerInterceptor(),
 		grpc_prometheus.StreamServerInterceptor,
@@ -114,6 +119,8 @@ func NewInstrumentedGRPCServer(logger *zap.Logger, rpcLogDetail GrpcLogDetail) *
 		)
 	}
 
+	unaryInterceptors = append(unaryInterceptors, extraUnaryInterceptors...)
+
 	server := grpc.NewServer(
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
@@ -125,6 +132,30 @@ func NewInstrumentedGRPCServer(logger *zap.Logger, rpcLogDetail GrpcLogDetail) *
 	return server
 }
 
+// GrpcStandardServices selects the standard gRPC services registered on a server, so load balancers and debugging
+// tools like grpcurl work without the proto files.
+type GrpcStandardServices struct {
+	// Reflection registers the server reflection service.
+	Reflection bool
+	// Health registers the grpc.health.v1 service, reporting all services of the server as serving.
+	Health bool
+}
+
+// Register registers the selected standard services. It must be called after the other services were registered,
+// since the health service reports the services registered at that time.
+func (s GrpcStandardServices) Register(server *grpc.Server) {
+	if s.Health {
+		healthServer := health.NewServer()
+		for name := range server.GetServiceInfo() {
+			healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+		}
+		healthpb.RegisterHealthServer(server, healthServer)
+	}
+	if s.Reflection {
+		reflection.Register(server)
+	}
+}
+
 // this helper type and associated functions are such that the ZAP jsonEncoder will properly encode the gRPC request payload.
 // We could instead just encode the payload to a string here, but then that string will be encoded again by the ZAP jsonEncoder, making downstream processing more difficult
 type protojsonObjectMarshaler struct {
@@ -79,6 +79,11 @@ type GuardianSetState struct {
 	mu      sync.Mutex
 	current *GuardianSet
 
+	// previous is the guardian set replaced by current during a guardian set transition, which VAAs may still be
+	// signed by until previousExpiry.
+	previous       *GuardianSet
+	previousExpiry time.Time
+
 	// Last heartbeat message received per guardian per p2p node. Maintained
 	// across guardian set updates - these values don't change.
 	lastHeartbeats map[common.Address]map[peer.ID]*gossipv1.Heartbeat
@@ -112,6 +117,27 @@ func (st *GuardianSetState) Get() *GuardianSet {
 	return st.current
 }
 
+// SetPrevious records the guardian set replaced by the current one, which stays valid until expiry.
+func (st *GuardianSetState) SetPrevious(set *GuardianSet, expiry time.Time) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.previous = set
+	st.previousExpiry = expiry
+}
+
+// GetPrevious returns the guardian set replaced by the current one, or nil if there is no guardian set transition in
+// progress.
+func (st *GuardianSetState) GetPrevious() *GuardianSet {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.previous == nil || time.Now().After(st.previousExpiry) {
+		return nil
+	}
+	return st.previous
+}
+
 // LastHeartbeat returns the most recent heartbeat message received for
 // a given guardian node, or nil if none have been received.
 func (st *GuardianSetState) LastHeartbeat(addr common.Address) map[peer.ID]*gossipv1.Heartbeat {
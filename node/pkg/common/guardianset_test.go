@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -92,3 +93,15 @@ func TestGet(t *testing.T) {
 	gss.Set(&gs)
 	assert.Equal(t, gss.Get(), &gs)
 }
+
+func TestGuardianSetStatePrevious(t *testing.T) {
+	st := NewGuardianSetState(nil)
+	assert.Nil(t, st.GetPrevious())
+
+	prev := &GuardianSet{Index: 1}
+	st.SetPrevious(prev, time.Now().Add(time.Hour))
+	assert.Equal(t, prev, st.GetPrevious())
+
+	st.SetPrevious(prev, time.Now().Add(-time.Second))
+	assert.Nil(t, st.GetPrevious())
+}
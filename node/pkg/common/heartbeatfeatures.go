@@ -0,0 +1,98 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// HeartbeatFeature is a single bit in the Heartbeat.FeatureFlags bitmap.
+// The bit positions are part of the gossip protocol and must never be reused or renumbered.
+type HeartbeatFeature uint64
+
+const (
+	HeartbeatFeatureGovernor HeartbeatFeature = 1 << iota
+	HeartbeatFeatureAccountant
+	HeartbeatFeatureAccountantEnforced
+	HeartbeatFeatureCCQ
+	HeartbeatFeatureGatewayRelayer
+	HeartbeatFeatureIBC
+	HeartbeatFeatureGossipZstd
+)
+
+var heartbeatFeatureNames = []struct {
+	feature HeartbeatFeature
+	name    string
+}{
+	{HeartbeatFeatureGovernor, "governor"},
+	{HeartbeatFeatureAccountant, "accountant"},
+	{HeartbeatFeatureAccountantEnforced, "accountant_enforced"},
+	{HeartbeatFeatureCCQ, "ccq"},
+	{HeartbeatFeatureGatewayRelayer, "gateway_relayer"},
+	{HeartbeatFeatureIBC, "ibc"},
+	{HeartbeatFeatureGossipZstd, "gossip_zstd"},
+}
+
+// HeartbeatFeatureNames returns the human-readable names of the bits set in flags, in bit order.
+// Unknown bits (set by newer nodes) are reported as "unknown_<bit>".
+func HeartbeatFeatureNames(flags uint64) []string {
+	names := make([]string, 0)
+	for bit := 0; bit < 64; bit++ {
+		mask := uint64(1) << bit
+		if flags&mask == 0 {
+			continue
+		}
+		name := fmt.Sprintf("unknown_%d", bit)
+		for _, f := range heartbeatFeatureNames {
+			if uint64(f.feature) == mask {
+				name = f.name
+				break
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// HeartbeatFeatureFlagsFromFeatures derives the feature bitmap from the legacy feature strings of a heartbeat, for
+// nodes that predate the bitmap. Unknown strings are ignored.
+func HeartbeatFeatureFlagsFromFeatures(features []string) uint64 {
+	var flags HeartbeatFeature
+	for _, f := range features {
+		switch {
+		case f == "governor":
+			flags |= HeartbeatFeatureGovernor
+		case f == "acct:logonly":
+			flags |= HeartbeatFeatureAccountant
+		case f == "acct:enforced":
+			flags |= HeartbeatFeatureAccountant | HeartbeatFeatureAccountantEnforced
+		case strings.HasPrefix(f, "ibc:"):
+			flags |= HeartbeatFeatureIBC
+		}
+	}
+	return uint64(flags)
+}
+
+// InvalidHeartbeatVersion replaces the version of a heartbeat that fails ValidateHeartbeatVersion, so that the rest of
+// the heartbeat is still used.
+const InvalidHeartbeatVersion = "invalid"
+
+// MaxHeartbeatVersionLength is the maximum length of the version string accepted in a heartbeat.
+const MaxHeartbeatVersionLength = 128
+
+// ValidateHeartbeatVersion checks that a version string received in a heartbeat is safe to
+// display and index: non-empty, bounded in length and consisting only of printable ASCII.
+func ValidateHeartbeatVersion(version string) error {
+	if version == "" {
+		return fmt.Errorf("version is empty")
+	}
+	if len(version) > MaxHeartbeatVersionLength {
+		return fmt.Errorf("version is too long: %d > %d", len(version), MaxHeartbeatVersionLength)
+	}
+	for _, r := range version {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return fmt.Errorf("version contains invalid character %q", r)
+		}
+	}
+	return nil
+}
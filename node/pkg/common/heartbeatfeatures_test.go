@@ -0,0 +1,55 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeartbeatFeatureNames(t *testing.T) {
+	assert.Equal(t, []string{}, HeartbeatFeatureNames(0))
+	assert.Equal(t, []string{"governor"}, HeartbeatFeatureNames(uint64(HeartbeatFeatureGovernor)))
+	assert.Equal(t,
+		[]string{"accountant", "accountant_enforced", "ibc"},
+		HeartbeatFeatureNames(uint64(HeartbeatFeatureAccountant|HeartbeatFeatureAccountantEnforced|HeartbeatFeatureIBC)),
+	)
+	assert.Equal(t, []string{"gossip_zstd"}, HeartbeatFeatureNames(uint64(HeartbeatFeatureGossipZstd)))
+	assert.Equal(t, []string{"ccq", "unknown_63"}, HeartbeatFeatureNames(uint64(HeartbeatFeatureCCQ)|1<<63))
+}
+
+func TestHeartbeatFeatureFlagsFromFeatures(t *testing.T) {
+	assert.Equal(t, uint64(0), HeartbeatFeatureFlagsFromFeatures(nil))
+	assert.Equal(t, uint64(HeartbeatFeatureGovernor|HeartbeatFeatureAccountant),
+		HeartbeatFeatureFlagsFromFeatures([]string{"governor", "acct:logonly", "unknown"}))
+	assert.Equal(t, uint64(HeartbeatFeatureAccountant|HeartbeatFeatureAccountantEnforced|HeartbeatFeatureIBC),
+		HeartbeatFeatureFlagsFromFeatures([]string{"acct:enforced", "ibc:osmosis"}))
+}
+
+func TestValidateHeartbeatVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		valid   bool
+	}{
+		{version: "development", valid: true},
+		{version: "v2.14.8", valid: true},
+		{version: "v2.14.8-rc1+abcdef", valid: true},
+		{version: "", valid: false},
+		{version: "v2.14.8 beta", valid: false},
+		{version: "v2.14.8\n", valid: false},
+		{version: "v2.14.8é", valid: false},
+		{version: strings.Repeat("a", MaxHeartbeatVersionLength), valid: true},
+		{version: strings.Repeat("a", MaxHeartbeatVersionLength+1), valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.version, func(t *testing.T) {
+			err := ValidateHeartbeatVersion(tc.version)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
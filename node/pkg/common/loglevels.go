@@ -0,0 +1,144 @@
+package common
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// ComponentLogLevels lets an operator change the log level of individual components at runtime, for instance to get
+// debug logs of a misbehaving watcher during an incident without restarting the node and losing its state.
+//
+// A component is identified by the "component" field of a logger, like "gacct" for the accountant, or else by a
+// segment of the name of the logger, like "ibcwatch", "processor" or "p2p" for the runnables of the supervisor.
+// Components without a level of their own log at the default level.
+type ComponentLogLevels struct {
+	defaultLevel zapcore.Level
+
+	mu       sync.RWMutex
+	levels   map[string]zapcore.Level // protected by `mu`
+	minLevel zapcore.Level            // protected by `mu`, the lowest of the default level and the component levels.
+}
+
+func NewComponentLogLevels(defaultLevel zapcore.Level) *ComponentLogLevels {
+	return &ComponentLogLevels{
+		defaultLevel: defaultLevel,
+		levels:       make(map[string]zapcore.Level),
+		minLevel:     defaultLevel,
+	}
+}
+
+// DefaultLevel returns the level of the components without a level of their own.
+func (l *ComponentLogLevels) DefaultLevel() zapcore.Level {
+	return l.defaultLevel
+}
+
+// SetLevel sets the log level of a component.
+func (l *ComponentLogLevels) SetLevel(component string, level zapcore.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.levels[component] = level
+	l.updateMinLevelAlreadyLocked()
+}
+
+// ResetLevel makes a component log at the default level again.
+func (l *ComponentLogLevels) ResetLevel(component string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.levels, component)
+	l.updateMinLevelAlreadyLocked()
+}
+
+// ComponentLevel is the log level of a component.
+type ComponentLevel struct {
+	Component string
+	Level     zapcore.Level
+}
+
+// Levels returns the components with a level of their own, sorted by name.
+func (l *ComponentLogLevels) Levels() []ComponentLevel {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	levels := make([]ComponentLevel, 0, len(l.levels))
+	for component, level := range l.levels {
+		levels = append(levels, ComponentLevel{Component: component, Level: level})
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i].Component < levels[j].Component })
+	return levels
+}
+
+func (l *ComponentLogLevels) updateMinLevelAlreadyLocked() {
+	l.minLevel = l.defaultLevel
+	for _, level := range l.levels {
+		if level < l.minLevel {
+			l.minLevel = level
+		}
+	}
+}
+
+// enabled returns whether an entry of the given level is logged for the component field and logger name. The
+// component field takes precedence, followed by the segments of the logger name from the most specific one.
+func (l *ComponentLogLevels) enabled(component string, loggerName string, level zapcore.Level) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if len(l.levels) == 0 {
+		return level >= l.defaultLevel
+	}
+
+	if component != "" {
+		if componentLevel, exists := l.levels[component]; exists {
+			return level >= componentLevel
+		}
+	}
+
+	segments := strings.Split(loggerName, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if componentLevel, exists := l.levels[segments[i]]; exists {
+			return level >= componentLevel
+		}
+	}
+
+	return level >= l.defaultLevel
+}
+
+func (l *ComponentLogLevels) anyEnabled(level zapcore.Level) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return level >= l.minLevel
+}
+
+// WrapCore returns a core that filters the entries of core by the level of their component. The level of core itself
+// should be the lowest level a component may be set to, usually debug.
+func (l *ComponentLogLevels) WrapCore(core zapcore.Core) zapcore.Core {
+	return &componentCore{Core: core, levels: l}
+}
+
+type componentCore struct {
+	zapcore.Core
+	levels    *ComponentLogLevels
+	component string // The "component" field of the logger, if any.
+}
+
+func (c *componentCore) Enabled(level zapcore.Level) bool {
+	return c.levels.anyEnabled(level) && c.Core.Enabled(level)
+}
+
+func (c *componentCore) With(fields []zapcore.Field) zapcore.Core {
+	component := c.component
+	for _, f := range fields {
+		if f.Key == "component" && f.Type == zapcore.StringType {
+			component = f.String
+		}
+	}
+	return &componentCore{Core: c.Core.With(fields), levels: c.levels, component: component}
+}
+
+func (c *componentCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.levels.enabled(c.component, ent.LoggerName, ent.Level) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestComponentLogLevels(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	levels := NewComponentLogLevels(zapcore.InfoLevel)
+	logger := zap.New(levels.WrapCore(core))
+
+	ibcLogger := logger.Named("root").Named("ibcwatch")
+	ibcSubLogger := ibcLogger.Named("poller")
+	procLogger := logger.Named("root").Named("processor")
+	acctLogger := logger.With(zap.String("component", "gacct"))
+
+	logAll := func() []string {
+		logs.TakeAll()
+		ibcLogger.Debug("ibc debug")
+		ibcSubLogger.Debug("ibc poller debug")
+		procLogger.Debug("processor debug")
+		procLogger.Info("processor info")
+		acctLogger.Debug("accountant debug")
+		acctLogger.Named("ibcwatch").Debug("accountant named ibcwatch debug")
+		var msgs []string
+		for _, e := range logs.TakeAll() {
+			msgs = append(msgs, e.Message)
+		}
+		return msgs
+	}
+
+	assert.Equal(t, []string{"processor info"}, logAll())
+	assert.False(t, logger.Core().Enabled(zapcore.DebugLevel))
+
+	levels.SetLevel("ibcwatch", zapcore.DebugLevel)
+	assert.True(t, logger.Core().Enabled(zapcore.DebugLevel))
+	assert.Equal(t, []string{"ibc debug", "ibc poller debug", "processor info", "accountant named ibcwatch debug"}, logAll())
+
+	// The component field takes precedence over the name of the logger.
+	levels.SetLevel("gacct", zapcore.WarnLevel)
+	assert.Equal(t, []string{"ibc debug", "ibc poller debug", "processor info"}, logAll())
+
+	// The most specific segment of the name takes precedence.
+	levels.SetLevel("poller", zapcore.InfoLevel)
+	assert.Equal(t, []string{"ibc debug", "processor info"}, logAll())
+
+	levels.SetLevel("processor", zapcore.ErrorLevel)
+	assert.Equal(t, []string{"ibc debug"}, logAll())
+
+	assert.Equal(t, []ComponentLevel{
+		{Component: "gacct", Level: zapcore.WarnLevel},
+		{Component: "ibcwatch", Level: zapcore.DebugLevel},
+		{Component: "poller", Level: zapcore.InfoLevel},
+		{Component: "processor", Level: zapcore.ErrorLevel},
+	}, levels.Levels())
+
+	for _, c := range []string{"gacct", "ibcwatch", "poller", "processor"} {
+		levels.ResetLevel(c)
+	}
+	assert.Equal(t, []string{"processor info"}, logAll())
+	assert.False(t, logger.Core().Enabled(zapcore.DebugLevel))
+	assert.Empty(t, levels.Levels())
+}
@@ -8,6 +8,24 @@ import (
 
 const ObsvReqChannelSize = 50
 
+const (
+	// ObservationRequestSourceLocal is the source of observation requests issued by this node and broadcast to the network.
+	ObservationRequestSourceLocal = "local"
+	// ObservationRequestSourceAccountant is the source of local-only observation requests issued by the accountant.
+	ObservationRequestSourceAccountant = "accountant"
+)
+
+// InboundObservationRequest is an observation request destined for the local watchers, together with its origin.
+type InboundObservationRequest struct {
+	Request *gossipv1.ObservationRequest
+	// Source is one of the ObservationRequestSource* constants for requests issued by this node, the hex-encoded address
+	// of the guardian that signed the request, or "envelope:" followed by the hex-encoded public key of the non-guardian
+	// producer of a request received in a signed envelope.
+	Source string
+	// EnvelopeSigner is the Ed25519 public key of the producer of a request received in a signed envelope, nil otherwise.
+	EnvelopeSigner []byte
+}
+
 var ErrChanFull = errors.New("channel is full")
 
 func PostObservationRequest(obsvReqSendC chan<- *gossipv1.ObservationRequest, req *gossipv1.ObservationRequest) error {
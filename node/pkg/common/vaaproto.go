@@ -0,0 +1,87 @@
+package common
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// VAAToProto converts a VAA into its protobuf representation.
+func VAAToProto(v *vaa.VAA) (*publicrpcv1.VAA, error) {
+	if v.Timestamp.Unix() < 0 || v.Timestamp.Unix() > math.MaxUint32 {
+		return nil, fmt.Errorf("timestamp out of range: %v", v.Timestamp)
+	}
+
+	p := &publicrpcv1.VAA{
+		Version:          uint32(v.Version),
+		GuardianSetIndex: v.GuardianSetIndex,
+		Signatures:       make([]*publicrpcv1.VAASignature, len(v.Signatures)),
+		Timestamp:        uint32(v.Timestamp.Unix()),
+		Nonce:            v.Nonce,
+		Sequence:         v.Sequence,
+		ConsistencyLevel: uint32(v.ConsistencyLevel),
+		EmitterChain:     publicrpcv1.ChainID(v.EmitterChain),
+		EmitterAddress:   v.EmitterAddress.Bytes(),
+		Payload:          v.Payload,
+	}
+
+	for i, sig := range v.Signatures {
+		p.Signatures[i] = &publicrpcv1.VAASignature{
+			GuardianIndex: uint32(sig.Index),
+			Signature:     sig.Signature[:],
+		}
+	}
+
+	return p, nil
+}
+
+// VAAFromProto converts the protobuf representation of a VAA back into a VAA. It returns an error if any field does not
+// fit into the corresponding VAA field.
+func VAAFromProto(p *publicrpcv1.VAA) (*vaa.VAA, error) {
+	if p.Version > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid version: %d", p.Version)
+	}
+	if p.ConsistencyLevel > math.MaxUint8 {
+		return nil, fmt.Errorf("invalid consistency level: %d", p.ConsistencyLevel)
+	}
+	if p.EmitterChain < 0 || p.EmitterChain > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid emitter chain: %d", p.EmitterChain)
+	}
+	if len(p.EmitterAddress) != 32 {
+		return nil, fmt.Errorf("emitter address must be 32 bytes, is %d", len(p.EmitterAddress))
+	}
+
+	v := &vaa.VAA{
+		Version:          uint8(p.Version),
+		GuardianSetIndex: p.GuardianSetIndex,
+		Signatures:       make([]*vaa.Signature, len(p.Signatures)),
+		Timestamp:        time.Unix(int64(p.Timestamp), 0),
+		Nonce:            p.Nonce,
+		Sequence:         p.Sequence,
+		ConsistencyLevel: uint8(p.ConsistencyLevel),
+		EmitterChain:     vaa.ChainID(p.EmitterChain),
+		Payload:          p.Payload,
+	}
+	copy(v.EmitterAddress[:], p.EmitterAddress)
+
+	if v.Payload == nil {
+		// Match vaa.Unmarshal, which never returns a nil payload.
+		v.Payload = []byte{}
+	}
+
+	for i, sig := range p.Signatures {
+		if sig.GuardianIndex > math.MaxUint8 {
+			return nil, fmt.Errorf("invalid guardian index for signature %d: %d", i, sig.GuardianIndex)
+		}
+		if len(sig.Signature) != 65 {
+			return nil, fmt.Errorf("signature %d must be 65 bytes, is %d", i, len(sig.Signature))
+		}
+		v.Signatures[i] = &vaa.Signature{Index: uint8(sig.GuardianIndex)}
+		copy(v.Signatures[i].Signature[:], sig.Signature)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/protobuf/proto"
+)
+
+func newVAAForProtoTest(t *testing.T) *vaa.VAA {
+	t.Helper()
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 3,
+		Signatures:       []*vaa.Signature{},
+		Timestamp:        time.Unix(1654543099, 0),
+		Nonce:            7,
+		Sequence:         1 << 60,
+		ConsistencyLevel: 32,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   vaa.Address{1, 2, 3},
+		Payload:          []byte{0xde, 0xad},
+	}
+
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	v.AddSignature(key, 0)
+	v.AddSignature(key, 5)
+	return v
+}
+
+func TestVAAProtoRoundTrip(t *testing.T) {
+	v := newVAAForProtoTest(t)
+
+	p, err := VAAToProto(v)
+	require.NoError(t, err)
+	assert.Equal(t, publicrpcv1.ChainID_CHAIN_ID_ETHEREUM, p.EmitterChain)
+	assert.Equal(t, uint32(1654543099), p.Timestamp)
+	require.Equal(t, 2, len(p.Signatures))
+	assert.Equal(t, uint32(5), p.Signatures[1].GuardianIndex)
+
+	// Also go through the wire format.
+	b, err := proto.Marshal(p)
+	require.NoError(t, err)
+	var p2 publicrpcv1.VAA
+	require.NoError(t, proto.Unmarshal(b, &p2))
+
+	v2, err := VAAFromProto(&p2)
+	require.NoError(t, err)
+	assert.Equal(t, v, v2)
+	assert.Equal(t, v.SigningDigest(), v2.SigningDigest())
+}
+
+func TestVAAProtoEmptyPayload(t *testing.T) {
+	v := newVAAForProtoTest(t)
+	v.Payload = []byte{}
+
+	p, err := VAAToProto(v)
+	require.NoError(t, err)
+	b, err := proto.Marshal(p)
+	require.NoError(t, err)
+	var p2 publicrpcv1.VAA
+	require.NoError(t, proto.Unmarshal(b, &p2))
+
+	v2, err := VAAFromProto(&p2)
+	require.NoError(t, err)
+	assert.Equal(t, v, v2)
+}
+
+func TestVAAToProtoTimestampOutOfRange(t *testing.T) {
+	v := newVAAForProtoTest(t)
+	v.Timestamp = time.Unix(1<<33, 0)
+	_, err := VAAToProto(v)
+	assert.Error(t, err)
+}
+
+func TestVAAFromProtoErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *publicrpcv1.VAA)
+	}{
+		{"version", func(p *publicrpcv1.VAA) { p.Version = 256 }},
+		{"consistency level", func(p *publicrpcv1.VAA) { p.ConsistencyLevel = 256 }},
+		{"emitter chain", func(p *publicrpcv1.VAA) { p.EmitterChain = 1 << 16 }},
+		{"negative emitter chain", func(p *publicrpcv1.VAA) { p.EmitterChain = -1 }},
+		{"emitter address", func(p *publicrpcv1.VAA) { p.EmitterAddress = []byte{1, 2, 3} }},
+		{"guardian index", func(p *publicrpcv1.VAA) { p.Signatures[0].GuardianIndex = 256 }},
+		{"signature", func(p *publicrpcv1.VAA) { p.Signatures[0].Signature = []byte{1} }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := VAAToProto(newVAAForProtoTest(t))
+			require.NoError(t, err)
+			tc.modify(p)
+			_, err = VAAFromProto(p)
+			assert.Error(t, err)
+		})
+	}
+}
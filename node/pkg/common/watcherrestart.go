@@ -0,0 +1,100 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// ErrWatcherRestartRequested is returned by a watcher runnable wrapped by a WatcherRestarter when a restart of its
+// chain was requested, so the supervisor restarts it.
+var ErrWatcherRestartRequested = errors.New("watcher restart requested")
+
+// watcherRestartWarnInterval is how often a warning is logged while waiting for a watcher to return after its context
+// was canceled for a restart. The replacement is only started once the old watcher returned, so that two instances
+// never publish to the message channel of the chain at the same time.
+const watcherRestartWarnInterval = 30 * time.Second
+
+// WatcherRestarter lets an operator restart the watchers of a single chain, for instance when its RPC endpoint wedges,
+// without restarting the whole node.
+type WatcherRestarter struct {
+	logger  *zap.Logger
+	mu      sync.Mutex
+	restart map[vaa.ChainID][]chan struct{}
+}
+
+func NewWatcherRestarter(logger *zap.Logger) *WatcherRestarter {
+	return &WatcherRestarter{logger: logger, restart: make(map[vaa.ChainID][]chan struct{})}
+}
+
+// Wrap returns a runnable that runs the watcher and returns ErrWatcherRestartRequested when a restart of one of the
+// chains it watches is requested. A chain can have several watcher runnables, which are all restarted together.
+func (r *WatcherRestarter) Wrap(runnable supervisor.Runnable, chainIDs ...vaa.ChainID) supervisor.Runnable {
+	restartC := make(chan struct{}, 1)
+	r.mu.Lock()
+	for _, chainID := range chainIDs {
+		r.restart[chainID] = append(r.restart[chainID], restartC)
+	}
+	r.mu.Unlock()
+
+	return func(ctx context.Context) error {
+		// Ignore a restart requested while the watcher was not running.
+		select {
+		case <-restartC:
+		default:
+		}
+
+		watcherCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		errC := make(chan error, 1)
+		go func() {
+			errC <- runnable(watcherCtx)
+		}()
+
+		select {
+		case err := <-errC:
+			return err
+		case <-restartC:
+		}
+
+		r.logger.Info("restarting watcher", zap.Any("chains", chainIDs))
+		cancel()
+		ticker := time.NewTicker(watcherRestartWarnInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-errC:
+				return ErrWatcherRestartRequested
+			case <-ticker.C:
+				r.logger.Warn("watcher has not stopped yet, waiting for it before restarting it", zap.Any("chains", chainIDs))
+			}
+		}
+	}
+}
+
+// Restart requests a restart of the watchers of the chain. It returns an error if no watcher runs for the chain.
+func (r *WatcherRestarter) Restart(chainID vaa.ChainID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	chans, exists := r.restart[chainID]
+	if !exists {
+		return fmt.Errorf("no watcher is running for chain %v", chainID)
+	}
+
+	for _, c := range chans {
+		// A restart that is already pending is not requested twice.
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
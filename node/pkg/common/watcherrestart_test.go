@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestWatcherRestarter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r := NewWatcherRestarter(zap.NewNop())
+
+	started := make(chan struct{}, 2)
+	watcher := func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	confirmed := r.Wrap(watcher, vaa.ChainIDSolana)
+	finalized := r.Wrap(watcher, vaa.ChainIDSolana)
+
+	errC := make(chan error, 2)
+	go func() { errC <- confirmed(ctx) }()
+	go func() { errC <- finalized(ctx) }()
+	<-started
+	<-started
+
+	assert.ErrorContains(t, r.Restart(vaa.ChainIDEthereum), "no watcher is running for chain ethereum")
+
+	// Both watchers of the chain are restarted.
+	require.NoError(t, r.Restart(vaa.ChainIDSolana))
+	assert.ErrorIs(t, <-errC, ErrWatcherRestartRequested)
+	assert.ErrorIs(t, <-errC, ErrWatcherRestartRequested)
+
+	// A restart requested while the watcher is not running is ignored when it starts again.
+	require.NoError(t, r.Restart(vaa.ChainIDSolana))
+	runCtx, runCancel := context.WithCancel(ctx)
+	go func() { errC <- confirmed(runCtx) }()
+	<-started
+	runCancel()
+	assert.ErrorIs(t, <-errC, context.Canceled)
+}
+
+func TestWatcherRestarterReturnsWatcherError(t *testing.T) {
+	r := NewWatcherRestarter(zap.NewNop())
+	watcherErr := errors.New("rpc failed")
+	runnable := r.Wrap(func(ctx context.Context) error { return watcherErr }, vaa.ChainIDEthereum)
+	assert.ErrorIs(t, runnable(context.Background()), watcherErr)
+}
+
+func TestWatcherRestarterWaitsForWatcher(t *testing.T) {
+	r := NewWatcherRestarter(zap.NewNop())
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	stopped := make(chan struct{})
+	runnable := r.Wrap(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		// Simulate a watcher that takes a while to notice the cancellation.
+		<-release
+		close(stopped)
+		return ctx.Err()
+	}, vaa.ChainIDEthereum)
+
+	errC := make(chan error, 1)
+	go func() { errC <- runnable(context.Background()) }()
+	<-started
+
+	require.NoError(t, r.Restart(vaa.ChainIDEthereum))
+	select {
+	case <-errC:
+		t.Fatal("the restart should wait for the watcher to return")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	assert.ErrorIs(t, <-errC, ErrWatcherRestartRequested)
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("the watcher should have returned")
+	}
+}
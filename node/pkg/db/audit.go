@@ -0,0 +1,59 @@
+package db
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+const auditEntry = "AUDIT:"
+
+// auditSeq disambiguates audit entries stored within the same nanosecond.
+var auditSeq uint64
+
+// auditEntryKey returns a key that sorts audit entries chronologically.
+func auditEntryKey(ts time.Time, seq uint64) []byte {
+	return []byte(fmt.Sprintf("%v%020d:%020d", auditEntry, ts.UnixNano(), seq))
+}
+
+// StoreAuditEntry appends a serialized admin audit log entry to the database.
+func (d *Database) StoreAuditEntry(ts time.Time, entry []byte) error {
+	key := auditEntryKey(ts, atomic.AddUint64(&auditSeq, 1))
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, entry)
+	}); err != nil {
+		return fmt.Errorf("failed to store audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuditEntries returns up to limit serialized audit log entries for which filter returns true, newest first.
+// A nil filter matches all entries.
+func (d *Database) GetAuditEntries(limit int, filter func(entry []byte) bool) ([][]byte, error) {
+	entries := make([][]byte, 0)
+	prefixBytes := []byte(auditEntry)
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Reverse = true
+		opts.Prefix = prefixBytes
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		// In reverse mode, Seek finds the largest key smaller or equal to the argument.
+		for it.Seek(append(prefixBytes, 0xff)); it.ValidForPrefix(prefixBytes) && len(entries) < limit; it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if filter == nil || filter(val) {
+				entries = append(entries, val)
+			}
+		}
+		return nil
+	})
+
+	return entries, err
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEntryKeyOrdering(t *testing.T) {
+	k1 := auditEntryKey(time.Unix(1, 0), 10)
+	k2 := auditEntryKey(time.Unix(10, 0), 2)
+	k3 := auditEntryKey(time.Unix(10, 0), 3)
+	assert.Equal(t, -1, bytes.Compare(k1, k2))
+	assert.Equal(t, -1, bytes.Compare(k2, k3))
+}
+
+func TestStoreAndGetAuditEntries(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	entries, err := db.GetAuditEntries(10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.StoreAuditEntry(now.Add(time.Duration(i)*time.Second), []byte(fmt.Sprintf("entry%d", i))))
+	}
+
+	// Unrelated keys sorting before and after the audit prefix must not be returned.
+	require.NoError(t, db.db.Update(func(txn *badger.Txn) error {
+		if err := txn.Set([]byte("ACCT:x"), []byte("acct")); err != nil {
+			return err
+		}
+		return txn.Set([]byte("GOV:x"), []byte("gov"))
+	}))
+
+	entries, err = db.GetAuditEntries(3, nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("entry4"), []byte("entry3"), []byte("entry2")}, entries)
+
+	entries, err = db.GetAuditEntries(10, func(entry []byte) bool { return bytes.HasSuffix(entry, []byte("1")) })
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("entry1")}, entries)
+}
@@ -0,0 +1,73 @@
+package db
+
+// Backups are taken with the badger backup format while the node is running. A backup is read from a single snapshot of
+// the database, so it is consistent as of the time it was started, even though the node keeps writing to the database
+// while it is being streamed. Backups are not encrypted, even if the database is, so they must be stored as carefully
+// as the database itself.
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// Backup writes a full backup of the database to w, as of the time it is called. It returns the version of the
+// database the backup was taken at.
+func (d *Database) Backup(w io.Writer) (uint64, error) {
+	version, err := d.db.Backup(w, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to back up database: %w", err)
+	}
+	return version, nil
+}
+
+// RestoreDatabase creates a new database at dstPath from a backup written by Backup. The database is encrypted at rest
+// with key, unless it is nil. dstPath must not exist or be empty, so a restore never overwrites a database. If the
+// restore fails, dstPath is removed, so that a partially restored database is never opened by mistake.
+func RestoreDatabase(r io.Reader, dstPath string, key []byte) (err error) {
+	if err := checkEmptyDir(dstPath); err != nil {
+		return err
+	}
+
+	opts := badger.DefaultOptions(dstPath)
+	if key != nil {
+		opts = encryptedOptions(dstPath, key)
+	}
+
+	dst, err := badger.Open(opts)
+	if err != nil {
+		os.RemoveAll(dstPath)
+		return fmt.Errorf("failed to create database: %w", err)
+	}
+
+	defer func() {
+		// Badger panics on some malformed backups instead of returning an error.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to load backup: %v", r)
+		}
+		dst.Close()
+		if err != nil {
+			os.RemoveAll(dstPath)
+		}
+	}()
+
+	if err := dst.Load(r, maxPendingWrites); err != nil {
+		return fmt.Errorf("failed to load backup: %w", err)
+	}
+
+	return nil
+}
+
+// checkEmptyDir returns an error if path is a directory with entries, so that a new database is never written over an
+// existing one.
+func checkEmptyDir(path string) error {
+	if entries, err := os.ReadDir(path); err == nil && len(entries) != 0 {
+		return fmt.Errorf("destination %s is not empty", path)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
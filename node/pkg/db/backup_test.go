@@ -0,0 +1,84 @@
+package db
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	src, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer src.Close()
+
+	v := getVAA()
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	v.AddSignature(privKey, 0)
+	require.NoError(t, src.StoreSignedVAA(&v))
+
+	var backup bytes.Buffer
+	version, err := src.Backup(&backup)
+	require.NoError(t, err)
+	assert.NotZero(t, version)
+
+	// Writes after the backup was taken are not part of it.
+	later := getVAA()
+	later.Sequence++
+	later.AddSignature(privKey, 0)
+	require.NoError(t, src.StoreSignedVAA(&later))
+
+	expected, err := v.Marshal()
+	require.NoError(t, err)
+
+	t.Run("plaintext", func(t *testing.T) {
+		dstPath := filepath.Join(t.TempDir(), "restored")
+		require.NoError(t, RestoreDatabase(bytes.NewReader(backup.Bytes()), dstPath, nil))
+
+		// A restore never overwrites a database.
+		assert.ErrorContains(t, RestoreDatabase(bytes.NewReader(backup.Bytes()), dstPath, nil), "not empty")
+
+		dst, err := Open(dstPath)
+		require.NoError(t, err)
+		defer dst.Close()
+
+		b, err := dst.GetSignedVAABytes(*VaaIDFromVAA(&v))
+		require.NoError(t, err)
+		assert.Equal(t, expected, b)
+
+		_, err = dst.GetSignedVAABytes(*VaaIDFromVAA(&later))
+		assert.ErrorIs(t, err, ErrVAANotFound)
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		dstPath := filepath.Join(t.TempDir(), "restored")
+		key := bytes.Repeat([]byte{0x42}, EncryptionKeySize)
+		require.NoError(t, RestoreDatabase(bytes.NewReader(backup.Bytes()), dstPath, key))
+
+		_, err := Open(dstPath)
+		assert.Error(t, err)
+
+		dst, err := OpenEncrypted(dstPath, key)
+		require.NoError(t, err)
+		defer dst.Close()
+
+		b, err := dst.GetSignedVAABytes(*VaaIDFromVAA(&v))
+		require.NoError(t, err)
+		assert.Equal(t, expected, b)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		dstPath := filepath.Join(t.TempDir(), "restored")
+		assert.Error(t, RestoreDatabase(bytes.NewReader([]byte("not a backup")), dstPath, nil))
+
+		// The partially restored database is removed.
+		_, err := os.Stat(dstPath)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -3,15 +3,18 @@ package db
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
 type Database struct {
-	db *badger.DB
+	db   *badger.DB
+	feed signedVAAFeed
 }
 
 type VAAID struct {
@@ -86,10 +89,21 @@ func Open(path string) (*Database, error) {
 }
 
 func (d *Database) Close() error {
+	d.feed.close()
 	return d.db.Close()
 }
 
 func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
+	return d.storeSignedVAA(v, 0)
+}
+
+// StoreSignedVAAWithTTL is like StoreSignedVAA, but the entry is deleted once ttl has passed. It is meant for caches
+// of VAAs received from the network, like the spy's.
+func (d *Database) StoreSignedVAAWithTTL(v *vaa.VAA, ttl time.Duration) error {
+	return d.storeSignedVAA(v, ttl)
+}
+
+func (d *Database) storeSignedVAA(v *vaa.VAA, ttl time.Duration) error {
 	if len(v.Signatures) == 0 {
 		panic("StoreSignedVAA called for unsigned VAA")
 	}
@@ -103,8 +117,13 @@ func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 	//
 	// TODO: panic on non-identical signing digest?
 
+	id := VaaIDFromVAA(v)
 	err := d.db.Update(func(txn *badger.Txn) error {
-		if err := txn.Set(VaaIDFromVAA(v).Bytes(), b); err != nil {
+		entry := badger.NewEntry(id.Bytes(), b)
+		if ttl != 0 {
+			entry = entry.WithTTL(ttl)
+		}
+		if err := txn.SetEntry(entry); err != nil {
 			return err
 		}
 		return nil
@@ -114,6 +133,7 @@ func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 		return fmt.Errorf("failed to commit tx: %w", err)
 	}
 
+	d.feed.publish(*id)
 	return nil
 }
 
@@ -138,6 +158,109 @@ func (d *Database) GetSignedVAABytes(id VAAID) (b []byte, err error) {
 	return
 }
 
+// DeleteSignedVAA deletes a stored VAA. It does not fail if the VAA is not stored.
+func (d *Database) DeleteSignedVAA(id VAAID) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(id.Bytes())
+	}); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// GetSignedVAABytesFromSequence returns the stored VAAs of the emitter in start with a sequence of at least
+// start.Sequence, ordered by sequence.
+func (d *Database) GetSignedVAABytesFromSequence(start VAAID) ([][]byte, error) {
+	type entry struct {
+		sequence uint64
+		b        []byte
+	}
+	entries := make([]entry, 0)
+
+	if err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		prefix := append(start.EmitterPrefixBytes(), '/')
+
+		// The keys are ordered lexicographically rather than numerically, so all entries of the emitter are scanned.
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			seq, err := strconv.ParseUint(string(item.Key()[len(prefix):]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid key %s: %w", string(item.Key()), err)
+			}
+			if seq < start.Sequence {
+				continue
+			}
+			b, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry{seq, b})
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].sequence < entries[j].sequence })
+
+	ret := make([][]byte, len(entries))
+	for i, e := range entries {
+		ret[i] = e.b
+	}
+	return ret, nil
+}
+
+// PruneSignedVAAs deletes the stored VAAs of the emitter in emitter with the lowest sequences, so that at most keep
+// remain. It returns the number of VAAs deleted. It is meant to bound caches of VAAs received from the network.
+func (d *Database) PruneSignedVAAs(emitter VAAID, keep int) (int, error) {
+	prefix := append(emitter.EmitterPrefixBytes(), '/')
+
+	var seqs []uint64
+	if err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := it.Item().Key()
+			seq, err := strconv.ParseUint(string(key[len(prefix):]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid key %s: %w", string(key), err)
+			}
+			seqs = append(seqs, seq)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	if len(seqs) <= keep {
+		return 0, nil
+	}
+
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	seqs = seqs[:len(seqs)-keep]
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		for _, seq := range seqs {
+			id := emitter
+			id.Sequence = seq
+			if err := txn.Delete(id.Bytes()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	return len(seqs), nil
+}
+
 func (d *Database) FindEmitterSequenceGap(prefix VAAID) (resp []uint64, firstSeq uint64, lastSeq uint64, err error) {
 	resp = make([]uint64, 0)
 	if err = d.db.View(func(txn *badger.Txn) error {
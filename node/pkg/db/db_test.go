@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func getVAA() vaa.VAA {
@@ -164,3 +165,131 @@ func TestFindEmitterSequenceGap(t *testing.T) {
 	assert.Equal(t, uint64(0x1), lastSeq)
 	assert.NoError(t, err)
 }
+
+func TestGetSignedVAABytesFromSequence(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+
+	// Sequences that sort differently as strings and as numbers.
+	for _, seq := range []uint64{1, 2, 10, 11, 100} {
+		v := getVAA()
+		v.Sequence = seq
+		v.AddSignature(privKey, 0)
+		require.NoError(t, db.StoreSignedVAA(&v))
+	}
+
+	// Another emitter on the same chain.
+	other := getVAA()
+	other.EmitterAddress = vaa.Address{1}
+	other.Sequence = 50
+	other.AddSignature(privKey, 0)
+	require.NoError(t, db.StoreSignedVAA(&other))
+
+	start := VaaIDFromVAA(&other)
+	start.EmitterAddress = getVAA().EmitterAddress
+	start.Sequence = 2
+
+	vaas, err := db.GetSignedVAABytesFromSequence(*start)
+	require.NoError(t, err)
+
+	seqs := make([]uint64, len(vaas))
+	for i, b := range vaas {
+		v, err := vaa.Unmarshal(b)
+		require.NoError(t, err)
+		assert.Equal(t, getVAA().EmitterAddress, v.EmitterAddress)
+		seqs[i] = v.Sequence
+	}
+	assert.Equal(t, []uint64{2, 10, 11, 100}, seqs)
+
+	start.Sequence = 101
+	vaas, err = db.GetSignedVAABytesFromSequence(*start)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(vaas))
+}
+
+func TestPruneSignedVAAs(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+
+	for _, seq := range []uint64{1, 2, 10, 11, 100} {
+		v := getVAA()
+		v.Sequence = seq
+		v.AddSignature(privKey, 0)
+		require.NoError(t, db.StoreSignedVAA(&v))
+	}
+
+	// Another emitter on the same chain, which is not pruned.
+	other := getVAA()
+	other.EmitterAddress = vaa.Address{1}
+	other.Sequence = 3
+	other.AddSignature(privKey, 0)
+	require.NoError(t, db.StoreSignedVAA(&other))
+
+	emitter := VaaIDFromVAA(&other)
+	emitter.EmitterAddress = getVAA().EmitterAddress
+
+	deleted, err := db.PruneSignedVAAs(*emitter, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, deleted)
+
+	emitter.Sequence = 0
+	vaas, err := db.GetSignedVAABytesFromSequence(*emitter)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vaas))
+	for i, seq := range []uint64{11, 100} {
+		v, err := vaa.Unmarshal(vaas[i])
+		require.NoError(t, err)
+		assert.Equal(t, seq, v.Sequence)
+	}
+
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(&other))
+	assert.NoError(t, err)
+
+	deleted, err = db.PruneSignedVAAs(*emitter, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+}
+
+func TestDeleteSignedVAA(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	v := getVAA()
+	v.AddSignature(privKey, 0)
+	require.NoError(t, db.StoreSignedVAA(&v))
+
+	id := *VaaIDFromVAA(&v)
+	require.NoError(t, db.DeleteSignedVAA(id))
+	_, err = db.GetSignedVAABytes(id)
+	assert.ErrorIs(t, err, ErrVAANotFound)
+
+	// Deleting it again is a no-op.
+	assert.NoError(t, db.DeleteSignedVAA(id))
+}
+
+func TestStoreSignedVAAWithTTL(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	v := getVAA()
+	v.AddSignature(privKey, 0)
+
+	require.NoError(t, db.StoreSignedVAAWithTTL(&v, time.Second))
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(&v))
+	require.NoError(t, err)
+
+	// Badger's TTL has a granularity of one second.
+	time.Sleep(2 * time.Second)
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(&v))
+	assert.ErrorIs(t, err, ErrVAANotFound)
+}
@@ -0,0 +1,102 @@
+package db
+
+// Badger can encrypt the database at rest with AES, using a key that is stored outside of the database directory. The
+// key is only used to encrypt the data keys that badger generates and rotates itself, so it never changes for the
+// lifetime of a database. An existing plaintext database can be encrypted by copying it with EncryptDatabase.
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+const (
+	// EncryptionKeySize is the size of the AES-256 key used to encrypt the database.
+	EncryptionKeySize = 32
+
+	// encryptedIndexCacheSize is the size of the index cache, which badger requires when encryption is enabled.
+	encryptedIndexCacheSize = 100 << 20
+
+	// maxPendingWrites is the number of pending writes when loading a backup into a database.
+	maxPendingWrites = 256
+)
+
+// OpenEncrypted opens the database at path, encrypting it at rest with the given key. A new database is encrypted from
+// the start. An existing database must have been created with the same key.
+func OpenEncrypted(path string, key []byte) (*Database, error) {
+	db, err := badger.Open(encryptedOptions(path, key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open encrypted database: %w", err)
+	}
+	return &Database{
+		db: db,
+	}, nil
+}
+
+func encryptedOptions(path string, key []byte) badger.Options {
+	return badger.DefaultOptions(path).WithEncryptionKey(key).WithIndexCacheSize(encryptedIndexCacheSize)
+}
+
+// ReadEncryptionKeyFile reads a database encryption key from a file containing EncryptionKeySize hex encoded bytes. The
+// file may be provided by a secret manager or a KMS agent, as long as it is readable when the node starts.
+func ReadEncryptionKeyFile(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database encryption key: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(b)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("database encryption key is not hex encoded: %w", err)
+	}
+
+	if len(key) != EncryptionKeySize {
+		return nil, fmt.Errorf("database encryption key must be %d bytes, got %d", EncryptionKeySize, len(key))
+	}
+
+	return key, nil
+}
+
+// EncryptDatabase copies the plaintext database at srcPath to a new database at dstPath, encrypted with the given key.
+// Entries with a TTL keep their expiry. The source database is left untouched and must not be in use.
+func EncryptDatabase(srcPath string, dstPath string, key []byte) error {
+	if err := checkEmptyDir(dstPath); err != nil {
+		return err
+	}
+
+	src, err := badger.Open(badger.DefaultOptions(srcPath).WithReadOnly(true))
+	if err != nil {
+		return fmt.Errorf("failed to open source database: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := badger.Open(encryptedOptions(dstPath, key))
+	if err != nil {
+		return fmt.Errorf("failed to create encrypted database: %w", err)
+	}
+	defer dst.Close()
+
+	r, w := io.Pipe()
+	backupErrC := make(chan error, 1)
+	go func() {
+		_, err := src.Backup(w, 0)
+		w.CloseWithError(err)
+		backupErrC <- err
+	}()
+
+	if err := dst.Load(r, maxPendingWrites); err != nil {
+		r.CloseWithError(err)
+		<-backupErrC
+		return fmt.Errorf("failed to write encrypted database: %w", err)
+	}
+
+	if err := <-backupErrC; err != nil {
+		return fmt.Errorf("failed to read source database: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEncryptionKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{0xab}, EncryptionKeySize)
+
+	path := filepath.Join(dir, "key")
+	require.NoError(t, os.WriteFile(path, []byte("0x"+hex.EncodeToString(key)+"\n"), 0600))
+	read, err := ReadEncryptionKeyFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, key, read)
+
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(key[:16])), 0600))
+	_, err = ReadEncryptionKeyFile(path)
+	assert.ErrorContains(t, err, "must be 32 bytes")
+
+	require.NoError(t, os.WriteFile(path, []byte("not hex"), 0600))
+	_, err = ReadEncryptionKeyFile(path)
+	assert.ErrorContains(t, err, "not hex encoded")
+
+	_, err = ReadEncryptionKeyFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestEncryptDatabase(t *testing.T) {
+	srcPath := t.TempDir()
+	dstPath := filepath.Join(t.TempDir(), "encrypted")
+	key := bytes.Repeat([]byte{0x42}, EncryptionKeySize)
+
+	src, err := Open(srcPath)
+	require.NoError(t, err)
+	v := getVAA()
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	v.AddSignature(privKey, 0)
+	require.NoError(t, src.StoreSignedVAA(&v))
+	require.NoError(t, src.Close())
+
+	require.NoError(t, EncryptDatabase(srcPath, dstPath, key))
+
+	// The destination must be empty.
+	assert.ErrorContains(t, EncryptDatabase(srcPath, dstPath, key), "not empty")
+
+	// The encrypted database can't be opened without the key.
+	_, err = Open(dstPath)
+	assert.Error(t, err)
+	_, err = OpenEncrypted(dstPath, bytes.Repeat([]byte{0x43}, EncryptionKeySize))
+	assert.Error(t, err)
+
+	dst, err := OpenEncrypted(dstPath, key)
+	require.NoError(t, err)
+	defer dst.Close()
+
+	expected, err := v.Marshal()
+	require.NoError(t, err)
+	b, err := dst.GetSignedVAABytes(*VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	assert.Equal(t, expected, b)
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// signedVAAFeed notifies its subscribers of the signed VAAs stored in the database, so integrations pushing VAAs do not
+// have to poll the database or hook into the processor.
+type signedVAAFeed struct {
+	mu     sync.Mutex
+	nextID uint64
+	subs   map[uint64]*SignedVAASubscription
+	closed bool
+}
+
+// SignedVAASubscription receives the IDs of the signed VAAs stored after it was created.
+type SignedVAASubscription struct {
+	// C receives the ID of every stored VAA. It is closed when the subscription is cancelled or the database is closed.
+	C <-chan VAAID
+
+	c       chan VAAID
+	feed    *signedVAAFeed
+	id      uint64
+	dropped atomic.Uint64
+}
+
+// Dropped returns the number of IDs which were not delivered because the channel was full.
+func (s *SignedVAASubscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe cancels the subscription and closes its channel. It can be called multiple times.
+func (s *SignedVAASubscription) Unsubscribe() {
+	s.feed.mu.Lock()
+	defer s.feed.mu.Unlock()
+
+	if _, exists := s.feed.subs[s.id]; exists {
+		delete(s.feed.subs, s.id)
+		close(s.c)
+	}
+}
+
+// SubscribeSignedVAAs returns a subscription receiving the ID of every signed VAA stored from now on, including the
+// VAAs which were already stored and are stored again. Storing a VAA never blocks on the subscribers: if the channel,
+// which holds bufferSize IDs, is full, the ID is dropped and counted by Dropped. Subscribers which cannot afford to miss
+// a VAA should read it back from the database, for instance with GetSignedVAABytesFromSequence, when IDs are dropped.
+func (d *Database) SubscribeSignedVAAs(bufferSize int) *SignedVAASubscription {
+	f := &d.feed
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c := make(chan VAAID, bufferSize)
+	sub := &SignedVAASubscription{C: c, c: c, feed: f, id: f.nextID}
+	f.nextID++
+
+	if f.closed {
+		close(c)
+		return sub
+	}
+	if f.subs == nil {
+		f.subs = make(map[uint64]*SignedVAASubscription)
+	}
+	f.subs[sub.id] = sub
+	return sub
+}
+
+// publish notifies the subscribers that a VAA was stored.
+func (f *signedVAAFeed) publish(id VAAID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, sub := range f.subs {
+		select {
+		case sub.c <- id:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// close cancels all the subscriptions. No subscription can be created afterwards.
+func (f *signedVAAFeed) close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for id, sub := range f.subs {
+		delete(f.subs, id)
+		close(sub.c)
+	}
+	f.closed = true
+}
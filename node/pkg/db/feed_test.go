@@ -0,0 +1,75 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeSignedVAAs(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	testVaa := getVAA()
+	testVaa.AddSignature(privKey, 0)
+
+	// VAAs stored before the subscription are not delivered.
+	require.NoError(t, db.StoreSignedVAA(&testVaa))
+
+	sub := db.SubscribeSignedVAAs(1)
+	other := db.SubscribeSignedVAAs(10)
+
+	testVaa.Sequence = 2
+	require.NoError(t, db.StoreSignedVAA(&testVaa))
+	testVaa.Sequence = 3
+	require.NoError(t, db.StoreSignedVAAWithTTL(&testVaa, time.Hour))
+
+	// The full subscription drops the IDs instead of blocking the writes.
+	id := <-sub.C
+	assert.Equal(t, uint64(2), id.Sequence)
+	assert.Len(t, sub.C, 0)
+	assert.Equal(t, uint64(1), sub.Dropped())
+
+	require.Len(t, other.C, 2)
+	assert.Equal(t, uint64(2), (<-other.C).Sequence)
+	assert.Equal(t, uint64(3), (<-other.C).Sequence)
+	assert.Equal(t, uint64(0), other.Dropped())
+
+	// The ID refers to the stored VAA.
+	b, err := db.GetSignedVAABytes(id)
+	require.NoError(t, err)
+	assert.NotEmpty(t, b)
+
+	sub.Unsubscribe()
+	sub.Unsubscribe()
+	_, open := <-sub.C
+	assert.False(t, open)
+
+	testVaa.Sequence = 4
+	require.NoError(t, db.StoreSignedVAA(&testVaa))
+	assert.Equal(t, uint64(4), (<-other.C).Sequence)
+}
+
+func TestSubscribeSignedVAAsClose(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+
+	sub := db.SubscribeSignedVAAs(1)
+	require.NoError(t, db.Close())
+
+	_, open := <-sub.C
+	assert.False(t, open)
+	sub.Unsubscribe()
+
+	// Subscribing to a closed database returns a closed channel.
+	_, open = <-db.SubscribeSignedVAAs(1).C
+	assert.False(t, open)
+}
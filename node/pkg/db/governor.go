@@ -2,12 +2,16 @@ package db
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/dgraph-io/badger/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"go.uber.org/zap"
@@ -22,6 +26,7 @@ type GovernorDB interface {
 	DeleteTransfer(t *Transfer) error
 	DeletePendingMsg(k *PendingTransfer) error
 	GetChainGovernorData(logger *zap.Logger) (transfers []*Transfer, pending []*PendingTransfer, err error)
+	GetSignedVAAsSince(logger *zap.Logger, emitterChain vaa.ChainID, emitterAddress vaa.Address, fromSequence uint64, since time.Time) ([]*vaa.VAA, error)
 }
 
 type MockGovernorDB struct {
@@ -47,6 +52,10 @@ func (d *MockGovernorDB) GetChainGovernorData(logger *zap.Logger) (transfers []*
 	return nil, nil, nil
 }
 
+func (d *MockGovernorDB) GetSignedVAAsSince(logger *zap.Logger, emitterChain vaa.ChainID, emitterAddress vaa.Address, fromSequence uint64, since time.Time) ([]*vaa.VAA, error) {
+	return nil, nil
+}
+
 type Transfer struct {
 	Timestamp      time.Time
 	Value          uint64
@@ -56,8 +65,17 @@ type Transfer struct {
 	EmitterAddress vaa.Address
 	MsgID          string
 	Hash           string
+	FastLane       bool // Published through the small transfer fast lane, so it does not count towards the daily limit.
+	// The chain whose limit the transfer counts against, if it is not the emitter chain, e.g. the source chain of a
+	// Wormhole Gateway transfer emitted on wormchain. ChainIDUnset otherwise.
+	AttributedChain vaa.ChainID
 }
 
+const (
+	transferFlagFastLane        = uint8(1)
+	transferFlagAttributedChain = uint8(2) // The flags byte is followed by the attributed chain.
+)
+
 func (t *Transfer) Marshal() ([]byte, error) {
 	buf := new(bytes.Buffer)
 
@@ -75,6 +93,20 @@ func (t *Transfer) Marshal() ([]byte, error) {
 	if len(t.Hash) > 0 {
 		buf.Write([]byte(t.Hash))
 	}
+	// The flags byte was added later, so it is only written when set to keep existing entries readable by older releases.
+	flags := uint8(0)
+	if t.FastLane {
+		flags |= transferFlagFastLane
+	}
+	if t.AttributedChain != vaa.ChainIDUnset {
+		flags |= transferFlagAttributedChain
+	}
+	if flags != 0 {
+		vaa.MustWrite(buf, binary.BigEndian, flags)
+	}
+	if t.AttributedChain != vaa.ChainIDUnset {
+		vaa.MustWrite(buf, binary.BigEndian, t.AttributedChain)
+	}
 	return buf.Bytes(), nil
 }
 
@@ -141,6 +173,20 @@ func UnmarshalTransfer(data []byte) (*Transfer, error) {
 		t.Hash = string(hash[:n])
 	}
 
+	if reader.Len() > 0 {
+		flags := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &flags); err != nil {
+			return nil, fmt.Errorf("failed to read flags: %w", err)
+		}
+		t.FastLane = flags&transferFlagFastLane != 0
+
+		if flags&transferFlagAttributedChain != 0 {
+			if err := binary.Read(reader, binary.BigEndian, &t.AttributedChain); err != nil {
+				return nil, fmt.Errorf("failed to read attributed chain id: %w", err)
+			}
+		}
+	}
+
 	return t, nil
 }
 
@@ -239,7 +285,12 @@ func UnmarshalPendingTransfer(data []byte) (*PendingTransfer, error) {
 const oldTransfer = "GOV:XFER:"
 const oldTransferLen = len(oldTransfer)
 
-const transfer = "GOV:XFER2:"
+const transferV2 = "GOV:XFER2:"
+const transferV2Len = len(transferV2)
+
+// Entries with the "GOV:XFER3" and "GOV:PENDING3" tags are sealed with a schema version and a checksum (see
+// sealGovernorEntry). Entries in the older formats are converted when the governor data is loaded.
+const transfer = "GOV:XFER3:"
 const transferLen = len(transfer)
 
 // Since we are changing the DB format of pending entries, we will use a new tag in the pending key field.
@@ -249,7 +300,10 @@ const transferLen = len(transfer)
 const oldPending = "GOV:PENDING:"
 const oldPendingLen = len(oldPending)
 
-const pending = "GOV:PENDING2:"
+const pendingV2 = "GOV:PENDING2:"
+const pendingV2Len = len(pendingV2)
+
+const pending = "GOV:PENDING3:"
 const pendingLen = len(pending)
 
 const minMsgIdLen = len("1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/0")
@@ -262,6 +316,10 @@ func oldTransferMsgID(t *Transfer) []byte {
 	return []byte(fmt.Sprintf("%v%v", oldTransfer, t.MsgID))
 }
 
+func transferV2MsgID(t *Transfer) []byte {
+	return []byte(fmt.Sprintf("%v%v", transferV2, t.MsgID))
+}
+
 func PendingMsgID(k *common.MessagePublication) []byte {
 	return []byte(fmt.Sprintf("%v%v", pending, k.MessageIDString()))
 }
@@ -270,6 +328,10 @@ func oldPendingMsgID(k *common.MessagePublication) []byte {
 	return []byte(fmt.Sprintf("%v%v", oldPending, k.MessageIDString()))
 }
 
+func pendingV2MsgID(k *common.MessagePublication) []byte {
+	return []byte(fmt.Sprintf("%v%v", pendingV2, k.MessageIDString()))
+}
+
 func IsTransfer(keyBytes []byte) bool {
 	return (len(keyBytes) >= transferLen+minMsgIdLen) && (string(keyBytes[0:transferLen]) == transfer)
 }
@@ -278,6 +340,10 @@ func isOldTransfer(keyBytes []byte) bool {
 	return (len(keyBytes) >= oldTransferLen+minMsgIdLen) && (string(keyBytes[0:oldTransferLen]) == oldTransfer)
 }
 
+func isTransferV2(keyBytes []byte) bool {
+	return (len(keyBytes) >= transferV2Len+minMsgIdLen) && (string(keyBytes[0:transferV2Len]) == transferV2)
+}
+
 func IsPendingMsg(keyBytes []byte) bool {
 	return (len(keyBytes) >= pendingLen+minMsgIdLen) && (string(keyBytes[0:pendingLen]) == pending)
 }
@@ -286,6 +352,109 @@ func isOldPendingMsg(keyBytes []byte) bool {
 	return (len(keyBytes) >= oldPendingLen+minMsgIdLen) && (string(keyBytes[0:oldPendingLen]) == oldPending)
 }
 
+func isPendingMsgV2(keyBytes []byte) bool {
+	return (len(keyBytes) >= pendingV2Len+minMsgIdLen) && (string(keyBytes[0:pendingV2Len]) == pendingV2)
+}
+
+// governorEntryVersion is the schema version of the governor entries written by this release.
+const governorEntryVersion = uint8(1)
+
+// pendingGatewayEntryVersion is the schema version of pending transfers whose message has a Gateway source chain. Their
+// payload is prefixed with the source chain, since it is not part of the marshaled message. Other entries keep the
+// previous version, so that they remain readable by older releases.
+const pendingGatewayEntryVersion = uint8(2)
+
+// governorEntryChecksumLen is the number of bytes of the SHA-256 digest appended to each governor entry.
+const governorEntryChecksumLen = 8
+
+var (
+	ErrGovernorEntryCorrupted          = errors.New("governor entry is corrupted")
+	ErrUnsupportedGovernorEntryVersion = errors.New("unsupported governor entry version")
+)
+
+// sealGovernorEntry wraps a marshaled transfer or pending transfer into the format stored in the database: the schema
+// version, the payload and a truncated SHA-256 checksum of both, so corrupted entries are detected on reload.
+func sealGovernorEntry(payload []byte) []byte {
+	return sealGovernorEntryVersion(governorEntryVersion, payload)
+}
+
+// sealGovernorEntryVersion is sealGovernorEntry with an explicit schema version.
+func sealGovernorEntryVersion(version uint8, payload []byte) []byte {
+	b := make([]byte, 0, 1+len(payload)+governorEntryChecksumLen)
+	b = append(b, version)
+	b = append(b, payload...)
+	digest := sha256.Sum256(b)
+	return append(b, digest[:governorEntryChecksumLen]...)
+}
+
+// openGovernorEntry verifies the checksum and schema version of an entry written by sealGovernorEntry and returns its payload.
+func openGovernorEntry(data []byte) ([]byte, error) {
+	version, payload, err := openVersionedGovernorEntry(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != governorEntryVersion {
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedGovernorEntryVersion, version)
+	}
+
+	return payload, nil
+}
+
+// openVersionedGovernorEntry verifies the checksum of an entry written by sealGovernorEntryVersion and returns its schema
+// version and payload.
+func openVersionedGovernorEntry(data []byte) (uint8, []byte, error) {
+	if len(data) < 1+governorEntryChecksumLen {
+		return 0, nil, fmt.Errorf("%w: entry too short", ErrGovernorEntryCorrupted)
+	}
+
+	body := data[:len(data)-governorEntryChecksumLen]
+	digest := sha256.Sum256(body)
+	if !bytes.Equal(digest[:governorEntryChecksumLen], data[len(body):]) {
+		return 0, nil, fmt.Errorf("%w: checksum mismatch", ErrGovernorEntryCorrupted)
+	}
+
+	return body[0], body[1:], nil
+}
+
+// sealPendingEntry returns the database entry of a pending transfer.
+func sealPendingEntry(p *PendingTransfer) []byte {
+	b, _ := p.Marshal()
+	if p.Msg.GatewaySourceChain == vaa.ChainIDUnset {
+		return sealGovernorEntry(b)
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, p.Msg.GatewaySourceChain)
+	buf.Write(b)
+	return sealGovernorEntryVersion(pendingGatewayEntryVersion, buf.Bytes())
+}
+
+// openPendingEntry verifies a database entry written by sealPendingEntry and returns the pending transfer.
+func openPendingEntry(data []byte) (*PendingTransfer, error) {
+	version, payload, err := openVersionedGovernorEntry(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case governorEntryVersion:
+		return UnmarshalPendingTransfer(payload)
+	case pendingGatewayEntryVersion:
+		if len(payload) < 2 {
+			return nil, fmt.Errorf("%w: entry too short", ErrGovernorEntryCorrupted)
+		}
+		p, err := UnmarshalPendingTransfer(payload[2:])
+		if err != nil {
+			return nil, err
+		}
+		p.Msg.GatewaySourceChain = vaa.ChainID(binary.BigEndian.Uint16(payload))
+		return p, nil
+	default:
+		return nil, fmt.Errorf("%w %d", ErrUnsupportedGovernorEntryVersion, version)
+	}
+}
+
 // This is called by the chain governor on start up to reload status.
 func (d *Database) GetChainGovernorData(logger *zap.Logger) (transfers []*Transfer, pending []*PendingTransfer, err error) {
 	return d.GetChainGovernorDataForTime(logger, time.Now())
@@ -293,7 +462,9 @@ func (d *Database) GetChainGovernorData(logger *zap.Logger) (transfers []*Transf
 
 func (d *Database) GetChainGovernorDataForTime(logger *zap.Logger, now time.Time) (transfers []*Transfer, pending []*PendingTransfer, err error) {
 	oldTransfers := []*Transfer{}
+	v2Transfers := []*Transfer{}
 	oldPendingToUpdate := []*PendingTransfer{}
+	v2PendingToUpdate := []*PendingTransfer{}
 	err = d.db.View(func(txn *badger.Txn) error {
 		opts := badger.DefaultIteratorOptions
 		opts.PrefetchSize = 10
@@ -308,7 +479,12 @@ func (d *Database) GetChainGovernorDataForTime(logger *zap.Logger, now time.Time
 			}
 
 			if IsPendingMsg(key) {
-				p, err := UnmarshalPendingTransfer(val)
+				p, err := openPendingEntry(val)
+				if errors.Is(err, ErrGovernorEntryCorrupted) || errors.Is(err, ErrUnsupportedGovernorEntryVersion) {
+					// A corrupted entry is skipped rather than preventing the governor from starting.
+					logger.Error("skipping corrupted database entry for pending vaa", zap.String("key", string(key)), zap.Error(err))
+					continue
+				}
 				if err != nil {
 					return err
 				}
@@ -323,12 +499,39 @@ func (d *Database) GetChainGovernorDataForTime(logger *zap.Logger, now time.Time
 
 				pending = append(pending, p)
 			} else if IsTransfer(key) {
+				payload, err := openGovernorEntry(val)
+				if err != nil {
+					// A missing transfer is repaired by the governor from the signed VAAs, see reconcileWithSignedVAAs.
+					logger.Error("skipping corrupted database entry for completed transfer", zap.String("key", string(key)), zap.Error(err))
+					continue
+				}
+
+				v, err := UnmarshalTransfer(payload)
+				if err != nil {
+					return err
+				}
+
+				transfers = append(transfers, v)
+			} else if isPendingMsgV2(key) {
+				p, err := UnmarshalPendingTransfer(val)
+				if err != nil {
+					return err
+				}
+
+				if time.Until(p.ReleaseTime) > maxEnqueuedTime {
+					p.ReleaseTime = now.Add(maxEnqueuedTime)
+				}
+
+				pending = append(pending, p)
+				v2PendingToUpdate = append(v2PendingToUpdate, p)
+			} else if isTransferV2(key) {
 				v, err := UnmarshalTransfer(val)
 				if err != nil {
 					return err
 				}
 
 				transfers = append(transfers, v)
+				v2Transfers = append(v2Transfers, v)
 			} else if isOldPendingMsg(key) {
 				msg, err := common.UnmarshalMessagePublication(val)
 				if err != nil {
@@ -349,46 +552,204 @@ func (d *Database) GetChainGovernorDataForTime(logger *zap.Logger, now time.Time
 			}
 		}
 
-		if len(oldPendingToUpdate) != 0 {
-			for _, pending := range oldPendingToUpdate {
-				logger.Info("updating format of database entry for pending vaa", zap.String("msgId", pending.Msg.MessageIDString()))
-				err := d.StorePendingMsg(pending)
-				if err != nil {
-					return fmt.Errorf("failed to write new pending msg for key [%v]: %w", pending.Msg.MessageIDString(), err)
-				}
+		for _, pending := range oldPendingToUpdate {
+			if err := d.updatePendingMsgFormat(logger, pending, oldPendingMsgID(&pending.Msg)); err != nil {
+				return err
+			}
+		}
 
-				key := oldPendingMsgID(&pending.Msg)
-				if err := d.db.Update(func(txn *badger.Txn) error {
-					err := txn.Delete(key)
-					return err
-				}); err != nil {
-					return fmt.Errorf("failed to delete old pending msg for key [%v]: %w", pending.Msg.MessageIDString(), err)
-				}
+		for _, pending := range v2PendingToUpdate {
+			if err := d.updatePendingMsgFormat(logger, pending, pendingV2MsgID(&pending.Msg)); err != nil {
+				return err
 			}
 		}
 
-		if len(oldTransfers) != 0 {
-			for _, xfer := range oldTransfers {
-				logger.Info("updating format of database entry for completed transfer", zap.String("msgId", xfer.MsgID))
-				err := d.StoreTransfer(xfer)
+		for _, xfer := range oldTransfers {
+			if err := d.updateTransferFormat(logger, xfer, oldTransferMsgID(xfer)); err != nil {
+				return err
+			}
+		}
+
+		for _, xfer := range v2Transfers {
+			if err := d.updateTransferFormat(logger, xfer, transferV2MsgID(xfer)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
+
+// DowngradeGovernorData rewrites the governor entries in the "GOV:XFER2" and "GOV:PENDING2" formats read by releases
+// which predate the sealed entries, so that a guardian can be downgraded without losing its transfers and enqueued VAAs.
+// The Gateway source chain of pending transfers is not part of the older format and is lost. Corrupted entries are
+// logged and skipped. It returns the number of transfers and pending transfers rewritten.
+func (d *Database) DowngradeGovernorData(logger *zap.Logger) (numTransfers int, numPending int, err error) {
+	type entry struct {
+		oldKey []byte
+		newKey []byte
+		value  []byte
+	}
+	var entries []entry
+
+	err = d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte("GOV:")
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			key := item.KeyCopy(nil)
+			if !IsTransfer(key) && !IsPendingMsg(key) {
+				continue
+			}
+
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			if IsTransfer(key) {
+				payload, err := openGovernorEntry(val)
 				if err != nil {
-					return fmt.Errorf("failed to write new completed transfer for key [%v]: %w", xfer.MsgID, err)
+					logger.Error("skipping corrupted database entry for completed transfer", zap.String("key", string(key)), zap.Error(err))
+					continue
 				}
-
-				key := oldTransferMsgID(xfer)
-				if err := d.db.Update(func(txn *badger.Txn) error {
-					err := txn.Delete(key)
-					return err
-				}); err != nil {
-					return fmt.Errorf("failed to delete old completed transfer for key [%v]: %w", xfer.MsgID, err)
+				xfer, err := UnmarshalTransfer(payload)
+				if err != nil {
+					logger.Error("skipping invalid database entry for completed transfer", zap.String("key", string(key)), zap.Error(err))
+					continue
 				}
+				entries = append(entries, entry{oldKey: key, newKey: transferV2MsgID(xfer), value: payload})
+				numTransfers++
+				continue
+			}
+
+			p, err := openPendingEntry(val)
+			if err != nil {
+				logger.Error("skipping corrupted database entry for pending vaa", zap.String("key", string(key)), zap.Error(err))
+				continue
+			}
+			if p.Msg.GatewaySourceChain != vaa.ChainIDUnset {
+				logger.Warn("dropping Gateway source chain of pending vaa, it will count against the wormchain limit after the downgrade",
+					zap.String("msgId", p.Msg.MessageIDString()), zap.Stringer("gatewaySourceChain", p.Msg.GatewaySourceChain))
+			}
+			b, err := p.Marshal()
+			if err != nil {
+				return fmt.Errorf("failed to marshal pending msg for key [%v]: %w", p.Msg.MessageIDString(), err)
 			}
+			entries = append(entries, entry{oldKey: key, newKey: pendingV2MsgID(&p.Msg), value: b})
+			numPending++
 		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
 
+	for _, e := range entries {
+		if err := d.db.Update(func(txn *badger.Txn) error {
+			if err := txn.Set(e.newKey, e.value); err != nil {
+				return err
+			}
+			return txn.Delete(e.oldKey)
+		}); err != nil {
+			return 0, 0, fmt.Errorf("failed to downgrade governor entry [%v]: %w", string(e.oldKey), err)
+		}
+	}
+
+	return numTransfers, numPending, nil
+}
+
+// updatePendingMsgFormat rewrites a pending transfer loaded from an older format in the current one and deletes the old entry.
+func (d *Database) updatePendingMsgFormat(logger *zap.Logger, pending *PendingTransfer, oldKey []byte) error {
+	logger.Info("updating format of database entry for pending vaa", zap.String("msgId", pending.Msg.MessageIDString()))
+	err := d.StorePendingMsg(pending)
+	if err != nil {
+		return fmt.Errorf("failed to write new pending msg for key [%v]: %w", pending.Msg.MessageIDString(), err)
+	}
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		err := txn.Delete(oldKey)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to delete old pending msg for key [%v]: %w", pending.Msg.MessageIDString(), err)
+	}
+
+	return nil
+}
+
+// updateTransferFormat rewrites a transfer loaded from an older format in the current one and deletes the old entry.
+func (d *Database) updateTransferFormat(logger *zap.Logger, xfer *Transfer, oldKey []byte) error {
+	logger.Info("updating format of database entry for completed transfer", zap.String("msgId", xfer.MsgID))
+	err := d.StoreTransfer(xfer)
+	if err != nil {
+		return fmt.Errorf("failed to write new completed transfer for key [%v]: %w", xfer.MsgID, err)
+	}
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		err := txn.Delete(oldKey)
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to delete old completed transfer for key [%v]: %w", xfer.MsgID, err)
+	}
+
+	return nil
+}
+
+// maxSignedVAAGap is the number of consecutive sequences without a signed VAA after which GetSignedVAAsSince assumes it
+// has reached the latest message of the emitter. Sequences can be missing a VAA because their message is still enqueued
+// by the governor or never reached a quorum.
+const maxSignedVAAGap = 1000
+
+var governorUnreadableSignedVAAs = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "wormhole_governor_unreadable_signed_vaas_total",
+		Help: "Total number of signed VAAs skipped by the governor on start up because they could not be read from the database",
+	})
+
+// GetSignedVAAsSince returns the stored VAAs of the emitter with a timestamp not before since, in sequence order. It is
+// used by the chain governor on start up to find transfers missing from its database entries. The VAAs are looked up by
+// sequence from fromSequence on until maxSignedVAAGap consecutive sequences have none, so only the recent history of the
+// emitter is read. VAAs which cannot be read are logged, counted and skipped.
+func (d *Database) GetSignedVAAsSince(logger *zap.Logger, emitterChain vaa.ChainID, emitterAddress vaa.Address, fromSequence uint64, since time.Time) ([]*vaa.VAA, error) {
+	var ret []*vaa.VAA
+	err := d.db.View(func(txn *badger.Txn) error {
+		id := VAAID{EmitterChain: emitterChain, EmitterAddress: emitterAddress}
+		gap := 0
+		for seq := fromSequence; gap < maxSignedVAAGap; seq++ {
+			id.Sequence = seq
+			item, err := txn.Get(id.Bytes())
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				gap++
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			gap = 0
+
+			b, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			v, err := vaa.Unmarshal(b)
+			if err != nil {
+				governorUnreadableSignedVAAs.Inc()
+				logger.Error("skipping unreadable signed VAA while reconciling governor transfers", zap.String("key", string(id.Bytes())), zap.Error(err))
+				continue
+			}
+			if v.Timestamp.Before(since) {
+				continue
+			}
+			ret = append(ret, v)
+		}
 		return nil
 	})
 
-	return
+	return ret, err
 }
 
 // This is called by the chain governor to persist a pending transfer.
@@ -396,7 +757,7 @@ func (d *Database) StoreTransfer(t *Transfer) error {
 	b, _ := t.Marshal()
 
 	err := d.db.Update(func(txn *badger.Txn) error {
-		if err := txn.Set(TransferMsgID(t), b); err != nil {
+		if err := txn.Set(TransferMsgID(t), sealGovernorEntry(b)); err != nil {
 			return err
 		}
 		return nil
@@ -411,10 +772,8 @@ func (d *Database) StoreTransfer(t *Transfer) error {
 
 // This is called by the chain governor to persist a pending transfer.
 func (d *Database) StorePendingMsg(pending *PendingTransfer) error {
-	b, _ := pending.Marshal()
-
 	err := d.db.Update(func(txn *badger.Txn) error {
-		if err := txn.Set(PendingMsgID(&pending.Msg), b); err != nil {
+		if err := txn.Set(PendingMsgID(&pending.Msg), sealPendingEntry(pending)); err != nil {
 			return err
 		}
 		return nil
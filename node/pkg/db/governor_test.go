@@ -2,6 +2,9 @@ package db
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 	"os"
@@ -12,6 +15,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/dgraph-io/badger/v3"
 	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -51,10 +55,86 @@ func TestSerializeAndDeserializeOfTransfer(t *testing.T) {
 
 	assert.Equal(t, xfer1, xfer2)
 
-	expectedTransferKey := "GOV:XFER2:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"
+	expectedTransferKey := "GOV:XFER3:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"
 	assert.Equal(t, expectedTransferKey, string(TransferMsgID(xfer2)))
 }
 
+func TestSerializeAndDeserializeOfFastLaneTransfer(t *testing.T) {
+	tokenAddr, err := vaa.StringToAddress("0x707f9118e33a9b8998bea41dd0d46f38bb963fc8")
+	require.NoError(t, err)
+
+	tokenBridgeAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	xfer1 := &Transfer{
+		Timestamp:      time.Unix(int64(1654516425), 0),
+		Value:          125000,
+		OriginChain:    vaa.ChainIDEthereum,
+		OriginAddress:  tokenAddr,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: tokenBridgeAddr,
+		MsgID:          "2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415",
+		Hash:           "Hash1",
+		FastLane:       true,
+	}
+
+	fastLaneBytes, err := xfer1.Marshal()
+	require.NoError(t, err)
+
+	xfer2, err := UnmarshalTransfer(fastLaneBytes)
+	require.NoError(t, err)
+	assert.Equal(t, xfer1, xfer2)
+
+	// Regular transfers keep the original layout, without the trailing flags byte.
+	xfer1.FastLane = false
+	regularBytes, err := xfer1.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, len(fastLaneBytes)-1, len(regularBytes))
+}
+
+func TestSerializeAndDeserializeOfAttributedTransfer(t *testing.T) {
+	tokenAddr, err := vaa.StringToAddress("0x707f9118e33a9b8998bea41dd0d46f38bb963fc8")
+	require.NoError(t, err)
+
+	tokenBridgeAddr, err := vaa.StringToAddress("0xaeb534c45c3049d380b9d9b966f9895f53abd4301bfaff407fa09dea8ae7a924")
+	require.NoError(t, err)
+
+	xfer1 := &Transfer{
+		Timestamp:       time.Unix(int64(1654516425), 0),
+		Value:           125000,
+		OriginChain:     vaa.ChainIDEthereum,
+		OriginAddress:   tokenAddr,
+		EmitterChain:    vaa.ChainIDWormchain,
+		EmitterAddress:  tokenBridgeAddr,
+		MsgID:           "3104/aeb534c45c3049d380b9d9b966f9895f53abd4301bfaff407fa09dea8ae7a924/789101112131415",
+		Hash:            "Hash1",
+		AttributedChain: vaa.ChainIDSei,
+	}
+
+	attributedBytes, err := xfer1.Marshal()
+	require.NoError(t, err)
+
+	xfer2, err := UnmarshalTransfer(attributedBytes)
+	require.NoError(t, err)
+	assert.Equal(t, xfer1, xfer2)
+
+	// The attributed chain follows the flags byte, and can be combined with the fast lane flag.
+	xfer1.FastLane = true
+	bothBytes, err := xfer1.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, len(attributedBytes), len(bothBytes))
+
+	xfer3, err := UnmarshalTransfer(bothBytes)
+	require.NoError(t, err)
+	assert.Equal(t, xfer1, xfer3)
+
+	xfer1.FastLane = false
+	xfer1.AttributedChain = vaa.ChainIDUnset
+	regularBytes, err := xfer1.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, len(attributedBytes)-3, len(regularBytes))
+}
+
 func TestPendingMsgID(t *testing.T) {
 	tokenBridgeAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
 	require.NoError(t, err)
@@ -70,7 +150,7 @@ func TestPendingMsgID(t *testing.T) {
 		ConsistencyLevel: 16,
 	}
 
-	assert.Equal(t, []byte("GOV:PENDING2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"), PendingMsgID(msg1))
+	assert.Equal(t, []byte("GOV:PENDING3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"), PendingMsgID(msg1))
 }
 
 func TestTransferMsgID(t *testing.T) {
@@ -91,37 +171,43 @@ func TestTransferMsgID(t *testing.T) {
 		Hash:           "Hash1",
 	}
 
-	assert.Equal(t, []byte("GOV:XFER2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"), TransferMsgID(xfer))
+	assert.Equal(t, []byte("GOV:XFER3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"), TransferMsgID(xfer))
 }
 
 func TestIsTransfer(t *testing.T) {
-	assert.Equal(t, true, IsTransfer([]byte("GOV:XFER2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
-	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER2:")))
-	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER2:1")))
-	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER2:1/1/1")))
-	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER2:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/")))
-	assert.Equal(t, true, IsTransfer([]byte("GOV:XFER2:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/0")))
+	assert.Equal(t, true, IsTransfer([]byte("GOV:XFER3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER3:")))
+	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER3:1")))
+	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER3:1/1/1")))
+	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER3:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/")))
+	assert.Equal(t, true, IsTransfer([]byte("GOV:XFER3:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/0")))
 	assert.Equal(t, false, IsTransfer([]byte("GOV:PENDING:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
 	assert.Equal(t, false, IsTransfer([]byte{0x01, 0x02, 0x03, 0x04}))
 	assert.Equal(t, false, IsTransfer([]byte{}))
 	assert.Equal(t, true, isOldTransfer([]byte("GOV:XFER:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
-	assert.Equal(t, false, isOldTransfer([]byte("GOV:XFER2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, isOldTransfer([]byte("GOV:XFER3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, true, isTransferV2([]byte("GOV:XFER2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, isTransferV2([]byte("GOV:XFER3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, IsTransfer([]byte("GOV:XFER2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
 
 }
 
 func TestIsPendingMsg(t *testing.T) {
-	assert.Equal(t, true, IsPendingMsg([]byte("GOV:PENDING2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
-	assert.Equal(t, false, IsPendingMsg([]byte("GOV:XFER2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
-	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING2:")))
-	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING2:"+"1")))
-	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING2:"+"1/1/1")))
-	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING2:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/")))
-	assert.Equal(t, true, IsPendingMsg([]byte("GOV:PENDING2:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/0")))
+	assert.Equal(t, true, IsPendingMsg([]byte("GOV:PENDING3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, IsPendingMsg([]byte("GOV:XFER3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING3:")))
+	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING3:"+"1")))
+	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING3:"+"1/1/1")))
+	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING3:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/")))
+	assert.Equal(t, true, IsPendingMsg([]byte("GOV:PENDING3:"+"1/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/0")))
 	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
 	assert.Equal(t, false, IsPendingMsg([]byte{0x01, 0x02, 0x03, 0x04}))
 	assert.Equal(t, false, IsPendingMsg([]byte{}))
 	assert.Equal(t, true, isOldPendingMsg([]byte("GOV:PENDING:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
-	assert.Equal(t, false, isOldPendingMsg([]byte("GOV:PENDING2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, isOldPendingMsg([]byte("GOV:PENDING3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, true, isPendingMsgV2([]byte("GOV:PENDING2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, isPendingMsgV2([]byte("GOV:PENDING3:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
+	assert.Equal(t, false, IsPendingMsg([]byte("GOV:PENDING2:"+"2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415")))
 }
 
 func TestGetChainGovernorData(t *testing.T) {
@@ -301,7 +387,7 @@ func TestSerializeAndDeserializeOfPendingTransfer(t *testing.T) {
 
 	assert.Equal(t, pending1, pending2)
 
-	expectedPendingKey := "GOV:PENDING2:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"
+	expectedPendingKey := "GOV:PENDING3:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"
 	assert.Equal(t, expectedPendingKey, string(PendingMsgID(&pending2.Msg)))
 }
 
@@ -572,7 +658,7 @@ func TestDeserializeOfOldTransfer(t *testing.T) {
 
 	assert.Equal(t, xfer1, xfer2)
 
-	expectedTransferKey := "GOV:XFER2:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"
+	expectedTransferKey := "GOV:XFER3:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"
 	assert.Equal(t, expectedTransferKey, string(TransferMsgID(xfer2)))
 }
 
@@ -660,3 +746,274 @@ func TestOldTransfersUpdatedWhenReloading(t *testing.T) {
 	assert.Equal(t, xfer1, xfers[0])
 	assert.Equal(t, xfer2, xfers[1])
 }
+
+func TestSealAndOpenGovernorEntry(t *testing.T) {
+	payload := []byte("governor entry")
+
+	sealed := sealGovernorEntry(payload)
+	assert.Equal(t, 1+len(payload)+governorEntryChecksumLen, len(sealed))
+	assert.Equal(t, governorEntryVersion, sealed[0])
+
+	opened, err := openGovernorEntry(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, payload, opened)
+
+	corrupted := append([]byte{}, sealed...)
+	corrupted[3] ^= 0x01
+	_, err = openGovernorEntry(corrupted)
+	assert.ErrorIs(t, err, ErrGovernorEntryCorrupted)
+
+	_, err = openGovernorEntry(sealed[:governorEntryChecksumLen])
+	assert.ErrorIs(t, err, ErrGovernorEntryCorrupted)
+
+	// An entry written by a future release with a valid checksum is rejected.
+	future := append([]byte{governorEntryVersion + 1}, payload...)
+	digest := sha256.Sum256(future)
+	future = append(future, digest[:governorEntryChecksumLen]...)
+	_, err = openGovernorEntry(future)
+	assert.ErrorContains(t, err, "unsupported governor entry version")
+}
+
+func (d *Database) setRawGovernorEntry(t *testing.T, key []byte, val []byte) {
+	err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, val)
+	})
+	require.NoError(t, err)
+}
+
+func TestV2EntriesUpdatedWhenReloading(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	tokenBridgeAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	tokenAddr, err := vaa.StringToAddress("0x707f9118e33a9b8998bea41dd0d46f38bb963fc8")
+	require.NoError(t, err)
+
+	xfer := &Transfer{
+		Timestamp:      time.Unix(int64(1654516425), 0),
+		Value:          125000,
+		OriginChain:    vaa.ChainIDEthereum,
+		OriginAddress:  tokenAddr,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: tokenBridgeAddr,
+		MsgID:          "2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415",
+		Hash:           "Hash1",
+	}
+	b, err := xfer.Marshal()
+	require.NoError(t, err)
+	db.setRawGovernorEntry(t, transferV2MsgID(xfer), b)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	pending := &PendingTransfer{
+		ReleaseTime: now.Add(time.Hour),
+		Msg: common.MessagePublication{
+			TxHash:           eth_common.HexToHash("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:        time.Unix(int64(1654516425), 0),
+			Nonce:            123456,
+			Sequence:         789101112131416,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   tokenBridgeAddr,
+			Payload:          []byte{4, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			ConsistencyLevel: 16,
+		},
+	}
+	b, err = pending.Marshal()
+	require.NoError(t, err)
+	db.setRawGovernorEntry(t, pendingV2MsgID(&pending.Msg), b)
+
+	logger := zap.NewNop()
+	for i := 0; i < 2; i++ {
+		xfers, pendings, err := db.GetChainGovernorDataForTime(logger, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(xfers))
+		require.Equal(t, 1, len(pendings))
+		assert.Equal(t, xfer, xfers[0])
+		assert.Equal(t, pending, pendings[0])
+
+		// Make sure the entries got rewritten in the current format.
+		assert.ErrorIs(t, badger.ErrKeyNotFound, db.rowExistsInDB(transferV2MsgID(xfer)))
+		assert.ErrorIs(t, badger.ErrKeyNotFound, db.rowExistsInDB(pendingV2MsgID(&pending.Msg)))
+		assert.NoError(t, db.rowExistsInDB(TransferMsgID(xfer)))
+		assert.NoError(t, db.rowExistsInDB(PendingMsgID(&pending.Msg)))
+	}
+}
+
+func TestStoreAndReloadPendingGatewayTransfer(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	tokenBridgeAddr, err := vaa.StringToAddress("0xaeb534c45c3049d380b9d9b966f9895f53abd4301bfaff407fa09dea8ae7a924")
+	require.NoError(t, err)
+
+	pending := &PendingTransfer{
+		ReleaseTime: time.Unix(int64(1654516425+72*60*60), 0),
+		Msg: common.MessagePublication{
+			TxHash:             eth_common.HexToHash("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:          time.Unix(int64(1654516425), 0),
+			Nonce:              123456,
+			Sequence:           789101112131415,
+			EmitterChain:       vaa.ChainIDWormchain,
+			EmitterAddress:     tokenBridgeAddr,
+			Payload:            []byte{3, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			GatewaySourceChain: vaa.ChainIDSei,
+		},
+	}
+	require.NoError(t, db.StorePendingMsg(pending))
+
+	// Only entries with a source chain use the new schema version.
+	assert.Equal(t, pendingGatewayEntryVersion, sealPendingEntry(pending)[0])
+	withoutSourceChain := *pending
+	withoutSourceChain.Msg.GatewaySourceChain = vaa.ChainIDUnset
+	assert.Equal(t, governorEntryVersion, sealPendingEntry(&withoutSourceChain)[0])
+
+	_, pendings, err := db.GetChainGovernorDataForTime(zap.NewNop(), time.Unix(int64(1654516425), 0))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pendings))
+	assert.Equal(t, pending, pendings[0])
+}
+
+func TestCorruptedEntriesSkippedWhenReloading(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	tokenBridgeAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	xfer1 := &Transfer{
+		Timestamp:      time.Unix(int64(1654516425), 0),
+		Value:          125000,
+		OriginChain:    vaa.ChainIDEthereum,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: tokenBridgeAddr,
+		MsgID:          "2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415",
+		Hash:           "Hash1",
+	}
+	require.NoError(t, db.StoreTransfer(xfer1))
+
+	xfer2 := *xfer1
+	xfer2.MsgID = "2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131416"
+	xfer2.Hash = "Hash2"
+	b, err := xfer2.Marshal()
+	require.NoError(t, err)
+	sealed := sealGovernorEntry(b)
+	sealed[10] ^= 0x01
+	db.setRawGovernorEntry(t, TransferMsgID(&xfer2), sealed)
+
+	xfers, pendings, err := db.GetChainGovernorData(zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(xfers))
+	assert.Equal(t, 0, len(pendings))
+	assert.Equal(t, xfer1, xfers[0])
+}
+
+func TestGetSignedVAAsSince(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	emitter := vaa.Address{4}
+
+	// Sequences that sort differently as strings and as numbers, one second apart, with a gap.
+	for _, seq := range []uint64{1, 2, 9, 10, 11} {
+		v := &vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Timestamp:        time.Unix(int64(1654516400+seq), 0),
+			Nonce:            1,
+			Sequence:         seq,
+			ConsistencyLevel: 32,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   emitter,
+			Payload:          []byte{1},
+		}
+		v.AddSignature(privKey, 0)
+		require.NoError(t, db.StoreSignedVAA(v))
+	}
+
+	// An unreadable VAA is skipped.
+	badID := VAAID{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitter, Sequence: 3}
+	db.setRawGovernorEntry(t, badID.Bytes(), []byte{1, 2, 3})
+
+	vaas, err := db.GetSignedVAAsSince(zap.NewNop(), vaa.ChainIDEthereum, emitter, 0, time.Unix(1654516402, 0))
+	require.NoError(t, err)
+	seqs := make([]uint64, len(vaas))
+	for i, v := range vaas {
+		seqs[i] = v.Sequence
+	}
+	assert.Equal(t, []uint64{2, 9, 10, 11}, seqs)
+
+	// Sequences before fromSequence are not read.
+	vaas, err = db.GetSignedVAAsSince(zap.NewNop(), vaa.ChainIDEthereum, emitter, 10, time.Unix(0, 0))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vaas))
+	assert.Equal(t, uint64(10), vaas[0].Sequence)
+
+	vaas, err = db.GetSignedVAAsSince(zap.NewNop(), vaa.ChainIDEthereum, vaa.Address{5}, 0, time.Unix(0, 0))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(vaas))
+}
+
+func TestDowngradeGovernorData(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	tokenAddr, err := vaa.StringToAddress("0x707f9118e33a9b8998bea41dd0d46f38bb963fc8")
+	require.NoError(t, err)
+	emitter, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	xfer := &Transfer{
+		Timestamp:      time.Unix(1654516425, 0),
+		Value:          125000,
+		OriginChain:    vaa.ChainIDEthereum,
+		OriginAddress:  tokenAddr,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: emitter,
+		MsgID:          "2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415",
+		Hash:           "Hash1",
+	}
+	require.NoError(t, db.StoreTransfer(xfer))
+
+	pending := &PendingTransfer{
+		ReleaseTime: time.Unix(1654516435, 0),
+		Msg: common.MessagePublication{
+			TxHash:             eth_common.HexToHash("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:          time.Unix(1654516425, 0),
+			Nonce:              123456,
+			Sequence:           789101112131416,
+			EmitterChain:       vaa.ChainIDWormchain,
+			EmitterAddress:     emitter,
+			Payload:            []byte{4, 0, 0, 0, 1},
+			ConsistencyLevel:   16,
+			GatewaySourceChain: vaa.ChainIDInjective,
+		},
+	}
+	require.NoError(t, db.StorePendingMsg(pending))
+
+	numTransfers, numPending, err := db.DowngradeGovernorData(zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 1, numTransfers)
+	assert.Equal(t, 1, numPending)
+
+	// The entries are now in the format of older releases, which is converted back on the next load.
+	_, err = db.db.NewTransaction(false).Get(transferV2MsgID(xfer))
+	require.NoError(t, err)
+	_, err = db.db.NewTransaction(false).Get(pendingV2MsgID(&pending.Msg))
+	require.NoError(t, err)
+	_, err = db.db.NewTransaction(false).Get(TransferMsgID(xfer))
+	assert.ErrorIs(t, err, badger.ErrKeyNotFound)
+
+	xfers, pendings, err := db.GetChainGovernorDataForTime(zap.NewNop(), time.Unix(1654516430, 0))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(xfers))
+	assert.Equal(t, xfer, xfers[0])
+	require.Equal(t, 1, len(pendings))
+	assert.Equal(t, pending.Msg.Sequence, pendings[0].Msg.Sequence)
+	assert.Equal(t, vaa.ChainIDUnset, pendings[0].Msg.GatewaySourceChain)
+}
@@ -0,0 +1,194 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// The integrity check scans the signed VAAs, for instance after a crash may have left truncated writes behind, and moves the
+// entries which cannot be served to a separate keyspace. Quarantined entries are kept for investigation, and the VAAs can be
+// fetched again from other guardians with refetch-vaa.
+
+const quarantineEntry = "QUARANTINE:"
+
+// Reasons an entry is quarantined.
+const (
+	QuarantineReasonUnmarshalFailed = "unmarshal_failed"
+	QuarantineReasonKeyMismatch     = "key_mismatch"
+	QuarantineReasonNoQuorum        = "no_quorum"
+)
+
+var (
+	integrityQuarantinedVAAs = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_db_integrity_quarantined_vaas_total",
+			Help: "Total number of signed VAAs moved to the quarantine by the integrity check, by reason",
+		}, []string{"reason"})
+	integrityCheckedVAAs = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_db_integrity_checked_vaas",
+			Help: "Number of signed VAAs checked by the last integrity check",
+		})
+	integrityUncheckedQuorum = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_db_integrity_unchecked_quorum_vaas",
+			Help: "Number of signed VAAs whose quorum could not be checked by the last integrity check because their guardian set was unknown",
+		})
+)
+
+// QuarantinedVAA is a signed VAA entry moved to the quarantine by the integrity check.
+type QuarantinedVAA struct {
+	// Key is the key the entry was stored under.
+	Key string `json:"key"`
+	// Reason is one of the QuarantineReason constants.
+	Reason string `json:"reason"`
+	// Detail describes the problem.
+	Detail        string    `json:"detail"`
+	QuarantinedAt time.Time `json:"quarantinedAt"`
+	// Data is the value of the entry.
+	Data []byte `json:"data"`
+}
+
+// IntegrityReport summarizes an integrity check.
+type IntegrityReport struct {
+	// Checked is the number of signed VAAs checked.
+	Checked int
+	// UncheckedQuorum is the number of signed VAAs whose quorum was not checked, because their guardian set was unknown.
+	UncheckedQuorum int
+	// Quarantined are the entries moved to the quarantine.
+	Quarantined []*QuarantinedVAA
+}
+
+// QuorumFunc returns the number of signatures required by a guardian set, or false if the guardian set is unknown.
+type QuorumFunc func(guardianSetIndex uint32) (int, bool)
+
+func quarantineKey(key []byte) []byte {
+	return append([]byte(quarantineEntry), key...)
+}
+
+// checkSignedVAA returns the reason and the detail if the entry stored under key is corrupt, or an empty reason, and whether
+// the quorum was checked.
+func checkSignedVAA(key []byte, val []byte, quorum QuorumFunc) (string, string, bool) {
+	v, err := vaa.Unmarshal(val)
+	if err != nil {
+		return QuarantineReasonUnmarshalFailed, err.Error(), true
+	}
+
+	if expected := VaaIDFromVAA(v).Bytes(); !bytes.Equal(key, expected) {
+		return QuarantineReasonKeyMismatch, fmt.Sprintf("the VAA belongs under %s", string(expected)), true
+	}
+
+	if quorum == nil {
+		return "", "", false
+	}
+	required, known := quorum(v.GuardianSetIndex)
+	if !known {
+		return "", "", false
+	}
+	if len(v.Signatures) < required {
+		return QuarantineReasonNoQuorum, fmt.Sprintf("%d signatures, guardian set %d requires %d", len(v.Signatures), v.GuardianSetIndex, required), true
+	}
+	return "", "", true
+}
+
+// CheckSignedVAAIntegrity checks that all the stored signed VAAs can be unmarshaled, are stored under their own message ID
+// and, if their guardian set is known to quorum, carry enough signatures for it. Entries failing the checks are moved to the
+// quarantine. It should be run before the database is used by the node.
+func (d *Database) CheckSignedVAAIntegrity(quorum QuorumFunc) (*IntegrityReport, error) {
+	report := &IntegrityReport{Quarantined: make([]*QuarantinedVAA, 0)}
+	now := time.Now()
+
+	prefix := []byte("signed/")
+	if err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			key := item.KeyCopy(nil)
+			err := item.Value(func(val []byte) error {
+				report.Checked++
+				reason, detail, quorumChecked := checkSignedVAA(key, val, quorum)
+				if reason == "" && !quorumChecked {
+					report.UncheckedQuorum++
+				}
+				if reason != "" {
+					report.Quarantined = append(report.Quarantined, &QuarantinedVAA{
+						Key:           string(key),
+						Reason:        reason,
+						Detail:        detail,
+						QuarantinedAt: now,
+						Data:          append([]byte(nil), val...),
+					})
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", string(key), err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, q := range report.Quarantined {
+		if err := d.quarantine(q); err != nil {
+			return nil, err
+		}
+		integrityQuarantinedVAAs.WithLabelValues(q.Reason).Inc()
+	}
+
+	integrityCheckedVAAs.Set(float64(report.Checked))
+	integrityUncheckedQuorum.Set(float64(report.UncheckedQuorum))
+	return report, nil
+}
+
+// quarantine moves a signed VAA entry to the quarantine.
+func (d *Database) quarantine(q *QuarantinedVAA) error {
+	b, err := json.Marshal(q)
+	if err != nil {
+		return fmt.Errorf("failed to marshal quarantined entry: %w", err)
+	}
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		if err := txn.Set(quarantineKey([]byte(q.Key)), b); err != nil {
+			return err
+		}
+		return txn.Delete([]byte(q.Key))
+	}); err != nil {
+		return fmt.Errorf("failed to quarantine %s: %w", q.Key, err)
+	}
+
+	return nil
+}
+
+// GetQuarantinedVAAs returns the entries moved to the quarantine by the integrity check, ordered by key.
+func (d *Database) GetQuarantinedVAAs() ([]*QuarantinedVAA, error) {
+	entries := make([]*QuarantinedVAA, 0)
+	prefix := []byte(quarantineEntry)
+	err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			var q QuarantinedVAA
+			if err := json.Unmarshal(val, &q); err != nil {
+				return fmt.Errorf("failed to unmarshal quarantined entry %s: %w", string(it.Item().Key()), err)
+			}
+			entries = append(entries, &q)
+		}
+		return nil
+	})
+
+	return entries, err
+}
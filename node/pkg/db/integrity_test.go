@@ -0,0 +1,97 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestCheckSignedVAAIntegrity(t *testing.T) {
+	db, err := Open(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	storeVAA := func(sequence uint64, guardianSetIndex uint32, numSignatures int) *vaa.VAA {
+		v := getVAA()
+		v.Sequence = sequence
+		v.GuardianSetIndex = guardianSetIndex
+		for i := 0; i < numSignatures; i++ {
+			v.AddSignature(privKey, uint8(i))
+		}
+		require.NoError(t, db.StoreSignedVAA(&v))
+		return &v
+	}
+
+	good := storeVAA(1, 1, 2)
+	noQuorum := storeVAA(2, 1, 1)
+	unknownSet := storeVAA(3, 7, 1)
+
+	// A write truncated by a crash.
+	truncated := storeVAA(4, 1, 2)
+	b, err := truncated.Marshal()
+	require.NoError(t, err)
+	truncatedKey := VaaIDFromVAA(truncated).Bytes()
+	require.NoError(t, db.db.Update(func(txn *badger.Txn) error { return txn.Set(truncatedKey, b[:len(b)/2]) }))
+
+	// A VAA stored under the wrong key.
+	misplacedKey := (&VAAID{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: good.EmitterAddress, Sequence: 1}).Bytes()
+	b, err = good.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(txn *badger.Txn) error { return txn.Set(misplacedKey, b) }))
+
+	quorum := func(guardianSetIndex uint32) (int, bool) {
+		if guardianSetIndex == 1 {
+			return 2, true
+		}
+		return 0, false
+	}
+
+	report, err := db.CheckSignedVAAIntegrity(quorum)
+	require.NoError(t, err)
+	assert.Equal(t, 5, report.Checked)
+	assert.Equal(t, 1, report.UncheckedQuorum)
+
+	reasons := make(map[string]string)
+	for _, q := range report.Quarantined {
+		reasons[q.Key] = q.Reason
+	}
+	assert.Equal(t, map[string]string{
+		string(VaaIDFromVAA(noQuorum).Bytes()): QuarantineReasonNoQuorum,
+		string(truncatedKey):                   QuarantineReasonUnmarshalFailed,
+		string(misplacedKey):                   QuarantineReasonKeyMismatch,
+	}, reasons)
+
+	// The good VAAs are kept, the corrupt ones are moved to the quarantine.
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(good))
+	assert.NoError(t, err)
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(unknownSet))
+	assert.NoError(t, err)
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(noQuorum))
+	assert.ErrorIs(t, err, ErrVAANotFound)
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(truncated))
+	assert.ErrorIs(t, err, ErrVAANotFound)
+
+	quarantined, err := db.GetQuarantinedVAAs()
+	require.NoError(t, err)
+	require.Len(t, quarantined, 3)
+	for _, q := range quarantined {
+		assert.Equal(t, reasons[q.Key], q.Reason)
+		assert.NotEmpty(t, q.Detail)
+		assert.NotEmpty(t, q.Data)
+	}
+
+	// Running the check again finds nothing new.
+	report, err = db.CheckSignedVAAIntegrity(quorum)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Checked)
+	assert.Empty(t, report.Quarantined)
+}
@@ -3,18 +3,12 @@ package devnet
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
-	mathrand "math/rand"
+
+	sdkdevnet "github.com/wormhole-foundation/wormhole/sdk/devnet"
 )
 
-// InsecureDeterministicEcdsaKeyByIndex generates a deterministic ecdsa.PrivateKey from a given index.
+// InsecureDeterministicEcdsaKeyByIndex generates a deterministic ecdsa.PrivateKey from a given index. The derivation lives
+// in the sdk/devnet package, so that integrators can build the same keys.
 func InsecureDeterministicEcdsaKeyByIndex(c elliptic.Curve, idx uint64) *ecdsa.PrivateKey {
-	// use 555 as offset to deterministically generate key 0 to match vaa-test such that
-	// we generate the same key.
-	r := mathrand.New(mathrand.NewSource(int64(555 + idx))) //#nosec G404 Testnet/devnet keys are not secret.
-	key, err := ecdsa.GenerateKey(c, r)
-	if err != nil {
-		panic(err)
-	}
-
-	return key
+	return sdkdevnet.InsecureDeterministicEcdsaKeyByIndex(c, idx)
 }
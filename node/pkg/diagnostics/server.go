@@ -0,0 +1,95 @@
+// Package diagnostics implements an optional HTTP server exposing pprof and goroutine dumps, for investigating support cases.
+// Unlike the status server, every request must be authenticated with a bearer token, since profiles and stack traces
+// reveal internal details of the node.
+package diagnostics
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	rpprof "runtime/pprof"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// minTokenLength is the minimum length of the bearer token, to discourage trivially guessable tokens.
+const minTokenLength = 32
+
+// LoadToken reads the bearer token from a file. Leading and trailing whitespace is ignored.
+func LoadToken(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read diagnostics token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if len(token) < minTokenLength {
+		return "", fmt.Errorf("diagnostics token must be at least %d characters long", minTokenLength)
+	}
+
+	return token, nil
+}
+
+// NewHandler returns the handler for the diagnostics server. Requests without the correct bearer token are rejected.
+func NewHandler(logger *zap.Logger, token string) http.Handler {
+	// Use a custom router rather than http.DefaultServeMux so only the endpoints below are exposed.
+	router := mux.NewRouter()
+
+	router.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	router.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	router.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	router.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	router.PathPrefix("/debug/pprof/").HandlerFunc(pprof.Index)
+
+	router.HandleFunc("/debug/goroutines", handleGoroutines)
+	router.HandleFunc("/debug/runtime", handleRuntime)
+
+	return &authHandler{logger: logger, token: []byte(token), next: router}
+}
+
+type authHandler struct {
+	logger *zap.Logger
+	token  []byte
+	next   http.Handler
+}
+
+func (h *authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") || subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), h.token) != 1 {
+		h.logger.Warn("rejected unauthenticated diagnostics request", zap.String("path", r.URL.Path), zap.String("remoteAddr", r.RemoteAddr))
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	h.logger.Info("diagnostics request", zap.String("path", r.URL.Path), zap.String("remoteAddr", r.RemoteAddr))
+	h.next.ServeHTTP(w, r)
+}
+
+// handleGoroutines writes the stack traces of all goroutines, in the same format as an unrecovered panic.
+func handleGoroutines(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if err := rpprof.Lookup("goroutine").WriteTo(w, 2); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// handleRuntime writes a summary of the Go runtime state.
+func handleRuntime(w http.ResponseWriter, r *http.Request) {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "go_version: %s\n", runtime.Version())
+	fmt.Fprintf(w, "num_cpu: %d\n", runtime.NumCPU())
+	fmt.Fprintf(w, "gomaxprocs: %d\n", runtime.GOMAXPROCS(0))
+	fmt.Fprintf(w, "num_goroutine: %d\n", runtime.NumGoroutine())
+	fmt.Fprintf(w, "heap_alloc_bytes: %d\n", m.HeapAlloc)
+	fmt.Fprintf(w, "heap_sys_bytes: %d\n", m.HeapSys)
+	fmt.Fprintf(w, "heap_objects: %d\n", m.HeapObjects)
+	fmt.Fprintf(w, "num_gc: %d\n", m.NumGC)
+}
@@ -0,0 +1,84 @@
+package diagnostics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testToken = "0123456789abcdef0123456789abcdef"
+
+func get(t *testing.T, h http.Handler, path string, auth string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRequestsMustBeAuthenticated(t *testing.T) {
+	h := NewHandler(zap.NewNop(), testToken)
+
+	for _, auth := range []string{"", "Bearer", "Bearer wrong", "Basic " + testToken, testToken, "Bearer " + testToken + "x"} {
+		rec := get(t, h, "/debug/goroutines", auth)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, auth)
+		assert.NotContains(t, rec.Body.String(), "goroutine")
+	}
+
+	rec := get(t, h, "/debug/goroutines", "Bearer "+testToken)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGoroutineDump(t *testing.T) {
+	h := NewHandler(zap.NewNop(), testToken)
+
+	rec := get(t, h, "/debug/goroutines", "Bearer "+testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "TestGoroutineDump")
+}
+
+func TestPprofAndRuntime(t *testing.T) {
+	h := NewHandler(zap.NewNop(), testToken)
+
+	rec := get(t, h, "/debug/pprof/", "Bearer "+testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "goroutine")
+
+	rec = get(t, h, "/debug/pprof/heap?debug=1", "Bearer "+testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.HasPrefix(rec.Body.String(), "heap profile"))
+
+	rec = get(t, h, "/debug/runtime", "Bearer "+testToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "num_goroutine:")
+
+	rec = get(t, h, "/metrics", "Bearer "+testToken)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestLoadToken(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(path, []byte(testToken+"\n"), 0600))
+	token, err := LoadToken(path)
+	require.NoError(t, err)
+	assert.Equal(t, testToken, token)
+
+	require.NoError(t, os.WriteFile(path, []byte("short"), 0600))
+	_, err = LoadToken(path)
+	assert.ErrorContains(t, err, "at least")
+
+	_, err = LoadToken(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
@@ -23,3 +23,11 @@ func (gov *ChainGovernor) initDevnetConfig() ([]tokenConfigEntry, []chainConfigE
 
 	return tokens, chains
 }
+
+func devnetTokenGroupList() []tokenGroupConfigEntry {
+	return []tokenGroupConfigEntry{
+		tokenGroupConfigEntry{name: "eth-derivatives", emitterChainID: vaa.ChainIDEthereum, dailyLimit: 50000, tokens: []tokenGroupMemberConfigEntry{
+			tokenGroupMemberConfigEntry{chain: 2, addr: "000000000000000000000000DDb64fE46a91D46ee29420539FC25FD07c5FEa3E"}, // WETH
+		}},
+	}
+}
@@ -0,0 +1,139 @@
+// An emitter limit is a daily notional limit for the transfers of a single emitter on an emitter chain, on top of the
+// limit of the chain. Without emitter limits, only the token bridge of a chain is governed. An emitter limit brings
+// another emitter of the chain under the governor, for instance a different token transfer protocol, so that a
+// compromised or buggy emitter can be constrained independently of the token bridge. Only messages of the emitter
+// using the token bridge transfer payloads (types one and three) are governed.
+//
+// The transfers of all the emitters of a chain count towards the limit of the chain and its token groups. A transfer
+// is additionally enqueued if it would exceed the limit of its emitter. The token bridge itself may also be given an
+// emitter limit below the limit of the chain, to reserve part of the chain limit for the other emitters.
+
+package governor
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type (
+	// Layout of the config data for each emitter limit
+	emitterLimitConfigEntry struct {
+		name           string
+		emitterChainID vaa.ChainID
+		emitterAddr    string
+		dailyLimit     uint64
+	}
+
+	// Payload of the map of emitter limits of a chain
+	emitterLimitEntry struct {
+		name        string
+		emitterAddr vaa.Address
+		dailyLimit  uint64
+	}
+)
+
+// sumValue returns the value of the transfers of the emitter since startTime.
+func (ee *emitterLimitEntry) sumValue(transfers []*db.Transfer, startTime time.Time) uint64 {
+	var sum uint64
+	for _, t := range transfers {
+		if !t.Timestamp.Before(startTime) && t.EmitterAddress == ee.emitterAddr {
+			sum += t.Value
+		}
+	}
+
+	return sum
+}
+
+// isGovernedEmitter returns whether the transfers of the emitter are governed, i.e. if it is the token bridge of the
+// chain or has an emitter limit.
+func (ce *chainEntry) isGovernedEmitter(emitterAddr vaa.Address) bool {
+	if emitterAddr == ce.emitterAddr {
+		return true
+	}
+
+	_, exists := ce.emitterLimits[emitterAddr]
+	return exists
+}
+
+// governedEmitters returns the addresses of all the governed emitters of the chain, starting with the token bridge
+// followed by the other emitters in address order.
+func (ce *chainEntry) governedEmitters() []vaa.Address {
+	others := make([]vaa.Address, 0, len(ce.emitterLimits))
+	for addr := range ce.emitterLimits {
+		if addr != ce.emitterAddr {
+			others = append(others, addr)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return bytes.Compare(others[i][:], others[j][:]) < 0
+	})
+
+	return append([]vaa.Address{ce.emitterAddr}, others...)
+}
+
+// exceededEmitterLimit returns the limit of the emitter if a transfer of the given value would push the emitter above
+// it, along with the value of the emitter before and after the transfer. It returns nil if the transfer fits.
+func (ce *chainEntry) exceededEmitterLimit(emitterAddr vaa.Address, value uint64, startTime time.Time) (limit *emitterLimitEntry, prevEmitterValue uint64, newEmitterValue uint64) {
+	ee, exists := ce.emitterLimits[emitterAddr]
+	if !exists {
+		return nil, 0, 0
+	}
+
+	prevEmitterValue = ee.sumValue(ce.transfers, startTime)
+	newEmitterValue = prevEmitterValue + value
+	if newEmitterValue < prevEmitterValue || newEmitterValue > ee.dailyLimit {
+		return ee, prevEmitterValue, newEmitterValue
+	}
+
+	return nil, 0, 0
+}
+
+// initEmitterLimits validates the emitter limit config and attaches the limits to their chains. It must be called after
+// the chains have been loaded. It assumes the caller holds the lock.
+func (gov *ChainGovernor) initEmitterLimits(configLimits []emitterLimitConfigEntry) error {
+	for _, cl := range configLimits {
+		ce, exists := gov.chains[cl.emitterChainID]
+		if !exists {
+			return fmt.Errorf("emitter limit %s is for chain %v, which is not configured", cl.name, cl.emitterChainID)
+		}
+
+		if cl.name == "" {
+			return fmt.Errorf("emitter limit for chain %v has no name", cl.emitterChainID)
+		}
+
+		if cl.dailyLimit == 0 {
+			return fmt.Errorf("emitter limit %s for chain %v has a zero daily limit", cl.name, cl.emitterChainID)
+		}
+
+		emitterAddr, err := vaa.StringToAddress(cl.emitterAddr)
+		if err != nil {
+			return fmt.Errorf("invalid emitter address in emitter limit %s: %s", cl.name, cl.emitterAddr)
+		}
+
+		if ce.emitterLimits == nil {
+			ce.emitterLimits = make(map[vaa.Address]*emitterLimitEntry)
+		}
+
+		if _, exists := ce.emitterLimits[emitterAddr]; exists {
+			return fmt.Errorf("duplicate emitter limit for emitter %v on chain %v", emitterAddr, cl.emitterChainID)
+		}
+
+		ee := &emitterLimitEntry{name: cl.name, emitterAddr: emitterAddr, dailyLimit: cl.dailyLimit}
+
+		gov.logger.Info("will monitor emitter:", zap.String("name", ee.name),
+			zap.Stringer("emitterChainId", cl.emitterChainID),
+			zap.Stringer("emitterAddr", ee.emitterAddr),
+			zap.Uint64("dailyLimit", ee.dailyLimit),
+		)
+
+		ce.emitterLimits[emitterAddr] = ee
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Chains connected to wormchain over IBC without a wormhole contract of their own, such as Sei, are served by Wormhole
+// Gateway: their transfers are bridged by the ibc-translator contract on wormchain, so they are observed as transfers
+// with payload emitted by the token bridge of wormchain. Counting them against the limit of wormchain would let the
+// transfers of one Gateway chain use up the limit of all the others, and would not limit the outflow of a compromised
+// Gateway chain on its own, so the governor attributes them to the chain they came from instead.
+//
+// The source chain is never taken from the payload, which is chosen by the sender of the transfer. It is set on the
+// message by the wormchain watcher, from the IBC channel the packet of the transaction was received on and the channel
+// mapping of the IBC watcher (see GatewaySourceChain in common.MessagePublication). Only transfers sent by a known
+// ibc-translator contract are attributed, since anybody can send a transfer with payload from wormchain. A transfer
+// without a source chain, or from a chain which is not configured in the governor, keeps counting against wormchain,
+// if wormchain is configured, or is not governed otherwise. This includes transfers repaired from signed VAAs on
+// startup, since the source chain is not part of the VAA.
+
+package governor
+
+import (
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// Layout of the config data for Wormhole Gateway
+type gatewayConfigEntry struct {
+	tokenBridge string   // The token bridge on wormchain, as a bech32 address.
+	translators []string // The ibc-translator contracts on wormchain, as bech32 addresses.
+}
+
+// Offsets in the payload of a token bridge transfer with payload (payload type 3).
+const (
+	transferWithPayloadFromAddressOffset = 101
+	transferWithPayloadPayloadOffset     = 133
+)
+
+func gatewayConfig(env int) gatewayConfigEntry {
+	if env == MainNetMode {
+		return gatewayConfigEntry{
+			tokenBridge: "wormhole1466nf3zuxpya8q9emxukd7vftaf6h4psr0a07srl5zw74zh84yjq4lyjmh",
+			translators: []string{"wormhole14ejqjyq8um4p3xfqj74yld5waqljf88fz25yxnma0cngspxe3les00fpjx"},
+		}
+	}
+
+	return gatewayConfigEntry{}
+}
+
+// initGatewayConfig sets up the attribution of Gateway transfers. Gateway transfers are not attributed if the token
+// bridge or the ibc-translator contracts are not known for the environment.
+func (gov *ChainGovernor) initGatewayConfig(cfg gatewayConfigEntry) error {
+	if cfg.tokenBridge == "" || len(cfg.translators) == 0 {
+		return nil
+	}
+
+	tokenBridge, err := wormchainAddress(cfg.tokenBridge)
+	if err != nil {
+		return fmt.Errorf("invalid gateway token bridge address: %w", err)
+	}
+
+	translators := make(map[vaa.Address]struct{}, len(cfg.translators))
+	for _, addr := range cfg.translators {
+		translator, err := wormchainAddress(addr)
+		if err != nil {
+			return fmt.Errorf("invalid ibc-translator address: %w", err)
+		}
+		translators[translator] = struct{}{}
+	}
+
+	gov.gatewayTokenBridge = tokenBridge
+	gov.gatewayTranslators = translators
+	return nil
+}
+
+// wormchainAddress converts a bech32 contract address on wormchain to a wormhole address.
+func wormchainAddress(addr string) (vaa.Address, error) {
+	hrp, b, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return vaa.Address{}, fmt.Errorf("failed to decode %s: %w", addr, err)
+	}
+	if hrp != "wormhole" {
+		return vaa.Address{}, fmt.Errorf("%s is not a wormchain address", addr)
+	}
+	return vaa.BytesToAddress(b)
+}
+
+// gatewaySourceChain returns the chain entry of the source chain of a Gateway transfer, or false if the message is not
+// a Gateway transfer or its source chain is not known or not configured. It assumes the caller holds the lock.
+func (gov *ChainGovernor) gatewaySourceChain(msg *common.MessagePublication) (*chainEntry, bool) {
+	if len(gov.gatewayTranslators) == 0 || msg.EmitterChain != vaa.ChainIDWormchain || msg.EmitterAddress != gov.gatewayTokenBridge {
+		return nil, false
+	}
+
+	sourceChain := msg.GatewaySourceChain
+	if sourceChain == vaa.ChainIDUnset || sourceChain == msg.EmitterChain || !gov.isGatewayTranslatorTransfer(msg.Payload) {
+		return nil, false
+	}
+
+	ce, exists := gov.chains[sourceChain]
+	return ce, exists
+}
+
+// isGatewayTranslatorTransfer returns true if the payload is a transfer with payload sent by an ibc-translator.
+func (gov *ChainGovernor) isGatewayTranslatorTransfer(payload []byte) bool {
+	if len(payload) < transferWithPayloadPayloadOffset || payload[0] != 3 {
+		return false
+	}
+
+	var from vaa.Address
+	copy(from[:], payload[transferWithPayloadFromAddressOffset:transferWithPayloadPayloadOffset])
+	_, exists := gov.gatewayTranslators[from]
+	return exists
+}
+
+// attributedChain returns the chain a transfer is attributed to, to be stored with it, if it is not the emitter chain.
+func attributedChain(ce *chainEntry, emitterChain vaa.ChainID) vaa.ChainID {
+	if ce.emitterChainId == emitterChain {
+		return vaa.ChainIDUnset
+	}
+	return ce.emitterChainId
+}
@@ -0,0 +1,265 @@
+package governor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	gatewayTokenBridgeAddrStr = "0xaeb534c45c3049d380b9d9b966f9895f53abd4301bfaff407fa09dea8ae7a924" //nolint:gosec
+	gatewayTranslatorAddrStr  = "0xae64091007e6ea18992097aa4fb68ee83f249ce912a8434f7d7e268804d98ff3"
+)
+
+// buildMockGatewayTransferPayloadBytes builds a transfer with payload sent by fromAddrStr, with the given inner payload.
+func buildMockGatewayTransferPayloadBytes(tokenChainID vaa.ChainID, tokenAddrStr string, fromAddrStr string, innerPayload string, amtFloat float64) []byte {
+	payload := buildMockTransferPayloadBytes(3, tokenChainID, tokenAddrStr, vaa.ChainIDEthereum, "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8", amtFloat)
+	fromAddr, _ := vaa.StringToAddress(fromAddrStr)
+	payload = append(payload, fromAddr.Bytes()...)
+	return append(payload, []byte(innerPayload)...)
+}
+
+func newChainGovernorWithGatewayForTest(t *testing.T) *ChainGovernor {
+	t.Helper()
+	gov, err := newChainGovernorForTest(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, gov.initGatewayConfig(gatewayConfig(MainNetMode)))
+	return gov
+}
+
+func TestMainnetGatewayConfig(t *testing.T) {
+	gov := NewChainGovernor(zap.NewNop(), &db.MockGovernorDB{}, GoTestMode)
+	require.NoError(t, gov.initGatewayConfig(gatewayConfig(MainNetMode)))
+
+	tokenBridgeAddr, err := vaa.StringToAddress(gatewayTokenBridgeAddrStr)
+	require.NoError(t, err)
+	translatorAddr, err := vaa.StringToAddress(gatewayTranslatorAddrStr)
+	require.NoError(t, err)
+
+	assert.Equal(t, tokenBridgeAddr, gov.gatewayTokenBridge)
+	assert.Equal(t, map[vaa.Address]struct{}{translatorAddr: {}}, gov.gatewayTranslators)
+
+	// Without the contracts of the environment, Gateway transfers are not attributed.
+	gov = NewChainGovernor(zap.NewNop(), &db.MockGovernorDB{}, GoTestMode)
+	require.NoError(t, gov.initGatewayConfig(gatewayConfig(TestNetMode)))
+	assert.Equal(t, 0, len(gov.gatewayTranslators))
+
+	gov = NewChainGovernor(zap.NewNop(), &db.MockGovernorDB{}, GoTestMode)
+	assert.ErrorContains(t, gov.initGatewayConfig(gatewayConfigEntry{tokenBridge: "wormhole1invalid", translators: []string{"wormhole1invalid"}}), "invalid gateway token bridge address")
+	assert.ErrorContains(t, gov.initGatewayConfig(gatewayConfigEntry{
+		tokenBridge: "wormhole1466nf3zuxpya8q9emxukd7vftaf6h4psr0a07srl5zw74zh84yjq4lyjmh",
+		translators: []string{"terra1x46rqay4d3cssq8gxxvqz8xt6nwlz4td20k38v"},
+	}), "invalid ibc-translator address")
+	assert.Equal(t, 0, len(gov.gatewayTranslators))
+}
+
+func TestGatewaySourceChain(t *testing.T) {
+	gov := newChainGovernorWithGatewayForTest(t)
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDSei, "0x0000000000000000000000000000000000000032", 5000, 0))
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	otherAddrStr := "0x0000000000000000000000000000000000000042"
+	tokenBridgeAddr, err := vaa.StringToAddress(gatewayTokenBridgeAddrStr)
+	require.NoError(t, err)
+	otherAddr, err := vaa.StringToAddress(otherAddrStr)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		emitterAddress vaa.Address
+		payload        []byte
+		sourceChain    vaa.ChainID
+		ok             bool
+	}{
+		{
+			name:           "gateway transfer",
+			emitterAddress: tokenBridgeAddr,
+			payload:        buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, gatewayTranslatorAddrStr, "", 1.25),
+			sourceChain:    vaa.ChainIDSei,
+			ok:             true,
+		},
+		{
+			name:           "source chain not configured",
+			emitterAddress: tokenBridgeAddr,
+			payload:        buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, gatewayTranslatorAddrStr, "", 1.25),
+			sourceChain:    vaa.ChainID(4000),
+		},
+		{
+			name:           "the payload does not set the source chain",
+			emitterAddress: tokenBridgeAddr,
+			payload:        buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, gatewayTranslatorAddrStr, `{"gateway_source":{"chain":32}}`, 1.25),
+		},
+		{
+			name:           "not sent by an ibc-translator",
+			emitterAddress: tokenBridgeAddr,
+			payload:        buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, otherAddrStr, "", 1.25),
+			sourceChain:    vaa.ChainIDSei,
+		},
+		{
+			name:           "not emitted by the token bridge",
+			emitterAddress: otherAddr,
+			payload:        buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, gatewayTranslatorAddrStr, "", 1.25),
+			sourceChain:    vaa.ChainIDSei,
+		},
+		{
+			name:           "transfer without payload",
+			emitterAddress: tokenBridgeAddr,
+			payload:        buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, tokenAddrStr, vaa.ChainIDEthereum, otherAddrStr, 1.25),
+			sourceChain:    vaa.ChainIDSei,
+		},
+		{
+			name:           "truncated",
+			emitterAddress: tokenBridgeAddr,
+			payload:        buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, gatewayTranslatorAddrStr, "", 1.25)[:120],
+			sourceChain:    vaa.ChainIDSei,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &common.MessagePublication{
+				EmitterChain:       vaa.ChainIDWormchain,
+				EmitterAddress:     tc.emitterAddress,
+				Payload:            tc.payload,
+				GatewaySourceChain: tc.sourceChain,
+			}
+			ce, ok := gov.gatewaySourceChain(msg)
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.sourceChain, ce.emitterChainId)
+			}
+		})
+	}
+}
+
+func TestGatewayTransfersCountAgainstSourceChain(t *testing.T) {
+	gov := newChainGovernorWithGatewayForTest(t)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(gatewayTokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(60)
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDWormchain, gatewayTokenBridgeAddrStr, 100000, 0))
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDSei, "0x0000000000000000000000000000000000000032", 5000, 0))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62))
+
+	newMsg := func(seq uint64, fromAddrStr string, sourceChain vaa.ChainID) *common.MessagePublication {
+		return &common.MessagePublication{
+			TxHash:             hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:          time.Unix(int64(1654543099), 0),
+			Nonce:              uint32(1),
+			Sequence:           seq,
+			EmitterChain:       vaa.ChainIDWormchain,
+			EmitterAddress:     tokenBridgeAddr,
+			ConsistencyLevel:   uint8(0),
+			Payload:            buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, fromAddrStr, `{"gateway_source":{"chain":32}}`, 1.25),
+			GatewaySourceChain: sourceChain,
+		}
+	}
+
+	now := time.Now()
+
+	// Two transfers fit in the limit of Sei, the third one is enqueued although wormchain is well below its limit.
+	for seq := uint64(1); seq <= 3; seq++ {
+		canPost, err := gov.ProcessMsgForTime(newMsg(seq, gatewayTranslatorAddrStr, vaa.ChainIDSei), now)
+		require.NoError(t, err)
+		assert.Equal(t, seq != 3, canPost)
+	}
+
+	// Transfers not sent by an ibc-translator, from a chain which is not configured or without a source chain count
+	// against wormchain, whatever their payload says.
+	canPost, err := gov.ProcessMsgForTime(newMsg(4, "0x0000000000000000000000000000000000000042", vaa.ChainIDSei), now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+	canPost, err = gov.ProcessMsgForTime(newMsg(5, gatewayTranslatorAddrStr, vaa.ChainID(4000)), now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+	canPost, err = gov.ProcessMsgForTime(newMsg(6, gatewayTranslatorAddrStr, vaa.ChainIDUnset), now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	sei := gov.chains[vaa.ChainIDSei]
+	require.Equal(t, 2, len(sei.transfers))
+	assert.Equal(t, 1, len(sei.pending))
+	for _, xfer := range sei.transfers {
+		assert.Equal(t, vaa.ChainIDWormchain, xfer.EmitterChain)
+		assert.Equal(t, vaa.ChainIDSei, xfer.AttributedChain)
+	}
+
+	wormchain := gov.chains[vaa.ChainIDWormchain]
+	require.Equal(t, 3, len(wormchain.transfers))
+	assert.Equal(t, 0, len(wormchain.pending))
+	for _, xfer := range wormchain.transfers {
+		assert.Equal(t, vaa.ChainIDUnset, xfer.AttributedChain)
+	}
+
+	// The enqueued transfer is released against the limit of Sei once the earlier ones age out.
+	toBePublished, err := gov.CheckPendingForTime(now.Add(time.Minute * 61))
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(toBePublished))
+	require.Equal(t, 1, len(sei.transfers))
+	assert.Equal(t, vaa.ChainIDSei, sei.transfers[0].AttributedChain)
+}
+
+func TestReloadGatewayTransfers(t *testing.T) {
+	gov := newChainGovernorWithGatewayForTest(t)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	tokenAddr, err := vaa.StringToAddress(tokenAddrStr)
+	require.NoError(t, err)
+	tokenBridgeAddr, err := vaa.StringToAddress(gatewayTokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	// Wormchain itself is not governed, like on mainnet.
+	gov.setDayLengthInMinutes(24 * 60)
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDSei, "0x0000000000000000000000000000000000000032", 100000, 0))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62))
+
+	now := time.Now()
+	startTime := now.Add(-time.Minute * time.Duration(gov.dayLengthInMinutes))
+
+	newTransfer := func(seq string, attributedChain vaa.ChainID) *db.Transfer {
+		return &db.Transfer{
+			Timestamp:       now.Add(-time.Minute),
+			Value:           2218,
+			OriginChain:     vaa.ChainIDEthereum,
+			OriginAddress:   tokenAddr,
+			EmitterChain:    vaa.ChainIDWormchain,
+			EmitterAddress:  tokenBridgeAddr,
+			MsgID:           "3104/aeb534c45c3049d380b9d9b966f9895f53abd4301bfaff407fa09dea8ae7a924/" + seq,
+			Hash:            "Hash" + seq,
+			AttributedChain: attributedChain,
+		}
+	}
+
+	gov.reloadTransfer(newTransfer("1", vaa.ChainIDSei), now, startTime)
+	gov.reloadTransfer(newTransfer("2", vaa.ChainIDUnset), now, startTime)
+
+	pendingMsg := common.MessagePublication{
+		TxHash:             hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:          now.Add(-time.Minute),
+		Nonce:              uint32(1),
+		Sequence:           3,
+		EmitterChain:       vaa.ChainIDWormchain,
+		EmitterAddress:     tokenBridgeAddr,
+		ConsistencyLevel:   uint8(0),
+		Payload:            buildMockGatewayTransferPayloadBytes(vaa.ChainIDEthereum, tokenAddrStr, gatewayTranslatorAddrStr, "", 1.25),
+		GatewaySourceChain: vaa.ChainIDSei,
+	}
+	gov.reloadPendingTransfer(&db.PendingTransfer{ReleaseTime: now.Add(time.Hour), Msg: pendingMsg}, now)
+
+	sei := gov.chains[vaa.ChainIDSei]
+	require.Equal(t, 1, len(sei.transfers))
+	assert.Equal(t, "Hash1", sei.transfers[0].Hash)
+	assert.Equal(t, 1, len(sei.pending))
+	_, exists := gov.chains[vaa.ChainIDWormchain]
+	assert.False(t, exists)
+}
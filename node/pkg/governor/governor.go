@@ -10,6 +10,16 @@
 // until it can be published without exceeding the limit. Even if the governor has an enqueued transfer, it will still allow
 // additional transfers that do not exceed the threshold.
 //
+// A chain may optionally be configured with a small transfer fast lane. Transfers with a value below the fast lane size
+// that would otherwise be enqueued because the daily limit has been reached are published immediately, as long as the
+// total value of fast lane transfers in the last 24 hours stays within the fast lane limit. Fast lane transfers are
+// tracked separately and do not count towards the daily limit.
+//
+// Tokens of a chain may also be put into token groups that share a combined daily limit, as described in token_groups.go.
+//
+// Besides the token bridge, other emitters of a chain may be governed with their own daily limit, as described in
+// emitter_limits.go.
+//
 // The chain governor checks for pending transfers each minute to see if any can be published yet. It will publish any that can be published
 // without exceeding the daily limit, even if one in front of it in the queue is too big.
 //
@@ -22,6 +32,13 @@
 // The set of chains to be monitored is specified in chains.go, which can be edited by hand.
 //
 // To enable the chain governor, you must specified the --chainGovernorEnabled guardiand command line argument.
+//
+// In shadow mode, enabled with --chainGovernorShadowMode, the governor makes all the same decisions and keeps the same
+// state as if it were enforced, but every message is published right away. Transfers that would have been enqueued are
+// logged and counted in guardian_governor_shadow_enqueued_vaas_total, and their would-be release is logged and counted in
+// guardian_governor_shadow_released_vaas_total, so that a new configuration can be evaluated against live traffic
+// before it is enforced. No notifications are sent in shadow mode, and the pending transfers are only kept in memory,
+// since they have been published already and must not be delayed once the governor is restarted in enforcing mode.
 
 package governor
 
@@ -71,6 +88,8 @@ type (
 		emitterChainID     vaa.ChainID
 		dailyLimit         uint64
 		bigTransactionSize uint64
+		fastLaneSize       uint64 // Transfers below this value may use the fast lane. Zero disables the fast lane.
+		fastLaneLimit      uint64 // Maximum notional value that may be published through the fast lane in a day.
 	}
 
 	// Key to the map of the tokens being monitored
@@ -82,13 +101,18 @@ type (
 	// Payload of the map of the tokens being monitored
 	tokenEntry struct {
 		price          *big.Float
-		decimals       *big.Int
+		decimals       *big.Int // 10^min(origDecimals, 8), used to normalize transfer amounts
+		origDecimals   int64
 		symbol         string
 		coinGeckoId    string
 		token          tokenKey
 		cfgPrice       *big.Float
 		coinGeckoPrice *big.Float
 		priceTime      time.Time
+
+		// oraclePrice is the latest price read from an on-chain oracle, if any. See SetOraclePrice().
+		oraclePrice     *big.Float
+		oraclePriceTime time.Time
 	}
 
 	// Payload for each enqueued transfer
@@ -106,9 +130,14 @@ type (
 		dailyLimit              uint64
 		bigTransactionSize      uint64
 		checkForBigTransactions bool
+		fastLaneSize            uint64
+		fastLaneLimit           uint64
 
-		transfers []*db.Transfer
-		pending   []*pendingEntry
+		transfers         []*db.Transfer
+		fastLaneTransfers []*db.Transfer
+		pending           []*pendingEntry
+		groups            []*tokenGroupEntry
+		emitterLimits     map[vaa.Address]*emitterLimitEntry
 	}
 )
 
@@ -116,6 +145,10 @@ func (ce *chainEntry) isBigTransfer(value uint64) bool {
 	return value >= ce.bigTransactionSize && ce.checkForBigTransactions
 }
 
+func (ce *chainEntry) isFastLaneTransfer(value uint64) bool {
+	return ce.fastLaneSize != 0 && value < ce.fastLaneSize
+}
+
 type ChainGovernor struct {
 	db                    db.GovernorDB // protected by `mutex`
 	logger                *zap.Logger
@@ -132,6 +165,10 @@ type ChainGovernor struct {
 	nextConfigPublishTime time.Time
 	statusPublishCounter  int64
 	configPublishCounter  int64
+	notificationC         chan *Notification       // protected by `mutex`, nil if notifications are disabled
+	shadowMode            bool                     // If set, messages are never delayed, see SetShadowMode.
+	gatewayTokenBridge    vaa.Address              // The token bridge on wormchain, see gateway.go.
+	gatewayTranslators    map[vaa.Address]struct{} // The ibc-translator contracts on wormchain, see gateway.go.
 }
 
 func NewChainGovernor(
@@ -150,8 +187,32 @@ func NewChainGovernor(
 	}
 }
 
+// SetShadowMode makes the governor only log and count the transfers it would enqueue instead of delaying them. It must
+// be called before the governor processes messages.
+func (gov *ChainGovernor) SetShadowMode(shadowMode bool) {
+	gov.shadowMode = shadowMode
+}
+
+// storePendingMsg writes a pending transfer to the database, unless the governor is in shadow mode. It assumes the
+// caller holds the lock.
+func (gov *ChainGovernor) storePendingMsg(pending *db.PendingTransfer) error {
+	if gov.shadowMode {
+		return nil
+	}
+	return gov.db.StorePendingMsg(pending)
+}
+
+// deletePendingMsg removes a pending transfer from the database, unless the governor is in shadow mode. It assumes the
+// caller holds the lock.
+func (gov *ChainGovernor) deletePendingMsg(pending *db.PendingTransfer) error {
+	if gov.shadowMode {
+		return nil
+	}
+	return gov.db.DeletePendingMsg(pending)
+}
+
 func (gov *ChainGovernor) Run(ctx context.Context) error {
-	gov.logger.Info("starting chain governor")
+	gov.logger.Info("starting chain governor", zap.Bool("shadowMode", gov.shadowMode))
 
 	if err := gov.initConfig(); err != nil {
 		return err
@@ -177,11 +238,18 @@ func (gov *ChainGovernor) initConfig() error {
 	gov.dayLengthInMinutes = 24 * 60
 	configTokens := tokenList()
 	configChains := chainList()
+	configGroups := tokenGroupList()
+
+	// No emitter limits are configured in any environment yet. Entries go here once an emitter other than the token
+	// bridge is to be governed.
+	var configEmitterLimits []emitterLimitConfigEntry
 
 	if gov.env == DevNetMode {
 		configTokens, configChains = gov.initDevnetConfig()
+		configGroups = devnetTokenGroupList()
 	} else if gov.env == TestNetMode {
 		configTokens, configChains = gov.initTestnetConfig()
+		configGroups = nil
 	}
 
 	for _, ct := range configTokens {
@@ -210,7 +278,7 @@ func (gov *ChainGovernor) initConfig() error {
 		}
 
 		key := tokenKey{chain: vaa.ChainID(ct.chain), addr: addr}
-		te := &tokenEntry{cfgPrice: cfgPrice, price: initialPrice, decimals: decimals, symbol: symbol, coinGeckoId: ct.coinGeckoId, token: key}
+		te := &tokenEntry{cfgPrice: cfgPrice, price: initialPrice, decimals: decimals, origDecimals: ct.decimals, symbol: symbol, coinGeckoId: ct.coinGeckoId, token: key}
 		te.updatePrice()
 
 		gov.tokens[key] = te
@@ -265,6 +333,12 @@ func (gov *ChainGovernor) initConfig() error {
 			dailyLimit:              cc.dailyLimit,
 			bigTransactionSize:      cc.bigTransactionSize,
 			checkForBigTransactions: cc.bigTransactionSize != 0,
+			fastLaneSize:            cc.fastLaneSize,
+			fastLaneLimit:           cc.fastLaneLimit,
+		}
+
+		if ce.fastLaneSize != 0 && ce.fastLaneLimit == 0 {
+			return fmt.Errorf("fast lane size is set but fast lane limit is zero for chain: %v", cc.emitterChainID)
 		}
 
 		gov.logger.Info("will monitor chain:", zap.Stringer("emitterChainId", cc.emitterChainID),
@@ -272,6 +346,8 @@ func (gov *ChainGovernor) initConfig() error {
 			zap.String("dailyLimit", fmt.Sprint(ce.dailyLimit)),
 			zap.Uint64("bigTransactionSize", ce.bigTransactionSize),
 			zap.Bool("checkForBigTransactions", ce.checkForBigTransactions),
+			zap.Uint64("fastLaneSize", ce.fastLaneSize),
+			zap.Uint64("fastLaneLimit", ce.fastLaneLimit),
 		)
 
 		gov.chains[cc.emitterChainID] = ce
@@ -281,7 +357,15 @@ func (gov *ChainGovernor) initConfig() error {
 		return fmt.Errorf("no chains are configured")
 	}
 
-	return nil
+	if err := gov.initTokenGroups(configGroups); err != nil {
+		return err
+	}
+
+	if err := gov.initGatewayConfig(gatewayConfig(gov.env)); err != nil {
+		return err
+	}
+
+	return gov.initEmitterLimits(configEmitterLimits)
 }
 
 // Returns true if the message can be published, false if it has been added to the pending list.
@@ -316,6 +400,15 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 	xferComplete, alreadySeen := gov.msgsSeen[hash]
 	if alreadySeen {
 		if !xferComplete {
+			if gov.shadowMode {
+				gov.logger.Info("allowing duplicate vaa to be published again although it is enqueued, because the governor is in shadow mode",
+					zap.String("msgID", msg.MessageIDString()),
+					zap.String("hash", hash),
+					zap.Stringer("txHash", msg.TxHash),
+				)
+				return true, nil
+			}
+
 			gov.logger.Info("ignoring duplicate vaa because it is enqueued",
 				zap.String("msgID", msg.MessageIDString()),
 				zap.String("hash", hash),
@@ -369,9 +462,15 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 
 	enqueueIt := false
 	var releaseTime time.Time
+	notificationEvent := NotificationEnqueued
+	var enqueueReason string
+	var shadowReason string
 	if ce.isBigTransfer(value) {
 		enqueueIt = true
+		shadowReason = "big_transaction"
 		releaseTime = now.Add(maxEnqueuedTime)
+		notificationEvent = NotificationBigTransfer
+		enqueueReason = "big transaction"
 		gov.logger.Error("enqueuing vaa because it is a big transaction",
 			zap.Uint64("value", value),
 			zap.Uint64("prevTotalValue", prevTotalValue),
@@ -383,8 +482,20 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 			zap.Stringer("txHash", msg.TxHash),
 		)
 	} else if newTotalValue > ce.dailyLimit {
+		if ce.isFastLaneTransfer(value) {
+			published, err := gov.publishOnFastLane(msg, ce, token, hash, value, now, startTime)
+			if err != nil {
+				return false, err
+			}
+			if published {
+				return true, nil
+			}
+		}
+
 		enqueueIt = true
+		shadowReason = "daily_limit"
 		releaseTime = now.Add(maxEnqueuedTime)
+		enqueueReason = "daily limit"
 		gov.logger.Error("enqueuing vaa because it would exceed the daily limit",
 			zap.Uint64("value", value),
 			zap.Uint64("prevTotalValue", prevTotalValue),
@@ -394,12 +505,44 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 			zap.String("hash", hash),
 			zap.Stringer("txHash", msg.TxHash),
 		)
+	} else if group, prevGroupValue, newGroupValue := ce.exceededTokenGroup(token.token, value, startTime); group != nil {
+		enqueueIt = true
+		shadowReason = "token_group"
+		releaseTime = now.Add(maxEnqueuedTime)
+		enqueueReason = fmt.Sprintf("daily limit of token group %s", group.name)
+		gov.logger.Error("enqueuing vaa because it would exceed the daily limit of its token group",
+			zap.String("group", group.name),
+			zap.Uint64("value", value),
+			zap.Uint64("prevGroupValue", prevGroupValue),
+			zap.Uint64("newGroupValue", newGroupValue),
+			zap.Uint64("groupDailyLimit", group.dailyLimit),
+			zap.Stringer("releaseTime", releaseTime),
+			zap.String("msgID", msg.MessageIDString()),
+			zap.String("hash", hash),
+			zap.Stringer("txHash", msg.TxHash),
+		)
+	} else if limit, prevEmitterValue, newEmitterValue := ce.exceededEmitterLimit(msg.EmitterAddress, value, startTime); limit != nil {
+		enqueueIt = true
+		shadowReason = "emitter_limit"
+		releaseTime = now.Add(maxEnqueuedTime)
+		enqueueReason = fmt.Sprintf("daily limit of emitter %s", limit.name)
+		gov.logger.Error("enqueuing vaa because it would exceed the daily limit of its emitter",
+			zap.String("emitterLimit", limit.name),
+			zap.Uint64("value", value),
+			zap.Uint64("prevEmitterValue", prevEmitterValue),
+			zap.Uint64("newEmitterValue", newEmitterValue),
+			zap.Uint64("emitterDailyLimit", limit.dailyLimit),
+			zap.Stringer("releaseTime", releaseTime),
+			zap.String("msgID", msg.MessageIDString()),
+			zap.String("hash", hash),
+			zap.Stringer("txHash", msg.TxHash),
+		)
 	}
 
 	if enqueueIt {
 		dbData := db.PendingTransfer{ReleaseTime: releaseTime, Msg: *msg}
 		gov.logger.Info("writing pending transfer to database", zap.String("msgId", msg.MessageIDString()))
-		err = gov.db.StorePendingMsg(&dbData)
+		err = gov.storePendingMsg(&dbData)
 		if err != nil {
 			gov.logger.Error("failed to store pending vaa",
 				zap.String("msgID", msg.MessageIDString()),
@@ -411,8 +554,24 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 		}
 		gov.logger.Info("wrote pending transfer to database", zap.String("msgId", msg.MessageIDString()))
 
-		ce.pending = append(ce.pending, &pendingEntry{token: token, amount: payload.Amount, hash: hash, dbData: dbData})
+		pe := &pendingEntry{token: token, amount: payload.Amount, hash: hash, dbData: dbData}
+		ce.pending = append(ce.pending, pe)
 		gov.msgsSeen[hash] = transferEnqueued
+		gov.queueNotificationAlreadyLocked(newNotification(notificationEvent, enqueueReason, pe, value))
+
+		// In shadow mode, the transfer stays in the pending list so that the following decisions are the ones an enforcing
+		// governor would make, but the message is published anyway.
+		if gov.shadowMode {
+			metricShadowEnqueuedVAAs.WithLabelValues(ce.emitterChainId.String(), shadowReason).Inc()
+			gov.logger.Warn("publishing vaa that would have been enqueued, because the governor is in shadow mode",
+				zap.String("reason", enqueueReason),
+				zap.Uint64("value", value),
+				zap.String("msgID", msg.MessageIDString()),
+				zap.String("hash", hash),
+				zap.Stringer("txHash", msg.TxHash),
+			)
+			return true, nil
+		}
 		return false, nil
 	}
 
@@ -426,13 +585,14 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 	)
 
 	xfer := db.Transfer{Timestamp: now,
-		Value:          value,
-		OriginChain:    token.token.chain,
-		OriginAddress:  token.token.addr,
-		EmitterChain:   msg.EmitterChain,
-		EmitterAddress: msg.EmitterAddress,
-		MsgID:          msg.MessageIDString(),
-		Hash:           hash,
+		Value:           value,
+		OriginChain:     token.token.chain,
+		OriginAddress:   token.token.addr,
+		EmitterChain:    msg.EmitterChain,
+		EmitterAddress:  msg.EmitterAddress,
+		AttributedChain: attributedChain(ce, msg.EmitterChain),
+		MsgID:           msg.MessageIDString(),
+		Hash:            hash,
 	}
 	err = gov.db.StoreTransfer(&xfer)
 	if err != nil {
@@ -449,6 +609,71 @@ func (gov *ChainGovernor) ProcessMsgForTime(msg *common.MessagePublication, now
 	return true, nil
 }
 
+// publishOnFastLane records a small transfer that would otherwise be enqueued because of the daily limit against the
+// fast lane of the chain. It returns false if the fast lane limit would be exceeded. It assumes the caller holds the lock.
+func (gov *ChainGovernor) publishOnFastLane(
+	msg *common.MessagePublication,
+	ce *chainEntry,
+	token *tokenEntry,
+	hash string,
+	value uint64,
+	now time.Time,
+	startTime time.Time,
+) (bool, error) {
+	prevFastLaneValue, err := gov.TrimAndSumFastLaneValueForChain(ce, startTime)
+	if err != nil {
+		gov.logger.Error("failed to trim fast lane transfers",
+			zap.String("msgID", msg.MessageIDString()),
+			zap.String("hash", hash),
+			zap.Stringer("txHash", msg.TxHash),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	newFastLaneValue := prevFastLaneValue + value
+	if newFastLaneValue < prevFastLaneValue || newFastLaneValue > ce.fastLaneLimit {
+		metricFastLaneTransfers.WithLabelValues(ce.emitterChainId.String(), "limit_exceeded").Inc()
+		return false, nil
+	}
+
+	gov.logger.Info("posting vaa on the fast lane because it is a small transfer",
+		zap.Uint64("value", value),
+		zap.Uint64("prevFastLaneValue", prevFastLaneValue),
+		zap.Uint64("newFastLaneValue", newFastLaneValue),
+		zap.Uint64("fastLaneLimit", ce.fastLaneLimit),
+		zap.String("msgID", msg.MessageIDString()),
+		zap.String("hash", hash),
+		zap.Stringer("txHash", msg.TxHash),
+	)
+
+	xfer := db.Transfer{Timestamp: now,
+		Value:           value,
+		OriginChain:     token.token.chain,
+		OriginAddress:   token.token.addr,
+		EmitterChain:    msg.EmitterChain,
+		EmitterAddress:  msg.EmitterAddress,
+		AttributedChain: attributedChain(ce, msg.EmitterChain),
+		MsgID:           msg.MessageIDString(),
+		Hash:            hash,
+		FastLane:        true,
+	}
+	if err := gov.db.StoreTransfer(&xfer); err != nil {
+		gov.logger.Error("failed to store fast lane transfer",
+			zap.String("msgID", msg.MessageIDString()),
+			zap.String("hash", hash),
+			zap.Stringer("txHash", msg.TxHash),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	ce.fastLaneTransfers = append(ce.fastLaneTransfers, &xfer)
+	gov.msgsSeen[hash] = transferComplete
+	metricFastLaneTransfers.WithLabelValues(ce.emitterChainId.String(), "published").Inc()
+	return true, nil
+}
+
 // IsGovernedMsg determines if the message applies to the governor. It grabs the lock.
 func (gov *ChainGovernor) IsGovernedMsg(msg *common.MessagePublication) (msgIsGoverned bool, err error) {
 	gov.mutex.Lock()
@@ -459,19 +684,24 @@ func (gov *ChainGovernor) IsGovernedMsg(msg *common.MessagePublication) (msgIsGo
 
 // parseMsgAlreadyLocked determines if the message applies to the governor and also returns data useful to the governor. It assumes the caller holds the lock.
 func (gov *ChainGovernor) parseMsgAlreadyLocked(msg *common.MessagePublication) (bool, *chainEntry, *tokenEntry, *vaa.TransferPayloadHdr, error) {
-	// If we don't care about this chain, the VAA can be published.
-	ce, exists := gov.chains[msg.EmitterChain]
-	if !exists {
-		if msg.EmitterChain != vaa.ChainIDPythNet {
-			gov.logger.Info("ignoring vaa because the emitter chain is not configured", zap.String("msgID", msg.MessageIDString()))
+	// Transfers from Gateway chains count against the limit of their source chain rather than wormchain.
+	ce, isGatewayTransfer := gov.gatewaySourceChain(msg)
+	if !isGatewayTransfer {
+		// If we don't care about this chain, the VAA can be published.
+		var exists bool
+		ce, exists = gov.chains[msg.EmitterChain]
+		if !exists {
+			if msg.EmitterChain != vaa.ChainIDPythNet {
+				gov.logger.Info("ignoring vaa because the emitter chain is not configured", zap.String("msgID", msg.MessageIDString()))
+			}
+			return false, nil, nil, nil, nil
 		}
-		return false, nil, nil, nil, nil
-	}
 
-	// If we don't care about this emitter, the VAA can be published.
-	if msg.EmitterAddress != ce.emitterAddr {
-		gov.logger.Info("ignoring vaa because the emitter address is not configured", zap.String("msgID", msg.MessageIDString()))
-		return false, nil, nil, nil, nil
+		// If we don't care about this emitter, the VAA can be published.
+		if !ce.isGovernedEmitter(msg.EmitterAddress) {
+			gov.logger.Info("ignoring vaa because the emitter address is not configured", zap.String("msgID", msg.MessageIDString()))
+			return false, nil, nil, nil, nil
+		}
 	}
 
 	// We only care about transfers.
@@ -516,6 +746,12 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 	}
 
 	for _, ce := range gov.chains {
+		if _, err := gov.TrimAndSumFastLaneValueForChain(ce, startTime); err != nil {
+			gov.logger.Error("failed to trim fast lane transfers", zap.Error(err))
+			gov.msgsToPublish = msgsToPublish
+			return nil, err
+		}
+
 		// Keep going as long as we find something that will fit.
 		for {
 			foundOne := false
@@ -574,6 +810,16 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 						continue
 					}
 
+					if group, _, _ := ce.exceededTokenGroup(pe.token.token, value, startTime); group != nil {
+						// This one won't fit in its token group. Keep checking other enqueued ones.
+						continue
+					}
+
+					if limit, _, _ := ce.exceededEmitterLimit(pe.dbData.Msg.EmitterAddress, value, startTime); limit != nil {
+						// This one won't fit in the limit of its emitter. Keep checking other enqueued ones.
+						continue
+					}
+
 					gov.logger.Info("posting pending vaa",
 						zap.Stringer("amount", pe.amount),
 						zap.Stringer("price", pe.token.price),
@@ -588,13 +834,14 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 
 				if countsTowardsTransfers {
 					xfer := db.Transfer{Timestamp: now,
-						Value:          value,
-						OriginChain:    pe.token.token.chain,
-						OriginAddress:  pe.token.token.addr,
-						EmitterChain:   pe.dbData.Msg.EmitterChain,
-						EmitterAddress: pe.dbData.Msg.EmitterAddress,
-						MsgID:          pe.dbData.Msg.MessageIDString(),
-						Hash:           pe.hash,
+						Value:           value,
+						OriginChain:     pe.token.token.chain,
+						OriginAddress:   pe.token.token.addr,
+						EmitterChain:    pe.dbData.Msg.EmitterChain,
+						EmitterAddress:  pe.dbData.Msg.EmitterAddress,
+						AttributedChain: attributedChain(ce, pe.dbData.Msg.EmitterChain),
+						MsgID:           pe.dbData.Msg.MessageIDString(),
+						Hash:            pe.hash,
 					}
 
 					if err := gov.db.StoreTransfer(&xfer); err != nil {
@@ -608,7 +855,7 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 					delete(gov.msgsSeen, pe.hash)
 				}
 
-				if err := gov.db.DeletePendingMsg(&pe.dbData); err != nil {
+				if err := gov.deletePendingMsg(&pe.dbData); err != nil {
 					gov.msgsToPublish = msgsToPublish
 					return nil, err
 				}
@@ -624,6 +871,18 @@ func (gov *ChainGovernor) CheckPendingForTime(now time.Time) ([]*common.MessageP
 		}
 	}
 
+	// In shadow mode, the released messages have already been published when they were enqueued.
+	if gov.shadowMode {
+		for _, msg := range msgsToPublish {
+			metricShadowReleasedVAAs.WithLabelValues(msg.EmitterChain.String()).Inc()
+			gov.logger.Info("vaa would have been released now, not publishing it again because the governor is in shadow mode",
+				zap.String("msgID", msg.MessageIDString()),
+				zap.Stringer("txHash", msg.TxHash),
+			)
+		}
+		return nil, nil
+	}
+
 	return msgsToPublish, nil
 }
 
@@ -651,6 +910,11 @@ func (gov *ChainGovernor) TrimAndSumValueForChain(ce *chainEntry, startTime time
 	return sum, err
 }
 
+func (gov *ChainGovernor) TrimAndSumFastLaneValueForChain(ce *chainEntry, startTime time.Time) (sum uint64, err error) {
+	sum, ce.fastLaneTransfers, err = gov.TrimAndSumValue(ce.fastLaneTransfers, startTime)
+	return sum, err
+}
+
 func (gov *ChainGovernor) TrimAndSumValue(transfers []*db.Transfer, startTime time.Time) (uint64, []*db.Transfer, error) {
 	if len(transfers) == 0 {
 		return 0, transfers, nil
@@ -3,7 +3,10 @@
 package governor
 
 import (
+	"encoding/hex"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/db"
@@ -36,12 +39,13 @@ func (gov *ChainGovernor) loadFromDBAlreadyLocked() error {
 		}
 	}
 
+	startTime := now.Add(-time.Minute * time.Duration(gov.dayLengthInMinutes))
+	var expired []*db.Transfer
 	if len(xfers) != 0 {
 		sort.SliceStable(xfers, func(i, j int) bool {
 			return xfers[i].Timestamp.Before(xfers[j].Timestamp)
 		})
 
-		startTime := now.Add(-time.Minute * time.Duration(gov.dayLengthInMinutes))
 		for _, xfer := range xfers {
 			if startTime.Before(xfer.Timestamp) {
 				gov.reloadTransfer(xfer, now, startTime)
@@ -49,42 +53,242 @@ func (gov *ChainGovernor) loadFromDBAlreadyLocked() error {
 				if err := gov.db.DeleteTransfer(xfer); err != nil {
 					return err
 				}
+				expired = append(expired, xfer)
 			}
 		}
 	}
 
+	gov.reconcileWithSignedVAAs(startTime, expired)
 	return nil
 }
 
-func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer, now time.Time) {
-	msg := &pending.Msg
-	ce, exists := gov.chains[msg.EmitterChain]
+// reconcileWithSignedVAAs checks that every governed transfer with a signed VAA in the database since startTime is
+// accounted for, and repairs the transfers that are missing, for instance because their entry was lost or corrupted.
+// Since the VAA does not say whether the transfer was published on the fast lane, a repaired transfer always counts
+// towards the daily limit.
+//
+// To avoid reading the whole history of the emitters, the signed VAAs are only read from the sequence following the
+// latest expired transfer, or else from the earliest transfer or enqueued VAA the governor knows of. Emitters without
+// any are not reconciled. Reconciliation is best effort, errors are logged rather than preventing the governor from starting.
+func (gov *ChainGovernor) reconcileWithSignedVAAs(startTime time.Time, expired []*db.Transfer) {
+	type emitter struct {
+		chain vaa.ChainID
+		addr  vaa.Address
+	}
+
+	var emitters []emitter
+	for _, ce := range gov.chains {
+		for _, emitterAddr := range ce.governedEmitters() {
+			emitters = append(emitters, emitter{chain: ce.emitterChainId, addr: emitterAddr})
+		}
+	}
+
+	// Gateway transfers are governed even if wormchain itself is not.
+	if _, exists := gov.chains[vaa.ChainIDWormchain]; !exists && len(gov.gatewayTranslators) != 0 {
+		emitters = append(emitters, emitter{chain: vaa.ChainIDWormchain, addr: gov.gatewayTokenBridge})
+	}
+
+	repaired := false
+	for _, e := range emitters {
+		fromSequence, exists := gov.reconcileFromSequence(e.chain, e.addr, expired)
+		if !exists {
+			gov.logger.Debug("no known transfers to start reconciling signed VAAs from",
+				zap.Stringer("EmitterChain", e.chain),
+				zap.Stringer("EmitterAddress", e.addr),
+			)
+			continue
+		}
+
+		vaas, err := gov.db.GetSignedVAAsSince(gov.logger, e.chain, e.addr, fromSequence, startTime)
+		if err != nil {
+			gov.logger.Error("failed to read signed VAAs to reconcile transfers",
+				zap.Stringer("EmitterChain", e.chain),
+				zap.Stringer("EmitterAddress", e.addr),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		for _, v := range vaas {
+			if gov.repairTransferIfMissing(v) {
+				repaired = true
+			}
+		}
+	}
+
+	// The transfers are trimmed in timestamp order, so they need to be sorted again if any were repaired.
+	if repaired {
+		for _, ce := range gov.chains {
+			sort.SliceStable(ce.transfers, func(i, j int) bool {
+				return ce.transfers[i].Timestamp.Before(ce.transfers[j].Timestamp)
+			})
+		}
+	}
+}
+
+// reconcileFromSequence returns the sequence of the emitter from which reconcileWithSignedVAAs reads the signed VAAs:
+// the one following the latest expired transfer if there is one, or else the earliest of the known transfers and
+// enqueued VAAs. It returns false if the governor knows of no message of the emitter.
+func (gov *ChainGovernor) reconcileFromSequence(emitterChain vaa.ChainID, emitterAddr vaa.Address, expired []*db.Transfer) (uint64, bool) {
+	var latestExpired uint64
+	foundExpired := false
+	for _, xfer := range expired {
+		if xfer.EmitterChain != emitterChain || xfer.EmitterAddress != emitterAddr {
+			continue
+		}
+		if seq, ok := msgIDSequence(xfer.MsgID); ok && (!foundExpired || seq > latestExpired) {
+			latestExpired, foundExpired = seq, true
+		}
+	}
+	if foundExpired {
+		return latestExpired + 1, true
+	}
+
+	var earliest uint64
+	found := false
+	update := func(seq uint64) {
+		if !found || seq < earliest {
+			earliest, found = seq, true
+		}
+	}
+	for _, ce := range gov.chains {
+		for _, transfers := range [][]*db.Transfer{ce.transfers, ce.fastLaneTransfers} {
+			for _, xfer := range transfers {
+				if xfer.EmitterChain != emitterChain || xfer.EmitterAddress != emitterAddr {
+					continue
+				}
+				if seq, ok := msgIDSequence(xfer.MsgID); ok {
+					update(seq)
+				}
+			}
+		}
+		for _, pe := range ce.pending {
+			if pe.dbData.Msg.EmitterChain == emitterChain && pe.dbData.Msg.EmitterAddress == emitterAddr {
+				update(pe.dbData.Msg.Sequence)
+			}
+		}
+	}
+	return earliest, found
+}
+
+// msgIDSequence returns the sequence of a message ID in the "chain/emitter/sequence" format.
+func msgIDSequence(msgID string) (uint64, bool) {
+	idx := strings.LastIndexByte(msgID, '/')
+	if idx < 0 {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(msgID[idx+1:], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return seq, true
+}
+
+// repairTransferIfMissing adds the transfer of a signed VAA if it is governed and missing, and returns whether it did.
+func (gov *ChainGovernor) repairTransferIfMissing(v *vaa.VAA) bool {
+	hash := hex.EncodeToString(v.SigningDigest().Bytes())
+	if _, alreadyExists := gov.msgsSeen[hash]; alreadyExists {
+		return false
+	}
+
+	if !vaa.IsTransfer(v.Payload) {
+		return false
+	}
+
+	// The Gateway source chain of a transfer is not part of the VAA, so a repaired transfer counts against the emitter chain.
+	ce, exists := gov.chains[v.EmitterChain]
 	if !exists {
-		gov.logger.Error("reloaded pending transfer for unsupported chain, dropping it",
-			zap.String("MsgID", msg.MessageIDString()),
-			zap.Stringer("TxHash", msg.TxHash),
-			zap.Stringer("Timestamp", msg.Timestamp),
-			zap.Uint32("Nonce", msg.Nonce),
-			zap.Uint64("Sequence", msg.Sequence),
-			zap.Uint8("ConsistencyLevel", msg.ConsistencyLevel),
-			zap.Stringer("EmitterChain", msg.EmitterChain),
-			zap.Stringer("EmitterAddress", msg.EmitterAddress),
+		return false
+	}
+
+	payload, err := vaa.DecodeTransferPayloadHdr(v.Payload)
+	if err != nil {
+		gov.logger.Error("failed to parse payload of signed VAA while reconciling transfers, ignoring it",
+			zap.String("MsgID", v.MessageID()),
+			zap.Error(err),
 		)
-		return
+		return false
 	}
 
-	if msg.EmitterAddress != ce.emitterAddr {
-		gov.logger.Error("reloaded pending transfer for unsupported emitter address, dropping it",
-			zap.String("MsgID", msg.MessageIDString()),
-			zap.Stringer("TxHash", msg.TxHash),
-			zap.Stringer("Timestamp", msg.Timestamp),
-			zap.Uint32("Nonce", msg.Nonce),
-			zap.Uint64("Sequence", msg.Sequence),
-			zap.Uint8("ConsistencyLevel", msg.ConsistencyLevel),
-			zap.Stringer("EmitterChain", msg.EmitterChain),
-			zap.Stringer("EmitterAddress", msg.EmitterAddress),
+	token, exists := gov.tokens[tokenKey{chain: payload.OriginChain, addr: payload.OriginAddress}]
+	if !exists {
+		return false
+	}
+
+	value, err := computeValue(payload.Amount, token)
+	if err != nil {
+		gov.logger.Error("failed to compute value of signed VAA while reconciling transfers, ignoring it",
+			zap.String("MsgID", v.MessageID()),
+			zap.Error(err),
 		)
-		return
+		return false
+	}
+
+	xfer := &db.Transfer{
+		Timestamp:       v.Timestamp,
+		Value:           value,
+		OriginChain:     payload.OriginChain,
+		OriginAddress:   payload.OriginAddress,
+		EmitterChain:    v.EmitterChain,
+		EmitterAddress:  v.EmitterAddress,
+		AttributedChain: attributedChain(ce, v.EmitterChain),
+		MsgID:           v.MessageID(),
+		Hash:            hash,
+	}
+
+	gov.logger.Warn("transfer with a signed VAA is missing from the database, repairing it",
+		zap.Stringer("Timestamp", xfer.Timestamp),
+		zap.Uint64("Value", xfer.Value),
+		zap.Stringer("OriginChain", xfer.OriginChain),
+		zap.Stringer("OriginAddress", xfer.OriginAddress),
+		zap.Stringer("AttributedChain", xfer.AttributedChain),
+		zap.String("MsgID", xfer.MsgID),
+		zap.String("Hash", xfer.Hash),
+	)
+
+	if err := gov.db.StoreTransfer(xfer); err != nil {
+		gov.logger.Error("failed to store repaired transfer", zap.String("MsgID", xfer.MsgID), zap.Error(err))
+		return false
+	}
+
+	ce.transfers = append(ce.transfers, xfer)
+	gov.msgsSeen[hash] = transferComplete
+	return true
+}
+
+func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer, now time.Time) {
+	msg := &pending.Msg
+	ce, isGatewayTransfer := gov.gatewaySourceChain(msg)
+	if !isGatewayTransfer {
+		var exists bool
+		ce, exists = gov.chains[msg.EmitterChain]
+		if !exists {
+			gov.logger.Error("reloaded pending transfer for unsupported chain, dropping it",
+				zap.String("MsgID", msg.MessageIDString()),
+				zap.Stringer("TxHash", msg.TxHash),
+				zap.Stringer("Timestamp", msg.Timestamp),
+				zap.Uint32("Nonce", msg.Nonce),
+				zap.Uint64("Sequence", msg.Sequence),
+				zap.Uint8("ConsistencyLevel", msg.ConsistencyLevel),
+				zap.Stringer("EmitterChain", msg.EmitterChain),
+				zap.Stringer("EmitterAddress", msg.EmitterAddress),
+			)
+			return
+		}
+
+		if !ce.isGovernedEmitter(msg.EmitterAddress) {
+			gov.logger.Error("reloaded pending transfer for unsupported emitter address, dropping it",
+				zap.String("MsgID", msg.MessageIDString()),
+				zap.Stringer("TxHash", msg.TxHash),
+				zap.Stringer("Timestamp", msg.Timestamp),
+				zap.Uint32("Nonce", msg.Nonce),
+				zap.Uint64("Sequence", msg.Sequence),
+				zap.Uint8("ConsistencyLevel", msg.ConsistencyLevel),
+				zap.Stringer("EmitterChain", msg.EmitterChain),
+				zap.Stringer("EmitterAddress", msg.EmitterAddress),
+			)
+			return
+		}
 	}
 
 	payload, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
@@ -157,19 +361,26 @@ func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer, now
 }
 
 func (gov *ChainGovernor) reloadTransfer(xfer *db.Transfer, now time.Time, startTime time.Time) {
-	ce, exists := gov.chains[xfer.EmitterChain]
+	chain := xfer.EmitterChain
+	if xfer.AttributedChain != vaa.ChainIDUnset {
+		chain = xfer.AttributedChain
+	}
+
+	ce, exists := gov.chains[chain]
 	if !exists {
 		gov.logger.Error("reloaded transfer for unsupported chain, dropping it",
 			zap.Stringer("Timestamp", xfer.Timestamp),
 			zap.Uint64("Value", xfer.Value),
 			zap.Stringer("EmitterChain", xfer.EmitterChain),
 			zap.Stringer("EmitterAddress", xfer.EmitterAddress),
+			zap.Stringer("AttributedChain", xfer.AttributedChain),
 			zap.String("MsgID", xfer.MsgID),
 		)
 		return
 	}
 
-	if xfer.EmitterAddress != ce.emitterAddr {
+	// The emitter of an attributed transfer is not an emitter of the chain it is attributed to.
+	if xfer.AttributedChain == vaa.ChainIDUnset && !ce.isGovernedEmitter(xfer.EmitterAddress) {
 		gov.logger.Error("reloaded transfer for unsupported emitter address, dropping it",
 			zap.Stringer("Timestamp", xfer.Timestamp),
 			zap.Uint64("Value", xfer.Value),
@@ -226,5 +437,9 @@ func (gov *ChainGovernor) reloadTransfer(xfer *db.Transfer, now time.Time, start
 		)
 	}
 
-	ce.transfers = append(ce.transfers, xfer)
+	if xfer.FastLane {
+		ce.fastLaneTransfers = append(ce.fastLaneTransfers, xfer)
+	} else {
+		ce.transfers = append(ce.transfers, xfer)
+	}
 }
@@ -105,8 +105,21 @@ func (gov *ChainGovernor) Status() string {
 	for _, ce := range gov.chains {
 		valueTrans := sumValue(ce.transfers, startTime)
 		s1 := fmt.Sprintf("chain: %v, dailyLimit: %v, total: %v, numPending: %v", ce.emitterChainId, ce.dailyLimit, valueTrans, len(ce.pending))
+		if ce.fastLaneSize != 0 {
+			s1 += fmt.Sprintf(", fastLaneSize: %v, fastLaneLimit: %v, fastLaneTotal: %v", ce.fastLaneSize, ce.fastLaneLimit, sumValue(ce.fastLaneTransfers, startTime))
+		}
 		resp += s1 + "\n"
 		gov.logger.Info(s1)
+		for _, ge := range ce.groups {
+			s1 := fmt.Sprintf("chain: %v, group: %v, dailyLimit: %v, total: %v, numTokens: %v", ce.emitterChainId, ge.name, ge.dailyLimit, ge.sumValue(ce.transfers, startTime), len(ge.tokens))
+			gov.logger.Info(s1)
+			resp += "   " + s1 + "\n"
+		}
+		for _, ee := range ce.emitterLimits {
+			s1 := fmt.Sprintf("chain: %v, emitter: %v (%v), dailyLimit: %v, total: %v", ce.emitterChainId, ee.name, ee.emitterAddr, ee.dailyLimit, ee.sumValue(ce.transfers, startTime))
+			gov.logger.Info(s1)
+			resp += "   " + s1 + "\n"
+		}
 		if len(ce.pending) != 0 {
 			for idx, pe := range ce.pending {
 				value, _ := computeValue(pe.amount, pe.token)
@@ -159,7 +172,7 @@ func (gov *ChainGovernor) DropPendingVAA(vaaId string) (string, error) {
 					zap.Stringer("timeStamp", pe.dbData.Msg.Timestamp),
 				)
 
-				if err := gov.db.DeletePendingMsg(&pe.dbData); err != nil {
+				if err := gov.deletePendingMsg(&pe.dbData); err != nil {
 					return "", err
 				}
 
@@ -194,11 +207,12 @@ func (gov *ChainGovernor) ReleasePendingVAA(vaaId string) (string, error) {
 				// We delete the pending message from the database, but we don't add it to the transfers
 				// because released messages do not apply to the limit.
 
-				if err := gov.db.DeletePendingMsg(&pe.dbData); err != nil {
+				if err := gov.deletePendingMsg(&pe.dbData); err != nil {
 					return "", err
 				}
 
 				ce.pending = append(ce.pending[:idx], ce.pending[idx+1:]...)
+				gov.queueNotificationAlreadyLocked(newNotification(NotificationReleased, "", pe, value))
 				str := fmt.Sprintf("pending vaa \"%v\" has been released and will be published soon", msgId)
 				return str, nil
 			}
@@ -228,7 +242,7 @@ func (gov *ChainGovernor) resetReleaseTimerForTime(vaaId string, now time.Time)
 					zap.Stringer("newReleaseTime", pe.dbData.ReleaseTime),
 				)
 
-				if err := gov.db.StorePendingMsg(&pe.dbData); err != nil {
+				if err := gov.storePendingMsg(&pe.dbData); err != nil {
 					gov.logger.Error("failed to store updated pending vaa", zap.String("msgID", msgId), zap.Error(err))
 					return "", err
 				}
@@ -358,6 +372,8 @@ func (gov *ChainGovernor) GetTokenList() []*publicrpcv1.GovernorGetTokenListResp
 			OriginChainId: uint32(tk.chain),
 			OriginAddress: "0x" + tk.addr.String(),
 			Price:         price,
+			Symbol:        te.symbol,
+			Decimals:      te.origDecimals,
 		})
 	}
 
@@ -390,6 +406,48 @@ var (
 			Help: "Chain governor number of VAAs enqueued due to limiting per chain",
 		}, []string{"chain_id", "chain_name", "enabled"})
 
+	// guardian_governor_fast_lane_available_notional{chain_id="1",chain_name="solana"} 100
+	metricFastLaneAvailableNotional = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "guardian_governor_fast_lane_available_notional",
+			Help: "Chain governor remaining available notional value in the small transfer fast lane per chain",
+		}, []string{"chain_id", "chain_name"})
+
+	// guardian_governor_fast_lane_transfers_total{chain_name="solana",result="published"} 3
+	metricFastLaneTransfers = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "guardian_governor_fast_lane_transfers_total",
+			Help: "Chain governor number of small transfers that hit the daily limit, by whether they were published through the fast lane",
+		}, []string{"chain_name", "result"})
+
+	// guardian_governor_token_group_available_notional{chain_id="2",chain_name="ethereum",group="stablecoins"} 100
+	metricTokenGroupAvailableNotional = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "guardian_governor_token_group_available_notional",
+			Help: "Chain governor remaining available notional value per token group",
+		}, []string{"chain_id", "chain_name", "group"})
+
+	// guardian_governor_emitter_limit_available_notional{chain_id="2",chain_name="ethereum",emitter="ntt-usdc"} 100
+	metricEmitterLimitAvailableNotional = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "guardian_governor_emitter_limit_available_notional",
+			Help: "Chain governor remaining available notional value per emitter limit",
+		}, []string{"chain_id", "chain_name", "emitter"})
+
+	// guardian_governor_shadow_enqueued_vaas_total{chain_name="ethereum",reason="daily_limit"} 1
+	metricShadowEnqueuedVAAs = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "guardian_governor_shadow_enqueued_vaas_total",
+			Help: "Chain governor number of VAAs that would have been enqueued if the governor were not in shadow mode, by reason",
+		}, []string{"chain_name", "reason"})
+
+	// guardian_governor_shadow_released_vaas_total{chain_name="ethereum"} 1
+	metricShadowReleasedVAAs = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "guardian_governor_shadow_released_vaas_total",
+			Help: "Chain governor number of VAAs that would have been released if the governor were not in shadow mode",
+		}, []string{"chain_name"})
+
 	// guardian_governor_total_enqueued_vaas 0
 	metricTotalEnqueuedVAAs = promauto.NewGauge(
 		prometheus.GaugeOpts{
@@ -428,6 +486,33 @@ func (gov *ChainGovernor) CollectMetrics(hb *gossipv1.Heartbeat, sendC chan<- []
 				value = ce.dailyLimit - value
 			}
 
+			if ce.fastLaneSize != 0 {
+				fastLaneValue := sumValue(ce.fastLaneTransfers, startTime)
+				fastLaneAvailable := uint64(0)
+				if fastLaneValue < ce.fastLaneLimit {
+					fastLaneAvailable = ce.fastLaneLimit - fastLaneValue
+				}
+				metricFastLaneAvailableNotional.WithLabelValues(chainId, chain.String()).Set(float64(fastLaneAvailable))
+			}
+
+			for _, ge := range ce.groups {
+				groupValue := ge.sumValue(ce.transfers, startTime)
+				groupAvailable := uint64(0)
+				if groupValue < ge.dailyLimit {
+					groupAvailable = ge.dailyLimit - groupValue
+				}
+				metricTokenGroupAvailableNotional.WithLabelValues(chainId, chain.String(), ge.name).Set(float64(groupAvailable))
+			}
+
+			for _, ee := range ce.emitterLimits {
+				emitterValue := ee.sumValue(ce.transfers, startTime)
+				emitterAvailable := uint64(0)
+				if emitterValue < ee.dailyLimit {
+					emitterAvailable = ee.dailyLimit - emitterValue
+				}
+				metricEmitterLimitAvailableNotional.WithLabelValues(chainId, chain.String(), ee.name).Set(float64(emitterAvailable))
+			}
+
 			pending := len(ce.pending)
 			totalNotional = fmt.Sprint(ce.dailyLimit)
 			available = float64(value)
@@ -534,35 +619,44 @@ func (gov *ChainGovernor) publishStatus(hb *gossipv1.Heartbeat, sendC chan<- []b
 			value = ce.dailyLimit - value
 		}
 
-		enqueuedVaas := make([]*gossipv1.ChainGovernorStatus_EnqueuedVAA, 0)
-		for _, pe := range ce.pending {
-			value, err := computeValue(pe.amount, pe.token)
-			if err != nil {
-				gov.logger.Error("failed to compute value of pending transfer", zap.String("msgID", pe.dbData.Msg.MessageIDString()), zap.Error(err))
-				value = 0
-			}
+		emitters := make([]*gossipv1.ChainGovernorStatus_Emitter, 0, 1)
+		for _, emitterAddr := range ce.governedEmitters() {
+			enqueuedVaas := make([]*gossipv1.ChainGovernorStatus_EnqueuedVAA, 0)
+			totalEnqueued := 0
+			for _, pe := range ce.pending {
+				if pe.dbData.Msg.EmitterAddress != emitterAddr {
+					continue
+				}
+				totalEnqueued++
+
+				value, err := computeValue(pe.amount, pe.token)
+				if err != nil {
+					gov.logger.Error("failed to compute value of pending transfer", zap.String("msgID", pe.dbData.Msg.MessageIDString()), zap.Error(err))
+					value = 0
+				}
 
-			if numEnqueued < 20 {
-				numEnqueued = numEnqueued + 1
-				enqueuedVaas = append(enqueuedVaas, &gossipv1.ChainGovernorStatus_EnqueuedVAA{
-					Sequence:      pe.dbData.Msg.Sequence,
-					ReleaseTime:   uint32(pe.dbData.ReleaseTime.Unix()),
-					NotionalValue: value,
-					TxHash:        pe.dbData.Msg.TxHash.String(),
-				})
+				if numEnqueued < 20 {
+					numEnqueued = numEnqueued + 1
+					enqueuedVaas = append(enqueuedVaas, &gossipv1.ChainGovernorStatus_EnqueuedVAA{
+						Sequence:      pe.dbData.Msg.Sequence,
+						ReleaseTime:   uint32(pe.dbData.ReleaseTime.Unix()),
+						NotionalValue: value,
+						TxHash:        pe.dbData.Msg.TxHash.String(),
+					})
+				}
 			}
-		}
 
-		emitter := gossipv1.ChainGovernorStatus_Emitter{
-			EmitterAddress:    "0x" + ce.emitterAddr.String(),
-			TotalEnqueuedVaas: uint64(len(ce.pending)),
-			EnqueuedVaas:      enqueuedVaas,
+			emitters = append(emitters, &gossipv1.ChainGovernorStatus_Emitter{
+				EmitterAddress:    "0x" + emitterAddr.String(),
+				TotalEnqueuedVaas: uint64(totalEnqueued),
+				EnqueuedVaas:      enqueuedVaas,
+			})
 		}
 
 		chains = append(chains, &gossipv1.ChainGovernorStatus_Chain{
 			ChainId:                    uint32(ce.emitterChainId),
 			RemainingAvailableNotional: value,
-			Emitters:                   []*gossipv1.ChainGovernorStatus_Emitter{&emitter},
+			Emitters:                   emitters,
 		})
 	}
 
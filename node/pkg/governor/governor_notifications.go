@@ -0,0 +1,287 @@
+// This file contains the notifications sent by the governor when it enqueues a transfer and when an enqueued transfer
+// is released manually, so operators get alerted without having to watch the logs. Notifications are queued while the
+// governor holds its lock and delivered by a separate runnable, so a slow sink never delays the processing of messages.
+
+package governor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// notificationQueueSize is the number of notifications that can be waiting for delivery before new ones are dropped.
+	notificationQueueSize = 100
+
+	// notificationRequestTimeout is the timeout of a single delivery attempt.
+	notificationRequestTimeout = 10 * time.Second
+
+	// notificationMaxElapsedTime is how long a delivery is retried before the notification is dropped.
+	notificationMaxElapsedTime = 2 * time.Minute
+
+	// PagerDutyEventsURL is the endpoint of the PagerDuty Events API v2.
+	PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+)
+
+var notificationsSent = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormhole_governor_notifications_total",
+		Help: "Total number of governor notifications by event and result",
+	}, []string{"event", "result"})
+
+// NotificationEvent is the event a notification is sent for.
+type NotificationEvent string
+
+const (
+	// NotificationEnqueued is sent when a transfer is enqueued because it would exceed the daily limit of its chain or
+	// token group.
+	NotificationEnqueued NotificationEvent = "enqueued"
+	// NotificationBigTransfer is sent when a transfer is enqueued because it reaches the big transaction size.
+	NotificationBigTransfer NotificationEvent = "big_transfer"
+	// NotificationReleased is sent when an enqueued transfer is released by an admin command.
+	NotificationReleased NotificationEvent = "released"
+)
+
+// Notification describes a governor event and the transfer it concerns.
+type Notification struct {
+	Event NotificationEvent `json:"event"`
+	// Reason explains why the transfer was enqueued, it is empty for releases.
+	Reason         string      `json:"reason,omitempty"`
+	MsgID          string      `json:"msgId"`
+	TxHash         string      `json:"txHash"`
+	EmitterChain   vaa.ChainID `json:"emitterChain"`
+	EmitterAddress string      `json:"emitterAddress"`
+	Sequence       uint64      `json:"sequence"`
+	Timestamp      time.Time   `json:"timestamp"`
+	OriginChain    vaa.ChainID `json:"originChain"`
+	OriginAddress  string      `json:"originAddress"`
+	TokenSymbol    string      `json:"tokenSymbol"`
+	// Amount is the raw amount of the transfer, normalized to at most 8 decimals like in the transfer payload.
+	Amount string `json:"amount"`
+	// NotionalValue is the value of the transfer in USD.
+	NotionalValue uint64 `json:"notionalValue"`
+	// ReleaseTime is when the transfer is released automatically, it is only set for enqueued transfers.
+	ReleaseTime *time.Time `json:"releaseTime,omitempty"`
+}
+
+// Summary returns a one line description of the notification.
+func (n *Notification) Summary() string {
+	switch n.Event {
+	case NotificationBigTransfer:
+		return fmt.Sprintf("governor enqueued big transfer %s worth $%d", n.MsgID, n.NotionalValue)
+	case NotificationReleased:
+		return fmt.Sprintf("governor transfer %s worth $%d was released manually", n.MsgID, n.NotionalValue)
+	default:
+		return fmt.Sprintf("governor enqueued transfer %s worth $%d (%s)", n.MsgID, n.NotionalValue, n.Reason)
+	}
+}
+
+// NotificationSink delivers governor notifications.
+type NotificationSink interface {
+	// Notify delivers a notification. It is retried if it returns an error.
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// WebhookSink POSTs each notification as JSON to an HTTP endpoint.
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewWebhookSink creates a sink posting the notifications to url.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{URL: url, Client: &http.Client{Timeout: notificationRequestTimeout}}
+}
+
+func (s *WebhookSink) Notify(ctx context.Context, n *Notification) error {
+	return postJSON(ctx, s.Client, s.URL, n)
+}
+
+// PagerDutySink triggers an alert for each notification through the PagerDuty Events API v2, or any endpoint compatible
+// with it. The message ID and event are used as the deduplication key, so retried deliveries do not create duplicate
+// alerts.
+type PagerDutySink struct {
+	URL        string
+	RoutingKey string
+	// Source identifies the guardian in the alerts.
+	Source string
+	Client *http.Client
+}
+
+// NewPagerDutySink creates a sink triggering PagerDuty alerts. If url is empty, PagerDutyEventsURL is used.
+func NewPagerDutySink(url string, routingKey string, source string) *PagerDutySink {
+	if url == "" {
+		url = PagerDutyEventsURL
+	}
+	return &PagerDutySink{URL: url, RoutingKey: routingKey, Source: source, Client: &http.Client{Timeout: notificationRequestTimeout}}
+}
+
+// pagerDutyEvent is the body of a PagerDuty Events API v2 request.
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string        `json:"summary"`
+	Source        string        `json:"source"`
+	Severity      string        `json:"severity"`
+	Component     string        `json:"component"`
+	Class         string        `json:"class"`
+	CustomDetails *Notification `json:"custom_details"`
+}
+
+func (s *PagerDutySink) Notify(ctx context.Context, n *Notification) error {
+	severity := "warning"
+	if n.Event == NotificationReleased {
+		severity = "info"
+	}
+	return postJSON(ctx, s.Client, s.URL, &pagerDutyEvent{
+		RoutingKey:  s.RoutingKey,
+		EventAction: "trigger",
+		DedupKey:    fmt.Sprintf("%s/%s", n.Event, n.MsgID),
+		Payload: pagerDutyPayload{
+			Summary:       n.Summary(),
+			Source:        s.Source,
+			Severity:      severity,
+			Component:     "governor",
+			Class:         string(n.Event),
+			CustomDetails: n,
+		},
+	})
+}
+
+// NewNotificationSink creates the sink for the given format, which is "webhook" or "pagerduty". The routing key is only
+// used by the pagerduty format, for which an empty url means PagerDutyEventsURL.
+func NewNotificationSink(format string, url string, routingKey string, source string) (NotificationSink, error) {
+	switch format {
+	case "webhook":
+		if url == "" {
+			return nil, fmt.Errorf("the webhook notification format requires a URL")
+		}
+		return NewWebhookSink(url), nil
+	case "pagerduty":
+		if routingKey == "" {
+			return nil, fmt.Errorf("the pagerduty notification format requires a routing key")
+		}
+		return NewPagerDutySink(url, routingKey, source), nil
+	default:
+		return nil, fmt.Errorf(`invalid notification format "%s", should be webhook or pagerduty`, format)
+	}
+}
+
+// postJSON posts body as JSON to url and returns an error unless the response has a 2xx status.
+func postJSON(ctx context.Context, client *http.Client, url string, body interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return backoff.Permanent(fmt.Errorf("failed to marshal notification: %w", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// newNotification creates the notification of an event for an enqueued transfer.
+func newNotification(event NotificationEvent, reason string, pe *pendingEntry, value uint64) *Notification {
+	msg := &pe.dbData.Msg
+	n := &Notification{
+		Event:          event,
+		Reason:         reason,
+		MsgID:          msg.MessageIDString(),
+		TxHash:         msg.TxHash.String(),
+		EmitterChain:   msg.EmitterChain,
+		EmitterAddress: msg.EmitterAddress.String(),
+		Sequence:       msg.Sequence,
+		Timestamp:      msg.Timestamp,
+		OriginChain:    pe.token.token.chain,
+		OriginAddress:  pe.token.token.addr.String(),
+		TokenSymbol:    pe.token.symbol,
+		Amount:         new(big.Int).Set(pe.amount).String(),
+		NotionalValue:  value,
+	}
+	if event != NotificationReleased {
+		releaseTime := pe.dbData.ReleaseTime
+		n.ReleaseTime = &releaseTime
+	}
+	return n
+}
+
+// queueNotificationAlreadyLocked queues a notification for delivery, if notifications are enabled and the governor is not
+// in shadow mode. It never blocks, the notification is dropped if the queue is full. It assumes the caller holds the lock.
+func (gov *ChainGovernor) queueNotificationAlreadyLocked(n *Notification) {
+	if gov.notificationC == nil || gov.shadowMode {
+		return
+	}
+
+	select {
+	case gov.notificationC <- n:
+	default:
+		notificationsSent.WithLabelValues(string(n.Event), "dropped").Inc()
+		gov.logger.Error("notification queue is full, dropping notification", zap.String("event", string(n.Event)), zap.String("msgID", n.MsgID))
+	}
+}
+
+// NotificationSender enables the notifications and returns the runnable delivering them to the sink. It must be called
+// before the governor processes messages.
+func (gov *ChainGovernor) NotificationSender(sink NotificationSink) supervisor.Runnable {
+	gov.mutex.Lock()
+	gov.notificationC = make(chan *Notification, notificationQueueSize)
+	notificationC := gov.notificationC
+	gov.mutex.Unlock()
+
+	return func(ctx context.Context) error {
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case n := <-notificationC:
+				bo := backoff.NewExponentialBackOff()
+				bo.MaxElapsedTime = notificationMaxElapsedTime
+				err := backoff.Retry(func() error {
+					err := sink.Notify(ctx, n)
+					if err != nil {
+						notificationsSent.WithLabelValues(string(n.Event), "retried").Inc()
+					}
+					return err
+				}, backoff.WithContext(bo, ctx))
+				if err != nil {
+					notificationsSent.WithLabelValues(string(n.Event), "failed").Inc()
+					gov.logger.Error("failed to deliver notification, dropping it", zap.String("event", string(n.Event)), zap.String("msgID", n.MsgID), zap.Error(err))
+					continue
+				}
+
+				notificationsSent.WithLabelValues(string(n.Event), "delivered").Inc()
+				gov.logger.Info("delivered notification", zap.String("event", string(n.Event)), zap.String("msgID", n.MsgID))
+			}
+		}
+	}
+}
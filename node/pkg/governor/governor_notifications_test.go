@@ -0,0 +1,178 @@
+package governor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// newGovernorForNotificationTest returns a governor with notifications enabled and a WETH token worth 88730 per 50 units
+// transferred from Ethereum, along with a function building those transfers.
+func newGovernorForNotificationTest(t *testing.T, dailyLimit uint64, bigTransactionSize uint64) (*ChainGovernor, func(sequence uint64, amount float64) *common.MessagePublication) {
+	t.Helper()
+	gov, err := newChainGovernorForTest(context.Background())
+	require.NoError(t, err)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E"       //nolint:gosec
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, dailyLimit, bigTransactionSize))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62))
+
+	// The runnable is not started, the notifications are read from the queue.
+	_ = gov.NotificationSender(nil)
+
+	newMsg := func(sequence uint64, amount float64) *common.MessagePublication {
+		return &common.MessagePublication{
+			TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:        time.Unix(int64(1654543099), 0),
+			Nonce:            uint32(1),
+			Sequence:         sequence,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   tokenBridgeAddr,
+			ConsistencyLevel: uint8(32),
+			Payload: buildMockTransferPayloadBytes(1,
+				vaa.ChainIDEthereum,
+				tokenAddrStr,
+				vaa.ChainIDPolygon,
+				"0x707f9118e33a9b8998bea41dd0d46f38bb963fc8",
+				amount,
+			),
+		}
+	}
+	return gov, newMsg
+}
+
+func TestNotificationOnBigTransferAndRelease(t *testing.T) {
+	gov, newMsg := newGovernorForNotificationTest(t, 1000000, 100000)
+	now, _ := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Jun 1, 2022 at 12:00pm (CST)")
+
+	// Transfers below the limits do not notify.
+	canPost, err := gov.ProcessMsgForTime(newMsg(1, 50), now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+	assert.Len(t, gov.notificationC, 0)
+
+	msg := newMsg(2, 100)
+	canPost, err = gov.ProcessMsgForTime(msg, now)
+	require.NoError(t, err)
+	assert.False(t, canPost)
+
+	require.Len(t, gov.notificationC, 1)
+	n := <-gov.notificationC
+	assert.Equal(t, NotificationBigTransfer, n.Event)
+	assert.Equal(t, "big transaction", n.Reason)
+	assert.Equal(t, msg.MessageIDString(), n.MsgID)
+	assert.Equal(t, msg.TxHash.String(), n.TxHash)
+	assert.Equal(t, vaa.ChainIDEthereum, n.EmitterChain)
+	assert.Equal(t, uint64(2), n.Sequence)
+	assert.Equal(t, "WETH", n.TokenSymbol)
+	assert.Equal(t, vaa.ChainIDEthereum, n.OriginChain)
+	assert.Equal(t, "10000000000", n.Amount)
+	assert.Equal(t, uint64(177461), n.NotionalValue)
+	require.NotNil(t, n.ReleaseTime)
+	assert.Equal(t, now.Add(maxEnqueuedTime), *n.ReleaseTime)
+
+	_, err = gov.ReleasePendingVAA(msg.MessageIDString())
+	require.NoError(t, err)
+
+	require.Len(t, gov.notificationC, 1)
+	n = <-gov.notificationC
+	assert.Equal(t, NotificationReleased, n.Event)
+	assert.Equal(t, msg.MessageIDString(), n.MsgID)
+	assert.Equal(t, uint64(177461), n.NotionalValue)
+	assert.Nil(t, n.ReleaseTime)
+}
+
+func TestNotificationOnDailyLimit(t *testing.T) {
+	gov, newMsg := newGovernorForNotificationTest(t, 100000, 0)
+	now, _ := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Jun 1, 2022 at 12:00pm (CST)")
+
+	canPost, err := gov.ProcessMsgForTime(newMsg(1, 50), now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	canPost, err = gov.ProcessMsgForTime(newMsg(2, 50), now)
+	require.NoError(t, err)
+	assert.False(t, canPost)
+
+	require.Len(t, gov.notificationC, 1)
+	n := <-gov.notificationC
+	assert.Equal(t, NotificationEnqueued, n.Event)
+	assert.Equal(t, "daily limit", n.Reason)
+	assert.Equal(t, uint64(88730), n.NotionalValue)
+}
+
+func TestNotificationsDisabled(t *testing.T) {
+	gov, err := newChainGovernorForTest(context.Background())
+	require.NoError(t, err)
+
+	// Queuing a notification without a sender is a no-op.
+	gov.queueNotificationAlreadyLocked(&Notification{Event: NotificationEnqueued})
+	assert.Nil(t, gov.notificationC)
+}
+
+func TestNotificationSinks(t *testing.T) {
+	var received []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received = append(received, body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	n := &Notification{Event: NotificationBigTransfer, Reason: "big transaction", MsgID: "2/0000/42", NotionalValue: 177462}
+
+	webhook, err := NewNotificationSink("webhook", server.URL, "", "")
+	require.NoError(t, err)
+	require.NoError(t, webhook.Notify(context.Background(), n))
+	require.Len(t, received, 1)
+	assert.Equal(t, "big_transfer", received[0]["event"])
+	assert.Equal(t, "2/0000/42", received[0]["msgId"])
+
+	pagerDuty, err := NewNotificationSink("pagerduty", server.URL, "routing-key", "guardian-0")
+	require.NoError(t, err)
+	require.NoError(t, pagerDuty.Notify(context.Background(), n))
+	require.Len(t, received, 2)
+	assert.Equal(t, "routing-key", received[1]["routing_key"])
+	assert.Equal(t, "trigger", received[1]["event_action"])
+	assert.Equal(t, "big_transfer/2/0000/42", received[1]["dedup_key"])
+	payload := received[1]["payload"].(map[string]interface{})
+	assert.Equal(t, "governor enqueued big transfer 2/0000/42 worth $177462", payload["summary"])
+	assert.Equal(t, "guardian-0", payload["source"])
+	assert.Equal(t, "warning", payload["severity"])
+	assert.Equal(t, "2/0000/42", payload["custom_details"].(map[string]interface{})["msgId"])
+
+	_, err = NewNotificationSink("email", server.URL, "", "")
+	assert.ErrorContains(t, err, `invalid notification format "email"`)
+	_, err = NewNotificationSink("webhook", "", "", "")
+	assert.Error(t, err)
+	_, err = NewNotificationSink("pagerduty", "", "", "")
+	assert.Error(t, err)
+
+	sink, err := NewNotificationSink("pagerduty", "", "routing-key", "guardian-0")
+	require.NoError(t, err)
+	assert.Equal(t, PagerDutyEventsURL, sink.(*PagerDutySink).URL)
+}
+
+func TestNotificationSinkRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL).Notify(context.Background(), &Notification{Event: NotificationReleased})
+	assert.ErrorContains(t, err, "notification endpoint returned status 400")
+}
@@ -3,6 +3,9 @@
 // The initial prices are read from the static config (tokens.go). After that, prices are
 // queried from CoinGecko. The chain governor then uses the maximum of the static price and
 // the latest CoinGecko price. The CoinGecko poll interval is specified by coinGeckoQueryIntervalInMins.
+//
+// The EVM watchers can also be configured to read gas token prices from on-chain oracles. These
+// are used as a secondary source, in place of the CoinGecko price when it is not available.
 
 package governor
 
@@ -19,6 +22,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 )
@@ -29,6 +33,10 @@ import (
 // coinGeckoQueryIntervalInMins specifies how often we query CoinGecko for prices.
 const coinGeckoQueryIntervalInMins = 15
 
+// oraclePriceMaxAge is how long after the oracle last updated it an oracle price can be used. Since we take the max of the configured
+// price and the oracle price, a stale price can only make us more conservative.
+const oraclePriceMaxAge = 24 * time.Hour
+
 // tokensPerCoinGeckoQuery specifies how many tokens will be in each CoinGecko query. The token list will be broken up into chunks of this size.
 const tokensPerCoinGeckoQuery = 200
 
@@ -197,7 +205,8 @@ func (gov *ChainGovernor) queryCoinGecko() error {
 					zap.Stringer("cfgPrice", te.cfgPrice),
 				)
 
-				te.price = te.cfgPrice
+				te.coinGeckoPrice = nil
+				te.updatePrice()
 				// Don't update the timestamp so we'll know when we last received an update from CoinGecko.
 			}
 		}
@@ -255,18 +264,71 @@ func (gov *ChainGovernor) revertAllPrices() {
 				zap.Stringer("cfgPrice", te.cfgPrice),
 			)
 
-			te.price = te.cfgPrice
+			te.coinGeckoPrice = nil
+			te.updatePrice()
 			// Don't update the timestamp so we'll know when we last received an update from CoinGecko.
 		}
 	}
 }
 
 // updatePrice updates the price of a single token. We should use the max(coinGeckoPrice, configuredPrice) as our price for computing notional value.
+// If there is no CoinGecko price, a recent oracle price is used in its place.
 func (te tokenEntry) updatePrice() {
-	if (te.coinGeckoPrice == nil) || (te.coinGeckoPrice.Cmp(te.cfgPrice) < 0) {
+	price := te.coinGeckoPrice
+	if price == nil && te.oraclePrice != nil && time.Since(te.oraclePriceTime) <= oraclePriceMaxAge {
+		price = te.oraclePrice
+	}
+
+	if (price == nil) || (price.Cmp(te.cfgPrice) < 0) {
 		te.price.Set(te.cfgPrice)
 	} else {
-		te.price.Set(te.coinGeckoPrice)
+		te.price.Set(price)
+	}
+}
+
+// SetOraclePrice records the price of a gas token read from an on-chain oracle by a watcher. Oracle prices are a secondary source,
+// only used when the CoinGecko price for the token is not available.
+func (gov *ChainGovernor) SetOraclePrice(p *common.GasTokenPrice) error {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	te, exists := gov.tokens[tokenKey{chain: p.ChainID, addr: p.TokenAddress}]
+	if !exists {
+		return fmt.Errorf("token %v:%v is not governed", p.ChainID, p.TokenAddress)
+	}
+
+	if p.Price <= 0 {
+		return fmt.Errorf("invalid oracle price for %s: %f", te.symbol, p.Price)
+	}
+
+	te.oraclePrice = big.NewFloat(p.Price)
+	te.oraclePriceTime = p.UpdatedAt
+	te.updatePrice()
+
+	gov.logger.Debug("received oracle price",
+		zap.String("symbol", te.symbol),
+		zap.Float64("oraclePrice", p.Price),
+		zap.Time("updatedAt", p.UpdatedAt),
+		zap.Stringer("price", te.price),
+	)
+
+	return nil
+}
+
+// OraclePriceHandler returns the runnable that records the gas token prices published by the watchers.
+func (gov *ChainGovernor) OraclePriceHandler(priceC <-chan *common.GasTokenPrice) supervisor.Runnable {
+	return func(ctx context.Context) error {
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case p := <-priceC:
+				if err := gov.SetOraclePrice(p); err != nil {
+					gov.logger.Error("failed to set oracle price", zap.Stringer("chainID", p.ChainID), zap.Error(err))
+				}
+			}
+		}
 	}
 }
 
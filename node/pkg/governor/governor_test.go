@@ -3,6 +3,7 @@ package governor
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -66,6 +68,34 @@ func (gov *ChainGovernor) setChainForTesting(emitterChainId vaa.ChainID, emitter
 	return nil
 }
 
+func (gov *ChainGovernor) setFastLaneForTesting(emitterChainId vaa.ChainID, fastLaneSize uint64, fastLaneLimit uint64) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce := gov.chains[emitterChainId]
+	ce.fastLaneSize = fastLaneSize
+	ce.fastLaneLimit = fastLaneLimit
+}
+
+func (gov *ChainGovernor) setTokenGroupForTesting(emitterChainId vaa.ChainID, name string, dailyLimit uint64, tokenAddrStrs ...string) error {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	cg := tokenGroupConfigEntry{name: name, emitterChainID: emitterChainId, dailyLimit: dailyLimit}
+	for _, addr := range tokenAddrStrs {
+		cg.tokens = append(cg.tokens, tokenGroupMemberConfigEntry{chain: uint16(emitterChainId), addr: addr})
+	}
+
+	return gov.initTokenGroups([]tokenGroupConfigEntry{cg})
+}
+
+func (gov *ChainGovernor) setEmitterLimitForTesting(emitterChainId vaa.ChainID, name string, emitterAddrStr string, dailyLimit uint64) error {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	return gov.initEmitterLimits([]emitterLimitConfigEntry{{name: name, emitterChainID: emitterChainId, emitterAddr: emitterAddrStr, dailyLimit: dailyLimit}})
+}
+
 func (gov *ChainGovernor) setTokenForTesting(tokenChainID vaa.ChainID, tokenAddrStr string, symbol string, price float64) error {
 	gov.mutex.Lock()
 	defer gov.mutex.Unlock()
@@ -80,7 +110,7 @@ func (gov *ChainGovernor) setTokenForTesting(tokenChainID vaa.ChainID, tokenAddr
 	decimals, _ := decimalsFloat.Int(nil)
 
 	key := tokenKey{chain: vaa.ChainID(tokenChainID), addr: tokenAddr}
-	te := &tokenEntry{cfgPrice: bigPrice, price: bigPrice, decimals: decimals, symbol: symbol, coinGeckoId: symbol, token: key}
+	te := &tokenEntry{cfgPrice: bigPrice, price: new(big.Float).Set(bigPrice), decimals: decimals, symbol: symbol, coinGeckoId: symbol, token: key}
 	gov.tokens[key] = te
 	cge, cgExists := gov.tokensByCoinGeckoId[te.coinGeckoId]
 	if !cgExists {
@@ -618,6 +648,336 @@ func TestTransfersUpToAndOverTheLimit(t *testing.T) {
 	assert.Equal(t, 4, len(gov.msgsSeen))
 }
 
+func TestSmallTransfersUseFastLaneWhenDailyLimitIsReached(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 5000, 0)
+	require.NoError(t, err)
+	gov.setFastLaneForTesting(vaa.ChainIDEthereum, 3000, 5000)
+	err = gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62)
+	require.NoError(t, err)
+
+	smallPayload := buildMockTransferPayloadBytes(1,
+		vaa.ChainIDEthereum,
+		tokenAddrStr,
+		vaa.ChainIDPolygon,
+		toAddrStr,
+		1.25,
+	)
+
+	largePayload := buildMockTransferPayloadBytes(1,
+		vaa.ChainIDEthereum,
+		tokenAddrStr,
+		vaa.ChainIDPolygon,
+		toAddrStr,
+		2.5,
+	)
+
+	newMsg := func(seq uint64, payload []byte) *common.MessagePublication {
+		return &common.MessagePublication{
+			TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:        time.Unix(int64(1654543099), 0),
+			Nonce:            uint32(1),
+			Sequence:         seq,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   tokenBridgeAddr,
+			ConsistencyLevel: uint8(32),
+			Payload:          payload,
+		}
+	}
+
+	now := time.Now()
+
+	// The first two fit within the daily limit.
+	for seq := uint64(1); seq <= 2; seq++ {
+		canPost, err := gov.ProcessMsgForTime(newMsg(seq, smallPayload), now)
+		require.NoError(t, err)
+		assert.Equal(t, true, canPost)
+	}
+
+	numTrans, valueTrans, numPending, _ := gov.getStatsForAllChains()
+	assert.Equal(t, 2, numTrans)
+	assert.Equal(t, uint64(4436), valueTrans)
+	assert.Equal(t, 0, numPending)
+
+	// A transfer above the fast lane size gets enqueued.
+	canPost, err := gov.ProcessMsgForTime(newMsg(3, largePayload), now)
+	require.NoError(t, err)
+	assert.Equal(t, false, canPost)
+
+	// But small ones go through the fast lane until its limit is reached.
+	for seq := uint64(4); seq <= 5; seq++ {
+		canPost, err := gov.ProcessMsgForTime(newMsg(seq, smallPayload), now)
+		require.NoError(t, err)
+		assert.Equal(t, true, canPost)
+	}
+
+	canPost, err = gov.ProcessMsgForTime(newMsg(6, smallPayload), now)
+	require.NoError(t, err)
+	assert.Equal(t, false, canPost)
+
+	numTrans, valueTrans, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 2, numTrans)
+	assert.Equal(t, uint64(4436), valueTrans)
+	assert.Equal(t, 2, numPending)
+	assert.Equal(t, uint64(4436+2218), valuePending)
+	assert.Equal(t, 6, len(gov.msgsSeen))
+
+	ce := gov.chains[vaa.ChainIDEthereum]
+	require.Equal(t, 2, len(ce.fastLaneTransfers))
+	assert.True(t, ce.fastLaneTransfers[0].FastLane)
+	assert.Equal(t, uint64(4436), sumValue(ce.fastLaneTransfers, now.Add(-time.Hour)))
+
+	// Once the fast lane transfers age out of the window, the fast lane is available again.
+	_, err = gov.CheckPendingForTime(now.Add(time.Hour * 25))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(ce.fastLaneTransfers))
+}
+
+func TestTransfersAreEnqueuedWhenTokenGroupLimitIsReached(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	wethAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E"  //nolint:gosec
+	stethAddrStr := "0xae7ab96520de3a18e5e111b5eaab095312d7fe84" //nolint:gosec
+	wbtcAddrStr := "0x2260fac5e5542a773aa44fbcfedf7c193bc2c599"  //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(60)
+	err = gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 100000, 0)
+	require.NoError(t, err)
+	for _, tokenAddrStr := range []string{wethAddrStr, stethAddrStr, wbtcAddrStr} {
+		err = gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, tokenAddrStr, 1774.62)
+		require.NoError(t, err)
+	}
+	err = gov.setTokenGroupForTesting(vaa.ChainIDEthereum, "eth-derivatives", 5000, wethAddrStr, stethAddrStr)
+	require.NoError(t, err)
+
+	newMsg := func(seq uint64, tokenAddrStr string) *common.MessagePublication {
+		return &common.MessagePublication{
+			TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:        time.Unix(int64(1654543099), 0),
+			Nonce:            uint32(1),
+			Sequence:         seq,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   tokenBridgeAddr,
+			ConsistencyLevel: uint8(32),
+			Payload: buildMockTransferPayloadBytes(1,
+				vaa.ChainIDEthereum,
+				tokenAddrStr,
+				vaa.ChainIDPolygon,
+				toAddrStr,
+				1.25,
+			),
+		}
+	}
+
+	now := time.Now()
+
+	// Transfers of both tokens in the group count towards its limit.
+	canPost, err := gov.ProcessMsgForTime(newMsg(1, wethAddrStr), now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	canPost, err = gov.ProcessMsgForTime(newMsg(2, stethAddrStr), now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	// The group limit is reached, even though the chain is well below its own limit.
+	canPost, err = gov.ProcessMsgForTime(newMsg(3, wethAddrStr), now)
+	require.NoError(t, err)
+	assert.Equal(t, false, canPost)
+
+	// Tokens outside of the group are not affected.
+	canPost, err = gov.ProcessMsgForTime(newMsg(4, wbtcAddrStr), now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	numTrans, valueTrans, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 3, numTrans)
+	assert.Equal(t, uint64(3*2218), valueTrans)
+	assert.Equal(t, 1, numPending)
+	assert.Equal(t, uint64(2218), valuePending)
+
+	ce := gov.chains[vaa.ChainIDEthereum]
+	require.Equal(t, 1, len(ce.groups))
+	assert.Equal(t, uint64(2*2218), ce.groups[0].sumValue(ce.transfers, now.Add(-time.Hour)))
+
+	// The pending transfer stays enqueued while the group is full.
+	toBePublished, err := gov.CheckPendingForTime(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(toBePublished))
+
+	// And gets released once the earlier transfers age out of the window.
+	toBePublished, err = gov.CheckPendingForTime(now.Add(time.Minute * 61))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(toBePublished))
+	assert.Equal(t, uint64(3), toBePublished[0].Sequence)
+
+	numTrans, valueTrans, numPending, _ = gov.getStatsForAllChains()
+	assert.Equal(t, 1, numTrans)
+	assert.Equal(t, uint64(2218), valueTrans)
+	assert.Equal(t, 0, numPending)
+}
+
+func TestInvalidTokenGroupConfig(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E"       //nolint:gosec
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 100000, 0))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62))
+
+	assert.ErrorContains(t, gov.setTokenGroupForTesting(vaa.ChainIDAcala, "group", 1000, tokenAddrStr), "not configured")
+	assert.ErrorContains(t, gov.setTokenGroupForTesting(vaa.ChainIDEthereum, "", 1000, tokenAddrStr), "has no name")
+	assert.ErrorContains(t, gov.setTokenGroupForTesting(vaa.ChainIDEthereum, "group", 0, tokenAddrStr), "zero daily limit")
+	assert.ErrorContains(t, gov.setTokenGroupForTesting(vaa.ChainIDEthereum, "group", 1000), "has no tokens")
+	assert.ErrorContains(t, gov.setTokenGroupForTesting(vaa.ChainIDEthereum, "group", 1000, "0x0000000000000000000000000000000000000001"), "not configured")
+
+	require.NoError(t, gov.setTokenGroupForTesting(vaa.ChainIDEthereum, "group", 1000, tokenAddrStr))
+	assert.ErrorContains(t, gov.setTokenGroupForTesting(vaa.ChainIDEthereum, "group", 1000, tokenAddrStr), "duplicate")
+}
+
+func TestTransfersAreEnqueuedWhenEmitterLimitIsReached(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+	otherEmitterAddrStr := "0x0000000000000000000000000000000000000042"
+	otherEmitterAddr, err := vaa.StringToAddress(otherEmitterAddrStr)
+	require.NoError(t, err)
+	ungovernedEmitterAddr, err := vaa.StringToAddress("0x0000000000000000000000000000000000000043")
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(60)
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 10000, 0))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62))
+	require.NoError(t, gov.setEmitterLimitForTesting(vaa.ChainIDEthereum, "other", otherEmitterAddrStr, 5000))
+
+	newMsg := func(seq uint64, emitterAddr vaa.Address) *common.MessagePublication {
+		return &common.MessagePublication{
+			TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+			Timestamp:        time.Unix(int64(1654543099), 0),
+			Nonce:            uint32(1),
+			Sequence:         seq,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   emitterAddr,
+			ConsistencyLevel: uint8(32),
+			Payload: buildMockTransferPayloadBytes(1,
+				vaa.ChainIDEthereum,
+				tokenAddrStr,
+				vaa.ChainIDPolygon,
+				toAddrStr,
+				1.25,
+			),
+		}
+	}
+
+	// Emitters without a limit other than the token bridge are not governed.
+	isGoverned, err := gov.IsGovernedMsg(newMsg(1, ungovernedEmitterAddr))
+	require.NoError(t, err)
+	assert.False(t, isGoverned)
+	isGoverned, err = gov.IsGovernedMsg(newMsg(1, otherEmitterAddr))
+	require.NoError(t, err)
+	assert.True(t, isGoverned)
+
+	now := time.Now()
+
+	canPost, err := gov.ProcessMsgForTime(newMsg(1, otherEmitterAddr), now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	canPost, err = gov.ProcessMsgForTime(newMsg(2, otherEmitterAddr), now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	// The emitter limit is reached, even though the chain is well below its own limit.
+	canPost, err = gov.ProcessMsgForTime(newMsg(3, otherEmitterAddr), now)
+	require.NoError(t, err)
+	assert.Equal(t, false, canPost)
+
+	// The token bridge is not affected, but its transfers and the ones of the other emitter share the chain limit.
+	canPost, err = gov.ProcessMsgForTime(newMsg(4, tokenBridgeAddr), now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	canPost, err = gov.ProcessMsgForTime(newMsg(5, tokenBridgeAddr), now)
+	require.NoError(t, err)
+	assert.Equal(t, true, canPost)
+
+	canPost, err = gov.ProcessMsgForTime(newMsg(6, tokenBridgeAddr), now)
+	require.NoError(t, err)
+	assert.Equal(t, false, canPost)
+
+	numTrans, valueTrans, numPending, _ := gov.getStatsForAllChains()
+	assert.Equal(t, 4, numTrans)
+	assert.Equal(t, uint64(4*2218), valueTrans)
+	assert.Equal(t, 2, numPending)
+
+	ce := gov.chains[vaa.ChainIDEthereum]
+	assert.Equal(t, []vaa.Address{tokenBridgeAddr, otherEmitterAddr}, ce.governedEmitters())
+	assert.Equal(t, uint64(2*2218), ce.emitterLimits[otherEmitterAddr].sumValue(ce.transfers, now.Add(-time.Hour)))
+
+	// The pending transfers stay enqueued while the limits are reached.
+	toBePublished, err := gov.CheckPendingForTime(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(toBePublished))
+
+	// And get released once the earlier transfers age out of the window.
+	toBePublished, err = gov.CheckPendingForTime(now.Add(time.Minute * 61))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(toBePublished))
+
+	numTrans, valueTrans, numPending, _ = gov.getStatsForAllChains()
+	assert.Equal(t, 2, numTrans)
+	assert.Equal(t, uint64(2*2218), valueTrans)
+	assert.Equal(t, 0, numPending)
+}
+
+func TestInvalidEmitterLimitConfig(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	emitterAddrStr := "0x0000000000000000000000000000000000000042"
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 100000, 0))
+
+	assert.ErrorContains(t, gov.setEmitterLimitForTesting(vaa.ChainIDPythNet, "other", emitterAddrStr, 1000), "not configured")
+	assert.ErrorContains(t, gov.setEmitterLimitForTesting(vaa.ChainIDEthereum, "", emitterAddrStr, 1000), "has no name")
+	assert.ErrorContains(t, gov.setEmitterLimitForTesting(vaa.ChainIDEthereum, "other", emitterAddrStr, 0), "zero daily limit")
+	assert.ErrorContains(t, gov.setEmitterLimitForTesting(vaa.ChainIDEthereum, "other", "0xzz", 1000), "invalid emitter address")
+
+	require.NoError(t, gov.setEmitterLimitForTesting(vaa.ChainIDEthereum, "other", emitterAddrStr, 1000))
+	assert.ErrorContains(t, gov.setEmitterLimitForTesting(vaa.ChainIDEthereum, "other2", emitterAddrStr, 1000), "duplicate")
+}
+
 func TestPendingTransferBeingReleased(t *testing.T) {
 	ctx := context.Background()
 	gov, err := newChainGovernorForTest(ctx)
@@ -1030,6 +1390,40 @@ func TestMainnetConfigIsValid(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDevnetConfigIsValid(t *testing.T) {
+	logger := zap.NewNop()
+	var db db.MockGovernorDB
+	gov := NewChainGovernor(logger, &db, GoTestMode)
+
+	gov.env = DevNetMode
+	err := gov.initConfig()
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(gov.chains[vaa.ChainIDEthereum].groups))
+}
+
+func TestGetTokenList(t *testing.T) {
+	logger := zap.NewNop()
+	var db db.MockGovernorDB
+	gov := NewChainGovernor(logger, &db, GoTestMode)
+
+	gov.env = DevNetMode
+	require.NoError(t, gov.initConfig())
+
+	tokens := gov.GetTokenList()
+	require.Equal(t, 2, len(tokens))
+
+	assert.Equal(t, uint32(vaa.ChainIDSolana), tokens[0].OriginChainId)
+	assert.Equal(t, "SOL", tokens[0].Symbol)
+	assert.Equal(t, int64(8), tokens[0].Decimals)
+	assert.Equal(t, float32(34.94), tokens[0].Price)
+
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), tokens[1].OriginChainId)
+	assert.Equal(t, "0x000000000000000000000000ddb64fe46a91d46ee29420539fc25fd07c5fea3e", tokens[1].OriginAddress)
+	assert.Equal(t, "WETH", tokens[1].Symbol)
+	assert.Equal(t, int64(8), tokens[1].Decimals)
+	assert.Equal(t, float32(1174), tokens[1].Price)
+}
+
 func TestTestnetConfigIsValid(t *testing.T) {
 	logger := zap.NewNop()
 	var db db.MockGovernorDB
@@ -1791,3 +2185,269 @@ func TestCoinGeckoQueries(t *testing.T) {
 		})
 	}
 }
+
+func TestOraclePriceIsUsedWhenThereIsNoCoinGeckoPrice(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E"
+	tokenAddr, err := vaa.StringToAddress(tokenAddrStr)
+	require.NoError(t, err)
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1000))
+	te := gov.tokens[tokenKey{chain: vaa.ChainIDEthereum, addr: tokenAddr}]
+
+	// The oracle price is used if it is higher than the configured price.
+	require.NoError(t, gov.SetOraclePrice(&common.GasTokenPrice{ChainID: vaa.ChainIDEthereum, TokenAddress: tokenAddr, Price: 1500, UpdatedAt: time.Now()}))
+	assert.Equal(t, big.NewFloat(1500).String(), te.price.String())
+
+	// The CoinGecko price takes precedence over the oracle price.
+	te.coinGeckoPrice = big.NewFloat(1200)
+	te.updatePrice()
+	assert.Equal(t, big.NewFloat(1200).String(), te.price.String())
+
+	// If the CoinGecko query fails, we fall back to the oracle price rather than the configured price.
+	gov.revertAllPrices()
+	assert.Nil(t, te.coinGeckoPrice)
+	assert.Equal(t, big.NewFloat(1500).String(), te.price.String())
+
+	// We never go below the configured price.
+	require.NoError(t, gov.SetOraclePrice(&common.GasTokenPrice{ChainID: vaa.ChainIDEthereum, TokenAddress: tokenAddr, Price: 900, UpdatedAt: time.Now()}))
+	assert.Equal(t, big.NewFloat(1000).String(), te.price.String())
+	assert.Equal(t, big.NewFloat(1000).String(), te.cfgPrice.String())
+
+	// Stale oracle prices are ignored.
+	require.NoError(t, gov.SetOraclePrice(&common.GasTokenPrice{ChainID: vaa.ChainIDEthereum, TokenAddress: tokenAddr, Price: 1500, UpdatedAt: time.Now().Add(-oraclePriceMaxAge - time.Minute)}))
+	assert.Equal(t, big.NewFloat(1000).String(), te.price.String())
+}
+
+func TestOraclePriceForUnknownTokenIsRejected(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	err = gov.SetOraclePrice(&common.GasTokenPrice{ChainID: vaa.ChainIDEthereum, TokenAddress: tokenAddr, Price: 1500, UpdatedAt: time.Now()})
+	assert.ErrorContains(t, err, "is not governed")
+}
+
+// signedVAAsGovernorDB is a mock database that returns the configured signed VAAs.
+type signedVAAsGovernorDB struct {
+	db.MockGovernorDB
+	vaas   []*vaa.VAA
+	stored []*db.Transfer
+}
+
+func (d *signedVAAsGovernorDB) StoreTransfer(t *db.Transfer) error {
+	d.stored = append(d.stored, t)
+	return nil
+}
+
+func (d *signedVAAsGovernorDB) GetSignedVAAsSince(logger *zap.Logger, emitterChain vaa.ChainID, emitterAddress vaa.Address, fromSequence uint64, since time.Time) ([]*vaa.VAA, error) {
+	var ret []*vaa.VAA
+	for _, v := range d.vaas {
+		if v.EmitterChain == emitterChain && v.EmitterAddress == emitterAddress && v.Sequence >= fromSequence && !v.Timestamp.Before(since) {
+			ret = append(ret, v)
+		}
+	}
+	return ret, nil
+}
+
+func TestMissingTransfersRepairedFromSignedVAAs(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, gov)
+
+	tokenBridgeAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	now := time.Now()
+	newVAA := func(seq uint64, timestamp time.Time, payload []byte) *vaa.VAA {
+		return &vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Timestamp:        time.Unix(timestamp.Unix(), 0),
+			Nonce:            1,
+			Sequence:         seq,
+			ConsistencyLevel: 32,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   tokenBridgeAddr,
+			Payload:          payload,
+		}
+	}
+
+	governedPayload := buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E", vaa.ChainIDPolygon, toAddrStr, 1.25)
+	accounted := newVAA(1, now.Add(-2*time.Hour), governedPayload)
+	missing := newVAA(2, now.Add(-time.Hour), governedPayload)
+	expired := newVAA(3, now.Add(-25*time.Hour), governedPayload)
+	ungoverned := newVAA(4, now.Add(-time.Hour), buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, "0x42", vaa.ChainIDPolygon, toAddrStr, 1.25))
+	notTransfer := newVAA(5, now.Add(-time.Hour), []byte{2, 0, 0})
+
+	gdb := &signedVAAsGovernorDB{vaas: []*vaa.VAA{accounted, missing, expired, ungoverned, notTransfer}}
+	gov.db = gdb
+
+	// The first transfer is already known from the database entries.
+	accountedHash := hex.EncodeToString(accounted.SigningDigest().Bytes())
+	gov.chains[vaa.ChainIDEthereum].transfers = []*db.Transfer{{
+		Timestamp:      accounted.Timestamp,
+		Value:          2218,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: tokenBridgeAddr,
+		MsgID:          accounted.MessageID(),
+		Hash:           accountedHash,
+	}}
+	gov.msgsSeen[accountedHash] = transferComplete
+
+	require.NoError(t, gov.loadFromDB())
+
+	missingHash := hex.EncodeToString(missing.SigningDigest().Bytes())
+	require.Equal(t, 1, len(gdb.stored))
+	assert.Equal(t, &db.Transfer{
+		Timestamp:      missing.Timestamp,
+		Value:          2218,
+		OriginChain:    vaa.ChainIDEthereum,
+		OriginAddress:  gdb.stored[0].OriginAddress,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: tokenBridgeAddr,
+		MsgID:          missing.MessageID(),
+		Hash:           missingHash,
+	}, gdb.stored[0])
+
+	numTrans, valueTrans, numPending, _ := gov.getStatsForAllChains()
+	assert.Equal(t, 2, numTrans)
+	assert.Equal(t, uint64(2*2218), valueTrans)
+	assert.Equal(t, 0, numPending)
+	assert.Equal(t, transferComplete, gov.msgsSeen[missingHash])
+
+	// Reconciling again does not count the repaired transfer twice.
+	require.NoError(t, gov.loadFromDB())
+	assert.Equal(t, 1, len(gdb.stored))
+	numTrans, _, _, _ = gov.getStatsForAllChains()
+	assert.Equal(t, 2, numTrans)
+}
+
+func TestReconcileFromSequence(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+
+	emitter, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+	msgID := func(seq uint64) string {
+		return fmt.Sprintf("%d/%s/%d", vaa.ChainIDEthereum, emitter, seq)
+	}
+
+	// Nothing is known about the emitter.
+	_, exists := gov.reconcileFromSequence(vaa.ChainIDEthereum, emitter, nil)
+	assert.False(t, exists)
+
+	// The earliest known transfer or enqueued VAA is used.
+	ce := gov.chains[vaa.ChainIDEthereum]
+	ce.transfers = []*db.Transfer{{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitter, MsgID: msgID(12)}}
+	ce.pending = []*pendingEntry{{dbData: db.PendingTransfer{Msg: common.MessagePublication{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitter, Sequence: 10}}}}
+	seq, exists := gov.reconcileFromSequence(vaa.ChainIDEthereum, emitter, nil)
+	assert.True(t, exists)
+	assert.Equal(t, uint64(10), seq)
+
+	// The latest expired transfer takes precedence.
+	expired := []*db.Transfer{
+		{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitter, MsgID: msgID(7)},
+		{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: emitter, MsgID: msgID(8)},
+		{EmitterChain: vaa.ChainIDSolana, EmitterAddress: emitter, MsgID: msgID(9)},
+	}
+	seq, exists = gov.reconcileFromSequence(vaa.ChainIDEthereum, emitter, expired)
+	assert.True(t, exists)
+	assert.Equal(t, uint64(9), seq)
+}
+
+// pendingRecordingDB counts the pending transfers written to and removed from the database.
+type pendingRecordingDB struct {
+	db.MockGovernorDB
+	stored  int
+	deleted int
+}
+
+func (d *pendingRecordingDB) StorePendingMsg(*db.PendingTransfer) error {
+	d.stored++
+	return nil
+}
+
+func (d *pendingRecordingDB) DeletePendingMsg(*db.PendingTransfer) error {
+	d.deleted++
+	return nil
+}
+
+func TestShadowModeNeverDelaysTransfers(t *testing.T) {
+	ctx := context.Background()
+	gov, err := newChainGovernorForTest(ctx)
+	require.NoError(t, err)
+	gov.SetShadowMode(true)
+	pendingDB := &pendingRecordingDB{}
+	gov.db = pendingDB
+
+	tokenAddrStr := "0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E" //nolint:gosec
+	toAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	tokenBridgeAddrStr := "0x0290fb167208af455bb137780163b7b7a9a10c16" //nolint:gosec
+	tokenBridgeAddr, err := vaa.StringToAddress(tokenBridgeAddrStr)
+	require.NoError(t, err)
+
+	gov.setDayLengthInMinutes(24 * 60)
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, tokenBridgeAddrStr, 1000000, 100000))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, tokenAddrStr, "WETH", 1774.62))
+
+	// A big transaction would be enqueued.
+	msg := common.MessagePublication{
+		TxHash:           hashFromString("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         uint64(1),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		ConsistencyLevel: uint8(32),
+		Payload: buildMockTransferPayloadBytes(1,
+			vaa.ChainIDEthereum,
+			tokenAddrStr,
+			vaa.ChainIDPolygon,
+			toAddrStr,
+			100,
+		),
+	}
+
+	enqueued := metricShadowEnqueuedVAAs.WithLabelValues(vaa.ChainIDEthereum.String(), "big_transaction")
+	released := metricShadowReleasedVAAs.WithLabelValues(vaa.ChainIDEthereum.String())
+	enqueuedBefore := testutil.ToFloat64(enqueued)
+	releasedBefore := testutil.ToFloat64(released)
+
+	now, _ := time.Parse("Jan 2, 2006 at 3:04pm (MST)", "Jun 1, 2022 at 12:00pm (CST)")
+	canPost, err := gov.ProcessMsgForTime(&msg, now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+	assert.Equal(t, enqueuedBefore+1, testutil.ToFloat64(enqueued))
+
+	// The governor still tracks the transfer as an enforcing governor would.
+	numTrans, _, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 0, numTrans)
+	assert.Equal(t, 1, numPending)
+	assert.Equal(t, uint64(177461), valuePending)
+
+	// A reobservation is published again.
+	canPost, err = gov.ProcessMsgForTime(&msg, now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	// Once the release time is reached, the transfer is counted but not published a second time.
+	toBePublished, err := gov.CheckPendingForTime(now.Add(maxEnqueuedTime + time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, toBePublished)
+	assert.Equal(t, releasedBefore+1, testutil.ToFloat64(released))
+	_, _, numPending, _ = gov.getStatsForAllChains()
+	assert.Equal(t, 0, numPending)
+	// The pending transfer was only kept in memory, so it is not enforced after a restart in enforcing mode.
+	assert.Equal(t, 0, pendingDB.stored)
+	assert.Equal(t, 0, pendingDB.deleted)
+}
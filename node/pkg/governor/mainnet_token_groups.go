@@ -0,0 +1,10 @@
+// This file contains the token group config to be used in the mainnet environment.
+//
+// This file is maintained by hand. Add / remove / update entries as appropriate. Every token in a group must also be
+// listed in the token config.
+
+package governor
+
+func tokenGroupList() []tokenGroupConfigEntry {
+	return []tokenGroupConfigEntry{}
+}
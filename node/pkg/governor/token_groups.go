@@ -0,0 +1,130 @@
+// A token group is a set of tokens on an emitter chain that share a combined daily notional limit, on top of the limit
+// of the chain. Groups are meant for tokens whose prices are correlated, for instance all stablecoins or all ETH
+// derivatives, where the risk of an exploit is better modeled by their combined outflow than by the outflow of each.
+//
+// A transfer of a token in a group is enqueued if it would exceed the limit of any of the groups the token belongs to,
+// even if the chain is still below its daily limit. Group usage is computed from the regular transfers of the chain,
+// so fast lane transfers and transfers released manually or by the timer do not count towards the group limits.
+
+package governor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type (
+	// Layout of the config data for each token group
+	tokenGroupConfigEntry struct {
+		name           string
+		emitterChainID vaa.ChainID
+		dailyLimit     uint64
+		tokens         []tokenGroupMemberConfigEntry
+	}
+
+	// Layout of the config data for each token in a token group
+	tokenGroupMemberConfigEntry struct {
+		chain uint16
+		addr  string
+	}
+
+	// Payload of the list of token groups of a chain
+	tokenGroupEntry struct {
+		name       string
+		dailyLimit uint64
+		tokens     map[tokenKey]struct{}
+	}
+)
+
+func (ge *tokenGroupEntry) contains(tk tokenKey) bool {
+	_, exists := ge.tokens[tk]
+	return exists
+}
+
+// sumValue returns the value of the transfers of tokens in the group since startTime.
+func (ge *tokenGroupEntry) sumValue(transfers []*db.Transfer, startTime time.Time) uint64 {
+	var sum uint64
+	for _, t := range transfers {
+		if !t.Timestamp.Before(startTime) && ge.contains(tokenKey{chain: t.OriginChain, addr: t.OriginAddress}) {
+			sum += t.Value
+		}
+	}
+
+	return sum
+}
+
+// exceededTokenGroup returns the first group of the token that a transfer of the given value would push above its
+// limit, along with the value of the group before and after the transfer. It returns nil if the transfer fits in all groups.
+func (ce *chainEntry) exceededTokenGroup(tk tokenKey, value uint64, startTime time.Time) (group *tokenGroupEntry, prevGroupValue uint64, newGroupValue uint64) {
+	for _, ge := range ce.groups {
+		if !ge.contains(tk) {
+			continue
+		}
+
+		prevGroupValue = ge.sumValue(ce.transfers, startTime)
+		newGroupValue = prevGroupValue + value
+		if newGroupValue < prevGroupValue || newGroupValue > ge.dailyLimit {
+			return ge, prevGroupValue, newGroupValue
+		}
+	}
+
+	return nil, 0, 0
+}
+
+// initTokenGroups validates the token group config and attaches the groups to their chains. It must be called after the
+// tokens and chains have been loaded. It assumes the caller holds the lock.
+func (gov *ChainGovernor) initTokenGroups(configGroups []tokenGroupConfigEntry) error {
+	for _, cg := range configGroups {
+		ce, exists := gov.chains[cg.emitterChainID]
+		if !exists {
+			return fmt.Errorf("token group %s is for chain %v, which is not configured", cg.name, cg.emitterChainID)
+		}
+
+		if cg.name == "" {
+			return fmt.Errorf("token group for chain %v has no name", cg.emitterChainID)
+		}
+
+		if cg.dailyLimit == 0 {
+			return fmt.Errorf("token group %s for chain %v has a zero daily limit", cg.name, cg.emitterChainID)
+		}
+
+		if len(cg.tokens) == 0 {
+			return fmt.Errorf("token group %s for chain %v has no tokens", cg.name, cg.emitterChainID)
+		}
+
+		for _, ge := range ce.groups {
+			if ge.name == cg.name {
+				return fmt.Errorf("duplicate token group %s for chain %v", cg.name, cg.emitterChainID)
+			}
+		}
+
+		ge := &tokenGroupEntry{name: cg.name, dailyLimit: cg.dailyLimit, tokens: make(map[tokenKey]struct{}, len(cg.tokens))}
+		for _, ct := range cg.tokens {
+			addr, err := vaa.StringToAddress(ct.addr)
+			if err != nil {
+				return fmt.Errorf("invalid address in token group %s: %s", cg.name, ct.addr)
+			}
+
+			key := tokenKey{chain: vaa.ChainID(ct.chain), addr: addr}
+			if _, exists := gov.tokens[key]; !exists {
+				return fmt.Errorf("token group %s contains token %v, which is not configured", cg.name, key)
+			}
+
+			ge.tokens[key] = struct{}{}
+		}
+
+		gov.logger.Info("will monitor token group:", zap.String("name", ge.name),
+			zap.Stringer("emitterChainId", cg.emitterChainID),
+			zap.Uint64("dailyLimit", ge.dailyLimit),
+			zap.Int("numTokens", len(ge.tokens)),
+		)
+
+		ce.groups = append(ce.groups, ge)
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+// Package lifecycle signals the lifecycle of the node to orchestration tooling, so that it can tell when the node is
+// up and when it is fully synced without inferring it from log lines. Lifecycle events are sent to systemd through
+// sd_notify, when the node runs as a Type=notify service, and appended as JSON lines to an optional event log.
+//
+// Uses a global singleton, like the readiness package whose state it reports.
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/coreos/go-systemd/daemon"
+	"go.uber.org/zap"
+)
+
+// Event is a lifecycle event of the node.
+type Event string
+
+const (
+	// EventStarting is signaled once the configuration has been validated and the node starts its components.
+	EventStarting Event = "starting"
+	// EventReady is signaled once the node has started all its components, and again after each reload. The chains may
+	// still be syncing: syncing can take longer than any reasonable service start timeout, so it is reported separately.
+	EventReady Event = "ready"
+	// EventSyncing reports the components registered with the readiness package which are not ready yet. It only
+	// updates the status, and is signaled again whenever that list changes.
+	EventSyncing Event = "syncing"
+	// EventSynced is signaled once all the components registered with the readiness package are ready, i.e. all chains
+	// are synced. It only updates the status.
+	EventSynced Event = "synced"
+	// EventReloading is signaled when a component reloads its state at runtime.
+	EventReloading Event = "reloading"
+	// EventStopping is signaled when the node starts shutting down.
+	EventStopping Event = "stopping"
+	// EventStopped is signaled right before the process exits.
+	EventStopped Event = "stopped"
+)
+
+// record is a line of the event log.
+type record struct {
+	Time   string `json:"time"`
+	Event  Event  `json:"event"`
+	PID    int    `json:"pid"`
+	Detail string `json:"detail,omitempty"`
+}
+
+var (
+	mu       sync.Mutex
+	logger   = zap.NewNop()
+	eventLog io.WriteCloser
+	ready    bool
+	synced   bool
+	stopping bool
+
+	// Replaced in tests.
+	sdNotify = daemon.SdNotify
+	now      = time.Now
+)
+
+// Init sets the logger of the package and opens the event log at eventLogPath, if not empty. Events are appended to
+// the event log, so that it keeps the history of previous runs.
+func Init(l *zap.Logger, eventLogPath string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	logger = l.With(zap.String("component", "lifecycle"))
+	if eventLogPath == "" {
+		return nil
+	}
+
+	f, err := os.OpenFile(eventLogPath, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open lifecycle event log: %w", err)
+	}
+	eventLog = f
+	return nil
+}
+
+// Signal signals a lifecycle event, with an optional human-readable detail. It is safe to signal the same event more
+// than once; EventReady is only sent again after a reload, EventSynced only once, and nothing but EventStopped is sent
+// once stopping.
+func Signal(event Event, detail string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	switch event {
+	case EventReady:
+		if ready || stopping {
+			return
+		}
+		ready = true
+	case EventSyncing:
+		if synced || stopping {
+			return
+		}
+	case EventSynced:
+		if synced || stopping {
+			return
+		}
+		synced = true
+	case EventReloading:
+		if stopping {
+			return
+		}
+		ready = false
+	case EventStopping:
+		if stopping {
+			return
+		}
+		stopping = true
+	}
+
+	logger.Info("lifecycle event", zap.String("event", string(event)), zap.String("detail", detail))
+	notifySystemd(event, detail)
+	writeEvent(event, detail)
+
+	if event == EventStopped && eventLog != nil {
+		_ = eventLog.Close()
+		eventLog = nil
+	}
+}
+
+// Reload signals EventReloading, runs reload and signals EventReady once it returns, if the node was ready before.
+// systemd expects a ready notification after each reload notification, and reloads are not supposed to change the
+// readiness of the node.
+func Reload(detail string, reload func() error) error {
+	mu.Lock()
+	wasReady := ready
+	mu.Unlock()
+
+	Signal(EventReloading, detail)
+	err := reload()
+	if wasReady {
+		Signal(EventReady, detail)
+	}
+	return err
+}
+
+// notifySystemd sends the sd_notify state of an event. It does nothing if the node is not run by systemd with a
+// notification socket.
+func notifySystemd(event Event, detail string) {
+	state := []string{"STATUS=" + statusLine(event, detail)}
+	switch event {
+	case EventReady:
+		state = append(state, daemon.SdNotifyReady)
+	case EventReloading:
+		state = append(state, daemon.SdNotifyReloading)
+	case EventStopping:
+		state = append(state, daemon.SdNotifyStopping)
+	case EventStopped:
+		return
+	}
+
+	if _, err := sdNotify(false, strings.Join(state, "\n")); err != nil {
+		logger.Warn("failed to notify systemd", zap.String("event", string(event)), zap.Error(err))
+	}
+}
+
+func statusLine(event Event, detail string) string {
+	if detail == "" {
+		return string(event)
+	}
+	return string(event) + ": " + strings.ReplaceAll(detail, "\n", " ")
+}
+
+// writeEvent appends an event to the event log, if there is one.
+func writeEvent(event Event, detail string) {
+	if eventLog == nil {
+		return
+	}
+
+	b, err := json.Marshal(record{
+		Time:   now().UTC().Format(time.RFC3339Nano),
+		Event:  event,
+		PID:    os.Getpid(),
+		Detail: detail,
+	})
+	if err != nil {
+		logger.Error("failed to marshal lifecycle event", zap.Error(err))
+		return
+	}
+	if _, err := eventLog.Write(append(b, '\n')); err != nil {
+		logger.Error("failed to write lifecycle event", zap.Error(err))
+	}
+}
+
+// ReadyRunnable returns a runnable that signals EventReady, then reports the components registered with the readiness
+// package which are not ready yet with EventSyncing, checking every interval, until they all are and EventSynced is
+// signaled. It must be started after all the components have been registered, since a component registered later is
+// not waited for.
+func ReadyRunnable(interval time.Duration) supervisor.Runnable {
+	return func(ctx context.Context) error {
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		Signal(EventReady, "all components are started")
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		lastStatus := ""
+		for {
+			notReady := readiness.NotReady()
+			if len(notReady) == 0 {
+				Signal(EventSynced, "all components are ready")
+				break
+			}
+
+			names := make([]string, len(notReady))
+			for i, c := range notReady {
+				names[i] = string(c)
+			}
+			if status := fmt.Sprintf("waiting for %d components: %s", len(names), strings.Join(names, ", ")); status != lastStatus {
+				Signal(EventSyncing, status)
+				lastStatus = status
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		}
+
+		<-ctx.Done()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,100 @@
+package lifecycle
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// setupTest resets the package state, and returns the path of the event log and the states sent to systemd.
+func setupTest(t *testing.T) (string, *[]string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "lifecycle.log")
+
+	var notified []string
+	sdNotify = func(unsetEnvironment bool, state string) (bool, error) {
+		notified = append(notified, state)
+		return true, nil
+	}
+	now = func() time.Time { return time.Unix(1700000000, 0) }
+	ready, synced, stopping = false, false, false
+	require.NoError(t, Init(zap.NewNop(), path))
+
+	t.Cleanup(func() {
+		Signal(EventStopped, "")
+	})
+	return path, &notified
+}
+
+func readEvents(t *testing.T, path string) []record {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestLifecycle(t *testing.T) {
+	path, notified := setupTest(t)
+
+	Signal(EventStarting, "v1.0.0")
+	Signal(EventReady, "all components are started")
+	Signal(EventReady, "all components are started")
+	Signal(EventSyncing, "waiting for 1 components: ethSyncing")
+	Signal(EventSynced, "all components are ready")
+	Signal(EventSynced, "all components are ready")
+	Signal(EventSyncing, "too late")
+	require.Error(t, Reload("chain governor", func() error { return errors.New("reload failed") }))
+	Signal(EventStopping, "received SIGTERM")
+	Signal(EventStopping, "root context cancelled")
+	Signal(EventReady, "too late")
+	Signal(EventStopped, "")
+
+	assert.Equal(t, []string{
+		"STATUS=starting: v1.0.0",
+		"STATUS=ready: all components are started\nREADY=1",
+		"STATUS=syncing: waiting for 1 components: ethSyncing",
+		"STATUS=synced: all components are ready",
+		"STATUS=reloading: chain governor\nRELOADING=1",
+		"STATUS=ready: chain governor\nREADY=1",
+		"STATUS=stopping: received SIGTERM\nSTOPPING=1",
+	}, *notified)
+
+	records := readEvents(t, path)
+	events := make([]Event, len(records))
+	for i, r := range records {
+		events[i] = r.Event
+	}
+	assert.Equal(t, []Event{EventStarting, EventReady, EventSyncing, EventSynced, EventReloading, EventReady, EventStopping, EventStopped}, events)
+	assert.Equal(t, "2023-11-14T22:13:20Z", records[0].Time)
+	assert.Equal(t, os.Getpid(), records[0].PID)
+	assert.Equal(t, "v1.0.0", records[0].Detail)
+}
+
+func TestReloadBeforeReady(t *testing.T) {
+	_, notified := setupTest(t)
+
+	// A reload does not make the node ready.
+	require.NoError(t, Reload("chain governor", func() error { return nil }))
+	assert.Equal(t, []string{"STATUS=reloading: chain governor\nRELOADING=1"}, *notified)
+
+	Signal(EventReady, "all components are ready")
+	assert.Len(t, *notified, 2)
+}
@@ -0,0 +1,349 @@
+// Package netmap maintains a model of the guardian network built from the heartbeats gossiped by the guardians: which
+// nodes every guardian runs, their versions and features, and the height each of them reports for every chain.
+package netmap
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	netmapHeartbeats = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_netmap_heartbeats_total",
+			Help: "Total number of verified heartbeats added to the network map",
+		})
+	netmapNodes = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_netmap_nodes",
+			Help: "Number of guardian nodes in the network map",
+		})
+)
+
+// DefaultStaleAfter is how long a node is considered live after its last heartbeat. Guardians send a heartbeat every 15
+// seconds.
+const DefaultStaleAfter = time.Minute
+
+// node is the state of a single guardian node, as reported by its last heartbeat.
+type node struct {
+	// peerID is the libp2p ID of the node, as announced in the heartbeat. It is empty if the node does not announce it.
+	peerID    string
+	heartbeat *gossipv1.Heartbeat
+	// received is when the last heartbeat was received.
+	received time.Time
+}
+
+// key identifies the node among the ones of its guardian. Nodes not announcing their libp2p ID are identified by name.
+func (n *node) key() string {
+	if n.peerID != "" {
+		return n.peerID
+	}
+	return "name:" + n.heartbeat.NodeName
+}
+
+// Model is the network map. It is safe for concurrent use.
+type Model struct {
+	staleAfter time.Duration
+
+	mu          sync.Mutex
+	guardianSet *common.GuardianSet
+	// nodes holds the nodes of every guardian, by node key.
+	nodes map[ethcommon.Address]map[string]*node
+}
+
+// NewModel creates an empty network map in which nodes are reported as stale staleAfter their last heartbeat.
+func NewModel(staleAfter time.Duration) *Model {
+	return &Model{
+		staleAfter: staleAfter,
+		nodes:      make(map[ethcommon.Address]map[string]*node),
+	}
+}
+
+// SetGuardianSet sets the guardian set the guardians of the map are listed against.
+func (m *Model) SetGuardianSet(gs *common.GuardianSet) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.guardianSet = gs
+}
+
+// GuardianSet returns the current guardian set, or nil if it has not been set yet.
+func (m *Model) GuardianSet() *common.GuardianSet {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.guardianSet
+}
+
+// Update adds a heartbeat to the map. The heartbeat must have been verified, which is the case of the heartbeats stored
+// in the guardian set state by the p2p layer. Heartbeats older than the last one of the same node are ignored.
+func (m *Model) Update(hb *gossipv1.Heartbeat, received time.Time) error {
+	if !ethcommon.IsHexAddress(hb.GuardianAddr) {
+		return fmt.Errorf("invalid guardian address %q", hb.GuardianAddr)
+	}
+	addr := ethcommon.HexToAddress(hb.GuardianAddr)
+
+	n := &node{heartbeat: hb, received: received}
+	if len(hb.P2PNodeId) != 0 {
+		peerID, err := peer.IDFromBytes(hb.P2PNodeId)
+		if err != nil {
+			return fmt.Errorf("invalid p2p node id: %w", err)
+		}
+		n.peerID = peerID.String()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nodes, exists := m.nodes[addr]
+	if !exists {
+		nodes = make(map[string]*node)
+		m.nodes[addr] = nodes
+	}
+	if prev, exists := nodes[n.key()]; exists && prev.heartbeat.Timestamp > hb.Timestamp {
+		return nil
+	}
+	nodes[n.key()] = n
+
+	netmapHeartbeats.Inc()
+	netmapNodes.Set(float64(m.numNodesAlreadyLocked()))
+	return nil
+}
+
+// Prune removes the nodes whose last heartbeat was received before the given time.
+func (m *Model) Prune(before time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for addr, nodes := range m.nodes {
+		for key, n := range nodes {
+			if n.received.Before(before) {
+				delete(nodes, key)
+			}
+		}
+		if len(nodes) == 0 {
+			delete(m.nodes, addr)
+		}
+	}
+	netmapNodes.Set(float64(m.numNodesAlreadyLocked()))
+}
+
+func (m *Model) numNodesAlreadyLocked() int {
+	num := 0
+	for _, nodes := range m.nodes {
+		num += len(nodes)
+	}
+	return num
+}
+
+// Heartbeats returns the last heartbeat of every node, by guardian address and node key.
+func (m *Model) Heartbeats() map[ethcommon.Address]map[string]*gossipv1.Heartbeat {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ret := make(map[ethcommon.Address]map[string]*gossipv1.Heartbeat, len(m.nodes))
+	for addr, nodes := range m.nodes {
+		ret[addr] = make(map[string]*gossipv1.Heartbeat, len(nodes))
+		for key, n := range nodes {
+			ret[addr][key] = n.heartbeat
+		}
+	}
+	return ret
+}
+
+// Snapshot is the network map at a point in time, as served to dashboards.
+type Snapshot struct {
+	Time             time.Time `json:"time"`
+	GuardianSetIndex *uint32   `json:"guardianSetIndex"`
+	// Guardians lists the guardians of the guardian set in order, followed by the ones not in the set.
+	Guardians []GuardianStatus `json:"guardians"`
+	// Chains lists the heights reported by the live nodes for every chain, by chain ID.
+	Chains []ChainStatus `json:"chains"`
+	// Versions is the number of live nodes running every version.
+	Versions map[string]int `json:"versions"`
+}
+
+// GuardianStatus is the status of a guardian and its nodes.
+type GuardianStatus struct {
+	Address string `json:"address"`
+	// Index is the index of the guardian in the guardian set, it is nil for guardians not in the set.
+	Index *int         `json:"index"`
+	Nodes []NodeStatus `json:"nodes"`
+}
+
+// NodeStatus is the status of a node, as reported by its last heartbeat.
+type NodeStatus struct {
+	P2PNodeID     string          `json:"p2pNodeId,omitempty"`
+	NodeName      string          `json:"nodeName"`
+	Version       string          `json:"version"`
+	Features      []string        `json:"features"`
+	FeatureFlags  []string        `json:"featureFlags"`
+	BootTime      time.Time       `json:"bootTime"`
+	LastHeartbeat time.Time       `json:"lastHeartbeat"`
+	Counter       int64           `json:"counter"`
+	Stale         bool            `json:"stale"`
+	Networks      []NetworkStatus `json:"networks"`
+}
+
+// NetworkStatus is the state of a chain as seen by a node.
+type NetworkStatus struct {
+	ChainID         vaa.ChainID `json:"chainId"`
+	ChainName       string      `json:"chainName"`
+	Height          int64       `json:"height"`
+	ContractAddress string      `json:"contractAddress"`
+	ErrorCount      uint64      `json:"errorCount"`
+}
+
+// ChainStatus summarizes the heights reported by the live nodes for a chain.
+type ChainStatus struct {
+	ChainID   vaa.ChainID   `json:"chainId"`
+	ChainName string        `json:"chainName"`
+	MaxHeight int64         `json:"maxHeight"`
+	Heights   []ChainHeight `json:"heights"`
+}
+
+// ChainHeight is the height reported by a node for a chain.
+type ChainHeight struct {
+	Guardian string `json:"guardian"`
+	NodeName string `json:"nodeName"`
+	Height   int64  `json:"height"`
+	// Lag is the number of blocks the node is behind the highest node for the chain.
+	Lag int64 `json:"lag"`
+}
+
+// Snapshot returns the network map at the given time.
+func (m *Model) Snapshot(now time.Time) *Snapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := &Snapshot{
+		Time:      now,
+		Guardians: make([]GuardianStatus, 0, len(m.nodes)),
+		Chains:    make([]ChainStatus, 0),
+		Versions:  make(map[string]int),
+	}
+
+	// List the guardians of the set first, even the ones without nodes, so missing guardians stand out.
+	listed := make(map[ethcommon.Address]struct{})
+	if m.guardianSet != nil {
+		index := m.guardianSet.Index
+		snapshot.GuardianSetIndex = &index
+		for i, addr := range m.guardianSet.Keys {
+			i := i
+			snapshot.Guardians = append(snapshot.Guardians, GuardianStatus{Address: addr.Hex(), Index: &i, Nodes: m.nodeStatusesAlreadyLocked(addr, now)})
+			listed[addr] = struct{}{}
+		}
+	}
+	others := make([]GuardianStatus, 0)
+	for addr := range m.nodes {
+		if _, exists := listed[addr]; !exists {
+			others = append(others, GuardianStatus{Address: addr.Hex(), Nodes: m.nodeStatusesAlreadyLocked(addr, now)})
+		}
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].Address < others[j].Address })
+	snapshot.Guardians = append(snapshot.Guardians, others...)
+
+	chains := make(map[vaa.ChainID]*ChainStatus)
+	for _, g := range snapshot.Guardians {
+		for _, n := range g.Nodes {
+			if n.Stale {
+				continue
+			}
+			snapshot.Versions[n.Version]++
+			for _, network := range n.Networks {
+				chain, exists := chains[network.ChainID]
+				if !exists {
+					chain = &ChainStatus{ChainID: network.ChainID, ChainName: network.ChainName}
+					chains[network.ChainID] = chain
+				}
+				if network.Height > chain.MaxHeight {
+					chain.MaxHeight = network.Height
+				}
+				chain.Heights = append(chain.Heights, ChainHeight{Guardian: g.Address, NodeName: n.NodeName, Height: network.Height})
+			}
+		}
+	}
+	for _, chain := range chains {
+		for i := range chain.Heights {
+			chain.Heights[i].Lag = chain.MaxHeight - chain.Heights[i].Height
+		}
+		snapshot.Chains = append(snapshot.Chains, *chain)
+	}
+	sort.Slice(snapshot.Chains, func(i, j int) bool { return snapshot.Chains[i].ChainID < snapshot.Chains[j].ChainID })
+
+	return snapshot
+}
+
+// nodeStatusesAlreadyLocked returns the status of the nodes of a guardian, sorted by name. It assumes the caller holds
+// the lock.
+func (m *Model) nodeStatusesAlreadyLocked(addr ethcommon.Address, now time.Time) []NodeStatus {
+	statuses := make([]NodeStatus, 0, len(m.nodes[addr]))
+	for _, n := range m.nodes[addr] {
+		hb := n.heartbeat
+		status := NodeStatus{
+			P2PNodeID:     n.peerID,
+			NodeName:      hb.NodeName,
+			Version:       hb.Version,
+			Features:      hb.Features,
+			FeatureFlags:  common.HeartbeatFeatureNames(hb.FeatureFlags),
+			BootTime:      time.Unix(0, hb.BootTimestamp),
+			LastHeartbeat: time.Unix(0, hb.Timestamp),
+			Counter:       hb.Counter,
+			Stale:         now.Sub(n.received) > m.staleAfter,
+			Networks:      make([]NetworkStatus, 0, len(hb.Networks)),
+		}
+		if status.Features == nil {
+			status.Features = []string{}
+		}
+		for _, network := range hb.Networks {
+			chainID := vaa.ChainID(network.Id)
+			status.Networks = append(status.Networks, NetworkStatus{
+				ChainID:         chainID,
+				ChainName:       chainID.String(),
+				Height:          network.Height,
+				ContractAddress: network.ContractAddress,
+				ErrorCount:      network.ErrorCount,
+			})
+		}
+		sort.Slice(status.Networks, func(i, j int) bool { return status.Networks[i].ChainID < status.Networks[j].ChainID })
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].NodeName < statuses[j].NodeName })
+	return statuses
+}
+
+// HeartbeatHandler returns the runnable adding the verified heartbeats pushed by the guardian set state to the map, and
+// pruning the nodes which have not sent a heartbeat for retention.
+func (m *Model) HeartbeatHandler(heartbeatC <-chan *gossipv1.Heartbeat, retention time.Duration) supervisor.Runnable {
+	return func(ctx context.Context) error {
+		logger := supervisor.Logger(ctx)
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case hb := <-heartbeatC:
+				if err := m.Update(hb, time.Now()); err != nil {
+					logger.Warn("failed to add heartbeat to the network map", zap.String("guardian", hb.GuardianAddr), zap.Error(err))
+				}
+			case <-ticker.C:
+				m.Prune(time.Now().Add(-retention))
+			}
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package netmap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	guardian0 = ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	guardian1 = ethcommon.HexToAddress("0x88D7D8B32a9105d228100E72dFFe2Fae0705D31c")
+	// outsider is not in the guardian set, its heartbeats are accepted with --disableHeartbeatVerify.
+	outsider = ethcommon.HexToAddress("0x58076F561CC62A47087B567C86f986426dFCD000")
+)
+
+func newHeartbeat(addr ethcommon.Address, nodeName string, timestamp time.Time, heights map[vaa.ChainID]int64) *gossipv1.Heartbeat {
+	hb := &gossipv1.Heartbeat{
+		NodeName:      nodeName,
+		Counter:       3,
+		Timestamp:     timestamp.UnixNano(),
+		Version:       "v2.23.0",
+		GuardianAddr:  addr.Hex(),
+		BootTimestamp: timestamp.Add(-time.Hour).UnixNano(),
+		Features:      []string{"governor"},
+		FeatureFlags:  uint64(common.HeartbeatFeatureGovernor),
+	}
+	for chainID, height := range heights {
+		hb.Networks = append(hb.Networks, &gossipv1.Heartbeat_Network{Id: uint32(chainID), Height: height, ContractAddress: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B"})
+	}
+	return hb
+}
+
+func newTestModel() *Model {
+	m := NewModel(time.Minute)
+	m.SetGuardianSet(&common.GuardianSet{Keys: []ethcommon.Address{guardian0, guardian1}, Index: 3})
+	return m
+}
+
+func TestSnapshot(t *testing.T) {
+	m := newTestModel()
+	now := time.Unix(1680000000, 0)
+
+	require.NoError(t, m.Update(newHeartbeat(outsider, "outsider", now, map[vaa.ChainID]int64{vaa.ChainIDEthereum: 90}), now))
+	require.NoError(t, m.Update(newHeartbeat(guardian0, "guardian-0", now, map[vaa.ChainID]int64{vaa.ChainIDSolana: 1000, vaa.ChainIDEthereum: 100}), now))
+
+	stale := newHeartbeat(guardian0, "guardian-0-backup", now.Add(-time.Hour), map[vaa.ChainID]int64{vaa.ChainIDEthereum: 10})
+	stale.Version = "v2.22.0"
+	require.NoError(t, m.Update(stale, now.Add(-time.Hour)))
+
+	snapshot := m.Snapshot(now)
+	assert.Equal(t, now, snapshot.Time)
+	require.NotNil(t, snapshot.GuardianSetIndex)
+	assert.Equal(t, uint32(3), *snapshot.GuardianSetIndex)
+
+	// The guardians of the set come first, in order, including the ones without nodes.
+	require.Len(t, snapshot.Guardians, 3)
+	assert.Equal(t, guardian0.Hex(), snapshot.Guardians[0].Address)
+	assert.Equal(t, 0, *snapshot.Guardians[0].Index)
+	assert.Equal(t, guardian1.Hex(), snapshot.Guardians[1].Address)
+	assert.Equal(t, 1, *snapshot.Guardians[1].Index)
+	assert.Empty(t, snapshot.Guardians[1].Nodes)
+	assert.Equal(t, outsider.Hex(), snapshot.Guardians[2].Address)
+	assert.Nil(t, snapshot.Guardians[2].Index)
+
+	nodes := snapshot.Guardians[0].Nodes
+	require.Len(t, nodes, 2)
+	assert.Equal(t, "guardian-0", nodes[0].NodeName)
+	assert.False(t, nodes[0].Stale)
+	assert.Equal(t, "v2.23.0", nodes[0].Version)
+	assert.Equal(t, []string{"governor"}, nodes[0].Features)
+	assert.Equal(t, []string{"governor"}, nodes[0].FeatureFlags)
+	assert.Equal(t, now, nodes[0].LastHeartbeat)
+	assert.Equal(t, now.Add(-time.Hour), nodes[0].BootTime)
+	assert.Equal(t, []NetworkStatus{
+		{ChainID: vaa.ChainIDSolana, ChainName: "solana", Height: 1000, ContractAddress: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B"},
+		{ChainID: vaa.ChainIDEthereum, ChainName: "ethereum", Height: 100, ContractAddress: "0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B"},
+	}, nodes[0].Networks)
+	assert.Equal(t, "guardian-0-backup", nodes[1].NodeName)
+	assert.True(t, nodes[1].Stale)
+
+	// Stale nodes are left out of the chains and versions.
+	assert.Equal(t, []ChainStatus{
+		{ChainID: vaa.ChainIDSolana, ChainName: "solana", MaxHeight: 1000, Heights: []ChainHeight{
+			{Guardian: guardian0.Hex(), NodeName: "guardian-0", Height: 1000},
+		}},
+		{ChainID: vaa.ChainIDEthereum, ChainName: "ethereum", MaxHeight: 100, Heights: []ChainHeight{
+			{Guardian: guardian0.Hex(), NodeName: "guardian-0", Height: 100},
+			{Guardian: outsider.Hex(), NodeName: "outsider", Height: 90, Lag: 10},
+		}},
+	}, snapshot.Chains)
+	assert.Equal(t, map[string]int{"v2.23.0": 2}, snapshot.Versions)
+}
+
+func TestUpdate(t *testing.T) {
+	m := newTestModel()
+	now := time.Unix(1680000000, 0)
+
+	key, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	peerID, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	peerIDBytes, err := peerID.Marshal()
+	require.NoError(t, err)
+
+	hb := newHeartbeat(guardian0, "guardian-0", now, nil)
+	hb.P2PNodeId = peerIDBytes
+	require.NoError(t, m.Update(hb, now))
+
+	// A node is identified by its libp2p ID, so renaming it does not create a new node.
+	renamed := newHeartbeat(guardian0, "guardian-0-renamed", now.Add(time.Second), nil)
+	renamed.P2PNodeId = peerIDBytes
+	require.NoError(t, m.Update(renamed, now))
+
+	// Heartbeats received out of order do not overwrite newer ones.
+	older := newHeartbeat(guardian0, "guardian-0-older", now.Add(-time.Second), nil)
+	older.P2PNodeId = peerIDBytes
+	require.NoError(t, m.Update(older, now))
+
+	heartbeats := m.Heartbeats()
+	require.Len(t, heartbeats[guardian0], 1)
+	assert.Equal(t, "guardian-0-renamed", heartbeats[guardian0][peerID.String()].NodeName)
+
+	invalid := newHeartbeat(guardian0, "guardian-0", now, nil)
+	invalid.P2PNodeId = []byte{1, 2, 3}
+	assert.ErrorContains(t, m.Update(invalid, now), "invalid p2p node id")
+
+	invalid = newHeartbeat(guardian0, "guardian-0", now, nil)
+	invalid.GuardianAddr = "guardian-0"
+	assert.ErrorContains(t, m.Update(invalid, now), "invalid guardian address")
+}
+
+func TestPrune(t *testing.T) {
+	m := newTestModel()
+	now := time.Unix(1680000000, 0)
+
+	require.NoError(t, m.Update(newHeartbeat(guardian0, "guardian-0", now, nil), now))
+	require.NoError(t, m.Update(newHeartbeat(guardian1, "guardian-1", now, nil), now.Add(-2*time.Hour)))
+
+	m.Prune(now.Add(-time.Hour))
+	heartbeats := m.Heartbeats()
+	assert.Len(t, heartbeats, 1)
+	assert.Contains(t, heartbeats, guardian0)
+}
+
+func TestHandler(t *testing.T) {
+	m := newTestModel()
+	now := time.Now()
+	require.NoError(t, m.Update(newHeartbeat(guardian0, "guardian-0", now, map[vaa.ChainID]int64{vaa.ChainIDEthereum: 100}), now))
+
+	server := httptest.NewServer(m.Handler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/v1/netmap")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var snapshot Snapshot
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&snapshot))
+	require.Len(t, snapshot.Guardians, 2)
+	require.Len(t, snapshot.Guardians[0].Nodes, 1)
+	assert.Equal(t, "guardian-0", snapshot.Guardians[0].Nodes[0].NodeName)
+	require.Len(t, snapshot.Chains, 1)
+	assert.Equal(t, int64(100), snapshot.Chains[0].MaxHeight)
+
+	resp, err = http.Post(server.URL+"/v1/netmap", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestPublicRPCServer(t *testing.T) {
+	ctx := context.Background()
+	s := NewPublicRPCServer(NewModel(time.Minute))
+
+	_, err := s.GetLastHeartbeats(ctx, &publicrpcv1.GetLastHeartbeatsRequest{})
+	assert.ErrorContains(t, err, "guardian set not fetched from chain yet")
+
+	s.model.SetGuardianSet(&common.GuardianSet{Keys: []ethcommon.Address{guardian0, guardian1}, Index: 3})
+	now := time.Now()
+	require.NoError(t, s.model.Update(newHeartbeat(guardian0, "guardian-0", now, nil), now))
+
+	heartbeats, err := s.GetLastHeartbeats(ctx, &publicrpcv1.GetLastHeartbeatsRequest{})
+	require.NoError(t, err)
+	require.Len(t, heartbeats.Entries, 1)
+	assert.Equal(t, guardian0.Hex(), heartbeats.Entries[0].VerifiedGuardianAddr)
+	assert.Equal(t, "guardian-0", heartbeats.Entries[0].RawHeartbeat.NodeName)
+
+	gs, err := s.GetCurrentGuardianSet(ctx, &publicrpcv1.GetCurrentGuardianSetRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), gs.GuardianSet.Index)
+	assert.Equal(t, []string{guardian0.Hex(), guardian1.Hex()}, gs.GuardianSet.Addresses)
+}
+
+type mockGuardianSetReader struct {
+	index    uint32
+	sets     map[uint32][]ethcommon.Address
+	err      error
+	numReads int
+}
+
+func (r *mockGuardianSetReader) GetCurrentGuardianSetIndex(ctx context.Context) (uint32, error) {
+	return r.index, r.err
+}
+
+func (r *mockGuardianSetReader) GetGuardianSet(ctx context.Context, index uint32) (ethabi.StructsGuardianSet, error) {
+	r.numReads++
+	return ethabi.StructsGuardianSet{Keys: r.sets[index]}, nil
+}
+
+func TestFetchGuardianSet(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	m := NewModel(time.Minute)
+	gst := common.NewGuardianSetState(nil)
+	reader := &mockGuardianSetReader{index: 3, sets: map[uint32][]ethcommon.Address{
+		3: {guardian0},
+		4: {guardian0, guardian1},
+	}}
+
+	require.NoError(t, m.fetchGuardianSet(ctx, logger, reader, gst))
+	assert.Equal(t, &common.GuardianSet{Keys: []ethcommon.Address{guardian0}, Index: 3}, m.GuardianSet())
+	assert.Equal(t, m.GuardianSet(), gst.Get())
+
+	// The guardian set is only read again when the index changes.
+	require.NoError(t, m.fetchGuardianSet(ctx, logger, reader, gst))
+	assert.Equal(t, 1, reader.numReads)
+
+	reader.index = 4
+	require.NoError(t, m.fetchGuardianSet(ctx, logger, reader, gst))
+	assert.Equal(t, uint32(4), gst.Get().Index)
+	assert.Len(t, gst.Get().Keys, 2)
+
+	reader.index = 5
+	assert.ErrorContains(t, m.fetchGuardianSet(ctx, logger, reader, gst), "guardian set 5 is empty")
+
+	reader.err = errors.New("connection refused")
+	assert.ErrorContains(t, m.fetchGuardianSet(ctx, logger, reader, gst), "failed to read the current guardian set index: connection refused")
+	assert.Equal(t, uint32(4), m.GuardianSet().Index)
+}
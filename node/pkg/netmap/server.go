@@ -0,0 +1,149 @@
+package netmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Handler returns the HTTP handler serving the network map as JSON on /v1/netmap.
+func (m *Model) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/netmap", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		// Dashboards are usually served from another origin.
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		if err := json.NewEncoder(w).Encode(m.Snapshot(time.Now())); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return mux
+}
+
+// PublicRPCServer serves the network map through the heartbeat and guardian set queries of the public RPC service, so
+// the existing tooling like `guardiand admin list-nodes` can be pointed at it.
+type PublicRPCServer struct {
+	publicrpcv1.UnimplementedPublicRPCServiceServer
+	model *Model
+}
+
+// NewPublicRPCServer creates the public RPC server of the given map.
+func NewPublicRPCServer(model *Model) *PublicRPCServer {
+	return &PublicRPCServer{model: model}
+}
+
+func (s *PublicRPCServer) GetLastHeartbeats(ctx context.Context, req *publicrpcv1.GetLastHeartbeatsRequest) (*publicrpcv1.GetLastHeartbeatsResponse, error) {
+	if s.model.GuardianSet() == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set not fetched from chain yet")
+	}
+
+	s.model.mu.Lock()
+	defer s.model.mu.Unlock()
+
+	resp := &publicrpcv1.GetLastHeartbeatsResponse{
+		Entries: make([]*publicrpcv1.GetLastHeartbeatsResponse_Entry, 0),
+	}
+	for addr, nodes := range s.model.nodes {
+		for _, n := range nodes {
+			resp.Entries = append(resp.Entries, &publicrpcv1.GetLastHeartbeatsResponse_Entry{
+				VerifiedGuardianAddr: addr.Hex(),
+				P2PNodeAddr:          n.peerID,
+				RawHeartbeat:         n.heartbeat,
+			})
+		}
+	}
+	return resp, nil
+}
+
+func (s *PublicRPCServer) GetCurrentGuardianSet(ctx context.Context, req *publicrpcv1.GetCurrentGuardianSetRequest) (*publicrpcv1.GetCurrentGuardianSetResponse, error) {
+	gs := s.model.GuardianSet()
+	if gs == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set not fetched from chain yet")
+	}
+
+	return &publicrpcv1.GetCurrentGuardianSetResponse{
+		GuardianSet: &publicrpcv1.GuardianSet{
+			Index:     gs.Index,
+			Addresses: gs.KeysAsHexStrings(),
+		},
+	}, nil
+}
+
+// GuardianSetReader reads the guardian set from the core contract, it is implemented by the EVM connectors.
+type GuardianSetReader interface {
+	GetCurrentGuardianSetIndex(ctx context.Context) (uint32, error)
+	GetGuardianSet(ctx context.Context, index uint32) (ethabi.StructsGuardianSet, error)
+}
+
+// GuardianSetFetcher returns the runnable reading the current guardian set from the core contract every interval. The
+// guardian set is stored in the guardian set state, against which the p2p layer verifies the heartbeats, and in the
+// map. Until it has been read once, no heartbeat is accepted.
+func (m *Model) GuardianSetFetcher(reader GuardianSetReader, gst *common.GuardianSetState, interval time.Duration) supervisor.Runnable {
+	return func(ctx context.Context) error {
+		logger := supervisor.Logger(ctx)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		healthy := false
+		for {
+			if err := m.fetchGuardianSet(ctx, logger, reader, gst); err != nil {
+				// Keep using the last guardian set, the guardian set changes rarely.
+				if m.GuardianSet() == nil {
+					return err
+				}
+				logger.Warn("failed to fetch the guardian set", zap.Error(err))
+			} else if !healthy {
+				supervisor.Signal(ctx, supervisor.SignalHealthy)
+				healthy = true
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}
+}
+
+func (m *Model) fetchGuardianSet(ctx context.Context, logger *zap.Logger, reader GuardianSetReader, gst *common.GuardianSetState) error {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	index, err := reader.GetCurrentGuardianSetIndex(timeout)
+	if err != nil {
+		return fmt.Errorf("failed to read the current guardian set index: %w", err)
+	}
+	if prev := m.GuardianSet(); prev != nil && prev.Index == index {
+		return nil
+	}
+
+	gs, err := reader.GetGuardianSet(timeout, index)
+	if err != nil {
+		return fmt.Errorf("failed to read guardian set %d: %w", index, err)
+	}
+	if len(gs.Keys) == 0 {
+		return fmt.Errorf("guardian set %d is empty", index)
+	}
+
+	guardianSet := &common.GuardianSet{Keys: gs.Keys, Index: index}
+	gst.Set(guardianSet)
+	m.SetGuardianSet(guardianSet)
+	logger.Info("fetched guardian set", zap.Uint32("index", index), zap.Strings("keys", guardianSet.KeysAsHexStrings()))
+	return nil
+}
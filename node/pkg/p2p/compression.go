@@ -0,0 +1,178 @@
+package p2p
+
+import (
+	"fmt"
+
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/klauspost/compress/zstd"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// gossipCompressionMinSize is the size from which outgoing gossip messages are compressed. Smaller messages (like
+	// observations and heartbeats) barely shrink, so it is not worth spending CPU on them.
+	gossipCompressionMinSize = 1024
+
+	// maxDecompressedGossipMessageSize bounds the memory used to decompress a single message, to protect against
+	// decompression bombs. It is well above the pubsub message size limit of the uncompressed protocol.
+	maxDecompressedGossipMessageSize = 16 * 1024 * 1024
+)
+
+var (
+	p2pMessageSize = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_p2p_message_size_bytes",
+			Help:    "Size on the wire of p2p pubsub broadcast messages by message type",
+			Buckets: prometheus.ExponentialBuckets(64, 2, 14),
+		}, []string{"direction", "type"})
+	p2pCompressedMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_compressed_messages_total",
+			Help: "Total number of compressed p2p pubsub broadcast messages",
+		}, []string{"direction"})
+	p2pCompressionSavedBytes = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_compression_saved_bytes_total",
+			Help: "Total number of bytes saved by compressing outgoing p2p pubsub broadcast messages",
+		})
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(maxDecompressedGossipMessageSize))
+)
+
+var gossipMessageFields = (&gossipv1.GossipMessage{}).ProtoReflect().Descriptor().Fields()
+
+// gossipMessageType returns the name of the message type of a serialized GossipMessage, for use as a metric label. It
+// only looks at the first field tag, so it is cheap enough to run on every message.
+func gossipMessageType(b []byte) string {
+	num, _, n := protowire.ConsumeTag(b)
+	if n < 0 {
+		return "invalid"
+	}
+	if f := gossipMessageFields.ByNumber(num); f != nil {
+		return string(f.Name())
+	}
+	return "unknown"
+}
+
+// gossipCompressionSupported returns true if every guardian in the current guardian set has advertised support for
+// compressed gossip messages from all of its nodes, and so has every peer subscribed to the gossip topic. Heartbeats
+// expire, so a guardian that is down or downgraded disables compression again. Peers which never send heartbeats, like
+// spies, may not support it, so messages are sent uncompressed while any of them is connected.
+func gossipCompressionSupported(gst *node_common.GuardianSetState, peers []peer.ID) bool {
+	gs := gst.Get()
+	if gs == nil || len(gs.Keys) == 0 {
+		return false
+	}
+
+	for _, key := range gs.Keys {
+		// A guardian may run several nodes, all of them need to support it.
+		heartbeats := gst.LastHeartbeat(key)
+		if len(heartbeats) == 0 {
+			return false
+		}
+		for _, hb := range heartbeats {
+			if hb.FeatureFlags&uint64(node_common.HeartbeatFeatureGossipZstd) == 0 {
+				return false
+			}
+		}
+	}
+
+	if len(peers) != 0 {
+		supported := make(map[peer.ID]bool)
+		for _, nodes := range gst.GetAll() {
+			for peerID, hb := range nodes {
+				supported[peerID] = hb.FeatureFlags&uint64(node_common.HeartbeatFeatureGossipZstd) != 0
+			}
+		}
+		for _, p := range peers {
+			if !supported[p] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// maybeCompressGossipMessage returns the message to publish for the serialized GossipMessage b. Large messages are
+// wrapped in a CompressedGossipMessage sent with the given protocol version if compress is set and compression saves
+// space.
+func maybeCompressGossipMessage(b []byte, compress bool, version uint32) []byte {
+	msgType := gossipMessageType(b)
+	if !compress || len(b) < gossipCompressionMinSize {
+		p2pMessageSize.WithLabelValues("sent", msgType).Observe(float64(len(b)))
+		return b
+	}
+
+	c, err := proto.Marshal(&gossipv1.GossipMessage{
+		Message: &gossipv1.GossipMessage_CompressedGossipMessage{
+			CompressedGossipMessage: &gossipv1.CompressedGossipMessage{
+				Algorithm: gossipv1.CompressedGossipMessage_ALGORITHM_ZSTD,
+				Data:      zstdEncoder.EncodeAll(b, nil),
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	// The version is appended so that the message type remains the first field.
+	c = setGossipProtocolVersion(c, version)
+
+	if len(c) >= len(b) {
+		p2pMessageSize.WithLabelValues("sent", msgType).Observe(float64(len(b)))
+		return b
+	}
+
+	p2pMessageSize.WithLabelValues("sent", msgType).Observe(float64(len(c)))
+	p2pCompressedMessages.WithLabelValues("sent").Inc()
+	p2pCompressionSavedBytes.Add(float64(len(b) - len(c)))
+	return c
+}
+
+// unmarshalGossipMessage deserializes a received GossipMessage into msg, transparently decompressing it if needed.
+func unmarshalGossipMessage(data []byte, msg *gossipv1.GossipMessage) error {
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return err
+	}
+
+	msgType := gossipMessageType(data)
+	if m, ok := msg.Message.(*gossipv1.GossipMessage_CompressedGossipMessage); ok {
+		b, err := decompressGossipMessage(m.CompressedGossipMessage)
+		if err != nil {
+			return err
+		}
+		if err := proto.Unmarshal(b, msg); err != nil {
+			return fmt.Errorf("failed to unmarshal decompressed message: %w", err)
+		}
+		if _, ok := msg.Message.(*gossipv1.GossipMessage_CompressedGossipMessage); ok {
+			return fmt.Errorf("nested compressed message")
+		}
+		msgType = gossipMessageType(b)
+		p2pCompressedMessages.WithLabelValues("received").Inc()
+	}
+
+	p2pMessageSize.WithLabelValues("received", msgType).Observe(float64(len(data)))
+	return nil
+}
+
+// decompressGossipMessage returns the serialized GossipMessage wrapped in c.
+func decompressGossipMessage(c *gossipv1.CompressedGossipMessage) ([]byte, error) {
+	if c.Algorithm != gossipv1.CompressedGossipMessage_ALGORITHM_ZSTD {
+		return nil, fmt.Errorf("unsupported compression algorithm: %v", c.Algorithm)
+	}
+
+	b, err := zstdDecoder.DecodeAll(c.Data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,125 @@
+package p2p
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func marshalSignedVAAForTest(t *testing.T, vaaSize int) []byte {
+	t.Helper()
+	b, err := proto.Marshal(&gossipv1.GossipMessage{
+		Message: &gossipv1.GossipMessage_SignedVaaWithQuorum{
+			SignedVaaWithQuorum: &gossipv1.SignedVAAWithQuorum{Vaa: bytes.Repeat([]byte{0x42}, vaaSize)},
+		},
+	})
+	require.NoError(t, err)
+	return b
+}
+
+func TestGossipMessageType(t *testing.T) {
+	assert.Equal(t, "signed_vaa_with_quorum", gossipMessageType(marshalSignedVAAForTest(t, 10)))
+	assert.Equal(t, "unknown", gossipMessageType([]byte{0x7a, 0x00}))
+	assert.Equal(t, "invalid", gossipMessageType([]byte{}))
+}
+
+func TestGossipCompressionRoundTrip(t *testing.T) {
+	b := marshalSignedVAAForTest(t, 4096)
+
+	c := maybeCompressGossipMessage(b, true, GossipProtocolV2)
+	assert.Less(t, len(c), len(b))
+	assert.Equal(t, "compressed_gossip_message", gossipMessageType(c))
+
+	var msg gossipv1.GossipMessage
+	require.NoError(t, unmarshalGossipMessage(c, &msg))
+	m, ok := msg.Message.(*gossipv1.GossipMessage_SignedVaaWithQuorum)
+	require.True(t, ok)
+	assert.Equal(t, bytes.Repeat([]byte{0x42}, 4096), m.SignedVaaWithQuorum.Vaa)
+
+	var wrapper gossipv1.GossipMessage
+	require.NoError(t, proto.Unmarshal(c, &wrapper))
+	assert.Equal(t, GossipProtocolV2, wrapper.ProtocolVersion)
+
+	// Uncompressed messages are accepted as well.
+	require.NoError(t, unmarshalGossipMessage(b, &msg))
+	_, ok = msg.Message.(*gossipv1.GossipMessage_SignedVaaWithQuorum)
+	assert.True(t, ok)
+}
+
+func TestGossipCompressionSkipped(t *testing.T) {
+	// Disabled.
+	b := marshalSignedVAAForTest(t, 4096)
+	assert.Equal(t, b, maybeCompressGossipMessage(b, false, GossipProtocolV2))
+
+	// Too small to be worth it.
+	b = marshalSignedVAAForTest(t, 100)
+	assert.Equal(t, b, maybeCompressGossipMessage(b, true, GossipProtocolV2))
+}
+
+func TestUnmarshalInvalidCompressedGossipMessage(t *testing.T) {
+	wrap := func(c *gossipv1.CompressedGossipMessage) []byte {
+		b, err := proto.Marshal(&gossipv1.GossipMessage{
+			Message: &gossipv1.GossipMessage_CompressedGossipMessage{CompressedGossipMessage: c},
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	inner := marshalSignedVAAForTest(t, 4096)
+	nested := maybeCompressGossipMessage(inner, true, GossipProtocolV2)
+
+	tests := []struct {
+		name string
+		data []byte
+		err  string
+	}{
+		{"unknown algorithm", wrap(&gossipv1.CompressedGossipMessage{Data: zstdEncoder.EncodeAll(inner, nil)}), "unsupported compression algorithm"},
+		{"bad data", wrap(&gossipv1.CompressedGossipMessage{Algorithm: gossipv1.CompressedGossipMessage_ALGORITHM_ZSTD, Data: []byte("junk")}), "failed to decompress"},
+		{"nested", wrap(&gossipv1.CompressedGossipMessage{Algorithm: gossipv1.CompressedGossipMessage_ALGORITHM_ZSTD, Data: zstdEncoder.EncodeAll(nested, nil)}), "nested"},
+		{"too large", wrap(&gossipv1.CompressedGossipMessage{Algorithm: gossipv1.CompressedGossipMessage_ALGORITHM_ZSTD, Data: zstdEncoder.EncodeAll(make([]byte, maxDecompressedGossipMessageSize+1), nil)}), "failed to decompress"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var msg gossipv1.GossipMessage
+			assert.ErrorContains(t, unmarshalGossipMessage(tc.data, &msg), tc.err)
+		})
+	}
+}
+
+func TestGossipCompressionSupported(t *testing.T) {
+	addr1 := common.Address{1}
+	addr2 := common.Address{2}
+	gst := node_common.NewGuardianSetState(nil)
+
+	assert.False(t, gossipCompressionSupported(gst, nil))
+
+	gst.Set(&node_common.GuardianSet{Keys: []common.Address{addr1, addr2}})
+	assert.False(t, gossipCompressionSupported(gst, nil))
+
+	hb := func(flags node_common.HeartbeatFeature) *gossipv1.Heartbeat {
+		return &gossipv1.Heartbeat{Timestamp: time.Now().UnixNano(), FeatureFlags: uint64(flags)}
+	}
+
+	require.NoError(t, gst.SetHeartbeat(addr1, peer.ID("a"), hb(node_common.HeartbeatFeatureGossipZstd|node_common.HeartbeatFeatureGovernor)))
+	assert.False(t, gossipCompressionSupported(gst, nil))
+
+	require.NoError(t, gst.SetHeartbeat(addr2, peer.ID("b"), hb(node_common.HeartbeatFeatureGossipZstd)))
+	assert.True(t, gossipCompressionSupported(gst, nil))
+
+	// So do the peers subscribed to the topic, which are only known to support it from their heartbeat.
+	assert.True(t, gossipCompressionSupported(gst, []peer.ID{"a", "b"}))
+	assert.False(t, gossipCompressionSupported(gst, []peer.ID{"a", "spy"}))
+
+	// All nodes of a guardian need to support it.
+	require.NoError(t, gst.SetHeartbeat(addr2, peer.ID("c"), hb(node_common.HeartbeatFeatureGovernor)))
+	assert.False(t, gossipCompressionSupported(gst, nil))
+}
@@ -0,0 +1,109 @@
+package p2p
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"go.uber.org/zap"
+)
+
+// DNS seeds let the operators of the bootstrap nodes publish their addresses in TXT records, so guardians follow a
+// change of the bootstrap nodes without updating their flags and restarting.
+
+const (
+	// dnsSeedRefreshInterval is how often the DNS seeds are resolved again after start up.
+	dnsSeedRefreshInterval = 10 * time.Minute
+
+	// dnsSeedTimeout is the timeout of resolving a DNS seed and of connecting to one of the peers it lists.
+	dnsSeedTimeout = 10 * time.Second
+
+	// dnsAddrPrefix is the prefix of the TXT records used by libp2p for /dnsaddr multiaddrs. It is optional here.
+	dnsAddrPrefix = "dnsaddr="
+)
+
+// txtResolver is implemented by net.Resolver.
+type txtResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// resolveDNSSeeds returns the multiaddrs listed in the TXT records of the DNS seeds. A record holds one or more
+// multiaddrs separated by commas or whitespace, each optionally prefixed with "dnsaddr=". Seeds that fail to resolve
+// are logged and skipped, since the other seeds and the static bootstrap peers may still be reachable.
+func resolveDNSSeeds(ctx context.Context, logger *zap.Logger, resolver txtResolver, seeds []string) []string {
+	var addrs []string
+	for _, seed := range seeds {
+		lookupCtx, cancel := context.WithTimeout(ctx, dnsSeedTimeout)
+		records, err := resolver.LookupTXT(lookupCtx, seed)
+		cancel()
+		if err != nil {
+			logger.Error("failed to resolve DNS seed", zap.String("seed", seed), zap.Error(err))
+			continue
+		}
+
+		for _, record := range records {
+			for _, addr := range strings.FieldsFunc(record, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+				addrs = append(addrs, strings.TrimPrefix(addr, dnsAddrPrefix))
+			}
+		}
+	}
+	return addrs
+}
+
+// parseBootstrapPeers parses the multiaddrs of the bootstrap peers, skipping invalid ones and the node itself.
+func parseBootstrapPeers(logger *zap.Logger, self peer.ID, addrs []string) []peer.AddrInfo {
+	bootstrappers := make([]peer.AddrInfo, 0)
+	for _, addr := range addrs {
+		if addr == "" {
+			continue
+		}
+		ma, err := multiaddr.NewMultiaddr(addr)
+		if err != nil {
+			logger.Error("Invalid bootstrap address", zap.String("peer", addr), zap.Error(err))
+			continue
+		}
+		pi, err := peer.AddrInfoFromP2pAddr(ma)
+		if err != nil {
+			logger.Error("Invalid bootstrap address", zap.String("peer", addr), zap.Error(err))
+			continue
+		}
+		if pi.ID == self {
+			logger.Info("We're a bootstrap node")
+			continue
+		}
+		bootstrappers = append(bootstrappers, *pi)
+	}
+	return bootstrappers
+}
+
+// refreshDNSSeeds periodically resolves the DNS seeds and connects to the listed peers the host is not connected to.
+func refreshDNSSeeds(ctx context.Context, logger *zap.Logger, h host.Host, resolver txtResolver, seeds []string) {
+	ticker := time.NewTicker(dnsSeedRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			addrs := resolveDNSSeeds(ctx, logger, resolver, seeds)
+			for _, pi := range parseBootstrapPeers(logger, h.ID(), addrs) {
+				if h.Network().Connectedness(pi.ID) == network.Connected {
+					continue
+				}
+
+				connectCtx, cancel := context.WithTimeout(ctx, dnsSeedTimeout)
+				err := h.Connect(connectCtx, pi)
+				cancel()
+				if err != nil {
+					logger.Warn("failed to connect to bootstrap peer from DNS seed", zap.String("peer", pi.String()), zap.Error(err))
+					continue
+				}
+				logger.Info("connected to bootstrap peer from DNS seed", zap.String("peer", pi.String()))
+			}
+		}
+	}
+}
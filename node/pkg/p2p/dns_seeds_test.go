@@ -0,0 +1,61 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testPeer1 = "12D3KooWQ1sV2kowPY1iJX1hJcVTysZjKv3sfULTGwhdpUGGZ1VF"
+	testPeer2 = "12D3KooWAkB9ynDur1Jtoa97LBUp8RXdhzS5uHgAfdTquJbrbN7i"
+)
+
+type mockTXTResolver map[string][]string
+
+func (r mockTXTResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, exists := r[name]
+	if !exists {
+		return nil, errors.New("no such host")
+	}
+	return records, nil
+}
+
+func TestResolveDNSSeeds(t *testing.T) {
+	resolver := mockTXTResolver{
+		"seed1.example.com": {
+			"dnsaddr=/dns4/bootstrap1.example.com/udp/8999/quic/p2p/" + testPeer1,
+			"/ip4/10.0.0.2/udp/8999/quic/p2p/" + testPeer2 + ", /ip4/10.0.0.3/udp/8999/quic/p2p/" + testPeer2,
+		},
+		"seed2.example.com": {""},
+	}
+
+	addrs := resolveDNSSeeds(context.Background(), zap.NewNop(), resolver, []string{"seed1.example.com", "missing.example.com", "seed2.example.com"})
+	assert.Equal(t, []string{
+		"/dns4/bootstrap1.example.com/udp/8999/quic/p2p/" + testPeer1,
+		"/ip4/10.0.0.2/udp/8999/quic/p2p/" + testPeer2,
+		"/ip4/10.0.0.3/udp/8999/quic/p2p/" + testPeer2,
+	}, addrs)
+}
+
+func TestParseBootstrapPeers(t *testing.T) {
+	self, err := peer.Decode(testPeer2)
+	require.NoError(t, err)
+
+	peers := parseBootstrapPeers(zap.NewNop(), self, []string{
+		"",
+		"/dns4/bootstrap1.example.com/udp/8999/quic/p2p/" + testPeer1,
+		"not a multiaddr",
+		"/ip4/10.0.0.1/udp/8999/quic",
+		"/ip4/10.0.0.2/udp/8999/quic/p2p/" + testPeer2,
+	})
+
+	require.Len(t, peers, 1)
+	assert.Equal(t, testPeer1, peers[0].ID.String())
+	assert.Equal(t, "/dns4/bootstrap1.example.com/udp/8999/quic", peers[0].Addrs[0].String())
+}
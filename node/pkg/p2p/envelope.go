@@ -0,0 +1,177 @@
+package p2p
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Gossip producers which are not guardians cannot sign with a guardian key. They wrap their messages in a SignedEnvelope
+// instead, signed with an Ed25519 key, and the guardians only accept the envelopes of the keys on their allowlist. The
+// signature covers the network ID and an expiry, so that an envelope cannot be replayed on another network or after a
+// few minutes. Only observation requests can be wrapped for now: those sent by non-guardians are only accepted in an
+// envelope, and are handled with the public key of their producer as their source.
+
+var signedEnvelopePrefix = []byte("signed_gossip_envelope|")
+
+const (
+	// signedEnvelopeValidity is how long the envelopes created by this node are valid.
+	signedEnvelopeValidity = time.Minute
+
+	// signedEnvelopeMaxValidity is the longest an envelope may still be valid for when it is received, so that its
+	// expiry bounds the time it can be replayed for.
+	signedEnvelopeMaxValidity = 5 * time.Minute
+
+	// ObservationRequestSourceEnvelopePrefix is the prefix of the source of observation requests received in a signed
+	// envelope, followed by the hex encoded public key of their producer.
+	ObservationRequestSourceEnvelopePrefix = "envelope:"
+)
+
+// EnvelopeSignerAllowlist is the set of Ed25519 public keys whose signed envelopes are accepted.
+type EnvelopeSignerAllowlist map[string]struct{}
+
+// ParseEnvelopeSignerAllowlist parses a comma separated list of hex encoded Ed25519 public keys.
+func ParseEnvelopeSignerAllowlist(config string) (EnvelopeSignerAllowlist, error) {
+	allowlist := make(EnvelopeSignerAllowlist)
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimPrefix(strings.TrimSpace(entry), "0x")
+		if entry == "" {
+			continue
+		}
+
+		pk, err := hex.DecodeString(entry)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid envelope signer "%s": %w`, entry, err)
+		}
+		if len(pk) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf(`invalid envelope signer "%s": expected %d bytes, got %d`, entry, ed25519.PublicKeySize, len(pk))
+		}
+		allowlist[string(pk)] = struct{}{}
+	}
+
+	return allowlist, nil
+}
+
+// Contains returns whether the public key pk is on the allowlist.
+func (a EnvelopeSignerAllowlist) Contains(pk []byte) bool {
+	_, exists := a[string(pk)]
+	return exists
+}
+
+// signedEnvelopeData returns the data signed for the message b, valid on networkID until expiresAt.
+func signedEnvelopeData(networkID string, expiresAt int64, b []byte) []byte {
+	data := make([]byte, 0, len(signedEnvelopePrefix)+2+len(networkID)+8+len(b))
+	data = append(data, signedEnvelopePrefix...)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(networkID)))
+	data = append(data, networkID...)
+	data = binary.BigEndian.AppendUint64(data, uint64(expiresAt))
+	return append(data, b...)
+}
+
+// checkEnvelopeMessage returns an error if msg may not be wrapped in a signed envelope.
+func checkEnvelopeMessage(msg *gossipv1.GossipMessage) error {
+	switch m := msg.Message.(type) {
+	case *gossipv1.GossipMessage_SignedObservationRequest:
+		// The request is authenticated by the envelope, it must not claim to be signed by a guardian.
+		if len(m.SignedObservationRequest.GuardianAddr) != 0 || len(m.SignedObservationRequest.Signature) != 0 || m.SignedObservationRequest.SigningKeyDelegation != nil {
+			return errors.New("observation requests in an envelope must not carry a guardian signature")
+		}
+		return nil
+	case *gossipv1.GossipMessage_SignedEnvelope, *gossipv1.GossipMessage_CompressedGossipMessage:
+		return errors.New("the wrapped message must not be an envelope or compressed")
+	default:
+		return fmt.Errorf("messages of type %T cannot be wrapped in an envelope", msg.Message)
+	}
+}
+
+// CreateSignedEnvelope wraps msg in a SignedEnvelope for networkID, signed with key and valid until expiresAt, ready to
+// be published.
+func CreateSignedEnvelope(key ed25519.PrivateKey, networkID string, expiresAt time.Time, msg *gossipv1.GossipMessage) (*gossipv1.GossipMessage, error) {
+	if err := checkEnvelopeMessage(msg); err != nil {
+		return nil, err
+	}
+
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	return &gossipv1.GossipMessage{
+		Message: &gossipv1.GossipMessage_SignedEnvelope{
+			SignedEnvelope: &gossipv1.SignedEnvelope{
+				Message:   b,
+				PublicKey: key.Public().(ed25519.PublicKey),
+				Signature: ed25519.Sign(key, signedEnvelopeData(networkID, expiresAt.Unix(), b)),
+				ExpiresAt: expiresAt.Unix(),
+			},
+		},
+	}, nil
+}
+
+// createEnvelopeObservationRequest returns the serialized GossipMessage publishing req, whose timestamp and nonce are
+// already set, in an envelope signed with key.
+func createEnvelopeObservationRequest(key ed25519.PrivateKey, networkID string, req *gossipv1.ObservationRequest, now time.Time) ([]byte, error) {
+	b, err := proto.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal observation request: %w", err)
+	}
+
+	msg, err := CreateSignedEnvelope(key, networkID, now.Add(signedEnvelopeValidity), &gossipv1.GossipMessage{
+		Message: &gossipv1.GossipMessage_SignedObservationRequest{
+			SignedObservationRequest: &gossipv1.SignedObservationRequest{ObservationRequest: b},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+// openSignedEnvelope verifies that s was signed for networkID by a key on the allowlist and has not expired at now. It
+// returns the message it wraps and the public key of its producer.
+func openSignedEnvelope(s *gossipv1.SignedEnvelope, networkID string, allowlist EnvelopeSignerAllowlist, now time.Time) (*gossipv1.GossipMessage, ed25519.PublicKey, error) {
+	if len(s.PublicKey) != ed25519.PublicKeySize {
+		return nil, nil, fmt.Errorf("invalid public key length: %d", len(s.PublicKey))
+	}
+	if !allowlist.Contains(s.PublicKey) {
+		return nil, nil, fmt.Errorf("signer %s is not allowed", hex.EncodeToString(s.PublicKey))
+	}
+	if !ed25519.Verify(s.PublicKey, signedEnvelopeData(networkID, s.ExpiresAt, s.Message), s.Signature) {
+		return nil, nil, errors.New("invalid signature")
+	}
+
+	expiresAt := time.Unix(s.ExpiresAt, 0)
+	if !now.Before(expiresAt) {
+		return nil, nil, fmt.Errorf("envelope expired at %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+	if expiresAt.After(now.Add(signedEnvelopeMaxValidity)) {
+		return nil, nil, fmt.Errorf("envelope expires too late at %s", expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	var msg gossipv1.GossipMessage
+	if err := proto.Unmarshal(s.Message, &msg); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal wrapped message: %w", err)
+	}
+	if err := checkEnvelopeMessage(&msg); err != nil {
+		return nil, nil, err
+	}
+
+	return &msg, ed25519.PublicKey(s.PublicKey), nil
+}
+
+// processEnvelopeObservationRequest returns the observation request wrapped in an envelope signed by signer, along with
+// its source.
+func processEnvelopeObservationRequest(s *gossipv1.SignedObservationRequest, signer ed25519.PublicKey) (*gossipv1.ObservationRequest, string, error) {
+	var r gossipv1.ObservationRequest
+	if err := proto.Unmarshal(s.ObservationRequest, &r); err != nil {
+		return nil, "", fmt.Errorf("failed to unmarshal observation request: %w", err)
+	}
+	return &r, ObservationRequestSourceEnvelopePrefix + hex.EncodeToString(signer), nil
+}
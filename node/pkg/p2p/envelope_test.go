@@ -0,0 +1,133 @@
+package p2p
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseEnvelopeSignerAllowlist(t *testing.T) {
+	pk, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	allowlist, err := ParseEnvelopeSignerAllowlist("")
+	require.NoError(t, err)
+	assert.Empty(t, allowlist)
+
+	allowlist, err = ParseEnvelopeSignerAllowlist(" 0x" + hex.EncodeToString(pk) + ", ")
+	require.NoError(t, err)
+	assert.Len(t, allowlist, 1)
+	assert.True(t, allowlist.Contains(pk))
+
+	_, err = ParseEnvelopeSignerAllowlist("zz")
+	assert.Error(t, err)
+	_, err = ParseEnvelopeSignerAllowlist(hex.EncodeToString(pk[:31]))
+	assert.Error(t, err)
+}
+
+const testNetworkID = "/wormhole/test"
+
+func TestSignedEnvelope(t *testing.T) {
+	pk, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	allowlist := EnvelopeSignerAllowlist{string(pk): {}}
+	now := time.Unix(1700000000, 0)
+
+	req := &gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{1}, Timestamp: now.UnixNano(), Nonce: 3}
+	b, err := createEnvelopeObservationRequest(key, testNetworkID, req, now)
+	require.NoError(t, err)
+
+	// The envelope survives serialization.
+	var received gossipv1.GossipMessage
+	require.NoError(t, unmarshalGossipMessage(b, &received))
+	s := received.GetSignedEnvelope()
+	require.NotNil(t, s)
+
+	opened, signer, err := openSignedEnvelope(s, testNetworkID, allowlist, now)
+	require.NoError(t, err)
+	assert.Equal(t, pk, signer)
+	r, source, err := processEnvelopeObservationRequest(opened.GetSignedObservationRequest(), signer)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(req, r))
+	assert.Equal(t, "envelope:"+hex.EncodeToString(pk), source)
+
+	// Keys which are not allowed are rejected.
+	_, _, err = openSignedEnvelope(s, testNetworkID, EnvelopeSignerAllowlist{}, now)
+	assert.ErrorContains(t, err, "not allowed")
+
+	// Envelopes cannot be replayed on another network or once expired.
+	_, _, err = openSignedEnvelope(s, "/wormhole/other", allowlist, now)
+	assert.ErrorContains(t, err, "invalid signature")
+	_, _, err = openSignedEnvelope(s, testNetworkID, allowlist, now.Add(signedEnvelopeValidity))
+	assert.ErrorContains(t, err, "expired")
+
+	// The expiry is signed and bounded.
+	tampered := proto.Clone(s).(*gossipv1.SignedEnvelope)
+	tampered.ExpiresAt += 3600
+	_, _, err = openSignedEnvelope(tampered, testNetworkID, allowlist, now)
+	assert.ErrorContains(t, err, "invalid signature")
+	tooLong, err := CreateSignedEnvelope(key, testNetworkID, now.Add(time.Hour), opened)
+	require.NoError(t, err)
+	_, _, err = openSignedEnvelope(tooLong.GetSignedEnvelope(), testNetworkID, allowlist, now)
+	assert.ErrorContains(t, err, "too late")
+
+	// Tampered messages are rejected.
+	tampered = proto.Clone(s).(*gossipv1.SignedEnvelope)
+	tampered.Message = append(tampered.Message, 0)
+	_, _, err = openSignedEnvelope(tampered, testNetworkID, allowlist, now)
+	assert.ErrorContains(t, err, "invalid signature")
+}
+
+// signEnvelope returns an envelope wrapping msg without the checks of CreateSignedEnvelope.
+func signEnvelope(key ed25519.PrivateKey, expiresAt time.Time, msg *gossipv1.GossipMessage) (*gossipv1.SignedEnvelope, error) {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &gossipv1.SignedEnvelope{
+		Message:   b,
+		PublicKey: key.Public().(ed25519.PublicKey),
+		Signature: ed25519.Sign(key, signedEnvelopeData(testNetworkID, expiresAt.Unix(), b)),
+		ExpiresAt: expiresAt.Unix(),
+	}, nil
+}
+
+func TestSignedEnvelopeMessageTypes(t *testing.T) {
+	pk, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	allowlist := EnvelopeSignerAllowlist{string(pk): {}}
+	now := time.Now()
+
+	rejected := map[string]*gossipv1.GossipMessage{
+		"cannot be wrapped": {Message: &gossipv1.GossipMessage_SignedObservation{
+			SignedObservation: &gossipv1.SignedObservation{Addr: []byte{1}, Hash: []byte{2}, Signature: []byte{3}, MessageId: "4"},
+		}},
+		"guardian signature": {Message: &gossipv1.GossipMessage_SignedObservationRequest{
+			SignedObservationRequest: &gossipv1.SignedObservationRequest{ObservationRequest: []byte{1}, GuardianAddr: make([]byte, 20)},
+		}},
+		"must not be an envelope": {Message: &gossipv1.GossipMessage_SignedEnvelope{SignedEnvelope: &gossipv1.SignedEnvelope{}}},
+	}
+	for expected, msg := range rejected {
+		_, err = CreateSignedEnvelope(key, testNetworkID, now.Add(time.Minute), msg)
+		assert.ErrorContains(t, err, expected)
+
+		s, err := signEnvelope(key, now.Add(time.Minute), msg)
+		require.NoError(t, err)
+		_, _, err = openSignedEnvelope(s, testNetworkID, allowlist, now)
+		assert.ErrorContains(t, err, expected)
+	}
+}
+
+func TestUnsignedObservationRequestOutsideEnvelope(t *testing.T) {
+	b, err := proto.Marshal(&gossipv1.ObservationRequest{ChainId: 2, TxHash: make([]byte, 32)})
+	require.NoError(t, err)
+	_, err = processSignedObservationRequest(&gossipv1.SignedObservationRequest{ObservationRequest: b}, &node_common.GuardianSet{})
+	assert.ErrorContains(t, err, "signed envelope")
+}
@@ -0,0 +1,139 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// Guardians and spies behind a NAT or a restrictive firewall cannot accept connections, so they can only connect to the
+// peers which can. With NATTraversal, such a host reserves a slot on circuit relay v2 nodes, which forward the
+// connections of other peers to it, and the two ends of a relayed connection then try to replace it with a direct one
+// by hole punching (DCUtR). Publicly reachable hosts can offer to be relays. Relays only forward a limited amount of
+// data per connection, so relayed connections are meant as a stepping stone to a direct one.
+
+var (
+	p2pConnections = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_p2p_connections",
+			Help: "Number of open p2p connections, by path (direct or relayed)",
+		}, []string{"path"})
+	p2pHolePunches = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_hole_punches_total",
+			Help: "Total number of hole punches to upgrade a relayed connection to a direct one, by result",
+		}, []string{"result"})
+)
+
+// NATTraversal configures circuit relay v2 and hole punching. The zero value only allows connections through relays
+// when the remote peer asks for it, which is the default of libp2p.
+type NATTraversal struct {
+	// RelayService makes the host act as a circuit relay v2 for other peers, once it finds itself publicly reachable.
+	RelayService bool
+	// StaticRelays are the relays on which the host reserves a slot when it is not publicly reachable, so that other
+	// peers can reach it through them. See ParseRelays.
+	StaticRelays []peer.AddrInfo
+	// HolePunching enables DCUtR, i.e. tries to upgrade relayed connections to direct ones.
+	HolePunching bool
+	// ForcePrivateReachability assumes the host is not publicly reachable rather than waiting for AutoNAT to find out,
+	// so that it reserves relay slots right away.
+	ForcePrivateReachability bool
+}
+
+// ParseRelays parses a comma separated list of relay multiaddrs, which must include the peer ID of the relay
+// (/p2p/<id>). Addresses of the same relay are merged.
+func ParseRelays(s string) ([]peer.AddrInfo, error) {
+	var addrs []ma.Multiaddr
+	for _, addr := range strings.Split(s, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		m, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid relay address %s: %w", addr, err)
+		}
+		addrs = append(addrs, m)
+	}
+
+	relays, err := peer.AddrInfosFromP2pAddrs(addrs...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid relay address: %w", err)
+	}
+	return relays, nil
+}
+
+// options returns the libp2p options implementing the configuration.
+func (n NATTraversal) options() []libp2p.Option {
+	var opts []libp2p.Option
+	if n.RelayService {
+		opts = append(opts, libp2p.EnableRelayService())
+	}
+	if len(n.StaticRelays) != 0 {
+		opts = append(opts, libp2p.EnableAutoRelay(autorelay.WithStaticRelays(n.StaticRelays)))
+	}
+	if n.HolePunching {
+		opts = append(opts, libp2p.EnableHolePunching(holepunch.WithTracer(holePunchMetrics{})))
+	}
+	if n.ForcePrivateReachability {
+		opts = append(opts, libp2p.ForceReachabilityPrivate())
+	}
+	return opts
+}
+
+func (n NATTraversal) logFields() []zap.Field {
+	relays := make([]string, len(n.StaticRelays))
+	for i, r := range n.StaticRelays {
+		relays[i] = r.ID.String()
+	}
+	return []zap.Field{
+		zap.Bool("relay_service", n.RelayService),
+		zap.Strings("static_relays", relays),
+		zap.Bool("hole_punching", n.HolePunching),
+		zap.Bool("force_private_reachability", n.ForcePrivateReachability),
+	}
+}
+
+// holePunchMetrics counts the results of hole punches.
+type holePunchMetrics struct{}
+
+func (holePunchMetrics) Trace(evt *holepunch.Event) {
+	if e, ok := evt.Evt.(*holepunch.EndHolePunchEvt); ok {
+		if e.Success {
+			p2pHolePunches.WithLabelValues("success").Inc()
+		} else {
+			p2pHolePunches.WithLabelValues("failure").Inc()
+		}
+	}
+}
+
+// connectionPath returns whether a connection goes through a relay.
+func connectionPath(c network.Conn) string {
+	if _, err := c.RemoteMultiaddr().ValueForProtocol(ma.P_CIRCUIT); err == nil {
+		return "relayed"
+	}
+	return "direct"
+}
+
+// connectionMetrics returns a notifiee tracking the number of direct and relayed connections.
+func connectionMetrics() network.Notifiee {
+	p2pConnections.WithLabelValues("direct").Add(0)
+	p2pConnections.WithLabelValues("relayed").Add(0)
+	return &network.NotifyBundle{
+		ConnectedF: func(_ network.Network, c network.Conn) {
+			p2pConnections.WithLabelValues(connectionPath(c)).Inc()
+		},
+		DisconnectedF: func(_ network.Network, c network.Conn) {
+			p2pConnections.WithLabelValues(connectionPath(c)).Dec()
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRelayID = "12D3KooWQXfsLHd7Mku9f2V1hpBgaxGaWhm7NGtH3P9u7xHRfD6N"
+
+func TestParseRelays(t *testing.T) {
+	relays, err := ParseRelays("/ip4/1.2.3.4/udp/8999/quic/p2p/" + testRelayID + ", /ip6/::1/udp/8999/quic/p2p/" + testRelayID)
+	require.NoError(t, err)
+	require.Len(t, relays, 1)
+	assert.Equal(t, testRelayID, relays[0].ID.String())
+	assert.Len(t, relays[0].Addrs, 2)
+
+	relays, err = ParseRelays("")
+	require.NoError(t, err)
+	assert.Empty(t, relays)
+
+	_, err = ParseRelays("/ip4/1.2.3.4/udp/8999/quic")
+	assert.Error(t, err, "the peer ID is required")
+	_, err = ParseRelays("not a multiaddr")
+	assert.Error(t, err)
+}
+
+func TestNATTraversalOptions(t *testing.T) {
+	assert.Empty(t, NATTraversal{}.options())
+
+	relays, err := ParseRelays("/ip4/1.2.3.4/udp/8999/quic/p2p/" + testRelayID)
+	require.NoError(t, err)
+	n := NATTraversal{RelayService: true, StaticRelays: relays, HolePunching: true, ForcePrivateReachability: true}
+	assert.Len(t, n.options(), 4)
+}
+
+func TestHolePunchMetrics(t *testing.T) {
+	success := testutil.ToFloat64(p2pHolePunches.WithLabelValues("success"))
+	failure := testutil.ToFloat64(p2pHolePunches.WithLabelValues("failure"))
+
+	tracer := holePunchMetrics{}
+	tracer.Trace(&holepunch.Event{Type: holepunch.EndHolePunchEvtT, Evt: &holepunch.EndHolePunchEvt{Success: true}})
+	tracer.Trace(&holepunch.Event{Type: holepunch.EndHolePunchEvtT, Evt: &holepunch.EndHolePunchEvt{Success: false}})
+	tracer.Trace(&holepunch.Event{Type: holepunch.StartHolePunchEvtT, Evt: &holepunch.StartHolePunchEvt{}})
+
+	assert.Equal(t, success+1, testutil.ToFloat64(p2pHolePunches.WithLabelValues("success")))
+	assert.Equal(t, failure+1, testutil.ToFloat64(p2pHolePunches.WithLabelValues("failure")))
+}
+
+// testConn is a connection with a given remote address.
+type testConn struct {
+	network.Conn
+	remote ma.Multiaddr
+}
+
+func (c testConn) RemoteMultiaddr() ma.Multiaddr { return c.remote }
+
+func TestConnectionPath(t *testing.T) {
+	direct := ma.StringCast("/ip4/1.2.3.4/udp/8999/quic")
+	relayed := ma.StringCast("/ip4/1.2.3.4/udp/8999/quic/p2p/" + testRelayID + "/p2p-circuit")
+	assert.Equal(t, "direct", connectionPath(testConn{remote: direct}))
+	assert.Equal(t, "relayed", connectionPath(testConn{remote: relayed}))
+}
@@ -4,7 +4,9 @@ import (
 	"math"
 	"regexp"
 	"strconv"
+	"time"
 
+	node_common "github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/version"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,6 +22,11 @@ var (
 			Name: "wormhole_network_node_height",
 			Help: "Network height of the given guardian node per network",
 		}, []string{"guardian_addr", "node_id", "node_name", "network"})
+	wormholeNetworkNodeCommitmentHeight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_network_node_commitment_height",
+			Help: "Latest, safe and finalized heights of the given guardian node per network",
+		}, []string{"guardian_addr", "node_id", "node_name", "network", "commitment"})
 	wormholeNetworkNodeErrors = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "wormhole_network_node_errors_count",
@@ -41,13 +48,21 @@ func collectNodeMetrics(addr common.Address, peerId peer.ID, hb *gossipv1.Heartb
 		chain := vaa.ChainID(n.Id)
 
 		wormholeNetworkNodeHeight.WithLabelValues(
-			addr.Hex(), peerId.Pretty(), hb.NodeName, chain.String()).Set(float64(n.Height))
+			addr.Hex(), peerId.String(), hb.NodeName, chain.String()).Set(float64(n.Height))
+
+		// Heights the node does not report are not exported, so that they do not look like a stalled chain.
+		for commitment, height := range map[string]int64{"latest": n.LatestHeight, "safe": n.SafeHeight, "finalized": n.FinalizedHeight} {
+			if height != 0 {
+				wormholeNetworkNodeCommitmentHeight.WithLabelValues(
+					addr.Hex(), peerId.String(), hb.NodeName, chain.String(), commitment).Set(float64(height))
+			}
+		}
 
 		wormholeNetworkNodeErrors.WithLabelValues(
-			addr.Hex(), peerId.Pretty(), hb.NodeName, chain.String()).Set(float64(n.ErrorCount))
+			addr.Hex(), peerId.String(), hb.NodeName, chain.String()).Set(float64(n.ErrorCount))
 
 		wormholeNetworkVersion.WithLabelValues(
-			addr.Hex(), peerId.Pretty(), hb.NodeName, chain.String(),
+			addr.Hex(), peerId.String(), hb.NodeName, chain.String(),
 			sanitizeVersion(hb.Version, version.Version())).Set(1)
 	}
 }
@@ -86,3 +101,51 @@ func sanitizeVersion(version string, reference string) string {
 	}
 	return v
 }
+
+var (
+	heartbeatAgeDesc = prometheus.NewDesc(
+		"wormhole_network_node_heartbeat_age_seconds",
+		"Seconds since the most recent heartbeat of the given guardian node was sent, as seen by this node",
+		[]string{"guardian_addr", "node_id", "node_name"}, nil)
+	guardiansSeenDesc = prometheus.NewDesc(
+		"wormhole_network_guardians_seen",
+		"Number of guardians of the current guardian set this node has recently received a heartbeat from",
+		nil, nil)
+)
+
+// heartbeatCollector exports the age of the most recent heartbeat of each node of the current guardian set. The age
+// is computed on scrape, so that it keeps growing while a guardian is not heard from.
+type heartbeatCollector struct {
+	gst *node_common.GuardianSetState
+}
+
+// NewHeartbeatCollector returns a collector of the heartbeats stored in gst, to be registered once per process.
+func NewHeartbeatCollector(gst *node_common.GuardianSetState) prometheus.Collector {
+	return &heartbeatCollector{gst: gst}
+}
+
+func (c *heartbeatCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- heartbeatAgeDesc
+	ch <- guardiansSeenDesc
+}
+
+func (c *heartbeatCollector) Collect(ch chan<- prometheus.Metric) {
+	gs := c.gst.Get()
+	if gs == nil {
+		return
+	}
+
+	now := time.Now()
+	heartbeats := c.gst.GetAll()
+	numSeen := 0
+	for _, addr := range gs.Keys {
+		if len(heartbeats[addr]) != 0 {
+			numSeen++
+		}
+		for peerId, hb := range heartbeats[addr] {
+			ch <- prometheus.MustNewConstMetric(heartbeatAgeDesc, prometheus.GaugeValue,
+				now.Sub(time.Unix(0, hb.Timestamp)).Seconds(), addr.Hex(), peerId.String(), hb.NodeName)
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(guardiansSeenDesc, prometheus.GaugeValue, float64(numSeen))
+}
@@ -1,7 +1,17 @@
 package p2p
 
 import (
+	"strings"
 	"testing"
+	"time"
+
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type sanitizeVersionCase struct {
@@ -31,3 +41,24 @@ func Test_sanitizeVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestHeartbeatCollector(t *testing.T) {
+	gst := node_common.NewGuardianSetState(nil)
+	collector := NewHeartbeatCollector(gst)
+
+	// Nothing is exported until the guardian set is known.
+	assert.Equal(t, 0, testutil.CollectAndCount(collector))
+
+	addrs := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+	gst.Set(&node_common.GuardianSet{Keys: addrs})
+	require.NoError(t, gst.SetHeartbeat(addrs[0], peer.ID("node"), &gossipv1.Heartbeat{NodeName: "a", Timestamp: time.Now().Add(-time.Minute).UnixNano()}))
+
+	expected := `
+# HELP wormhole_network_guardians_seen Number of guardians of the current guardian set this node has recently received a heartbeat from
+# TYPE wormhole_network_guardians_seen gauge
+wormhole_network_guardians_seen 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected), "wormhole_network_guardians_seen"))
+	assert.Equal(t, 2, testutil.CollectAndCount(collector))
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "wormhole_network_node_heartbeat_age_seconds"))
+}
@@ -0,0 +1,75 @@
+package p2p
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+)
+
+// Observation requests carry the time they were signed at and a random nonce, so that a request signed by a guardian
+// can't be replayed by someone else to make all guardians re-observe transactions over and over. A request is accepted
+// if its timestamp is within obsvReqReplayWindow of the local time and its nonce hasn't been seen from the same guardian
+// within that window. Requests without a timestamp are rejected, since they could be replayed indefinitely.
+
+// obsvReqReplayWindow is how far the timestamp of an observation request may be from the local time, in either direction.
+const obsvReqReplayWindow = 5 * time.Minute
+
+// stampObservationRequest sets the timestamp and nonce of an observation request about to be signed.
+func stampObservationRequest(req *gossipv1.ObservationRequest, now time.Time) {
+	var nonce [8]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		panic(err)
+	}
+	req.Timestamp = now.UnixNano()
+	req.Nonce = binary.BigEndian.Uint64(nonce[:])
+}
+
+type obsvReqNonceKey struct {
+	source string
+	nonce  uint64
+}
+
+// obsvReqReplayGuard remembers the nonces of recent observation requests. It is only used by the p2p receive loop, so it is not thread safe.
+type obsvReqReplayGuard struct {
+	// seen maps the nonces of accepted requests to the time after which they can be forgotten, since their timestamp is then too old anyway.
+	seen      map[obsvReqNonceKey]time.Time
+	lastPrune time.Time
+}
+
+func newObsvReqReplayGuard() *obsvReqReplayGuard {
+	return &obsvReqReplayGuard{seen: make(map[obsvReqNonceKey]time.Time)}
+}
+
+// check returns an error if the request, whose signature by its source (a guardian or an envelope producer) was already
+// verified, is stale or replayed. Otherwise it records the nonce of the request.
+func (g *obsvReqReplayGuard) check(source string, req *gossipv1.ObservationRequest, now time.Time) error {
+	if req.Timestamp == 0 {
+		return errors.New("request has no timestamp")
+	}
+
+	ts := time.Unix(0, req.Timestamp)
+	if ts.Before(now.Add(-obsvReqReplayWindow)) || ts.After(now.Add(obsvReqReplayWindow)) {
+		return fmt.Errorf("timestamp %s is too far from the local time", ts.UTC().Format(time.RFC3339))
+	}
+
+	if now.Sub(g.lastPrune) > obsvReqReplayWindow {
+		for k, expiry := range g.seen {
+			if now.After(expiry) {
+				delete(g.seen, k)
+			}
+		}
+		g.lastPrune = now
+	}
+
+	key := obsvReqNonceKey{source: source, nonce: req.Nonce}
+	if _, exists := g.seen[key]; exists {
+		return fmt.Errorf("replayed request with nonce %d", req.Nonce)
+	}
+	g.seen[key] = ts.Add(obsvReqReplayWindow)
+
+	return nil
+}
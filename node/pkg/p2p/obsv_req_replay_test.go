@@ -0,0 +1,46 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObsvReqReplayGuard(t *testing.T) {
+	g := newObsvReqReplayGuard()
+	guardian1 := common.Address{1}
+	guardian2 := common.Address{2}
+	now := time.Now()
+
+	req := &gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{1}}
+	stampObservationRequest(req, now)
+	assert.NotZero(t, req.Nonce)
+
+	assert.NoError(t, g.check(guardian1.Hex(), req, now))
+	assert.ErrorContains(t, g.check(guardian1.Hex(), req, now.Add(time.Second)), "replayed")
+
+	// Nonces are tracked per guardian.
+	assert.NoError(t, g.check(guardian2.Hex(), req, now))
+
+	// Requests signed too long ago or in the future are rejected.
+	old := &gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{1}}
+	stampObservationRequest(old, now.Add(-obsvReqReplayWindow-time.Second))
+	assert.ErrorContains(t, g.check(guardian1.Hex(), old, now), "too far")
+	future := &gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{1}}
+	stampObservationRequest(future, now.Add(obsvReqReplayWindow+time.Second))
+	assert.ErrorContains(t, g.check(guardian1.Hex(), future, now), "too far")
+
+	// Requests without a timestamp are rejected.
+	unstamped := &gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{1}}
+	assert.ErrorContains(t, g.check(guardian1.Hex(), unstamped, now), "no timestamp")
+
+	// Nonces are forgotten once the requests are too old to be accepted anyway.
+	later := now.Add(2*obsvReqReplayWindow + time.Second)
+	fresh := &gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{1}}
+	stampObservationRequest(fresh, later)
+	assert.NoError(t, g.check(guardian1.Hex(), fresh, later))
+	assert.Len(t, g.seen, 1)
+}
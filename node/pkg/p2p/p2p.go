@@ -3,8 +3,11 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"time"
@@ -20,7 +23,6 @@ import (
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"github.com/libp2p/go-libp2p/core/peer"
-	"github.com/multiformats/go-multiaddr"
 
 	"github.com/libp2p/go-libp2p"
 	dht "github.com/libp2p/go-libp2p-kad-dht"
@@ -57,6 +59,11 @@ var (
 			Name: "wormhole_p2p_broadcast_messages_received_total",
 			Help: "Total number of p2p pubsub broadcast messages received",
 		}, []string{"type"})
+	p2pHeartbeatsInvalidVersion = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_heartbeats_invalid_version_total",
+			Help: "Total number of p2p heartbeats received with an invalid version, which is replaced by \"invalid\"",
+		})
 	p2pReceiveChannelOverflow = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "wormhole_p2p_receive_channel_overflow",
@@ -81,7 +88,10 @@ func signedObservationRequestDigest(b []byte) common.Hash {
 
 type Components struct {
 	// P2PIDInHeartbeat determines if the guardian will put it's libp2p node ID in the authenticated heartbeat payload
-	P2PIDInHeartbeat           bool
+	P2PIDInHeartbeat bool
+	// FeatureFlags are additional node_common.HeartbeatFeature bits to advertise in the heartbeat for subsystems
+	// the p2p package has no handle on. Governor, accountant and IBC flags are derived automatically.
+	FeatureFlags               uint64
 	ListeningAddressesPatterns []string
 	// Port on which the Guardian is going to bind
 	Port uint
@@ -92,6 +102,29 @@ type Components struct {
 	// ProtectedHostByGuardianKeyLock is only useful to prevent a race condition in test as ProtectedHostByGuardianKey
 	// is only accessed by a single routine at any given time in a running Guardian.
 	ProtectedHostByGuardianKeyLock sync.Mutex
+	// SigningKey, if set, signs heartbeats and observation requests instead of the guardian key. It must be
+	// accompanied by a SigningKeyDelegation issued by the guardian key.
+	SigningKey           *ecdsa.PrivateKey
+	SigningKeyDelegation *gossipv1.SignedP2PSigningKeyDelegation
+	// CompressGossip enables compression of large outgoing gossip messages, once all guardians in the current
+	// guardian set and all connected peers support it. Incoming compressed messages are always accepted.
+	CompressGossip bool
+	// BootstrapDNSSeeds are domain names whose TXT records list bootstrap peer multiaddrs. They are resolved on start up
+	// in addition to the bootstrap peers, and periodically afterwards to follow changes of the bootstrap nodes.
+	BootstrapDNSSeeds []string
+	// PeerExchange enables gossipsub peer exchange, so peers pruned from the mesh are told about other peers to connect
+	// to instead. It should at least be enabled on the bootstrap nodes.
+	PeerExchange bool
+	// ResourceLimits overrides the limits of the libp2p resource manager.
+	ResourceLimits ResourceLimits
+	// NATTraversal configures circuit relay v2 and hole punching, for hosts behind restrictive firewalls.
+	NATTraversal NATTraversal
+	// EnvelopeSigners are the Ed25519 public keys of the non-guardian producers whose signed envelopes are accepted.
+	// Envelopes of other keys are dropped.
+	EnvelopeSigners EnvelopeSignerAllowlist
+	// EnvelopeSigningKey, if set on a node without a guardian key, signs the envelopes its observation requests are
+	// published in.
+	EnvelopeSigningKey ed25519.PrivateKey
 }
 
 func (f *Components) ListeningAddresses() []string {
@@ -137,7 +170,7 @@ func DefaultConnectionManager() (*connmgr.BasicConnMgr, error) {
 
 func Run(
 	obsvC chan<- *gossipv1.SignedObservation,
-	obsvReqC chan<- *gossipv1.ObservationRequest,
+	obsvReqC chan<- *node_common.InboundObservationRequest,
 	obsvReqSendC <-chan *gossipv1.ObservationRequest,
 	gossipSendC chan []byte,
 	signedInC chan<- *gossipv1.SignedVAAWithQuorum,
@@ -167,7 +200,17 @@ func Run(
 
 		logger := supervisor.Logger(ctx)
 
-		h, err := libp2p.New(
+		signer, err := newControlSigner(logger, gk, components.SigningKey, components.SigningKeyDelegation)
+		if err != nil {
+			return err
+		}
+
+		rm, err := newResourceManager(logger, components.ResourceLimits)
+		if err != nil {
+			return fmt.Errorf("failed to create resource manager: %w", err)
+		}
+
+		opts := []libp2p.Option{
 			// Use the keypair we generated
 			libp2p.Identity(priv),
 
@@ -186,30 +229,19 @@ func Run(
 			// connections by attaching a connection manager.
 			libp2p.ConnectionManager(components.ConnMgr),
 
+			// Limit the connections, streams and memory of the host.
+			libp2p.ResourceManager(rm),
+
 			// Let this host use the DHT to find other hosts
 			libp2p.Routing(func(h host.Host) (routing.PeerRouting, error) {
 				logger.Info("Connecting to bootstrap peers", zap.String("bootstrap_peers", bootstrapPeers))
-				bootstrappers := make([]peer.AddrInfo, 0)
-				for _, addr := range strings.Split(bootstrapPeers, ",") {
-					if addr == "" {
-						continue
-					}
-					ma, err := multiaddr.NewMultiaddr(addr)
-					if err != nil {
-						logger.Error("Invalid bootstrap address", zap.String("peer", addr), zap.Error(err))
-						continue
-					}
-					pi, err := peer.AddrInfoFromP2pAddr(ma)
-					if err != nil {
-						logger.Error("Invalid bootstrap address", zap.String("peer", addr), zap.Error(err))
-						continue
-					}
-					if pi.ID == h.ID() {
-						logger.Info("We're a bootstrap node")
-						continue
-					}
-					bootstrappers = append(bootstrappers, *pi)
+				addrs := strings.Split(bootstrapPeers, ",")
+				if len(components.BootstrapDNSSeeds) != 0 {
+					seedAddrs := resolveDNSSeeds(ctx, logger, net.DefaultResolver, components.BootstrapDNSSeeds)
+					logger.Info("Resolved DNS seeds", zap.Strings("seeds", components.BootstrapDNSSeeds), zap.Strings("bootstrap_peers", seedAddrs))
+					addrs = append(addrs, seedAddrs...)
 				}
+				bootstrappers := parseBootstrapPeers(logger, h.ID(), addrs)
 				// TODO(leo): Persistent data store (i.e. address book)
 				idht, err := dht.New(ctx, h, dht.Mode(dht.ModeServer),
 					// This intentionally makes us incompatible with the global IPFS DHT
@@ -218,12 +250,19 @@ func Run(
 				)
 				return idht, err
 			}),
-		)
+		}
+
+		// Relay and hole punching options, if any.
+		opts = append(opts, components.NATTraversal.options()...)
+		logger.Info("Configured NAT traversal", components.NATTraversal.logFields()...)
 
+		h, err := libp2p.New(opts...)
 		if err != nil {
 			panic(err)
 		}
 
+		h.Network().Notify(connectionMetrics())
+
 		defer func() {
 			// TODO: libp2p cannot be cleanly restarted (https://github.com/libp2p/go-libp2p/issues/992)
 			logger.Error("p2p routine has exited, cancelling root context...", zap.Error(re))
@@ -238,7 +277,7 @@ func Run(
 		topic := fmt.Sprintf("%s/%s", networkID, "broadcast")
 
 		logger.Info("Subscribing pubsub topic", zap.String("topic", topic))
-		ps, err := pubsub.NewGossipSub(ctx, h)
+		ps, err := pubsub.NewGossipSub(ctx, h, pubsub.WithPeerExchange(components.PeerExchange))
 		if err != nil {
 			panic(err)
 		}
@@ -256,6 +295,10 @@ func Run(
 		logger.Info("Node has been started", zap.String("peer_id", h.ID().String()),
 			zap.String("addrs", fmt.Sprintf("%v", h.Addrs())))
 
+		if len(components.BootstrapDNSSeeds) != 0 {
+			go refreshDNSSeeds(ctx, logger, h, net.DefaultResolver, components.BootstrapDNSSeeds)
+		}
+
 		bootTime := time.Now()
 
 		// Periodically run guardian state set cleanup.
@@ -290,7 +333,7 @@ func Run(
 				case <-tick.C:
 
 					// create a heartbeat
-					b := func() []byte {
+					b, err := func() ([]byte, error) {
 						DefaultRegistry.mu.Lock()
 						defer DefaultRegistry.mu.Unlock()
 						networks := make([]*gossipv1.Heartbeat_Network, 0, len(DefaultRegistry.networkStats))
@@ -301,14 +344,22 @@ func Run(
 						}
 
 						features := make([]string, 0)
+						// Every node can decompress gossip messages, whether or not it compresses its own.
+						featureFlags := components.FeatureFlags | uint64(node_common.HeartbeatFeatureGossipZstd)
 						if gov != nil {
 							features = append(features, "governor")
+							featureFlags |= uint64(node_common.HeartbeatFeatureGovernor)
 						}
 						if acct != nil {
 							features = append(features, acct.FeatureString())
+							featureFlags |= uint64(node_common.HeartbeatFeatureAccountant)
+							if acct.IsEnforcing() {
+								featureFlags |= uint64(node_common.HeartbeatFeatureAccountantEnforced)
+							}
 						}
 						if ibcFeatures != nil && *ibcFeatures != "" {
 							features = append(features, *ibcFeatures)
+							featureFlags |= uint64(node_common.HeartbeatFeatureIBC)
 						}
 
 						heartbeat := &gossipv1.Heartbeat{
@@ -320,6 +371,10 @@ func Run(
 							GuardianAddr:  ourAddr.String(),
 							BootTimestamp: bootTime.UnixNano(),
 							Features:      features,
+							FeatureFlags:  featureFlags,
+
+							GossipProtocolVersion:    CurrentGossipProtocolVersion,
+							MinGossipProtocolVersion: minGossipProtocolVersion(gossipProtocolDeprecations, time.Now()),
 						}
 
 						if components.P2PIDInHeartbeat {
@@ -335,9 +390,13 @@ func Run(
 							gov.CollectMetrics(heartbeat, gossipSendC, gk, ourAddr)
 						}
 
+						signedHeartbeat, err := createSignedHeartbeat(signer, heartbeat)
+						if err != nil {
+							return nil, err
+						}
 						msg := gossipv1.GossipMessage{
 							Message: &gossipv1.GossipMessage_SignedHeartbeat{
-								SignedHeartbeat: createSignedHeartbeat(gk, heartbeat),
+								SignedHeartbeat: signedHeartbeat,
 							},
 						}
 
@@ -345,14 +404,28 @@ func Run(
 						if err != nil {
 							panic(err)
 						}
-						return b
+						return b, nil
 					}()
+					if err != nil {
+						logger.Error("failed to sign heartbeat", zap.Error(err))
+						continue
+					}
 
+					b = encodeGossipMessage(b, gst, th.ListPeers(), components.CompressGossip)
 					err = th.Publish(ctx, b)
 					if err != nil {
 						logger.Warn("failed to publish heartbeat message", zap.Error(err))
 					}
 
+					negotiation := negotiateGossipProtocolVersion(gst, th.ListPeers(), gossipProtocolDeprecations, time.Now())
+					p2pGossipProtocolVersion.Set(float64(negotiation.Version))
+					p2pDeprecatedGossipProtocolNodes.Set(float64(negotiation.DeprecatedNodes))
+					if negotiation.DeprecatedNodes != 0 {
+						logger.Warn("guardian nodes only support deprecated gossip protocol versions and need to be upgraded",
+							zap.Int("numNodes", negotiation.DeprecatedNodes),
+							zap.Uint32("negotiatedVersion", negotiation.Version))
+					}
+
 					p2pHeartbeatsSent.Inc()
 					ctr += 1
 				}
@@ -365,28 +438,53 @@ func Run(
 				case <-ctx.Done():
 					return
 				case msg := <-gossipSendC:
+					msg = encodeGossipMessage(msg, gst, th.ListPeers(), components.CompressGossip)
 					err := th.Publish(ctx, msg)
 					p2pMessagesSent.Inc()
 					if err != nil {
 						logger.Error("failed to publish message from queue", zap.Error(err))
 					}
 				case msg := <-obsvReqSendC:
+					stampObservationRequest(msg, time.Now())
+
+					// Nodes which are not guardians publish their requests in a signed envelope.
+					if gk == nil && components.EnvelopeSigningKey != nil {
+						b, err := createEnvelopeObservationRequest(components.EnvelopeSigningKey, networkID, msg, time.Now())
+						if err != nil {
+							logger.Error("failed to create signed envelope for observation request", zap.Error(err))
+							continue
+						}
+						b = encodeGossipMessage(b, gst, th.ListPeers(), components.CompressGossip)
+						err = th.Publish(ctx, b)
+						p2pMessagesSent.Inc()
+						if err != nil {
+							logger.Error("failed to publish observation request", zap.Error(err))
+						} else {
+							logger.Info("published observation request in signed envelope", zap.Any("observation_request", msg))
+						}
+						continue
+					}
+
 					b, err := proto.Marshal(msg)
 					if err != nil {
 						panic(err)
 					}
 
-					// Sign the observation request using our node's guardian key.
+					// Sign the observation request using our node's guardian key (or its delegated signing key).
 					digest := signedObservationRequestDigest(b)
-					sig, err := ethcrypto.Sign(digest.Bytes(), gk)
+					sig, delegation, err := signer.sign(digest)
 					if err != nil {
-						panic(err)
+						// Only this node handles the request, since the network would not accept it unsigned.
+						logger.Error("failed to sign observation request, not publishing it", zap.Error(err))
+						obsvReqC <- &node_common.InboundObservationRequest{Request: msg, Source: node_common.ObservationRequestSourceLocal}
+						continue
 					}
 
 					sReq := &gossipv1.SignedObservationRequest{
-						ObservationRequest: b,
-						Signature:          sig,
-						GuardianAddr:       ethcrypto.PubkeyToAddress(gk.PublicKey).Bytes(),
+						ObservationRequest:   b,
+						Signature:            sig,
+						GuardianAddr:         ethcrypto.PubkeyToAddress(gk.PublicKey).Bytes(),
+						SigningKeyDelegation: delegation,
 					}
 
 					envelope := &gossipv1.GossipMessage{
@@ -399,8 +497,9 @@ func Run(
 					}
 
 					// Send to local observation request queue (the loopback message is ignored)
-					obsvReqC <- msg
+					obsvReqC <- &node_common.InboundObservationRequest{Request: msg, Source: node_common.ObservationRequestSourceLocal}
 
+					b = encodeGossipMessage(b, gst, th.ListPeers(), components.CompressGossip)
 					err = th.Publish(ctx, b)
 					p2pMessagesSent.Inc()
 					if err != nil {
@@ -412,6 +511,7 @@ func Run(
 			}
 		}()
 
+		obsvReqReplay := newObsvReqReplayGuard()
 		for {
 			envelope, err := sub.Next(ctx)
 			if err != nil {
@@ -419,15 +519,24 @@ func Run(
 			}
 
 			var msg gossipv1.GossipMessage
-			err = proto.Unmarshal(envelope.Data, &msg)
+			err = unmarshalGossipMessage(envelope.Data, &msg)
 			if err != nil {
 				logger.Info("received invalid message",
+					zap.Error(err),
 					zap.Binary("data", envelope.Data),
 					zap.String("from", envelope.GetFrom().String()))
 				p2pMessagesReceived.WithLabelValues("invalid").Inc()
 				continue
 			}
 
+			if err := checkGossipProtocolVersion(&msg, minGossipProtocolVersion(gossipProtocolDeprecations, time.Now())); err != nil {
+				logger.Debug("received message with a deprecated protocol version",
+					zap.Error(err),
+					zap.String("from", envelope.GetFrom().String()))
+				p2pMessagesReceived.WithLabelValues("deprecated_protocol").Inc()
+				continue
+			}
+
 			if envelope.GetFrom() == h.ID() {
 				logger.Debug("received message from ourselves, ignoring",
 					zap.Any("payload", msg.Message))
@@ -435,6 +544,26 @@ func Run(
 				continue
 			}
 
+			// The producer of a message received in a signed envelope, nil for other messages.
+			var envelopeSigner ed25519.PublicKey
+			if m, ok := msg.Message.(*gossipv1.GossipMessage_SignedEnvelope); ok {
+				inner, signer, err := openSignedEnvelope(m.SignedEnvelope, networkID, components.EnvelopeSigners, time.Now())
+				if err != nil {
+					p2pMessagesReceived.WithLabelValues("invalid_signed_envelope").Inc()
+					logger.Debug("invalid signed envelope received",
+						zap.Error(err),
+						zap.Binary("raw", envelope.Data),
+						zap.String("from", envelope.GetFrom().String()))
+					continue
+				}
+				p2pMessagesReceived.WithLabelValues("signed_envelope").Inc()
+				logger.Debug("valid signed envelope received",
+					zap.String("signer", hex.EncodeToString(m.SignedEnvelope.PublicKey)),
+					zap.String("from", envelope.GetFrom().String()))
+				msg.Message = inner.Message
+				envelopeSigner = signer
+			}
+
 			logger.Debug("received message",
 				zap.Any("payload", msg.Message),
 				zap.Binary("raw", envelope.Data),
@@ -525,7 +654,24 @@ func Run(
 						zap.String("from", envelope.GetFrom().String()))
 					break
 				}
-				r, err := processSignedObservationRequest(s, gs)
+				var r *gossipv1.ObservationRequest
+				var source string
+				if envelopeSigner != nil {
+					r, source, err = processEnvelopeObservationRequest(s, envelopeSigner)
+				} else {
+					r, err = processSignedObservationRequest(s, gs)
+					source = common.BytesToAddress(s.GuardianAddr).Hex()
+				}
+				if err == nil {
+					if replayErr := obsvReqReplay.check(source, r, time.Now()); replayErr != nil {
+						p2pMessagesReceived.WithLabelValues("replayed_signed_observation_request").Inc()
+						logger.Debug("dropping replayed signed observation request",
+							zap.Error(replayErr),
+							zap.Any("value", r),
+							zap.String("from", envelope.GetFrom().String()))
+						break
+					}
+				}
 				if err != nil {
 					p2pMessagesReceived.WithLabelValues("invalid_signed_observation_request").Inc()
 					logger.Debug("invalid signed observation request received",
@@ -537,10 +683,11 @@ func Run(
 				} else {
 					logger.Info("valid signed observation request received",
 						zap.Any("value", r),
+						zap.String("source", source),
 						zap.String("from", envelope.GetFrom().String()))
 
 					select {
-					case obsvReqC <- r:
+					case obsvReqC <- &node_common.InboundObservationRequest{Request: r, Source: source, EnvelopeSigner: envelopeSigner}:
 						p2pMessagesReceived.WithLabelValues("signed_observation_request").Inc()
 					default:
 						p2pReceiveChannelOverflow.WithLabelValues("signed_observation_request").Inc()
@@ -565,26 +712,25 @@ func Run(
 	}
 }
 
-func createSignedHeartbeat(gk *ecdsa.PrivateKey, heartbeat *gossipv1.Heartbeat) *gossipv1.SignedHeartbeat {
-	ourAddr := ethcrypto.PubkeyToAddress(gk.PublicKey)
-
+func createSignedHeartbeat(signer *controlSigner, heartbeat *gossipv1.Heartbeat) (*gossipv1.SignedHeartbeat, error) {
 	b, err := proto.Marshal(heartbeat)
 	if err != nil {
 		panic(err)
 	}
 
-	// Sign the heartbeat using our node's guardian key.
+	// Sign the heartbeat using our node's guardian key (or its delegated signing key).
 	digest := heartbeatDigest(b)
-	sig, err := ethcrypto.Sign(digest.Bytes(), gk)
+	sig, delegation, err := signer.sign(digest)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	return &gossipv1.SignedHeartbeat{
-		Heartbeat:    b,
-		Signature:    sig,
-		GuardianAddr: ourAddr.Bytes(),
-	}
+		Heartbeat:            b,
+		Signature:            sig,
+		GuardianAddr:         signer.guardianAddr.Bytes(),
+		SigningKeyDelegation: delegation,
+	}, nil
 }
 
 func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *node_common.GuardianSet, gst *node_common.GuardianSetState, disableVerify bool) (*gossipv1.Heartbeat, error) {
@@ -606,16 +752,26 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *node_
 		return nil, fmt.Errorf("invalid message: too short")
 	}
 
+	expectedSigner, err := expectedControlSigner(pk, s.SigningKeyDelegation)
+	if err != nil && !disableVerify {
+		return nil, err
+	}
+
 	pubKey, err := ethcrypto.Ecrecover(digest.Bytes(), s.Signature)
 	if err != nil {
 		return nil, errors.New("failed to recover public key")
 	}
 
 	signerAddr := common.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:])
-	if pk != signerAddr && !disableVerify {
+	if expectedSigner != signerAddr && !disableVerify {
 		return nil, fmt.Errorf("invalid signer: %v", signerAddr)
 	}
 
+	// With a delegated signing key, the message is sent on behalf of the guardian in the envelope.
+	if s.SigningKeyDelegation != nil {
+		signerAddr = envelopeAddr
+	}
+
 	var h gossipv1.Heartbeat
 	err = proto.Unmarshal(s.Heartbeat, &h)
 	if err != nil {
@@ -630,6 +786,17 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *node_
 		return nil, fmt.Errorf("GuardianAddr in heartbeat does not match signerAddr")
 	}
 
+	// An invalid version only invalidates that field, the heartbeat is still used for everything else.
+	if err := node_common.ValidateHeartbeatVersion(h.Version); err != nil {
+		h.Version = node_common.InvalidHeartbeatVersion
+		p2pHeartbeatsInvalidVersion.Inc()
+	}
+
+	// Nodes that predate the feature bitmap only send the feature strings.
+	if h.FeatureFlags == 0 {
+		h.FeatureFlags = node_common.HeartbeatFeatureFlagsFromFeatures(h.Features)
+	}
+
 	// Store verified heartbeat in global guardian set state.
 	if err := gst.SetHeartbeat(signerAddr, from, &h); err != nil {
 		return nil, fmt.Errorf("failed to store in guardian set state: %w", err)
@@ -641,6 +808,9 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *node_
 }
 
 func processSignedObservationRequest(s *gossipv1.SignedObservationRequest, gs *node_common.GuardianSet) (*gossipv1.ObservationRequest, error) {
+	if len(s.GuardianAddr) == 0 {
+		return nil, errors.New("observation requests of non-guardians must be wrapped in a signed envelope")
+	}
 	envelopeAddr := common.BytesToAddress(s.GuardianAddr)
 	idx, ok := gs.KeyIndex(envelopeAddr)
 	var pk common.Address
@@ -657,13 +827,18 @@ func processSignedObservationRequest(s *gossipv1.SignedObservationRequest, gs *n
 
 	digest := signedObservationRequestDigest(s.ObservationRequest)
 
+	expectedSigner, err := expectedControlSigner(pk, s.SigningKeyDelegation)
+	if err != nil {
+		return nil, err
+	}
+
 	pubKey, err := ethcrypto.Ecrecover(digest.Bytes(), s.Signature)
 	if err != nil {
 		return nil, errors.New("failed to recover public key")
 	}
 
 	signerAddr := common.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:])
-	if pk != signerAddr {
+	if expectedSigner != signerAddr {
 		return nil, fmt.Errorf("invalid signer: %v", signerAddr)
 	}
 
@@ -673,7 +848,7 @@ func processSignedObservationRequest(s *gossipv1.SignedObservationRequest, gs *n
 		return nil, fmt.Errorf("failed to unmarshal observation request: %w", err)
 	}
 
-	// TODO: implement per-guardian rate limiting
+	// Replay protection is applied by the caller, rate limiting by the reobservation handler.
 
 	return &h, nil
 }
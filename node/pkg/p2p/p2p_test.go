@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
 )
 
 func TestSignedHeartbeat(t *testing.T) {
@@ -81,7 +82,10 @@ func TestSignedHeartbeat(t *testing.T) {
 			Features:      []string{},
 		}
 
-		s := createSignedHeartbeat(gk, heartbeat)
+		signer, err := newControlSigner(zap.NewNop(), gk, nil, nil)
+		assert.NoError(t, err)
+		s, err := createSignedHeartbeat(signer, heartbeat)
+		assert.NoError(t, err)
 		gs := &node_common.GuardianSet{
 			Keys:  []common.Address{addr},
 			Index: 1,
@@ -103,3 +107,31 @@ func TestSignedHeartbeat(t *testing.T) {
 		testFunc(t, tc)
 	}
 }
+
+func TestSignedHeartbeatInvalidVersion(t *testing.T) {
+	gk, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	assert.NoError(t, err)
+	addr := ethcrypto.PubkeyToAddress(gk.PublicKey)
+
+	heartbeat := &gossipv1.Heartbeat{
+		NodeName:     "someNode",
+		Counter:      1,
+		Timestamp:    time.Now().UnixNano(),
+		Version:      "0.0.1 beta\n",
+		GuardianAddr: addr.String(),
+		Features:     []string{"governor", "acct:enforced"},
+	}
+
+	signer, err := newControlSigner(zap.NewNop(), gk, nil, nil)
+	assert.NoError(t, err)
+	s, err := createSignedHeartbeat(signer, heartbeat)
+	assert.NoError(t, err)
+	gs := &node_common.GuardianSet{Keys: []common.Address{addr}, Index: 1}
+
+	// The heartbeat is kept, only the version is flagged. The feature bitmap is derived from the feature strings.
+	h, err := processSignedHeartbeat("someone", s, gs, node_common.NewGuardianSetState(nil), false)
+	assert.NoError(t, err)
+	assert.Equal(t, node_common.InvalidHeartbeatVersion, h.Version)
+	assert.Equal(t, "someNode", h.NodeName)
+	assert.Equal(t, uint64(node_common.HeartbeatFeatureGovernor|node_common.HeartbeatFeatureAccountant|node_common.HeartbeatFeatureAccountantEnforced), h.FeatureFlags)
+}
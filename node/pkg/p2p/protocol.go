@@ -0,0 +1,198 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Gossip protocol versions. Every node advertises the range of versions it can receive in its heartbeat, and sends
+// with the highest version every node of every guardian in the current guardian set supports, so new message formats
+// are only used once the whole network has upgraded. A new format therefore requires a new version, which must be
+// appended here and never renumbered.
+const (
+	// GossipProtocolV1 is the protocol of the nodes predating protocol versioning.
+	GossipProtocolV1 uint32 = 1
+	// GossipProtocolV2 adds versioned envelopes and zstd compressed messages (see CompressedGossipMessage).
+	GossipProtocolV2 uint32 = 2
+
+	// CurrentGossipProtocolVersion is the highest version this node can send and receive.
+	CurrentGossipProtocolVersion = GossipProtocolV2
+)
+
+// gossipProtocolMessageVersionField is the field number of GossipMessage.protocol_version.
+const gossipProtocolMessageVersionField = 11
+
+// gossipProtocolDeprecations maps the versions being phased out to the end of their deprecation window. Until then,
+// the guardians still running them are reported but accommodated. Afterwards, this node stops accepting messages of
+// these versions and stops negotiating down to them, so the deadline must leave operators enough time to upgrade.
+var gossipProtocolDeprecations = map[uint32]time.Time{}
+
+// errDeprecatedGossipProtocol is returned for messages sent with a version past its deprecation window.
+var errDeprecatedGossipProtocol = errors.New("deprecated gossip protocol version")
+
+var (
+	p2pGossipProtocolVersion = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_p2p_gossip_protocol_version",
+			Help: "Gossip protocol version negotiated with the current guardian set",
+		})
+	p2pDeprecatedGossipProtocolNodes = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_p2p_deprecated_gossip_protocol_nodes",
+			Help: "Number of nodes in the current guardian set which only support deprecated gossip protocol versions",
+		})
+)
+
+// minGossipProtocolVersion returns the lowest version whose deprecation window has not ended at the given time.
+func minGossipProtocolVersion(deprecations map[uint32]time.Time, now time.Time) uint32 {
+	min := GossipProtocolV1
+	for min < CurrentGossipProtocolVersion {
+		deadline, deprecated := deprecations[min]
+		if !deprecated || now.Before(deadline) {
+			break
+		}
+		min++
+	}
+	return min
+}
+
+// isGossipProtocolVersionDeprecated returns true if the version is being phased out, whether or not its deprecation
+// window has ended.
+func isGossipProtocolVersionDeprecated(deprecations map[uint32]time.Time, version uint32) bool {
+	_, deprecated := deprecations[version]
+	return deprecated
+}
+
+// heartbeatGossipProtocolVersions returns the range of versions the node which sent the heartbeat supports.
+func heartbeatGossipProtocolVersions(hb *gossipv1.Heartbeat) (min uint32, max uint32) {
+	max = hb.GossipProtocolVersion
+	if max == 0 {
+		// Nodes predating protocol versioning advertised compression support with a feature flag.
+		max = GossipProtocolV1
+		if hb.FeatureFlags&uint64(node_common.HeartbeatFeatureGossipZstd) != 0 {
+			max = GossipProtocolV2
+		}
+	}
+
+	min = hb.MinGossipProtocolVersion
+	if min == 0 {
+		min = GossipProtocolV1
+	}
+
+	return min, max
+}
+
+// gossipProtocolNegotiation is the outcome of negotiateGossipProtocolVersion.
+type gossipProtocolNegotiation struct {
+	// Version is the version to send messages with.
+	Version uint32
+	// DeprecatedNodes is the number of nodes which only support deprecated versions. Past the deprecation window,
+	// they are left behind and cannot decode the messages of the newer versions anymore.
+	DeprecatedNodes int
+}
+
+// negotiateGossipProtocolVersion returns the highest version supported by every node of every guardian in the current
+// guardian set and by the peers subscribed to the gossip topic. A guardian without heartbeats may run any version, so it
+// holds the network back to the lowest version still accepted, in the same way as a guardian which has not upgraded.
+// The same goes for peers which never send heartbeats, like spies.
+func negotiateGossipProtocolVersion(gst *node_common.GuardianSetState, peers []peer.ID, deprecations map[uint32]time.Time, now time.Time) gossipProtocolNegotiation {
+	minAccepted := minGossipProtocolVersion(deprecations, now)
+	result := gossipProtocolNegotiation{Version: minAccepted}
+
+	gs := gst.Get()
+	if gs == nil || len(gs.Keys) == 0 {
+		return result
+	}
+
+	version := CurrentGossipProtocolVersion
+	// The lowest version accepted by the other nodes, which may have ended deprecation windows this node has not.
+	othersMinAccepted := minAccepted
+	for _, key := range gs.Keys {
+		// A guardian may run several nodes, all of them need to support the version.
+		heartbeats := gst.LastHeartbeat(key)
+		if len(heartbeats) == 0 {
+			version = minAccepted
+		}
+		for _, hb := range heartbeats {
+			min, max := heartbeatGossipProtocolVersions(hb)
+			if isGossipProtocolVersionDeprecated(deprecations, max) {
+				result.DeprecatedNodes++
+			}
+			if max < version {
+				version = max
+			}
+			if min > othersMinAccepted {
+				othersMinAccepted = min
+			}
+		}
+	}
+
+	// Peers are only known to support a version from their heartbeat. Those of guardians outside of the current
+	// guardian set are taken into account as well, since they may still be relaying messages.
+	if len(peers) != 0 {
+		heartbeats := make(map[peer.ID]*gossipv1.Heartbeat)
+		for _, nodes := range gst.GetAll() {
+			for peerID, hb := range nodes {
+				heartbeats[peerID] = hb
+			}
+		}
+		for _, p := range peers {
+			hb, exists := heartbeats[p]
+			if !exists {
+				version = minAccepted
+				continue
+			}
+			if _, max := heartbeatGossipProtocolVersions(hb); max < version {
+				version = max
+			}
+		}
+	}
+
+	// Nodes past the deprecation windows are not waited for.
+	if version < othersMinAccepted {
+		version = othersMinAccepted
+	}
+	if version > CurrentGossipProtocolVersion {
+		version = CurrentGossipProtocolVersion
+	}
+
+	result.Version = version
+	return result
+}
+
+// setGossipProtocolVersion sets the protocol_version field of the serialized GossipMessage b. Messages are serialized
+// by many components, so the field is appended rather than set before serialization: the last occurrence of a field
+// takes precedence when parsing. b may be shared, so it is copied rather than appended to in place.
+func setGossipProtocolVersion(b []byte, version uint32) []byte {
+	b = protowire.AppendTag(b[:len(b):len(b)], gossipProtocolMessageVersionField, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(version))
+}
+
+// encodeGossipMessage returns the message to publish for the serialized GossipMessage b, sent with the version
+// negotiated with the current guardian set and the peers subscribed to the gossip topic, and compressed if compress is
+// set, the version allows it and every guardian supports it (see gossipCompressionSupported).
+func encodeGossipMessage(b []byte, gst *node_common.GuardianSetState, peers []peer.ID, compress bool) []byte {
+	version := negotiateGossipProtocolVersion(gst, peers, gossipProtocolDeprecations, time.Now()).Version
+	b = setGossipProtocolVersion(b, version)
+	return maybeCompressGossipMessage(b, compress && version >= GossipProtocolV2 && gossipCompressionSupported(gst, peers), version)
+}
+
+// checkGossipProtocolVersion returns an error if msg was sent with a version this node does not accept anymore.
+func checkGossipProtocolVersion(msg *gossipv1.GossipMessage, minAccepted uint32) error {
+	version := msg.ProtocolVersion
+	if version == 0 {
+		version = GossipProtocolV1
+	}
+	if version < minAccepted {
+		return fmt.Errorf("%w: %d, the minimum is %d", errDeprecatedGossipProtocol, version, minAccepted)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinGossipProtocolVersion(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, GossipProtocolV1, minGossipProtocolVersion(nil, now))
+
+	// Within the deprecation window.
+	deprecations := map[uint32]time.Time{GossipProtocolV1: now.Add(time.Hour)}
+	assert.Equal(t, GossipProtocolV1, minGossipProtocolVersion(deprecations, now))
+
+	// Past the deprecation window.
+	assert.Equal(t, GossipProtocolV2, minGossipProtocolVersion(deprecations, now.Add(2*time.Hour)))
+
+	// The current version can never be phased out.
+	deprecations[CurrentGossipProtocolVersion] = now
+	assert.Equal(t, CurrentGossipProtocolVersion, minGossipProtocolVersion(deprecations, now.Add(2*time.Hour)))
+}
+
+func TestNegotiateGossipProtocolVersion(t *testing.T) {
+	addr1 := common.Address{1}
+	addr2 := common.Address{2}
+	gst := node_common.NewGuardianSetState(nil)
+	now := time.Now()
+
+	negotiate := func(deprecations map[uint32]time.Time) gossipProtocolNegotiation {
+		return negotiateGossipProtocolVersion(gst, nil, deprecations, now)
+	}
+
+	assert.Equal(t, GossipProtocolV1, negotiate(nil).Version)
+
+	gst.Set(&node_common.GuardianSet{Keys: []common.Address{addr1, addr2}})
+	assert.Equal(t, GossipProtocolV1, negotiate(nil).Version)
+
+	legacy := func(flags node_common.HeartbeatFeature) *gossipv1.Heartbeat {
+		return &gossipv1.Heartbeat{Timestamp: now.UnixNano(), FeatureFlags: uint64(flags)}
+	}
+	versioned := func(min, max uint32) *gossipv1.Heartbeat {
+		return &gossipv1.Heartbeat{Timestamp: now.UnixNano(), GossipProtocolVersion: max, MinGossipProtocolVersion: min}
+	}
+
+	// Nodes predating protocol versioning which support compression speak version 2.
+	require.NoError(t, gst.SetHeartbeat(addr1, peer.ID("a"), legacy(node_common.HeartbeatFeatureGossipZstd|node_common.HeartbeatFeatureGovernor)))
+	assert.Equal(t, GossipProtocolV1, negotiate(nil).Version)
+
+	require.NoError(t, gst.SetHeartbeat(addr2, peer.ID("b"), versioned(GossipProtocolV1, GossipProtocolV2)))
+	assert.Equal(t, GossipProtocolV2, negotiate(nil).Version)
+
+	// Newer nodes do not change anything.
+	require.NoError(t, gst.SetHeartbeat(addr2, peer.ID("b"), versioned(GossipProtocolV1, CurrentGossipProtocolVersion+1)))
+	assert.Equal(t, CurrentGossipProtocolVersion, negotiate(nil).Version)
+
+	// All nodes of a guardian need to support the version.
+	require.NoError(t, gst.SetHeartbeat(addr2, peer.ID("c"), legacy(node_common.HeartbeatFeatureGovernor)))
+	n := negotiate(nil)
+	assert.Equal(t, GossipProtocolV1, n.Version)
+	assert.Equal(t, 0, n.DeprecatedNodes)
+
+	// The node stuck on version 1 is reported during the deprecation window...
+	deprecations := map[uint32]time.Time{GossipProtocolV1: now.Add(time.Hour)}
+	n = negotiate(deprecations)
+	assert.Equal(t, GossipProtocolV1, n.Version)
+	assert.Equal(t, 1, n.DeprecatedNodes)
+
+	// ...and left behind afterwards.
+	deprecations[GossipProtocolV1] = now
+	n = negotiate(deprecations)
+	assert.Equal(t, GossipProtocolV2, n.Version)
+	assert.Equal(t, 1, n.DeprecatedNodes)
+
+	// Nodes which ended a deprecation window before this one are not waited for either.
+	require.NoError(t, gst.SetHeartbeat(addr1, peer.ID("a"), versioned(GossipProtocolV2, GossipProtocolV2)))
+	assert.Equal(t, GossipProtocolV2, negotiate(nil).Version)
+}
+
+func TestNegotiateGossipProtocolVersionWithPeers(t *testing.T) {
+	addr := common.Address{1}
+	gst := node_common.NewGuardianSetState(nil)
+	gst.Set(&node_common.GuardianSet{Keys: []common.Address{addr}})
+	now := time.Now()
+	require.NoError(t, gst.SetHeartbeat(addr, peer.ID("a"), &gossipv1.Heartbeat{Timestamp: now.UnixNano(), GossipProtocolVersion: GossipProtocolV2}))
+
+	assert.Equal(t, GossipProtocolV2, negotiateGossipProtocolVersion(gst, []peer.ID{"a"}, nil, now).Version)
+
+	// A peer without heartbeat, like a spy, may run any version.
+	assert.Equal(t, GossipProtocolV1, negotiateGossipProtocolVersion(gst, []peer.ID{"a", "spy"}, nil, now).Version)
+
+	// Unless it is past the deprecation window.
+	deprecations := map[uint32]time.Time{GossipProtocolV1: now}
+	assert.Equal(t, GossipProtocolV2, negotiateGossipProtocolVersion(gst, []peer.ID{"a", "spy"}, deprecations, now).Version)
+
+	// The nodes of guardians outside of the guardian set are known from their heartbeat.
+	require.NoError(t, gst.SetHeartbeat(common.Address{2}, peer.ID("b"), &gossipv1.Heartbeat{Timestamp: now.UnixNano()}))
+	assert.Equal(t, GossipProtocolV1, negotiateGossipProtocolVersion(gst, []peer.ID{"a", "b"}, nil, now).Version)
+}
+
+func TestEncodeGossipMessage(t *testing.T) {
+	gst := node_common.NewGuardianSetState(nil)
+	b := marshalSignedVAAForTest(t, 4096)
+	shared := append(make([]byte, 0, 2*len(b)), b...)
+
+	// Without guardian set, messages are sent with version 1 and never compressed.
+	e := encodeGossipMessage(shared, gst, nil, true)
+	assert.Equal(t, make([]byte, 2), shared[len(b):len(b)+2], "the spare capacity of the message must not be written to")
+	assert.Equal(t, "signed_vaa_with_quorum", gossipMessageType(e))
+	var msg gossipv1.GossipMessage
+	require.NoError(t, unmarshalGossipMessage(e, &msg))
+	assert.Equal(t, GossipProtocolV1, msg.ProtocolVersion)
+	assert.NotNil(t, msg.GetSignedVaaWithQuorum())
+
+	addr := common.Address{1}
+	gst.Set(&node_common.GuardianSet{Keys: []common.Address{addr}})
+	require.NoError(t, gst.SetHeartbeat(addr, peer.ID("a"), &gossipv1.Heartbeat{Timestamp: time.Now().UnixNano(), GossipProtocolVersion: GossipProtocolV2}))
+
+	// Compression requires the guardians to advertise it.
+	e = encodeGossipMessage(b, gst, nil, true)
+	assert.Equal(t, "signed_vaa_with_quorum", gossipMessageType(e))
+
+	require.NoError(t, gst.SetHeartbeat(addr, peer.ID("a"), &gossipv1.Heartbeat{Timestamp: time.Now().UnixNano(), GossipProtocolVersion: GossipProtocolV2, FeatureFlags: uint64(node_common.HeartbeatFeatureGossipZstd)}))
+	e = encodeGossipMessage(b, gst, nil, true)
+	assert.Equal(t, "compressed_gossip_message", gossipMessageType(e))
+	require.NoError(t, unmarshalGossipMessage(e, &msg))
+	assert.Equal(t, GossipProtocolV2, msg.ProtocolVersion)
+	assert.NotNil(t, msg.GetSignedVaaWithQuorum())
+}
+
+func TestCheckGossipProtocolVersion(t *testing.T) {
+	assert.NoError(t, checkGossipProtocolVersion(&gossipv1.GossipMessage{}, GossipProtocolV1))
+	assert.NoError(t, checkGossipProtocolVersion(&gossipv1.GossipMessage{ProtocolVersion: GossipProtocolV2}, GossipProtocolV2))
+
+	err := checkGossipProtocolVersion(&gossipv1.GossipMessage{}, GossipProtocolV2)
+	assert.ErrorIs(t, err, errDeprecatedGossipProtocol)
+	assert.EqualError(t, err, "deprecated gossip protocol version: 1, the minimum is 2")
+}
@@ -1,8 +1,11 @@
 package p2p
 
 import (
+	"sort"
 	"sync"
 
+	"google.golang.org/protobuf/proto"
+
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -15,6 +18,9 @@ type registry struct {
 	// Mapping of chain IDs to network status messages.
 	networkStats map[vaa.ChainID]*gossipv1.Heartbeat_Network
 
+	// Mapping of chain IDs to their latest, safe and finalized heights, which are merged into the network status.
+	networkHeights map[vaa.ChainID]NetworkHeights
+
 	// Per-chain error counters
 	errorCounters  map[vaa.ChainID]uint64
 	errorCounterMu sync.Mutex
@@ -22,8 +28,9 @@ type registry struct {
 
 func NewRegistry() *registry {
 	return &registry{
-		networkStats:  map[vaa.ChainID]*gossipv1.Heartbeat_Network{},
-		errorCounters: map[vaa.ChainID]uint64{},
+		networkStats:   map[vaa.ChainID]*gossipv1.Heartbeat_Network{},
+		networkHeights: map[vaa.ChainID]NetworkHeights{},
+		errorCounters:  map[vaa.ChainID]uint64{},
 	}
 }
 
@@ -40,6 +47,50 @@ func (r *registry) SetNetworkStats(chain vaa.ChainID, data *gossipv1.Heartbeat_N
 	r.mu.Unlock()
 }
 
+// NetworkHeights are the heights of a chain broadcast in Heartbeat messages. A height is zero if it is unknown or does
+// not apply to the chain. On chains with instant finality, all three heights are the same.
+type NetworkHeights struct {
+	Latest    int64
+	Safe      int64
+	Finalized int64
+}
+
+// InstantFinalityHeights returns the heights of a chain with instant finality at the given height.
+func InstantFinalityHeights(height int64) NetworkHeights {
+	return NetworkHeights{Latest: height, Safe: height, Finalized: height}
+}
+
+// SetNetworkHeights sets the heights of the chain to be broadcast in Heartbeat messages. Heights left at zero keep
+// their previous value, so that watchers learning the heights from different sources can set them separately. The
+// heights are kept when the network status is replaced by SetNetworkStats.
+func (r *registry) SetNetworkHeights(chain vaa.ChainID, heights NetworkHeights) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := r.networkHeights[chain]
+	if heights.Latest != 0 {
+		current.Latest = heights.Latest
+	}
+	if heights.Safe != 0 {
+		current.Safe = heights.Safe
+	}
+	if heights.Finalized != 0 {
+		current.Finalized = heights.Finalized
+	}
+	r.networkHeights[chain] = current
+}
+
+// ClearLatestHeight resets the latest height of the chain to unknown, for watchers that can no longer keep it current.
+func (r *registry) ClearLatestHeight(chain vaa.ChainID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if current, ok := r.networkHeights[chain]; ok {
+		current.Latest = 0
+		r.networkHeights[chain] = current
+	}
+}
+
 func (r *registry) AddErrorCount(chain vaa.ChainID, delta uint64) {
 	r.errorCounterMu.Lock()
 	defer r.errorCounterMu.Unlock()
@@ -51,3 +102,26 @@ func (r *registry) GetErrorCount(chain vaa.ChainID) uint64 {
 	defer r.errorCounterMu.Unlock()
 	return r.errorCounters[chain]
 }
+
+// GetNetworkStats returns a copy of the current network status of each chain, sorted by chain ID.
+func (r *registry) GetNetworkStats() []*gossipv1.Heartbeat_Network {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ret := make([]*gossipv1.Heartbeat_Network, 0, len(r.networkStats))
+	for chain, v := range r.networkStats {
+		n := proto.Clone(v).(*gossipv1.Heartbeat_Network)
+		if heights, ok := r.networkHeights[chain]; ok {
+			n.LatestHeight = heights.Latest
+			n.SafeHeight = heights.Safe
+			n.FinalizedHeight = heights.Finalized
+		}
+		ret = append(ret, n)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Id < ret[j].Id
+	})
+
+	return ret
+}
@@ -8,6 +8,7 @@ import (
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/mr-tron/base58"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -15,6 +16,7 @@ func TestNewRegistry(t *testing.T) {
 	registry := NewRegistry()
 	assert.Equal(t, 0, len(registry.errorCounters))
 	assert.Equal(t, 0, len(registry.networkStats))
+	assert.Equal(t, 0, len(registry.networkHeights))
 }
 
 func TestSetNetworkStats(t *testing.T) {
@@ -53,3 +55,54 @@ func TestGetErrorCount(t *testing.T) {
 	assert.Equal(t, uint64(1), registry.GetErrorCount(vaa.ChainIDEthereum))
 	assert.Equal(t, uint64(0), registry.GetErrorCount(vaa.ChainIDSolana))
 }
+
+func TestGetNetworkStats(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetNetworkStats(vaa.ChainIDSolana, &gossipv1.Heartbeat_Network{Height: 100})
+	registry.SetNetworkStats(vaa.ChainIDEthereum, &gossipv1.Heartbeat_Network{Height: 200})
+
+	stats := registry.GetNetworkStats()
+	assert.Equal(t, 2, len(stats))
+	assert.Equal(t, uint32(vaa.ChainIDSolana), stats[0].Id)
+	assert.Equal(t, int64(100), stats[0].Height)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), stats[1].Id)
+	assert.Equal(t, int64(200), stats[1].Height)
+
+	// The returned entries are copies.
+	stats[0].Height = 300
+	assert.Equal(t, int64(100), registry.networkStats[vaa.ChainIDSolana].Height)
+}
+
+func TestSetNetworkHeights(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetNetworkStats(vaa.ChainIDEthereum, &gossipv1.Heartbeat_Network{Height: 100})
+	registry.SetNetworkHeights(vaa.ChainIDEthereum, NetworkHeights{Latest: 130})
+	registry.SetNetworkHeights(vaa.ChainIDEthereum, NetworkHeights{Safe: 110})
+	registry.SetNetworkHeights(vaa.ChainIDEthereum, NetworkHeights{Finalized: 100})
+
+	// Chains without a network status are not broadcast.
+	registry.SetNetworkHeights(vaa.ChainIDSolana, InstantFinalityHeights(5))
+
+	stats := registry.GetNetworkStats()
+	require.Equal(t, 1, len(stats))
+	assert.Equal(t, int64(100), stats[0].Height)
+	assert.Equal(t, int64(130), stats[0].LatestHeight)
+	assert.Equal(t, int64(110), stats[0].SafeHeight)
+	assert.Equal(t, int64(100), stats[0].FinalizedHeight)
+
+	// The heights are kept when the network status is replaced.
+	registry.SetNetworkStats(vaa.ChainIDEthereum, &gossipv1.Heartbeat_Network{Height: 101})
+	registry.SetNetworkHeights(vaa.ChainIDEthereum, NetworkHeights{Latest: 131})
+	stats = registry.GetNetworkStats()
+	assert.Equal(t, int64(101), stats[0].Height)
+	assert.Equal(t, int64(131), stats[0].LatestHeight)
+	assert.Equal(t, int64(110), stats[0].SafeHeight)
+	assert.Equal(t, int64(100), stats[0].FinalizedHeight)
+
+	// Clearing the latest height keeps the others.
+	registry.ClearLatestHeight(vaa.ChainIDEthereum)
+	stats = registry.GetNetworkStats()
+	assert.Equal(t, int64(0), stats[0].LatestHeight)
+	assert.Equal(t, int64(110), stats[0].SafeHeight)
+	assert.Equal(t, int64(100), stats[0].FinalizedHeight)
+}
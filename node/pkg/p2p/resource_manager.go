@@ -0,0 +1,165 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// The libp2p resource manager rejects connections, streams and memory reservations beyond its limits, which shows up
+// as stream resets on the other side and nothing at all in our logs. Its default limits scale with the system memory
+// and can be lower than what a busy guardian needs (e.g. fewer connections than the high watermark of the connection
+// manager), so they can be raised with ResourceLimits, and every rejection is counted.
+
+var (
+	p2pResourceBlocked = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_resource_manager_blocked_total",
+			Help: "Total number of connections, streams and memory reservations rejected by the libp2p resource manager, by resource",
+		}, []string{"resource"})
+	p2pResourceLimit = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_p2p_resource_manager_limit",
+			Help: "System wide limits of the libp2p resource manager, by resource",
+		}, []string{"resource"})
+)
+
+// ResourceLimits overrides the system wide limits of the libp2p resource manager. Zero values keep the defaults of
+// libp2p, which are scaled to the memory available to it.
+type ResourceLimits struct {
+	// Memory is the memory libp2p may use, in bytes. The other default limits scale with it. Defaults to 1/8 of the
+	// system memory.
+	Memory int64
+	// Conns is the maximum number of connections, and ConnsInbound the maximum number of inbound ones.
+	Conns        int
+	ConnsInbound int
+	// Streams is the maximum number of streams, and StreamsInbound the maximum number of inbound ones.
+	Streams        int
+	StreamsInbound int
+	// PeerStreams is the maximum number of streams to a single peer.
+	PeerStreams int
+}
+
+// limitConfig returns the limit configuration of the resource manager, starting from the defaults of libp2p.
+func (l ResourceLimits) limitConfig() rcmgr.LimitConfig {
+	scaling := rcmgr.DefaultLimits
+	libp2p.SetDefaultServiceLimits(&scaling)
+	lc := scaling.AutoScale()
+	if l.Memory > 0 {
+		// Keep the file descriptor limit chosen by AutoScale, only the memory is configured.
+		lc = scaling.Scale(l.Memory, lc.System.FD)
+	}
+
+	if l.Conns > 0 {
+		lc.System.Conns = l.Conns
+		lc.System.ConnsOutbound = l.Conns
+		if lc.System.ConnsInbound > l.Conns {
+			lc.System.ConnsInbound = l.Conns
+		}
+	}
+	if l.ConnsInbound > 0 {
+		lc.System.ConnsInbound = l.ConnsInbound
+	}
+	if l.Streams > 0 {
+		lc.System.Streams = l.Streams
+		lc.System.StreamsOutbound = l.Streams
+		if lc.System.StreamsInbound > l.Streams {
+			lc.System.StreamsInbound = l.Streams
+		}
+	}
+	if l.StreamsInbound > 0 {
+		lc.System.StreamsInbound = l.StreamsInbound
+	}
+	if l.PeerStreams > 0 {
+		lc.PeerDefault.Streams = l.PeerStreams
+		lc.PeerDefault.StreamsInbound = l.PeerStreams
+		lc.PeerDefault.StreamsOutbound = l.PeerStreams
+	}
+
+	return lc
+}
+
+// newResourceManager creates the resource manager of the libp2p host, which reports its rejections to prometheus.
+func newResourceManager(logger *zap.Logger, limits ResourceLimits) (network.ResourceManager, error) {
+	lc := limits.limitConfig()
+
+	system := lc.System
+	p2pResourceLimit.WithLabelValues("memory").Set(float64(system.Memory))
+	p2pResourceLimit.WithLabelValues("fd").Set(float64(system.FD))
+	p2pResourceLimit.WithLabelValues("conns").Set(float64(system.Conns))
+	p2pResourceLimit.WithLabelValues("conns_inbound").Set(float64(system.ConnsInbound))
+	p2pResourceLimit.WithLabelValues("streams").Set(float64(system.Streams))
+	p2pResourceLimit.WithLabelValues("streams_inbound").Set(float64(system.StreamsInbound))
+	p2pResourceLimit.WithLabelValues("peer_streams").Set(float64(lc.PeerDefault.Streams))
+
+	logger.Info("libp2p resource limits",
+		zap.Int64("memory", system.Memory),
+		zap.Int("fd", system.FD),
+		zap.Int("conns", system.Conns),
+		zap.Int("connsInbound", system.ConnsInbound),
+		zap.Int("streams", system.Streams),
+		zap.Int("streamsInbound", system.StreamsInbound),
+		zap.Int("peerStreams", lc.PeerDefault.Streams),
+	)
+
+	return rcmgr.NewResourceManager(rcmgr.NewFixedLimiter(lc), rcmgr.WithMetrics(resourceMetrics{}))
+}
+
+// resourceMetrics implements rcmgr.MetricsReporter. Only rejections are counted.
+type resourceMetrics struct{}
+
+func directionLabel(resource string, dir network.Direction) string {
+	if dir == network.DirInbound {
+		return resource + "_inbound"
+	}
+	return resource + "_outbound"
+}
+
+func (resourceMetrics) AllowConn(network.Direction, bool) {}
+
+func (resourceMetrics) BlockConn(dir network.Direction, _ bool) {
+	p2pResourceBlocked.WithLabelValues(directionLabel("conn", dir)).Inc()
+}
+
+func (resourceMetrics) AllowStream(peer.ID, network.Direction) {}
+
+func (resourceMetrics) BlockStream(_ peer.ID, dir network.Direction) {
+	p2pResourceBlocked.WithLabelValues(directionLabel("stream", dir)).Inc()
+}
+
+func (resourceMetrics) AllowPeer(peer.ID) {}
+
+func (resourceMetrics) BlockPeer(peer.ID) {
+	p2pResourceBlocked.WithLabelValues("peer").Inc()
+}
+
+func (resourceMetrics) AllowProtocol(protocol.ID) {}
+
+func (resourceMetrics) BlockProtocol(protocol.ID) {
+	p2pResourceBlocked.WithLabelValues("protocol").Inc()
+}
+
+func (resourceMetrics) BlockProtocolPeer(protocol.ID, peer.ID) {
+	p2pResourceBlocked.WithLabelValues("protocol_peer").Inc()
+}
+
+func (resourceMetrics) AllowService(string) {}
+
+func (resourceMetrics) BlockService(string) {
+	p2pResourceBlocked.WithLabelValues("service").Inc()
+}
+
+func (resourceMetrics) BlockServicePeer(string, peer.ID) {
+	p2pResourceBlocked.WithLabelValues("service_peer").Inc()
+}
+
+func (resourceMetrics) AllowMemory(int) {}
+
+func (resourceMetrics) BlockMemory(int) {
+	p2pResourceBlocked.WithLabelValues("memory").Inc()
+}
@@ -0,0 +1,56 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLimitsDefaults(t *testing.T) {
+	defaults := ResourceLimits{}.limitConfig()
+	assert.Greater(t, defaults.System.Conns, 0)
+	assert.Greater(t, defaults.System.Streams, 0)
+	assert.Greater(t, defaults.System.Memory, int64(0))
+
+	// Raising the memory scales the other limits up with it.
+	scaled := ResourceLimits{Memory: defaults.System.Memory * 4}.limitConfig()
+	assert.Greater(t, scaled.System.Conns, defaults.System.Conns)
+	assert.Greater(t, scaled.System.Memory, defaults.System.Memory)
+	assert.Equal(t, defaults.System.FD, scaled.System.FD)
+}
+
+func TestResourceLimitsOverrides(t *testing.T) {
+	lc := ResourceLimits{Conns: 1000, Streams: 20000, StreamsInbound: 5000, PeerStreams: 2048}.limitConfig()
+	assert.Equal(t, 1000, lc.System.Conns)
+	assert.Equal(t, 1000, lc.System.ConnsOutbound)
+	assert.LessOrEqual(t, lc.System.ConnsInbound, 1000)
+	assert.Equal(t, 20000, lc.System.Streams)
+	assert.Equal(t, 20000, lc.System.StreamsOutbound)
+	assert.Equal(t, 5000, lc.System.StreamsInbound)
+	assert.Equal(t, 2048, lc.PeerDefault.Streams)
+	assert.Equal(t, 2048, lc.PeerDefault.StreamsInbound)
+
+	// A total below the default inbound limit caps the inbound limit as well.
+	lc = ResourceLimits{Conns: 1, ConnsInbound: 0}.limitConfig()
+	assert.Equal(t, 1, lc.System.ConnsInbound)
+	lc = ResourceLimits{Conns: 10, ConnsInbound: 4}.limitConfig()
+	assert.Equal(t, 4, lc.System.ConnsInbound)
+}
+
+func TestResourceMetrics(t *testing.T) {
+	inbound := p2pResourceBlocked.WithLabelValues("stream_inbound")
+	memory := p2pResourceBlocked.WithLabelValues("memory")
+	inboundBefore := testutil.ToFloat64(inbound)
+	memoryBefore := testutil.ToFloat64(memory)
+
+	var reporter resourceMetrics
+	reporter.AllowStream("", network.DirInbound)
+	reporter.BlockStream("", network.DirInbound)
+	reporter.BlockStream("", network.DirOutbound)
+	reporter.BlockMemory(1 << 20)
+
+	assert.Equal(t, inboundBefore+1, testutil.ToFloat64(inbound))
+	assert.Equal(t, memoryBefore+1, testutil.ToFloat64(memory))
+}
@@ -0,0 +1,191 @@
+package p2p
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var signingKeyDelegationPrefix = []byte("p2p_signing_key_delegation|")
+
+var (
+	p2pSigningKeyDelegationExpiry = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_p2p_signing_key_delegation_expiry",
+			Help: "UNIX timestamp at which the p2p signing key delegation expires (0 if gossip control messages are signed with the guardian key)",
+		})
+	p2pSigningKeyDelegationExpiredMessages = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_signing_key_delegation_expired_messages_total",
+			Help: "Total number of gossip control messages not sent because the p2p signing key delegation has expired",
+		})
+)
+
+// ErrSigningKeyDelegationExpired is returned when a gossip control message cannot be signed because the delegation of
+// the p2p signing key has expired.
+var ErrSigningKeyDelegationExpired = errors.New("p2p signing key delegation has expired")
+
+// ErrNoGuardianKey is returned when a gossip control message is signed by a node without a guardian key.
+var ErrNoGuardianKey = errors.New("no guardian key to sign gossip control messages with")
+
+func signingKeyDelegationDigest(b []byte) common.Hash {
+	return ethcrypto.Keccak256Hash(append(signingKeyDelegationPrefix, b...))
+}
+
+// CreateSigningKeyDelegation returns a delegation, signed with the guardian key gk, that authorizes signingAddr to sign
+// gossip control messages on behalf of the guardian until expiresAt.
+func CreateSigningKeyDelegation(gk *ecdsa.PrivateKey, signingAddr common.Address, expiresAt time.Time) (*gossipv1.SignedP2PSigningKeyDelegation, error) {
+	b, err := proto.Marshal(&gossipv1.P2PSigningKeyDelegation{
+		SigningAddr: signingAddr.Bytes(),
+		ExpiresAt:   expiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal delegation: %w", err)
+	}
+
+	sig, err := ethcrypto.Sign(signingKeyDelegationDigest(b).Bytes(), gk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign delegation: %w", err)
+	}
+
+	return &gossipv1.SignedP2PSigningKeyDelegation{
+		Delegation: b,
+		Signature:  sig,
+	}, nil
+}
+
+// VerifySigningKeyDelegation checks that d was signed by guardianAddr and has not expired at now.
+// It returns the delegated signing address and the expiry of the delegation.
+func VerifySigningKeyDelegation(d *gossipv1.SignedP2PSigningKeyDelegation, guardianAddr common.Address, now time.Time) (common.Address, time.Time, error) {
+	if d == nil {
+		return common.Address{}, time.Time{}, errors.New("delegation is missing")
+	}
+
+	pubKey, err := ethcrypto.Ecrecover(signingKeyDelegationDigest(d.Delegation).Bytes(), d.Signature)
+	if err != nil {
+		return common.Address{}, time.Time{}, errors.New("failed to recover public key of delegation signer")
+	}
+
+	signerAddr := common.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:])
+	if signerAddr != guardianAddr {
+		return common.Address{}, time.Time{}, fmt.Errorf("delegation signed by %v instead of %v", signerAddr, guardianAddr)
+	}
+
+	var m gossipv1.P2PSigningKeyDelegation
+	if err := proto.Unmarshal(d.Delegation, &m); err != nil {
+		return common.Address{}, time.Time{}, fmt.Errorf("failed to unmarshal delegation: %w", err)
+	}
+
+	if len(m.SigningAddr) != common.AddressLength {
+		return common.Address{}, time.Time{}, fmt.Errorf("invalid signing address length: %d", len(m.SigningAddr))
+	}
+
+	expiresAt := time.Unix(m.ExpiresAt, 0)
+	if !now.Before(expiresAt) {
+		return common.Address{}, time.Time{}, fmt.Errorf("delegation expired at %v", expiresAt)
+	}
+
+	return common.BytesToAddress(m.SigningAddr), expiresAt, nil
+}
+
+// expectedControlSigner returns the address that must have signed a gossip control message sent by guardianAddr,
+// which is the guardian itself unless the message carries a signing key delegation.
+func expectedControlSigner(guardianAddr common.Address, d *gossipv1.SignedP2PSigningKeyDelegation) (common.Address, error) {
+	if d == nil {
+		return guardianAddr, nil
+	}
+	signingAddr, _, err := VerifySigningKeyDelegation(d, guardianAddr, time.Now())
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signing key delegation: %w", err)
+	}
+	return signingAddr, nil
+}
+
+// controlSigner signs gossip control messages (heartbeats and observation requests) using either the guardian key
+// or a delegated signing key.
+type controlSigner struct {
+	logger       *zap.Logger
+	gk           *ecdsa.PrivateKey
+	guardianAddr common.Address
+
+	signingKey *ecdsa.PrivateKey
+	delegation *gossipv1.SignedP2PSigningKeyDelegation
+	expiresAt  time.Time
+}
+
+// newControlSigner returns a signer using signingKey if a delegation is provided and the guardian key gk otherwise.
+// Nodes which are not guardians, like spies, have no guardian key and get a signer which refuses to sign.
+func newControlSigner(logger *zap.Logger, gk *ecdsa.PrivateKey, signingKey *ecdsa.PrivateKey, delegation *gossipv1.SignedP2PSigningKeyDelegation) (*controlSigner, error) {
+	if gk == nil {
+		if signingKey != nil || delegation != nil {
+			return nil, errors.New("a p2p signing key requires a guardian key")
+		}
+		return &controlSigner{logger: logger}, nil
+	}
+
+	s := &controlSigner{
+		logger:       logger,
+		gk:           gk,
+		guardianAddr: ethcrypto.PubkeyToAddress(gk.PublicKey),
+	}
+
+	if signingKey == nil && delegation == nil {
+		p2pSigningKeyDelegationExpiry.Set(0)
+		return s, nil
+	}
+	if signingKey == nil || delegation == nil {
+		return nil, errors.New("the p2p signing key and its delegation must be specified together")
+	}
+
+	signingAddr, expiresAt, err := VerifySigningKeyDelegation(delegation, s.guardianAddr, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid p2p signing key delegation: %w", err)
+	}
+	if signingAddr != ethcrypto.PubkeyToAddress(signingKey.PublicKey) {
+		return nil, fmt.Errorf("p2p signing key delegation is for %v, not for the configured signing key", signingAddr)
+	}
+
+	s.signingKey = signingKey
+	s.delegation = delegation
+	s.expiresAt = expiresAt
+	p2pSigningKeyDelegationExpiry.Set(float64(expiresAt.Unix()))
+	logger.Info("signing gossip control messages with delegated p2p signing key",
+		zap.Stringer("signingAddr", signingAddr),
+		zap.Time("expiresAt", expiresAt))
+	return s, nil
+}
+
+// sign signs digest and returns the signature together with the delegation that has to accompany it, if any.
+// Once the delegation has expired, nothing is signed: the guardian key is never used in place of the delegated key,
+// so the delegation has to be renewed and the node restarted.
+func (s *controlSigner) sign(digest common.Hash) ([]byte, *gossipv1.SignedP2PSigningKeyDelegation, error) {
+	if s.gk == nil {
+		return nil, nil, ErrNoGuardianKey
+	}
+
+	key, delegation := s.gk, (*gossipv1.SignedP2PSigningKeyDelegation)(nil)
+	if s.signingKey != nil {
+		if !time.Now().Before(s.expiresAt) {
+			p2pSigningKeyDelegationExpiredMessages.Inc()
+			s.logger.Error("p2p signing key delegation has expired, not signing gossip control message",
+				zap.Time("expiresAt", s.expiresAt))
+			return nil, nil, ErrSigningKeyDelegationExpired
+		}
+		key, delegation = s.signingKey, s.delegation
+	}
+
+	sig, err := ethcrypto.Sign(digest.Bytes(), key)
+	if err != nil {
+		panic(err)
+	}
+	return sig, delegation, nil
+}
@@ -0,0 +1,192 @@
+package p2p
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	node_common "github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func generateKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	return k
+}
+
+func TestVerifySigningKeyDelegation(t *testing.T) {
+	gk := generateKey(t)
+	gAddr := ethcrypto.PubkeyToAddress(gk.PublicKey)
+	sk := generateKey(t)
+	sAddr := ethcrypto.PubkeyToAddress(sk.PublicKey)
+
+	now := time.Now()
+	d, err := CreateSigningKeyDelegation(gk, sAddr, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	addr, expiresAt, err := VerifySigningKeyDelegation(d, gAddr, now)
+	require.NoError(t, err)
+	assert.Equal(t, sAddr, addr)
+	assert.Equal(t, now.Add(time.Hour).Unix(), expiresAt.Unix())
+
+	// Issued by someone else.
+	_, _, err = VerifySigningKeyDelegation(d, sAddr, now)
+	assert.Error(t, err)
+
+	// Expired.
+	_, _, err = VerifySigningKeyDelegation(d, gAddr, now.Add(2*time.Hour))
+	assert.Error(t, err)
+
+	// Tampered with.
+	var m gossipv1.P2PSigningKeyDelegation
+	require.NoError(t, proto.Unmarshal(d.Delegation, &m))
+	m.ExpiresAt += 3600
+	b, err := proto.Marshal(&m)
+	require.NoError(t, err)
+	_, _, err = VerifySigningKeyDelegation(&gossipv1.SignedP2PSigningKeyDelegation{Delegation: b, Signature: d.Signature}, gAddr, now)
+	assert.Error(t, err)
+
+	_, _, err = VerifySigningKeyDelegation(nil, gAddr, now)
+	assert.Error(t, err)
+}
+
+func TestNewControlSigner(t *testing.T) {
+	gk := generateKey(t)
+	sk := generateKey(t)
+	otherKey := generateKey(t)
+
+	d, err := CreateSigningKeyDelegation(gk, ethcrypto.PubkeyToAddress(sk.PublicKey), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	_, err = newControlSigner(zap.NewNop(), gk, sk, d)
+	assert.NoError(t, err)
+
+	_, err = newControlSigner(zap.NewNop(), gk, sk, nil)
+	assert.Error(t, err)
+
+	_, err = newControlSigner(zap.NewNop(), gk, nil, d)
+	assert.Error(t, err)
+
+	// The delegation does not match the signing key.
+	_, err = newControlSigner(zap.NewNop(), gk, otherKey, d)
+	assert.Error(t, err)
+
+	// The delegation was not issued by our guardian key.
+	_, err = newControlSigner(zap.NewNop(), otherKey, sk, d)
+	assert.Error(t, err)
+}
+
+func TestSignedHeartbeatWithDelegatedKey(t *testing.T) {
+	gk := generateKey(t)
+	gAddr := ethcrypto.PubkeyToAddress(gk.PublicKey)
+	sk := generateKey(t)
+
+	d, err := CreateSigningKeyDelegation(gk, ethcrypto.PubkeyToAddress(sk.PublicKey), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	signer, err := newControlSigner(zap.NewNop(), gk, sk, d)
+	require.NoError(t, err)
+
+	heartbeat := &gossipv1.Heartbeat{
+		NodeName:     "someNode",
+		Timestamp:    time.Now().UnixNano(),
+		Version:      "0.0.1beta",
+		GuardianAddr: gAddr.String(),
+	}
+	gs := &node_common.GuardianSet{Keys: []common.Address{gAddr}, Index: 1}
+
+	s, err := createSignedHeartbeat(signer, heartbeat)
+	require.NoError(t, err)
+	assert.Equal(t, gAddr.Bytes(), s.GuardianAddr)
+	assert.NotNil(t, s.SigningKeyDelegation)
+
+	h, err := processSignedHeartbeat("someone", s, gs, node_common.NewGuardianSetState(nil), false)
+	require.NoError(t, err)
+	assert.Equal(t, gAddr.String(), h.GuardianAddr)
+
+	// A message signed by the delegated key without the delegation must be rejected.
+	s.SigningKeyDelegation = nil
+	_, err = processSignedHeartbeat("someone", s, gs, node_common.NewGuardianSetState(nil), false)
+	assert.Error(t, err)
+
+	// A delegation issued by a guardian for a key must not be usable by another guardian.
+	gk2 := generateKey(t)
+	gAddr2 := ethcrypto.PubkeyToAddress(gk2.PublicKey)
+	heartbeat.GuardianAddr = gAddr2.String()
+	s, err = createSignedHeartbeat(signer, heartbeat)
+	require.NoError(t, err)
+	s.GuardianAddr = gAddr2.Bytes()
+	gs = &node_common.GuardianSet{Keys: []common.Address{gAddr, gAddr2}, Index: 1}
+	_, err = processSignedHeartbeat("someone", s, gs, node_common.NewGuardianSetState(nil), false)
+	assert.Error(t, err)
+}
+
+func TestSignedObservationRequestWithDelegatedKey(t *testing.T) {
+	gk := generateKey(t)
+	gAddr := ethcrypto.PubkeyToAddress(gk.PublicKey)
+	sk := generateKey(t)
+
+	d, err := CreateSigningKeyDelegation(gk, ethcrypto.PubkeyToAddress(sk.PublicKey), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	signer, err := newControlSigner(zap.NewNop(), gk, sk, d)
+	require.NoError(t, err)
+
+	b, err := proto.Marshal(&gossipv1.ObservationRequest{ChainId: 2, TxHash: make([]byte, 32)})
+	require.NoError(t, err)
+	sig, delegation, err := signer.sign(signedObservationRequestDigest(b))
+	require.NoError(t, err)
+	gs := &node_common.GuardianSet{Keys: []common.Address{gAddr}, Index: 1}
+
+	r, err := processSignedObservationRequest(&gossipv1.SignedObservationRequest{
+		ObservationRequest:   b,
+		Signature:            sig,
+		GuardianAddr:         gAddr.Bytes(),
+		SigningKeyDelegation: delegation,
+	}, gs)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), r.ChainId)
+
+	_, err = processSignedObservationRequest(&gossipv1.SignedObservationRequest{
+		ObservationRequest: b,
+		Signature:          sig,
+		GuardianAddr:       gAddr.Bytes(),
+	}, gs)
+	assert.Error(t, err)
+}
+
+func TestControlSignerRefusesToSignAfterExpiry(t *testing.T) {
+	gk := generateKey(t)
+	sk := generateKey(t)
+
+	d, err := CreateSigningKeyDelegation(gk, ethcrypto.PubkeyToAddress(sk.PublicKey), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	signer, err := newControlSigner(zap.NewNop(), gk, sk, d)
+	require.NoError(t, err)
+	signer.expiresAt = time.Now().Add(-time.Second)
+
+	// The guardian key is not used in place of the expired delegated key.
+	_, _, err = signer.sign(heartbeatDigest([]byte("test")))
+	assert.ErrorIs(t, err, ErrSigningKeyDelegationExpired)
+
+	_, err = createSignedHeartbeat(signer, &gossipv1.Heartbeat{NodeName: "someNode"})
+	assert.ErrorIs(t, err, ErrSigningKeyDelegationExpired)
+}
+
+func TestControlSignerWithoutGuardianKey(t *testing.T) {
+	signer, err := newControlSigner(zap.NewNop(), nil, nil, nil)
+	require.NoError(t, err)
+	_, _, err = signer.sign(heartbeatDigest([]byte("test")))
+	assert.ErrorIs(t, err, ErrNoGuardianKey)
+
+	sk := generateKey(t)
+	_, err = newControlSigner(zap.NewNop(), nil, sk, nil)
+	assert.Error(t, err)
+}
@@ -26,7 +26,7 @@ import (
 type G struct {
 	// arguments passed to p2p.New
 	obsvC                  chan *gossipv1.SignedObservation
-	obsvReqC               chan *gossipv1.ObservationRequest
+	obsvReqC               chan *node_common.InboundObservationRequest
 	obsvReqSendC           chan *gossipv1.ObservationRequest
 	sendC                  chan []byte
 	signedInC              chan *gossipv1.SignedVAAWithQuorum
@@ -61,7 +61,7 @@ func NewG(t *testing.T, nodeName string) *G {
 
 	g := &G{
 		obsvC:                  make(chan *gossipv1.SignedObservation, cs),
-		obsvReqC:               make(chan *gossipv1.ObservationRequest, cs),
+		obsvReqC:               make(chan *node_common.InboundObservationRequest, cs),
 		obsvReqSendC:           make(chan *gossipv1.ObservationRequest, cs),
 		sendC:                  make(chan []byte, cs),
 		signedInC:              make(chan *gossipv1.SignedVAAWithQuorum, cs),
@@ -50,6 +50,11 @@ var (
 			Name: "wormhole_aggregation_state_settled_signatures_total",
 			Help: "Total number of signatures produced by a validator, counted after waiting a fixed amount of time",
 		}, []string{"addr", "origin", "status"})
+	aggregationStateExpiredBeforeQuorum = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_aggregation_state_expired_before_quorum_total",
+			Help: "Total number of aggregation states expired without reaching quorum, by emitter chain and reason",
+		}, []string{"emitter_chain", "reason"})
 )
 
 const (
@@ -62,10 +67,13 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 	p.logger.Info("aggregation state summary", zap.Int("cached", len(p.state.signatures)))
 	aggregationStateEntries.Set(float64(len(p.state.signatures)))
 
+	p.cleanupSignedDigests(time.Now())
+
 	for hash, s := range p.state.signatures {
 		delta := time.Since(s.firstObserved)
+		policy := p.cleanupPolicy(s)
 
-		if !s.submitted && s.ourObservation != nil && delta > settlementTime {
+		if !s.submitted && s.ourObservation != nil && delta > policy.SettlementTime {
 			// Expire pending VAAs post settlement time if we have a stored quorum VAA.
 			//
 			// This occurs when we observed a message after the cluster has already reached
@@ -90,8 +98,8 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 		}
 
 		switch {
-		case !s.settled && delta > settlementTime:
-			// After 30 seconds, the observation is considered settled - it's unlikely that more observations will
+		case !s.settled && delta > policy.SettlementTime:
+			// After the settlement time, the observation is considered settled - it's unlikely that more observations will
 			// arrive, barring special circumstances. This is a better time to count misses than submission,
 			// because we submit right when we quorum rather than waiting for all observations to arrive.
 			s.settled = true
@@ -129,7 +137,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 					aggregationStateFulfillment.WithLabelValues(k.Hex(), s.source, "missing").Inc()
 				}
 			}
-		case s.submitted && delta.Hours() >= 1:
+		case s.submitted && delta >= policy.SubmittedRetention:
 			// We could delete submitted observations right away, but then we'd lose context about additional (late)
 			// observation that come in. Therefore, keep it for a reasonable amount of time.
 			// If a very late observation arrives after cleanup, a nil aggregation state will be created
@@ -137,24 +145,26 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 			p.logger.Info("expiring submitted observation", zap.String("digest", hash), zap.Duration("delta", delta))
 			delete(p.state.signatures, hash)
 			aggregationStateExpiration.Inc()
-		case !s.submitted && ((s.ourMsg != nil && s.retryCount >= 14400 /* 120 hours */) || (s.ourMsg == nil && s.retryCount >= 10 /* 5 minutes */)):
+		case !s.submitted && ((s.ourMsg != nil && s.retryCount >= policy.MaxRetries) || (s.ourMsg == nil && s.retryCount >= 10)):
 			// Clearly, this horse is dead and continued beatings won't bring it closer to quorum.
 			p.logger.Info("expiring unsubmitted observation after exhausting retries", zap.String("digest", hash), zap.Duration("delta", delta))
 			delete(p.state.signatures, hash)
 			aggregationStateTimeout.Inc()
-		case !s.submitted && delta.Minutes() >= 5 && time.Since(s.lastRetry) >= retryTime:
-			// Poor observation has been unsubmitted for five minutes - clearly, something went wrong.
+			aggregationStateExpiredBeforeQuorum.WithLabelValues(observationChainName(s), "retries_exhausted").Inc()
+		case !s.submitted && delta >= policy.RetryTime && time.Since(s.lastRetry) >= policy.RetryTime:
+			// Poor observation has been unsubmitted for the retry time - clearly, something went wrong.
 			// If we have previously submitted an observation, and it was reliable, we can make another attempt to get
 			// it over the finish line by sending a re-observation request to the network and rebroadcasting our
 			// sig. If we do not have an observation, it means we either never observed it, or it got
 			// revived by a malfunctioning guardian node, in which case, we can't do anything about it
 			// and just delete it to keep our state nice and lean.
 			if s.ourMsg != nil {
-				// Unreliable observations cannot be resubmitted and can be considered failed after 5 minutes
+				// Unreliable observations cannot be resubmitted and can be considered failed after the retry time
 				if !s.ourObservation.IsReliable() {
 					p.logger.Info("expiring unsubmitted unreliable observation", zap.String("digest", hash), zap.Duration("delta", delta))
 					delete(p.state.signatures, hash)
 					aggregationStateTimeout.Inc()
+					aggregationStateExpiredBeforeQuorum.WithLabelValues(observationChainName(s), "unreliable").Inc()
 					break
 				}
 				p.logger.Info("resubmitting observation",
@@ -188,6 +198,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 				)
 				delete(p.state.signatures, hash)
 				aggregationStateUnobserved.Inc()
+				aggregationStateExpiredBeforeQuorum.WithLabelValues(observationChainName(s), "unobserved").Inc()
 			}
 		}
 	}
@@ -200,3 +211,12 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 		}
 	}
 }
+
+// observationChainName returns the name of the emitter chain of an observation for metrics. The chain of an
+// observation we did not make ourselves is unknown.
+func observationChainName(s *state) string {
+	if s.ourObservation == nil {
+		return "unknown"
+	}
+	return s.ourObservation.GetEmitterChain().String()
+}
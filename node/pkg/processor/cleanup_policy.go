@@ -0,0 +1,123 @@
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// CleanupPolicy controls how long the processor keeps the state of the observations of a chain. Chains with a long
+// time to finality need longer windows, since the other guardians observe their messages much later than we do.
+type CleanupPolicy struct {
+	// SettlementTime is the time after which an observation is considered settled and the missing signatures are counted.
+	SettlementTime time.Duration
+	// RetryTime is how long an observation stays without quorum before it is resubmitted, and the time between retries.
+	RetryTime time.Duration
+	// MaxRetries is the number of resubmissions after which an observation without quorum is expired.
+	MaxRetries uint
+	// SubmittedRetention is how long the state of an observation is kept after reaching quorum, to account for late
+	// observations.
+	SubmittedRetention time.Duration
+}
+
+// DefaultCleanupPolicy is the cleanup policy of the chains without a configured one.
+var DefaultCleanupPolicy = CleanupPolicy{
+	SettlementTime:     settlementTime,
+	RetryTime:          retryTime,
+	MaxRetries:         14400,
+	SubmittedRetention: time.Hour,
+}
+
+// cleanupPolicyConfig is an entry of the cleanup policy file. Durations are strings parsed by time.ParseDuration.
+type cleanupPolicyConfig struct {
+	ChainID            uint16 `json:"chainId"`
+	SettlementTime     string `json:"settlementTime"`
+	RetryTime          string `json:"retryTime"`
+	MaxRetries         uint   `json:"maxRetries"`
+	SubmittedRetention string `json:"submittedRetention"`
+}
+
+// ReadCleanupPoliciesFile reads the per chain cleanup policies from a JSON file. The file contains a list of objects
+// with the chainId and the settlementTime, retryTime, maxRetries and submittedRetention of the chain. Omitted settings
+// keep their default value. An empty path means no configured policies.
+func ReadCleanupPoliciesFile(path string) (map[vaa.ChainID]CleanupPolicy, error) {
+	if path == "" {
+		return map[vaa.ChainID]CleanupPolicy{}, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cleanup policies file: %w", err)
+	}
+
+	return ParseCleanupPolicies(b)
+}
+
+// ParseCleanupPolicies parses the per chain cleanup policies, see ReadCleanupPoliciesFile.
+func ParseCleanupPolicies(config []byte) (map[vaa.ChainID]CleanupPolicy, error) {
+	var entries []cleanupPolicyConfig
+	if err := json.Unmarshal(config, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse cleanup policies: %w", err)
+	}
+
+	ret := make(map[vaa.ChainID]CleanupPolicy)
+	for _, entry := range entries {
+		chainID := vaa.ChainID(entry.ChainID)
+		if chainID == vaa.ChainIDUnset {
+			return nil, fmt.Errorf("cleanup policy without chainId")
+		}
+		if _, exists := ret[chainID]; exists {
+			return nil, fmt.Errorf("duplicate cleanup policy for chain %v", chainID)
+		}
+
+		policy := DefaultCleanupPolicy
+		for _, d := range []struct {
+			name  string
+			value string
+			dst   *time.Duration
+		}{
+			{"settlementTime", entry.SettlementTime, &policy.SettlementTime},
+			{"retryTime", entry.RetryTime, &policy.RetryTime},
+			{"submittedRetention", entry.SubmittedRetention, &policy.SubmittedRetention},
+		} {
+			if d.value == "" {
+				continue
+			}
+			duration, err := time.ParseDuration(d.value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s of cleanup policy for chain %v: %w", d.name, chainID, err)
+			}
+			if duration <= 0 {
+				return nil, fmt.Errorf("invalid %s of cleanup policy for chain %v: must be positive", d.name, chainID)
+			}
+			*d.dst = duration
+		}
+		if entry.MaxRetries != 0 {
+			policy.MaxRetries = entry.MaxRetries
+		}
+
+		ret[chainID] = policy
+	}
+
+	return ret, nil
+}
+
+// SetCleanupPolicies configures the cleanup policies of the chains that should not use DefaultCleanupPolicy.
+func (p *Processor) SetCleanupPolicies(policies map[vaa.ChainID]CleanupPolicy) {
+	p.cleanupPolicies = policies
+}
+
+// cleanupPolicy returns the cleanup policy of the chain of an observation. The chain of an observation we did not make
+// ourselves is unknown, so those use DefaultCleanupPolicy.
+func (p *Processor) cleanupPolicy(s *state) CleanupPolicy {
+	if s.ourObservation == nil {
+		return DefaultCleanupPolicy
+	}
+	if policy, exists := p.cleanupPolicies[s.ourObservation.GetEmitterChain()]; exists {
+		return policy
+	}
+	return DefaultCleanupPolicy
+}
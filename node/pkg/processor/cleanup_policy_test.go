@@ -0,0 +1,155 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestParseCleanupPolicies(t *testing.T) {
+	policies, err := ParseCleanupPolicies([]byte(`[
+		{"chainId": 2, "retryTime": "15m", "maxRetries": 100},
+		{"chainId": 10, "settlementTime": "2m", "submittedRetention": "6h"}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+
+	eth := policies[vaa.ChainIDEthereum]
+	assert.Equal(t, 15*time.Minute, eth.RetryTime)
+	assert.Equal(t, uint(100), eth.MaxRetries)
+	assert.Equal(t, DefaultCleanupPolicy.SettlementTime, eth.SettlementTime)
+	assert.Equal(t, DefaultCleanupPolicy.SubmittedRetention, eth.SubmittedRetention)
+
+	fantom := policies[vaa.ChainIDFantom]
+	assert.Equal(t, 2*time.Minute, fantom.SettlementTime)
+	assert.Equal(t, 6*time.Hour, fantom.SubmittedRetention)
+	assert.Equal(t, DefaultCleanupPolicy.RetryTime, fantom.RetryTime)
+	assert.Equal(t, DefaultCleanupPolicy.MaxRetries, fantom.MaxRetries)
+
+	policies, err = ReadCleanupPoliciesFile("")
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+}
+
+func TestParseCleanupPoliciesInvalid(t *testing.T) {
+	tests := []struct {
+		config string
+		err    string
+	}{
+		{`{}`, "failed to parse cleanup policies"},
+		{`[{"retryTime": "15m"}]`, "cleanup policy without chainId"},
+		{`[{"chainId": 2}, {"chainId": 2}]`, "duplicate cleanup policy for chain ethereum"},
+		{`[{"chainId": 2, "retryTime": "soon"}]`, "invalid retryTime of cleanup policy for chain ethereum"},
+		{`[{"chainId": 2, "submittedRetention": "-1h"}]`, "invalid submittedRetention of cleanup policy for chain ethereum: must be positive"},
+	}
+	for _, tc := range tests {
+		_, err := ParseCleanupPolicies([]byte(tc.config))
+		assert.ErrorContains(t, err, tc.err, tc.config)
+	}
+}
+
+func newProcessorForCleanupTest(t *testing.T) *Processor {
+	t.Helper()
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { database.Close() })
+
+	return &Processor{
+		logger:       zap.NewNop(),
+		db:           database,
+		gs:           &common.GuardianSet{Keys: []ethcommon.Address{{1}}},
+		state:        &aggregationState{observationMap{}},
+		gossipSendC:  make(chan []byte, 10),
+		obsvReqSendC: make(chan *gossipv1.ObservationRequest, 10),
+	}
+}
+
+func TestHandleCleanupUsesChainPolicy(t *testing.T) {
+	p := newProcessorForCleanupTest(t)
+	p.SetCleanupPolicies(map[vaa.ChainID]CleanupPolicy{
+		vaa.ChainIDEthereum: {
+			SettlementTime:     time.Minute,
+			RetryTime:          time.Hour,
+			MaxRetries:         2,
+			SubmittedRetention: 3 * time.Hour,
+		},
+	})
+
+	_, v := newMessageForConflictTest([]byte{1})
+	now := time.Now()
+	p.state.signatures["waiting"] = &state{
+		firstObserved:  now.Add(-10 * time.Minute),
+		ourObservation: v,
+		ourMsg:         []byte{1},
+		settled:        true,
+	}
+	p.state.signatures["submitted"] = &state{
+		firstObserved:  now.Add(-2 * time.Hour),
+		ourObservation: v,
+		submitted:      true,
+		settled:        true,
+	}
+	p.state.signatures["exhausted"] = &state{
+		firstObserved:  now.Add(-3 * time.Hour),
+		ourObservation: v,
+		ourMsg:         []byte{1},
+		settled:        true,
+		retryCount:     2,
+	}
+	// Observations of other chains still use the default policy.
+	p.state.signatures["nil"] = &state{
+		firstObserved: now.Add(-10 * time.Minute),
+		settled:       true,
+	}
+
+	expired := aggregationStateExpiredBeforeQuorum.WithLabelValues("ethereum", "retries_exhausted")
+	unobserved := aggregationStateExpiredBeforeQuorum.WithLabelValues("unknown", "unobserved")
+	expiredBefore := testutil.ToFloat64(expired)
+	unobservedBefore := testutil.ToFloat64(unobserved)
+
+	p.handleCleanup(context.Background())
+
+	require.Contains(t, p.state.signatures, "waiting")
+	assert.Equal(t, uint(0), p.state.signatures["waiting"].retryCount)
+	assert.Contains(t, p.state.signatures, "submitted")
+	assert.NotContains(t, p.state.signatures, "exhausted")
+	assert.NotContains(t, p.state.signatures, "nil")
+	assert.Equal(t, expiredBefore+1, testutil.ToFloat64(expired))
+	assert.Equal(t, unobservedBefore+1, testutil.ToFloat64(unobserved))
+}
+
+func TestHandleCleanupDefaultPolicy(t *testing.T) {
+	p := newProcessorForCleanupTest(t)
+
+	_, v := newMessageForConflictTest([]byte{1})
+	now := time.Now()
+	p.state.signatures["waiting"] = &state{
+		firstObserved:  now.Add(-10 * time.Minute),
+		ourObservation: v,
+		ourMsg:         []byte{1},
+		settled:        true,
+	}
+	p.state.signatures["submitted"] = &state{
+		firstObserved:  now.Add(-2 * time.Hour),
+		ourObservation: v,
+		submitted:      true,
+		settled:        true,
+	}
+
+	p.handleCleanup(context.Background())
+
+	require.Contains(t, p.state.signatures, "waiting")
+	assert.Equal(t, uint(1), p.state.signatures["waiting"].retryCount)
+	assert.Len(t, p.gossipSendC, 1)
+	assert.NotContains(t, p.state.signatures, "submitted")
+}
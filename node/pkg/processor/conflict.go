@@ -0,0 +1,140 @@
+package processor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/mr-tron/base58"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	digestConflictsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_message_digest_conflicts_total",
+			Help: "Total number of messages observed with a different digest than a previous message with the same emitter and sequence",
+		},
+		[]string{"emitter_chain"})
+)
+
+const (
+	// signedDigestRetention is how long we remember the digest we signed for a message ID. Conflicts with messages that
+	// reached quorum are also detected after that, using the VAA stored in the database.
+	signedDigestRetention = 24 * time.Hour
+
+	// maxDigestConflicts is the number of conflicts kept for the admin RPC.
+	maxDigestConflicts = 100
+)
+
+type (
+	// signedDigest records the digest we signed for a message ID.
+	signedDigest struct {
+		digest   ethcommon.Hash
+		txHash   ethcommon.Hash
+		signedAt time.Time
+	}
+
+	// DigestConflict describes two different messages observed for the same emitter chain, emitter address and sequence.
+	DigestConflict struct {
+		MessageID         string
+		Digest            ethcommon.Hash
+		TxHash            ethcommon.Hash
+		ConflictingDigest ethcommon.Hash
+		ConflictingTxHash ethcommon.Hash
+		DetectedAt        time.Time
+	}
+
+	// DigestConflicts holds the most recent digest conflicts detected by the processor. It is safe for concurrent use.
+	DigestConflicts struct {
+		mu      sync.Mutex
+		entries []DigestConflict
+	}
+)
+
+func NewDigestConflicts() *DigestConflicts {
+	return &DigestConflicts{}
+}
+
+func (c *DigestConflicts) add(conflict DigestConflict) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, conflict)
+	if len(c.entries) > maxDigestConflicts {
+		c.entries = c.entries[len(c.entries)-maxDigestConflicts:]
+	}
+}
+
+// Get returns the recorded conflicts, newest first.
+func (c *DigestConflicts) Get() []DigestConflict {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ret := make([]DigestConflict, 0, len(c.entries))
+	for i := len(c.entries) - 1; i >= 0; i-- {
+		ret = append(ret, c.entries[i])
+	}
+	return ret
+}
+
+// checkDigestConflict returns true if we have already signed a different digest for the message ID of k, in which case
+// the message must not be signed. existing is the quorum VAA stored for the message, if any. Otherwise, it remembers
+// the digest so that later conflicting messages can be detected.
+func (p *Processor) checkDigestConflict(k *common.MessagePublication, v *VAA, digest ethcommon.Hash, existing *vaa.VAA) bool {
+	// Unreliable messages (e.g. PythNet) cannot be reobserved and are not tracked, there are too many of them.
+	if k.Unreliable {
+		return false
+	}
+
+	msgId := v.MessageID()
+
+	prev, exists := p.signedDigests[msgId]
+	if !exists && existing != nil {
+		prev = signedDigest{digest: existing.SigningDigest()}
+		exists = true
+	}
+
+	if exists && prev.digest != digest {
+		digestConflictsTotal.WithLabelValues(k.EmitterChain.String()).Inc()
+		p.logger.Error("SECURITY CRITICAL: observed a message with the same emitter and sequence as a previous one but a different digest, refusing to sign it",
+			zap.String("message_id", msgId),
+			zap.Stringer("digest", digest),
+			zap.Stringer("txhash", k.TxHash),
+			zap.String("txhash_b58", base58.Encode(k.TxHash.Bytes())),
+			zap.Stringer("previous_digest", prev.digest),
+			zap.Stringer("previous_txhash", prev.txHash),
+			zap.Time("timestamp", k.Timestamp),
+			zap.Uint32("nonce", k.Nonce),
+			zap.Uint8("consistency_level", k.ConsistencyLevel),
+		)
+		if p.digestConflicts != nil {
+			p.digestConflicts.add(DigestConflict{
+				MessageID:         msgId,
+				Digest:            prev.digest,
+				TxHash:            prev.txHash,
+				ConflictingDigest: digest,
+				ConflictingTxHash: k.TxHash,
+				DetectedAt:        time.Now(),
+			})
+		}
+		return true
+	}
+
+	if !exists {
+		p.signedDigests[msgId] = signedDigest{digest: digest, txHash: k.TxHash, signedAt: time.Now()}
+	}
+
+	return false
+}
+
+// cleanupSignedDigests forgets the digests signed more than signedDigestRetention ago.
+func (p *Processor) cleanupSignedDigests(now time.Time) {
+	for msgId, sd := range p.signedDigests {
+		if now.Sub(sd.signedAt) > signedDigestRetention {
+			delete(p.signedDigests, msgId)
+		}
+	}
+}
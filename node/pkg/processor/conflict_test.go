@@ -0,0 +1,117 @@
+package processor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func newMessageForConflictTest(payload []byte) (*common.MessagePublication, *VAA) {
+	k := &common.MessagePublication{
+		TxHash:           ethcommon.BytesToHash(payload),
+		Timestamp:        time.Unix(1654543099, 0),
+		Nonce:            1,
+		Sequence:         42,
+		ConsistencyLevel: 32,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   vaa.Address{1, 2, 3},
+		Payload:          payload,
+	}
+	v := &VAA{VAA: vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		Timestamp:        k.Timestamp,
+		Nonce:            k.Nonce,
+		Sequence:         k.Sequence,
+		ConsistencyLevel: k.ConsistencyLevel,
+		EmitterChain:     k.EmitterChain,
+		EmitterAddress:   k.EmitterAddress,
+		Payload:          k.Payload,
+	}}
+	return k, v
+}
+
+func newProcessorForConflictTest() *Processor {
+	return &Processor{
+		logger:          zap.NewNop(),
+		signedDigests:   make(map[string]signedDigest),
+		digestConflicts: NewDigestConflicts(),
+	}
+}
+
+func TestDigestConflictIsDetected(t *testing.T) {
+	p := newProcessorForConflictTest()
+
+	k1, v1 := newMessageForConflictTest([]byte{1})
+	assert.False(t, p.checkDigestConflict(k1, v1, v1.SigningDigest(), nil))
+
+	// Observing the same message again is fine.
+	assert.False(t, p.checkDigestConflict(k1, v1, v1.SigningDigest(), nil))
+	assert.Equal(t, 0, len(p.digestConflicts.Get()))
+
+	// But a different payload for the same emitter and sequence is not.
+	k2, v2 := newMessageForConflictTest([]byte{2})
+	assert.True(t, p.checkDigestConflict(k2, v2, v2.SigningDigest(), nil))
+
+	conflicts := p.digestConflicts.Get()
+	require.Equal(t, 1, len(conflicts))
+	assert.Equal(t, v1.MessageID(), conflicts[0].MessageID)
+	assert.Equal(t, v1.SigningDigest(), conflicts[0].Digest)
+	assert.Equal(t, k1.TxHash, conflicts[0].TxHash)
+	assert.Equal(t, v2.SigningDigest(), conflicts[0].ConflictingDigest)
+	assert.Equal(t, k2.TxHash, conflicts[0].ConflictingTxHash)
+
+	// The original message is still the one that counts.
+	assert.False(t, p.checkDigestConflict(k1, v1, v1.SigningDigest(), nil))
+}
+
+func TestDigestConflictWithStoredVAA(t *testing.T) {
+	p := newProcessorForConflictTest()
+
+	_, v1 := newMessageForConflictTest([]byte{1})
+	k2, v2 := newMessageForConflictTest([]byte{2})
+	assert.True(t, p.checkDigestConflict(k2, v2, v2.SigningDigest(), &v1.VAA))
+	assert.False(t, p.checkDigestConflict(k2, v1, v1.SigningDigest(), &v1.VAA))
+}
+
+func TestUnreliableMessagesAreNotTracked(t *testing.T) {
+	p := newProcessorForConflictTest()
+
+	k1, v1 := newMessageForConflictTest([]byte{1})
+	k1.Unreliable = true
+	k2, v2 := newMessageForConflictTest([]byte{2})
+	k2.Unreliable = true
+
+	assert.False(t, p.checkDigestConflict(k1, v1, v1.SigningDigest(), nil))
+	assert.False(t, p.checkDigestConflict(k2, v2, v2.SigningDigest(), nil))
+	assert.Equal(t, 0, len(p.signedDigests))
+}
+
+func TestSignedDigestsExpire(t *testing.T) {
+	p := newProcessorForConflictTest()
+
+	k1, v1 := newMessageForConflictTest([]byte{1})
+	assert.False(t, p.checkDigestConflict(k1, v1, v1.SigningDigest(), nil))
+
+	p.cleanupSignedDigests(time.Now())
+	assert.Equal(t, 1, len(p.signedDigests))
+
+	p.cleanupSignedDigests(time.Now().Add(signedDigestRetention + time.Minute))
+	assert.Equal(t, 0, len(p.signedDigests))
+}
+
+func TestDigestConflictsAreBounded(t *testing.T) {
+	c := NewDigestConflicts()
+	for i := 0; i < maxDigestConflicts+10; i++ {
+		c.add(DigestConflict{MessageID: string(rune('a' + i%26)), DetectedAt: time.Unix(int64(i), 0)})
+	}
+
+	conflicts := c.Get()
+	require.Equal(t, maxDigestConflicts, len(conflicts))
+	assert.Equal(t, time.Unix(maxDigestConflicts+9, 0), conflicts[0].DetectedAt)
+}
@@ -0,0 +1,118 @@
+package processor
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"time"
+)
+
+type (
+	// StateDumper allows other components (the admin server) to request a snapshot of the processor state. The request
+	// is handled by the processor routine, so the snapshot is consistent and no locking of the processor maps is needed.
+	StateDumper struct {
+		reqC chan chan *StateSnapshot
+	}
+
+	// StateSnapshot is a point in time copy of the processor state, for support cases.
+	StateSnapshot struct {
+		Time               time.Time             `json:"time"`
+		GuardianSetIndex   *uint32               `json:"guardianSetIndex,omitempty"`
+		Observations       []ObservationSnapshot `json:"observations"`
+		NumSignedDigests   int                   `json:"numSignedDigests"`
+		NumPythNetVaas     int                   `json:"numPythNetVaas"`
+		NumDigestConflicts int                   `json:"numDigestConflicts"`
+	}
+
+	// ObservationSnapshot describes a single entry in the observation aggregation map.
+	ObservationSnapshot struct {
+		Digest          string    `json:"digest"`
+		MessageID       string    `json:"messageId,omitempty"`
+		TxHash          string    `json:"txHash,omitempty"`
+		Source          string    `json:"source,omitempty"`
+		FirstObserved   time.Time `json:"firstObserved"`
+		LastRetry       time.Time `json:"lastRetry"`
+		RetryCount      uint      `json:"retryCount"`
+		NumSignatures   int       `json:"numSignatures"`
+		HaveObservation bool      `json:"haveObservation"`
+		Submitted       bool      `json:"submitted"`
+		Settled         bool      `json:"settled"`
+	}
+)
+
+func NewStateDumper() *StateDumper {
+	return &StateDumper{reqC: make(chan chan *StateSnapshot)}
+}
+
+// Dump requests a snapshot from the processor and waits for it. It returns an error if the context is canceled first,
+// for instance because the processor is not running.
+func (d *StateDumper) Dump(ctx context.Context) (*StateSnapshot, error) {
+	respC := make(chan *StateSnapshot, 1)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case d.reqC <- respC:
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case snapshot := <-respC:
+		return snapshot, nil
+	}
+}
+
+// stateDumpReqC returns the channel on which the processor receives dump requests. It is nil if dumping is not enabled,
+// so the processor never selects it.
+func (p *Processor) stateDumpReqC() chan chan *StateSnapshot {
+	if p.stateDumper == nil {
+		return nil
+	}
+	return p.stateDumper.reqC
+}
+
+// snapshotState returns a copy of the processor state. It must be called from the processor routine.
+func (p *Processor) snapshotState() *StateSnapshot {
+	snapshot := &StateSnapshot{
+		Time:             time.Now(),
+		Observations:     make([]ObservationSnapshot, 0, len(p.state.signatures)),
+		NumSignedDigests: len(p.signedDigests),
+		NumPythNetVaas:   len(p.pythnetVaas),
+	}
+
+	if p.gs != nil {
+		idx := p.gs.Index
+		snapshot.GuardianSetIndex = &idx
+	}
+
+	if p.digestConflicts != nil {
+		snapshot.NumDigestConflicts = len(p.digestConflicts.Get())
+	}
+
+	for digest, s := range p.state.signatures {
+		o := ObservationSnapshot{
+			Digest:          digest,
+			Source:          s.source,
+			FirstObserved:   s.firstObserved,
+			LastRetry:       s.lastRetry,
+			RetryCount:      s.retryCount,
+			NumSignatures:   len(s.signatures),
+			HaveObservation: s.ourObservation != nil,
+			Submitted:       s.submitted,
+			Settled:         s.settled,
+		}
+		if s.ourObservation != nil {
+			o.MessageID = s.ourObservation.MessageID()
+		}
+		if len(s.txHash) != 0 {
+			o.TxHash = hex.EncodeToString(s.txHash)
+		}
+		snapshot.Observations = append(snapshot.Observations, o)
+	}
+
+	sort.Slice(snapshot.Observations, func(i, j int) bool {
+		return snapshot.Observations[i].FirstObserved.Before(snapshot.Observations[j].FirstObserved)
+	})
+
+	return snapshot
+}
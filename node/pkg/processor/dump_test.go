@@ -0,0 +1,88 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSnapshotState(t *testing.T) {
+	_, v := newMessageForConflictTest([]byte{1})
+	now := time.Now()
+
+	p := &Processor{
+		logger:        zap.NewNop(),
+		gs:            &common.GuardianSet{Index: 3},
+		signedDigests: map[string]signedDigest{"a": {}},
+		pythnetVaas:   map[string]PythNetVaaEntry{},
+		state: &aggregationState{observationMap{
+			"newer": &state{
+				firstObserved: now,
+				signatures:    map[ethcommon.Address][]byte{{1}: {1}, {2}: {2}},
+				source:        "loopback",
+				retryCount:    2,
+			},
+			"older": &state{
+				firstObserved:  now.Add(-time.Minute),
+				ourObservation: v,
+				txHash:         []byte{0xab, 0xcd},
+				submitted:      true,
+			},
+		}},
+	}
+
+	snapshot := p.snapshotState()
+	require.NotNil(t, snapshot.GuardianSetIndex)
+	assert.Equal(t, uint32(3), *snapshot.GuardianSetIndex)
+	assert.Equal(t, 1, snapshot.NumSignedDigests)
+	assert.Equal(t, 0, snapshot.NumPythNetVaas)
+
+	require.Equal(t, 2, len(snapshot.Observations))
+	assert.Equal(t, "older", snapshot.Observations[0].Digest)
+	assert.Equal(t, v.MessageID(), snapshot.Observations[0].MessageID)
+	assert.Equal(t, "abcd", snapshot.Observations[0].TxHash)
+	assert.True(t, snapshot.Observations[0].HaveObservation)
+	assert.True(t, snapshot.Observations[0].Submitted)
+	assert.Equal(t, "newer", snapshot.Observations[1].Digest)
+	assert.Equal(t, 2, snapshot.Observations[1].NumSignatures)
+	assert.Equal(t, "loopback", snapshot.Observations[1].Source)
+	assert.Equal(t, uint(2), snapshot.Observations[1].RetryCount)
+	assert.False(t, snapshot.Observations[1].HaveObservation)
+}
+
+func TestStateDumperTimesOut(t *testing.T) {
+	d := NewStateDumper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// Nothing is serving the request, as if the processor were stuck.
+	_, err := d.Dump(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestStateDumperServedByProcessor(t *testing.T) {
+	d := NewStateDumper()
+	p := &Processor{
+		stateDumper: d,
+		state:       &aggregationState{observationMap{}},
+	}
+
+	go func() {
+		respC := <-p.stateDumpReqC()
+		respC <- p.snapshotState()
+	}()
+
+	snapshot, err := d.Dump(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, snapshot.GuardianSetIndex)
+	assert.Equal(t, 0, len(snapshot.Observations))
+
+	assert.Nil(t, (&Processor{}).stateDumpReqC())
+}
@@ -0,0 +1,145 @@
+package processor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	guardianSetTransitionVAAs = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_guardian_set_transition_vaas_total",
+			Help: "Total number of VAAs assembled under the other guardian set of a guardian set transition, grouped by reason",
+		}, []string{"reason"})
+)
+
+// SetGuardianSetOverlap configures how long the previous guardian set keeps being used after a guardian set update.
+// During the overlap, observations are aggregated under both the previous and the new guardian set, so that messages
+// observed by guardians on either side of the switchover still reach quorum, and the VAAs that reached quorum under the
+// previous set are assembled again under the new set once it has quorum, so that they stay valid after the previous set
+// expires. A zero overlap disables the dual verification.
+func (p *Processor) SetGuardianSetOverlap(overlap time.Duration) {
+	p.gsOverlap = overlap
+}
+
+// updateGuardianSet makes gs the current guardian set, opening an overlap window for the previous set if it is older.
+func (p *Processor) updateGuardianSet(gs *common.GuardianSet) {
+	if p.gsOverlap > 0 && p.gs != nil && gs.Index > p.gs.Index {
+		p.prevGs = p.gs
+		p.prevGsExpiry = time.Now().Add(p.gsOverlap)
+		p.gst.SetPrevious(p.prevGs, p.prevGsExpiry)
+		p.logger.Info("starting guardian set transition",
+			zap.Uint32("previous_index", p.prevGs.Index),
+			zap.Uint32("index", gs.Index),
+			zap.Time("overlap_end", p.prevGsExpiry))
+	}
+
+	p.gs = gs
+	p.logger.Info("guardian set updated",
+		zap.Strings("set", p.gs.KeysAsHexStrings()),
+		zap.Uint32("index", p.gs.Index))
+	p.gst.Set(p.gs)
+}
+
+// transitionPeer returns the other guardian set of the transition gs is part of, or nil if there is no transition in
+// progress or gs is not part of it.
+func (p *Processor) transitionPeer(gs *common.GuardianSet) *common.GuardianSet {
+	if p.prevGs == nil || gs == nil {
+		return nil
+	}
+	if time.Now().After(p.prevGsExpiry) {
+		p.logger.Info("guardian set transition ended", zap.Uint32("previous_index", p.prevGs.Index))
+		p.prevGs = nil
+		return nil
+	}
+
+	switch gs.Index {
+	case p.prevGs.Index:
+		return p.gs
+	case p.gs.Index:
+		return p.prevGs
+	}
+	return nil
+}
+
+// aggregateSignatures returns the signatures of the members of gs, with their index in gs, and which members signed.
+func aggregateSignatures(gs *common.GuardianSet, s *state) ([]*vaa.Signature, []bool) {
+	agg := make([]bool, len(gs.Keys))
+	var sigs []*vaa.Signature
+	for i, a := range gs.Keys {
+		sig, ok := s.signatures[a]
+
+		if ok {
+			var bs [65]byte
+			if n := copy(bs[:], sig); n != 65 {
+				panic(fmt.Sprintf("invalid sig len: %d", n))
+			}
+
+			sigs = append(sigs, &vaa.Signature{
+				Index:     uint8(i),
+				Signature: bs,
+			})
+		}
+
+		agg[i] = ok
+	}
+
+	return sigs, agg
+}
+
+// handleTransitionQuorum checks whether our observation of hash reaches quorum under the other guardian set of a
+// transition, and if so assembles the VAA under that set. This is done if the VAA has not been submitted yet, which
+// closes the quorum gap when the guardians signing the message are split between the sets, or if the VAA was submitted
+// under the previous set and the new set now has quorum. Since the guardian set index is not part of the signing
+// digest, the signatures are reused as is. It returns true if the VAA was assembled.
+func (p *Processor) handleTransitionQuorum(hash string, s *state) bool {
+	v, ok := s.ourObservation.(*VAA)
+	if !ok {
+		return false
+	}
+
+	alt := p.transitionPeer(s.gs)
+	if alt == nil {
+		return false
+	}
+
+	reason := "quorum"
+	if s.submitted {
+		if alt.Index < s.gs.Index {
+			return false
+		}
+		reason = "resigned"
+	}
+
+	sigs, _ := aggregateSignatures(alt, s)
+	if len(sigs) < vaa.CalculateQuorum(len(alt.Keys)) {
+		return false
+	}
+
+	p.logger.Info("assembling VAA under the other guardian set of the transition",
+		zap.String("digest", hash),
+		zap.String("reason", reason),
+		zap.Uint32("index", alt.Index),
+		zap.Uint32("previous_index", s.gs.Index))
+
+	v.GuardianSetIndex = alt.Index
+	s.gs = alt
+	v.HandleQuorum(sigs, hash, p)
+	guardianSetTransitionVAAs.WithLabelValues(reason).Inc()
+	return true
+}
+
+// transitionGuardianSetForVAA returns the guardian set a signed VAA received from the network is verified with. During
+// a transition, VAAs signed by the previous set are still accepted.
+func (p *Processor) transitionGuardianSetForVAA(v *vaa.VAA) *common.GuardianSet {
+	if prev := p.transitionPeer(p.gs); prev != nil && prev.Index == v.GuardianSetIndex {
+		return prev
+	}
+	return p.gs
+}
@@ -0,0 +1,177 @@
+package processor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/reporter"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// newTransitionTestProcessor returns a processor in a transition from a guardian set of prevKey to one of newKey, and
+// our observation of a PythNet VAA made under the previous set. PythNet VAAs are stored in memory.
+func newTransitionTestProcessor(t *testing.T, prevKey, newKey *ecdsa.PrivateKey) (*Processor, string, *VAA) {
+	t.Helper()
+	p := &Processor{
+		logger:            zap.NewNop(),
+		gossipSendC:       make(chan []byte, 10),
+		attestationEvents: reporter.EventListener(zap.NewNop()),
+		pythnetVaas:       make(map[string]PythNetVaaEntry),
+		gst:               common.NewGuardianSetState(nil),
+	}
+	p.SetGuardianSetOverlap(time.Hour)
+	prevGs := &common.GuardianSet{Keys: []ethcommon.Address{crypto.PubkeyToAddress(prevKey.PublicKey)}, Index: 1}
+	p.updateGuardianSet(prevGs)
+	p.updateGuardianSet(&common.GuardianSet{Keys: []ethcommon.Address{crypto.PubkeyToAddress(newKey.PublicKey)}, Index: 2})
+	require.Equal(t, prevGs, p.prevGs)
+
+	v := &VAA{VAA: getVAA()}
+	v.EmitterChain = vaa.ChainIDPythNet
+	hash := ethcommon.Bytes2Hex(v.SigningDigest().Bytes())
+	p.state = &aggregationState{observationMap{
+		hash: {firstObserved: time.Now(), signatures: map[ethcommon.Address][]byte{}, ourObservation: v, gs: prevGs},
+	}}
+
+	return p, hash, v
+}
+
+func signedObservation(t *testing.T, v *VAA, key *ecdsa.PrivateKey) *gossipv1.SignedObservation {
+	t.Helper()
+	digest := v.SigningDigest()
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	require.NoError(t, err)
+	return &gossipv1.SignedObservation{Addr: crypto.PubkeyToAddress(key.PublicKey).Bytes(), Hash: digest.Bytes(), Signature: sig}
+}
+
+func TestGuardianSetTransitionQuorumUnderNewSet(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, hash, v := newTransitionTestProcessor(t, prevKey, newKey)
+
+	// Only the new set signs the message we observed under the previous set, which still reaches quorum.
+	p.handleObservation(context.Background(), signedObservation(t, v, newKey))
+	require.True(t, p.state.signatures[hash].submitted)
+	assert.Equal(t, uint32(2), p.state.signatures[hash].gs.Index)
+
+	signed, err := p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), signed.GuardianSetIndex)
+	assert.NoError(t, signed.Verify([]ethcommon.Address{crypto.PubkeyToAddress(newKey.PublicKey)}))
+}
+
+func TestGuardianSetTransitionResignsUnderNewSet(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, hash, v := newTransitionTestProcessor(t, prevKey, newKey)
+
+	p.handleObservation(context.Background(), signedObservation(t, v, prevKey))
+	require.True(t, p.state.signatures[hash].submitted)
+	signed, err := p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), signed.GuardianSetIndex)
+
+	// Once the new set has quorum, the VAA is assembled again under it.
+	p.handleObservation(context.Background(), signedObservation(t, v, newKey))
+	signed, err = p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), signed.GuardianSetIndex)
+	assert.NoError(t, signed.Verify([]ethcommon.Address{crypto.PubkeyToAddress(newKey.PublicKey)}))
+	assert.Equal(t, 2, len(p.gossipSendC))
+}
+
+func TestGuardianSetTransitionEnds(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, hash, v := newTransitionTestProcessor(t, prevKey, newKey)
+
+	// After the overlap, signatures of the new set are not accepted for observations made under the previous set.
+	p.prevGsExpiry = time.Now().Add(-time.Second)
+	p.handleObservation(context.Background(), signedObservation(t, v, newKey))
+	assert.False(t, p.state.signatures[hash].submitted)
+	assert.Nil(t, p.prevGs)
+}
+
+func TestGuardianSetTransitionReplacesStoredVAAFromGossip(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, _, v := newTransitionTestProcessor(t, prevKey, newKey)
+	hook := &recordingQuorumHook{p: p}
+	p.AddQuorumHook(hook)
+
+	gossip := func(key *ecdsa.PrivateKey, index uint32) {
+		signed := v.VAA
+		signed.GuardianSetIndex = index
+		signed.Signatures = nil
+		signed.AddSignature(key, 0)
+		b, err := signed.Marshal()
+		require.NoError(t, err)
+		p.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: b})
+	}
+
+	// The VAA signed by the new set replaces the one signed by the previous set, but not the other way around.
+	gossip(prevKey, 1)
+	gossip(newKey, 2)
+	gossip(prevKey, 1)
+
+	signed, err := p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), signed.GuardianSetIndex)
+	assert.Equal(t, []string{
+		"before gossip not stored", "after gossip stored",
+		"before transition stored", "after transition stored",
+	}, hook.calls)
+}
+
+func TestGossipedReindexedVAADoesNotReplaceStoredVAA(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, _, v := newTransitionTestProcessor(t, prevKey, newKey)
+
+	gossip := func(key *ecdsa.PrivateKey, index uint32) {
+		signed := v.VAA
+		signed.GuardianSetIndex = index
+		signed.Signatures = nil
+		signed.AddSignature(key, 0)
+		b, err := signed.Marshal()
+		require.NoError(t, err)
+		p.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: b})
+	}
+
+	gossip(prevKey, 1)
+
+	// The guardian set index is not part of the signing digest, so the signatures of a re-indexed copy still verify.
+	gossip(newKey, 1002)
+	gossip(prevKey, 1002)
+
+	signed, err := p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), signed.GuardianSetIndex)
+	require.NoError(t, signed.Verify([]ethcommon.Address{crypto.PubkeyToAddress(prevKey.PublicKey)}))
+
+	// A re-indexed copy of a VAA we do not store yet is not stored either.
+	v.VAA.Sequence++
+	gossip(newKey, 1002)
+	_, err = p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA))
+	assert.ErrorIs(t, err, db.ErrVAANotFound)
+}
@@ -0,0 +1,115 @@
+package processor
+
+import (
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/reporter"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	quorumHookPanics = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_quorum_hook_panics_total",
+			Help: "Total number of panics recovered from quorum hooks, grouped by hook",
+		}, []string{"hook"})
+)
+
+// QuorumSource is how a VAA reached quorum on this node.
+type QuorumSource int
+
+const (
+	// QuorumSourceLocal is a VAA assembled by the processor from the signatures of the guardians.
+	QuorumSourceLocal QuorumSource = iota
+	// QuorumSourceGossip is a VAA received with a quorum of signatures from the network.
+	QuorumSourceGossip
+	// QuorumSourceTransition is a VAA which already reached quorum under the previous guardian set of a guardian set
+	// transition, and reached it again under the new set, either assembled by the processor or received from the
+	// network. It replaces the VAA stored under the previous set.
+	QuorumSourceTransition
+)
+
+func (s QuorumSource) String() string {
+	switch s {
+	case QuorumSourceLocal:
+		return "local"
+	case QuorumSourceGossip:
+		return "gossip"
+	case QuorumSourceTransition:
+		return "transition"
+	default:
+		return "unknown"
+	}
+}
+
+// QuorumHook is notified of the VAAs which reach quorum on this node, so that components like notification sinks or
+// transaction submitters can act on them without the processor depending on them. VAAs received from the network are
+// only passed to the hooks if this node did not store them yet. During a guardian set transition, a VAA may be passed
+// a second time with QuorumSourceTransition, signed by the new guardian set: hooks which must act once per message
+// should ignore it.
+//
+// The hooks are called on the processor goroutine, in the order they were added. They must return quickly and must not
+// modify the VAA: a hook doing slow work, like a network request, should queue the VAA and process it on a goroutine of
+// its own. A panicking hook is logged and skipped, so that it cannot stop the processor or the other hooks.
+type QuorumHook interface {
+	// BeforeStore is called with a VAA which reached quorum, before it is stored in the database.
+	BeforeStore(v *vaa.VAA, source QuorumSource)
+	// AfterStore is called once the VAA was stored in the database, with the error if storing it failed.
+	AfterStore(v *vaa.VAA, source QuorumSource, err error)
+}
+
+// AddQuorumHook registers a hook notified of the VAAs reaching quorum. It must be called before the processor is run.
+func (p *Processor) AddQuorumHook(hook QuorumHook) {
+	p.quorumHooks = append(p.quorumHooks, hook)
+}
+
+// storeQuorumVAA stores a VAA which reached quorum, notifying the hooks before and after.
+func (p *Processor) storeQuorumVAA(v *vaa.VAA, source QuorumSource) error {
+	for _, hook := range p.quorumHooks {
+		p.callQuorumHook(hook, v, func() { hook.BeforeStore(v, source) })
+	}
+
+	err := p.storeSignedVAA(v)
+
+	for _, hook := range p.quorumHooks {
+		p.callQuorumHook(hook, v, func() { hook.AfterStore(v, source, err) })
+	}
+	return err
+}
+
+// callQuorumHook calls a method of a hook, recovering from a panic.
+func (p *Processor) callQuorumHook(hook QuorumHook, v *vaa.VAA, call func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			name := fmt.Sprintf("%T", hook)
+			quorumHookPanics.WithLabelValues(name).Inc()
+			p.logger.Error("quorum hook panicked",
+				zap.String("hook", name),
+				zap.String("message_id", v.MessageID()),
+				zap.Any("panic", r),
+				zap.Stack("stack"))
+		}
+	}()
+	call()
+}
+
+// attestationEventsHook reports the VAAs reaching quorum to the subscribers of the attestation events, like the spy
+// and the VAA webhook.
+type attestationEventsHook struct {
+	events *reporter.AttestationEventReporter
+}
+
+func (h *attestationEventsHook) BeforeStore(*vaa.VAA, QuorumSource) {}
+
+// AfterStore reports the VAA once per message: VAAs signed again by the new guardian set of a transition were already
+// reported. A VAA received from the network is only reported if it was stored, while our own VAAs are reported anyway
+// since they are broadcast.
+func (h *attestationEventsHook) AfterStore(v *vaa.VAA, source QuorumSource, err error) {
+	if source == QuorumSourceTransition || (source == QuorumSourceGossip && err != nil) {
+		return
+	}
+	h.events.ReportVAAQuorum(v)
+}
@@ -0,0 +1,141 @@
+package processor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/reporter"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// recordingQuorumHook records the calls of the hooks, along with whether the VAA was stored at the time.
+type recordingQuorumHook struct {
+	p     *Processor
+	calls []string
+}
+
+func (h *recordingQuorumHook) record(name string, v *vaa.VAA, source QuorumSource) {
+	_, err := h.p.getSignedVAA(*db.VaaIDFromVAA(v))
+	stored := "stored"
+	if err == db.ErrVAANotFound {
+		stored = "not stored"
+	}
+	h.calls = append(h.calls, name+" "+source.String()+" "+stored)
+}
+
+func (h *recordingQuorumHook) BeforeStore(v *vaa.VAA, source QuorumSource) {
+	h.record("before", v, source)
+}
+
+func (h *recordingQuorumHook) AfterStore(v *vaa.VAA, source QuorumSource, err error) {
+	if err != nil {
+		h.calls = append(h.calls, "after error")
+		return
+	}
+	h.record("after", v, source)
+}
+
+func TestQuorumHooksLocal(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, hash, v := newTransitionTestProcessor(t, prevKey, newKey)
+
+	hook1 := &recordingQuorumHook{p: p}
+	hook2 := &recordingQuorumHook{p: p}
+	p.AddQuorumHook(hook1)
+	p.AddQuorumHook(hook2)
+
+	p.handleObservation(context.Background(), signedObservation(t, v, newKey))
+	require.True(t, p.state.signatures[hash].submitted)
+
+	assert.Equal(t, []string{"before local not stored", "after local stored"}, hook1.calls)
+	assert.Equal(t, hook1.calls, hook2.calls)
+}
+
+func TestQuorumHooksGossip(t *testing.T) {
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	p := &Processor{
+		logger:            zap.NewNop(),
+		attestationEvents: reporter.EventListener(zap.NewNop()),
+		pythnetVaas:       make(map[string]PythNetVaaEntry),
+		gs:                &common.GuardianSet{Keys: []ethcommon.Address{crypto.PubkeyToAddress(key.PublicKey)}, Index: 1},
+	}
+	hook := &recordingQuorumHook{p: p}
+	p.AddQuorumHook(hook)
+
+	// PythNet VAAs are stored in memory.
+	v := getVAA()
+	v.EmitterChain = vaa.ChainIDPythNet
+	v.AddSignature(key, 0)
+	vaaBytes, err := v.Marshal()
+	require.NoError(t, err)
+
+	p.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: vaaBytes})
+	assert.Equal(t, []string{"before gossip not stored", "after gossip stored"}, hook.calls)
+
+	// A VAA we already store is not passed to the hooks again.
+	p.handleInboundSignedVAAWithQuorum(context.Background(), &gossipv1.SignedVAAWithQuorum{Vaa: vaaBytes})
+	assert.Equal(t, 2, len(hook.calls))
+}
+
+// panickingQuorumHook panics on every call.
+type panickingQuorumHook struct{}
+
+func (panickingQuorumHook) BeforeStore(*vaa.VAA, QuorumSource)       { panic("before") }
+func (panickingQuorumHook) AfterStore(*vaa.VAA, QuorumSource, error) { panic("after") }
+
+func TestQuorumHookPanicRecovered(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, hash, v := newTransitionTestProcessor(t, prevKey, newKey)
+
+	hook := &recordingQuorumHook{p: p}
+	p.AddQuorumHook(panickingQuorumHook{})
+	p.AddQuorumHook(hook)
+
+	p.handleObservation(context.Background(), signedObservation(t, v, newKey))
+	require.True(t, p.state.signatures[hash].submitted)
+	assert.Equal(t, []string{"before local not stored", "after local stored"}, hook.calls)
+}
+
+func TestQuorumHooksTransition(t *testing.T) {
+	prevKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	p, _, v := newTransitionTestProcessor(t, prevKey, newKey)
+
+	hook := &recordingQuorumHook{p: p}
+	p.AddQuorumHook(hook)
+	p.AddQuorumHook(&attestationEventsHook{events: p.attestationEvents})
+	sub := p.attestationEvents.Subscribe()
+
+	// The VAA reaches quorum under the previous set, then again under the new set.
+	p.handleObservation(context.Background(), signedObservation(t, v, prevKey))
+	p.handleObservation(context.Background(), signedObservation(t, v, newKey))
+
+	assert.Equal(t, []string{
+		"before local not stored", "after local stored",
+		"before transition stored", "after transition stored",
+	}, hook.calls)
+
+	// The attestation events only report it once.
+	require.Equal(t, 1, len(sub.Channels.VAAQuorumC))
+	assert.Equal(t, uint32(1), (<-sub.Channels.VAAQuorumC).GuardianSetIndex)
+}
@@ -95,14 +95,27 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 		return
 	}
 
+	// Generate digest of the unsigned VAA.
+	digest := v.SigningDigest()
+
+	existing, err := p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA))
+	if err != nil {
+		existing = nil
+	}
+
+	// SECURITY: Never sign two different messages for the same emitter and sequence. This protects against emitter
+	// contract bugs and RPC nodes feeding us forged messages.
+	if p.checkDigestConflict(k, v, digest, existing) {
+		return
+	}
+
 	// Ignore incoming observations when our database already has a quorum VAA for it.
 	// This can occur when we're receiving late observations due to node catchup, and
 	// processing those won't do us any good.
 	//
 	// Exception: if an observation is made within the settlement time (30s), we'll
 	// process it so other nodes won't consider it a miss.
-
-	if existing, err := p.getSignedVAA(*db.VaaIDFromVAA(&v.VAA)); err == nil {
+	if err == nil {
 		if k.Timestamp.Sub(existing.Timestamp) > settlementTime {
 			p.logger.Info("ignoring observation since we already have a quorum VAA for it",
 				zap.Stringer("emitter_chain", k.EmitterChain),
@@ -128,9 +141,6 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 		)
 	}
 
-	// Generate digest of the unsigned VAA.
-	digest := v.SigningDigest()
-
 	// Sign the digest using our node's guardian key.
 	s, err := crypto.Sign(digest.Bytes(), p.gk)
 	if err != nil {
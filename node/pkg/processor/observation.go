@@ -3,7 +3,7 @@ package processor
 import (
 	"context"
 	"encoding/hex"
-	"fmt"
+	"strconv"
 	"time"
 
 	node_common "github.com/certusone/wormhole/node/pkg/common"
@@ -41,6 +41,12 @@ var (
 			Name: "wormhole_observations_unknown_total",
 			Help: "Total number of verified observations we haven't seen ourselves",
 		})
+	signatureArrivalLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_observation_signature_latency_seconds",
+			Help:    "Time between the first observation of a digest and the arrival of the signature of each guardian, grouped by guardian index",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 14),
+		}, []string{"guardian_index"})
 )
 
 // handleObservation processes a remote VAA observation, verifies it, checks whether the VAA has met quorum,
@@ -127,7 +133,13 @@ func (p *Processor) handleObservation(ctx context.Context, m *gossipv1.SignedObs
 
 	// Verify that m.Addr is included in the guardian set. If it's not, drop the message. In case it's us
 	// who have the outdated guardian set, we'll just wait for the message to be retransmitted eventually.
-	_, ok := gs.KeyIndex(their_addr)
+	// During a guardian set transition, members of the other set are accepted as well.
+	guardianIndex, ok := gs.KeyIndex(their_addr)
+	if !ok {
+		if peer := p.transitionPeer(gs); peer != nil {
+			guardianIndex, ok = peer.KeyIndex(their_addr)
+		}
+	}
 	if !ok {
 		p.logger.Debug("received observation by unknown guardian - is our guardian set outdated?",
 			zap.String("digest", hash),
@@ -165,28 +177,15 @@ func (p *Processor) handleObservation(ctx context.Context, m *gossipv1.SignedObs
 		}
 	}
 
+	// Only the first arrival of each signature is timed, retransmissions would skew the latency towards the retry interval.
+	if _, exists := p.state.signatures[hash].signatures[their_addr]; !exists {
+		signatureArrivalLatency.WithLabelValues(strconv.Itoa(guardianIndex)).Observe(time.Since(p.state.signatures[hash].firstObserved).Seconds())
+	}
+
 	p.state.signatures[hash].signatures[their_addr] = m.Signature
 
 	// Aggregate all valid signatures into a list of vaa.Signature and construct signed VAA.
-	agg := make([]bool, len(gs.Keys))
-	var sigs []*vaa.Signature
-	for i, a := range gs.Keys {
-		s, ok := p.state.signatures[hash].signatures[a]
-
-		if ok {
-			var bs [65]byte
-			if n := copy(bs[:], s); n != 65 {
-				panic(fmt.Sprintf("invalid sig len: %d", n))
-			}
-
-			sigs = append(sigs, &vaa.Signature{
-				Index:     uint8(i),
-				Signature: bs,
-			})
-		}
-
-		agg[i] = ok
-	}
+	sigs, agg := aggregateSignatures(gs, p.state.signatures[hash])
 
 	if p.state.signatures[hash].ourObservation != nil {
 		// We have made this observation on chain!
@@ -206,7 +205,7 @@ func (p *Processor) handleObservation(ctx context.Context, m *gossipv1.SignedObs
 
 		if len(sigs) >= quorum && !p.state.signatures[hash].submitted {
 			p.state.signatures[hash].ourObservation.HandleQuorum(sigs, hash, p)
-		} else {
+		} else if !p.handleTransitionQuorum(hash, p.state.signatures[hash]) {
 			p.logger.Info("quorum not met or already submitted, doing nothing",
 				zap.String("digest", hash))
 		}
@@ -247,7 +246,19 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 		return
 	}
 
-	if err := v.Verify(p.gs.Keys); err != nil {
+	// The guardian set index is not covered by the signing digest, so a VAA could otherwise be re-indexed by anyone and
+	// still verify.
+	gs := p.transitionGuardianSetForVAA(v)
+	if v.GuardianSetIndex != gs.Index {
+		p.logger.Warn("dropping SignedVAAWithQuorum message because it is not signed by a known guardian set",
+			zap.String("digest", hash),
+			zap.Uint32("index", v.GuardianSetIndex),
+			zap.Uint32("current_index", p.gs.Index),
+		)
+		return
+	}
+
+	if err := v.Verify(gs.Keys); err != nil {
 		p.logger.Warn("dropping SignedVAAWithQuorum message because it failed verification: " + err.Error())
 		return
 	}
@@ -257,13 +268,19 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 	//  - the signature's addresses match the node's current guardian set
 	//  - enough signatures are present for the VAA to reach quorum
 
-	// Check if we already store this VAA
-	_, err = p.getSignedVAA(*db.VaaIDFromVAA(v))
+	// Check if we already store this VAA. A VAA stored under an older guardian set is only replaced by one signed by the
+	// current set, like the VAAs assembled again under the new set of a guardian set transition, so that it stays valid
+	// once the older set expires.
+	source := QuorumSourceGossip
+	stored, err := p.getSignedVAA(*db.VaaIDFromVAA(v))
 	if err == nil {
-		p.logger.Debug("ignored SignedVAAWithQuorum message for VAA we already store",
-			zap.String("digest", hash),
-		)
-		return
+		if v.GuardianSetIndex != p.gs.Index || stored.GuardianSetIndex >= v.GuardianSetIndex {
+			p.logger.Debug("ignored SignedVAAWithQuorum message for VAA we already store",
+				zap.String("digest", hash),
+			)
+			return
+		}
+		source = QuorumSourceTransition
 	} else if err != db.ErrVAANotFound {
 		p.logger.Error("failed to look up VAA in database",
 			zap.String("digest", hash),
@@ -277,11 +294,10 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 		zap.String("digest", hash),
 		zap.Any("vaa", v),
 		zap.String("bytes", hex.EncodeToString(m.Vaa)),
-		zap.String("message_id", v.MessageID()))
+		zap.String("message_id", v.MessageID()),
+		zap.Stringer("source", source))
 
-	if err := p.storeSignedVAA(v); err != nil {
+	if err := p.storeQuorumVAA(v, source); err != nil {
 		p.logger.Error("failed to store signed VAA", zap.Error(err))
-		return
 	}
-	p.attestationEvents.ReportVAAQuorum(v)
 }
@@ -7,6 +7,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -123,3 +127,45 @@ func TestHandleInboundSignedVAAWithQuorum(t *testing.T) {
 		})
 	}
 }
+
+// getSampleCountAndSum returns the number of observations of a histogram and their sum.
+func getSampleCountAndSum(t *testing.T, h prometheus.Observer) (uint64, float64) {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, h.(prometheus.Metric).Write(m))
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
+
+func TestSignatureArrivalLatency(t *testing.T) {
+	key0, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	key1, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	v := getVAA()
+	digest := v.SigningDigest()
+	hash := ethcommon.Bytes2Hex(digest.Bytes())
+
+	processor := Processor{logger: zap.NewNop()}
+	processor.gs = &common.GuardianSet{Keys: []ethcommon.Address{crypto.PubkeyToAddress(key0.PublicKey), crypto.PubkeyToAddress(key1.PublicKey)}, Index: 1}
+	processor.state = &aggregationState{observationMap{
+		hash: {firstObserved: time.Now().Add(-10 * time.Second), signatures: map[ethcommon.Address][]byte{}},
+	}}
+
+	latency := signatureArrivalLatency.WithLabelValues("1")
+	countBefore, sumBefore := getSampleCountAndSum(t, latency)
+
+	sig, err := crypto.Sign(digest.Bytes(), key1)
+	require.NoError(t, err)
+	obsv := &gossipv1.SignedObservation{Addr: crypto.PubkeyToAddress(key1.PublicKey).Bytes(), Hash: digest.Bytes(), Signature: sig}
+	processor.handleObservation(context.Background(), obsv)
+
+	count, sum := getSampleCountAndSum(t, latency)
+	assert.Equal(t, countBefore+1, count)
+	assert.GreaterOrEqual(t, sum-sumBefore, 10.0)
+
+	// A retransmission of the same signature is not timed again.
+	processor.handleObservation(context.Background(), obsv)
+	count, _ = getSampleCountAndSum(t, latency)
+	assert.Equal(t, countBefore+1, count)
+}
@@ -125,6 +125,23 @@ type Processor struct {
 	acct        *accountant.Accountant
 	acctReadC   <-chan *common.MessagePublication
 	pythnetVaas map[string]PythNetVaaEntry
+
+	// signedDigests is the digest we signed for each recently observed message ID.
+	signedDigests map[string]signedDigest
+	// digestConflicts records the conflicting messages we refused to sign, for the admin RPC.
+	digestConflicts *DigestConflicts
+	// stateDumper is used by the admin RPC to request a snapshot of our state.
+	stateDumper *StateDumper
+	// cleanupPolicies are the cleanup policies of the chains that do not use DefaultCleanupPolicy.
+	cleanupPolicies map[vaa.ChainID]CleanupPolicy
+	// gsOverlap is how long the previous guardian set is still used after a guardian set update.
+	gsOverlap time.Duration
+	// prevGs is the previous guardian set during a guardian set transition, or nil.
+	prevGs *common.GuardianSet
+	// prevGsExpiry is the end of the overlap window of prevGs.
+	prevGsExpiry time.Time
+	// quorumHooks are notified of the VAAs reaching quorum, see AddQuorumHook.
+	quorumHooks []QuorumHook
 }
 
 func NewProcessor(
@@ -143,9 +160,11 @@ func NewProcessor(
 	g *governor.ChainGovernor,
 	acct *accountant.Accountant,
 	acctReadC <-chan *common.MessagePublication,
+	digestConflicts *DigestConflicts,
+	stateDumper *StateDumper,
 ) *Processor {
 
-	return &Processor{
+	p := &Processor{
 		msgC:         msgC,
 		setC:         setC,
 		gossipSendC:  gossipSendC,
@@ -166,7 +185,13 @@ func NewProcessor(
 		acct:        acct,
 		acctReadC:   acctReadC,
 		pythnetVaas: make(map[string]PythNetVaaEntry),
+
+		signedDigests:   make(map[string]signedDigest),
+		digestConflicts: digestConflicts,
+		stateDumper:     stateDumper,
 	}
+	p.AddQuorumHook(&attestationEventsHook{events: attestationEvents})
+	return p
 }
 
 func (p *Processor) Run(ctx context.Context) error {
@@ -182,11 +207,8 @@ func (p *Processor) Run(ctx context.Context) error {
 				p.acct.Close()
 			}
 			return ctx.Err()
-		case p.gs = <-p.setC:
-			p.logger.Info("guardian set updated",
-				zap.Strings("set", p.gs.KeysAsHexStrings()),
-				zap.Uint32("index", p.gs.Index))
-			p.gst.Set(p.gs)
+		case gs := <-p.setC:
+			p.updateGuardianSet(gs)
 		case k := <-p.msgC:
 			if p.governor != nil {
 				if !p.governor.ProcessMsg(k) {
@@ -221,6 +243,8 @@ func (p *Processor) Run(ctx context.Context) error {
 			p.handleInboundSignedVAAWithQuorum(ctx, m)
 		case <-p.cleanup.C:
 			p.handleCleanup(ctx)
+		case respC := <-p.stateDumpReqC():
+			respC <- p.snapshotState()
 		case <-govTimer.C:
 			if p.governor != nil {
 				toBePublished, err := p.governor.CheckPending()
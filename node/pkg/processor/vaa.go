@@ -38,12 +38,16 @@ func (v *VAA) HandleQuorum(sigs []*vaa.Signature, hash string, p *Processor) {
 		zap.String("bytes", hex.EncodeToString(vaaBytes)),
 		zap.String("message_id", signed.MessageID()))
 
-	if err := p.storeSignedVAA(signed); err != nil {
+	// A VAA which was already submitted is assembled again under the new guardian set of a transition.
+	source := QuorumSourceLocal
+	if p.state.signatures[hash].submitted {
+		source = QuorumSourceTransition
+	}
+	if err := p.storeQuorumVAA(signed, source); err != nil {
 		p.logger.Error("failed to store signed VAA", zap.Error(err))
 	}
 
 	p.broadcastSignedVAA(signed)
-	p.attestationEvents.ReportVAAQuorum(signed)
 	p.state.signatures[hash].submitted = true
 }
 
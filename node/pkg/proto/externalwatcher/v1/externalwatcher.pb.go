@@ -0,0 +1,679 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: externalwatcher/v1/externalwatcher.proto
+
+package externalwatcherv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatcherMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*WatcherMessage_Hello
+	//	*WatcherMessage_Observation
+	//	*WatcherMessage_Height
+	Message isWatcherMessage_Message `protobuf_oneof:"message"`
+}
+
+func (x *WatcherMessage) Reset() {
+	*x = WatcherMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatcherMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatcherMessage) ProtoMessage() {}
+
+func (x *WatcherMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatcherMessage.ProtoReflect.Descriptor instead.
+func (*WatcherMessage) Descriptor() ([]byte, []int) {
+	return file_externalwatcher_v1_externalwatcher_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *WatcherMessage) GetMessage() isWatcherMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *WatcherMessage) GetHello() *Hello {
+	if x, ok := x.GetMessage().(*WatcherMessage_Hello); ok {
+		return x.Hello
+	}
+	return nil
+}
+
+func (x *WatcherMessage) GetObservation() *Observation {
+	if x, ok := x.GetMessage().(*WatcherMessage_Observation); ok {
+		return x.Observation
+	}
+	return nil
+}
+
+func (x *WatcherMessage) GetHeight() *Height {
+	if x, ok := x.GetMessage().(*WatcherMessage_Height); ok {
+		return x.Height
+	}
+	return nil
+}
+
+type isWatcherMessage_Message interface {
+	isWatcherMessage_Message()
+}
+
+type WatcherMessage_Hello struct {
+	Hello *Hello `protobuf:"bytes,1,opt,name=hello,proto3,oneof"`
+}
+
+type WatcherMessage_Observation struct {
+	Observation *Observation `protobuf:"bytes,2,opt,name=observation,proto3,oneof"`
+}
+
+type WatcherMessage_Height struct {
+	Height *Height `protobuf:"bytes,3,opt,name=height,proto3,oneof"`
+}
+
+func (*WatcherMessage_Hello) isWatcherMessage_Message() {}
+
+func (*WatcherMessage_Observation) isWatcherMessage_Message() {}
+
+func (*WatcherMessage_Height) isWatcherMessage_Message() {}
+
+type Hello struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Wormhole chain ID of the chain watched.
+	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Address of the core contract watched, as reported in the heartbeat. Informational only.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (x *Hello) Reset() {
+	*x = Hello{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hello) ProtoMessage() {}
+
+func (x *Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hello.ProtoReflect.Descriptor instead.
+func (*Hello) Descriptor() ([]byte, []int) {
+	return file_externalwatcher_v1_externalwatcher_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Hello) GetChainId() uint32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *Hello) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+// Observation is a message published by the core contract, which the watcher considers final.
+type Observation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Transaction hash or other 32 byte identifier of the transaction, used for reobservation.
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// UNIX time in seconds of the block the message was published in.
+	Timestamp        int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Nonce            uint32 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Sequence         uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ConsistencyLevel uint32 `protobuf:"varint,5,opt,name=consistency_level,json=consistencyLevel,proto3" json:"consistency_level,omitempty"`
+	// 32 byte emitter address.
+	EmitterAddress []byte `protobuf:"bytes,6,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	Payload        []byte `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Set if the message cannot be reobserved.
+	Unreliable bool `protobuf:"varint,8,opt,name=unreliable,proto3" json:"unreliable,omitempty"`
+}
+
+func (x *Observation) Reset() {
+	*x = Observation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Observation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Observation) ProtoMessage() {}
+
+func (x *Observation) ProtoReflect() protoreflect.Message {
+	mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Observation.ProtoReflect.Descriptor instead.
+func (*Observation) Descriptor() ([]byte, []int) {
+	return file_externalwatcher_v1_externalwatcher_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Observation) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *Observation) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Observation) GetNonce() uint32 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *Observation) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Observation) GetConsistencyLevel() uint32 {
+	if x != nil {
+		return x.ConsistencyLevel
+	}
+	return 0
+}
+
+func (x *Observation) GetEmitterAddress() []byte {
+	if x != nil {
+		return x.EmitterAddress
+	}
+	return nil
+}
+
+func (x *Observation) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Observation) GetUnreliable() bool {
+	if x != nil {
+		return x.Unreliable
+	}
+	return false
+}
+
+// Height reports the block heights of the chain. The safe and finalized heights are zero if they are not applicable to
+// the chain, and equal to the latest height if it has instant finality.
+type Height struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Latest block height.
+	Height          int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	SafeHeight      int64 `protobuf:"varint,2,opt,name=safe_height,json=safeHeight,proto3" json:"safe_height,omitempty"`
+	FinalizedHeight int64 `protobuf:"varint,3,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+}
+
+func (x *Height) Reset() {
+	*x = Height{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Height) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Height) ProtoMessage() {}
+
+func (x *Height) ProtoReflect() protoreflect.Message {
+	mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Height.ProtoReflect.Descriptor instead.
+func (*Height) Descriptor() ([]byte, []int) {
+	return file_externalwatcher_v1_externalwatcher_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Height) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Height) GetSafeHeight() int64 {
+	if x != nil {
+		return x.SafeHeight
+	}
+	return 0
+}
+
+func (x *Height) GetFinalizedHeight() int64 {
+	if x != nil {
+		return x.FinalizedHeight
+	}
+	return 0
+}
+
+type GuardianMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//
+	//	*GuardianMessage_ObservationRequest
+	Message isGuardianMessage_Message `protobuf_oneof:"message"`
+}
+
+func (x *GuardianMessage) Reset() {
+	*x = GuardianMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardianMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianMessage) ProtoMessage() {}
+
+func (x *GuardianMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianMessage.ProtoReflect.Descriptor instead.
+func (*GuardianMessage) Descriptor() ([]byte, []int) {
+	return file_externalwatcher_v1_externalwatcher_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *GuardianMessage) GetMessage() isGuardianMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *GuardianMessage) GetObservationRequest() *ObservationRequest {
+	if x, ok := x.GetMessage().(*GuardianMessage_ObservationRequest); ok {
+		return x.ObservationRequest
+	}
+	return nil
+}
+
+type isGuardianMessage_Message interface {
+	isGuardianMessage_Message()
+}
+
+type GuardianMessage_ObservationRequest struct {
+	ObservationRequest *ObservationRequest `protobuf:"bytes,1,opt,name=observation_request,json=observationRequest,proto3,oneof"`
+}
+
+func (*GuardianMessage_ObservationRequest) isGuardianMessage_Message() {}
+
+// ObservationRequest asks the watcher to look up the messages published by a transaction again and to send them as
+// observations.
+type ObservationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *ObservationRequest) Reset() {
+	*x = ObservationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObservationRequest) ProtoMessage() {}
+
+func (x *ObservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_externalwatcher_v1_externalwatcher_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObservationRequest.ProtoReflect.Descriptor instead.
+func (*ObservationRequest) Descriptor() ([]byte, []int) {
+	return file_externalwatcher_v1_externalwatcher_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ObservationRequest) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+var File_externalwatcher_v1_externalwatcher_proto protoreflect.FileDescriptor
+
+var file_externalwatcher_v1_externalwatcher_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0xc9,
+	0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x31, 0x0a, 0x05, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x68,
+	0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x43, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4d, 0x0a, 0x05, 0x48, 0x65,
+	0x6c, 0x6c, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x0b, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0x6c, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x61, 0x66, 0x65, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x77, 0x0a, 0x0f, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x59, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x32, 0x70, 0x0a, 0x16, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x56, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x22, 0x2e,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x23, 0x2e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x75, 0x73, 0x6f,
+	0x6e, 0x65, 0x2f, 0x77, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_externalwatcher_v1_externalwatcher_proto_rawDescOnce sync.Once
+	file_externalwatcher_v1_externalwatcher_proto_rawDescData = file_externalwatcher_v1_externalwatcher_proto_rawDesc
+)
+
+func file_externalwatcher_v1_externalwatcher_proto_rawDescGZIP() []byte {
+	file_externalwatcher_v1_externalwatcher_proto_rawDescOnce.Do(func() {
+		file_externalwatcher_v1_externalwatcher_proto_rawDescData = protoimpl.X.CompressGZIP(file_externalwatcher_v1_externalwatcher_proto_rawDescData)
+	})
+	return file_externalwatcher_v1_externalwatcher_proto_rawDescData
+}
+
+var file_externalwatcher_v1_externalwatcher_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_externalwatcher_v1_externalwatcher_proto_goTypes = []interface{}{
+	(*WatcherMessage)(nil),     // 0: externalwatcher.v1.WatcherMessage
+	(*Hello)(nil),              // 1: externalwatcher.v1.Hello
+	(*Observation)(nil),        // 2: externalwatcher.v1.Observation
+	(*Height)(nil),             // 3: externalwatcher.v1.Height
+	(*GuardianMessage)(nil),    // 4: externalwatcher.v1.GuardianMessage
+	(*ObservationRequest)(nil), // 5: externalwatcher.v1.ObservationRequest
+}
+var file_externalwatcher_v1_externalwatcher_proto_depIdxs = []int32{
+	1, // 0: externalwatcher.v1.WatcherMessage.hello:type_name -> externalwatcher.v1.Hello
+	2, // 1: externalwatcher.v1.WatcherMessage.observation:type_name -> externalwatcher.v1.Observation
+	3, // 2: externalwatcher.v1.WatcherMessage.height:type_name -> externalwatcher.v1.Height
+	5, // 3: externalwatcher.v1.GuardianMessage.observation_request:type_name -> externalwatcher.v1.ObservationRequest
+	0, // 4: externalwatcher.v1.ExternalWatcherService.Connect:input_type -> externalwatcher.v1.WatcherMessage
+	4, // 5: externalwatcher.v1.ExternalWatcherService.Connect:output_type -> externalwatcher.v1.GuardianMessage
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_externalwatcher_v1_externalwatcher_proto_init() }
+func file_externalwatcher_v1_externalwatcher_proto_init() {
+	if File_externalwatcher_v1_externalwatcher_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_externalwatcher_v1_externalwatcher_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatcherMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_externalwatcher_v1_externalwatcher_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hello); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_externalwatcher_v1_externalwatcher_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_externalwatcher_v1_externalwatcher_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Height); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_externalwatcher_v1_externalwatcher_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardianMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_externalwatcher_v1_externalwatcher_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObservationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_externalwatcher_v1_externalwatcher_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*WatcherMessage_Hello)(nil),
+		(*WatcherMessage_Observation)(nil),
+		(*WatcherMessage_Height)(nil),
+	}
+	file_externalwatcher_v1_externalwatcher_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*GuardianMessage_ObservationRequest)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_externalwatcher_v1_externalwatcher_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_externalwatcher_v1_externalwatcher_proto_goTypes,
+		DependencyIndexes: file_externalwatcher_v1_externalwatcher_proto_depIdxs,
+		MessageInfos:      file_externalwatcher_v1_externalwatcher_proto_msgTypes,
+	}.Build()
+	File_externalwatcher_v1_externalwatcher_proto = out.File
+	file_externalwatcher_v1_externalwatcher_proto_rawDesc = nil
+	file_externalwatcher_v1_externalwatcher_proto_goTypes = nil
+	file_externalwatcher_v1_externalwatcher_proto_depIdxs = nil
+}
@@ -0,0 +1,140 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package externalwatcherv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ExternalWatcherServiceClient is the client API for ExternalWatcherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExternalWatcherServiceClient interface {
+	// Connect opens a stream for a single chain. The first message sent by the watcher must be a Hello naming the chain.
+	// Afterwards, the watcher sends the messages it observes and its block height, and the guardian sends the observation
+	// requests for the chain. Only one watcher can be connected for a chain at a time.
+	Connect(ctx context.Context, opts ...grpc.CallOption) (ExternalWatcherService_ConnectClient, error)
+}
+
+type externalWatcherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExternalWatcherServiceClient(cc grpc.ClientConnInterface) ExternalWatcherServiceClient {
+	return &externalWatcherServiceClient{cc}
+}
+
+func (c *externalWatcherServiceClient) Connect(ctx context.Context, opts ...grpc.CallOption) (ExternalWatcherService_ConnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExternalWatcherService_ServiceDesc.Streams[0], "/externalwatcher.v1.ExternalWatcherService/Connect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &externalWatcherServiceConnectClient{stream}
+	return x, nil
+}
+
+type ExternalWatcherService_ConnectClient interface {
+	Send(*WatcherMessage) error
+	Recv() (*GuardianMessage, error)
+	grpc.ClientStream
+}
+
+type externalWatcherServiceConnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *externalWatcherServiceConnectClient) Send(m *WatcherMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *externalWatcherServiceConnectClient) Recv() (*GuardianMessage, error) {
+	m := new(GuardianMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExternalWatcherServiceServer is the server API for ExternalWatcherService service.
+// All implementations must embed UnimplementedExternalWatcherServiceServer
+// for forward compatibility
+type ExternalWatcherServiceServer interface {
+	// Connect opens a stream for a single chain. The first message sent by the watcher must be a Hello naming the chain.
+	// Afterwards, the watcher sends the messages it observes and its block height, and the guardian sends the observation
+	// requests for the chain. Only one watcher can be connected for a chain at a time.
+	Connect(ExternalWatcherService_ConnectServer) error
+	mustEmbedUnimplementedExternalWatcherServiceServer()
+}
+
+// UnimplementedExternalWatcherServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExternalWatcherServiceServer struct {
+}
+
+func (UnimplementedExternalWatcherServiceServer) Connect(ExternalWatcherService_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedExternalWatcherServiceServer) mustEmbedUnimplementedExternalWatcherServiceServer() {
+}
+
+// UnsafeExternalWatcherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExternalWatcherServiceServer will
+// result in compilation errors.
+type UnsafeExternalWatcherServiceServer interface {
+	mustEmbedUnimplementedExternalWatcherServiceServer()
+}
+
+func RegisterExternalWatcherServiceServer(s grpc.ServiceRegistrar, srv ExternalWatcherServiceServer) {
+	s.RegisterService(&ExternalWatcherService_ServiceDesc, srv)
+}
+
+func _ExternalWatcherService_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExternalWatcherServiceServer).Connect(&externalWatcherServiceConnectServer{stream})
+}
+
+type ExternalWatcherService_ConnectServer interface {
+	Send(*GuardianMessage) error
+	Recv() (*WatcherMessage, error)
+	grpc.ServerStream
+}
+
+type externalWatcherServiceConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *externalWatcherServiceConnectServer) Send(m *GuardianMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *externalWatcherServiceConnectServer) Recv() (*WatcherMessage, error) {
+	m := new(WatcherMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExternalWatcherService_ServiceDesc is the grpc.ServiceDesc for ExternalWatcherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExternalWatcherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "externalwatcher.v1.ExternalWatcherService",
+	HandlerType: (*ExternalWatcherServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _ExternalWatcherService_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "externalwatcher/v1/externalwatcher.proto",
+}
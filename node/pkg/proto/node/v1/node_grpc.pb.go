@@ -50,6 +50,47 @@ type NodePrivilegedServiceClient interface {
 	SignExistingVAA(ctx context.Context, in *SignExistingVAARequest, opts ...grpc.CallOption) (*SignExistingVAAResponse, error)
 	// DumpRPCs returns the RPCs being used by the guardian
 	DumpRPCs(ctx context.Context, in *DumpRPCsRequest, opts ...grpc.CallOption) (*DumpRPCsResponse, error)
+	// GetAuditLog returns the most recent entries of the admin RPC audit log, newest first.
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	// AccountantStatus lists the transfers pending in the accountant, along with the state the accountant
+	// contract on wormchain reports for each of them.
+	AccountantStatus(ctx context.Context, in *AccountantStatusRequest, opts ...grpc.CallOption) (*AccountantStatusResponse, error)
+	// AccountantModifications lists the balance modifications made on the accountant contract by governance, as
+	// mirrored by this guardian.
+	AccountantModifications(ctx context.Context, in *AccountantModificationsRequest, opts ...grpc.CallOption) (*AccountantModificationsResponse, error)
+	// GetMessageDigestConflicts returns the most recent messages the node refused to sign because a message with
+	// the same emitter chain, emitter address and sequence but a different digest was observed before, newest first.
+	GetMessageDigestConflicts(ctx context.Context, in *GetMessageDigestConflictsRequest, opts ...grpc.CallOption) (*GetMessageDigestConflictsResponse, error)
+	// DumpState writes a JSON snapshot of the processor state, the governor queues and the watcher heights to a file
+	// in the node's data directory, for support cases.
+	DumpState(ctx context.Context, in *DumpStateRequest, opts ...grpc.CallOption) (*DumpStateResponse, error)
+	// RefetchSignedVAA replaces a locally stored, possibly corrupted, signed VAA with a copy fetched from the public
+	// RPC of other guardians. The fetched VAA is verified against the current guardian set, and then overwrites the
+	// local copy in a single write, so the local copy is kept if the fetched VAA is invalid or cannot be stored.
+	RefetchSignedVAA(ctx context.Context, in *RefetchSignedVAARequest, opts ...grpc.CallOption) (*RefetchSignedVAAResponse, error)
+	// InjectSignedVAA stores a VAA with quorum of the current guardian set, or of the previous one during a guardian
+	// set transition, for instance a governance VAA the node missed while it was down, through the same path as signed
+	// VAAs received via gossip. It can optionally be broadcast to the gossip network as well, for the other nodes which
+	// missed it.
+	InjectSignedVAA(ctx context.Context, in *InjectSignedVAARequest, opts ...grpc.CallOption) (*InjectSignedVAAResponse, error)
+	// IbcChannelMap returns the IBC channel ID to Wormhole chain ID mapping used by the IBC watcher, as queried from
+	// the contract on wormchain.
+	IbcChannelMap(ctx context.Context, in *IbcChannelMapRequest, opts ...grpc.CallOption) (*IbcChannelMapResponse, error)
+	// RestartWatcher tears down and restarts the watchers of a single chain, for instance when its RPC endpoint wedges,
+	// without restarting the whole node.
+	RestartWatcher(ctx context.Context, in *RestartWatcherRequest, opts ...grpc.CallOption) (*RestartWatcherResponse, error)
+	// GetPeerStatus summarizes the most recent heartbeats this node received from each guardian of the current
+	// guardian set, to diagnose p2p partitions where only some guardians see each other.
+	GetPeerStatus(ctx context.Context, in *GetPeerStatusRequest, opts ...grpc.CallOption) (*GetPeerStatusResponse, error)
+	// ListQuarantinedVAAs lists the signed VAA entries moved to the quarantine by the database integrity check at boot.
+	ListQuarantinedVAAs(ctx context.Context, in *ListQuarantinedVAAsRequest, opts ...grpc.CallOption) (*ListQuarantinedVAAsResponse, error)
+	// SetLogLevel changes the log level of a single component, like a watcher or the processor, until the node restarts.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// GetLogLevels returns the default log level and the components whose log level was changed.
+	GetLogLevels(ctx context.Context, in *GetLogLevelsRequest, opts ...grpc.CallOption) (*GetLogLevelsResponse, error)
+	// BackupDB streams a backup of the database as of the time of the call, while the node keeps running.
+	// The backup is not encrypted, even if the database is.
+	BackupDB(ctx context.Context, in *BackupDBRequest, opts ...grpc.CallOption) (NodePrivilegedService_BackupDBClient, error)
 }
 
 type nodePrivilegedServiceClient struct {
@@ -159,6 +200,155 @@ func (c *nodePrivilegedServiceClient) DumpRPCs(ctx context.Context, in *DumpRPCs
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantStatus(ctx context.Context, in *AccountantStatusRequest, opts ...grpc.CallOption) (*AccountantStatusResponse, error) {
+	out := new(AccountantStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantModifications(ctx context.Context, in *AccountantModificationsRequest, opts ...grpc.CallOption) (*AccountantModificationsResponse, error) {
+	out := new(AccountantModificationsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantModifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetMessageDigestConflicts(ctx context.Context, in *GetMessageDigestConflictsRequest, opts ...grpc.CallOption) (*GetMessageDigestConflictsResponse, error) {
+	out := new(GetMessageDigestConflictsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetMessageDigestConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) DumpState(ctx context.Context, in *DumpStateRequest, opts ...grpc.CallOption) (*DumpStateResponse, error) {
+	out := new(DumpStateResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/DumpState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) RefetchSignedVAA(ctx context.Context, in *RefetchSignedVAARequest, opts ...grpc.CallOption) (*RefetchSignedVAAResponse, error) {
+	out := new(RefetchSignedVAAResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/RefetchSignedVAA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) InjectSignedVAA(ctx context.Context, in *InjectSignedVAARequest, opts ...grpc.CallOption) (*InjectSignedVAAResponse, error) {
+	out := new(InjectSignedVAAResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/InjectSignedVAA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) IbcChannelMap(ctx context.Context, in *IbcChannelMapRequest, opts ...grpc.CallOption) (*IbcChannelMapResponse, error) {
+	out := new(IbcChannelMapResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/IbcChannelMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) RestartWatcher(ctx context.Context, in *RestartWatcherRequest, opts ...grpc.CallOption) (*RestartWatcherResponse, error) {
+	out := new(RestartWatcherResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/RestartWatcher", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetPeerStatus(ctx context.Context, in *GetPeerStatusRequest, opts ...grpc.CallOption) (*GetPeerStatusResponse, error) {
+	out := new(GetPeerStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetPeerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ListQuarantinedVAAs(ctx context.Context, in *ListQuarantinedVAAsRequest, opts ...grpc.CallOption) (*ListQuarantinedVAAsResponse, error) {
+	out := new(ListQuarantinedVAAsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ListQuarantinedVAAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetLogLevels(ctx context.Context, in *GetLogLevelsRequest, opts ...grpc.CallOption) (*GetLogLevelsResponse, error) {
+	out := new(GetLogLevelsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) BackupDB(ctx context.Context, in *BackupDBRequest, opts ...grpc.CallOption) (NodePrivilegedService_BackupDBClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodePrivilegedService_ServiceDesc.Streams[0], "/node.v1.NodePrivilegedService/BackupDB", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodePrivilegedServiceBackupDBClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodePrivilegedService_BackupDBClient interface {
+	Recv() (*BackupDBResponse, error)
+	grpc.ClientStream
+}
+
+type nodePrivilegedServiceBackupDBClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodePrivilegedServiceBackupDBClient) Recv() (*BackupDBResponse, error) {
+	m := new(BackupDBResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // NodePrivilegedServiceServer is the server API for NodePrivilegedService service.
 // All implementations must embed UnimplementedNodePrivilegedServiceServer
 // for forward compatibility
@@ -195,6 +385,47 @@ type NodePrivilegedServiceServer interface {
 	SignExistingVAA(context.Context, *SignExistingVAARequest) (*SignExistingVAAResponse, error)
 	// DumpRPCs returns the RPCs being used by the guardian
 	DumpRPCs(context.Context, *DumpRPCsRequest) (*DumpRPCsResponse, error)
+	// GetAuditLog returns the most recent entries of the admin RPC audit log, newest first.
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	// AccountantStatus lists the transfers pending in the accountant, along with the state the accountant
+	// contract on wormchain reports for each of them.
+	AccountantStatus(context.Context, *AccountantStatusRequest) (*AccountantStatusResponse, error)
+	// AccountantModifications lists the balance modifications made on the accountant contract by governance, as
+	// mirrored by this guardian.
+	AccountantModifications(context.Context, *AccountantModificationsRequest) (*AccountantModificationsResponse, error)
+	// GetMessageDigestConflicts returns the most recent messages the node refused to sign because a message with
+	// the same emitter chain, emitter address and sequence but a different digest was observed before, newest first.
+	GetMessageDigestConflicts(context.Context, *GetMessageDigestConflictsRequest) (*GetMessageDigestConflictsResponse, error)
+	// DumpState writes a JSON snapshot of the processor state, the governor queues and the watcher heights to a file
+	// in the node's data directory, for support cases.
+	DumpState(context.Context, *DumpStateRequest) (*DumpStateResponse, error)
+	// RefetchSignedVAA replaces a locally stored, possibly corrupted, signed VAA with a copy fetched from the public
+	// RPC of other guardians. The fetched VAA is verified against the current guardian set, and then overwrites the
+	// local copy in a single write, so the local copy is kept if the fetched VAA is invalid or cannot be stored.
+	RefetchSignedVAA(context.Context, *RefetchSignedVAARequest) (*RefetchSignedVAAResponse, error)
+	// InjectSignedVAA stores a VAA with quorum of the current guardian set, or of the previous one during a guardian
+	// set transition, for instance a governance VAA the node missed while it was down, through the same path as signed
+	// VAAs received via gossip. It can optionally be broadcast to the gossip network as well, for the other nodes which
+	// missed it.
+	InjectSignedVAA(context.Context, *InjectSignedVAARequest) (*InjectSignedVAAResponse, error)
+	// IbcChannelMap returns the IBC channel ID to Wormhole chain ID mapping used by the IBC watcher, as queried from
+	// the contract on wormchain.
+	IbcChannelMap(context.Context, *IbcChannelMapRequest) (*IbcChannelMapResponse, error)
+	// RestartWatcher tears down and restarts the watchers of a single chain, for instance when its RPC endpoint wedges,
+	// without restarting the whole node.
+	RestartWatcher(context.Context, *RestartWatcherRequest) (*RestartWatcherResponse, error)
+	// GetPeerStatus summarizes the most recent heartbeats this node received from each guardian of the current
+	// guardian set, to diagnose p2p partitions where only some guardians see each other.
+	GetPeerStatus(context.Context, *GetPeerStatusRequest) (*GetPeerStatusResponse, error)
+	// ListQuarantinedVAAs lists the signed VAA entries moved to the quarantine by the database integrity check at boot.
+	ListQuarantinedVAAs(context.Context, *ListQuarantinedVAAsRequest) (*ListQuarantinedVAAsResponse, error)
+	// SetLogLevel changes the log level of a single component, like a watcher or the processor, until the node restarts.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// GetLogLevels returns the default log level and the components whose log level was changed.
+	GetLogLevels(context.Context, *GetLogLevelsRequest) (*GetLogLevelsResponse, error)
+	// BackupDB streams a backup of the database as of the time of the call, while the node keeps running.
+	// The backup is not encrypted, even if the database is.
+	BackupDB(*BackupDBRequest, NodePrivilegedService_BackupDBServer) error
 	mustEmbedUnimplementedNodePrivilegedServiceServer()
 }
 
@@ -235,6 +466,48 @@ func (UnimplementedNodePrivilegedServiceServer) SignExistingVAA(context.Context,
 func (UnimplementedNodePrivilegedServiceServer) DumpRPCs(context.Context, *DumpRPCsRequest) (*DumpRPCsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DumpRPCs not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantStatus(context.Context, *AccountantStatusRequest) (*AccountantStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantStatus not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantModifications(context.Context, *AccountantModificationsRequest) (*AccountantModificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantModifications not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetMessageDigestConflicts(context.Context, *GetMessageDigestConflictsRequest) (*GetMessageDigestConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageDigestConflicts not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) DumpState(context.Context, *DumpStateRequest) (*DumpStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpState not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) RefetchSignedVAA(context.Context, *RefetchSignedVAARequest) (*RefetchSignedVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefetchSignedVAA not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) InjectSignedVAA(context.Context, *InjectSignedVAARequest) (*InjectSignedVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectSignedVAA not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) IbcChannelMap(context.Context, *IbcChannelMapRequest) (*IbcChannelMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IbcChannelMap not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) RestartWatcher(context.Context, *RestartWatcherRequest) (*RestartWatcherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartWatcher not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetPeerStatus(context.Context, *GetPeerStatusRequest) (*GetPeerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerStatus not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ListQuarantinedVAAs(context.Context, *ListQuarantinedVAAsRequest) (*ListQuarantinedVAAsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedVAAs not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetLogLevels(context.Context, *GetLogLevelsRequest) (*GetLogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevels not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) BackupDB(*BackupDBRequest, NodePrivilegedService_BackupDBServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackupDB not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) mustEmbedUnimplementedNodePrivilegedServiceServer() {}
 
 // UnsafeNodePrivilegedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -446,6 +719,261 @@ func _NodePrivilegedService_DumpRPCs_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantStatus(ctx, req.(*AccountantStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantModifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantModificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantModifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantModifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantModifications(ctx, req.(*AccountantModificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetMessageDigestConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageDigestConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetMessageDigestConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetMessageDigestConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetMessageDigestConflicts(ctx, req.(*GetMessageDigestConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_DumpState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).DumpState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/DumpState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).DumpState(ctx, req.(*DumpStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_RefetchSignedVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefetchSignedVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).RefetchSignedVAA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/RefetchSignedVAA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).RefetchSignedVAA(ctx, req.(*RefetchSignedVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_InjectSignedVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InjectSignedVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).InjectSignedVAA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/InjectSignedVAA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).InjectSignedVAA(ctx, req.(*InjectSignedVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_IbcChannelMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IbcChannelMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).IbcChannelMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/IbcChannelMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).IbcChannelMap(ctx, req.(*IbcChannelMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_RestartWatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartWatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).RestartWatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/RestartWatcher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).RestartWatcher(ctx, req.(*RestartWatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetPeerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetPeerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetPeerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetPeerStatus(ctx, req.(*GetPeerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ListQuarantinedVAAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedVAAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ListQuarantinedVAAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ListQuarantinedVAAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ListQuarantinedVAAs(ctx, req.(*ListQuarantinedVAAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetLogLevels(ctx, req.(*GetLogLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_BackupDB_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupDBRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodePrivilegedServiceServer).BackupDB(m, &nodePrivilegedServiceBackupDBServer{stream})
+}
+
+type NodePrivilegedService_BackupDBServer interface {
+	Send(*BackupDBResponse) error
+	grpc.ServerStream
+}
+
+type nodePrivilegedServiceBackupDBServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodePrivilegedServiceBackupDBServer) Send(m *BackupDBResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // NodePrivilegedService_ServiceDesc is the grpc.ServiceDesc for NodePrivilegedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,7 +1025,65 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DumpRPCs",
 			Handler:    _NodePrivilegedService_DumpRPCs_Handler,
 		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _NodePrivilegedService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "AccountantStatus",
+			Handler:    _NodePrivilegedService_AccountantStatus_Handler,
+		},
+		{
+			MethodName: "AccountantModifications",
+			Handler:    _NodePrivilegedService_AccountantModifications_Handler,
+		},
+		{
+			MethodName: "GetMessageDigestConflicts",
+			Handler:    _NodePrivilegedService_GetMessageDigestConflicts_Handler,
+		},
+		{
+			MethodName: "DumpState",
+			Handler:    _NodePrivilegedService_DumpState_Handler,
+		},
+		{
+			MethodName: "RefetchSignedVAA",
+			Handler:    _NodePrivilegedService_RefetchSignedVAA_Handler,
+		},
+		{
+			MethodName: "InjectSignedVAA",
+			Handler:    _NodePrivilegedService_InjectSignedVAA_Handler,
+		},
+		{
+			MethodName: "IbcChannelMap",
+			Handler:    _NodePrivilegedService_IbcChannelMap_Handler,
+		},
+		{
+			MethodName: "RestartWatcher",
+			Handler:    _NodePrivilegedService_RestartWatcher_Handler,
+		},
+		{
+			MethodName: "GetPeerStatus",
+			Handler:    _NodePrivilegedService_GetPeerStatus_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedVAAs",
+			Handler:    _NodePrivilegedService_ListQuarantinedVAAs_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _NodePrivilegedService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetLogLevels",
+			Handler:    _NodePrivilegedService_GetLogLevels_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BackupDB",
+			Handler:       _NodePrivilegedService_BackupDB_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "node/v1/node.proto",
 }
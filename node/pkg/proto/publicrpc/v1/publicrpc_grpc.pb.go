@@ -23,8 +23,14 @@ type PublicRPCServiceClient interface {
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(ctx context.Context, in *GetLastHeartbeatsRequest, opts ...grpc.CallOption) (*GetLastHeartbeatsResponse, error)
 	GetSignedVAA(ctx context.Context, in *GetSignedVAARequest, opts ...grpc.CallOption) (*GetSignedVAAResponse, error)
+	// GetSignedVAAs returns the signed VAAs of up to 100 message IDs in one request. VAAs that are not
+	// available do not fail the whole request: their entry is returned with empty vaa_bytes.
+	GetSignedVAAs(ctx context.Context, in *GetSignedVAAsRequest, opts ...grpc.CallOption) (*GetSignedVAAsResponse, error)
 	GetSignedBatchVAA(ctx context.Context, in *GetSignedBatchVAARequest, opts ...grpc.CallOption) (*GetSignedBatchVAAResponse, error)
 	GetCurrentGuardianSet(ctx context.Context, in *GetCurrentGuardianSetRequest, opts ...grpc.CallOption) (*GetCurrentGuardianSetResponse, error)
+	// GetGuardianSetMetadata returns the metadata advertised in the most recent heartbeats
+	// of the nodes in the current guardian set (version, feature flags and enabled chains).
+	GetGuardianSetMetadata(ctx context.Context, in *GetGuardianSetMetadataRequest, opts ...grpc.CallOption) (*GetGuardianSetMetadataResponse, error)
 	GovernorGetAvailableNotionalByChain(ctx context.Context, in *GovernorGetAvailableNotionalByChainRequest, opts ...grpc.CallOption) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(ctx context.Context, in *GovernorGetEnqueuedVAAsRequest, opts ...grpc.CallOption) (*GovernorGetEnqueuedVAAsResponse, error)
 	GovernorIsVAAEnqueued(ctx context.Context, in *GovernorIsVAAEnqueuedRequest, opts ...grpc.CallOption) (*GovernorIsVAAEnqueuedResponse, error)
@@ -57,6 +63,15 @@ func (c *publicRPCServiceClient) GetSignedVAA(ctx context.Context, in *GetSigned
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) GetSignedVAAs(ctx context.Context, in *GetSignedVAAsRequest, opts ...grpc.CallOption) (*GetSignedVAAsResponse, error) {
+	out := new(GetSignedVAAsResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedVAAs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicRPCServiceClient) GetSignedBatchVAA(ctx context.Context, in *GetSignedBatchVAARequest, opts ...grpc.CallOption) (*GetSignedBatchVAAResponse, error) {
 	out := new(GetSignedBatchVAAResponse)
 	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedBatchVAA", in, out, opts...)
@@ -75,6 +90,15 @@ func (c *publicRPCServiceClient) GetCurrentGuardianSet(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) GetGuardianSetMetadata(ctx context.Context, in *GetGuardianSetMetadataRequest, opts ...grpc.CallOption) (*GetGuardianSetMetadataResponse, error) {
+	out := new(GetGuardianSetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetGuardianSetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicRPCServiceClient) GovernorGetAvailableNotionalByChain(ctx context.Context, in *GovernorGetAvailableNotionalByChainRequest, opts ...grpc.CallOption) (*GovernorGetAvailableNotionalByChainResponse, error) {
 	out := new(GovernorGetAvailableNotionalByChainResponse)
 	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GovernorGetAvailableNotionalByChain", in, out, opts...)
@@ -120,8 +144,14 @@ type PublicRPCServiceServer interface {
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(context.Context, *GetLastHeartbeatsRequest) (*GetLastHeartbeatsResponse, error)
 	GetSignedVAA(context.Context, *GetSignedVAARequest) (*GetSignedVAAResponse, error)
+	// GetSignedVAAs returns the signed VAAs of up to 100 message IDs in one request. VAAs that are not
+	// available do not fail the whole request: their entry is returned with empty vaa_bytes.
+	GetSignedVAAs(context.Context, *GetSignedVAAsRequest) (*GetSignedVAAsResponse, error)
 	GetSignedBatchVAA(context.Context, *GetSignedBatchVAARequest) (*GetSignedBatchVAAResponse, error)
 	GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error)
+	// GetGuardianSetMetadata returns the metadata advertised in the most recent heartbeats
+	// of the nodes in the current guardian set (version, feature flags and enabled chains).
+	GetGuardianSetMetadata(context.Context, *GetGuardianSetMetadataRequest) (*GetGuardianSetMetadataResponse, error)
 	GovernorGetAvailableNotionalByChain(context.Context, *GovernorGetAvailableNotionalByChainRequest) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(context.Context, *GovernorGetEnqueuedVAAsRequest) (*GovernorGetEnqueuedVAAsResponse, error)
 	GovernorIsVAAEnqueued(context.Context, *GovernorIsVAAEnqueuedRequest) (*GovernorIsVAAEnqueuedResponse, error)
@@ -139,12 +169,18 @@ func (UnimplementedPublicRPCServiceServer) GetLastHeartbeats(context.Context, *G
 func (UnimplementedPublicRPCServiceServer) GetSignedVAA(context.Context, *GetSignedVAARequest) (*GetSignedVAAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAA not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) GetSignedVAAs(context.Context, *GetSignedVAAsRequest) (*GetSignedVAAsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAAs not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) GetSignedBatchVAA(context.Context, *GetSignedBatchVAARequest) (*GetSignedBatchVAAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSignedBatchVAA not implemented")
 }
 func (UnimplementedPublicRPCServiceServer) GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentGuardianSet not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) GetGuardianSetMetadata(context.Context, *GetGuardianSetMetadataRequest) (*GetGuardianSetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianSetMetadata not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) GovernorGetAvailableNotionalByChain(context.Context, *GovernorGetAvailableNotionalByChainRequest) (*GovernorGetAvailableNotionalByChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovernorGetAvailableNotionalByChain not implemented")
 }
@@ -206,6 +242,24 @@ func _PublicRPCService_GetSignedVAA_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_GetSignedVAAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignedVAAsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetSignedVAAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetSignedVAAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetSignedVAAs(ctx, req.(*GetSignedVAAsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicRPCService_GetSignedBatchVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSignedBatchVAARequest)
 	if err := dec(in); err != nil {
@@ -242,6 +296,24 @@ func _PublicRPCService_GetCurrentGuardianSet_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_GetGuardianSetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianSetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetGuardianSetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetGuardianSetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetGuardianSetMetadata(ctx, req.(*GetGuardianSetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicRPCService_GovernorGetAvailableNotionalByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GovernorGetAvailableNotionalByChainRequest)
 	if err := dec(in); err != nil {
@@ -329,6 +401,10 @@ var PublicRPCService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSignedVAA",
 			Handler:    _PublicRPCService_GetSignedVAA_Handler,
 		},
+		{
+			MethodName: "GetSignedVAAs",
+			Handler:    _PublicRPCService_GetSignedVAAs_Handler,
+		},
 		{
 			MethodName: "GetSignedBatchVAA",
 			Handler:    _PublicRPCService_GetSignedBatchVAA_Handler,
@@ -337,6 +413,10 @@ var PublicRPCService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrentGuardianSet",
 			Handler:    _PublicRPCService_GetCurrentGuardianSet_Handler,
 		},
+		{
+			MethodName: "GetGuardianSetMetadata",
+			Handler:    _PublicRPCService_GetGuardianSetMetadata_Handler,
+		},
 		{
 			MethodName: "GovernorGetAvailableNotionalByChain",
 			Handler:    _PublicRPCService_GovernorGetAvailableNotionalByChain_Handler,
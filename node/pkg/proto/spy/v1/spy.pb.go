@@ -33,6 +33,10 @@ type EmitterFilter struct {
 	ChainId v1.ChainID `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3,enum=publicrpc.v1.ChainID" json:"chain_id,omitempty"`
 	// Hex-encoded (without leading 0x) emitter address.
 	EmitterAddress string `protobuf:"bytes,2,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	// If set, signed VAAs from this emitter cached by the spy are replayed before live VAAs.
+	// Requires the spy to run with a VAA cache. Like GetCachedVAA, replayed VAAs may be forged or missing unless the
+	// spy runs with --vaaCacheGuardianSet.
+	ResumeFrom *ResumeFrom `protobuf:"bytes,3,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
 }
 
 func (x *EmitterFilter) Reset() {
@@ -81,6 +85,61 @@ func (x *EmitterFilter) GetEmitterAddress() string {
 	return ""
 }
 
+func (x *EmitterFilter) GetResumeFrom() *ResumeFrom {
+	if x != nil {
+		return x.ResumeFrom
+	}
+	return nil
+}
+
+type ResumeFrom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence of the first VAA to replay. Clients typically pass the sequence after the last VAA they processed.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *ResumeFrom) Reset() {
+	*x = ResumeFrom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeFrom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeFrom) ProtoMessage() {}
+
+func (x *ResumeFrom) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeFrom.ProtoReflect.Descriptor instead.
+func (*ResumeFrom) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResumeFrom) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 type BatchFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -97,7 +156,7 @@ type BatchFilter struct {
 func (x *BatchFilter) Reset() {
 	*x = BatchFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[1]
+		mi := &file_spy_v1_spy_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -110,7 +169,7 @@ func (x *BatchFilter) String() string {
 func (*BatchFilter) ProtoMessage() {}
 
 func (x *BatchFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[1]
+	mi := &file_spy_v1_spy_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +182,7 @@ func (x *BatchFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchFilter.ProtoReflect.Descriptor instead.
 func (*BatchFilter) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{1}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BatchFilter) GetChainId() v1.ChainID {
@@ -161,7 +220,7 @@ type BatchTransactionFilter struct {
 func (x *BatchTransactionFilter) Reset() {
 	*x = BatchTransactionFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[2]
+		mi := &file_spy_v1_spy_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -174,7 +233,7 @@ func (x *BatchTransactionFilter) String() string {
 func (*BatchTransactionFilter) ProtoMessage() {}
 
 func (x *BatchTransactionFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[2]
+	mi := &file_spy_v1_spy_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,7 +246,7 @@ func (x *BatchTransactionFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchTransactionFilter.ProtoReflect.Descriptor instead.
 func (*BatchTransactionFilter) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{2}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BatchTransactionFilter) GetChainId() v1.ChainID {
@@ -220,7 +279,7 @@ type FilterEntry struct {
 func (x *FilterEntry) Reset() {
 	*x = FilterEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[3]
+		mi := &file_spy_v1_spy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +292,7 @@ func (x *FilterEntry) String() string {
 func (*FilterEntry) ProtoMessage() {}
 
 func (x *FilterEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[3]
+	mi := &file_spy_v1_spy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +305,7 @@ func (x *FilterEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterEntry.ProtoReflect.Descriptor instead.
 func (*FilterEntry) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{4}
 }
 
 func (m *FilterEntry) GetFilter() isFilterEntry_Filter {
@@ -312,7 +371,7 @@ type SubscribeSignedVAARequest struct {
 func (x *SubscribeSignedVAARequest) Reset() {
 	*x = SubscribeSignedVAARequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[4]
+		mi := &file_spy_v1_spy_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -325,7 +384,7 @@ func (x *SubscribeSignedVAARequest) String() string {
 func (*SubscribeSignedVAARequest) ProtoMessage() {}
 
 func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[4]
+	mi := &file_spy_v1_spy_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +397,7 @@ func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAARequest.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAARequest) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{4}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SubscribeSignedVAARequest) GetFilters() []*FilterEntry {
@@ -361,7 +420,7 @@ type SubscribeSignedVAAByTypeRequest struct {
 func (x *SubscribeSignedVAAByTypeRequest) Reset() {
 	*x = SubscribeSignedVAAByTypeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[5]
+		mi := &file_spy_v1_spy_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -374,7 +433,7 @@ func (x *SubscribeSignedVAAByTypeRequest) String() string {
 func (*SubscribeSignedVAAByTypeRequest) ProtoMessage() {}
 
 func (x *SubscribeSignedVAAByTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[5]
+	mi := &file_spy_v1_spy_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +446,7 @@ func (x *SubscribeSignedVAAByTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAAByTypeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAAByTypeRequest) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{5}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SubscribeSignedVAAByTypeRequest) GetFilters() []*FilterEntry {
@@ -409,7 +468,7 @@ type SubscribeSignedVAAResponse struct {
 func (x *SubscribeSignedVAAResponse) Reset() {
 	*x = SubscribeSignedVAAResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[6]
+		mi := &file_spy_v1_spy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +481,7 @@ func (x *SubscribeSignedVAAResponse) String() string {
 func (*SubscribeSignedVAAResponse) ProtoMessage() {}
 
 func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[6]
+	mi := &file_spy_v1_spy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +494,7 @@ func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAAResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAAResponse) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{6}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubscribeSignedVAAResponse) GetVaaBytes() []byte {
@@ -460,7 +519,7 @@ type SubscribeSignedVAAByTypeResponse struct {
 func (x *SubscribeSignedVAAByTypeResponse) Reset() {
 	*x = SubscribeSignedVAAByTypeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[7]
+		mi := &file_spy_v1_spy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -473,7 +532,7 @@ func (x *SubscribeSignedVAAByTypeResponse) String() string {
 func (*SubscribeSignedVAAByTypeResponse) ProtoMessage() {}
 
 func (x *SubscribeSignedVAAByTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[7]
+	mi := &file_spy_v1_spy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +545,7 @@ func (x *SubscribeSignedVAAByTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAAByTypeResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAAByTypeResponse) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{7}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *SubscribeSignedVAAByTypeResponse) GetVaaType() isSubscribeSignedVAAByTypeResponse_VaaType {
@@ -527,6 +586,120 @@ func (*SubscribeSignedVAAByTypeResponse_SignedVaa) isSubscribeSignedVAAByTypeRes
 func (*SubscribeSignedVAAByTypeResponse_SignedBatchVaa) isSubscribeSignedVAAByTypeResponse_VaaType() {
 }
 
+type GetCachedVAARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source chain
+	ChainId v1.ChainID `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3,enum=publicrpc.v1.ChainID" json:"chain_id,omitempty"`
+	// Hex-encoded (without leading 0x) emitter address.
+	EmitterAddress string `protobuf:"bytes,2,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	// Sequence of the VAA.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *GetCachedVAARequest) Reset() {
+	*x = GetCachedVAARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCachedVAARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCachedVAARequest) ProtoMessage() {}
+
+func (x *GetCachedVAARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCachedVAARequest.ProtoReflect.Descriptor instead.
+func (*GetCachedVAARequest) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCachedVAARequest) GetChainId() v1.ChainID {
+	if x != nil {
+		return x.ChainId
+	}
+	return v1.ChainID(0)
+}
+
+func (x *GetCachedVAARequest) GetEmitterAddress() string {
+	if x != nil {
+		return x.EmitterAddress
+	}
+	return ""
+}
+
+func (x *GetCachedVAARequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type GetCachedVAAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Raw VAA bytes
+	VaaBytes []byte `protobuf:"bytes,1,opt,name=vaa_bytes,json=vaaBytes,proto3" json:"vaa_bytes,omitempty"`
+}
+
+func (x *GetCachedVAAResponse) Reset() {
+	*x = GetCachedVAAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCachedVAAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCachedVAAResponse) ProtoMessage() {}
+
+func (x *GetCachedVAAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCachedVAAResponse.ProtoReflect.Descriptor instead.
+func (*GetCachedVAAResponse) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCachedVAAResponse) GetVaaBytes() []byte {
+	if x != nil {
+		return x.VaaBytes
+	}
+	return nil
+}
+
 var File_spy_v1_spy_proto protoreflect.FileDescriptor
 
 var file_spy_v1_spy_proto_rawDesc = []byte{
@@ -536,14 +709,20 @@ var file_spy_v1_spy_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70,
 	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6a,
-	0x0a, 0x0d, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x30, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6a, 0x0a, 0x0b, 0x42, 0x61,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f,
+	0x01, 0x0a, 0x0d, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x22, 0x28, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6a, 0x0a, 0x0b, 0x42, 0x61,
 	0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75,
 	0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
@@ -597,26 +776,47 @@ var file_spy_v1_spy_proto_rawDesc = []byte{
 	0x6e, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x56, 0x41, 0x41, 0x57, 0x69, 0x74, 0x68, 0x51,
 	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42,
 	0x61, 0x74, 0x63, 0x68, 0x56, 0x61, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x76, 0x61, 0x61, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x32, 0xb3, 0x02, 0x0a, 0x0d, 0x53, 0x70, 0x79, 0x52, 0x50, 0x43, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x21, 0x2e, 0x73,
-	0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31,
-	0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x5f, 0x76, 0x61, 0x61, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x9c, 0x01, 0x0a, 0x18, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41,
-	0x41, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x56, 0x41, 0x41, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x42, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61, 0x5f, 0x62, 0x79, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x56,
+	0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61,
+	0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76,
+	0x61, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x32, 0xbf, 0x03, 0x0a, 0x0d, 0x53, 0x70, 0x79, 0x52,
+	0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41,
+	0x12, 0x21, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22,
+	0x18, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x9c,
+	0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x56, 0x41, 0x41, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x2e, 0x73, 0x70,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41,
+	0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61,
+	0x5f, 0x62, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x89, 0x01,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x1b,
+	0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x56, 0x41,
+	0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x38, 0x12, 0x36, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x76, 0x61,
+	0x61, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x65, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x75, 0x73, 0x6f, 0x6e,
 	0x65, 0x2f, 0x77, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
 	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x79, 0x2f, 0x76, 0x31,
@@ -635,40 +835,47 @@ func file_spy_v1_spy_proto_rawDescGZIP() []byte {
 	return file_spy_v1_spy_proto_rawDescData
 }
 
-var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_spy_v1_spy_proto_goTypes = []interface{}{
 	(*EmitterFilter)(nil),                    // 0: spy.v1.EmitterFilter
-	(*BatchFilter)(nil),                      // 1: spy.v1.BatchFilter
-	(*BatchTransactionFilter)(nil),           // 2: spy.v1.BatchTransactionFilter
-	(*FilterEntry)(nil),                      // 3: spy.v1.FilterEntry
-	(*SubscribeSignedVAARequest)(nil),        // 4: spy.v1.SubscribeSignedVAARequest
-	(*SubscribeSignedVAAByTypeRequest)(nil),  // 5: spy.v1.SubscribeSignedVAAByTypeRequest
-	(*SubscribeSignedVAAResponse)(nil),       // 6: spy.v1.SubscribeSignedVAAResponse
-	(*SubscribeSignedVAAByTypeResponse)(nil), // 7: spy.v1.SubscribeSignedVAAByTypeResponse
-	(v1.ChainID)(0),                          // 8: publicrpc.v1.ChainID
-	(*v11.SignedVAAWithQuorum)(nil),          // 9: gossip.v1.SignedVAAWithQuorum
-	(*v11.SignedBatchVAAWithQuorum)(nil),     // 10: gossip.v1.SignedBatchVAAWithQuorum
+	(*ResumeFrom)(nil),                       // 1: spy.v1.ResumeFrom
+	(*BatchFilter)(nil),                      // 2: spy.v1.BatchFilter
+	(*BatchTransactionFilter)(nil),           // 3: spy.v1.BatchTransactionFilter
+	(*FilterEntry)(nil),                      // 4: spy.v1.FilterEntry
+	(*SubscribeSignedVAARequest)(nil),        // 5: spy.v1.SubscribeSignedVAARequest
+	(*SubscribeSignedVAAByTypeRequest)(nil),  // 6: spy.v1.SubscribeSignedVAAByTypeRequest
+	(*SubscribeSignedVAAResponse)(nil),       // 7: spy.v1.SubscribeSignedVAAResponse
+	(*SubscribeSignedVAAByTypeResponse)(nil), // 8: spy.v1.SubscribeSignedVAAByTypeResponse
+	(*GetCachedVAARequest)(nil),              // 9: spy.v1.GetCachedVAARequest
+	(*GetCachedVAAResponse)(nil),             // 10: spy.v1.GetCachedVAAResponse
+	(v1.ChainID)(0),                          // 11: publicrpc.v1.ChainID
+	(*v11.SignedVAAWithQuorum)(nil),          // 12: gossip.v1.SignedVAAWithQuorum
+	(*v11.SignedBatchVAAWithQuorum)(nil),     // 13: gossip.v1.SignedBatchVAAWithQuorum
 }
 var file_spy_v1_spy_proto_depIdxs = []int32{
-	8,  // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	8,  // 1: spy.v1.BatchFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	8,  // 2: spy.v1.BatchTransactionFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	0,  // 3: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
-	1,  // 4: spy.v1.FilterEntry.batch_filter:type_name -> spy.v1.BatchFilter
-	2,  // 5: spy.v1.FilterEntry.batch_transaction_filter:type_name -> spy.v1.BatchTransactionFilter
-	3,  // 6: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
-	3,  // 7: spy.v1.SubscribeSignedVAAByTypeRequest.filters:type_name -> spy.v1.FilterEntry
-	9,  // 8: spy.v1.SubscribeSignedVAAByTypeResponse.signed_vaa:type_name -> gossip.v1.SignedVAAWithQuorum
-	10, // 9: spy.v1.SubscribeSignedVAAByTypeResponse.signed_batch_vaa:type_name -> gossip.v1.SignedBatchVAAWithQuorum
-	4,  // 10: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
-	5,  // 11: spy.v1.SpyRPCService.SubscribeSignedVAAByType:input_type -> spy.v1.SubscribeSignedVAAByTypeRequest
-	6,  // 12: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
-	7,  // 13: spy.v1.SpyRPCService.SubscribeSignedVAAByType:output_type -> spy.v1.SubscribeSignedVAAByTypeResponse
-	12, // [12:14] is the sub-list for method output_type
-	10, // [10:12] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	11, // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	1,  // 1: spy.v1.EmitterFilter.resume_from:type_name -> spy.v1.ResumeFrom
+	11, // 2: spy.v1.BatchFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	11, // 3: spy.v1.BatchTransactionFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	0,  // 4: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
+	2,  // 5: spy.v1.FilterEntry.batch_filter:type_name -> spy.v1.BatchFilter
+	3,  // 6: spy.v1.FilterEntry.batch_transaction_filter:type_name -> spy.v1.BatchTransactionFilter
+	4,  // 7: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
+	4,  // 8: spy.v1.SubscribeSignedVAAByTypeRequest.filters:type_name -> spy.v1.FilterEntry
+	12, // 9: spy.v1.SubscribeSignedVAAByTypeResponse.signed_vaa:type_name -> gossip.v1.SignedVAAWithQuorum
+	13, // 10: spy.v1.SubscribeSignedVAAByTypeResponse.signed_batch_vaa:type_name -> gossip.v1.SignedBatchVAAWithQuorum
+	11, // 11: spy.v1.GetCachedVAARequest.chain_id:type_name -> publicrpc.v1.ChainID
+	5,  // 12: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
+	6,  // 13: spy.v1.SpyRPCService.SubscribeSignedVAAByType:input_type -> spy.v1.SubscribeSignedVAAByTypeRequest
+	9,  // 14: spy.v1.SpyRPCService.GetCachedVAA:input_type -> spy.v1.GetCachedVAARequest
+	7,  // 15: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
+	8,  // 16: spy.v1.SpyRPCService.SubscribeSignedVAAByType:output_type -> spy.v1.SubscribeSignedVAAByTypeResponse
+	10, // 17: spy.v1.SpyRPCService.GetCachedVAA:output_type -> spy.v1.GetCachedVAAResponse
+	15, // [15:18] is the sub-list for method output_type
+	12, // [12:15] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_spy_v1_spy_proto_init() }
@@ -690,7 +897,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchFilter); i {
+			switch v := v.(*ResumeFrom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -702,7 +909,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchTransactionFilter); i {
+			switch v := v.(*BatchFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -714,7 +921,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterEntry); i {
+			switch v := v.(*BatchTransactionFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -726,7 +933,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSignedVAARequest); i {
+			switch v := v.(*FilterEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -738,7 +945,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSignedVAAByTypeRequest); i {
+			switch v := v.(*SubscribeSignedVAARequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -750,7 +957,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSignedVAAResponse); i {
+			switch v := v.(*SubscribeSignedVAAByTypeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -762,6 +969,18 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedVAAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeSignedVAAByTypeResponse); i {
 			case 0:
 				return &v.state
@@ -773,13 +992,37 @@ func file_spy_v1_spy_proto_init() {
 				return nil
 			}
 		}
+		file_spy_v1_spy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCachedVAARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCachedVAAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_spy_v1_spy_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_spy_v1_spy_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*FilterEntry_EmitterFilter)(nil),
 		(*FilterEntry_BatchFilter)(nil),
 		(*FilterEntry_BatchTransactionFilter)(nil),
 	}
-	file_spy_v1_spy_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_spy_v1_spy_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*SubscribeSignedVAAByTypeResponse_SignedVaa)(nil),
 		(*SubscribeSignedVAAByTypeResponse_SignedBatchVaa)(nil),
 	}
@@ -789,7 +1032,7 @@ func file_spy_v1_spy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spy_v1_spy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,12 @@ type SpyRPCServiceClient interface {
 	SubscribeSignedVAA(ctx context.Context, in *SubscribeSignedVAARequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedVAAClient, error)
 	// SubscribeSignedBatchVAA returns a stream of signed VAA messages, by type, received on the network.
 	SubscribeSignedVAAByType(ctx context.Context, in *SubscribeSignedVAAByTypeRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedVAAByTypeClient, error)
+	// GetCachedVAA returns a signed VAA from the local cache of the spy, so clients can recover VAAs they missed
+	// without querying a guardian. Requires the spy to run with a VAA cache. Unless the spy runs with
+	// --vaaCacheGuardianSet, the cache holds VAAs as received from any peer: a peer can insert forged VAAs, which are
+	// returned as is, and evict the real VAAs of an emitter by sending forged ones with higher sequences. Clients must
+	// verify the VAAs they get, and may not find the ones that were evicted.
+	GetCachedVAA(ctx context.Context, in *GetCachedVAARequest, opts ...grpc.CallOption) (*GetCachedVAAResponse, error)
 }
 
 type spyRPCServiceClient struct {
@@ -96,6 +102,15 @@ func (x *spyRPCServiceSubscribeSignedVAAByTypeClient) Recv() (*SubscribeSignedVA
 	return m, nil
 }
 
+func (c *spyRPCServiceClient) GetCachedVAA(ctx context.Context, in *GetCachedVAARequest, opts ...grpc.CallOption) (*GetCachedVAAResponse, error) {
+	out := new(GetCachedVAAResponse)
+	err := c.cc.Invoke(ctx, "/spy.v1.SpyRPCService/GetCachedVAA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpyRPCServiceServer is the server API for SpyRPCService service.
 // All implementations must embed UnimplementedSpyRPCServiceServer
 // for forward compatibility
@@ -104,6 +119,12 @@ type SpyRPCServiceServer interface {
 	SubscribeSignedVAA(*SubscribeSignedVAARequest, SpyRPCService_SubscribeSignedVAAServer) error
 	// SubscribeSignedBatchVAA returns a stream of signed VAA messages, by type, received on the network.
 	SubscribeSignedVAAByType(*SubscribeSignedVAAByTypeRequest, SpyRPCService_SubscribeSignedVAAByTypeServer) error
+	// GetCachedVAA returns a signed VAA from the local cache of the spy, so clients can recover VAAs they missed
+	// without querying a guardian. Requires the spy to run with a VAA cache. Unless the spy runs with
+	// --vaaCacheGuardianSet, the cache holds VAAs as received from any peer: a peer can insert forged VAAs, which are
+	// returned as is, and evict the real VAAs of an emitter by sending forged ones with higher sequences. Clients must
+	// verify the VAAs they get, and may not find the ones that were evicted.
+	GetCachedVAA(context.Context, *GetCachedVAARequest) (*GetCachedVAAResponse, error)
 	mustEmbedUnimplementedSpyRPCServiceServer()
 }
 
@@ -117,6 +138,9 @@ func (UnimplementedSpyRPCServiceServer) SubscribeSignedVAA(*SubscribeSignedVAARe
 func (UnimplementedSpyRPCServiceServer) SubscribeSignedVAAByType(*SubscribeSignedVAAByTypeRequest, SpyRPCService_SubscribeSignedVAAByTypeServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeSignedVAAByType not implemented")
 }
+func (UnimplementedSpyRPCServiceServer) GetCachedVAA(context.Context, *GetCachedVAARequest) (*GetCachedVAAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCachedVAA not implemented")
+}
 func (UnimplementedSpyRPCServiceServer) mustEmbedUnimplementedSpyRPCServiceServer() {}
 
 // UnsafeSpyRPCServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -172,13 +196,36 @@ func (x *spyRPCServiceSubscribeSignedVAAByTypeServer) Send(m *SubscribeSignedVAA
 	return x.ServerStream.SendMsg(m)
 }
 
+func _SpyRPCService_GetCachedVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCachedVAARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpyRPCServiceServer).GetCachedVAA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spy.v1.SpyRPCService/GetCachedVAA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpyRPCServiceServer).GetCachedVAA(ctx, req.(*GetCachedVAARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SpyRPCService_ServiceDesc is the grpc.ServiceDesc for SpyRPCService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var SpyRPCService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "spy.v1.SpyRPCService",
 	HandlerType: (*SpyRPCServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCachedVAA",
+			Handler:    _SpyRPCService_GetCachedVAA_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "SubscribeSignedVAA",
@@ -63,19 +63,23 @@ func (s *PublicrpcServer) GetLastHeartbeats(ctx context.Context, req *publicrpcv
 	return resp, nil
 }
 
-func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.GetSignedVAARequest) (*publicrpcv1.GetSignedVAAResponse, error) {
-	if req.MessageId == nil {
+// MaxSignedVAAsPerRequest is the maximum number of message IDs accepted by GetSignedVAAs.
+const MaxSignedVAAsPerRequest = 100
+
+// vaaIDFromMessageID validates a message ID of a request and converts it to the ID of the VAA in the database.
+func vaaIDFromMessageID(messageID *publicrpcv1.MessageID) (*db.VAAID, error) {
+	if messageID == nil {
 		return nil, status.Error(codes.InvalidArgument, "no message ID specified")
 	}
 
-	chainID := vaa.ChainID(req.MessageId.EmitterChain.Number())
+	chainID := vaa.ChainID(messageID.EmitterChain.Number())
 
 	// This interface is not supported for PythNet messages because those VAAs are not stored in the database.
 	if chainID == vaa.ChainIDPythNet {
 		return nil, status.Error(codes.InvalidArgument, "not supported for PythNet")
 	}
 
-	address, err := hex.DecodeString(req.MessageId.EmitterAddress)
+	address, err := hex.DecodeString(messageID.EmitterAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode address: %v", err))
 	}
@@ -86,11 +90,20 @@ func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.Get
 	addr := vaa.Address{}
 	copy(addr[:], address)
 
-	b, err := s.db.GetSignedVAABytes(db.VAAID{
+	return &db.VAAID{
 		EmitterChain:   chainID,
 		EmitterAddress: addr,
-		Sequence:       req.MessageId.Sequence,
-	})
+		Sequence:       messageID.Sequence,
+	}, nil
+}
+
+func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.GetSignedVAARequest) (*publicrpcv1.GetSignedVAAResponse, error) {
+	id, err := vaaIDFromMessageID(req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.db.GetSignedVAABytes(*id)
 
 	if err != nil {
 		if err == db.ErrVAANotFound {
@@ -105,6 +118,39 @@ func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.Get
 	}, nil
 }
 
+// GetSignedVAAs returns the signed VAAs of several message IDs at once. The response has an entry for each requested
+// message ID, in the same order, whose VAA bytes are empty if the VAA is not in the database. The whole request fails
+// if any of the message IDs is invalid.
+func (s *PublicrpcServer) GetSignedVAAs(ctx context.Context, req *publicrpcv1.GetSignedVAAsRequest) (*publicrpcv1.GetSignedVAAsResponse, error) {
+	if len(req.MessageIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no message IDs specified")
+	}
+	if len(req.MessageIds) > MaxSignedVAAsPerRequest {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d message IDs can be requested at once", MaxSignedVAAsPerRequest))
+	}
+
+	ids := make([]*db.VAAID, len(req.MessageIds))
+	for i, messageID := range req.MessageIds {
+		id, err := vaaIDFromMessageID(messageID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("message ID %d: %s", i, status.Convert(err).Message()))
+		}
+		ids[i] = id
+	}
+
+	resp := &publicrpcv1.GetSignedVAAsResponse{Entries: make([]*publicrpcv1.GetSignedVAAsResponse_Entry, len(ids))}
+	for i, id := range ids {
+		b, err := s.db.GetSignedVAABytes(*id)
+		if err != nil && err != db.ErrVAANotFound {
+			s.logger.Error("failed to fetch VAA", zap.Error(err), zap.Any("messageID", req.MessageIds[i]))
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		resp.Entries[i] = &publicrpcv1.GetSignedVAAsResponse_Entry{MessageId: req.MessageIds[i], VaaBytes: b}
+	}
+
+	return resp, nil
+}
+
 func (s *PublicrpcServer) GetSignedBatchVAA(ctx context.Context, req *publicrpcv1.GetSignedBatchVAARequest) (*publicrpcv1.GetSignedBatchVAAResponse, error) {
 	// TEMP - noop implementaion to satisfy inclusion requirement
 	return nil, status.Error(codes.Unimplemented, "not yet implemented")
@@ -130,6 +176,42 @@ func (s *PublicrpcServer) GetCurrentGuardianSet(ctx context.Context, req *public
 	return resp, nil
 }
 
+func (s *PublicrpcServer) GetGuardianSetMetadata(ctx context.Context, req *publicrpcv1.GetGuardianSetMetadataRequest) (*publicrpcv1.GetGuardianSetMetadataResponse, error) {
+	gs := s.gst.Get()
+	if gs == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set not fetched from chain yet")
+	}
+
+	resp := &publicrpcv1.GetGuardianSetMetadataResponse{
+		GuardianSetIndex: gs.Index,
+		Entries:          make([]*publicrpcv1.GetGuardianSetMetadataResponse_Entry, 0),
+	}
+
+	// Unlike GetLastHeartbeats, only report guardians in the current set, in guardian set order.
+	for _, addr := range gs.Keys {
+		for peerId, hb := range s.gst.LastHeartbeat(addr) {
+			entry := &publicrpcv1.GetGuardianSetMetadataResponse_Entry{
+				VerifiedGuardianAddr: addr.Hex(),
+				P2PNodeAddr:          peerId.Pretty(),
+				NodeName:             hb.NodeName,
+				FeatureFlags:         hb.FeatureFlags,
+				Features:             common.HeartbeatFeatureNames(hb.FeatureFlags),
+				ChainIds:             make([]uint32, 0, len(hb.Networks)),
+				Timestamp:            hb.Timestamp,
+			}
+			if err := common.ValidateHeartbeatVersion(hb.Version); err == nil {
+				entry.Version = hb.Version
+			}
+			for _, n := range hb.Networks {
+				entry.ChainIds = append(entry.ChainIds, n.Id)
+			}
+			resp.Entries = append(resp.Entries, entry)
+		}
+	}
+
+	return resp, nil
+}
+
 func (s *PublicrpcServer) GovernorGetAvailableNotionalByChain(ctx context.Context, req *publicrpcv1.GovernorGetAvailableNotionalByChainRequest) (*publicrpcv1.GovernorGetAvailableNotionalByChainResponse, error) {
 	resp := &publicrpcv1.GovernorGetAvailableNotionalByChainResponse{}
 
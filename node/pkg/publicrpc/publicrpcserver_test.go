@@ -3,10 +3,18 @@ package publicrpc
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -80,3 +88,92 @@ func TestGovernorIsVAAEnqueuedNoMessage(t *testing.T) {
 		assert.Equal(t, expected_err, err)
 	})
 }
+
+func TestGetGuardianSetMetadata(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := zap.NewProduction()
+	gst := common.NewGuardianSetState(nil)
+	server := &PublicrpcServer{logger: logger, gst: gst}
+
+	_, err := server.GetGuardianSetMetadata(ctx, &publicrpcv1.GetGuardianSetMetadataRequest{})
+	assert.Equal(t, status.Error(codes.Unavailable, "guardian set not fetched from chain yet"), err)
+
+	inSet := ethcommon.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	notInSet := ethcommon.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee")
+	gst.Set(&common.GuardianSet{Keys: []ethcommon.Address{inSet}, Index: 3})
+
+	require.NoError(t, gst.SetHeartbeat(inSet, "peer1", &gossipv1.Heartbeat{
+		NodeName:     "node1",
+		Version:      "v2.14.8",
+		FeatureFlags: uint64(common.HeartbeatFeatureGovernor | common.HeartbeatFeatureCCQ),
+		Networks:     []*gossipv1.Heartbeat_Network{{Id: 2}, {Id: 4}},
+		Timestamp:    42,
+	}))
+	require.NoError(t, gst.SetHeartbeat(notInSet, "peer2", &gossipv1.Heartbeat{NodeName: "node2", Version: "v2.14.8"}))
+
+	resp, err := server.GetGuardianSetMetadata(ctx, &publicrpcv1.GetGuardianSetMetadataRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), resp.GuardianSetIndex)
+	require.Equal(t, 1, len(resp.Entries))
+
+	entry := resp.Entries[0]
+	assert.Equal(t, inSet.Hex(), entry.VerifiedGuardianAddr)
+	assert.Equal(t, "node1", entry.NodeName)
+	assert.Equal(t, "v2.14.8", entry.Version)
+	assert.Equal(t, []string{"governor", "ccq"}, entry.Features)
+	assert.Equal(t, []uint32{2, 4}, entry.ChainIds)
+	assert.Equal(t, int64(42), entry.Timestamp)
+}
+
+func TestGetSignedVAAs(t *testing.T) {
+	ctx := context.Background()
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 1,
+		Timestamp:        time.Unix(1000, 0),
+		Sequence:         42,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   vaa.Address{1},
+		Payload:          []byte{1, 2, 3},
+	}
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	v.AddSignature(key, 0)
+	require.NoError(t, database.StoreSignedVAA(v))
+	expected, err := v.Marshal()
+	require.NoError(t, err)
+
+	messageID := func(sequence uint64) *publicrpcv1.MessageID {
+		return &publicrpcv1.MessageID{EmitterChain: publicrpcv1.ChainID_CHAIN_ID_ETHEREUM, EmitterAddress: v.EmitterAddress.String(), Sequence: sequence}
+	}
+
+	logger := zap.NewNop()
+	server := &PublicrpcServer{logger: logger, db: database}
+
+	// Missing VAAs are left empty rather than failing the request.
+	resp, err := server.GetSignedVAAs(ctx, &publicrpcv1.GetSignedVAAsRequest{MessageIds: []*publicrpcv1.MessageID{messageID(41), messageID(42)}})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 2)
+	assert.Equal(t, uint64(41), resp.Entries[0].MessageId.Sequence)
+	assert.Empty(t, resp.Entries[0].VaaBytes)
+	assert.Equal(t, uint64(42), resp.Entries[1].MessageId.Sequence)
+	assert.Equal(t, expected, resp.Entries[1].VaaBytes)
+
+	// Invalid message IDs fail the whole request.
+	_, err = server.GetSignedVAAs(ctx, &publicrpcv1.GetSignedVAAsRequest{MessageIds: []*publicrpcv1.MessageID{messageID(42), {EmitterAddress: "AAAA"}}})
+	assert.Equal(t, status.Error(codes.InvalidArgument, "message ID 1: address must be 32 bytes"), err)
+
+	_, err = server.GetSignedVAAs(ctx, &publicrpcv1.GetSignedVAAsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	tooMany := make([]*publicrpcv1.MessageID, MaxSignedVAAsPerRequest+1)
+	for i := range tooMany {
+		tooMany[i] = messageID(uint64(i))
+	}
+	_, err = server.GetSignedVAAs(ctx, &publicrpcv1.GetSignedVAAsRequest{MessageIds: tooMany})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,219 @@
+package publicrpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	throttledRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_publicrpc_throttled_requests_total",
+			Help: "Total number of publicrpc requests rejected by the rate limiter, grouped by tier",
+		}, []string{"tier"})
+	invalidAPIKeys = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_publicrpc_invalid_api_keys_total",
+			Help: "Total number of publicrpc requests rejected because of an unknown API key",
+		})
+)
+
+const (
+	// IPTier is the rate limit tier of the requests without an API key, which are limited per client IP.
+	IPTier = "ip"
+
+	// clientIdleTimeout is how long the bucket of a client is kept after its last request.
+	clientIdleTimeout = 10 * time.Minute
+)
+
+// APIKey is an entry of the API keys file: a key and the rate limit tier of the requests using it.
+type APIKey struct {
+	Key  string `json:"key"`
+	Tier string `json:"tier"`
+}
+
+// ReadAPIKeysFile reads a JSON array of API keys. An empty path returns no keys.
+func ReadAPIKeysFile(path string) ([]APIKey, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API keys file: %w", err)
+	}
+
+	var keys []APIKey
+	if err := json.Unmarshal(b, &keys); err != nil {
+		return nil, fmt.Errorf("failed to parse API keys file: %w", err)
+	}
+	return keys, nil
+}
+
+// RateLimiter limits the rate of the publicrpc requests of each client, so that guardians exposing publicrpc do not
+// have to rely on an external proxy. Requests with an API key, sent as an "authorization: Bearer <key>" header, are
+// limited per key according to the tier of the key. Other requests are limited per client IP according to IPTier,
+// or not limited if there is no such tier. Requests over the limit are rejected with ResourceExhausted.
+type RateLimiter struct {
+	tiers map[string]ratelimit.Limit
+	// keys maps the SHA-256 hash of each API key to its tier, so that the keys are compared in constant time.
+	keys map[[sha256.Size]byte]string
+
+	mu        sync.Mutex
+	clients   map[string]*clientLimiter
+	lastPrune time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter creates a rate limiter with the given tiers, as parsed by ratelimit.ParseLimits, and API keys. Every
+// API key must use one of the tiers.
+func NewRateLimiter(tiers map[string]ratelimit.Limit, keys []APIKey) (*RateLimiter, error) {
+	l := &RateLimiter{
+		tiers:   tiers,
+		keys:    make(map[[sha256.Size]byte]string),
+		clients: make(map[string]*clientLimiter),
+	}
+
+	for i, k := range keys {
+		if k.Key == "" {
+			return nil, fmt.Errorf("API key %d is empty", i)
+		}
+		tier := strings.ToLower(k.Tier)
+		if tier == IPTier {
+			return nil, fmt.Errorf("API key %d uses the reserved tier %s", i, IPTier)
+		}
+		if _, exists := tiers[tier]; !exists {
+			return nil, fmt.Errorf("API key %d uses the unknown tier %s", i, k.Tier)
+		}
+		hash := sha256.Sum256([]byte(k.Key))
+		if _, exists := l.keys[hash]; exists {
+			return nil, fmt.Errorf("API key %d is a duplicate", i)
+		}
+		l.keys[hash] = tier
+	}
+
+	return l, nil
+}
+
+// UnaryServerInterceptor is the gRPC interceptor enforcing the rate limits.
+func (l *RateLimiter) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.allow(ctx, time.Now()); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// allow returns an error if the request of ctx exceeds the limit of its client.
+func (l *RateLimiter) allow(ctx context.Context, now time.Time) error {
+	tier, client, err := l.client(ctx)
+	if err != nil {
+		return err
+	}
+	if client == "" {
+		return nil
+	}
+
+	limit, exists := l.tiers[tier]
+	if !exists {
+		return nil
+	}
+
+	if !l.limiter(tier+"/"+client, limit, now).AllowN(now, 1) {
+		throttledRequests.WithLabelValues(tier).Inc()
+		return status.Errorf(codes.ResourceExhausted, "rate limit of tier %s exceeded", tier)
+	}
+	return nil
+}
+
+// client returns the tier and the identity of the client of a request, which is the hash of its API key or its IP. It
+// returns an empty client for local requests, which are not limited.
+func (l *RateLimiter) client(ctx context.Context) (tier string, client string, err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, auth := range md.Get("authorization") {
+		if key, found := cutPrefixFold(auth, "Bearer "); found {
+			hash := sha256.Sum256([]byte(strings.TrimSpace(key)))
+			tier, exists := l.keys[hash]
+			if !exists {
+				invalidAPIKeys.Inc()
+				return "", "", status.Error(codes.Unauthenticated, "unknown API key")
+			}
+			return tier, hex.EncodeToString(hash[:8]), nil
+		}
+	}
+
+	return IPTier, clientIP(ctx, md), nil
+}
+
+// clientIP returns the IP of the client of a request. Requests proxied by the REST gateway of publicWeb arrive over
+// the local socket, with the IP of the client appended to X-Forwarded-For by the gateway, so the last entry of the
+// header can be trusted. Other local requests have no client IP.
+func clientIP(ctx context.Context, md metadata.MD) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil && net.ParseIP(host) != nil {
+			return host
+		}
+	}
+
+	if fwd := md.Get("x-forwarded-for"); len(fwd) != 0 {
+		hops := strings.Split(fwd[len(fwd)-1], ",")
+		if ip := strings.TrimSpace(hops[len(hops)-1]); net.ParseIP(ip) != nil {
+			return ip
+		}
+	}
+
+	return ""
+}
+
+// cutPrefixFold is like strings.Cut for a prefix, ignoring the case of the prefix.
+func cutPrefixFold(s string, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// limiter returns the token bucket of a client, creating it if needed, and drops the buckets of idle clients.
+func (l *RateLimiter) limiter(client string, limit ratelimit.Limit, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastPrune) > time.Minute {
+		for c, cl := range l.clients {
+			if now.Sub(cl.lastSeen) > clientIdleTimeout {
+				delete(l.clients, c)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	cl, exists := l.clients[client]
+	if !exists {
+		cl = &clientLimiter{limiter: rate.NewLimiter(limit.Rate, limit.Burst)}
+		l.clients[client] = cl
+	}
+	cl.lastSeen = now
+	return cl.limiter
+}
@@ -0,0 +1,116 @@
+package publicrpc
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requestContext returns the context of a request from addr with the given metadata.
+func requestContext(addr net.Addr, kv ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+}
+
+func newTestRateLimiter(t *testing.T) *RateLimiter {
+	t.Helper()
+	tiers, err := ratelimit.ParseLimits("ip=1:2,partner=10:5")
+	require.NoError(t, err)
+	l, err := NewRateLimiter(tiers, []APIKey{{Key: "partner-key", Tier: "partner"}})
+	require.NoError(t, err)
+	return l
+}
+
+func TestRateLimiterPerIP(t *testing.T) {
+	l := newTestRateLimiter(t)
+	now := time.Now()
+	client1 := requestContext(&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234})
+	client2 := requestContext(&net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 1234})
+
+	// The burst is available at once, then the client is throttled until the bucket refills.
+	assert.NoError(t, l.allow(client1, now))
+	assert.NoError(t, l.allow(client1, now))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(l.allow(client1, now)))
+	assert.NoError(t, l.allow(client2, now))
+	assert.NoError(t, l.allow(client1, now.Add(time.Second)))
+
+	// The source port does not matter.
+	assert.Equal(t, codes.ResourceExhausted, status.Code(l.allow(requestContext(&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5678}), now.Add(time.Second))))
+}
+
+func TestRateLimiterForwardedRequests(t *testing.T) {
+	l := newTestRateLimiter(t)
+	now := time.Now()
+	socket := &net.UnixAddr{Name: "/run/publicrpc.sock", Net: "unix"}
+
+	// The gateway appends the IP of the client, earlier hops are set by the client and not trusted.
+	forwarded := func(fwd string) context.Context { return requestContext(socket, "x-forwarded-for", fwd) }
+	assert.NoError(t, l.allow(forwarded("1.1.1.1, 10.0.0.1"), now))
+	assert.NoError(t, l.allow(forwarded("2.2.2.2, 10.0.0.1"), now))
+	assert.Error(t, l.allow(forwarded("10.0.0.1"), now))
+
+	// Local requests are not limited.
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, l.allow(requestContext(socket), now))
+	}
+}
+
+func TestRateLimiterAPIKeys(t *testing.T) {
+	l := newTestRateLimiter(t)
+	now := time.Now()
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}
+
+	// Requests with an API key get the budget of its tier, independently of their IP.
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, l.allow(requestContext(addr, "authorization", "Bearer partner-key"), now))
+	}
+	assert.Equal(t, codes.ResourceExhausted, status.Code(l.allow(requestContext(addr, "authorization", "bearer partner-key"), now)))
+	assert.NoError(t, l.allow(requestContext(addr), now))
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(l.allow(requestContext(addr, "authorization", "Bearer wrong-key"), now)))
+}
+
+func TestNewRateLimiterValidatesKeys(t *testing.T) {
+	tiers, err := ratelimit.ParseLimits("ip=1,partner=10")
+	require.NoError(t, err)
+
+	_, err = NewRateLimiter(tiers, []APIKey{{Key: "k", Tier: "unknown"}})
+	assert.ErrorContains(t, err, "unknown tier")
+	_, err = NewRateLimiter(tiers, []APIKey{{Key: "k", Tier: IPTier}})
+	assert.ErrorContains(t, err, "reserved tier")
+	_, err = NewRateLimiter(tiers, []APIKey{{Key: "k", Tier: "partner"}, {Key: "k", Tier: "partner"}})
+	assert.ErrorContains(t, err, "duplicate")
+	_, err = NewRateLimiter(tiers, []APIKey{{Tier: "partner"}})
+	assert.ErrorContains(t, err, "empty")
+
+	// Without the ip tier, requests without an API key are not limited.
+	l, err := NewRateLimiter(map[string]ratelimit.Limit{}, nil)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, l.allow(requestContext(&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}), time.Now()))
+	}
+}
+
+func TestReadAPIKeysFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"key": "partner-key", "tier": "partner"}]`), 0600))
+
+	keys, err := ReadAPIKeysFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []APIKey{{Key: "partner-key", Tier: "partner"}}, keys)
+
+	keys, err = ReadAPIKeysFile("")
+	require.NoError(t, err)
+	assert.Nil(t, keys)
+}
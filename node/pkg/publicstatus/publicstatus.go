@@ -0,0 +1,300 @@
+// Package publicstatus implements a small, cached, read-only HTTP JSON API exposing the node's view of the network
+// (current guardian set, per-chain heights reported in heartbeats and the governor configuration). It is meant to be
+// exposed publicly on its own listener, without the full public gRPC surface.
+package publicstatus
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var publicStatusRequests = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormhole_public_status_requests_total",
+		Help: "Total number of requests to the public status endpoints, by path and whether they were served from cache",
+	}, []string{"path", "cached"})
+
+// DefaultCacheTTL is how long a response is served from cache before being regenerated.
+const DefaultCacheTTL = 5 * time.Second
+
+// Governor is the subset of *governor.ChainGovernor used by the server.
+type Governor interface {
+	GetAvailableNotionalByChain() []*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry
+	GetTokenList() []*publicrpcv1.GovernorGetTokenListResponse_Entry
+}
+
+type HealthResponse struct {
+	Status           string `json:"status"`
+	GuardianSetIndex uint32 `json:"guardianSetIndex"`
+	// NumGuardiansSeen is the number of guardians in the current set a heartbeat was received from recently.
+	NumGuardiansSeen int `json:"numGuardiansSeen"`
+}
+
+type GuardianSetResponse struct {
+	Index     uint32   `json:"index"`
+	Addresses []string `json:"addresses"`
+}
+
+type ChainHeight struct {
+	GuardianAddr string `json:"guardianAddr"`
+	NodeName     string `json:"nodeName"`
+	Height       int64  `json:"height"`
+	// Latest, safe and finalized heights, omitted if the node does not report them for the chain.
+	LatestHeight    int64 `json:"latestHeight,omitempty"`
+	SafeHeight      int64 `json:"safeHeight,omitempty"`
+	FinalizedHeight int64 `json:"finalizedHeight,omitempty"`
+}
+
+type ChainHeights struct {
+	ChainId   uint32        `json:"chainId"`
+	ChainName string        `json:"chainName"`
+	Heights   []ChainHeight `json:"heights"`
+}
+
+type HeightsResponse struct {
+	Chains []ChainHeights `json:"chains"`
+}
+
+type GovernorChain struct {
+	ChainId            uint32 `json:"chainId"`
+	NotionalLimit      uint64 `json:"notionalLimit"`
+	BigTransactionSize uint64 `json:"bigTransactionSize"`
+}
+
+type GovernorToken struct {
+	OriginChainId uint32  `json:"originChainId"`
+	OriginAddress string  `json:"originAddress"`
+	Price         float32 `json:"price"`
+	Symbol        string  `json:"symbol"`
+	Decimals      int64   `json:"decimals"`
+}
+
+type GovernorConfigResponse struct {
+	Chains []GovernorChain `json:"chains"`
+	Tokens []GovernorToken `json:"tokens"`
+}
+
+type cacheEntry struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+// Server serves the public status endpoints.
+type Server struct {
+	logger         *zap.Logger
+	gst            *common.GuardianSetState
+	gov            Governor
+	cacheTTL       time.Duration
+	allowedOrigins []string
+
+	// now is replaced in tests.
+	now func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+
+	mux *http.ServeMux
+}
+
+// NewServer returns a server for the given state. gov may be nil if the governor is disabled. allowedOrigins lists the
+// origins allowed to make cross-origin requests; "*" allows any origin and an empty list disables CORS.
+func NewServer(logger *zap.Logger, gst *common.GuardianSetState, gov Governor, cacheTTL time.Duration, allowedOrigins []string) *Server {
+	s := &Server{
+		logger:         logger,
+		gst:            gst,
+		gov:            gov,
+		cacheTTL:       cacheTTL,
+		allowedOrigins: allowedOrigins,
+		now:            time.Now,
+		cache:          make(map[string]cacheEntry),
+		mux:            http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("/v1/health", s.cached(s.health))
+	s.mux.HandleFunc("/v1/guardianset", s.cached(s.guardianSet))
+	s.mux.HandleFunc("/v1/heights", s.cached(s.heights))
+	s.mux.HandleFunc("/v1/governor/config", s.cached(s.governorConfig))
+
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" && s.originAllowed(origin) {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Set("Access-Control-Allow-Methods", "GET,HEAD")
+			w.Header().Set("Access-Control-Allow-Headers", "content-type,accept")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) originAllowed(origin string) bool {
+	for _, o := range s.allowedOrigins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// cached wraps a response generator so that its output is reused for cacheTTL. A generator returning nil means the
+// resource is not available.
+func (s *Server) cached(generate func() interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, hit, ok := s.get(r.URL.Path, generate)
+		publicStatusRequests.WithLabelValues(r.URL.Path, strconv.FormatBool(hit)).Inc()
+		if !ok {
+			http.Error(w, "not available", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(s.cacheTTL/time.Second)))
+		_, _ = w.Write(body)
+	}
+}
+
+func (s *Server) get(key string, generate func() interface{}) (body []byte, hit bool, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if e, found := s.cache[key]; found && now.Before(e.expiresAt) {
+		return e.body, true, e.body != nil
+	}
+
+	var b []byte
+	if v := generate(); v != nil {
+		var err error
+		b, err = json.Marshal(v)
+		if err != nil {
+			s.logger.Error("failed to marshal public status response", zap.String("path", key), zap.Error(err))
+			return nil, false, false
+		}
+	}
+
+	s.cache[key] = cacheEntry{body: b, expiresAt: now.Add(s.cacheTTL)}
+	return b, false, b != nil
+}
+
+func (s *Server) health() interface{} {
+	gs := s.gst.Get()
+	if gs == nil {
+		return &HealthResponse{Status: "no guardian set"}
+	}
+
+	seen := 0
+	hbs := s.gst.GetAll()
+	for _, k := range gs.Keys {
+		if len(hbs[k]) > 0 {
+			seen++
+		}
+	}
+
+	return &HealthResponse{
+		Status:           "ok",
+		GuardianSetIndex: gs.Index,
+		NumGuardiansSeen: seen,
+	}
+}
+
+func (s *Server) guardianSet() interface{} {
+	gs := s.gst.Get()
+	if gs == nil {
+		return nil
+	}
+	return &GuardianSetResponse{
+		Index:     gs.Index,
+		Addresses: gs.KeysAsHexStrings(),
+	}
+}
+
+func (s *Server) heights() interface{} {
+	byChain := make(map[uint32][]ChainHeight)
+	for addr, nodes := range s.gst.GetAll() {
+		for _, hb := range nodes {
+			for _, n := range hb.Networks {
+				byChain[n.Id] = append(byChain[n.Id], ChainHeight{
+					GuardianAddr:    addr.Hex(),
+					NodeName:        hb.NodeName,
+					Height:          n.Height,
+					LatestHeight:    n.LatestHeight,
+					SafeHeight:      n.SafeHeight,
+					FinalizedHeight: n.FinalizedHeight,
+				})
+			}
+		}
+	}
+
+	resp := &HeightsResponse{Chains: make([]ChainHeights, 0, len(byChain))}
+	for id, heights := range byChain {
+		sort.Slice(heights, func(i, j int) bool {
+			if heights[i].GuardianAddr != heights[j].GuardianAddr {
+				return heights[i].GuardianAddr < heights[j].GuardianAddr
+			}
+			return heights[i].NodeName < heights[j].NodeName
+		})
+		resp.Chains = append(resp.Chains, ChainHeights{
+			ChainId:   id,
+			ChainName: vaa.ChainID(id).String(),
+			Heights:   heights,
+		})
+	}
+	sort.Slice(resp.Chains, func(i, j int) bool {
+		return resp.Chains[i].ChainId < resp.Chains[j].ChainId
+	})
+
+	return resp
+}
+
+func (s *Server) governorConfig() interface{} {
+	if s.gov == nil {
+		return nil
+	}
+
+	resp := &GovernorConfigResponse{
+		Chains: make([]GovernorChain, 0),
+		Tokens: make([]GovernorToken, 0),
+	}
+	for _, c := range s.gov.GetAvailableNotionalByChain() {
+		resp.Chains = append(resp.Chains, GovernorChain{
+			ChainId:            c.ChainId,
+			NotionalLimit:      c.NotionalLimit,
+			BigTransactionSize: c.BigTransactionSize,
+		})
+	}
+	for _, t := range s.gov.GetTokenList() {
+		resp.Tokens = append(resp.Tokens, GovernorToken{
+			OriginChainId: t.OriginChainId,
+			OriginAddress: t.OriginAddress,
+			Price:         t.Price,
+			Symbol:        t.Symbol,
+			Decimals:      t.Decimals,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,171 @@
+package publicstatus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type mockGovernor struct{}
+
+func (mockGovernor) GetAvailableNotionalByChain() []*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry {
+	return []*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry{
+		{ChainId: 2, RemainingAvailableNotional: 50, NotionalLimit: 100, BigTransactionSize: 10},
+	}
+}
+
+func (mockGovernor) GetTokenList() []*publicrpcv1.GovernorGetTokenListResponse_Entry {
+	return []*publicrpcv1.GovernorGetTokenListResponse_Entry{
+		{OriginChainId: 2, OriginAddress: "0x01", Price: 1.5, Symbol: "TKN", Decimals: 8},
+	}
+}
+
+var (
+	guardian1 = ethcommon.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")
+	guardian2 = ethcommon.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157")
+)
+
+func newTestServer(t *testing.T, gov Governor, origins []string) (*Server, *common.GuardianSetState) {
+	t.Helper()
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(&common.GuardianSet{Keys: []ethcommon.Address{guardian1, guardian2}, Index: 3})
+	require.NoError(t, gst.SetHeartbeat(guardian1, "peer1", &gossipv1.Heartbeat{
+		NodeName: "node1",
+		Networks: []*gossipv1.Heartbeat_Network{{Id: 2, Height: 100, LatestHeight: 130, SafeHeight: 110, FinalizedHeight: 100}, {Id: 1, Height: 7}},
+	}))
+	return NewServer(zap.NewNop(), gst, gov, time.Minute, origins), gst
+}
+
+func get(t *testing.T, s *Server, path string, origin string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestGuardianSet(t *testing.T) {
+	s, _ := newTestServer(t, nil, nil)
+
+	rec := get(t, s, "/v1/guardianset", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var resp GuardianSetResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, uint32(3), resp.Index)
+	assert.Equal(t, []string{guardian1.Hex(), guardian2.Hex()}, resp.Addresses)
+}
+
+func TestHealth(t *testing.T) {
+	s, _ := newTestServer(t, nil, nil)
+
+	rec := get(t, s, "/v1/health", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp HealthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, HealthResponse{Status: "ok", GuardianSetIndex: 3, NumGuardiansSeen: 1}, resp)
+}
+
+func TestHeights(t *testing.T) {
+	s, _ := newTestServer(t, nil, nil)
+
+	rec := get(t, s, "/v1/heights", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp HeightsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, 2, len(resp.Chains))
+	assert.Equal(t, uint32(1), resp.Chains[0].ChainId)
+	assert.Equal(t, "solana", resp.Chains[0].ChainName)
+	assert.Equal(t, []ChainHeight{{GuardianAddr: guardian1.Hex(), NodeName: "node1", Height: 7}}, resp.Chains[0].Heights)
+	assert.Equal(t, uint32(2), resp.Chains[1].ChainId)
+	assert.Equal(t, int64(100), resp.Chains[1].Heights[0].Height)
+	assert.Equal(t, int64(130), resp.Chains[1].Heights[0].LatestHeight)
+	assert.Equal(t, int64(110), resp.Chains[1].Heights[0].SafeHeight)
+	assert.Equal(t, int64(100), resp.Chains[1].Heights[0].FinalizedHeight)
+}
+
+func TestGovernorConfig(t *testing.T) {
+	s, _ := newTestServer(t, nil, nil)
+	rec := get(t, s, "/v1/governor/config", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	s, _ = newTestServer(t, mockGovernor{}, nil)
+	rec = get(t, s, "/v1/governor/config", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp GovernorConfigResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, []GovernorChain{{ChainId: 2, NotionalLimit: 100, BigTransactionSize: 10}}, resp.Chains)
+	assert.Equal(t, []GovernorToken{{OriginChainId: 2, OriginAddress: "0x01", Price: 1.5, Symbol: "TKN", Decimals: 8}}, resp.Tokens)
+}
+
+func TestResponsesAreCached(t *testing.T) {
+	s, gst := newTestServer(t, nil, nil)
+	now := time.Unix(1000, 0)
+	s.now = func() time.Time { return now }
+
+	rec := get(t, s, "/v1/guardianset", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	before := rec.Body.String()
+
+	gst.Set(&common.GuardianSet{Keys: []ethcommon.Address{guardian1}, Index: 4})
+
+	rec = get(t, s, "/v1/guardianset", "")
+	assert.Equal(t, before, rec.Body.String())
+	assert.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+
+	now = now.Add(time.Minute)
+	rec = get(t, s, "/v1/guardianset", "")
+	var resp GuardianSetResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, uint32(4), resp.Index)
+}
+
+func TestCORS(t *testing.T) {
+	s, _ := newTestServer(t, nil, []string{"https://example.com"})
+
+	rec := get(t, s, "/v1/health", "https://example.com")
+	assert.Equal(t, "https://example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	rec = get(t, s, "/v1/health", "https://evil.example")
+	assert.Equal(t, "", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	req := httptest.NewRequest(http.MethodOptions, "/v1/health", nil)
+	req.Header.Set("Origin", "https://example.com")
+	req.Header.Set("Access-Control-Request-Method", "GET")
+	preflight := httptest.NewRecorder()
+	s.ServeHTTP(preflight, req)
+	assert.Equal(t, http.StatusNoContent, preflight.Code)
+	assert.Equal(t, "GET,HEAD", preflight.Header().Get("Access-Control-Allow-Methods"))
+
+	s, _ = newTestServer(t, nil, []string{"*"})
+	rec = get(t, s, "/v1/health", "https://anything.example")
+	assert.Equal(t, "https://anything.example", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestOnlyReadMethodsAllowed(t *testing.T) {
+	s, _ := newTestServer(t, nil, nil)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/health", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = get(t, s, "/v1/unknown", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
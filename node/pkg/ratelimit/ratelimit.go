@@ -0,0 +1,210 @@
+// Package ratelimit limits the rate of the requests the watchers send to their RPC endpoints.
+//
+// Each endpoint, identified by the host of its URL, gets a token bucket which is shared by all the watchers using it,
+// since RPC providers usually enforce their request budgets per API key or host. A watcher gets the limiter of its
+// endpoint from DefaultRegistry and waits for it before each request, so that a watcher stuck in a tight retry loop is
+// slowed down instead of getting the API key of the guardian banned. Endpoints without a configured limit are not
+// limited.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+)
+
+var (
+	rateLimitedRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_rpc_rate_limited_requests_total",
+			Help: "Total number of RPC requests subject to a rate limit, by endpoint",
+		}, []string{"endpoint"})
+	throttledRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_rpc_throttled_requests_total",
+			Help: "Total number of RPC requests delayed by the rate limit of their endpoint, by endpoint",
+		}, []string{"endpoint"})
+	throttledSeconds = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_rpc_throttled_seconds_total",
+			Help: "Total time RPC requests were delayed by the rate limit of their endpoint, by endpoint",
+		}, []string{"endpoint"})
+)
+
+// DefaultEndpoint is the key of the limit applied to each endpoint without a limit of its own.
+const DefaultEndpoint = "*"
+
+// Limit is the request budget of an endpoint.
+type Limit struct {
+	// Rate is the number of requests per second.
+	Rate rate.Limit
+	// Burst is the number of requests which can be sent at once.
+	Burst int
+}
+
+// ParseLimits parses a comma separated list of <endpoint>=<rps>[:<burst>] entries, where endpoint is the host of the
+// RPC URLs, including the port if the URLs have one, or DefaultEndpoint. The burst defaults to the rate, rounded up.
+func ParseLimits(config string) (map[string]Limit, error) {
+	limits := make(map[string]Limit)
+	if config == "" {
+		return limits, nil
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		endpoint, value, found := strings.Cut(entry, "=")
+		if !found || endpoint == "" {
+			return nil, fmt.Errorf(`invalid rate limit "%s", must be <endpoint>=<rps>[:<burst>]`, entry)
+		}
+		endpoint = strings.ToLower(endpoint)
+		if _, exists := limits[endpoint]; exists {
+			return nil, fmt.Errorf(`duplicate rate limit for endpoint "%s"`, endpoint)
+		}
+
+		rateStr, burstStr, hasBurst := strings.Cut(value, ":")
+		rps, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil || rps <= 0 || math.IsInf(rps, 0) {
+			return nil, fmt.Errorf(`invalid rate of endpoint "%s": %s`, endpoint, rateStr)
+		}
+		burst := int(math.Ceil(rps))
+		if hasBurst {
+			burst, err = strconv.Atoi(burstStr)
+			if err != nil || burst <= 0 {
+				return nil, fmt.Errorf(`invalid burst of endpoint "%s": %s`, endpoint, burstStr)
+			}
+		}
+
+		limits[endpoint] = Limit{Rate: rate.Limit(rps), Burst: burst}
+	}
+
+	return limits, nil
+}
+
+// EndpointOf returns the endpoint of a URL, which is its host. URLs without scheme, like "sui:9000", are accepted as well.
+func EndpointOf(rawUrl string) string {
+	if !strings.Contains(rawUrl, "://") {
+		rawUrl = "//" + rawUrl
+	}
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Host)
+}
+
+// Limiter enforces the request budget of an endpoint. A nil Limiter does not limit anything, so the watchers do not
+// have to check whether their endpoint is limited.
+type Limiter struct {
+	endpoint string
+	limiter  *rate.Limiter
+}
+
+// Wait blocks until a request can be sent to the endpoint, or returns an error if ctx is done first.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	rateLimitedRequests.WithLabelValues(l.endpoint).Inc()
+	if l.limiter.Allow() {
+		return nil
+	}
+
+	throttledRequests.WithLabelValues(l.endpoint).Inc()
+	start := time.Now()
+	err := l.limiter.Wait(ctx)
+	throttledSeconds.WithLabelValues(l.endpoint).Add(time.Since(start).Seconds())
+	if err != nil {
+		return fmt.Errorf("rate limit of %s: %w", l.endpoint, err)
+	}
+	return nil
+}
+
+// Registry holds the limiters of the endpoints.
+type Registry struct {
+	mu       sync.Mutex
+	limits   map[string]Limit
+	limiters map[string]*Limiter
+}
+
+// DefaultRegistry is the registry used by the watchers.
+var DefaultRegistry = NewRegistry(nil)
+
+// NewRegistry returns a registry enforcing limits, as returned by ParseLimits.
+func NewRegistry(limits map[string]Limit) *Registry {
+	r := &Registry{}
+	r.Configure(limits)
+	return r
+}
+
+// Configure replaces the limits of the registry. It must be called before the watchers are started, since the
+// limiters already handed out are not updated.
+func (r *Registry) Configure(limits map[string]Limit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limits = limits
+	r.limiters = make(map[string]*Limiter)
+}
+
+// ForURL returns the limiter of the endpoint of rawUrl, which is shared by all the URLs of the same endpoint, or nil if
+// the endpoint is not limited.
+func (r *Registry) ForURL(rawUrl string) *Limiter {
+	endpoint := EndpointOf(rawUrl)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if l, exists := r.limiters[endpoint]; exists {
+		return l
+	}
+
+	limit, exists := r.limits[endpoint]
+	if !exists {
+		limit, exists = r.limits[DefaultEndpoint]
+	}
+	var l *Limiter
+	if exists {
+		l = &Limiter{endpoint: endpoint, limiter: rate.NewLimiter(limit.Rate, limit.Burst)}
+	}
+	r.limiters[endpoint] = l
+	return l
+}
+
+// Transport returns an http.RoundTripper which waits for the limiter of the endpoint of rawUrl before sending each
+// request with base, or base itself if the endpoint is not limited. A nil base means http.DefaultTransport.
+func (r *Registry) Transport(rawUrl string, base http.RoundTripper) http.RoundTripper {
+	l := r.ForURL(rawUrl)
+	if l == nil {
+		return base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{limiter: l, base: base}
+}
+
+type transport struct {
+	limiter *Limiter
+	base    http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		// A RoundTripper must always close the body of the request.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
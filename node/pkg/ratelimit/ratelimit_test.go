@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestParseLimits(t *testing.T) {
+	limits, err := ParseLimits("")
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	limits, err = ParseLimits("eth-mainnet.example.com=25:50, Solana.example.com:8899=2.5,*=10")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Limit{
+		"eth-mainnet.example.com": {Rate: 25, Burst: 50},
+		"solana.example.com:8899": {Rate: 2.5, Burst: 3},
+		DefaultEndpoint:           {Rate: 10, Burst: 10},
+	}, limits)
+
+	for config, expectedErr := range map[string]string{
+		"example.com":                     `invalid rate limit "example.com"`,
+		"=10":                             `invalid rate limit "=10"`,
+		"example.com=fast":                `invalid rate of endpoint "example.com": fast`,
+		"example.com=0":                   `invalid rate of endpoint "example.com": 0`,
+		"example.com=10:0":                `invalid burst of endpoint "example.com": 0`,
+		"example.com=10,EXAMPLE.com=20:5": `duplicate rate limit for endpoint "example.com"`,
+	} {
+		_, err := ParseLimits(config)
+		assert.ErrorContains(t, err, expectedErr, config)
+	}
+}
+
+func TestEndpointOf(t *testing.T) {
+	assert.Equal(t, "eth.example.com", EndpointOf("wss://eth.example.com/v1/secret-api-key"))
+	assert.Equal(t, "eth.example.com:8545", EndpointOf("http://ETH.example.com:8545"))
+	assert.Equal(t, "sui:9000", EndpointOf("sui:9000"))
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(map[string]Limit{"eth.example.com": {Rate: 1, Burst: 1}})
+
+	// URLs of the same endpoint share a limiter.
+	l := r.ForURL("wss://eth.example.com/key1")
+	require.NotNil(t, l)
+	assert.Same(t, l, r.ForURL("https://eth.example.com/key2"))
+
+	// Endpoints without a limit are not limited, and a nil limiter never blocks.
+	assert.Nil(t, r.ForURL("https://bsc.example.com"))
+	require.NoError(t, r.ForURL("https://bsc.example.com").Wait(context.Background()))
+
+	r.Configure(map[string]Limit{DefaultEndpoint: {Rate: 1, Burst: 1}})
+	assert.NotNil(t, r.ForURL("https://bsc.example.com"))
+	assert.NotSame(t, r.ForURL("https://bsc.example.com"), r.ForURL("https://eth.example.com"))
+}
+
+func TestLimiterWait(t *testing.T) {
+	l := &Limiter{endpoint: "example.com", limiter: rate.NewLimiter(rate.Every(time.Hour), 1)}
+	require.NoError(t, l.Wait(context.Background()))
+
+	// The bucket is empty, so the next request has to wait for longer than the context allows.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorContains(t, l.Wait(ctx), "rate limit of example.com")
+}
+
+func TestTransport(t *testing.T) {
+	numRequests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+	}))
+	defer srv.Close()
+
+	r := NewRegistry(map[string]Limit{EndpointOf(srv.URL): {Rate: rate.Every(time.Hour), Burst: 1}})
+	assert.Nil(t, r.Transport("https://other.example.com", nil))
+
+	client := &http.Client{Transport: r.Transport(srv.URL, nil)}
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	assert.ErrorContains(t, err, "rate limit of")
+	assert.Equal(t, 1, numRequests)
+}
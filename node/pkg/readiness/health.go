@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 )
 
@@ -37,6 +38,20 @@ func SetReady(component Component) {
 	}
 }
 
+// NotReady returns the registered components which are not ready yet, sorted by name.
+func NotReady() []Component {
+	mu.Lock()
+	defer mu.Unlock()
+	var notReady []Component
+	for k, v := range registry {
+		if !v {
+			notReady = append(notReady, Component(k))
+		}
+	}
+	sort.Slice(notReady, func(i, j int) bool { return notReady[i] < notReady[j] })
+	return notReady
+}
+
 // Handler returns a net/http handler for the readiness check. It returns 200 OK if all components are ready,
 // or 412 Precondition Failed otherwise. For operator convenience, a list of components and their states
 // is returned as plain text (not meant for machine consumption!).
@@ -0,0 +1,249 @@
+// Package reobservation multiplexes inbound observation requests to the per-chain watchers.
+//
+// All requests, whether issued by this node or received from other guardians, pass through a single
+// Handler which drops duplicates and enforces per-guardian, per-chain and global rate limits before
+// forwarding a request to the watcher of the requested chain.
+package reobservation
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+var (
+	reobservationRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_reobservation_requests_total",
+			Help: "Total number of observation requests received, by source, chain and outcome",
+		}, []string{"source", "chain_name", "result"})
+)
+
+// Possible values of the "result" label.
+const (
+	resultForwarded           = "forwarded"
+	resultDuplicate           = "duplicate"
+	resultGuardianRateLimited = "guardian_rate_limited"
+	resultChainRateLimited    = "chain_rate_limited"
+	resultGlobalRateLimited   = "global_rate_limited"
+	resultUnknownChain        = "unknown_chain"
+	resultWatcherChannelFull  = "watcher_channel_full"
+)
+
+// Config contains the tunables of a Handler.
+type Config struct {
+	// DedupWindow is how long a forwarded (chain, tx hash) pair suppresses identical requests.
+	DedupWindow time.Duration
+	// CleanupInterval is how often expired entries are removed from the dedup cache.
+	CleanupInterval time.Duration
+
+	// GuardianRate and GuardianBurst configure the token bucket applied to the requests of each other guardian
+	// separately, so that a single guardian can't use up the chain and global budgets. Requests issued by this
+	// node are not subject to it.
+	GuardianRate  rate.Limit
+	GuardianBurst int
+
+	// ChainRate and ChainBurst configure the token bucket applied to each chain separately.
+	ChainRate  rate.Limit
+	ChainBurst int
+
+	// GlobalRate and GlobalBurst configure the token bucket shared by all chains.
+	GlobalRate  rate.Limit
+	GlobalBurst int
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		// Due to the automatic re-observation requests sent out by the processor we may end
+		// up getting multiple requests to re-observe the same tx. Requests received in the
+		// last 11 minutes are cached so that we don't end up repeatedly re-observing the
+		// same transactions.
+		DedupWindow:     11 * time.Minute,
+		CleanupInterval: 7 * time.Minute,
+		GuardianRate:    rate.Limit(1),
+		GuardianBurst:   10,
+		ChainRate:       rate.Limit(2),
+		ChainBurst:      20,
+		GlobalRate:      rate.Limit(10),
+		GlobalBurst:     50,
+	}
+}
+
+// Rate limit tiers of ConfigWithLimits.
+const (
+	TierGuardian = "guardian"
+	TierChain    = "chain"
+	TierGlobal   = "global"
+)
+
+// ConfigWithLimits returns the default configuration with the rate limits of the given tiers, as parsed by
+// ratelimit.ParseLimits, replaced. Tiers other than TierGuardian, TierChain and TierGlobal are rejected.
+func ConfigWithLimits(limits map[string]ratelimit.Limit) (Config, error) {
+	cfg := DefaultConfig()
+	for tier, limit := range limits {
+		switch tier {
+		case TierGuardian:
+			cfg.GuardianRate, cfg.GuardianBurst = limit.Rate, limit.Burst
+		case TierChain:
+			cfg.ChainRate, cfg.ChainBurst = limit.Rate, limit.Burst
+		case TierGlobal:
+			cfg.GlobalRate, cfg.GlobalBurst = limit.Rate, limit.Burst
+		default:
+			return Config{}, fmt.Errorf(`unknown rate limit tier "%s", must be one of %s, %s or %s`, tier, TierGuardian, TierChain, TierGlobal)
+		}
+	}
+	return cfg, nil
+}
+
+type cachedRequest struct {
+	chainId vaa.ChainID
+	txHash  string
+}
+
+// Handler forwards observation requests to the watchers.
+type Handler struct {
+	logger        *zap.Logger
+	clock         clock.Clock
+	cfg           Config
+	chainObsvReqC map[vaa.ChainID]chan *gossipv1.ObservationRequest
+
+	cache            map[cachedRequest]time.Time
+	guardianLimiters map[string]*rate.Limiter
+	chainLimiters    map[vaa.ChainID]*rate.Limiter
+	globalLimiter    *rate.Limiter
+}
+
+// NewHandler returns a handler forwarding requests to chainObsvReqC. Requests for chains not in the map are dropped.
+func NewHandler(logger *zap.Logger, clock clock.Clock, cfg Config, chainObsvReqC map[vaa.ChainID]chan *gossipv1.ObservationRequest) *Handler {
+	return &Handler{
+		logger:           logger,
+		clock:            clock,
+		cfg:              cfg,
+		chainObsvReqC:    chainObsvReqC,
+		cache:            make(map[cachedRequest]time.Time),
+		guardianLimiters: make(map[string]*rate.Limiter),
+		chainLimiters:    make(map[vaa.ChainID]*rate.Limiter),
+		globalLimiter:    rate.NewLimiter(cfg.GlobalRate, cfg.GlobalBurst),
+	}
+}
+
+// Run processes requests from obsvReqC until ctx is cancelled.
+func (h *Handler) Run(ctx context.Context, obsvReqC <-chan *common.InboundObservationRequest) {
+	ticker := h.clock.Ticker(h.cfg.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.cleanup()
+		case req := <-obsvReqC:
+			h.handle(req)
+		}
+	}
+}
+
+func (h *Handler) cleanup() {
+	now := h.clock.Now()
+	for r, t := range h.cache {
+		if now.Sub(t) > h.cfg.DedupWindow {
+			delete(h.cache, r)
+		}
+	}
+}
+
+func (h *Handler) handle(in *common.InboundObservationRequest) {
+	req := in.Request
+	r := cachedRequest{
+		chainId: vaa.ChainID(req.ChainId),
+		txHash:  hex.EncodeToString(req.TxHash),
+	}
+
+	result := h.route(r, req, in.Source)
+	reobservationRequests.WithLabelValues(in.Source, r.chainId.String(), result).Inc()
+
+	switch result {
+	case resultForwarded:
+		h.logger.Debug("forwarded re-observation request to watcher",
+			zap.Stringer("chain", r.chainId),
+			zap.String("tx_hash", r.txHash),
+			zap.String("source", in.Source))
+	case resultDuplicate:
+		// We've recently seen a re-observation request for this tx so skip this one.
+		h.logger.Info("skipping duplicate re-observation request",
+			zap.Stringer("chain", r.chainId),
+			zap.String("tx_hash", r.txHash),
+			zap.String("source", in.Source))
+	case resultUnknownChain:
+		h.logger.Error("unknown chain ID for reobservation request",
+			zap.Uint16("chain_id", uint16(r.chainId)),
+			zap.String("tx_hash", r.txHash),
+			zap.String("source", in.Source))
+	default:
+		h.logger.Warn("dropping reobservation request",
+			zap.String("reason", result),
+			zap.Stringer("chain_id", r.chainId),
+			zap.String("tx_hash", r.txHash),
+			zap.String("source", in.Source))
+	}
+}
+
+// route decides what to do with a request and forwards it if appropriate, returning the outcome.
+func (h *Handler) route(r cachedRequest, req *gossipv1.ObservationRequest, source string) string {
+	now := h.clock.Now()
+
+	if t, ok := h.cache[r]; ok && now.Sub(t) <= h.cfg.DedupWindow {
+		return resultDuplicate
+	}
+
+	channel, ok := h.chainObsvReqC[r.chainId]
+	if !ok {
+		return resultUnknownChain
+	}
+
+	// Check the per-guardian limit first so that a single guardian cannot drain the chain and global budgets.
+	if source != common.ObservationRequestSourceLocal {
+		limiter, ok := h.guardianLimiters[source]
+		if !ok {
+			limiter = rate.NewLimiter(h.cfg.GuardianRate, h.cfg.GuardianBurst)
+			h.guardianLimiters[source] = limiter
+		}
+		if !limiter.AllowN(now, 1) {
+			return resultGuardianRateLimited
+		}
+	}
+
+	// Check the per-chain limit next so that a single noisy chain cannot drain the global budget.
+	limiter, ok := h.chainLimiters[r.chainId]
+	if !ok {
+		limiter = rate.NewLimiter(h.cfg.ChainRate, h.cfg.ChainBurst)
+		h.chainLimiters[r.chainId] = limiter
+	}
+	if !limiter.AllowN(now, 1) {
+		return resultChainRateLimited
+	}
+	if !h.globalLimiter.AllowN(now, 1) {
+		return resultGlobalRateLimited
+	}
+
+	select {
+	case channel <- req:
+		h.cache[r] = now
+		return resultForwarded
+	default:
+		return resultWatcherChannelFull
+	}
+}
@@ -1,4 +1,4 @@
-package guardiand
+package reobservation
 
 import (
 	"context"
@@ -6,33 +6,40 @@ import (
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 type reobservationTestContext struct {
 	context.Context
 	clock         *clock.Mock
-	obsvReqC      chan *gossipv1.ObservationRequest
+	obsvReqC      chan<- *common.InboundObservationRequest
 	chainObsvReqC map[vaa.ChainID]chan *gossipv1.ObservationRequest
 }
 
 func setUpReobservationTest() (reobservationTestContext, func()) {
+	return setUpReobservationTestWithConfig(DefaultConfig())
+}
+
+func setUpReobservationTestWithConfig(cfg Config) (reobservationTestContext, func()) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 
 	clock := clock.NewMock()
 
-	obsvReqC := make(chan *gossipv1.ObservationRequest)
+	obsvReqC := make(chan *common.InboundObservationRequest)
 
 	chainObsvReqC := make(map[vaa.ChainID]chan *gossipv1.ObservationRequest)
 	for i := 0; i < 10; i++ {
 		chainObsvReqC[vaa.ChainID(i)] = make(chan *gossipv1.ObservationRequest, 1)
 	}
 
-	go handleReobservationRequests(ctx, clock, zap.NewNop(), obsvReqC, chainObsvReqC)
+	go NewHandler(zap.NewNop(), clock, cfg, chainObsvReqC).Run(ctx, obsvReqC)
 
 	tc := reobservationTestContext{
 		Context:       ctx,
@@ -43,6 +50,11 @@ func setUpReobservationTest() (reobservationTestContext, func()) {
 	return tc, cancel
 }
 
+// send submits a request as if it had been received from another guardian.
+func (tc reobservationTestContext) send(req *gossipv1.ObservationRequest) {
+	tc.obsvReqC <- &common.InboundObservationRequest{Request: req, Source: "0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5"}
+}
+
 func readFromChannel(parent context.Context, c <-chan *gossipv1.ObservationRequest) (*gossipv1.ObservationRequest, bool) {
 	ctx, cancel := context.WithTimeout(parent, 50*time.Millisecond)
 	defer cancel()
@@ -64,7 +76,7 @@ func TestReobservationRequest(t *testing.T) {
 		TxHash:  []byte{0xe5, 0x9c, 0x1b, 0xe5, 0x0b, 0xe7, 0xe4, 0x7e},
 	}
 
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	actual, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	require.True(t, ok)
@@ -81,14 +93,14 @@ func TestDuplicateReobservation(t *testing.T) {
 		TxHash:  []byte{0xe5, 0x9c, 0x1b, 0xe5, 0x0b, 0xe7, 0xe4, 0x7e},
 	}
 
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	actual, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	require.True(t, ok)
 	assert.Equal(t, req, actual)
 
 	// Receiving the same request again should not trigger another re-observation.
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	assert.False(t, ok)
@@ -103,7 +115,7 @@ func TestMultipleReobservations(t *testing.T) {
 		TxHash:  []byte{0xe5, 0x9c, 0x1b, 0xe5, 0x0b, 0xe7, 0xe4, 0x7e},
 	}
 
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	actual, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	require.True(t, ok)
@@ -112,7 +124,7 @@ func TestMultipleReobservations(t *testing.T) {
 	// Send a request for the same chain id but different tx hash.
 	req.TxHash = []byte{0x6e, 0xf0, 0xa6, 0xba, 0x47, 0x3d, 0x34, 0x51}
 
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	actual, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	require.True(t, ok)
@@ -120,7 +132,7 @@ func TestMultipleReobservations(t *testing.T) {
 
 	// Send a request for the same tx hash but different chain id.
 	req.ChainId = 3
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	actual, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	require.True(t, ok)
@@ -136,7 +148,7 @@ func TestReobserveUnknownChainId(t *testing.T) {
 		TxHash:  []byte{0xe5, 0x9c, 0x1b, 0xe5, 0x0b, 0xe7, 0xe4, 0x7e},
 	}
 
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	_, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	assert.False(t, ok)
@@ -151,7 +163,7 @@ func TestReobservationCacheEviction(t *testing.T) {
 		TxHash:  []byte{0xe5, 0x9c, 0x1b, 0xe5, 0x0b, 0xe7, 0xe4, 0x7e},
 	}
 
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	actual, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	require.True(t, ok)
@@ -161,7 +173,7 @@ func TestReobservationCacheEviction(t *testing.T) {
 	ctx.clock.Add(7*time.Minute + 30*time.Second)
 
 	// Receiving the same request again should not trigger another re-observation.
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	assert.False(t, ok)
@@ -171,7 +183,7 @@ func TestReobservationCacheEviction(t *testing.T) {
 	ctx.clock.Add(7 * time.Minute)
 
 	// This time the request should be passed through.
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	actual, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req.ChainId)])
 	require.True(t, ok)
@@ -188,14 +200,14 @@ func TestBlockingSend(t *testing.T) {
 	}
 
 	// Send one reobservation request but don't drain it from the chain-specific channel.
-	ctx.obsvReqC <- req
+	ctx.send(req)
 
 	// Now send another request for the same chain id but different tx hash.  This should get dropped.
 	req2 := &gossipv1.ObservationRequest{
 		ChainId: 1,
 		TxHash:  []byte{0x96, 0xe3, 0x94, 0xec, 0x5a, 0x00, 0xfc, 0x8b},
 	}
-	ctx.obsvReqC <- req2
+	ctx.send(req2)
 
 	// This is a bit awkward but we need to wait until the goroutine handling the requests has finished
 	// processing the second request.  If we read from the channel too quickly then we might pop out the
@@ -210,3 +222,124 @@ func TestBlockingSend(t *testing.T) {
 	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(req2.ChainId)])
 	assert.False(t, ok)
 }
+
+func TestChainRateLimit(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ChainRate = 1
+	cfg.ChainBurst = 1
+	ctx, cancel := setUpReobservationTestWithConfig(cfg)
+	defer cancel()
+
+	req := &gossipv1.ObservationRequest{ChainId: 1, TxHash: []byte{0x01}}
+	ctx.send(req)
+	_, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(1)])
+	require.True(t, ok)
+
+	// A second request for the same chain exceeds the burst and is dropped...
+	ctx.send(&gossipv1.ObservationRequest{ChainId: 1, TxHash: []byte{0x02}})
+	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(1)])
+	assert.False(t, ok)
+
+	// ...while other chains are unaffected.
+	ctx.send(&gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{0x02}})
+	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(2)])
+	assert.True(t, ok)
+
+	// Once the bucket has refilled, requests for the first chain are accepted again.
+	ctx.clock.Add(time.Second)
+	ctx.send(&gossipv1.ObservationRequest{ChainId: 1, TxHash: []byte{0x02}})
+	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(1)])
+	assert.True(t, ok)
+}
+
+func TestGlobalRateLimit(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.GlobalRate = 1
+	cfg.GlobalBurst = 2
+	ctx, cancel := setUpReobservationTestWithConfig(cfg)
+	defer cancel()
+
+	for chain := 1; chain <= 3; chain++ {
+		ctx.send(&gossipv1.ObservationRequest{ChainId: uint32(chain), TxHash: []byte{0x01}})
+		_, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(chain)])
+		assert.Equal(t, chain <= 2, ok, "chain %d", chain)
+	}
+}
+
+func TestRateLimitedRequestIsNotCached(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ChainRate = 1
+	cfg.ChainBurst = 1
+	ctx, cancel := setUpReobservationTestWithConfig(cfg)
+	defer cancel()
+
+	ctx.send(&gossipv1.ObservationRequest{ChainId: 1, TxHash: []byte{0x01}})
+	_, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(1)])
+	require.True(t, ok)
+
+	req := &gossipv1.ObservationRequest{ChainId: 1, TxHash: []byte{0x02}}
+	ctx.send(req)
+	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(1)])
+	require.False(t, ok)
+
+	// A request dropped due to rate limiting must not be treated as a duplicate later on.
+	ctx.clock.Add(time.Second)
+	ctx.send(req)
+	actual, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(1)])
+	require.True(t, ok)
+	assert.Equal(t, req, actual)
+}
+
+func TestGuardianRateLimit(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.GuardianRate = 1
+	cfg.GuardianBurst = 1
+	ctx, cancel := setUpReobservationTestWithConfig(cfg)
+	defer cancel()
+
+	ctx.send(&gossipv1.ObservationRequest{ChainId: 1, TxHash: []byte{0x01}})
+	_, ok := readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(1)])
+	require.True(t, ok)
+
+	// A second request from the same guardian exceeds its burst, even for another chain...
+	ctx.send(&gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{0x01}})
+	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(2)])
+	assert.False(t, ok)
+
+	// ...while other guardians and this node are unaffected.
+	ctx.obsvReqC <- &common.InboundObservationRequest{Request: &gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{0x02}}, Source: "0xfF6CB952589BDE862c25Ef4392132fb9D4A42157"}
+	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(2)])
+	assert.True(t, ok)
+
+	for i := byte(0); i < 3; i++ {
+		ctx.obsvReqC <- &common.InboundObservationRequest{Request: &gossipv1.ObservationRequest{ChainId: 3, TxHash: []byte{i}}, Source: common.ObservationRequestSourceLocal}
+		_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(3)])
+		assert.True(t, ok)
+	}
+
+	// Once the bucket has refilled, requests from the first guardian are accepted again.
+	ctx.clock.Add(time.Second)
+	ctx.send(&gossipv1.ObservationRequest{ChainId: 2, TxHash: []byte{0x03}})
+	_, ok = readFromChannel(ctx, ctx.chainObsvReqC[vaa.ChainID(2)])
+	assert.True(t, ok)
+}
+
+func TestConfigWithLimits(t *testing.T) {
+	limits, err := ratelimit.ParseLimits("guardian=0.5:5,global=20")
+	require.NoError(t, err)
+	cfg, err := ConfigWithLimits(limits)
+	require.NoError(t, err)
+
+	def := DefaultConfig()
+	assert.Equal(t, rate.Limit(0.5), cfg.GuardianRate)
+	assert.Equal(t, 5, cfg.GuardianBurst)
+	assert.Equal(t, def.ChainRate, cfg.ChainRate)
+	assert.Equal(t, def.ChainBurst, cfg.ChainBurst)
+	assert.Equal(t, rate.Limit(20), cfg.GlobalRate)
+	assert.Equal(t, 20, cfg.GlobalBurst)
+
+	limits, err = ratelimit.ParseLimits("ethereum=1")
+	require.NoError(t, err)
+	_, err = ConfigWithLimits(limits)
+	assert.ErrorContains(t, err, "unknown rate limit tier")
+}
@@ -0,0 +1,231 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// The webhook writer pushes the signed VAAs of selected emitters to an HTTP endpoint as soon as the node sees them reach
+// quorum, so small integrators get a push pipeline without running a spy. Deliveries are retried, so the endpoint may
+// receive the same VAA more than once and must be idempotent.
+
+const (
+	// webhookRequestTimeout is the timeout of a single delivery attempt.
+	webhookRequestTimeout = 10 * time.Second
+
+	// webhookMaxElapsedTime is how long a delivery is retried before the VAA is dropped.
+	webhookMaxElapsedTime = 5 * time.Minute
+
+	// webhookQueueSize is the number of VAAs that may be waiting for delivery. While the endpoint is down, deliveries
+	// are retried one at a time, and VAAs arriving once the queue is full are dropped rather than holding up the
+	// attestation events of the other subscribers.
+	webhookQueueSize = 1000
+)
+
+var webhookDeliveries = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormhole_vaa_webhook_deliveries_total",
+		Help: "Total number of signed VAAs pushed to the webhook by result",
+	}, []string{"result"})
+
+// WebhookEmitter identifies an emitter whose VAAs are pushed to the webhook.
+type WebhookEmitter struct {
+	EmitterChain   vaa.ChainID
+	EmitterAddress vaa.Address
+}
+
+type WebhookConfig struct {
+	// URL is the endpoint the VAAs are POSTed to.
+	URL string
+	// Emitters are the emitters whose VAAs are pushed.
+	Emitters []WebhookEmitter
+}
+
+// WebhookPayload is the JSON body posted to the webhook for each VAA.
+type WebhookPayload struct {
+	EmitterChain   vaa.ChainID `json:"emitterChain"`
+	EmitterAddress string      `json:"emitterAddress"`
+	Sequence       uint64      `json:"sequence"`
+	// VAA is the signed VAA, base64 encoded.
+	VAA []byte `json:"vaa"`
+}
+
+// ParseWebhookEmitters parses a comma separated list of emitters of the form chain:emitterAddress, where the chain is a
+// chain ID or name and the address is the hex encoded 32 byte emitter address.
+func ParseWebhookEmitters(config string) ([]WebhookEmitter, error) {
+	var ret []WebhookEmitter
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Split(entry, ":")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf(`invalid webhook emitter "%s", should be chain:emitterAddress`, entry)
+		}
+
+		chainID, err := vaa.ChainIDFromString(fields[0])
+		if err != nil {
+			i, err := strconv.ParseUint(fields[0], 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid chain in webhook emitter "%s", should be a chain name or ID`, entry)
+			}
+			chainID = vaa.ChainID(i)
+		}
+
+		address, err := vaa.StringToAddress(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf(`invalid address in webhook emitter "%s": %w`, entry, err)
+		}
+
+		ret = append(ret, WebhookEmitter{EmitterChain: chainID, EmitterAddress: address})
+	}
+
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("no webhook emitters specified")
+	}
+
+	return ret, nil
+}
+
+type webhookWriter struct {
+	config   *WebhookConfig
+	client   *http.Client
+	emitters map[WebhookEmitter]struct{}
+}
+
+// WebhookWriter returns a runnable that pushes the signed VAAs of the configured emitters to the webhook.
+func WebhookWriter(events *AttestationEventReporter, config *WebhookConfig) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		logger := supervisor.Logger(ctx)
+
+		w := &webhookWriter{
+			config:   config,
+			client:   &http.Client{Timeout: webhookRequestTimeout},
+			emitters: make(map[WebhookEmitter]struct{}, len(config.Emitters)),
+		}
+		for _, e := range config.Emitters {
+			w.emitters[e] = struct{}{}
+		}
+
+		queue := make(chan *vaa.VAA, webhookQueueSize)
+		errC := make(chan error, 1)
+		common.RunWithScissors(ctx, errC, "vaawebhook_worker", func(ctx context.Context) error {
+			return w.deliverQueued(ctx, logger, queue)
+		})
+
+		sub := events.Subscribe()
+		defer events.Unsubscribe(sub.ClientId)
+		logger.Info("pushing signed VAAs to webhook", zap.String("url", config.URL), zap.Int("numEmitters", len(w.emitters)))
+
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err := <-errC:
+				return fmt.Errorf("webhook worker failed: %w", err)
+			case <-sub.Channels.MessagePublicationC:
+				// Only signed VAAs are pushed.
+			case v := <-sub.Channels.VAAQuorumC:
+				if !w.matches(v) {
+					continue
+				}
+
+				select {
+				case queue <- v:
+				default:
+					webhookDeliveries.WithLabelValues("dropped").Inc()
+					logger.Error("webhook delivery queue is full, dropping signed VAA", zap.String("message_id", v.MessageID()))
+				}
+			}
+		}
+	}
+}
+
+// deliverQueued pushes the VAAs in the queue to the webhook one at a time, until the context is canceled.
+func (w *webhookWriter) deliverQueued(ctx context.Context, logger *zap.Logger, queue <-chan *vaa.VAA) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case v := <-queue:
+			if err := w.deliver(ctx, v); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				webhookDeliveries.WithLabelValues("failed").Inc()
+				logger.Error("failed to push signed VAA to webhook, dropping it",
+					zap.String("message_id", v.MessageID()),
+					zap.Error(err))
+				continue
+			}
+
+			webhookDeliveries.WithLabelValues("delivered").Inc()
+			logger.Debug("pushed signed VAA to webhook", zap.String("message_id", v.MessageID()))
+		}
+	}
+}
+
+func (w *webhookWriter) matches(v *vaa.VAA) bool {
+	_, exists := w.emitters[WebhookEmitter{EmitterChain: v.EmitterChain, EmitterAddress: v.EmitterAddress}]
+	return exists
+}
+
+// deliver posts the VAA to the webhook, retrying with an exponential backoff until it is accepted with a 2xx status or
+// webhookMaxElapsedTime has passed.
+func (w *webhookWriter) deliver(ctx context.Context, v *vaa.VAA) error {
+	b, err := v.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal VAA: %w", err)
+	}
+
+	body, err := json.Marshal(WebhookPayload{
+		EmitterChain:   v.EmitterChain,
+		EmitterAddress: v.EmitterAddress.String(),
+		Sequence:       v.Sequence,
+		VAA:            b,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = webhookMaxElapsedTime
+
+	return backoff.Retry(func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := w.client.Do(req)
+		if err != nil {
+			webhookDeliveries.WithLabelValues("retried").Inc()
+			return err
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			webhookDeliveries.WithLabelValues("retried").Inc()
+			return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+		}
+		return nil
+	}, backoff.WithContext(bo, ctx))
+}
@@ -0,0 +1,134 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const webhookTestEmitter = "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"
+
+func TestParseWebhookEmitters(t *testing.T) {
+	emitters, err := ParseWebhookEmitters("2:" + webhookTestEmitter + ", solana:" + webhookTestEmitter)
+	require.NoError(t, err)
+	require.Len(t, emitters, 2)
+	assert.Equal(t, vaa.ChainIDEthereum, emitters[0].EmitterChain)
+	assert.Equal(t, webhookTestEmitter, emitters[0].EmitterAddress.String())
+	assert.Equal(t, vaa.ChainIDSolana, emitters[1].EmitterChain)
+
+	_, err = ParseWebhookEmitters("")
+	assert.ErrorContains(t, err, "no webhook emitters")
+
+	_, err = ParseWebhookEmitters("2")
+	assert.ErrorContains(t, err, "should be chain:emitterAddress")
+
+	_, err = ParseWebhookEmitters("junk:" + webhookTestEmitter)
+	assert.ErrorContains(t, err, "invalid chain")
+
+	_, err = ParseWebhookEmitters("2:junk")
+	assert.ErrorContains(t, err, "invalid address")
+}
+
+func TestWebhookDeliver(t *testing.T) {
+	var calls int32
+	var received WebhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first attempt to exercise the retry.
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	emitters, err := ParseWebhookEmitters("2:" + webhookTestEmitter)
+	require.NoError(t, err)
+	w := &webhookWriter{
+		config:   &WebhookConfig{URL: srv.URL, Emitters: emitters},
+		client:   srv.Client(),
+		emitters: map[WebhookEmitter]struct{}{emitters[0]: {}},
+	}
+
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		Timestamp:        time.Unix(1000, 0),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitters[0].EmitterAddress,
+		Sequence:         42,
+		Payload:          []byte("payload"),
+		ConsistencyLevel: 1,
+	}
+	assert.True(t, w.matches(v))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, w.deliver(ctx, v))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	assert.Equal(t, vaa.ChainIDEthereum, received.EmitterChain)
+	assert.Equal(t, webhookTestEmitter, received.EmitterAddress)
+	assert.Equal(t, uint64(42), received.Sequence)
+	expected, err := v.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, expected, received.VAA)
+
+	other := *v
+	other.EmitterChain = vaa.ChainIDSolana
+	assert.False(t, w.matches(&other))
+}
+
+func TestWebhookDeliverQueued(t *testing.T) {
+	received := make(chan uint64, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload WebhookPayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		received <- payload.Sequence
+	}))
+	defer srv.Close()
+
+	w := &webhookWriter{
+		config: &WebhookConfig{URL: srv.URL},
+		client: srv.Client(),
+	}
+
+	addr, err := vaa.StringToAddress(webhookTestEmitter)
+	require.NoError(t, err)
+	queue := make(chan *vaa.VAA, 2)
+	for seq := uint64(1); seq <= 2; seq++ {
+		queue <- &vaa.VAA{
+			Version:        vaa.SupportedVAAVersion,
+			Timestamp:      time.Unix(1000, 0),
+			EmitterChain:   vaa.ChainIDEthereum,
+			EmitterAddress: addr,
+			Sequence:       seq,
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.deliverQueued(ctx, zap.NewNop(), queue) }()
+
+	// The queued VAAs are delivered in order.
+	for seq := uint64(1); seq <= 2; seq++ {
+		select {
+		case got := <-received:
+			assert.Equal(t, seq, got)
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the webhook delivery")
+		}
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+}
@@ -80,6 +80,9 @@ type supervisor struct {
 
 	// propagate panics, ie. don't catch them.
 	propagatePanic bool
+
+	// defaultPolicy is the restart policy of runnables that were not started with a specific one.
+	defaultPolicy RestartPolicy
 }
 
 // SupervisorOpt are runtime configurable options for the supervisor.
@@ -100,13 +103,15 @@ func New(ctx context.Context, logger *zap.Logger, rootRunnable Runnable, opts ..
 		logger:  logger,
 		ilogger: logger.Named("supervisor"),
 		pReq:    make(chan *processorRequest),
+
+		defaultPolicy: DefaultRestartPolicy,
 	}
 
 	for _, o := range opts {
 		o(sup)
 	}
 
-	sup.root = newNode("root", rootRunnable, sup, nil)
+	sup.root = newNode("root", rootRunnable, sup, nil, sup.defaultPolicy)
 
 	go sup.processor(ctx)
 
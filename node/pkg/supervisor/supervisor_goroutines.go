@@ -0,0 +1,132 @@
+package supervisor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// The supervisor labels the goroutine of every runnable with the DN of the runnable, using pprof labels. Goroutines
+// inherit the labels of the goroutine starting them, so every goroutine started by a runnable (but not its child
+// runnables, which are started by the supervisor) can be attributed to it. This allows finding the runnable leaking
+// goroutines in a running node.
+
+// runnableLabel is the pprof label holding the DN of the runnable that started a goroutine.
+const runnableLabel = "supervisor_dn"
+
+// setRunnableLabel labels the calling goroutine, and the goroutines it starts, with the DN of a runnable.
+func setRunnableLabel(dn string) {
+	pprof.SetGoroutineLabels(pprof.WithLabels(context.Background(), pprof.Labels(runnableLabel, dn)))
+}
+
+// GoroutinesByRunnable returns the number of live goroutines started by each runnable, by DN. Goroutines that were not
+// started by a runnable are not counted.
+func GoroutinesByRunnable() (map[string]int, error) {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return nil, fmt.Errorf("failed to write goroutine profile: %w", err)
+	}
+	return parseGoroutineProfile(&buf)
+}
+
+// parseGoroutineProfile counts the goroutines of each runnable in a goroutine profile in the debug=1 text format, in
+// which goroutines with the same stack and labels are grouped as:
+//
+//	3 @ 0x43a8d6 0x44a2b2 ...
+//	# labels: {"supervisor_dn":"root.foo"}
+//	#	0x43a8d5	runtime.gopark+0xd5	...
+func parseGoroutineProfile(buf *bytes.Buffer) (map[string]int, error) {
+	counts := make(map[string]int)
+
+	count := 0
+	scanner := bufio.NewScanner(buf)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if countStr, _, ok := strings.Cut(line, " @ "); ok {
+			n, err := strconv.Atoi(countStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid goroutine count in %q: %w", line, err)
+			}
+			count = n
+			continue
+		}
+
+		if strings.HasPrefix(line, "# labels: ") && count != 0 {
+			labelsStr := strings.TrimPrefix(line, "# labels: ")
+			var labels map[string]string
+			if err := json.Unmarshal([]byte(labelsStr), &labels); err != nil {
+				return nil, fmt.Errorf("invalid goroutine labels %q: %w", labelsStr, err)
+			}
+			if dn, ok := labels[runnableLabel]; ok {
+				counts[dn] += count
+			}
+			count = 0
+			continue
+		}
+
+		if line == "" {
+			count = 0
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// Restart cancels the context of the runnable at dn, so that the supervisor restarts it once it has returned, along
+// with its children. It is meant for components like a watchdog, which restart a misbehaving runnable rather than the
+// whole node. The root runnable cannot be restarted.
+func Restart(ctx context.Context, dn string) error {
+	sup, ok := ctx.Value(supervisorKey).(*supervisor)
+	if !ok {
+		panic("supervisor function called from non-runnable context")
+	}
+
+	sup.mu.Lock()
+	defer sup.mu.Unlock()
+
+	n := sup.findNodeByDN(dn)
+	if n == nil {
+		return fmt.Errorf("no runnable %s", dn)
+	}
+	if n.parent == nil {
+		return fmt.Errorf("the root runnable cannot be restarted")
+	}
+	if n.state != nodeStateNew && n.state != nodeStateHealthy {
+		return fmt.Errorf("runnable %s is not running (%s)", dn, n.state)
+	}
+
+	sup.ilogger.Warn("restarting runnable on request", zap.String("dn", dn))
+	n.ctxC()
+	return nil
+}
+
+// findNodeByDN is like nodeByDN, but returns nil if there is no node at dn.
+func (s *supervisor) findNodeByDN(dn string) *node {
+	parts := strings.Split(dn, ".")
+	if parts[0] != "root" {
+		return nil
+	}
+
+	cur := s.root
+	for _, part := range parts[1:] {
+		next, ok := cur.children[part]
+		if !ok {
+			return nil
+		}
+		cur = next
+	}
+	return cur
+}
@@ -0,0 +1,72 @@
+package supervisor
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestParseGoroutineProfile(t *testing.T) {
+	profile := `goroutine profile: total 6
+3 @ 0x43a8d6 0x44a2b2
+# labels: {"supervisor_dn":"root.foo"}
+#	0x43a8d5	runtime.gopark+0xd5	/usr/lib/go/src/runtime/proc.go:363
+
+1 @ 0x43a8d6 0x44a2b2
+# labels: {"other":"x", "supervisor_dn":"root.foo.bar"}
+#	0x43a8d5	runtime.gopark+0xd5	/usr/lib/go/src/runtime/proc.go:363
+
+2 @ 0x43a8d6 0x44a2b2
+#	0x43a8d5	runtime.gopark+0xd5	/usr/lib/go/src/runtime/proc.go:363
+`
+	counts, err := parseGoroutineProfile(bytes.NewBufferString(profile))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"root.foo": 3, "root.foo.bar": 1}, counts)
+}
+
+func TestGoroutinesByRunnableAndRestart(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	started := make(chan struct{}, 10)
+	var rootCtx context.Context
+	s := New(ctx, zap.NewNop(), func(ctx context.Context) error {
+		rootCtx = ctx
+		if err := Run(ctx, "leaky", func(ctx context.Context) error {
+			for i := 0; i < 5; i++ {
+				go func() { <-ctx.Done() }()
+			}
+			started <- struct{}{}
+			Signal(ctx, SignalHealthy)
+			<-ctx.Done()
+			return ctx.Err()
+		}); err != nil {
+			return err
+		}
+		Signal(ctx, SignalHealthy)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	<-started
+	s.waitSettleError(ctx, t)
+
+	counts, err := GoroutinesByRunnable()
+	require.NoError(t, err)
+	// The runnable itself and the goroutines it started.
+	assert.Equal(t, 6, counts["root.leaky"])
+
+	require.NoError(t, Restart(rootCtx, "root.leaky"))
+	select {
+	case <-started:
+	case <-ctx.Done():
+		t.Fatal("runnable was not restarted")
+	}
+
+	assert.Error(t, Restart(rootCtx, "root"))
+	assert.Error(t, Restart(rootCtx, "root.missing"))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"go.uber.org/zap"
@@ -34,6 +35,9 @@ type node struct {
 
 	// Backoff used to keep runnables from being restarted too fast.
 	bo *backoff.ExponentialBackOff
+	// Restart policy of the runnable, and the times it was restarted within the window of the policy.
+	policy   RestartPolicy
+	restarts []time.Time
 
 	// Context passed to the runnable, and its cancel function.
 	ctx  context.Context
@@ -131,17 +135,14 @@ func (n *node) groupSiblings(name string) map[string]bool {
 
 // newNode creates a new node with a given parent. It does not register it with the parent (as that depends on group
 // placement).
-func newNode(name string, runnable Runnable, sup *supervisor, parent *node) *node {
+func newNode(name string, runnable Runnable, sup *supervisor, parent *node, policy RestartPolicy) *node {
 	// We use exponential backoff for failed runnables, but at some point we cap at a given backoff time.
-	// To achieve this, we set MaxElapsedTime to 0, which will cap the backoff at MaxInterval.
-	bo := backoff.NewExponentialBackOff()
-	bo.MaxElapsedTime = 0
-
 	n := &node{
 		name:     name,
 		runnable: runnable,
 
-		bo: bo,
+		bo:     policy.newBackOff(),
+		policy: policy,
 
 		sup:    sup,
 		parent: parent,
@@ -202,8 +203,13 @@ func (s *supervisor) nodeByDN(dn string) *node {
 // reNodeName validates a node name against constraints.
 var reNodeName = regexp.MustCompile(`[a-z90-9_]{1,64}`)
 
-// runGroup schedules a new group of runnables to run on a node.
+// runGroup schedules a new group of runnables to run on a node, with the default restart policy.
 func (n *node) runGroup(runnables map[string]Runnable) error {
+	return n.runGroupWithPolicy(runnables, n.sup.defaultPolicy)
+}
+
+// runGroupWithPolicy schedules a new group of runnables to run on a node, with the given restart policy.
+func (n *node) runGroupWithPolicy(runnables map[string]Runnable, policy RestartPolicy) error {
 	// Check that the parent node is in the right state.
 	if n.state != nodeStateNew {
 		return fmt.Errorf("cannot run new runnable on non-NEW node")
@@ -226,7 +232,7 @@ func (n *node) runGroup(runnables map[string]Runnable) error {
 		if g := n.groupSiblings(name); g != nil {
 			return fmt.Errorf("duplicate child name %q", name)
 		}
-		node := newNode(name, runnable, n.sup, n)
+		node := newNode(name, runnable, n.sup, n, policy)
 		n.children[name] = node
 
 		dns[name] = node.dn()
@@ -0,0 +1,128 @@
+package supervisor
+
+// Restart policies control how fast a runnable that keeps failing gets restarted. Runnables that die are restarted
+// after an exponential backoff with jitter, capped at a maximum interval. On top of that, a policy can limit the number
+// of restarts within a time window: once the limit is reached, the runnable is only restarted after the maximum
+// backoff until its older restarts leave the window. This keeps flapping runnables, for instance a watcher that
+// signals healthy and dies right after, from hot-looping.
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	runnableRestarts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "supervisor_runnable_restarts_total",
+			Help: "Total number of restarts of supervised runnables by cause (died or canceled)",
+		}, []string{"dn", "cause"})
+	runnableRestartBackoff = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "supervisor_runnable_restart_backoff_seconds",
+			Help: "Backoff applied before the last restart of a supervised runnable that died",
+		}, []string{"dn"})
+	runnableRestartsThrottled = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "supervisor_runnable_restarts_throttled_total",
+			Help: "Total number of restarts of supervised runnables delayed because they exceeded the restart limit of their policy",
+		}, []string{"dn"})
+)
+
+// RestartPolicy defines how a runnable that died gets restarted.
+type RestartPolicy struct {
+	// InitialBackoff is the backoff before the first restart after the runnable was last healthy.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponential backoff.
+	MaxBackoff time.Duration
+	// Jitter is the randomization factor applied to each backoff, between 0 (none) and 1.
+	Jitter float64
+	// MaxRestarts is the number of restarts allowed within Window before every restart is delayed by MaxBackoff.
+	// Zero means no limit.
+	MaxRestarts int
+	// Window is the time window over which MaxRestarts is counted.
+	Window time.Duration
+}
+
+// DefaultRestartPolicy is the policy used for runnables that were not started with a specific one. It matches the
+// defaults of the backoff library with no restart limit.
+var DefaultRestartPolicy = RestartPolicy{
+	InitialBackoff: backoff.DefaultInitialInterval,
+	MaxBackoff:     backoff.DefaultMaxInterval,
+	Jitter:         backoff.DefaultRandomizationFactor,
+}
+
+// validate checks that the policy is usable.
+func (p RestartPolicy) validate() error {
+	if p.InitialBackoff <= 0 || p.MaxBackoff < p.InitialBackoff {
+		return fmt.Errorf("invalid backoff range [%s, %s]", p.InitialBackoff, p.MaxBackoff)
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("invalid jitter %f", p.Jitter)
+	}
+	if p.MaxRestarts < 0 || (p.MaxRestarts > 0 && p.Window <= 0) {
+		return fmt.Errorf("invalid restart limit of %d per %s", p.MaxRestarts, p.Window)
+	}
+	return nil
+}
+
+// newBackOff returns the exponential backoff implementing the policy.
+func (p RestartPolicy) newBackOff() *backoff.ExponentialBackOff {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = p.InitialBackoff
+	bo.MaxInterval = p.MaxBackoff
+	bo.RandomizationFactor = p.Jitter
+	// Setting MaxElapsedTime to 0 caps the backoff at MaxInterval instead of stopping.
+	bo.MaxElapsedTime = 0
+	bo.Reset()
+	return bo
+}
+
+// WithDefaultRestartPolicy sets the policy used for runnables that were not started with a specific one. It panics if
+// the policy is invalid.
+func WithDefaultRestartPolicy(policy RestartPolicy) SupervisorOpt {
+	if err := policy.validate(); err != nil {
+		panic(fmt.Errorf("invalid restart policy: %w", err))
+	}
+	return func(s *supervisor) {
+		s.defaultPolicy = policy
+	}
+}
+
+// RunWithRestartPolicy starts a single runnable in its own group, restarted according to the given policy.
+func RunWithRestartPolicy(ctx context.Context, name string, policy RestartPolicy, runnable Runnable) error {
+	if err := policy.validate(); err != nil {
+		return fmt.Errorf("invalid restart policy for %q: %w", name, err)
+	}
+	node, unlock := fromContext(ctx)
+	defer unlock()
+	return node.runGroupWithPolicy(map[string]Runnable{name: runnable}, policy)
+}
+
+// nextBackOff returns how long to wait before restarting a node that died at the given time, and records the restart.
+func (n *node) nextBackOff(now time.Time) time.Duration {
+	bo := n.bo.NextBackOff()
+
+	if n.policy.MaxRestarts > 0 {
+		// Forget the restarts that left the window.
+		cutoff := now.Add(-n.policy.Window)
+		i := 0
+		for i < len(n.restarts) && n.restarts[i].Before(cutoff) {
+			i++
+		}
+		n.restarts = append(n.restarts[i:], now)
+
+		if len(n.restarts) > n.policy.MaxRestarts {
+			bo = n.policy.MaxBackoff
+			runnableRestartsThrottled.WithLabelValues(n.dn()).Inc()
+		}
+	}
+
+	runnableRestartBackoff.WithLabelValues(n.dn()).Set(bo.Seconds())
+	return bo
+}
@@ -0,0 +1,56 @@
+package supervisor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartPolicyValidate(t *testing.T) {
+	assert.NoError(t, DefaultRestartPolicy.validate())
+	assert.NoError(t, RestartPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second, MaxRestarts: 1, Window: time.Minute}.validate())
+
+	assert.Error(t, RestartPolicy{MaxBackoff: time.Second}.validate())
+	assert.Error(t, RestartPolicy{InitialBackoff: time.Minute, MaxBackoff: time.Second}.validate())
+	assert.Error(t, RestartPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second, Jitter: 1.5}.validate())
+	assert.Error(t, RestartPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second, MaxRestarts: 1}.validate())
+}
+
+func TestNextBackOff(t *testing.T) {
+	policy := RestartPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     8 * time.Second,
+		MaxRestarts:    5,
+		Window:         time.Minute,
+	}
+	n := newNode("root", nil, &supervisor{}, nil, policy)
+
+	// Without jitter, the backoff grows exponentially.
+	now := time.Now()
+	for _, expected := range []time.Duration{1000, 1500, 2250, 3375, 5062} {
+		expected *= time.Millisecond
+		assert.InDelta(t, expected, n.nextBackOff(now), float64(time.Millisecond))
+	}
+
+	// Becoming healthy resets the exponential backoff, but not the restart limit, so the maximum backoff is used.
+	n.bo.Reset()
+	assert.Equal(t, 8*time.Second, n.nextBackOff(now))
+
+	// Once the earlier restarts leave the window, the runnable can be restarted quickly again.
+	n.bo.Reset()
+	assert.Equal(t, time.Second, n.nextBackOff(now.Add(2*time.Minute)))
+	assert.Len(t, n.restarts, 1)
+}
+
+func TestNextBackOffJitter(t *testing.T) {
+	policy := RestartPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second, Jitter: 0.5}
+	n := newNode("root", nil, &supervisor{}, nil, policy)
+
+	for i := 0; i < 100; i++ {
+		bo := n.nextBackOff(time.Now())
+		assert.GreaterOrEqual(t, bo, 500*time.Millisecond)
+		assert.LessOrEqual(t, bo, 1500*time.Millisecond)
+	}
+	assert.Empty(t, n.restarts)
+}
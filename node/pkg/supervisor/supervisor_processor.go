@@ -131,6 +131,8 @@ func (s *supervisor) processSchedule(r *processorRequestSchedule) {
 
 	n := s.nodeByDN(r.dn)
 	go func() {
+		setRunnableLabel(r.dn)
+
 		if !s.propagatePanic {
 			defer func() {
 				if rec := recover(); rec != nil {
@@ -372,7 +374,10 @@ func (s *supervisor) processGC() {
 		// Only back off when the node unexpectedly died - not when it got canceled.
 		bo := time.Duration(0)
 		if n.state == nodeStateDead {
-			bo = n.bo.NextBackOff()
+			bo = n.nextBackOff(time.Now())
+			runnableRestarts.WithLabelValues(dn, "died").Inc()
+		} else {
+			runnableRestarts.WithLabelValues(dn, "canceled").Inc()
 		}
 
 		// Prepare node for rescheduling - remove its children, reset its state to new.
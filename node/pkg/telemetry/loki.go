@@ -0,0 +1,345 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blendle/zapdriver"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap/zapcore"
+)
+
+// Logs can be pushed to Grafana Loki instead of Google Cloud Logging. Each entry is labeled with the level, the component and
+// the chain it was logged for, so that the logs of a single watcher can be selected without a full text search. Entries are
+// buffered and pushed in batches by a background worker, which retries failed pushes with a backoff. If Loki is unreachable
+// for long enough for the buffer to fill up, new entries are dropped rather than slowing down the node.
+
+var (
+	lokiDroppedEntries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_telemetry_loki_dropped_entries_total",
+			Help: "Total number of log entries not pushed to Loki, by reason",
+		}, []string{"reason"})
+	lokiFailedPushes = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_telemetry_loki_failed_pushes_total",
+			Help: "Total number of batches of log entries which could not be pushed to Loki after all retries",
+		})
+	lokiPushedEntries = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_telemetry_loki_pushed_entries_total",
+			Help: "Total number of log entries pushed to Loki",
+		})
+)
+
+const (
+	// lokiDefaultBatchSize is the default maximum number of entries in a push.
+	lokiDefaultBatchSize = 1000
+
+	// lokiDefaultBatchInterval is the default maximum time an entry waits before it is pushed.
+	lokiDefaultBatchInterval = time.Second
+
+	// lokiBufferSize is the number of entries buffered while a push is in progress.
+	lokiBufferSize = 10000
+
+	// lokiMaxRetries is the number of times a failed push is retried before its entries are dropped.
+	lokiMaxRetries = 5
+
+	lokiMinBackoff = 500 * time.Millisecond
+	lokiMaxBackoff = 30 * time.Second
+
+	lokiFlushTimeout = 10 * time.Second
+)
+
+// LokiConfig configures the Loki client.
+type LokiConfig struct {
+	// URL is the push endpoint, e.g. https://loki.example.com/loki/api/v1/push. Credentials for basic authentication can be
+	// given in the URL.
+	URL string
+	// TenantID is sent in the X-Scope-OrgID header to multi-tenant Loki deployments, if set.
+	TenantID string
+	// Labels are added to all entries.
+	Labels map[string]string
+	// BatchSize is the maximum number of entries in a push. Defaults to 1000.
+	BatchSize int
+	// BatchInterval is the maximum time an entry waits before it is pushed. Defaults to 1s.
+	BatchInterval time.Duration
+}
+
+// ExternalLoggerLoki is an ExternalLogger pushing the logs to Grafana Loki.
+type ExternalLoggerLoki struct {
+	config  LokiConfig
+	client  *http.Client
+	entryC  chan lokiEntry
+	flushC  chan chan error
+	backoff time.Duration
+}
+
+// lokiEntry is a log entry waiting to be pushed.
+type lokiEntry struct {
+	streamLabels lokiStreamLabels
+	time         time.Time
+	line         string
+}
+
+// lokiStreamLabels are the labels which differ between entries. Entries with the same labels are pushed in the same stream.
+type lokiStreamLabels struct {
+	level     string
+	component string
+	chain     string
+}
+
+// lokiLabelFields are the fields of a JSON encoded entry the labels are taken from. The component is the "component" field, or
+// else the name of the logger, which is the name of the runnable for loggers created by the supervisor. The chain is the
+// "chain" field, or else the "network" field used by some watchers.
+type lokiLabelFields struct {
+	Logger    string          `json:"logger"`
+	Component string          `json:"component"`
+	Chain     json.RawMessage `json:"chain"`
+	Network   json.RawMessage `json:"network"`
+}
+
+// NewLokiLogger creates a Telemetry logger pushing the logs to Grafana Loki until ctx is done.
+// skipPrivateLogs: if set to `true`, logs with the field zap.Bool("_privateLogEntry", true) will not be logged by telemetry.
+func NewLokiLogger(ctx context.Context, config LokiConfig, skipPrivateLogs bool) (*Telemetry, error) {
+	if !strings.HasPrefix(config.URL, "http://") && !strings.HasPrefix(config.URL, "https://") {
+		return nil, errors.New("the Loki URL must be an http or https URL")
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = lokiDefaultBatchSize
+	}
+	if config.BatchInterval <= 0 {
+		config.BatchInterval = lokiDefaultBatchInterval
+	}
+
+	logger := &ExternalLoggerLoki{
+		config:  config,
+		client:  &http.Client{Timeout: 30 * time.Second},
+		entryC:  make(chan lokiEntry, lokiBufferSize),
+		flushC:  make(chan chan error),
+		backoff: lokiMinBackoff,
+	}
+	go logger.run(ctx)
+
+	return &Telemetry{
+		encoder: &guardianTelemetryEncoder{
+			Encoder:         zapcore.NewJSONEncoder(zapdriver.NewProductionEncoderConfig()),
+			logger:          logger,
+			skipPrivateLogs: skipPrivateLogs,
+		},
+	}, nil
+}
+
+func (logger *ExternalLoggerLoki) log(time time.Time, message json.RawMessage, level zapcore.Level) {
+	entry := lokiEntry{
+		streamLabels: lokiStreamLabels{level: level.String()},
+		time:         time,
+		line:         strings.TrimSuffix(string(message), "\n"),
+	}
+
+	var fields lokiLabelFields
+	if err := json.Unmarshal(message, &fields); err == nil {
+		entry.streamLabels.component = fields.Component
+		if entry.streamLabels.component == "" {
+			entry.streamLabels.component = fields.Logger
+		}
+		entry.streamLabels.chain = rawLabelValue(fields.Chain)
+		if entry.streamLabels.chain == "" {
+			entry.streamLabels.chain = rawLabelValue(fields.Network)
+		}
+	}
+
+	select {
+	case logger.entryC <- entry:
+	default:
+		lokiDroppedEntries.WithLabelValues("buffer_full").Inc()
+	}
+}
+
+// rawLabelValue returns a JSON value as a label value, without the quotes of strings.
+func rawLabelValue(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// flush pushes all the entries logged so far.
+func (logger *ExternalLoggerLoki) flush() error {
+	errC := make(chan error, 1)
+	select {
+	case logger.flushC <- errC:
+	case <-time.After(lokiFlushTimeout):
+		return errors.New("timeout waiting for the Loki worker")
+	}
+
+	select {
+	case err := <-errC:
+		return err
+	case <-time.After(lokiFlushTimeout):
+		return errors.New("timeout flushing the logs to Loki")
+	}
+}
+
+// run batches the entries and pushes them.
+func (logger *ExternalLoggerLoki) run(ctx context.Context) {
+	ticker := time.NewTicker(logger.config.BatchInterval)
+	defer ticker.Stop()
+
+	batch := make([]lokiEntry, 0, logger.config.BatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-logger.entryC:
+			batch = append(batch, entry)
+			if len(batch) >= logger.config.BatchSize {
+				_ = logger.pushWithRetries(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) != 0 {
+				_ = logger.pushWithRetries(ctx, batch)
+				batch = batch[:0]
+			}
+		case errC := <-logger.flushC:
+			// Take everything logged before the flush was requested, still pushing at most BatchSize entries at once.
+			var err error
+			for drained := false; !drained; {
+				select {
+				case entry := <-logger.entryC:
+					batch = append(batch, entry)
+				default:
+					drained = true
+				}
+				if len(batch) != 0 && (drained || len(batch) >= logger.config.BatchSize) {
+					if pushErr := logger.pushWithRetries(ctx, batch); pushErr != nil {
+						err = pushErr
+					}
+					batch = batch[:0]
+				}
+			}
+			errC <- err
+		}
+	}
+}
+
+// pushWithRetries pushes a batch, retrying with an exponential backoff if the push fails with a retryable error. If the batch
+// cannot be pushed, its entries are dropped.
+func (logger *ExternalLoggerLoki) pushWithRetries(ctx context.Context, batch []lokiEntry) error {
+	body, err := encodeLokiPush(logger.config.Labels, batch)
+	if err != nil {
+		lokiDroppedEntries.WithLabelValues("encoding_failed").Add(float64(len(batch)))
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		retryable, err := logger.push(ctx, body)
+		if err == nil {
+			lokiPushedEntries.Add(float64(len(batch)))
+			logger.backoff = lokiMinBackoff
+			return nil
+		}
+
+		if !retryable || attempt == lokiMaxRetries {
+			// The failure is not logged, since the entry would end up in this very logger.
+			lokiFailedPushes.Inc()
+			lokiDroppedEntries.WithLabelValues("push_failed").Add(float64(len(batch)))
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			lokiFailedPushes.Inc()
+			lokiDroppedEntries.WithLabelValues("push_failed").Add(float64(len(batch)))
+			return ctx.Err()
+		case <-time.After(logger.backoff):
+		}
+		logger.backoff *= 2
+		if logger.backoff > lokiMaxBackoff {
+			logger.backoff = lokiMaxBackoff
+		}
+	}
+}
+
+// push sends a single push request and returns whether it is worth retrying if it fails.
+func (logger *ExternalLoggerLoki) push(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, logger.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if logger.config.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", logger.config.TenantID)
+	}
+
+	resp, err := logger.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 == 2 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode/100 == 5
+	return retryable, fmt.Errorf("push failed with status %s", resp.Status)
+}
+
+type (
+	lokiPushRequest struct {
+		Streams []lokiStream `json:"streams"`
+	}
+
+	lokiStream struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	}
+)
+
+// encodeLokiPush encodes a batch as the body of a push request, grouping the entries with the same labels in a stream.
+func encodeLokiPush(labels map[string]string, batch []lokiEntry) ([]byte, error) {
+	// Loki requires the entries of a stream to be in order, while concurrent goroutines may log slightly out of order.
+	sort.SliceStable(batch, func(i, j int) bool { return batch[i].time.Before(batch[j].time) })
+
+	streams := make(map[lokiStreamLabels]*lokiStream)
+	order := make([]lokiStreamLabels, 0)
+	for _, entry := range batch {
+		stream, exists := streams[entry.streamLabels]
+		if !exists {
+			stream = &lokiStream{Stream: make(map[string]string, len(labels)+3)}
+			for k, v := range labels {
+				stream.Stream[k] = v
+			}
+			stream.Stream["level"] = entry.streamLabels.level
+			if entry.streamLabels.component != "" {
+				stream.Stream["component"] = entry.streamLabels.component
+			}
+			if entry.streamLabels.chain != "" {
+				stream.Stream["chain"] = entry.streamLabels.chain
+			}
+			streams[entry.streamLabels] = stream
+			order = append(order, entry.streamLabels)
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(entry.time.UnixNano(), 10), entry.line})
+	}
+
+	req := lokiPushRequest{Streams: make([]lokiStream, 0, len(streams))}
+	for _, key := range order {
+		req.Streams = append(req.Streams, *streams[key])
+	}
+	return json.Marshal(req)
+}
@@ -0,0 +1,136 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// lokiServerMock records the pushes it receives. It fails the first failures pushes with a 503.
+type lokiServerMock struct {
+	mu       sync.Mutex
+	failures int
+	tenants  []string
+	pushes   []lokiPushRequest
+}
+
+func (s *lokiServerMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var req lokiPushRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.tenants = append(s.tenants, r.Header.Get("X-Scope-OrgID"))
+	s.pushes = append(s.pushes, req)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// streams returns the lines received by stream labels, in the format of the query language.
+func (s *lokiServerMock) streams() map[string][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	streams := make(map[string][]string)
+	for _, push := range s.pushes {
+		for _, stream := range push.Streams {
+			key := `{chain="` + stream.Stream["chain"] + `",component="` + stream.Stream["component"] + `",level="` + stream.Stream["level"] + `",node_name="` + stream.Stream["node_name"] + `"}`
+			for _, value := range stream.Values {
+				var line struct {
+					Message string `json:"message"`
+				}
+				if err := json.Unmarshal([]byte(value[1]), &line); err != nil {
+					panic(err)
+				}
+				streams[key] = append(streams[key], line.Message)
+			}
+		}
+	}
+	return streams
+}
+
+func TestLokiLogger(t *testing.T) {
+	srv := &lokiServerMock{failures: 1}
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tm, err := NewLokiLogger(ctx, LokiConfig{
+		URL:           httpSrv.URL + "/loki/api/v1/push",
+		TenantID:      "guardians",
+		Labels:        map[string]string{"node_name": "guardian-0"},
+		BatchInterval: time.Hour,
+	}, true)
+	require.NoError(t, err)
+	logger := tm.WrapLogger(zap.NewNop())
+
+	logger.Named("ethwatch").Info("new block", zap.String("network", "eth"))
+	logger.With(zap.String("component", "gacct")).Warn("transfer pending")
+	logger.Info("observation request", zap.Stringer("chain", fakeStringer("solana")))
+	logger.Info("private", zap.Bool("_privateLogEntry", true))
+	logger.Debug("below the telemetry log level")
+	logger.Named("ethwatch").Info("another block", zap.String("network", "eth"))
+
+	// The first push fails and is retried.
+	require.NoError(t, tm.Close())
+
+	assert.Equal(t, map[string][]string{
+		`{chain="eth",component="ethwatch",level="info",node_name="guardian-0"}`: {"new block", "another block"},
+		`{chain="",component="gacct",level="warn",node_name="guardian-0"}`:       {"transfer pending"},
+		`{chain="solana",component="",level="info",node_name="guardian-0"}`:      {"observation request"},
+	}, srv.streams())
+	assert.Equal(t, []string{"guardians"}, srv.tenants)
+}
+
+func TestLokiLoggerBatchSize(t *testing.T) {
+	srv := &lokiServerMock{}
+	httpSrv := httptest.NewServer(srv)
+	defer httpSrv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tm, err := NewLokiLogger(ctx, LokiConfig{URL: httpSrv.URL, BatchSize: 2, BatchInterval: time.Hour}, false)
+	require.NoError(t, err)
+	logger := tm.WrapLogger(zap.NewNop())
+
+	for i := 0; i < 5; i++ {
+		logger.Info("message")
+	}
+	require.NoError(t, tm.Close())
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	require.Len(t, srv.pushes, 3)
+	assert.Len(t, srv.pushes[0].Streams[0].Values, 2)
+	assert.Len(t, srv.pushes[2].Streams[0].Values, 1)
+}
+
+func TestLokiLoggerInvalidURL(t *testing.T) {
+	_, err := NewLokiLogger(context.Background(), LokiConfig{URL: "loki:3100"}, false)
+	assert.Error(t, err)
+}
+
+type fakeStringer string
+
+func (s fakeStringer) String() string {
+	return string(s)
+}
@@ -0,0 +1,242 @@
+// Package watchdog implements a runnable that monitors the goroutines of every supervised runnable and the heap of the
+// node, and restarts the runnables exceeding their limits rather than letting a leak take the whole node down.
+//
+// Goroutines are attributed to the runnable that started them, as labeled by the supervisor. The heap cannot be
+// attributed to a runnable, so when it still exceeds its limit after a garbage collection the runnable with the most
+// goroutines, the most likely culprit of a leak, is restarted. A restart only releases the goroutines that honor the
+// context of the runnable. At most one runnable is restarted per cooldown, so that the restart of one runnable doesn't
+// cascade into the others, and the runnables the node can't work without are never restarted.
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	runnableGoroutines = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_watchdog_runnable_goroutines",
+			Help: "Number of live goroutines started by each supervised runnable",
+		}, []string{"runnable"})
+	watchdogRestarts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_watchdog_restarts_total",
+			Help: "Total number of runnables restarted by the watchdog, grouped by runnable and reason",
+		}, []string{"runnable", "reason"})
+)
+
+const (
+	// DefaultInterval is how often the limits are checked by default.
+	DefaultInterval = 30 * time.Second
+
+	// restartCooldown is how long no runnable is restarted after a restart, so that the restarted runnable can release
+	// its resources before the limits are enforced again.
+	restartCooldown = 5 * time.Minute
+)
+
+// criticalRunnables are never restarted by the watchdog, along with their children, since restarting them disrupts
+// the whole node.
+var criticalRunnables = []string{"root", "root.processor", "root.p2p"}
+
+// Config holds the limits enforced by the watchdog. A zero limit is not enforced.
+type Config struct {
+	// GoroutineLimit is the maximum number of goroutines started by a single runnable.
+	GoroutineLimit int
+	// HeapLimitBytes is the maximum heap in use by the node.
+	HeapLimitBytes uint64
+	// Interval is how often the limits are checked.
+	Interval time.Duration
+	// DumpDir is the directory goroutine dumps and heap profiles are written to before a restart. No dumps are
+	// written if it is empty.
+	DumpDir string
+}
+
+// Watchdog enforces the limits of a Config.
+type Watchdog struct {
+	logger *zap.Logger
+	cfg    Config
+
+	// lastRestart is the last time a runnable was restarted.
+	lastRestart time.Time
+
+	// Hooks replaced in tests.
+	goroutineCounts func() (map[string]int, error)
+	heapInUse       func() uint64
+	freeMemory      func()
+	restart         func(ctx context.Context, dn string) error
+}
+
+// New creates a watchdog enforcing cfg.
+func New(logger *zap.Logger, cfg Config) (*Watchdog, error) {
+	if cfg.GoroutineLimit < 0 {
+		return nil, fmt.Errorf("goroutine limit must not be negative")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+
+	return &Watchdog{
+		logger:          logger.Named("watchdog"),
+		cfg:             cfg,
+		goroutineCounts: supervisor.GoroutinesByRunnable,
+		heapInUse: func() uint64 {
+			var m runtime.MemStats
+			runtime.ReadMemStats(&m)
+			return m.HeapInuse
+		},
+		freeMemory: debug.FreeOSMemory,
+		restart:    supervisor.Restart,
+	}, nil
+}
+
+// Run is the supervised runnable of the watchdog.
+func (w *Watchdog) Run(ctx context.Context) error {
+	w.logger.Info("watchdog started",
+		zap.Int("goroutine_limit", w.cfg.GoroutineLimit),
+		zap.Uint64("heap_limit_bytes", w.cfg.HeapLimitBytes),
+		zap.Duration("interval", w.cfg.Interval))
+
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			w.check(ctx, time.Now())
+		}
+	}
+}
+
+// check enforces the limits once.
+func (w *Watchdog) check(ctx context.Context, now time.Time) {
+	counts, err := w.goroutineCounts()
+	if err != nil {
+		w.logger.Error("failed to count goroutines", zap.Error(err))
+		return
+	}
+
+	runnableGoroutines.Reset()
+	for dn, n := range counts {
+		runnableGoroutines.WithLabelValues(dn).Set(float64(n))
+	}
+
+	if !w.lastRestart.IsZero() && now.Sub(w.lastRestart) < restartCooldown {
+		return
+	}
+
+	if w.cfg.GoroutineLimit > 0 {
+		if dn := w.largestRunnable(counts); dn != "" && counts[dn] > w.cfg.GoroutineLimit {
+			w.logger.Warn("runnable exceeds the goroutine limit",
+				zap.String("runnable", dn),
+				zap.Int("goroutines", counts[dn]),
+				zap.Int("limit", w.cfg.GoroutineLimit))
+			w.restartRunnable(ctx, dn, "goroutines", now)
+			return
+		}
+	}
+
+	if w.cfg.HeapLimitBytes > 0 && w.heapInUse() > w.cfg.HeapLimitBytes {
+		// The heap in use includes garbage not collected yet, which is not a leak.
+		w.freeMemory()
+		if heap := w.heapInUse(); heap > w.cfg.HeapLimitBytes {
+			dn := w.largestRunnable(counts)
+			w.logger.Warn("heap exceeds the limit",
+				zap.Uint64("heap_bytes", heap),
+				zap.Uint64("limit", w.cfg.HeapLimitBytes),
+				zap.String("runnable", dn))
+			if dn != "" {
+				w.restartRunnable(ctx, dn, "heap", now)
+			}
+		}
+	}
+}
+
+// restartable returns whether the watchdog may restart the runnable at dn, which is not the case of the critical
+// runnables and their children.
+func restartable(dn string) bool {
+	for _, critical := range criticalRunnables {
+		if dn == critical || (critical != "root" && strings.HasPrefix(dn, critical+".")) {
+			return false
+		}
+	}
+	return true
+}
+
+// largestRunnable returns the restartable runnable with the most goroutines, or an empty string if there is none.
+func (w *Watchdog) largestRunnable(counts map[string]int) string {
+	var largest string
+	for dn, n := range counts {
+		if !restartable(dn) {
+			continue
+		}
+		if largest == "" || n > counts[largest] || (n == counts[largest] && dn < largest) {
+			largest = dn
+		}
+	}
+	return largest
+}
+
+// restartRunnable writes the dumps and restarts the runnable at dn.
+func (w *Watchdog) restartRunnable(ctx context.Context, dn string, reason string, now time.Time) {
+	if w.cfg.DumpDir != "" {
+		if err := w.writeDumps(dn, reason, now); err != nil {
+			w.logger.Error("failed to write dumps", zap.String("runnable", dn), zap.Error(err))
+		}
+	}
+
+	w.lastRestart = now
+	if err := w.restart(ctx, dn); err != nil {
+		w.logger.Error("failed to restart runnable", zap.String("runnable", dn), zap.Error(err))
+		return
+	}
+
+	w.logger.Warn("restarted runnable", zap.String("runnable", dn), zap.String("reason", reason))
+	watchdogRestarts.WithLabelValues(dn, reason).Inc()
+}
+
+// writeDumps writes a dump of all goroutines and a heap profile to the dump directory, for investigating the leak.
+func (w *Watchdog) writeDumps(dn string, reason string, now time.Time) error {
+	prefix := filepath.Join(w.cfg.DumpDir, fmt.Sprintf("watchdog-%d-%s-%s", now.Unix(), dn, reason))
+
+	for _, d := range []struct {
+		suffix  string
+		profile string
+		debug   int
+	}{
+		{"goroutines.txt", "goroutine", 2},
+		{"heap.pprof", "heap", 0},
+	} {
+		path := prefix + "-" + d.suffix
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return err
+		}
+		err = pprof.Lookup(d.profile).WriteTo(f, d.debug)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		w.logger.Info("wrote dump", zap.String("path", path))
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package watchdog
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newTestWatchdog returns a watchdog reporting the given goroutine counts and heap, and recording the restarts.
+func newTestWatchdog(t *testing.T, cfg Config, counts map[string]int, heap uint64) (*Watchdog, *[]string) {
+	t.Helper()
+	w, err := New(zap.NewNop(), cfg)
+	require.NoError(t, err)
+
+	var restarted []string
+	w.goroutineCounts = func() (map[string]int, error) { return counts, nil }
+	w.heapInUse = func() uint64 { return heap }
+	w.freeMemory = func() {}
+	w.restart = func(ctx context.Context, dn string) error {
+		restarted = append(restarted, dn)
+		return nil
+	}
+	return w, &restarted
+}
+
+func TestWatchdogGoroutineLimit(t *testing.T) {
+	counts := map[string]int{"root": 500, "root.ethwatch": 150, "root.solwatch": 120, "root.p2p": 400, "root.p2p.peers": 300, "root.processor": 200}
+	w, restarted := newTestWatchdog(t, Config{GoroutineLimit: 100}, counts, 0)
+
+	// The critical runnables are never restarted.
+	now := time.Now()
+	w.check(context.Background(), now)
+	assert.Equal(t, []string{"root.ethwatch"}, *restarted)
+
+	// No runnable is restarted during the cooldown.
+	w.check(context.Background(), now.Add(time.Minute))
+	assert.Equal(t, []string{"root.ethwatch"}, *restarted)
+
+	counts["root.ethwatch"] = 50
+	w.check(context.Background(), now.Add(restartCooldown))
+	assert.Equal(t, []string{"root.ethwatch", "root.solwatch"}, *restarted)
+}
+
+func TestWatchdogHeapLimit(t *testing.T) {
+	dumpDir := t.TempDir()
+	counts := map[string]int{"root": 500, "root.ethwatch": 30, "root.solwatch": 40, "root.p2p": 100}
+	w, restarted := newTestWatchdog(t, Config{HeapLimitBytes: 1000, DumpDir: dumpDir}, counts, 2000)
+
+	// The runnable with the most goroutines is restarted, then after the cooldown the next one.
+	now := time.Now()
+	w.check(context.Background(), now)
+	w.check(context.Background(), now.Add(time.Minute))
+	assert.Equal(t, []string{"root.solwatch"}, *restarted)
+	counts["root.solwatch"] = 10
+	w.check(context.Background(), now.Add(restartCooldown))
+	assert.Equal(t, []string{"root.solwatch", "root.ethwatch"}, *restarted)
+
+	entries, err := os.ReadDir(dumpDir)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(entries))
+
+	// Nothing is restarted below the limit.
+	w.heapInUse = func() uint64 { return 500 }
+	w.check(context.Background(), now.Add(time.Hour))
+	assert.Equal(t, 2, len(*restarted))
+
+	// Nor if the heap is below the limit once the garbage is collected.
+	heap := uint64(2000)
+	w.heapInUse = func() uint64 { return heap }
+	w.freeMemory = func() { heap = 800 }
+	w.check(context.Background(), now.Add(2*time.Hour))
+	assert.Equal(t, 2, len(*restarted))
+}
+
+func TestWatchdogConfig(t *testing.T) {
+	_, err := New(zap.NewNop(), Config{GoroutineLimit: -1})
+	assert.Error(t, err)
+
+	w, err := New(zap.NewNop(), Config{})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultInterval, w.cfg.Interval)
+}
@@ -1,6 +1,7 @@
 package algorand
 
 import (
+	"bytes"
 	"context"
 	"encoding/base32"
 	"encoding/binary"
@@ -15,6 +16,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	eth_common "github.com/ethereum/go-ethereum/common"
@@ -22,6 +24,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 type (
@@ -38,9 +41,28 @@ type (
 		readinessSync readiness.Component
 
 		next_round uint64
+
+		// groupSearches limits the reobservation requests by group ID, since each of them pages through the calls of
+		// our app in the last groupSearchRounds rounds.
+		groupSearches *rate.Limiter
+
+		// indexerLimiter and algodLimiter enforce the request budgets of the endpoints, the SDK clients can't be
+		// given a rate limited transport.
+		indexerLimiter *ratelimit.Limiter
+		algodLimiter   *ratelimit.Limiter
 	}
 )
 
+const (
+	// groupSearchRounds is how many rounds back the indexer is searched for reobservation requests by group ID
+	// (roughly an hour). Older groups can be reobserved by the ID of one of their transactions.
+	groupSearchRounds = 1200
+
+	// groupSearchInterval and groupSearchBurst limit the rate of the reobservation requests by group ID.
+	groupSearchInterval = time.Minute
+	groupSearchBurst    = 5
+)
+
 var (
 	algorandMessagesConfirmed = promauto.NewCounter(
 		prometheus.CounterOpts{
@@ -74,6 +96,7 @@ func NewWatcher(
 		obsvReqC:      obsvReqC,
 		readinessSync: common.MustConvertChainIdToReadinessSyncing(vaa.ChainIDAlgorand),
 		next_round:    0,
+		groupSearches: rate.NewLimiter(rate.Every(groupSearchInterval), groupSearchBurst),
 	}
 }
 
@@ -102,19 +125,15 @@ func lookAtTxn(e *Watcher, t types.SignedTxnInBlock, b types.Block, logger *zap.
 
 		logger.Info("emitter: " + hex.EncodeToString(emitter[:]))
 
-		t.Txn.GenesisID = b.GenesisID
-		t.Txn.GenesisHash = b.GenesisHash
-		Id := crypto.GetTxID(t.Txn)
-
-		id, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(Id)
+		id, err := txID(t, b)
 		if err != nil {
 			logger.Error("Base32 DecodeString", zap.Error(err))
 			continue
 		}
 
-		logger.Info("id: " + hex.EncodeToString(id) + " " + Id)
+		logger.Info("id: " + hex.EncodeToString(id[:]) + " " + base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(id[:]))
 
-		var txHash = eth_common.BytesToHash(id) // 32 bytes = d3b136a6a182a40554b2fafbc8d12a7a22737c10c81e33b33d1dcb74c532708b
+		var txHash = eth_common.BytesToHash(id[:]) // 32 bytes = d3b136a6a182a40554b2fafbc8d12a7a22737c10c81e33b33d1dcb74c532708b
 
 		observation := &common.MessagePublication{
 			TxHash:           txHash,
@@ -143,6 +162,143 @@ func lookAtTxn(e *Watcher, t types.SignedTxnInBlock, b types.Block, logger *zap.
 	}
 }
 
+// txID returns the raw ID of a top-level transaction in block b.
+func txID(t types.SignedTxnInBlock, b types.Block) (types.Digest, error) {
+	t.Txn.GenesisID = b.GenesisID
+	t.Txn.GenesisHash = b.GenesisHash
+
+	var d types.Digest
+	id, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(crypto.GetTxID(t.Txn))
+	if err != nil {
+		return d, err
+	}
+	copy(d[:], id)
+	return d, nil
+}
+
+// groupTxns returns the transactions of block b that are part of the atomic group containing the transaction or
+// group identified by hash. If hash is the ID of a transaction that is not part of a group, only that transaction
+// is returned.
+func groupTxns(b types.Block, hash types.Digest) []types.SignedTxnInBlock {
+	group := hash
+	for _, t := range b.Payset {
+		id, err := txID(t, b)
+		if err != nil {
+			continue
+		}
+		if id == hash {
+			if t.Txn.Group == (types.Digest{}) {
+				return []types.SignedTxnInBlock{t}
+			}
+			group = t.Txn.Group
+			break
+		}
+	}
+
+	txns := make([]types.SignedTxnInBlock, 0)
+	for _, t := range b.Payset {
+		if t.Txn.Group == group {
+			txns = append(txns, t)
+		}
+	}
+	return txns
+}
+
+// findGroupRounds returns the rounds of the transactions calling our app that belong to the given group,
+// searching at most groupSearchRounds rounds back from lastRound.
+func (e *Watcher) findGroupRounds(ctx context.Context, indexerClient *indexer.Client, group []byte, lastRound uint64) ([]uint64, error) {
+	minRound := uint64(0)
+	if lastRound > groupSearchRounds {
+		minRound = lastRound - groupSearchRounds
+	}
+
+	rounds := make([]uint64, 0)
+	next := ""
+	for {
+		q := indexerClient.SearchForTransactions().ApplicationId(e.appid).MinRound(minRound).Limit(1000)
+		if next != "" {
+			q = q.NextToken(next)
+		}
+		if err := e.indexerLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		result, err := q.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range result.Transactions {
+			if bytes.Equal(t.Group, group) && (len(rounds) == 0 || rounds[len(rounds)-1] != t.ConfirmedRound) {
+				rounds = append(rounds, t.ConfirmedRound)
+			}
+		}
+		if result.NextToken == "" || len(result.Transactions) == 0 {
+			return rounds, nil
+		}
+		next = result.NextToken
+	}
+}
+
+// reobserve re-parses the atomic group identified by hash, which is either the ID of any transaction in the group or
+// the group ID itself. The emitting call is usually an inner transaction of some other call of the group, so the whole
+// group is re-parsed.
+func (e *Watcher) reobserve(ctx context.Context, logger *zap.Logger, indexerClient *indexer.Client, algodClient *algod.Client, hash []byte) error {
+	var digest types.Digest
+	if len(hash) != len(digest) {
+		return fmt.Errorf("invalid hash length: %d", len(hash))
+	}
+	copy(digest[:], hash)
+
+	if err := e.indexerLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	result, err := indexerClient.SearchForTransactions().TXID(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(hash)).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("SearchForTransactions: %w", err)
+	}
+
+	rounds := make([]uint64, 0, len(result.Transactions))
+	for _, t := range result.Transactions {
+		rounds = append(rounds, t.ConfirmedRound)
+	}
+
+	if len(rounds) == 0 {
+		// Not a transaction ID - try it as a group ID.
+		if !e.groupSearches.Allow() {
+			logger.Warn("dropping reobservation request by group ID, too many group searches", zap.String("hash", hex.EncodeToString(hash)))
+			return nil
+		}
+		rounds, err = e.findGroupRounds(ctx, indexerClient, hash, result.CurrentRound)
+		if err != nil {
+			return fmt.Errorf("failed to search for group: %w", err)
+		}
+		if len(rounds) == 0 {
+			logger.Info("no transaction or group found for reobservation request", zap.String("hash", hex.EncodeToString(hash)))
+			return nil
+		}
+	}
+
+	for _, r := range rounds {
+		if err := e.algodLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		block, err := algodClient.Block(r).Do(ctx)
+		if err != nil {
+			return fmt.Errorf("Block %d: %w", r, err)
+		}
+
+		txns := groupTxns(block, digest)
+		logger.Info("reobserving group",
+			zap.Uint64("round", r),
+			zap.String("hash", hex.EncodeToString(hash)),
+			zap.Int("num_txns", len(txns)))
+		for _, t := range txns {
+			lookAtTxn(e, t, block, logger)
+		}
+	}
+
+	return nil
+}
+
 func (e *Watcher) Run(ctx context.Context) error {
 	// an odd thing to broadcast...
 	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAlgorand, &gossipv1.Heartbeat_Network{
@@ -157,6 +313,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 	timer := time.NewTicker(time.Second * 1)
 	defer timer.Stop()
 
+	e.indexerLimiter = ratelimit.DefaultRegistry.ForURL(e.indexerRPC)
+	e.algodLimiter = ratelimit.DefaultRegistry.ForURL(e.algodRPC)
+
 	indexerClient, err := indexer.MakeClient(e.indexerRPC, e.indexerToken)
 	if err != nil {
 		logger.Error("indexer make client", zap.Error(err))
@@ -171,6 +330,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 		return err
 	}
 
+	if err := e.algodLimiter.Wait(ctx); err != nil {
+		return err
+	}
 	status, err := algodClient.StatusAfterBlock(0).Do(context.Background())
 	if err != nil {
 		logger.Error("StatusAfterBlock", zap.Error(err))
@@ -195,28 +357,15 @@ func (e *Watcher) Run(ctx context.Context) error {
 				zap.String("tx_hash", hex.EncodeToString(r.TxHash)),
 				zap.String("base32_tx_hash", base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(r.TxHash)))
 
-			result, err := indexerClient.SearchForTransactions().TXID(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(r.TxHash)).Do(context.Background())
-			if err != nil {
-				logger.Error("SearchForTransactions", zap.Error(err))
+			if err := e.reobserve(ctx, logger, indexerClient, algodClient, r.TxHash); err != nil {
+				logger.Error("failed to process observation request", zap.Error(err))
 				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
-				break
-			}
-			for _, t := range result.Transactions {
-				r := t.ConfirmedRound
-
-				block, err := algodClient.Block(r).Do(context.Background())
-				if err != nil {
-					logger.Error("SearchForTransactions", zap.Error(err))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAlgorand, 1)
-					break
-				}
-
-				for _, element := range block.Payset {
-					lookAtTxn(e, element, block, logger)
-				}
 			}
 
 		case <-timer.C:
+			if err := e.algodLimiter.Wait(ctx); err != nil {
+				return nil
+			}
 			status, err := algodClient.Status().Do(context.Background())
 			if err != nil {
 				logger.Error(fmt.Sprintf("algodClient.Status: %s", err.Error()))
@@ -226,6 +375,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 			if e.next_round <= status.LastRound {
 				for {
+					if err := e.algodLimiter.Wait(ctx); err != nil {
+						return nil
+					}
 					block, err := algodClient.Block(e.next_round).Do(context.Background())
 					if err != nil {
 						logger.Error(fmt.Sprintf("algodClient.Block %d: %s", e.next_round, err.Error()))
@@ -253,6 +405,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 				Height:          int64(status.LastRound),
 				ContractAddress: fmt.Sprintf("%d", e.appid),
 			})
+			p2p.DefaultRegistry.SetNetworkHeights(vaa.ChainIDAlgorand, p2p.InstantFinalityHeights(int64(status.LastRound)))
 
 			readiness.SetReady(e.readinessSync)
 		}
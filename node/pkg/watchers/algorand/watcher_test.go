@@ -0,0 +1,54 @@
+package algorand
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTxn(note byte, group types.Digest) types.SignedTxnInBlock {
+	var t types.SignedTxnInBlock
+	t.Txn.Type = types.ApplicationCallTx
+	t.Txn.Note = []byte{note}
+	t.Txn.Group = group
+	return t
+}
+
+func TestGroupTxns(t *testing.T) {
+	groupA := types.Digest{0xaa}
+	groupB := types.Digest{0xbb}
+
+	b := types.Block{}
+	b.GenesisID = "testnet-v1.0"
+	b.Payset = []types.SignedTxnInBlock{
+		testTxn(1, groupA),
+		testTxn(2, groupB),
+		testTxn(3, groupA),
+		testTxn(4, types.Digest{}),
+	}
+
+	// By group ID.
+	txns := groupTxns(b, groupA)
+	require.Equal(t, 2, len(txns))
+	assert.Equal(t, []byte{1}, txns[0].Txn.Note)
+	assert.Equal(t, []byte{3}, txns[1].Txn.Note)
+
+	// By the ID of any transaction in the group.
+	id, err := txID(b.Payset[2], b)
+	require.NoError(t, err)
+	txns = groupTxns(b, id)
+	require.Equal(t, 2, len(txns))
+	assert.Equal(t, []byte{1}, txns[0].Txn.Note)
+
+	// A transaction that is not part of a group.
+	id, err = txID(b.Payset[3], b)
+	require.NoError(t, err)
+	txns = groupTxns(b, id)
+	require.Equal(t, 1, len(txns))
+	assert.Equal(t, []byte{4}, txns[0].Txn.Note)
+
+	// Unknown.
+	assert.Equal(t, 0, len(groupTxns(b, types.Digest{0xcc})))
+}
@@ -0,0 +1,111 @@
+package aptos
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// ChainProfile describes an Aptos-VM chain. Forks of Aptos running the wormhole core contract can be watched by
+// adding a profile for their chain ID instead of copying the watcher.
+type ChainProfile struct {
+	// ChainID is the wormhole chain ID of the chain.
+	ChainID vaa.ChainID
+	// RPC is the URL of the node's REST API.
+	RPC string
+	// Account is the account the wormhole core contract is deployed at.
+	Account string
+	// Handle is the event handle of the WormholeMessage events, i.e. "<account>::state::WormholeMessageHandle".
+	Handle string
+}
+
+// chainProfileConfig is the on-disk representation of a ChainProfile.
+type chainProfileConfig struct {
+	Chain   string `json:"chain"`
+	RPC     string `json:"rpc"`
+	Account string `json:"account"`
+	Handle  string `json:"handle"`
+}
+
+// Validate checks that the chain ID is a known wormhole chain and that all fields of the profile are set.
+func (p ChainProfile) Validate() error {
+	if !isKnownChainID(p.ChainID) {
+		return fmt.Errorf("unknown chain ID %d", uint16(p.ChainID))
+	}
+	if _, err := common.ConvertChainIdToReadinessSyncing(p.ChainID); err != nil {
+		return err
+	}
+	if p.RPC == "" {
+		return fmt.Errorf("%s: rpc must be specified", p.ChainID)
+	}
+	if p.Account == "" {
+		return fmt.Errorf("%s: account must be specified", p.ChainID)
+	}
+	if p.Handle == "" {
+		return fmt.Errorf("%s: handle must be specified", p.ChainID)
+	}
+	return nil
+}
+
+// isKnownChainID returns true if chainID is one of the chain IDs known to the SDK.
+func isKnownChainID(chainID vaa.ChainID) bool {
+	for _, id := range vaa.GetAllNetworkIDs() {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadChainProfiles reads a JSON array of chain profiles of the form
+//
+//	[{"chain": "<wormhole chain name>", "rpc": "<url>", "account": "<address>", "handle": "<event handle>"}]
+//
+// Aptos itself is configured using the dedicated node flags and may not be listed. Unknown chains and fields are
+// rejected, so that a typo does not silently leave a chain unwatched.
+func LoadChainProfiles(path string) ([]ChainProfile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Aptos-VM chain profiles: %w", err)
+	}
+
+	var configs []chainProfileConfig
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&configs); err != nil {
+		return nil, fmt.Errorf("failed to parse Aptos-VM chain profiles: %w", err)
+	}
+
+	profiles := make([]ChainProfile, 0, len(configs))
+	seen := make(map[vaa.ChainID]struct{})
+	for _, c := range configs {
+		chainID, err := vaa.ChainIDFromString(c.Chain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Aptos-VM chain profile: %w", err)
+		}
+		if chainID == vaa.ChainIDAptos {
+			return nil, fmt.Errorf("%s must be configured using the --aptos* flags", chainID)
+		}
+		if _, ok := seen[chainID]; ok {
+			return nil, fmt.Errorf("duplicate profile for %s", chainID)
+		}
+		seen[chainID] = struct{}{}
+
+		p := ChainProfile{
+			ChainID: chainID,
+			RPC:     c.RPC,
+			Account: c.Account,
+			Handle:  c.Handle,
+		}
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, p)
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,55 @@
+package aptos
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func writeProfiles(t *testing.T, content string) string {
+	t.Helper()
+	p := path.Join(t.TempDir(), "profiles.json")
+	require.NoError(t, os.WriteFile(p, []byte(content), 0600))
+	return p
+}
+
+func TestLoadChainProfiles(t *testing.T) {
+	p := writeProfiles(t, `[{"chain": "sei", "rpc": "http://localhost:8080", "account": "0xde0036a9", "handle": "0xde0036a9::state::WormholeMessageHandle"}]`)
+
+	profiles, err := LoadChainProfiles(p)
+	require.NoError(t, err)
+	assert.Equal(t, []ChainProfile{{
+		ChainID: vaa.ChainIDSei,
+		RPC:     "http://localhost:8080",
+		Account: "0xde0036a9",
+		Handle:  "0xde0036a9::state::WormholeMessageHandle",
+	}}, profiles)
+}
+
+func TestLoadChainProfilesErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown chain": `[{"chain": "nochain", "rpc": "r", "account": "a", "handle": "h"}]`,
+		"aptos":         `[{"chain": "aptos", "rpc": "r", "account": "a", "handle": "h"}]`,
+		"duplicate":     `[{"chain": "sei", "rpc": "r", "account": "a", "handle": "h"}, {"chain": "sei", "rpc": "r", "account": "a", "handle": "h"}]`,
+		"missing rpc":   `[{"chain": "sei", "account": "a", "handle": "h"}]`,
+		"missing field": `[{"chain": "sei", "rpc": "r", "account": "a"}]`,
+		"invalid json":  `{`,
+		"unknown field": `[{"chain": "sei", "rpc": "r", "account": "a", "handle": "h", "hanlde": "h"}]`,
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadChainProfiles(writeProfiles(t, content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestChainProfileValidateUnknownChain(t *testing.T) {
+	p := ChainProfile{ChainID: vaa.ChainID(65000), RPC: "r", Account: "a", Handle: "h"}
+	assert.Error(t, p.Validate())
+}
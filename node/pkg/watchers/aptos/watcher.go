@@ -12,6 +12,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	eth_common "github.com/ethereum/go-ethereum/common"
@@ -25,9 +26,11 @@ import (
 type (
 	// Watcher is responsible for looking over Aptos blockchain and reporting new transactions to the wormhole contract
 	Watcher struct {
+		chainID      vaa.ChainID
 		aptosRPC     string
 		aptosAccount string
 		aptosHandle  string
+		httpClient   *http.Client
 
 		msgC          chan<- *common.MessagePublication
 		obsvReqC      <-chan *gossipv1.ObservationRequest
@@ -36,16 +39,16 @@ type (
 )
 
 var (
-	aptosMessagesConfirmed = promauto.NewCounter(
+	aptosMessagesConfirmed = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "wormhole_aptos_observations_confirmed_total",
 			Help: "Total number of verified Aptos observations found",
-		})
-	currentAptosHeight = promauto.NewGauge(
+		}, []string{"chain_name"})
+	currentAptosHeight = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "wormhole_aptos_current_height",
 			Help: "Current Aptos block height",
-		})
+		}, []string{"chain_name"})
 )
 
 // NewWatcher creates a new Aptos appid watcher
@@ -55,25 +58,41 @@ func NewWatcher(
 	aptosHandle string,
 	msgC chan<- *common.MessagePublication,
 	obsvReqC <-chan *gossipv1.ObservationRequest,
+) *Watcher {
+	return NewWatcherForProfile(ChainProfile{
+		ChainID: vaa.ChainIDAptos,
+		RPC:     aptosRPC,
+		Account: aptosAccount,
+		Handle:  aptosHandle,
+	}, msgC, obsvReqC)
+}
+
+// NewWatcherForProfile creates a new watcher for the Aptos-VM chain described by profile.
+func NewWatcherForProfile(
+	profile ChainProfile,
+	msgC chan<- *common.MessagePublication,
+	obsvReqC <-chan *gossipv1.ObservationRequest,
 ) *Watcher {
 	return &Watcher{
-		aptosRPC:      aptosRPC,
-		aptosAccount:  aptosAccount,
-		aptosHandle:   aptosHandle,
+		chainID:       profile.ChainID,
+		aptosRPC:      profile.RPC,
+		aptosAccount:  profile.Account,
+		aptosHandle:   profile.Handle,
+		httpClient:    &http.Client{Transport: ratelimit.DefaultRegistry.Transport(profile.RPC, nil)},
 		msgC:          msgC,
 		obsvReqC:      obsvReqC,
-		readinessSync: common.MustConvertChainIdToReadinessSyncing(vaa.ChainIDAptos),
+		readinessSync: common.MustConvertChainIdToReadinessSyncing(profile.ChainID),
 	}
 }
 
 func (e *Watcher) Run(ctx context.Context) error {
-	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAptos, &gossipv1.Heartbeat_Network{
+	p2p.DefaultRegistry.SetNetworkStats(e.chainID, &gossipv1.Heartbeat_Network{
 		ContractAddress: e.aptosAccount,
 	})
 
 	logger := supervisor.Logger(ctx)
 
-	logger.Info("Aptos watcher connecting to RPC node ", zap.String("url", e.aptosRPC))
+	logger.Info("Aptos watcher connecting to RPC node ", zap.Stringer("chain", e.chainID), zap.String("url", e.aptosRPC))
 
 	// SECURITY: the API guarantees that we only get the events from the right
 	// contract
@@ -96,7 +115,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case r := <-e.obsvReqC:
-			if vaa.ChainID(r.ChainId) != vaa.ChainIDAptos {
+			if vaa.ChainID(r.ChainId) != e.chainID {
 				panic("invalid chain ID")
 			}
 
@@ -110,13 +129,13 @@ func (e *Watcher) Run(ctx context.Context) error {
 			body, err := e.retrievePayload(s)
 			if err != nil {
 				logger.Error("retrievePayload", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
 				continue
 			}
 
 			if !gjson.Valid(string(body)) {
 				logger.Error("InvalidJson: " + string(body))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
 				break
 
 			}
@@ -158,7 +177,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 			eventsJson, err := e.retrievePayload(s)
 			if err != nil {
 				logger.Error("retrievePayload", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
 				continue
 			}
 
@@ -171,7 +190,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 			if !gjson.Valid(string(eventsJson)) {
 				logger.Error("InvalidJson: " + string(eventsJson))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
 				continue
 
 			}
@@ -200,13 +219,13 @@ func (e *Watcher) Run(ctx context.Context) error {
 			health, err := e.retrievePayload(aptosHealth)
 			if err != nil {
 				logger.Error("health", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
 				continue
 			}
 
 			if !gjson.Valid(string(health)) {
 				logger.Error("Invalid JSON in health response: " + string(health))
-				p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDAptos, 1)
+				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
 				continue
 
 			}
@@ -219,11 +238,12 @@ func (e *Watcher) Run(ctx context.Context) error {
 			blockHeight := pHealth.Get("block_height")
 
 			if blockHeight.Exists() {
-				currentAptosHeight.Set(float64(blockHeight.Uint()))
-				p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAptos, &gossipv1.Heartbeat_Network{
+				currentAptosHeight.WithLabelValues(e.chainID.String()).Set(float64(blockHeight.Uint()))
+				p2p.DefaultRegistry.SetNetworkStats(e.chainID, &gossipv1.Heartbeat_Network{
 					Height:          int64(blockHeight.Uint()),
 					ContractAddress: e.aptosAccount,
 				})
+				p2p.DefaultRegistry.SetNetworkHeights(e.chainID, p2p.InstantFinalityHeights(int64(blockHeight.Uint())))
 
 				readiness.SetReady(e.readinessSync)
 			}
@@ -232,7 +252,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 }
 
 func (e *Watcher) retrievePayload(s string) ([]byte, error) {
-	res, err := http.Get(s) // nolint
+	res, err := e.httpClient.Get(s) // nolint
 	if err != nil {
 		return nil, err
 	}
@@ -302,13 +322,13 @@ func (e *Watcher) observeData(logger *zap.Logger, data gjson.Result, nativeSeq u
 		Timestamp:        time.Unix(int64(ts.Uint()), 0),
 		Nonce:            uint32(nonce.Uint()), // uint32
 		Sequence:         sequence.Uint(),
-		EmitterChain:     vaa.ChainIDAptos,
+		EmitterChain:     e.chainID,
 		EmitterAddress:   a,
 		Payload:          pl,
 		ConsistencyLevel: uint8(consistencyLevel.Uint()),
 	}
 
-	aptosMessagesConfirmed.Inc()
+	aptosMessagesConfirmed.WithLabelValues(e.chainID.String()).Inc()
 
 	logger.Info("message observed",
 		zap.Stringer("txHash", observation.TxHash),
@@ -12,6 +12,7 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,6 +22,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/wormconn"
 
 	"github.com/tidwall/gjson"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -31,8 +33,8 @@ import (
 
 // ReadLimitSize can be used to increase the read limit size on the listening connection. The default read limit size is not large enough,
 // causing "failed to read: read limited at 32769 bytes" errors during testing. Increasing this limit effects an internal buffer that
-// is used to as part of the zero alloc/copy design.
-const ReadLimitSize = 524288
+// is used to as part of the zero alloc/copy design. It is the same limit as the one of the wormconn tendermint subscriptions.
+const ReadLimitSize = wormconn.TendermintReadLimit
 
 type (
 	// Watcher is responsible for looking over a cosmwasm blockchain and reporting new transactions to the contract
@@ -186,7 +188,8 @@ func (e *Watcher) Run(ctx context.Context) error {
 	common.RunWithScissors(ctx, errC, "cosmwasm_block_height", func(ctx context.Context) error {
 		t := time.NewTicker(5 * time.Second)
 		client := &http.Client{
-			Timeout: time.Second * 5,
+			Timeout:   time.Second * 5,
+			Transport: ratelimit.DefaultRegistry.Transport(e.urlLCD, nil),
 		}
 
 		for {
@@ -221,6 +224,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 					Height:          latestBlock.Int(),
 					ContractAddress: e.contract,
 				})
+				p2p.DefaultRegistry.SetNetworkHeights(e.chainID, p2p.InstantFinalityHeights(latestBlock.Int()))
 
 				readiness.SetReady(e.readinessSync)
 			}
@@ -242,7 +246,8 @@ func (e *Watcher) Run(ctx context.Context) error {
 				logger.Info("received observation request", zap.String("network", networkName), zap.String("tx_hash", tx))
 
 				client := &http.Client{
-					Timeout: time.Second * 5,
+					Timeout:   time.Second * 5,
+					Transport: ratelimit.DefaultRegistry.Transport(e.urlLCD, nil),
 				}
 
 				// Query for tx by hash
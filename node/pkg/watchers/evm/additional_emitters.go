@@ -0,0 +1,281 @@
+// This file contains the code used to observe message publications from contracts other than the core bridge, for
+// instance a contract that takes over publishing messages during a shutdown or a migration. Each contract is configured
+// with its address and the ABI of the event it emits, so new contracts can be supported without new watcher code.
+//
+// SECURITY: Messages from the configured contracts are trusted like the ones from the core bridge. The event must
+// have the same fields as LogMessagePublished (sender, sequence, nonce, payload and consistencyLevel), and the
+// contract must set the sender to the caller, since it becomes the emitter address of the VAA.
+
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// maxAdditionalEmitterBlockRange is the maximum number of blocks queried for additional emitter events at once.
+const maxAdditionalEmitterBlockRange = 1000
+
+// additionalEmitterEventFields are the fields the event of an additional emitter must have, with their ABI types.
+var additionalEmitterEventFields = map[string]string{
+	"sender":           "address",
+	"sequence":         "uint64",
+	"nonce":            "uint32",
+	"payload":          "bytes",
+	"consistencyLevel": "uint8",
+}
+
+// AdditionalEmitter is a contract, other than the core bridge, whose events are observed as message publications.
+type AdditionalEmitter struct {
+	Address eth_common.Address
+	Event   abi.Event
+}
+
+// NewAdditionalEmitter creates an additional emitter from the JSON ABI of the contract (only the event needs to be
+// included) and the name of the event. It returns an error if the event does not have the expected fields.
+func NewAdditionalEmitter(address eth_common.Address, abiJSON string, eventName string) (*AdditionalEmitter, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	event, exists := contractAbi.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("event %s is not in the ABI", eventName)
+	}
+	if event.Anonymous {
+		return nil, fmt.Errorf("event %s is anonymous", eventName)
+	}
+
+	if len(event.Inputs) != len(additionalEmitterEventFields) {
+		return nil, fmt.Errorf("event %s has %d fields, expected %d", eventName, len(event.Inputs), len(additionalEmitterEventFields))
+	}
+	for _, input := range event.Inputs {
+		expectedType, exists := additionalEmitterEventFields[input.Name]
+		if !exists {
+			return nil, fmt.Errorf("event %s has unexpected field %s", eventName, input.Name)
+		}
+		if input.Type.String() != expectedType {
+			return nil, fmt.Errorf("field %s of event %s has type %s, expected %s", input.Name, eventName, input.Type.String(), expectedType)
+		}
+		// Indexed dynamic fields are hashed, so the payload could not be recovered.
+		if input.Indexed && input.Name == "payload" {
+			return nil, fmt.Errorf("field payload of event %s must not be indexed", eventName)
+		}
+	}
+
+	return &AdditionalEmitter{Address: address, Event: event}, nil
+}
+
+// ParseLog parses a log emitted by the additional emitter into the equivalent core bridge event.
+func (e *AdditionalEmitter) ParseLog(l types.Log) (*ethabi.AbiLogMessagePublished, error) {
+	if l.Address != e.Address {
+		return nil, fmt.Errorf("log emitted by %s, expected %s", l.Address.Hex(), e.Address.Hex())
+	}
+	if len(l.Topics) == 0 || l.Topics[0] != e.Event.ID {
+		return nil, fmt.Errorf("log is not a %s event", e.Event.Name)
+	}
+
+	fields := make(map[string]interface{})
+	if err := e.Event.Inputs.NonIndexed().UnpackIntoMap(fields, l.Data); err != nil {
+		return nil, fmt.Errorf("failed to unpack %s event: %w", e.Event.Name, err)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range e.Event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, l.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to parse topics of %s event: %w", e.Event.Name, err)
+	}
+
+	// The types were checked when the emitter was created.
+	return &ethabi.AbiLogMessagePublished{
+		Sender:           fields["sender"].(eth_common.Address),
+		Sequence:         fields["sequence"].(uint64),
+		Nonce:            fields["nonce"].(uint32),
+		Payload:          fields["payload"].([]byte),
+		ConsistencyLevel: fields["consistencyLevel"].(uint8),
+		Raw:              l,
+	}, nil
+}
+
+// additionalEmitterConfig is an entry of the additional emitters file.
+type additionalEmitterConfig struct {
+	ChainID uint16          `json:"chainId"`
+	Address string          `json:"address"`
+	ABI     json.RawMessage `json:"abi"`
+	Event   string          `json:"event"`
+}
+
+// ReadAdditionalEmittersFile reads the additional emitters configuration from a JSON file. The file contains a list of
+// objects with the chainId, the address of the contract, its abi (a JSON ABI that includes the event) and the name of
+// the event. An empty path means no additional emitters.
+func ReadAdditionalEmittersFile(path string) (map[vaa.ChainID][]*AdditionalEmitter, error) {
+	if path == "" {
+		return map[vaa.ChainID][]*AdditionalEmitter{}, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read additional emitters file: %w", err)
+	}
+
+	return ParseAdditionalEmitters(b)
+}
+
+// ParseAdditionalEmitters parses the additional emitters configuration, see ReadAdditionalEmittersFile.
+func ParseAdditionalEmitters(config []byte) (map[vaa.ChainID][]*AdditionalEmitter, error) {
+	var entries []additionalEmitterConfig
+	if err := json.Unmarshal(config, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse additional emitters: %w", err)
+	}
+
+	ret := make(map[vaa.ChainID][]*AdditionalEmitter)
+	for _, entry := range entries {
+		chainID := vaa.ChainID(entry.ChainID)
+		if !eth_common.IsHexAddress(entry.Address) {
+			return nil, fmt.Errorf(`invalid address "%s" for additional emitter on chain %v`, entry.Address, chainID)
+		}
+		address := eth_common.HexToAddress(entry.Address)
+
+		for _, other := range ret[chainID] {
+			if other.Address == address {
+				return nil, fmt.Errorf("duplicate additional emitter %s on chain %v", address.Hex(), chainID)
+			}
+		}
+
+		emitter, err := NewAdditionalEmitter(address, string(entry.ABI), entry.Event)
+		if err != nil {
+			return nil, fmt.Errorf("invalid additional emitter %s on chain %v: %w", address.Hex(), chainID, err)
+		}
+
+		ret[chainID] = append(ret[chainID], emitter)
+	}
+
+	return ret, nil
+}
+
+// SetAdditionalEmitters configures contracts other than the core bridge whose events are observed as message publications.
+func (w *Watcher) SetAdditionalEmitters(emitters []*AdditionalEmitter) {
+	w.additionalEmitters = emitters
+}
+
+// parseAdditionalEmitterLog parses a log if it was emitted by one of the additional emitters. It returns nil if it wasn't.
+func parseAdditionalEmitterLog(emitters []*AdditionalEmitter, l types.Log) (*ethabi.AbiLogMessagePublished, error) {
+	if len(l.Topics) == 0 {
+		return nil, nil
+	}
+	for _, e := range emitters {
+		if l.Address == e.Address && l.Topics[0] == e.Event.ID {
+			return e.ParseLog(l)
+		}
+	}
+	return nil, nil
+}
+
+// getLogsFilter is the filter of an eth_getLogs query.
+type getLogsFilter struct {
+	FromBlock string               `json:"fromBlock"`
+	ToBlock   string               `json:"toBlock"`
+	Address   []eth_common.Address `json:"address"`
+	Topics    [][]eth_common.Hash  `json:"topics"`
+}
+
+// fetchAdditionalEmitterEvents returns the events of the additional emitters in the given block range.
+func (w *Watcher) fetchAdditionalEmitterEvents(ctx context.Context, ethConn connectors.Connector, fromBlock uint64, toBlock uint64) ([]*ethabi.AbiLogMessagePublished, error) {
+	filter := getLogsFilter{
+		FromBlock: eth_hexutil.EncodeBig(new(big.Int).SetUint64(fromBlock)),
+		ToBlock:   eth_hexutil.EncodeBig(new(big.Int).SetUint64(toBlock)),
+		Topics:    [][]eth_common.Hash{{}},
+	}
+	for _, e := range w.additionalEmitters {
+		filter.Address = append(filter.Address, e.Address)
+		filter.Topics[0] = append(filter.Topics[0], e.Event.ID)
+	}
+
+	var logs []types.Log
+	if err := ethConn.RawCallContext(ctx, &logs, "eth_getLogs", filter); err != nil {
+		return nil, fmt.Errorf("failed to get logs from block %d to %d: %w", fromBlock, toBlock, err)
+	}
+
+	events := make([]*ethabi.AbiLogMessagePublished, 0, len(logs))
+	for _, l := range logs {
+		// SECURITY: The node could return unrelated logs, so only the ones matching an emitter and its event are parsed.
+		ev, err := parseAdditionalEmitterLog(w.additionalEmitters, l)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse log %d of tx %s: %w", l.Index, l.TxHash.Hex(), err)
+		}
+		if ev != nil && !l.Removed {
+			events = append(events, ev)
+		}
+	}
+
+	return events, nil
+}
+
+// watchAdditionalEmitters queries the events of the additional emitters on each new block and sends them to messageC,
+// where they are handled like the core bridge events.
+func (w *Watcher) watchAdditionalEmitters(ctx context.Context, logger *zap.Logger, ethConn connectors.Connector, errC chan error, messageC chan<- *ethabi.AbiLogMessagePublished) error {
+	headSink := make(chan *connectors.NewBlock, 2)
+	sub, err := ethConn.SubscribeForBlocks(ctx, errC, headSink)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to header events for additional emitters: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	// nextBlock is the first block that has not been queried yet, or zero before the first block.
+	var nextBlock uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-sub.Err():
+			return fmt.Errorf("error while processing header subscription for additional emitters: %w", err)
+		case ev := <-headSink:
+			if ev == nil || ev.Number == nil || ev.Safe {
+				continue
+			}
+
+			blockNumber := ev.Number.Uint64()
+			if nextBlock == 0 {
+				nextBlock = blockNumber
+			}
+
+			for nextBlock <= blockNumber {
+				toBlock := nextBlock + maxAdditionalEmitterBlockRange - 1
+				if toBlock > blockNumber {
+					toBlock = blockNumber
+				}
+
+				events, err := w.fetchAdditionalEmitterEvents(ctx, ethConn, nextBlock, toBlock)
+				if err != nil {
+					// The range is queried again on the next block.
+					ethConnectionErrors.WithLabelValues(w.networkName, "additional_emitters_error").Inc()
+					logger.Error("failed to query additional emitters", zap.String("eth_network", w.networkName), zap.Error(err))
+					break
+				}
+
+				for _, ev := range events {
+					messageC <- ev
+				}
+				nextBlock = toBlock + 1
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const shutdownEmitterABI = `[{"type":"event","name":"ShutdownMessagePublished","anonymous":false,"inputs":[
+	{"name":"sender","type":"address","indexed":true},
+	{"name":"sequence","type":"uint64","indexed":false},
+	{"name":"nonce","type":"uint32","indexed":false},
+	{"name":"payload","type":"bytes","indexed":false},
+	{"name":"consistencyLevel","type":"uint8","indexed":false}]}]`
+
+var shutdownEmitterAddress = eth_common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+func shutdownEmitterLog(t *testing.T, emitter *AdditionalEmitter, sender eth_common.Address, sequence uint64) types.Log {
+	t.Helper()
+	data, err := emitter.Event.Inputs.NonIndexed().Pack(sequence, uint32(42), []byte("payload"), uint8(1))
+	require.NoError(t, err)
+	return types.Log{
+		Address: emitter.Address,
+		Topics:  []eth_common.Hash{emitter.Event.ID, eth_common.BytesToHash(sender.Bytes())},
+		Data:    data,
+		TxHash:  eth_common.HexToHash("0x01"),
+	}
+}
+
+func TestNewAdditionalEmitter(t *testing.T) {
+	e, err := NewAdditionalEmitter(shutdownEmitterAddress, shutdownEmitterABI, "ShutdownMessagePublished")
+	require.NoError(t, err)
+	assert.Equal(t, "ShutdownMessagePublished(address,uint64,uint32,bytes,uint8)", e.Event.Sig)
+
+	_, err = NewAdditionalEmitter(shutdownEmitterAddress, shutdownEmitterABI, "Other")
+	assert.ErrorContains(t, err, "not in the ABI")
+
+	wrongType := `[{"type":"event","name":"E","inputs":[
+		{"name":"sender","type":"address"},{"name":"sequence","type":"uint256"},{"name":"nonce","type":"uint32"},
+		{"name":"payload","type":"bytes"},{"name":"consistencyLevel","type":"uint8"}]}]`
+	_, err = NewAdditionalEmitter(shutdownEmitterAddress, wrongType, "E")
+	assert.ErrorContains(t, err, "field sequence of event E has type uint256, expected uint64")
+
+	missingField := `[{"type":"event","name":"E","inputs":[
+		{"name":"sender","type":"address"},{"name":"sequence","type":"uint64"},{"name":"payload","type":"bytes"}]}]`
+	_, err = NewAdditionalEmitter(shutdownEmitterAddress, missingField, "E")
+	assert.ErrorContains(t, err, "has 3 fields, expected 5")
+
+	indexedPayload := `[{"type":"event","name":"E","inputs":[
+		{"name":"sender","type":"address"},{"name":"sequence","type":"uint64"},{"name":"nonce","type":"uint32"},
+		{"name":"payload","type":"bytes","indexed":true},{"name":"consistencyLevel","type":"uint8"}]}]`
+	_, err = NewAdditionalEmitter(shutdownEmitterAddress, indexedPayload, "E")
+	assert.ErrorContains(t, err, "must not be indexed")
+}
+
+func TestAdditionalEmitterParseLog(t *testing.T) {
+	e, err := NewAdditionalEmitter(shutdownEmitterAddress, shutdownEmitterABI, "ShutdownMessagePublished")
+	require.NoError(t, err)
+
+	sender := eth_common.HexToAddress("0x0000000000000000000000000000000000001234")
+	l := shutdownEmitterLog(t, e, sender, 7)
+
+	ev, err := e.ParseLog(l)
+	require.NoError(t, err)
+	assert.Equal(t, sender, ev.Sender)
+	assert.Equal(t, uint64(7), ev.Sequence)
+	assert.Equal(t, uint32(42), ev.Nonce)
+	assert.Equal(t, []byte("payload"), ev.Payload)
+	assert.Equal(t, uint8(1), ev.ConsistencyLevel)
+	assert.Equal(t, l.TxHash, ev.Raw.TxHash)
+
+	// Logs of other contracts or events are not parsed.
+	other := l
+	other.Address = eth_common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	_, err = e.ParseLog(other)
+	assert.Error(t, err)
+	ev, err = parseAdditionalEmitterLog([]*AdditionalEmitter{e}, other)
+	assert.NoError(t, err)
+	assert.Nil(t, ev)
+
+	other = l
+	other.Topics = []eth_common.Hash{logMessagePublishedTopic, l.Topics[1]}
+	ev, err = parseAdditionalEmitterLog([]*AdditionalEmitter{e}, other)
+	assert.NoError(t, err)
+	assert.Nil(t, ev)
+}
+
+func TestParseAdditionalEmitters(t *testing.T) {
+	config := fmt.Sprintf(`[{"chainId": 2, "address": "%s", "abi": %s, "event": "ShutdownMessagePublished"}]`, shutdownEmitterAddress.Hex(), shutdownEmitterABI)
+	emitters, err := ParseAdditionalEmitters([]byte(config))
+	require.NoError(t, err)
+	require.Len(t, emitters[vaa.ChainIDEthereum], 1)
+	assert.Equal(t, shutdownEmitterAddress, emitters[vaa.ChainIDEthereum][0].Address)
+
+	duplicate := fmt.Sprintf(`[%s, %s]`, config[1:len(config)-1], config[1:len(config)-1])
+	_, err = ParseAdditionalEmitters([]byte(duplicate))
+	assert.ErrorContains(t, err, "duplicate additional emitter")
+
+	_, err = ParseAdditionalEmitters([]byte(`[{"chainId": 2, "address": "junk", "abi": [], "event": "E"}]`))
+	assert.ErrorContains(t, err, "invalid address")
+
+	emitters, err = ReadAdditionalEmittersFile("")
+	require.NoError(t, err)
+	assert.Empty(t, emitters)
+}
+
+// mockGetLogsConnector implements eth_getLogs. Only RawCallContext is implemented.
+type mockGetLogsConnector struct {
+	connectors.Connector
+	logs    []types.Log
+	filters []getLogsFilter
+}
+
+func (c *mockGetLogsConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "eth_getLogs" {
+		panic("method not implemented by mockGetLogsConnector")
+	}
+	c.filters = append(c.filters, args[0].(getLogsFilter))
+	*result.(*[]types.Log) = c.logs
+	return nil
+}
+
+func TestFetchAdditionalEmitterEvents(t *testing.T) {
+	e, err := NewAdditionalEmitter(shutdownEmitterAddress, shutdownEmitterABI, "ShutdownMessagePublished")
+	require.NoError(t, err)
+
+	w := NewEthWatcher("", eth_common.Address{}, "eth", vaa.ChainIDEthereum, nil, nil, nil, false)
+	w.SetAdditionalEmitters([]*AdditionalEmitter{e})
+
+	sender := eth_common.HexToAddress("0x0000000000000000000000000000000000001234")
+	unrelated := shutdownEmitterLog(t, e, sender, 8)
+	unrelated.Address = eth_common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	conn := &mockGetLogsConnector{logs: []types.Log{shutdownEmitterLog(t, e, sender, 7), unrelated}}
+
+	events, err := w.fetchAdditionalEmitterEvents(context.Background(), conn, 10, 20)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(7), events[0].Sequence)
+
+	require.Len(t, conn.filters, 1)
+	assert.Equal(t, "0xa", conn.filters[0].FromBlock)
+	assert.Equal(t, "0x14", conn.filters[0].ToBlock)
+	assert.Equal(t, []eth_common.Address{shutdownEmitterAddress}, conn.filters[0].Address)
+	assert.Equal(t, [][]eth_common.Hash{{e.Event.ID}}, conn.filters[0].Topics)
+}
@@ -0,0 +1,184 @@
+// This file contains the code used to handle message publications in batches. On chains emitting hundreds of messages
+// per block, handling each message on its own means one block timestamp query per message when it is observed, one
+// receipt query per message when it is confirmed, and holding the pending lock while blocking on the processor.
+// Instead, the events are collected until a short deadline, the queries are made once per block and transaction, and the
+// messages are sent to the processor after the pending lock was released.
+
+package evm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// messageBatchDeadline is how long the watcher waits for more message publications after the first one of a batch.
+	// The events of a block are usually delivered together, so this is enough to handle them as a single batch.
+	messageBatchDeadline = 50 * time.Millisecond
+
+	// maxMessageBatchSize is the maximum number of message publications handled as a single batch.
+	maxMessageBatchSize = 1000
+)
+
+// collectMessageBatch returns the first event along with the events received from messageC until the deadline expires,
+// the batch is full or the context is canceled.
+func collectMessageBatch(ctx context.Context, first *ethabi.AbiLogMessagePublished, messageC <-chan *ethabi.AbiLogMessagePublished, deadline time.Duration, maxSize int) []*ethabi.AbiLogMessagePublished {
+	batch := []*ethabi.AbiLogMessagePublished{first}
+	timer := time.NewTimer(deadline)
+	defer timer.Stop()
+	for len(batch) < maxSize {
+		select {
+		case <-ctx.Done():
+			return batch
+		case <-timer.C:
+			return batch
+		case ev := <-messageC:
+			batch = append(batch, ev)
+		}
+	}
+	return batch
+}
+
+// handleMessageBatch turns a batch of message publication events into message publications. The block timestamp is
+// requested once per block. Messages requesting to be published immediately are sent to the processor, the others are
+// added to the pending messages to wait for their confirmations.
+func (w *Watcher) handleMessageBatch(ctx context.Context, logger *zap.Logger, events []*ethabi.AbiLogMessagePublished) error {
+	blockTimes := make(map[eth_common.Hash]uint64)
+	immediate := make([]*common.MessagePublication, 0)
+	pending := make(map[pendingKey]*pendingMessage)
+
+	for _, ev := range events {
+		blockTime, exists := blockTimes[ev.Raw.BlockHash]
+		if !exists {
+			// Request timestamp for block
+			msm := time.Now()
+			timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+			var err error
+			blockTime, err = w.ethConn.TimeOfBlockByHash(timeout, ev.Raw.BlockHash)
+			cancel()
+			queryLatency.WithLabelValues(w.networkName, "block_by_number").Observe(time.Since(msm).Seconds())
+
+			if err != nil {
+				ethConnectionErrors.WithLabelValues(w.networkName, "block_by_number_error").Inc()
+				p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+				return fmt.Errorf("failed to request timestamp for block %d, hash %s: %w",
+					ev.Raw.BlockNumber, ev.Raw.BlockHash.String(), err)
+			}
+			blockTimes[ev.Raw.BlockHash] = blockTime
+		}
+
+		message := &common.MessagePublication{
+			TxHash:           ev.Raw.TxHash,
+			Timestamp:        time.Unix(int64(blockTime), 0),
+			Nonce:            ev.Nonce,
+			Sequence:         ev.Sequence,
+			EmitterChain:     w.chainID,
+			EmitterAddress:   PadAddress(ev.Sender),
+			Payload:          ev.Payload,
+			ConsistencyLevel: ev.ConsistencyLevel,
+		}
+
+		ethMessagesObserved.WithLabelValues(w.networkName).Inc()
+
+		if message.ConsistencyLevel == vaa.ConsistencyLevelPublishImmediately {
+			logger.Info("found new message publication transaction, publishing it immediately",
+				zap.Stringer("tx", ev.Raw.TxHash),
+				zap.Uint64("block", ev.Raw.BlockNumber),
+				zap.Stringer("blockhash", ev.Raw.BlockHash),
+				zap.Uint64("blockTime", blockTime),
+				zap.Uint64("Sequence", ev.Sequence),
+				zap.Uint32("Nonce", ev.Nonce),
+				zap.Uint8("ConsistencyLevel", ev.ConsistencyLevel),
+				zap.String("eth_network", w.networkName))
+
+			immediate = append(immediate, message)
+			continue
+		}
+
+		logger.Info("found new message publication transaction",
+			zap.Stringer("tx", ev.Raw.TxHash),
+			zap.Uint64("block", ev.Raw.BlockNumber),
+			zap.Stringer("blockhash", ev.Raw.BlockHash),
+			zap.Uint64("blockTime", blockTime),
+			zap.Uint64("Sequence", ev.Sequence),
+			zap.Uint32("Nonce", ev.Nonce),
+			zap.Uint8("ConsistencyLevel", ev.ConsistencyLevel),
+			zap.String("eth_network", w.networkName))
+
+		key := pendingKey{
+			TxHash:         message.TxHash,
+			BlockHash:      ev.Raw.BlockHash,
+			EmitterAddress: message.EmitterAddress,
+			Sequence:       message.Sequence,
+		}
+		pending[key] = &pendingMessage{
+			message: message,
+			height:  ev.Raw.BlockNumber,
+		}
+	}
+
+	if len(pending) != 0 {
+		w.pendingMu.Lock()
+		for key, pm := range pending {
+			w.pending[key] = pm
+		}
+		w.pendingMu.Unlock()
+	}
+
+	w.publishMessages(ctx, immediate)
+	return nil
+}
+
+// publishMessages sends the messages to the processor. It must not be called while holding the pending lock, since
+// sending blocks until the processor is ready.
+func (w *Watcher) publishMessages(ctx context.Context, messages []*common.MessagePublication) {
+	for _, message := range messages {
+		select {
+		case <-ctx.Done():
+			return
+		case w.msgC <- message:
+			ethMessagesConfirmed.WithLabelValues(w.networkName).Inc()
+		}
+	}
+}
+
+// receiptResult is the result of a transaction receipt query.
+type receiptResult struct {
+	receipt *types.Receipt
+	err     error
+}
+
+// receiptCache requests the receipt of each transaction once while processing a block, since a transaction can emit
+// many messages.
+type receiptCache struct {
+	ethConn  connectors.Connector
+	receipts map[eth_common.Hash]receiptResult
+}
+
+func newReceiptCache(ethConn connectors.Connector) *receiptCache {
+	return &receiptCache{ethConn: ethConn, receipts: make(map[eth_common.Hash]receiptResult)}
+}
+
+// get returns the receipt of the transaction, requesting it if it was not requested yet.
+func (c *receiptCache) get(ctx context.Context, txHash eth_common.Hash) (*types.Receipt, error) {
+	if r, exists := c.receipts[txHash]; exists {
+		return r.receipt, r.err
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	receipt, err := c.ethConn.TransactionReceipt(timeout, txHash)
+	cancel()
+
+	c.receipts[txHash] = receiptResult{receipt: receipt, err: err}
+	return receipt, err
+}
@@ -0,0 +1,119 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type mockBatchConnector struct {
+	connectors.Connector
+	blockTimeCalls int
+	receiptCalls   int
+}
+
+func (c *mockBatchConnector) TimeOfBlockByHash(ctx context.Context, hash eth_common.Hash) (uint64, error) {
+	c.blockTimeCalls++
+	if hash == (eth_common.Hash{}) {
+		return 0, errors.New("not found")
+	}
+	return 1700000000 + uint64(hash[31]), nil
+}
+
+func (c *mockBatchConnector) TransactionReceipt(ctx context.Context, txHash eth_common.Hash) (*types.Receipt, error) {
+	c.receiptCalls++
+	return &types.Receipt{Status: 1, TxHash: txHash}, nil
+}
+
+func batchTestEvent(blockHash byte, sequence uint64, consistencyLevel uint8) *ethabi.AbiLogMessagePublished {
+	return &ethabi.AbiLogMessagePublished{
+		Sender:           eth_common.HexToAddress("0x0000000000000000000000000000000000001234"),
+		Sequence:         sequence,
+		ConsistencyLevel: consistencyLevel,
+		Raw: types.Log{
+			BlockNumber: 100,
+			BlockHash:   eth_common.Hash{31: blockHash},
+			TxHash:      eth_common.Hash{31: byte(sequence)},
+		},
+	}
+}
+
+func TestCollectMessageBatch(t *testing.T) {
+	messageC := make(chan *ethabi.AbiLogMessagePublished, 10)
+	for i := 1; i <= 5; i++ {
+		messageC <- batchTestEvent(1, uint64(i), 1)
+	}
+
+	// The batch is limited to its maximum size.
+	batch := collectMessageBatch(context.Background(), batchTestEvent(1, 0, 1), messageC, time.Minute, 3)
+	require.Len(t, batch, 3)
+	assert.Equal(t, uint64(0), batch[0].Sequence)
+	assert.Equal(t, uint64(2), batch[2].Sequence)
+
+	// The remaining events are collected until the deadline.
+	start := time.Now()
+	batch = collectMessageBatch(context.Background(), batchTestEvent(1, 10, 1), messageC, 10*time.Millisecond, 100)
+	assert.Len(t, batch, 4)
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestHandleMessageBatch(t *testing.T) {
+	conn := &mockBatchConnector{}
+	msgC := make(chan *common.MessagePublication, 10)
+	w := NewEthWatcher("", eth_common.Address{}, "eth", vaa.ChainIDEthereum, msgC, nil, nil, false)
+	w.ethConn = conn
+
+	events := []*ethabi.AbiLogMessagePublished{
+		batchTestEvent(1, 1, 1),
+		batchTestEvent(1, 2, vaa.ConsistencyLevelPublishImmediately),
+		batchTestEvent(2, 3, 1),
+		batchTestEvent(1, 4, 1),
+	}
+	require.NoError(t, w.handleMessageBatch(context.Background(), zap.NewNop(), events))
+
+	// The timestamp is requested once per block.
+	assert.Equal(t, 2, conn.blockTimeCalls)
+
+	require.Len(t, msgC, 1)
+	msg := <-msgC
+	assert.Equal(t, uint64(2), msg.Sequence)
+	assert.Equal(t, time.Unix(1700000001, 0), msg.Timestamp)
+
+	require.Len(t, w.pending, 3)
+	for key, pm := range w.pending {
+		assert.Equal(t, key.Sequence, pm.message.Sequence)
+		assert.Equal(t, uint64(100), pm.height)
+		assert.Equal(t, time.Unix(1700000000+int64(key.BlockHash[31]), 0), pm.message.Timestamp)
+	}
+
+	// A batch fails if a timestamp cannot be requested.
+	err := w.handleMessageBatch(context.Background(), zap.NewNop(), []*ethabi.AbiLogMessagePublished{batchTestEvent(0, 5, 1)})
+	assert.ErrorContains(t, err, "failed to request timestamp")
+	assert.Len(t, w.pending, 3)
+}
+
+func TestReceiptCache(t *testing.T) {
+	conn := &mockBatchConnector{}
+	receipts := newReceiptCache(conn)
+
+	for i := 0; i < 3; i++ {
+		receipt, err := receipts.get(context.Background(), eth_common.Hash{1})
+		require.NoError(t, err)
+		assert.Equal(t, eth_common.Hash{1}, receipt.TxHash)
+	}
+	_, err := receipts.get(context.Background(), eth_common.Hash{2})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, conn.receiptCalls)
+}
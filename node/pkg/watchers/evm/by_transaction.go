@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -19,12 +21,13 @@ var (
 	logMessagePublishedTopic = eth_common.HexToHash("0x6eb224fb001ed210e379b335e35efe88672a8ce935d981a6896b27ffdf52a3b2")
 )
 
-// MessageEventsForTransaction returns the lockup events for a given transaction.
+// MessageEventsForTransaction returns the lockup events for a given transaction, including the ones of the additional emitters.
 // Returns the block number and a list of MessagePublication events.
 func MessageEventsForTransaction(
 	ctx context.Context,
 	ethConn connectors.Connector,
 	contract eth_common.Address,
+	additionalEmitters []*AdditionalEmitter,
 	chainId vaa.ChainID,
 	tx eth_common.Hash) (uint64, []*common.MessagePublication, error) {
 
@@ -52,6 +55,24 @@ func MessageEventsForTransaction(
 		return 0, nil, fmt.Errorf("failed to get block time: %w", err)
 	}
 
+	msgs, err := messagesFromReceipt(ethConn, contract, additionalEmitters, chainId, receipt, blockTime)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return receipt.BlockNumber.Uint64(), msgs, nil
+}
+
+// messagesFromReceipt returns the message publications of the core bridge and of the additional emitters in the logs
+// of a transaction receipt.
+func messagesFromReceipt(
+	ethConn connectors.Connector,
+	contract eth_common.Address,
+	additionalEmitters []*AdditionalEmitter,
+	chainId vaa.ChainID,
+	receipt *types.Receipt,
+	blockTime uint64) ([]*common.MessagePublication, error) {
+
 	msgs := make([]*common.MessagePublication, 0, len(receipt.Logs))
 
 	// Extract logs
@@ -60,18 +81,26 @@ func MessageEventsForTransaction(
 			continue
 		}
 
-		// SECURITY: Skip logs not produced by our contract.
-		if l.Address != contract {
-			continue
-		}
-
-		if l.Topics[0] != logMessagePublishedTopic {
-			continue
-		}
-
-		ev, err := ethConn.ParseLogMessagePublished(*l)
-		if err != nil {
-			return 0, nil, fmt.Errorf("failed to parse log: %w", err)
+		var ev *ethabi.AbiLogMessagePublished
+		var err error
+		if l.Address == contract {
+			if len(l.Topics) == 0 || l.Topics[0] != logMessagePublishedTopic {
+				continue
+			}
+
+			ev, err = ethConn.ParseLogMessagePublished(*l)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse log: %w", err)
+			}
+		} else {
+			// SECURITY: Skip logs not produced by our contract or one of the additional emitters.
+			ev, err = parseAdditionalEmitterLog(additionalEmitters, *l)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse log: %w", err)
+			}
+			if ev == nil {
+				continue
+			}
 		}
 
 		message := &common.MessagePublication{
@@ -88,5 +117,5 @@ func MessageEventsForTransaction(
 		msgs = append(msgs, message)
 	}
 
-	return receipt.BlockNumber.Uint64(), msgs, nil
+	return msgs, nil
 }
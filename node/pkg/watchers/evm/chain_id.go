@@ -0,0 +1,136 @@
+// This file contains the code used to verify at startup that the RPC endpoints of a watcher are connected to the
+// expected EVM chain. This protects against a misconfigured RPC endpoint (for instance a testnet endpoint on a mainnet
+// guardian, or the endpoint of a different chain) producing invalid observations.
+
+package evm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// mainnetEvmChainIDs maps the Wormhole chain ID of each EVM chain to its mainnet EVM chain ID.
+var mainnetEvmChainIDs = map[vaa.ChainID]uint64{
+	vaa.ChainIDEthereum:  1,
+	vaa.ChainIDBSC:       56,
+	vaa.ChainIDPolygon:   137,
+	vaa.ChainIDAvalanche: 43114,
+	vaa.ChainIDOasis:     42262,
+	vaa.ChainIDAurora:    1313161554,
+	vaa.ChainIDFantom:    250,
+	vaa.ChainIDKarura:    686,
+	vaa.ChainIDAcala:     787,
+	vaa.ChainIDKlaytn:    8217,
+	vaa.ChainIDCelo:      42220,
+	vaa.ChainIDMoonbeam:  1284,
+	vaa.ChainIDArbitrum:  42161,
+	vaa.ChainIDOptimism:  10,
+	vaa.ChainIDBase:      8453,
+	vaa.ChainIDNeon:      245022934,
+}
+
+// testnetEvmChainIDs maps the Wormhole chain ID of each EVM chain to its testnet EVM chain ID.
+var testnetEvmChainIDs = map[vaa.ChainID]uint64{
+	vaa.ChainIDEthereum:  5,
+	vaa.ChainIDBSC:       97,
+	vaa.ChainIDPolygon:   80001,
+	vaa.ChainIDAvalanche: 43113,
+	vaa.ChainIDOasis:     42261,
+	vaa.ChainIDAurora:    1313161555,
+	vaa.ChainIDFantom:    4002,
+	vaa.ChainIDKarura:    596,
+	vaa.ChainIDAcala:     595,
+	vaa.ChainIDKlaytn:    1001,
+	vaa.ChainIDCelo:      44787,
+	vaa.ChainIDMoonbeam:  1287,
+	vaa.ChainIDArbitrum:  421613,
+	vaa.ChainIDOptimism:  420,
+	vaa.ChainIDBase:      84531,
+	vaa.ChainIDNeon:      245022926,
+	vaa.ChainIDSepolia:   11155111,
+}
+
+// ExpectedEvmChainIDs returns the EVM chain IDs the watchers should be connected to in mainnet or testnet. Devnet
+// chains have no well known IDs, so there is nothing to verify there.
+func ExpectedEvmChainIDs(testnet bool) map[vaa.ChainID]uint64 {
+	if testnet {
+		return testnetEvmChainIDs
+	}
+	return mainnetEvmChainIDs
+}
+
+// SetExpectedEvmChainIDs enables the verification of the EVM chain ID of the endpoints of the watcher at startup. The
+// watcher refuses to start if the chain ID reported by an endpoint does not match the one expected for its chain.
+func (w *Watcher) SetExpectedEvmChainIDs(expected map[vaa.ChainID]uint64) {
+	w.expectedEvmChainIDs = expected
+}
+
+// verifyEvmChainIDs checks that the endpoints of the watcher are connected to the expected EVM chains. The root chain
+// endpoint used by Polygon and Optimism is expected to be on Ethereum.
+func (w *Watcher) verifyEvmChainIDs(ctx context.Context, logger *zap.Logger) error {
+	if w.expectedEvmChainIDs == nil {
+		return nil
+	}
+
+	expected, exists := w.expectedEvmChainIDs[w.chainID]
+	if !exists {
+		logger.Warn("no expected EVM chain ID configured for chain, not verifying it", zap.Stringer("chainID", w.chainID))
+		return nil
+	}
+
+	if err := verifyEvmChainID(ctx, logger, w.url, expected); err != nil {
+		return err
+	}
+
+	if w.rootChainRpc != "" {
+		if expected, exists := w.expectedEvmChainIDs[vaa.ChainIDEthereum]; exists {
+			if err := verifyEvmChainID(ctx, logger, w.rootChainRpc, expected); err != nil {
+				return fmt.Errorf("root chain: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// verifyEvmChainID queries eth_chainId on the given url and returns an error if it does not match the expected chain ID.
+func verifyEvmChainID(ctx context.Context, logger *zap.Logger, url string, expected uint64) error {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	c, err := rpc.DialContext(timeout, url)
+	if err != nil {
+		return fmt.Errorf("failed to connect to url %s to check the EVM chain ID: %w", url, err)
+	}
+	defer c.Close()
+
+	if err := ratelimit.DefaultRegistry.ForURL(url).Wait(timeout); err != nil {
+		return err
+	}
+	actual, err := queryEvmChainID(timeout, c)
+	if err != nil {
+		return fmt.Errorf("failed to query the EVM chain ID of url %s: %w", url, err)
+	}
+
+	if actual != expected {
+		return fmt.Errorf("url %s is connected to EVM chain ID %d, expected %d", url, actual, expected)
+	}
+
+	logger.Info("verified EVM chain ID", zap.String("url", url), zap.Uint64("evmChainID", actual))
+	return nil
+}
+
+func queryEvmChainID(ctx context.Context, c *rpc.Client) (uint64, error) {
+	var result eth_hexutil.Uint64
+	if err := c.CallContext(ctx, &result, "eth_chainId"); err != nil {
+		return 0, err
+	}
+	return uint64(result), nil
+}
@@ -0,0 +1,82 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// newChainIdServer returns an RPC server that reports the given EVM chain ID.
+func newChainIdServer(t *testing.T, evmChainID uint64) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_chainId", req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, req.ID, evmChainID)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestVerifyEvmChainIDs(t *testing.T) {
+	ctx := context.Background()
+	ethSrv := newChainIdServer(t, 1)
+	bscSrv := newChainIdServer(t, 56)
+
+	w := NewEthWatcher(ethSrv.URL, eth_common.Address{}, "eth", vaa.ChainIDEthereum, nil, nil, nil, false)
+
+	// Verification is disabled by default.
+	w.url = bscSrv.URL
+	assert.NoError(t, w.verifyEvmChainIDs(ctx, zap.NewNop()))
+
+	w.SetExpectedEvmChainIDs(ExpectedEvmChainIDs(false))
+	assert.ErrorContains(t, w.verifyEvmChainIDs(ctx, zap.NewNop()), "connected to EVM chain ID 56, expected 1")
+
+	w.url = ethSrv.URL
+	assert.NoError(t, w.verifyEvmChainIDs(ctx, zap.NewNop()))
+
+	// A mainnet endpoint is rejected in testnet.
+	w.SetExpectedEvmChainIDs(ExpectedEvmChainIDs(true))
+	assert.ErrorContains(t, w.verifyEvmChainIDs(ctx, zap.NewNop()), "expected 5")
+}
+
+func TestVerifyEvmChainIDsRootChain(t *testing.T) {
+	ctx := context.Background()
+	polygonSrv := newChainIdServer(t, 137)
+	ethSrv := newChainIdServer(t, 1)
+	bscSrv := newChainIdServer(t, 56)
+
+	w := NewEthWatcher(polygonSrv.URL, eth_common.Address{}, "polygon", vaa.ChainIDPolygon, nil, nil, nil, false)
+	w.SetExpectedEvmChainIDs(ExpectedEvmChainIDs(false))
+
+	w.rootChainRpc = ethSrv.URL
+	assert.NoError(t, w.verifyEvmChainIDs(ctx, zap.NewNop()))
+
+	w.rootChainRpc = bscSrv.URL
+	assert.ErrorContains(t, w.verifyEvmChainIDs(ctx, zap.NewNop()), "root chain")
+}
+
+func TestExpectedEvmChainIDsAreUnique(t *testing.T) {
+	for _, testnet := range []bool{false, true} {
+		seen := map[uint64]vaa.ChainID{}
+		for chainID, evmChainID := range ExpectedEvmChainIDs(testnet) {
+			other, exists := seen[evmChainID]
+			assert.False(t, exists, "%v and %v have the same EVM chain ID %d", chainID, other, evmChainID)
+			seen[evmChainID] = chainID
+		}
+	}
+}
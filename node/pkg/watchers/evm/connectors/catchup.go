@@ -0,0 +1,124 @@
+package connectors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// When the block poller falls behind, for instance after the RPC node was down, it fetches all the missed blocks one
+// after the other. Doing that at full speed tends to run into the rate limit of hosted RPC providers, and failing
+// requests would restart the watcher. Instead, the poller slows down whenever the provider reports a rate limit and
+// speeds up again as requests succeed.
+
+var (
+	pollerBlocksBehind = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_poller_blocks_behind",
+			Help: "Number of blocks the block poller still has to fetch to catch up with the chain",
+		}, []string{"eth_network"})
+	pollerCatchUpDelay = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_poller_catch_up_delay_seconds",
+			Help: "Current delay between the block fetches of the block poller, raised when the RPC provider rate limits it",
+		}, []string{"eth_network"})
+	pollerRateLimitedRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_poller_rate_limited_requests_total",
+			Help: "Total number of block poller requests rejected by the rate limit of the RPC provider",
+		}, []string{"eth_network"})
+)
+
+const (
+	// catchUpMinDelay is the delay between block fetches after the first rate limited request.
+	catchUpMinDelay = 100 * time.Millisecond
+
+	// catchUpMaxDelay is the highest delay between block fetches.
+	catchUpMaxDelay = 10 * time.Second
+)
+
+// catchUpThrottle adapts the delay between the block fetches of a poller to the rate limit of the RPC provider. The
+// delay doubles on every rate limited request and shrinks by a quarter on every successful one, so that it settles
+// just below the limit. It is zero as long as no request has been rate limited.
+type catchUpThrottle struct {
+	networkName string
+	delay       time.Duration
+}
+
+func newCatchUpThrottle(networkName string) *catchUpThrottle {
+	pollerCatchUpDelay.WithLabelValues(networkName).Set(0)
+	return &catchUpThrottle{networkName: networkName}
+}
+
+// rateLimited records a rate limited request.
+func (t *catchUpThrottle) rateLimited() {
+	pollerRateLimitedRequests.WithLabelValues(t.networkName).Inc()
+	t.delay *= 2
+	if t.delay < catchUpMinDelay {
+		t.delay = catchUpMinDelay
+	} else if t.delay > catchUpMaxDelay {
+		t.delay = catchUpMaxDelay
+	}
+	pollerCatchUpDelay.WithLabelValues(t.networkName).Set(t.delay.Seconds())
+}
+
+// succeeded records a successful request.
+func (t *catchUpThrottle) succeeded() {
+	if t.delay == 0 {
+		return
+	}
+	t.delay -= t.delay / 4
+	if t.delay < catchUpMinDelay/2 {
+		t.delay = 0
+	}
+	pollerCatchUpDelay.WithLabelValues(t.networkName).Set(t.delay.Seconds())
+}
+
+// wait blocks for the current delay, or until ctx is done.
+func (t *catchUpThrottle) wait(ctx context.Context) error {
+	if t.delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(t.delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// limitExceededErrorCode is the JSON-RPC error code for a request exceeding a limit, see EIP-1474.
+const limitExceededErrorCode = -32005
+
+// isRateLimitError returns true if err indicates that the RPC provider rejected a request because of its rate limit,
+// with HTTP 429 or a JSON-RPC error. Some providers use their own code instead of the EIP-1474 one, so the message of
+// JSON-RPC errors is checked as well. Other errors are never taken for rate limits, since their message may contain
+// anything, like a block number or hash.
+func isRateLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.ErrorCode() == limitExceededErrorCode {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Error())
+	return strings.Contains(msg, "too many requests") || strings.Contains(msg, "rate limit")
+}
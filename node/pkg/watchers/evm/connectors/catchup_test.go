@@ -0,0 +1,105 @@
+package connectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type limitExceededError struct{}
+
+func (limitExceededError) Error() string  { return "daily request count exceeded" }
+func (limitExceededError) ErrorCode() int { return limitExceededErrorCode }
+
+// providerError is a JSON-RPC error with a provider specific code.
+type providerError struct{ msg string }
+
+func (e providerError) Error() string  { return e.msg }
+func (e providerError) ErrorCode() int { return -32000 }
+
+func TestIsRateLimitError(t *testing.T) {
+	assert.False(t, isRateLimitError(nil))
+	assert.False(t, isRateLimitError(errors.New("connection refused")))
+	assert.False(t, isRateLimitError(rpc.HTTPError{StatusCode: 500, Status: "500 Internal Server Error"}))
+	assert.False(t, isRateLimitError(rpc.HTTPError{StatusCode: 500, Status: "500 Internal Server Error", Body: []byte("rate limit")}))
+	assert.False(t, isRateLimitError(errors.New("block 4290000 not found")))
+	assert.False(t, isRateLimitError(errors.New("rate limit reached")))
+	assert.False(t, isRateLimitError(providerError{"header not found"}))
+
+	assert.True(t, isRateLimitError(rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}))
+	assert.True(t, isRateLimitError(fmt.Errorf("failed to get block: %w", rpc.HTTPError{StatusCode: 429})))
+	assert.True(t, isRateLimitError(limitExceededError{}))
+	assert.True(t, isRateLimitError(providerError{"Your app has exceeded its compute units per second capacity. Rate limit reached"}))
+	assert.True(t, isRateLimitError(fmt.Errorf("failed to get block: %w", providerError{"Too Many Requests"})))
+}
+
+func TestCatchUpThrottle(t *testing.T) {
+	throttle := newCatchUpThrottle("test")
+	assert.Equal(t, time.Duration(0), throttle.delay)
+	throttle.succeeded()
+	assert.Equal(t, time.Duration(0), throttle.delay)
+
+	throttle.rateLimited()
+	assert.Equal(t, catchUpMinDelay, throttle.delay)
+	throttle.rateLimited()
+	assert.Equal(t, 2*catchUpMinDelay, throttle.delay)
+	for i := 0; i < 20; i++ {
+		throttle.rateLimited()
+	}
+	assert.Equal(t, catchUpMaxDelay, throttle.delay)
+
+	// Successful requests speed the poller up again until it runs at full speed.
+	throttle.succeeded()
+	assert.Equal(t, catchUpMaxDelay*3/4, throttle.delay)
+	for i := 0; i < 30; i++ {
+		throttle.succeeded()
+	}
+	assert.Equal(t, time.Duration(0), throttle.delay)
+
+	// A cancelled context interrupts the wait.
+	throttle.rateLimited()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, throttle.wait(ctx), context.Canceled)
+}
+
+func TestBlockPollerRateLimited(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop()
+	baseConnector := mockConnectorForPoller{}
+	poller := &BlockPollConnector{
+		Connector: &baseConnector,
+		Delay:     time.Millisecond,
+		finalizer: newMockFinalizerForPoller(true),
+		throttle:  newCatchUpThrottle("test"),
+	}
+
+	baseConnector.setBlockNumber(0x309a0c)
+	lastBlock, err := poller.getBlock(ctx, logger, nil, false)
+	require.NoError(t, err)
+	baseConnector.setBlockNumber(0x309a0d)
+
+	// A rate limited request is not an error, the poller slows down instead.
+	baseConnector.setSingleError(rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"})
+	block, err := poller.pollBlocks(ctx, logger, lastBlock, false)
+	require.NoError(t, err)
+	assert.Equal(t, lastBlock, block)
+	assert.Equal(t, catchUpMinDelay, poller.throttle.delay)
+
+	block, err = poller.pollBlocks(ctx, logger, lastBlock, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x309a0d), block.Number.Uint64())
+	assert.Less(t, poller.throttle.delay, catchUpMinDelay)
+
+	// Other errors are still reported.
+	baseConnector.setSingleError(errors.New("connection refused"))
+	_, err = poller.pollBlocks(ctx, logger, block, false)
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	ethEvent "github.com/ethereum/go-ethereum/event"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"go.uber.org/zap"
 )
 
@@ -32,6 +33,7 @@ type CeloConnector struct {
 	rawClient   *celoRpc.Client
 	filterer    *celoAbi.AbiFilterer
 	caller      *celoAbi.AbiCaller
+	limiter     *ratelimit.Limiter
 }
 
 func NewCeloConnector(ctx context.Context, networkName, rawUrl string, address ethCommon.Address, logger *zap.Logger) (*CeloConnector, error) {
@@ -58,6 +60,7 @@ func NewCeloConnector(ctx context.Context, networkName, rawUrl string, address e
 		rawClient:   rawClient,
 		filterer:    filterer,
 		caller:      caller,
+		limiter:     ratelimit.DefaultRegistry.ForURL(rawUrl),
 	}, nil
 }
 
@@ -70,11 +73,17 @@ func (c *CeloConnector) ContractAddress() ethCommon.Address {
 }
 
 func (c *CeloConnector) GetCurrentGuardianSetIndex(ctx context.Context) (uint32, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
 	opts := &celoBind.CallOpts{Context: ctx}
 	return c.caller.GetCurrentGuardianSetIndex(opts)
 }
 
 func (c *CeloConnector) GetGuardianSet(ctx context.Context, index uint32) (ethAbi.StructsGuardianSet, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return ethAbi.StructsGuardianSet{}, err
+	}
 	opts := &celoBind.CallOpts{Context: ctx}
 	celoGs, err := c.caller.GetGuardianSet(opts, index)
 	if err != nil {
@@ -93,6 +102,9 @@ func (c *CeloConnector) GetGuardianSet(ctx context.Context, index uint32) (ethAb
 }
 
 func (c *CeloConnector) WatchLogMessagePublished(ctx context.Context, errC chan error, sink chan<- *ethAbi.AbiLogMessagePublished) (ethEvent.Subscription, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 	messageC := make(chan *celoAbi.AbiLogMessagePublished, 2)
@@ -118,6 +130,9 @@ func (c *CeloConnector) WatchLogMessagePublished(ctx context.Context, errC chan
 }
 
 func (c *CeloConnector) TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*ethTypes.Receipt, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	celoReceipt, err := c.client.TransactionReceipt(ctx, celoCommon.BytesToHash(txHash.Bytes()))
 	if err != nil {
 		return nil, err
@@ -127,6 +142,9 @@ func (c *CeloConnector) TransactionReceipt(ctx context.Context, txHash ethCommon
 }
 
 func (c *CeloConnector) TimeOfBlockByHash(ctx context.Context, hash ethCommon.Hash) (uint64, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
 	block, err := c.client.BlockByHash(ctx, celoCommon.BytesToHash(hash.Bytes()))
 	if err != nil {
 		return 0, err
@@ -145,6 +163,9 @@ func (c *CeloConnector) ParseLogMessagePublished(ethLog ethTypes.Log) (*ethAbi.A
 }
 
 func (c *CeloConnector) SubscribeForBlocks(ctx context.Context, errC chan error, sink chan<- *NewBlock) (ethereum.Subscription, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	headSink := make(chan *celoTypes.Header, 2)
 	headerSubscription, err := c.client.SubscribeNewHead(ctx, headSink)
 	if err != nil {
@@ -178,6 +199,9 @@ func (c *CeloConnector) SubscribeForBlocks(ctx context.Context, errC chan error,
 }
 
 func (c *CeloConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
 	return c.rawClient.CallContext(ctx, result, method, args...)
 }
 
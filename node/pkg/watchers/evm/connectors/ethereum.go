@@ -16,6 +16,7 @@ import (
 	ethEvent "github.com/ethereum/go-ethereum/event"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"go.uber.org/zap"
 )
 
@@ -29,6 +30,7 @@ type EthereumConnector struct {
 	rawClient   *ethRpc.Client
 	filterer    *ethAbi.AbiFilterer
 	caller      *ethAbi.AbiCaller
+	limiter     *ratelimit.Limiter
 }
 
 func NewEthereumConnector(ctx context.Context, networkName, rawUrl string, address ethCommon.Address, logger *zap.Logger) (*EthereumConnector, error) {
@@ -55,6 +57,7 @@ func NewEthereumConnector(ctx context.Context, networkName, rawUrl string, addre
 		client:      client,
 		filterer:    filterer,
 		caller:      caller,
+		limiter:     ratelimit.DefaultRegistry.ForURL(rawUrl),
 		rawClient:   rawClient,
 	}, nil
 }
@@ -68,24 +71,39 @@ func (e *EthereumConnector) ContractAddress() ethCommon.Address {
 }
 
 func (e *EthereumConnector) GetCurrentGuardianSetIndex(ctx context.Context) (uint32, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
 	return e.caller.GetCurrentGuardianSetIndex(&ethBind.CallOpts{Context: ctx})
 }
 
 func (e *EthereumConnector) GetGuardianSet(ctx context.Context, index uint32) (ethAbi.StructsGuardianSet, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return ethAbi.StructsGuardianSet{}, err
+	}
 	return e.caller.GetGuardianSet(&ethBind.CallOpts{Context: ctx}, index)
 }
 
 func (e *EthereumConnector) WatchLogMessagePublished(ctx context.Context, errC chan error, sink chan<- *ethAbi.AbiLogMessagePublished) (ethEvent.Subscription, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 	return e.filterer.WatchLogMessagePublished(&ethBind.WatchOpts{Context: timeout}, sink, nil)
 }
 
 func (e *EthereumConnector) TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*ethTypes.Receipt, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	return e.client.TransactionReceipt(ctx, txHash)
 }
 
 func (e *EthereumConnector) TimeOfBlockByHash(ctx context.Context, hash ethCommon.Hash) (uint64, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
 	block, err := e.client.HeaderByHash(ctx, hash)
 	if err != nil {
 		return 0, err
@@ -99,6 +117,9 @@ func (e *EthereumConnector) ParseLogMessagePublished(log ethTypes.Log) (*ethAbi.
 }
 
 func (e *EthereumConnector) SubscribeForBlocks(ctx context.Context, errC chan error, sink chan<- *NewBlock) (ethereum.Subscription, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	headSink := make(chan *ethTypes.Header, 2)
 	headerSubscription, err := e.client.SubscribeNewHead(ctx, headSink)
 	if err != nil {
@@ -132,10 +153,20 @@ func (e *EthereumConnector) SubscribeForBlocks(ctx context.Context, errC chan er
 }
 
 func (e *EthereumConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return err
+	}
 	return e.rawClient.CallContext(ctx, result, method, args...)
 
 }
 
+func (e *EthereumConnector) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]ethTypes.Log, error) {
+	if err := e.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return e.client.FilterLogs(ctx, q)
+}
+
 func (e *EthereumConnector) Client() *ethClient.Client {
 	return e.client
 }
@@ -11,7 +11,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	ethereum "github.com/ethereum/go-ethereum"
 	ethCommon "github.com/ethereum/go-ethereum/common"
-	ethClient "github.com/ethereum/go-ethereum/ethclient"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	ethEvent "github.com/ethereum/go-ethereum/event"
 
 	"github.com/certusone/wormhole/node/pkg/common"
@@ -23,14 +23,19 @@ import (
 // finalized message log events.
 type LogPollConnector struct {
 	Connector
-	client      *ethClient.Client
+	client      LogFilterer
 	messageFeed ethEvent.Feed
 	errFeed     ethEvent.Feed
 
 	prevBlockNum *big.Int
 }
 
-func NewLogPollConnector(ctx context.Context, baseConnector Connector, client *ethClient.Client) (*LogPollConnector, error) {
+// LogFilterer is the subset of the client used by the LogPollConnector to query the logs.
+type LogFilterer interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]ethTypes.Log, error)
+}
+
+func NewLogPollConnector(ctx context.Context, baseConnector Connector, client LogFilterer) (*LogPollConnector, error) {
 	connector := &LogPollConnector{Connector: baseConnector, client: client}
 	// The supervisor will keep the poller running
 	err := supervisor.Run(ctx, "logPoller", common.WrapWithScissors(connector.run, "logPoller"))
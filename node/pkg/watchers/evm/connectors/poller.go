@@ -29,6 +29,7 @@ type BlockPollConnector struct {
 	useFinalized      bool
 	publishSafeBlocks bool
 	finalizer         PollFinalizer
+	throttle          *catchUpThrottle
 	blockFeed         ethEvent.Feed
 	errFeed           ethEvent.Feed
 }
@@ -61,6 +62,8 @@ func (b *BlockPollConnector) runFromSupervisor(ctx context.Context) error {
 }
 
 func (b *BlockPollConnector) run(ctx context.Context, logger *zap.Logger) error {
+	b.throttle = newCatchUpThrottle(b.Connector.NetworkName())
+
 	lastBlock, err := b.getBlock(ctx, logger, nil, false)
 	if err != nil {
 		return err
@@ -122,25 +125,46 @@ func (b *BlockPollConnector) pollBlocks(ctx context.Context, logger *zap.Logger,
 
 	lastPublishedBlock = lastBlock
 
+	if err := b.throttle.wait(ctx); err != nil {
+		return lastPublishedBlock, err
+	}
+
 	// Fetch the latest block on the chain
 	// We could do this on every iteration such that if a new block is created while this function is being executed,
 	// it would automatically fetch new blocks but in order to reduce API load this will be done on the next iteration.
 	latestBlock, err := b.getBlockWithTimeout(ctx, logger, nil, safe)
 	if err != nil {
+		if isRateLimitError(err) {
+			// Try again on the next iteration, after the delay of the throttle.
+			b.rateLimited(logger, "latest", err)
+			return lastPublishedBlock, nil
+		}
 		logger.Error("failed to look up latest block",
 			zap.Uint64("lastSeenBlock", lastBlock.Number.Uint64()), zap.Error(err))
 		return lastPublishedBlock, fmt.Errorf("failed to look up latest block: %w", err)
 	}
 	for {
+		if !safe {
+			pollerBlocksBehind.WithLabelValues(b.Connector.NetworkName()).Set(float64(new(big.Int).Sub(latestBlock.Number, lastPublishedBlock.Number).Int64()))
+		}
+
 		if lastPublishedBlock.Number.Cmp(latestBlock.Number) >= 0 {
 			// We have to wait for a new block to become available
 			return
 		}
 
+		if err := b.throttle.wait(ctx); err != nil {
+			return lastPublishedBlock, err
+		}
+
 		// Try to fetch the next block between lastBlock and latestBlock
 		nextBlockNumber := new(big.Int).Add(lastPublishedBlock.Number, big.NewInt(1))
 		block, err := b.getBlockWithTimeout(ctx, logger, nextBlockNumber, safe)
 		if err != nil {
+			if isRateLimitError(err) {
+				b.rateLimited(logger, nextBlockNumber.String(), err)
+				continue
+			}
 			logger.Error("failed to fetch next block",
 				zap.Uint64("block", nextBlockNumber.Uint64()), zap.Error(err))
 			return lastPublishedBlock, fmt.Errorf("failed to fetch next block (%d): %w", nextBlockNumber.Uint64(), err)
@@ -148,10 +172,15 @@ func (b *BlockPollConnector) pollBlocks(ctx context.Context, logger *zap.Logger,
 
 		finalized, err := b.isBlockFinalizedWithTimeout(ctx, block)
 		if err != nil {
+			if isRateLimitError(err) {
+				b.rateLimited(logger, nextBlockNumber.String(), err)
+				continue
+			}
 			logger.Error("failed to check block finalization",
 				zap.Uint64("block", block.Number.Uint64()), zap.Error(err))
 			return lastPublishedBlock, fmt.Errorf("failed to check block finalization (%d): %w", block.Number.Uint64(), err)
 		}
+		b.throttle.succeeded()
 
 		if !finalized {
 			break
@@ -164,6 +193,13 @@ func (b *BlockPollConnector) pollBlocks(ctx context.Context, logger *zap.Logger,
 	return
 }
 
+// rateLimited slows down the poller after a request for block was rate limited by the RPC provider.
+func (b *BlockPollConnector) rateLimited(logger *zap.Logger, block string, err error) {
+	b.throttle.rateLimited()
+	logger.Warn("block poller rate limited by the RPC provider, slowing down",
+		zap.String("block", block), zap.Duration("delay", b.throttle.delay), zap.Error(err))
+}
+
 func (b *BlockPollConnector) getBlockWithTimeout(ctx context.Context, logger *zap.Logger, blockNumber *big.Int, safe bool) (*NewBlock, error) {
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	rootAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/polygonabi"
 
@@ -44,6 +45,7 @@ type PolygonConnector struct {
 	// These are used for querying the root chain contract.
 	rootRawClient *ethRpc.Client
 	rootClient    *ethClient.Client
+	rootLimiter   *ratelimit.Limiter
 
 	// These are used to subscribe for new checkpoint events from the root chain contract.
 	rootFilterer *rootAbi.AbiRootChainFilterer
@@ -83,6 +85,7 @@ func NewPolygonConnector(
 		logger:        logger,
 		rootRawClient: rootRawClient,
 		rootClient:    rootClient,
+		rootLimiter:   ratelimit.DefaultRegistry.ForURL(rootChainUrl),
 		rootFilterer:  rootFilterer,
 		rootCaller:    rootCaller,
 	}
@@ -97,6 +100,9 @@ func (c *PolygonConnector) SubscribeForBlocks(ctx context.Context, errC chan err
 
 	// Subscribe to new checkpoint events from the root chain contract.
 	messageC := make(chan *rootAbi.AbiRootChainNewHeaderBlock, 2)
+	if err := c.rootLimiter.Wait(timeout); err != nil {
+		return nil, err
+	}
 	messageSub, err := c.rootFilterer.WatchNewHeaderBlock(&ethBind.WatchOpts{Context: timeout}, messageC, nil, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new checkpoint watcher: %w", err)
@@ -104,6 +110,9 @@ func (c *PolygonConnector) SubscribeForBlocks(ctx context.Context, errC chan err
 
 	// Get and publish the current latest block.
 	opts := &ethBind.CallOpts{Context: ctx}
+	if err := c.rootLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 	initialBlock, err := c.rootCaller.GetLastChildBlock(opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get initial block: %w", err)
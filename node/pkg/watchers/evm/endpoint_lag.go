@@ -0,0 +1,271 @@
+// This file contains the code used to monitor how far the RPC endpoints of a watcher lag behind. Each endpoint is polled
+// for its latest and finalized blocks, and two gauges are exported per endpoint: how many blocks its latest block is
+// behind the best head known for the chain, and how many blocks its finalized block is behind its latest block. A
+// growing finality lag means that finality has stalled, which stops observations on the chains waiting for finalized
+// blocks, so it can be alerted on before they stop. The latest block of the endpoint of the watcher is also broadcast in
+// the heartbeats.
+
+package evm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// endpointLagQueryInterval specifies how often the endpoints are polled.
+	endpointLagQueryInterval = 15 * time.Second
+
+	// endpointLagMaxDialBackoff is the maximum delay between two attempts to connect to an endpoint.
+	endpointLagMaxDialBackoff = 5 * time.Minute
+
+	// endpointHeadExpiry specifies how long the latest block reported by an endpoint counts towards the best known head
+	// of its chain. This keeps an endpoint that reported a bogus head, or that is no longer polled, from making every
+	// other endpoint of the chain look behind forever.
+	endpointHeadExpiry = 4 * endpointLagQueryInterval
+)
+
+var (
+	endpointBlocksBehindHead = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_endpoint_blocks_behind_head",
+			Help: "Number of blocks the latest block of an endpoint is behind the best known head of its chain",
+		}, []string{"eth_network", "chain", "endpoint"})
+	endpointFinalityLag = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_endpoint_finality_lag_blocks",
+			Help: "Number of blocks between the latest and the finalized block of an endpoint",
+		}, []string{"eth_network", "chain", "endpoint"})
+	endpointLagQueries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_endpoint_lag_queries_total",
+			Help: "Total number of endpoint lag queries by result",
+		}, []string{"eth_network", "chain", "endpoint", "result"})
+)
+
+// bestHeads tracks the latest block recently seen by each endpoint of each chain, so that endpoints of the same chain
+// used by different watchers, such as the root chain endpoints of Polygon and Optimism, are compared with each other.
+type bestHeads struct {
+	mu    sync.Mutex
+	heads map[vaa.ChainID]map[string]endpointHead
+}
+
+// endpointHead is the latest block reported by an endpoint and when it was reported.
+type endpointHead struct {
+	latest  uint64
+	updated time.Time
+}
+
+var knownHeads = newBestHeads()
+
+func newBestHeads() *bestHeads {
+	return &bestHeads{heads: make(map[vaa.ChainID]map[string]endpointHead)}
+}
+
+// update records the latest block of an endpoint of the chain and returns the best head reported by the endpoints of
+// the chain within endpointHeadExpiry. The heads reported before that are forgotten.
+func (b *bestHeads) update(chainID vaa.ChainID, endpoint string, latest uint64, now time.Time) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	heads, exists := b.heads[chainID]
+	if !exists {
+		heads = make(map[string]endpointHead)
+		b.heads[chainID] = heads
+	}
+	heads[endpoint] = endpointHead{latest: latest, updated: now}
+
+	best := latest
+	for ep, h := range heads {
+		if now.Sub(h.updated) > endpointHeadExpiry {
+			delete(heads, ep)
+			continue
+		}
+		if h.latest > best {
+			best = h.latest
+		}
+	}
+	return best
+}
+
+// lagEndpoint is an RPC endpoint monitored for lag.
+type lagEndpoint struct {
+	chainID vaa.ChainID
+	url     string
+}
+
+// isWatcherEndpoint returns true if the endpoint is the one the watcher follows, whose latest block is broadcast in the
+// heartbeats.
+func (w *Watcher) isWatcherEndpoint(e lagEndpoint) bool {
+	return e.chainID == w.chainID && e.url == w.url
+}
+
+// endpointLabel returns the host of an endpoint, so that credentials in the path or query of the url are not exported
+// in metrics.
+func endpointLabel(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return "invalid"
+	}
+	return u.Host
+}
+
+// lagEndpoints returns the endpoints of the watcher, including the root chain endpoint if there is one.
+func (w *Watcher) lagEndpoints() []lagEndpoint {
+	endpoints := []lagEndpoint{{chainID: w.chainID, url: w.url}}
+	if w.rootChainRpc != "" {
+		endpoints = append(endpoints, lagEndpoint{chainID: vaa.ChainIDEthereum, url: w.rootChainRpc})
+	}
+	return endpoints
+}
+
+// lagClient is the connection to a monitored endpoint. The connection is retried with an exponential backoff until it
+// succeeds.
+type lagClient struct {
+	client   *rpc.Client
+	nextDial time.Time
+	backoff  time.Duration
+}
+
+// connectLagEndpoint returns the connection to the endpoint, dialing it if it is not connected and the backoff has expired. It
+// returns nil if the endpoint is not connected.
+func (w *Watcher) connectLagEndpoint(ctx context.Context, logger *zap.Logger, lc *lagClient, e lagEndpoint) *rpc.Client {
+	if lc.client != nil || time.Now().Before(lc.nextDial) {
+		return lc.client
+	}
+
+	c, err := rpc.DialContext(ctx, e.url)
+	if err != nil {
+		// The lag is only monitored, so this is no reason to restart the watcher.
+		if lc.backoff == 0 {
+			lc.backoff = endpointLagQueryInterval
+		} else if lc.backoff *= 2; lc.backoff > endpointLagMaxDialBackoff {
+			lc.backoff = endpointLagMaxDialBackoff
+		}
+		lc.nextDial = time.Now().Add(lc.backoff)
+		logger.Error("failed to connect to endpoint to monitor its lag",
+			zap.String("eth_network", w.networkName),
+			zap.String("endpoint", endpointLabel(e.url)),
+			zap.Duration("retryIn", lc.backoff),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	lc.client = c
+	lc.backoff = 0
+	return c
+}
+
+// monitorEndpointLag polls the endpoints of the watcher for their latest and finalized blocks until ctx is canceled.
+func (w *Watcher) monitorEndpointLag(ctx context.Context, logger *zap.Logger) error {
+	endpoints := w.lagEndpoints()
+	clients := make([]lagClient, len(endpoints))
+	defer func() {
+		// The latest height is only known while the endpoint of the watcher is polled.
+		p2p.DefaultRegistry.ClearLatestHeight(w.chainID)
+		for _, lc := range clients {
+			if lc.client != nil {
+				lc.client.Close()
+			}
+		}
+	}()
+
+	t := time.NewTicker(endpointLagQueryInterval)
+	defer t.Stop()
+	for {
+		for i, e := range endpoints {
+			if c := w.connectLagEndpoint(ctx, logger, &clients[i], e); c != nil {
+				w.updateEndpointLag(ctx, logger, c, e)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// updateEndpointLag queries an endpoint and updates its lag metrics.
+func (w *Watcher) updateEndpointLag(ctx context.Context, logger *zap.Logger, c *rpc.Client, e lagEndpoint) {
+	labels := []string{w.networkName, e.chainID.String(), endpointLabel(e.url)}
+
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	if err := ratelimit.DefaultRegistry.ForURL(e.url).Wait(timeout); err != nil {
+		return
+	}
+	latest, finalized, finalizedSupported, err := queryEndpointHeights(timeout, c)
+	if err != nil {
+		if w.isWatcherEndpoint(e) {
+			p2p.DefaultRegistry.ClearLatestHeight(w.chainID)
+		}
+		endpointLagQueries.WithLabelValues(append(labels, "failed")...).Inc()
+		logger.Warn("failed to query endpoint heights",
+			zap.String("eth_network", w.networkName),
+			zap.String("endpoint", endpointLabel(e.url)),
+			zap.Error(err),
+		)
+		return
+	}
+	endpointLagQueries.WithLabelValues(append(labels, "success")...).Inc()
+
+	if w.isWatcherEndpoint(e) {
+		p2p.DefaultRegistry.SetNetworkHeights(w.chainID, p2p.NetworkHeights{Latest: int64(latest)})
+	}
+
+	head := knownHeads.update(e.chainID, e.url, latest, time.Now())
+	endpointBlocksBehindHead.WithLabelValues(labels...).Set(float64(head - latest))
+
+	if finalizedSupported {
+		lag := uint64(0)
+		if latest > finalized {
+			lag = latest - finalized
+		}
+		endpointFinalityLag.WithLabelValues(labels...).Set(float64(lag))
+	}
+}
+
+// queryEndpointHeights returns the latest and finalized block numbers of an endpoint in a single batch request. The
+// finalized block is reported as not supported if the endpoint does not know the finalized tag.
+func queryEndpointHeights(ctx context.Context, c *rpc.Client) (latest uint64, finalized uint64, finalizedSupported bool, err error) {
+	type block struct {
+		Number eth_hexutil.Uint64 `json:"number"`
+	}
+
+	var latestBlock, finalizedBlock *block
+	batch := []rpc.BatchElem{
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"latest", false}, Result: &latestBlock},
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"finalized", false}, Result: &finalizedBlock},
+	}
+	if err := c.BatchCallContext(ctx, batch); err != nil {
+		return 0, 0, false, err
+	}
+
+	if batch[0].Error != nil {
+		return 0, 0, false, fmt.Errorf("failed to query latest block: %w", batch[0].Error)
+	}
+	if latestBlock == nil {
+		return 0, 0, false, fmt.Errorf("latest block is missing")
+	}
+
+	if batch[1].Error != nil || finalizedBlock == nil {
+		return uint64(latestBlock.Number), 0, false, nil
+	}
+	return uint64(latestBlock.Number), uint64(finalizedBlock.Number), true, nil
+}
@@ -0,0 +1,127 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// heightsService implements eth_getBlockByNumber for the latest and finalized tags.
+type heightsService struct {
+	latest    uint64
+	finalized uint64 // Zero if the finalized tag is not supported.
+}
+
+func (s *heightsService) GetBlockByNumber(tag string, full bool) (map[string]interface{}, error) {
+	switch tag {
+	case "latest":
+		return map[string]interface{}{"number": eth_hexutil.Uint64(s.latest)}, nil
+	case "finalized":
+		if s.finalized == 0 {
+			return nil, errors.New("'finalized' is not a valid block tag")
+		}
+		return map[string]interface{}{"number": eth_hexutil.Uint64(s.finalized)}, nil
+	}
+	return nil, errors.New("unexpected tag")
+}
+
+func newHeightsServer(t *testing.T, svc *heightsService) *httptest.Server {
+	t.Helper()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", svc))
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+	t.Cleanup(server.Stop)
+	return srv
+}
+
+func TestQueryEndpointHeights(t *testing.T) {
+	ctx := context.Background()
+
+	srv := newHeightsServer(t, &heightsService{latest: 1000, finalized: 936})
+	c, err := rpc.DialContext(ctx, srv.URL)
+	require.NoError(t, err)
+	defer c.Close()
+
+	latest, finalized, finalizedSupported, err := queryEndpointHeights(ctx, c)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), latest)
+	assert.Equal(t, uint64(936), finalized)
+	assert.True(t, finalizedSupported)
+
+	srv = newHeightsServer(t, &heightsService{latest: 1000})
+	c2, err := rpc.DialContext(ctx, srv.URL)
+	require.NoError(t, err)
+	defer c2.Close()
+
+	latest, _, finalizedSupported, err = queryEndpointHeights(ctx, c2)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), latest)
+	assert.False(t, finalizedSupported)
+}
+
+func TestUpdateEndpointLag(t *testing.T) {
+	ctx := context.Background()
+	knownHeads = newBestHeads()
+
+	ahead := newHeightsServer(t, &heightsService{latest: 1000, finalized: 936})
+	behind := newHeightsServer(t, &heightsService{latest: 990, finalized: 900})
+
+	w := NewEthWatcher(ahead.URL, eth_common.Address{}, "eth", vaa.ChainIDEthereum, nil, nil, nil, false)
+	update := func(url string) {
+		c, err := rpc.DialContext(ctx, url)
+		require.NoError(t, err)
+		defer c.Close()
+		w.updateEndpointLag(ctx, zap.NewNop(), c, lagEndpoint{chainID: vaa.ChainIDEthereum, url: url})
+	}
+
+	update(ahead.URL)
+	update(behind.URL)
+
+	aheadLabels := []string{"eth", "ethereum", endpointLabel(ahead.URL)}
+	behindLabels := []string{"eth", "ethereum", endpointLabel(behind.URL)}
+	assert.Equal(t, float64(0), testutil.ToFloat64(endpointBlocksBehindHead.WithLabelValues(aheadLabels...)))
+	assert.Equal(t, float64(10), testutil.ToFloat64(endpointBlocksBehindHead.WithLabelValues(behindLabels...)))
+	assert.Equal(t, float64(64), testutil.ToFloat64(endpointFinalityLag.WithLabelValues(aheadLabels...)))
+	assert.Equal(t, float64(90), testutil.ToFloat64(endpointFinalityLag.WithLabelValues(behindLabels...)))
+}
+
+func TestBestHeadsExpire(t *testing.T) {
+	heads := newBestHeads()
+	start := time.Now()
+
+	assert.Equal(t, uint64(1000), heads.update(vaa.ChainIDEthereum, "bogus", 1000, start))
+	assert.Equal(t, uint64(1000), heads.update(vaa.ChainIDEthereum, "honest", 900, start.Add(endpointLagQueryInterval)))
+	assert.Equal(t, uint64(100), heads.update(vaa.ChainIDPolygon, "polygon", 100, start.Add(endpointLagQueryInterval)))
+
+	// The head of the bogus endpoint is forgotten once it stops reporting it.
+	assert.Equal(t, uint64(910), heads.update(vaa.ChainIDEthereum, "honest", 910, start.Add(endpointHeadExpiry+time.Second)))
+}
+
+func TestEndpointLabel(t *testing.T) {
+	assert.Equal(t, "eth-mainnet.example.com", endpointLabel("https://eth-mainnet.example.com/v2/secretApiKey"))
+	assert.Equal(t, "localhost:8545", endpointLabel("ws://user:password@localhost:8545"))
+	assert.Equal(t, "invalid", endpointLabel("not a url"))
+}
+
+func TestLagEndpoints(t *testing.T) {
+	w := NewEthWatcher("ws://polygon:8546", eth_common.Address{}, "polygon", vaa.ChainIDPolygon, nil, nil, nil, false)
+	assert.Equal(t, []lagEndpoint{{chainID: vaa.ChainIDPolygon, url: "ws://polygon:8546"}}, w.lagEndpoints())
+
+	require.NoError(t, w.SetRootChainParams("ws://eth:8546", "0x86E4Dc95c7FBdBf52e33D563BbDB00823894C287"))
+	assert.Equal(t, []lagEndpoint{
+		{chainID: vaa.ChainIDPolygon, url: "ws://polygon:8546"},
+		{chainID: vaa.ChainIDEthereum, url: "ws://eth:8546"},
+	}, w.lagEndpoints())
+}
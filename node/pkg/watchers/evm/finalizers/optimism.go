@@ -14,6 +14,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	ctcAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/finalizers/optimismctcabi"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
@@ -41,6 +42,7 @@ type OptimismFinalizer struct {
 	// These are used for querying the ctc contract.
 	ctcRawClient *ethRpc.Client
 	ctcClient    *ethClient.Client
+	ctcLimiter   *ratelimit.Limiter
 
 	// This is used to grab the rollup information from the ctc contract
 	ctcCaller ctcCallerIntf
@@ -80,6 +82,7 @@ func NewOptimismFinalizer(
 		finalizerMapping:       make([]RollupInfo, 0),
 		ctcRawClient:           ctcRawClient,
 		ctcClient:              ctcClient,
+		ctcLimiter:             ratelimit.DefaultRegistry.ForURL(ctcChainUrl),
 		ctcCaller:              ctcCaller,
 	}
 
@@ -99,10 +102,16 @@ func (f *OptimismFinalizer) GetRollupInfo(ctx context.Context) (RollupInfo, erro
 	// Get the current latest blocks.
 	opts := &ethBind.CallOpts{Context: ctx}
 	var entry RollupInfo
+	if err := f.ctcLimiter.Wait(ctx); err != nil {
+		return entry, err
+	}
 	l2Block, err := f.ctcCaller.GetTotalElements(opts)
 	if err != nil {
 		return entry, fmt.Errorf("failed to get L2 block: %w", err)
 	}
+	if err := f.ctcLimiter.Wait(ctx); err != nil {
+		return entry, err
+	}
 	l1Block, err := f.ctcCaller.GetLastBlockNumber(opts)
 	if err != nil {
 		return entry, fmt.Errorf("failed to get L1 block: %w", err)
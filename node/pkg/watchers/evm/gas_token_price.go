@@ -0,0 +1,215 @@
+// This file contains the code used to sample the price of the native gas token of a chain from an on-chain price oracle.
+// The oracle must implement the Chainlink AggregatorV3Interface (decimals() and latestRoundData()). The prices are passed to
+// the governor, which uses them as a secondary source when CoinGecko prices are not available.
+
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// gasTokenPriceQueryInterval specifies how often we query the oracle.
+	gasTokenPriceQueryInterval = 5 * time.Minute
+
+	// Function selectors from the Chainlink AggregatorV3Interface.
+	oracleDecimalsSelector        = "0x313ce567" // decimals()
+	oracleLatestRoundDataSelector = "0xfeaf968c" // latestRoundData()
+)
+
+var (
+	gasTokenPriceQueries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_gas_token_price_queries_total",
+			Help: "Total number of gas token price oracle queries by result",
+		}, []string{"eth_network", "result"})
+	gasTokenPrice = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_gas_token_price",
+			Help: "Latest USD price of the gas token read from the on-chain oracle",
+		}, []string{"eth_network"})
+)
+
+// GasTokenPriceOracle defines the on-chain oracle used to sample the price of the gas token of a chain.
+type GasTokenPriceOracle struct {
+	// OracleAddress is the address of the Chainlink compatible price feed for the gas token in USD.
+	OracleAddress eth_common.Address
+
+	// TokenAddress is the address of the wrapped gas token, which is how the governor identifies the token.
+	TokenAddress vaa.Address
+}
+
+// ParseGasTokenPriceOracles parses the gas token price oracle configuration. It is a comma separated list of entries of the
+// form chainID:oracleAddress:wrappedTokenAddress, where the addresses are hex EVM addresses. An empty string means no oracles.
+func ParseGasTokenPriceOracles(config string) (map[vaa.ChainID]*GasTokenPriceOracle, error) {
+	ret := make(map[vaa.ChainID]*GasTokenPriceOracle)
+	if config == "" {
+		return ret, nil
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		fields := strings.Split(strings.TrimSpace(entry), ":")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf(`invalid gas token price oracle entry "%s", should be chainID:oracleAddress:wrappedTokenAddress`, entry)
+		}
+
+		chainID, err := strconv.ParseUint(fields[0], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid chain ID in gas token price oracle entry "%s": %w`, entry, err)
+		}
+
+		if _, exists := ret[vaa.ChainID(chainID)]; exists {
+			return nil, fmt.Errorf("duplicate gas token price oracle for chain %d", chainID)
+		}
+
+		if !eth_common.IsHexAddress(fields[1]) {
+			return nil, fmt.Errorf(`invalid oracle address in gas token price oracle entry "%s"`, entry)
+		}
+
+		if !eth_common.IsHexAddress(fields[2]) {
+			return nil, fmt.Errorf(`invalid token address in gas token price oracle entry "%s"`, entry)
+		}
+
+		var tokenAddress vaa.Address
+		copy(tokenAddress[12:], eth_common.HexToAddress(fields[2]).Bytes())
+
+		ret[vaa.ChainID(chainID)] = &GasTokenPriceOracle{
+			OracleAddress: eth_common.HexToAddress(fields[1]),
+			TokenAddress:  tokenAddress,
+		}
+	}
+
+	return ret, nil
+}
+
+// SetGasTokenPriceOracle enables sampling the price of the gas token from an on-chain oracle. The prices are published on priceC.
+// If oracle is nil, the feature is disabled.
+func (w *Watcher) SetGasTokenPriceOracle(oracle *GasTokenPriceOracle, priceC chan<- *common.GasTokenPrice) {
+	w.gasTokenPriceOracle = oracle
+	w.gasTokenPriceC = priceC
+}
+
+// handleGasTokenPrice periodically queries the gas token price oracle and publishes the price. Errors are logged but do not
+// bring down the watcher, since the governor falls back to its other price sources.
+func (w *Watcher) handleGasTokenPrice(ctx context.Context, logger *zap.Logger) error {
+	t := time.NewTicker(gasTokenPriceQueryInterval)
+	defer t.Stop()
+
+	for {
+		w.publishGasTokenPrice(ctx, logger)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// publishGasTokenPrice queries the oracle once and publishes the result.
+func (w *Watcher) publishGasTokenPrice(ctx context.Context, logger *zap.Logger) {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	price, updatedAt, err := queryGasTokenPrice(timeout, w.ethConn, w.gasTokenPriceOracle.OracleAddress)
+	if err != nil {
+		logger.Error("failed to query gas token price oracle",
+			zap.String("eth_network", w.networkName),
+			zap.Stringer("oracle", w.gasTokenPriceOracle.OracleAddress),
+			zap.Error(err),
+		)
+		gasTokenPriceQueries.WithLabelValues(w.networkName, "error").Inc()
+		return
+	}
+
+	priceFloat, _ := price.Float64()
+	logger.Debug("queried gas token price",
+		zap.String("eth_network", w.networkName),
+		zap.Float64("price", priceFloat),
+		zap.Time("updatedAt", updatedAt),
+	)
+	gasTokenPriceQueries.WithLabelValues(w.networkName, "success").Inc()
+	gasTokenPrice.WithLabelValues(w.networkName).Set(priceFloat)
+
+	select {
+	case w.gasTokenPriceC <- &common.GasTokenPrice{
+		ChainID:      w.chainID,
+		TokenAddress: w.gasTokenPriceOracle.TokenAddress,
+		Price:        priceFloat,
+		UpdatedAt:    updatedAt,
+	}:
+	default:
+		logger.Warn("gas token price channel is full, dropping price", zap.String("eth_network", w.networkName))
+	}
+}
+
+// queryGasTokenPrice reads the latest price from a Chainlink compatible oracle. It returns the price scaled by the decimals
+// of the feed, along with the time the oracle last updated it.
+func queryGasTokenPrice(ctx context.Context, ethConn connectors.Connector, oracle eth_common.Address) (*big.Float, time.Time, error) {
+	decimalsResult, err := callOracle(ctx, ethConn, oracle, oracleDecimalsSelector)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to query decimals: %w", err)
+	}
+	if len(decimalsResult) != 32 {
+		return nil, time.Time{}, fmt.Errorf("unexpected decimals result length: %d", len(decimalsResult))
+	}
+	decimals := new(big.Int).SetBytes(decimalsResult)
+	if !decimals.IsUint64() || decimals.Uint64() > 36 {
+		return nil, time.Time{}, fmt.Errorf("invalid decimals: %s", decimals)
+	}
+
+	roundData, err := callOracle(ctx, ethConn, oracle, oracleLatestRoundDataSelector)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to query latest round data: %w", err)
+	}
+
+	// latestRoundData returns (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound).
+	if len(roundData) != 5*32 {
+		return nil, time.Time{}, fmt.Errorf("unexpected latest round data result length: %d", len(roundData))
+	}
+
+	answer := new(big.Int).SetBytes(roundData[32:64])
+	if roundData[32]&0x80 != 0 || answer.Sign() == 0 {
+		return nil, time.Time{}, fmt.Errorf("oracle returned a non-positive price")
+	}
+
+	updatedAt := new(big.Int).SetBytes(roundData[96:128])
+	if !updatedAt.IsInt64() || updatedAt.Sign() == 0 {
+		return nil, time.Time{}, fmt.Errorf("oracle returned an invalid update time: %s", updatedAt)
+	}
+
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), decimals, nil))
+	price := new(big.Float).Quo(new(big.Float).SetInt(answer), scale)
+
+	return price, time.Unix(updatedAt.Int64(), 0), nil
+}
+
+// oracleCallMsg is the call object passed to eth_call.
+type oracleCallMsg struct {
+	To   eth_common.Address `json:"to"`
+	Data string             `json:"data"`
+}
+
+// callOracle does an eth_call of the specified function (which takes no arguments) on the oracle and returns the result.
+func callOracle(ctx context.Context, ethConn connectors.Connector, oracle eth_common.Address, selector string) ([]byte, error) {
+	var result eth_hexutil.Bytes
+	if err := ethConn.RawCallContext(ctx, &result, "eth_call", oracleCallMsg{To: oracle, Data: selector}, "latest"); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
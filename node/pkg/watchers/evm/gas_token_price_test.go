@@ -0,0 +1,127 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// mockOracleConnector implements eth_call for a Chainlink compatible oracle. Only RawCallContext is implemented.
+type mockOracleConnector struct {
+	connectors.Connector
+	decimals  []byte
+	roundData []byte
+	err       error
+}
+
+func (c *mockOracleConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if c.err != nil {
+		return c.err
+	}
+	if method != "eth_call" {
+		panic("method not implemented by mockOracleConnector")
+	}
+
+	switch data := args[0].(oracleCallMsg).Data; data {
+	case oracleDecimalsSelector:
+		*result.(*eth_hexutil.Bytes) = c.decimals
+	case oracleLatestRoundDataSelector:
+		*result.(*eth_hexutil.Bytes) = c.roundData
+	default:
+		panic("unexpected call data: " + data)
+	}
+	return nil
+}
+
+func word(v *big.Int) []byte {
+	return eth_common.LeftPadBytes(v.Bytes(), 32)
+}
+
+func roundData(answer *big.Int, updatedAt int64) []byte {
+	ret := word(big.NewInt(1))
+	if answer.Sign() < 0 {
+		// Two's complement, as returned by the contract for an int256.
+		ret = append(ret, word(new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 256), answer))...)
+	} else {
+		ret = append(ret, word(answer)...)
+	}
+	ret = append(ret, word(big.NewInt(updatedAt))...)
+	ret = append(ret, word(big.NewInt(updatedAt))...)
+	ret = append(ret, word(big.NewInt(1))...)
+	return ret
+}
+
+func TestQueryGasTokenPrice(t *testing.T) {
+	oracle := eth_common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+	conn := &mockOracleConnector{
+		decimals:  word(big.NewInt(8)),
+		roundData: roundData(big.NewInt(185012345678), 1680099814),
+	}
+
+	price, updatedAt, err := queryGasTokenPrice(context.Background(), conn, oracle)
+	require.NoError(t, err)
+	priceFloat, _ := price.Float64()
+	assert.InDelta(t, 1850.12345678, priceFloat, 1e-9)
+	assert.Equal(t, time.Unix(1680099814, 0), updatedAt)
+}
+
+func TestQueryGasTokenPriceErrors(t *testing.T) {
+	oracle := eth_common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+
+	tests := []struct {
+		name string
+		conn *mockOracleConnector
+		err  string
+	}{
+		{"rpc error", &mockOracleConnector{err: errors.New("rpc failed")}, "rpc failed"},
+		{"bad decimals", &mockOracleConnector{decimals: []byte{8}}, "unexpected decimals result length"},
+		{"too many decimals", &mockOracleConnector{decimals: word(big.NewInt(100))}, "invalid decimals"},
+		{"bad round data", &mockOracleConnector{decimals: word(big.NewInt(8)), roundData: word(big.NewInt(1))}, "unexpected latest round data result length"},
+		{"zero price", &mockOracleConnector{decimals: word(big.NewInt(8)), roundData: roundData(big.NewInt(0), 1680099814)}, "non-positive price"},
+		{"negative price", &mockOracleConnector{decimals: word(big.NewInt(8)), roundData: roundData(big.NewInt(-5), 1680099814)}, "non-positive price"},
+		{"no update time", &mockOracleConnector{decimals: word(big.NewInt(8)), roundData: roundData(big.NewInt(5), 0)}, "invalid update time"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := queryGasTokenPrice(context.Background(), tc.conn, oracle)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestParseGasTokenPriceOracles(t *testing.T) {
+	oracles, err := ParseGasTokenPriceOracles("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(oracles))
+
+	oracles, err = ParseGasTokenPriceOracles("2:0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419:0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2, 4:0x0567F2323251f0Aab15c8dFb1967E4e8A7D42aeE:0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(oracles))
+
+	wethAddr, err := vaa.StringToAddress("000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+	require.NoError(t, err)
+	assert.Equal(t, eth_common.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"), oracles[vaa.ChainIDEthereum].OracleAddress)
+	assert.Equal(t, wethAddr, oracles[vaa.ChainIDEthereum].TokenAddress)
+	assert.Equal(t, eth_common.HexToAddress("0x0567F2323251f0Aab15c8dFb1967E4e8A7D42aeE"), oracles[vaa.ChainIDBSC].OracleAddress)
+
+	for _, config := range []string{
+		"2:0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419",
+		"eth:0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419:0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+		"2:junk:0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+		"2:0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419:junk",
+		"2:0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419:0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2,2:0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419:0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+	} {
+		_, err := ParseGasTokenPriceOracles(config)
+		assert.Error(t, err, config)
+	}
+}
@@ -0,0 +1,212 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	ethReceiptProofChecks = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_receipt_proof_checks_total",
+			Help: "Total number of message publications checked against the receipts root of their block, by result",
+		}, []string{"eth_network", "result"})
+)
+
+// errReceiptProofMismatch is returned when a message publication does not match the receipts committed to by the
+// receiptsRoot of its block, which means that the RPC node returned falsified data.
+var errReceiptProofMismatch = errors.New("message publication does not match the receipts root of its block")
+
+// ParseVerifyReceiptsChains parses the comma separated list of the chains, by name or ID, whose messages are checked
+// against the receipts root of their block.
+func ParseVerifyReceiptsChains(config string) (map[vaa.ChainID]bool, error) {
+	return parseChainList(config)
+}
+
+// SetVerifyReceipts enables checking every message publication against the receipts root of its block before it is
+// sent to the processor. All the receipts of the block are requested and the receipt trie is recomputed, so that a
+// message can only be signed if it is committed to by the block header, rather than on the word of the log
+// subscription or eth_getLogs. This is only possible on chains whose headers and receipts use the Ethereum encoding.
+func (w *Watcher) SetVerifyReceipts(verifyReceipts bool) {
+	w.verifyReceipts = verifyReceipts
+}
+
+// verifyReobservedMessages checks the messages of a re-observed transaction against the receipts root of its block.
+func (w *Watcher) verifyReobservedMessages(ctx context.Context, txHash eth_common.Hash, msgs []*common.MessagePublication) error {
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	receipt, err := w.ethConn.TransactionReceipt(timeout, txHash)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	v := newReceiptProofVerifier(w)
+	for _, msg := range msgs {
+		if err := v.verify(ctx, receipt.BlockHash, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// receiptProofTimeout is how long each of the requests for a block and its receipts may take.
+const receiptProofTimeout = 15 * time.Second
+
+// receiptProofBlock holds the fields of an eth_getBlockByHash response needed to check the receipts. The receipts root
+// is only taken from the header once its hash was checked, so the header must use the Ethereum encoding.
+type receiptProofBlock struct {
+	Header       *types.Header     `json:"-"`
+	Transactions []eth_common.Hash `json:"transactions"`
+}
+
+type blockReceiptsResult struct {
+	block    *receiptProofBlock
+	receipts types.Receipts
+	err      error
+}
+
+// receiptProofVerifier checks message publications against the receipts root of their block. The receipts of each
+// block are requested and verified once, since a block can contain many messages.
+type receiptProofVerifier struct {
+	w      *Watcher
+	blocks map[eth_common.Hash]blockReceiptsResult
+}
+
+func newReceiptProofVerifier(w *Watcher) *receiptProofVerifier {
+	return &receiptProofVerifier{w: w, blocks: make(map[eth_common.Hash]blockReceiptsResult)}
+}
+
+// verify returns nil if msg, published in the block blockHash, is in the receipts committed to by the receiptsRoot of
+// the block. The error wraps errReceiptProofMismatch if the RPC node returned inconsistent data, other errors are
+// likely transient.
+func (v *receiptProofVerifier) verify(ctx context.Context, blockHash eth_common.Hash, msg *common.MessagePublication) error {
+	err := v.verifyMessage(ctx, blockHash, msg)
+	switch {
+	case err == nil:
+		ethReceiptProofChecks.WithLabelValues(v.w.networkName, "valid").Inc()
+	case errors.Is(err, errReceiptProofMismatch):
+		ethReceiptProofChecks.WithLabelValues(v.w.networkName, "mismatch").Inc()
+	default:
+		ethReceiptProofChecks.WithLabelValues(v.w.networkName, "error").Inc()
+	}
+	return err
+}
+
+func (v *receiptProofVerifier) verifyMessage(ctx context.Context, blockHash eth_common.Hash, msg *common.MessagePublication) error {
+	r, exists := v.blocks[blockHash]
+	if !exists {
+		r.block, r.receipts, r.err = v.fetchBlockReceipts(ctx, blockHash)
+		v.blocks[blockHash] = r
+	}
+	if r.err != nil {
+		return r.err
+	}
+
+	for i, txHash := range r.block.Transactions {
+		if txHash != msg.TxHash {
+			continue
+		}
+
+		msgs, err := messagesFromReceipt(v.w.ethConn, v.w.contract, v.w.additionalEmitters, v.w.chainID, r.receipts[i], 0)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errReceiptProofMismatch, err)
+		}
+		for _, m := range msgs {
+			if sameMessagePublication(m, msg) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: the receipt of transaction %s does not contain the message", errReceiptProofMismatch, msg.TxHash)
+	}
+
+	return fmt.Errorf("%w: transaction %s is not in block %s", errReceiptProofMismatch, msg.TxHash, blockHash)
+}
+
+// fetchBlockReceipts requests the receipts of a block and checks them against its receiptsRoot. The header is hashed
+// to check that the receiptsRoot is the one of the requested block, rather than trusting the hash returned by the node.
+func (v *receiptProofVerifier) fetchBlockReceipts(ctx context.Context, blockHash eth_common.Hash) (*receiptProofBlock, types.Receipts, error) {
+	block, err := v.fetchBlock(ctx, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// eth_getBlockReceipts is not supported by every node, fall back to requesting the receipts one by one.
+	var receipts types.Receipts
+	timeout, cancel := context.WithTimeout(ctx, receiptProofTimeout)
+	err = v.w.ethConn.RawCallContext(timeout, &receipts, "eth_getBlockReceipts", blockHash)
+	cancel()
+	if err != nil || len(receipts) != len(block.Transactions) {
+		receipts = make(types.Receipts, len(block.Transactions))
+		for i, txHash := range block.Transactions {
+			timeout, cancel := context.WithTimeout(ctx, receiptProofTimeout)
+			receipts[i], err = v.w.ethConn.TransactionReceipt(timeout, txHash)
+			cancel()
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get receipt of transaction %s: %w", txHash, err)
+			}
+		}
+	}
+
+	for i, receipt := range receipts {
+		if receipt == nil || receipt.TxHash != block.Transactions[i] {
+			return nil, nil, fmt.Errorf("%w: receipt %d is not the one of transaction %s", errReceiptProofMismatch, i, block.Transactions[i])
+		}
+	}
+
+	if root := types.DeriveSha(receipts, trie.NewStackTrie(nil)); root != block.Header.ReceiptHash {
+		return nil, nil, fmt.Errorf("%w: the receipts of block %s have root %s instead of %s", errReceiptProofMismatch, blockHash, root, block.Header.ReceiptHash)
+	}
+
+	return block, receipts, nil
+}
+
+// fetchBlock requests a block and checks that its header hashes to blockHash.
+func (v *receiptProofVerifier) fetchBlock(ctx context.Context, blockHash eth_common.Hash) (*receiptProofBlock, error) {
+	timeout, cancel := context.WithTimeout(ctx, receiptProofTimeout)
+	defer cancel()
+
+	var raw json.RawMessage
+	if err := v.w.ethConn.RawCallContext(timeout, &raw, "eth_getBlockByHash", blockHash, false); err != nil {
+		return nil, fmt.Errorf("failed to get block: %w", err)
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, fmt.Errorf("block %s not found", blockHash)
+	}
+
+	var block receiptProofBlock
+	if err := json.Unmarshal(raw, &block); err != nil {
+		return nil, fmt.Errorf("failed to decode block %s: %w", blockHash, err)
+	}
+	if err := json.Unmarshal(raw, &block.Header); err != nil {
+		return nil, fmt.Errorf("failed to decode header of block %s: %w", blockHash, err)
+	}
+	if hash := block.Header.Hash(); hash != blockHash {
+		return nil, fmt.Errorf("%w: requested block %s, got a header hashing to %s", errReceiptProofMismatch, blockHash, hash)
+	}
+
+	return &block, nil
+}
+
+// sameMessagePublication returns true if both messages have the same content. The timestamp is not compared since it
+// is not part of the receipt.
+func sameMessagePublication(a *common.MessagePublication, b *common.MessagePublication) bool {
+	return a.TxHash == b.TxHash &&
+		a.Nonce == b.Nonce &&
+		a.Sequence == b.Sequence &&
+		a.EmitterChain == b.EmitterChain &&
+		a.EmitterAddress == b.EmitterAddress &&
+		a.ConsistencyLevel == b.ConsistencyLevel &&
+		bytes.Equal(a.Payload, b.Payload)
+}
@@ -0,0 +1,217 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// mockReceiptsConnector serves a block and its receipts.
+type mockReceiptsConnector struct {
+	connectors.Connector
+	block               *receiptProofBlock
+	blockHash           eth_common.Hash // The hash of the header of block.
+	receipts            types.Receipts
+	noBlockReceipts     bool
+	blockErr            error
+	blockCalls          int
+	transactionReceipts int
+}
+
+func (c *mockReceiptsConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	switch method {
+	case "eth_getBlockByHash":
+		c.blockCalls++
+		if c.blockErr != nil {
+			return c.blockErr
+		}
+		if c.block == nil {
+			*result.(*json.RawMessage) = json.RawMessage("null")
+			return nil
+		}
+		b, err := json.Marshal(c.block.Header)
+		if err != nil {
+			return err
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return err
+		}
+		fields["transactions"] = c.block.Transactions
+		*result.(*json.RawMessage), err = json.Marshal(fields)
+		return err
+	case "eth_getBlockReceipts":
+		if c.noBlockReceipts {
+			return errors.New("the method eth_getBlockReceipts does not exist/is not available")
+		}
+		*result.(*types.Receipts) = c.receipts
+	default:
+		panic("method not implemented by mockReceiptsConnector")
+	}
+	return nil
+}
+
+func (c *mockReceiptsConnector) TransactionReceipt(ctx context.Context, txHash eth_common.Hash) (*types.Receipt, error) {
+	c.transactionReceipts++
+	for _, r := range c.receipts {
+		if r.TxHash == txHash {
+			return r, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+// setupReceiptProofTest returns a watcher connected to a block with two transactions, each publishing a message with
+// an additional emitter, and the message of the second transaction.
+func setupReceiptProofTest(t *testing.T) (*Watcher, *mockReceiptsConnector, *common.MessagePublication) {
+	e, err := NewAdditionalEmitter(shutdownEmitterAddress, shutdownEmitterABI, "ShutdownMessagePublished")
+	require.NoError(t, err)
+	sender := eth_common.HexToAddress("0x0000000000000000000000000000000000001234")
+
+	receipts := types.Receipts{}
+	for i := 0; i < 2; i++ {
+		l := shutdownEmitterLog(t, e, sender, uint64(i))
+		l.TxHash = eth_common.Hash{31: byte(i + 1)}
+		receipts = append(receipts, &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21000 * (i + 1)),
+			Logs:              []*types.Log{&l},
+			TxHash:            l.TxHash,
+		})
+	}
+
+	header := &types.Header{
+		Number:      big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		ReceiptHash: types.DeriveSha(receipts, trie.NewStackTrie(nil)),
+	}
+	conn := &mockReceiptsConnector{
+		block: &receiptProofBlock{
+			Header:       header,
+			Transactions: []eth_common.Hash{receipts[0].TxHash, receipts[1].TxHash},
+		},
+		blockHash: header.Hash(),
+		receipts:  receipts,
+	}
+
+	w := NewEthWatcher("", eth_common.Address{}, "eth", vaa.ChainIDEthereum, nil, nil, nil, false)
+	w.SetAdditionalEmitters([]*AdditionalEmitter{e})
+	w.ethConn = conn
+
+	msgs, err := messagesFromReceipt(conn, w.contract, w.additionalEmitters, w.chainID, receipts[1], 0)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+
+	return w, conn, msgs[0]
+}
+
+func TestReceiptProofVerifier(t *testing.T) {
+	w, conn, msg := setupReceiptProofTest(t)
+	v := newReceiptProofVerifier(w)
+
+	require.NoError(t, v.verify(context.Background(), conn.blockHash, msg))
+
+	// The block is only requested once.
+	other := *msg
+	other.Payload = []byte("falsified")
+	assert.ErrorIs(t, v.verify(context.Background(), conn.blockHash, &other), errReceiptProofMismatch)
+	assert.Equal(t, 1, conn.blockCalls)
+
+	other = *msg
+	other.TxHash = eth_common.Hash{31: 9}
+	assert.ErrorIs(t, v.verify(context.Background(), conn.blockHash, &other), errReceiptProofMismatch)
+
+	other = *msg
+	other.Sequence++
+	assert.ErrorIs(t, v.verify(context.Background(), conn.blockHash, &other), errReceiptProofMismatch)
+
+	// A block other than the requested one.
+	assert.ErrorIs(t, v.verify(context.Background(), eth_common.Hash{2}, msg), errReceiptProofMismatch)
+}
+
+func TestReceiptProofVerifierFalsifiedReceipts(t *testing.T) {
+	w, conn, msg := setupReceiptProofTest(t)
+
+	// The node returns a receipt with a log which is not committed to by the header.
+	falsified := *conn.receipts[1]
+	l := *falsified.Logs[0]
+	l.Data = append([]byte{}, l.Data...)
+	l.Data[len(l.Data)-1] ^= 0xff
+	falsified.Logs = []*types.Log{&l}
+	conn.receipts = types.Receipts{conn.receipts[0], &falsified}
+
+	err := newReceiptProofVerifier(w).verify(context.Background(), conn.blockHash, msg)
+	assert.ErrorIs(t, err, errReceiptProofMismatch)
+	assert.ErrorContains(t, err, "have root")
+
+	// Receipts in the wrong order.
+	conn.receipts = types.Receipts{conn.receipts[1], conn.receipts[0]}
+	err = newReceiptProofVerifier(w).verify(context.Background(), conn.blockHash, msg)
+	assert.ErrorIs(t, err, errReceiptProofMismatch)
+}
+
+func TestReceiptProofVerifierFalsifiedHeader(t *testing.T) {
+	w, conn, msg := setupReceiptProofTest(t)
+
+	// The node returns falsified receipts along with a header committing to them, which is not the requested block.
+	falsified := *conn.receipts[1]
+	l := *falsified.Logs[0]
+	l.Data = append([]byte{}, l.Data...)
+	l.Data[len(l.Data)-1] ^= 0xff
+	falsified.Logs = []*types.Log{&l}
+	conn.receipts = types.Receipts{conn.receipts[0], &falsified}
+	header := *conn.block.Header
+	header.ReceiptHash = types.DeriveSha(conn.receipts, trie.NewStackTrie(nil))
+	conn.block.Header = &header
+
+	err := newReceiptProofVerifier(w).verify(context.Background(), conn.blockHash, msg)
+	assert.ErrorIs(t, err, errReceiptProofMismatch)
+	assert.ErrorContains(t, err, "header hashing to")
+}
+
+func TestReceiptProofVerifierFallback(t *testing.T) {
+	w, conn, msg := setupReceiptProofTest(t)
+	conn.noBlockReceipts = true
+
+	require.NoError(t, newReceiptProofVerifier(w).verify(context.Background(), conn.blockHash, msg))
+	assert.Equal(t, 2, conn.transactionReceipts)
+}
+
+func TestReceiptProofVerifierTransientError(t *testing.T) {
+	w, conn, msg := setupReceiptProofTest(t)
+	conn.blockErr = errors.New("connection refused")
+
+	err := newReceiptProofVerifier(w).verify(context.Background(), conn.blockHash, msg)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, errReceiptProofMismatch)
+
+	conn.block = nil
+	conn.blockErr = nil
+	err = newReceiptProofVerifier(w).verify(context.Background(), eth_common.Hash{1}, msg)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, errReceiptProofMismatch)
+}
+
+func TestParseVerifyReceiptsChains(t *testing.T) {
+	chains, err := ParseVerifyReceiptsChains("")
+	require.NoError(t, err)
+	assert.Empty(t, chains)
+
+	chains, err = ParseVerifyReceiptsChains("ethereum, 4")
+	require.NoError(t, err)
+	assert.Equal(t, map[vaa.ChainID]bool{vaa.ChainIDEthereum: true, vaa.ChainIDBSC: true}, chains)
+
+	_, err = ParseVerifyReceiptsChains("ethereum,junk")
+	assert.ErrorContains(t, err, `invalid chain "junk"`)
+}
@@ -0,0 +1,156 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	ethTraceReobservedMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_trace_reobserved_messages_total",
+			Help: "Total number of re-observed message publications found in the transaction trace but missing from the receipt logs",
+		}, []string{"eth_network"})
+	ethTraceReobservationErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_trace_reobservation_errors_total",
+			Help: "Total number of errors tracing re-observed transactions",
+		}, []string{"eth_network"})
+)
+
+// callTracerConfig configures the built-in callTracer of debug_traceTransaction to include the logs of every call.
+var callTracerConfig = map[string]interface{}{
+	"tracer":       "callTracer",
+	"tracerConfig": map[string]interface{}{"withLog": true},
+}
+
+type (
+	// callFrame is a call of a debug_traceTransaction callTracer result. Only the fields needed to extract the logs are
+	// decoded.
+	callFrame struct {
+		Error string      `json:"error,omitempty"`
+		Calls []callFrame `json:"calls,omitempty"`
+		Logs  []callLog   `json:"logs,omitempty"`
+	}
+
+	// callLog is a log emitted by a call of a debug_traceTransaction callTracer result.
+	callLog struct {
+		Address eth_common.Address `json:"address"`
+		Topics  []eth_common.Hash  `json:"topics"`
+		Data    hexutil.Bytes      `json:"data"`
+	}
+)
+
+// ParseTraceReobservationChains parses the comma separated list of the chains, by name or ID, whose re-observed
+// transactions are also traced.
+func ParseTraceReobservationChains(config string) (map[vaa.ChainID]bool, error) {
+	return parseChainList(config)
+}
+
+// CheckTraceReobservationChains returns an error if a chain whose re-observed transactions are traced does not check
+// its messages against the receipts root of their block. The logs found in a trace are not committed to by anything
+// the RPC node cannot make up, so the recovered messages may only be signed once they are found in the verified receipts.
+func CheckTraceReobservationChains(traceChains map[vaa.ChainID]bool, verifyReceiptsChains map[vaa.ChainID]bool) error {
+	for chainID, trace := range traceChains {
+		if trace && !verifyReceiptsChains[chainID] {
+			return fmt.Errorf("re-observed transactions of %v are traced, but its receipts are not verified", chainID)
+		}
+	}
+	return nil
+}
+
+// SetTraceReobservation enables tracing re-observed transactions with debug_traceTransaction, to recover the message
+// publications missing from the logs of the receipt. Some providers deliver incomplete logs on some chains, typically
+// for events emitted by internal transactions. The RPC node must support the callTracer with the withLog option, and
+// receipt verification must be enabled with SetVerifyReceipts (see CheckTraceReobservationChains).
+func (w *Watcher) SetTraceReobservation(traceReobservation bool) {
+	w.traceReobservation = traceReobservation
+}
+
+// logsFromCallFrame appends the logs of a call and its sub-calls to logs, in order. The logs of failed calls are skipped,
+// since their state changes, including their logs, are reverted.
+func logsFromCallFrame(frame *callFrame, txHash eth_common.Hash, logs []*types.Log) []*types.Log {
+	if frame.Error != "" {
+		return logs
+	}
+
+	for _, l := range frame.Logs {
+		logs = append(logs, &types.Log{
+			Address: l.Address,
+			Topics:  l.Topics,
+			Data:    l.Data,
+			TxHash:  txHash,
+		})
+	}
+	for i := range frame.Calls {
+		logs = logsFromCallFrame(&frame.Calls[i], txHash, logs)
+	}
+
+	return logs
+}
+
+// MessageEventsForTransactionFromTrace returns the message publications of a transaction found in its trace, including
+// the ones of the additional emitters. It returns the block number and the messages.
+func MessageEventsForTransactionFromTrace(
+	ctx context.Context,
+	ethConn connectors.Connector,
+	contract eth_common.Address,
+	additionalEmitters []*AdditionalEmitter,
+	chainId vaa.ChainID,
+	tx eth_common.Hash) (uint64, []*common.MessagePublication, error) {
+
+	// The receipt is still needed for the status and the block of the transaction.
+	receipt, err := ethConn.TransactionReceipt(ctx, tx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+	if receipt.Status != 1 {
+		return 0, nil, fmt.Errorf("non-success transaction status: %d", receipt.Status)
+	}
+
+	var trace callFrame
+	if err := ethConn.RawCallContext(ctx, &trace, "debug_traceTransaction", tx, callTracerConfig); err != nil {
+		return 0, nil, fmt.Errorf("failed to trace transaction: %w", err)
+	}
+
+	blockTime, err := ethConn.TimeOfBlockByHash(ctx, receipt.BlockHash)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get block time: %w", err)
+	}
+
+	traced := &types.Receipt{Logs: logsFromCallFrame(&trace, tx, nil)}
+	msgs, err := messagesFromReceipt(ethConn, contract, additionalEmitters, chainId, traced, blockTime)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return receipt.BlockNumber.Uint64(), msgs, nil
+}
+
+// missingMessagePublications returns the messages of traced that are not in msgs.
+func missingMessagePublications(msgs []*common.MessagePublication, traced []*common.MessagePublication) []*common.MessagePublication {
+	var missing []*common.MessagePublication
+	for _, t := range traced {
+		found := false
+		for _, m := range msgs {
+			if sameMessagePublication(m, t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, t)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,124 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// mockTraceConnector serves the receipt and the callTracer trace of a transaction.
+type mockTraceConnector struct {
+	connectors.Connector
+	receipt *types.Receipt
+	trace   string
+}
+
+func (c *mockTraceConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "debug_traceTransaction" {
+		panic("method not implemented by mockTraceConnector")
+	}
+	if c.trace == "" {
+		return errors.New("the method debug_traceTransaction does not exist/is not available")
+	}
+	return json.Unmarshal([]byte(c.trace), result)
+}
+
+func (c *mockTraceConnector) TransactionReceipt(ctx context.Context, txHash eth_common.Hash) (*types.Receipt, error) {
+	return c.receipt, nil
+}
+
+func (c *mockTraceConnector) TimeOfBlockByHash(ctx context.Context, hash eth_common.Hash) (uint64, error) {
+	return 1654543099, nil
+}
+
+// traceLogJSON returns the callTracer representation of a log.
+func traceLogJSON(t *testing.T, l types.Log) string {
+	t.Helper()
+	b, err := json.Marshal(callLog{Address: l.Address, Topics: l.Topics, Data: l.Data})
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestMessageEventsForTransactionFromTrace(t *testing.T) {
+	e, err := NewAdditionalEmitter(shutdownEmitterAddress, shutdownEmitterABI, "ShutdownMessagePublished")
+	require.NoError(t, err)
+	sender := eth_common.HexToAddress("0x0000000000000000000000000000000000001234")
+	tx := eth_common.HexToHash("0x01")
+
+	// The message of the top level call is in the receipt. The one of the internal call is only in the trace, and the one
+	// of the reverted internal call is not published at all.
+	top := shutdownEmitterLog(t, e, sender, 1)
+	internal := shutdownEmitterLog(t, e, sender, 2)
+	reverted := shutdownEmitterLog(t, e, sender, 3)
+	conn := &mockTraceConnector{
+		receipt: &types.Receipt{
+			Status:      types.ReceiptStatusSuccessful,
+			BlockNumber: big.NewInt(42),
+			Logs:        []*types.Log{&top},
+			TxHash:      tx,
+		},
+		trace: `{"type":"CALL","logs":[` + traceLogJSON(t, top) + `],"calls":[` +
+			`{"type":"CALL","calls":[{"type":"DELEGATECALL","logs":[` + traceLogJSON(t, internal) + `]}]},` +
+			`{"type":"CALL","error":"execution reverted","logs":[` + traceLogJSON(t, reverted) + `]}]}`,
+	}
+
+	additionalEmitters := []*AdditionalEmitter{e}
+	contract := eth_common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	blockNumber, msgs, err := MessageEventsForTransaction(context.Background(), conn, contract, additionalEmitters, vaa.ChainIDEthereum, tx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), blockNumber)
+	require.Len(t, msgs, 1)
+
+	blockNumber, traced, err := MessageEventsForTransactionFromTrace(context.Background(), conn, contract, additionalEmitters, vaa.ChainIDEthereum, tx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), blockNumber)
+	require.Len(t, traced, 2)
+	assert.Equal(t, uint64(1), traced[0].Sequence)
+	assert.Equal(t, uint64(2), traced[1].Sequence)
+	assert.Equal(t, tx, traced[1].TxHash)
+	assert.Equal(t, PadAddress(sender), traced[1].EmitterAddress)
+	assert.Equal(t, msgs[0].Timestamp, traced[1].Timestamp)
+
+	missing := missingMessagePublications(msgs, traced)
+	require.Len(t, missing, 1)
+	assert.Equal(t, uint64(2), missing[0].Sequence)
+	assert.Empty(t, missingMessagePublications(traced, msgs))
+
+	// Failed transactions are not traced.
+	conn.receipt.Status = types.ReceiptStatusFailed
+	_, _, err = MessageEventsForTransactionFromTrace(context.Background(), conn, contract, additionalEmitters, vaa.ChainIDEthereum, tx)
+	assert.ErrorContains(t, err, "non-success transaction status")
+
+	// Nodes without the debug API return an error.
+	conn.receipt.Status = types.ReceiptStatusSuccessful
+	conn.trace = ""
+	_, _, err = MessageEventsForTransactionFromTrace(context.Background(), conn, contract, additionalEmitters, vaa.ChainIDEthereum, tx)
+	assert.ErrorContains(t, err, "failed to trace transaction")
+}
+
+func TestMissingMessagePublications(t *testing.T) {
+	a := &common.MessagePublication{TxHash: eth_common.Hash{1}, Sequence: 1}
+	b := &common.MessagePublication{TxHash: eth_common.Hash{1}, Sequence: 2}
+
+	assert.Empty(t, missingMessagePublications([]*common.MessagePublication{a, b}, []*common.MessagePublication{b}))
+	assert.Equal(t, []*common.MessagePublication{b}, missingMessagePublications([]*common.MessagePublication{a}, []*common.MessagePublication{a, b}))
+	assert.Equal(t, []*common.MessagePublication{a}, missingMessagePublications(nil, []*common.MessagePublication{a}))
+}
+
+func TestCheckTraceReobservationChains(t *testing.T) {
+	trace := map[vaa.ChainID]bool{vaa.ChainIDEthereum: true, vaa.ChainIDBSC: true}
+
+	assert.NoError(t, CheckTraceReobservationChains(trace, map[vaa.ChainID]bool{vaa.ChainIDEthereum: true, vaa.ChainIDBSC: true, vaa.ChainIDPolygon: true}))
+	assert.NoError(t, CheckTraceReobservationChains(map[vaa.ChainID]bool{}, map[vaa.ChainID]bool{}))
+	assert.ErrorContains(t, CheckTraceReobservationChains(trace, map[vaa.ChainID]bool{vaa.ChainIDEthereum: true}), "bsc")
+}
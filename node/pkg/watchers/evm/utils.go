@@ -1,6 +1,10 @@
 package evm
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
@@ -14,3 +18,27 @@ func PadAddress(address common.Address) vaa.Address {
 
 	return addr
 }
+
+// parseChainList parses a comma separated list of chains, by name or ID, into a set.
+func parseChainList(config string) (map[vaa.ChainID]bool, error) {
+	ret := make(map[vaa.ChainID]bool)
+	if config == "" {
+		return ret, nil
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if id, err := strconv.ParseUint(entry, 10, 16); err == nil {
+			ret[vaa.ChainID(id)] = true
+			continue
+		}
+
+		chainID, err := vaa.ChainIDFromString(entry)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid chain "%s": %w`, entry, err)
+		}
+		ret[chainID] = true
+	}
+
+	return ret, nil
+}
@@ -2,6 +2,7 @@ package evm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -24,6 +25,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -61,6 +63,11 @@ var (
 			Name: "wormhole_eth_query_latency",
 			Help: "Latency histogram for Ethereum calls (note that most interactions are streaming queries, NOT calls, and we cannot measure latency for those",
 		}, []string{"eth_network", "operation"})
+	ethBlockProcessingLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "wormhole_eth_block_processing_latency",
+			Help: "Latency histogram for processing a new block, including confirming the pending messages and sending them to the processor",
+		}, []string{"eth_network"})
 )
 
 type (
@@ -120,6 +127,22 @@ type (
 		// These parameters are currently only used for Polygon and should be set via SetRootChainParams()
 		rootChainRpc      string
 		rootChainContract string
+
+		// These parameters are only used if sampling the gas token price is enabled via SetGasTokenPriceOracle().
+		gasTokenPriceOracle *GasTokenPriceOracle
+		gasTokenPriceC      chan<- *common.GasTokenPrice
+
+		// If set via SetExpectedEvmChainIDs(), the EVM chain IDs the endpoints are verified against at startup.
+		expectedEvmChainIDs map[vaa.ChainID]uint64
+
+		// Contracts other than the core bridge whose events are observed as message publications, set via SetAdditionalEmitters().
+		additionalEmitters []*AdditionalEmitter
+
+		// If set via SetVerifyReceipts(), messages are checked against the receipts root of their block before being published.
+		verifyReceipts bool
+
+		// If set via SetTraceReobservation(), re-observed transactions are also traced to recover messages missing from their receipt.
+		traceReobservation bool
 	}
 
 	pendingKey struct {
@@ -171,6 +194,10 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 	ctx, watcherContextCancelFunc := context.WithCancel(parentCtx)
 	defer watcherContextCancelFunc()
 
+	if w.traceReobservation && !w.verifyReceipts {
+		return fmt.Errorf("tracing re-observed transactions requires receipt verification")
+	}
+
 	useFinalizedBlocks := ((w.chainID == vaa.ChainIDEthereum || w.chainID == vaa.ChainIDSepolia) && (!w.unsafeDevMode))
 	if (w.chainID == vaa.ChainIDKarura || w.chainID == vaa.ChainIDAcala) && (!w.unsafeDevMode) {
 		ufb, err := w.getAcalaMode(ctx)
@@ -188,6 +215,12 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 		ContractAddress: w.contract.Hex(),
 	})
 
+	if err := w.verifyEvmChainIDs(ctx, logger); err != nil {
+		ethConnectionErrors.WithLabelValues(w.networkName, "chain_id_error").Inc()
+		p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+		return fmt.Errorf("failed to verify EVM chain ID: %w", err)
+	}
+
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 
@@ -254,7 +287,7 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 			p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
 			return fmt.Errorf("creating block poll connector failed: %w", err)
 		}
-		w.ethConn, err = connectors.NewLogPollConnector(ctx, pollConnector, baseConnector.Client())
+		w.ethConn, err = connectors.NewLogPollConnector(ctx, pollConnector, baseConnector)
 		if err != nil {
 			ethConnectionErrors.WithLabelValues(w.networkName, "dial_error").Inc()
 			p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
@@ -370,6 +403,15 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 	}
 	defer messageSub.Unsubscribe()
 
+	if len(w.additionalEmitters) != 0 {
+		for _, e := range w.additionalEmitters {
+			logger.Info("observing additional emitter", zap.String("eth_network", w.networkName), zap.Stringer("address", e.Address), zap.String("event", e.Event.Sig))
+		}
+		common.RunWithScissors(ctx, errC, "evm_fetch_additional_emitters", func(ctx context.Context) error {
+			return w.watchAdditionalEmitters(ctx, logger, w.ethConn, errC, messageC)
+		})
+	}
+
 	// Fetch initial guardian set
 	if err := w.fetchAndUpdateGuardianSet(logger, ctx, w.ethConn); err != nil {
 		return fmt.Errorf("failed to request guardian set: %v", err)
@@ -392,6 +434,17 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 		}
 	})
 
+	if w.gasTokenPriceOracle != nil {
+		logger.Info("sampling gas token price from oracle", zap.String("eth_network", w.networkName), zap.Stringer("oracle", w.gasTokenPriceOracle.OracleAddress))
+		common.RunWithScissors(ctx, errC, "evm_fetch_gas_token_price", func(ctx context.Context) error {
+			return w.handleGasTokenPrice(ctx, logger)
+		})
+	}
+
+	common.RunWithScissors(ctx, errC, "evm_monitor_endpoint_lag", func(ctx context.Context) error {
+		return w.monitorEndpointLag(ctx, logger)
+	})
+
 	// Track the current block numbers so we can compare it to the block number of
 	// the message publication for observation requests.
 	var currentBlockNumber uint64
@@ -425,7 +478,7 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 				safeBlockNumberU := atomic.LoadUint64(&currentSafeBlockNumber)
 
 				timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
-				blockNumber, msgs, err := MessageEventsForTransaction(timeout, w.ethConn, w.contract, w.chainID, tx)
+				blockNumber, msgs, err := MessageEventsForTransaction(timeout, w.ethConn, w.contract, w.additionalEmitters, w.chainID, tx)
 				cancel()
 
 				if err != nil {
@@ -435,6 +488,36 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 					continue
 				}
 
+				// The messages recovered from the trace are only signed once verifyReobservedMessages found them in the receipts
+				// committed to by the block header, which Run makes sure is enabled.
+				if w.traceReobservation {
+					timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+					_, traced, err := MessageEventsForTransactionFromTrace(timeout, w.ethConn, w.contract, w.additionalEmitters, w.chainID, tx)
+					cancel()
+
+					if err != nil {
+						ethTraceReobservationErrors.WithLabelValues(w.networkName).Inc()
+						logger.Error("failed to trace re-observed transaction, only using the messages of its receipt",
+							zap.Error(err), zap.String("eth_network", w.networkName),
+							zap.String("tx_hash", tx.Hex()))
+					} else if missing := missingMessagePublications(msgs, traced); len(missing) != 0 {
+						ethTraceReobservedMessages.WithLabelValues(w.networkName).Add(float64(len(missing)))
+						logger.Warn("found message publications in the trace of a re-observed transaction that are missing from its receipt",
+							zap.Int("missing", len(missing)), zap.String("eth_network", w.networkName),
+							zap.String("tx_hash", tx.Hex()))
+						msgs = append(msgs, missing...)
+					}
+				}
+
+				if w.verifyReceipts {
+					if err := w.verifyReobservedMessages(ctx, tx, msgs); err != nil {
+						logger.Error("failed to check the re-observed messages against the receipts root of their block",
+							zap.Error(err), zap.String("eth_network", w.networkName),
+							zap.String("tx_hash", tx.Hex()))
+						continue
+					}
+				}
+
 				for _, msg := range msgs {
 					if msg.ConsistencyLevel == vaa.ConsistencyLevelPublishImmediately {
 						logger.Info("re-observed message publication transaction, publishing it immediately",
@@ -538,73 +621,11 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 				p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
 				return nil
 			case ev := <-messageC:
-				// Request timestamp for block
-				msm := time.Now()
-				timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
-				blockTime, err := w.ethConn.TimeOfBlockByHash(timeout, ev.Raw.BlockHash)
-				cancel()
-				queryLatency.WithLabelValues(w.networkName, "block_by_number").Observe(time.Since(msm).Seconds())
-
-				if err != nil {
-					ethConnectionErrors.WithLabelValues(w.networkName, "block_by_number_error").Inc()
-					p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
-					errC <- fmt.Errorf("failed to request timestamp for block %d, hash %s: %w",
-						ev.Raw.BlockNumber, ev.Raw.BlockHash.String(), err)
+				batch := collectMessageBatch(ctx, ev, messageC, messageBatchDeadline, maxMessageBatchSize)
+				if err := w.handleMessageBatch(ctx, logger, batch); err != nil {
+					errC <- err
 					return nil
 				}
-
-				message := &common.MessagePublication{
-					TxHash:           ev.Raw.TxHash,
-					Timestamp:        time.Unix(int64(blockTime), 0),
-					Nonce:            ev.Nonce,
-					Sequence:         ev.Sequence,
-					EmitterChain:     w.chainID,
-					EmitterAddress:   PadAddress(ev.Sender),
-					Payload:          ev.Payload,
-					ConsistencyLevel: ev.ConsistencyLevel,
-				}
-
-				ethMessagesObserved.WithLabelValues(w.networkName).Inc()
-
-				if message.ConsistencyLevel == vaa.ConsistencyLevelPublishImmediately {
-					logger.Info("found new message publication transaction, publishing it immediately",
-						zap.Stringer("tx", ev.Raw.TxHash),
-						zap.Uint64("block", ev.Raw.BlockNumber),
-						zap.Stringer("blockhash", ev.Raw.BlockHash),
-						zap.Uint64("blockTime", blockTime),
-						zap.Uint64("Sequence", ev.Sequence),
-						zap.Uint32("Nonce", ev.Nonce),
-						zap.Uint8("ConsistencyLevel", ev.ConsistencyLevel),
-						zap.String("eth_network", w.networkName))
-
-					w.msgC <- message
-					ethMessagesConfirmed.WithLabelValues(w.networkName).Inc()
-					continue
-				}
-
-				logger.Info("found new message publication transaction",
-					zap.Stringer("tx", ev.Raw.TxHash),
-					zap.Uint64("block", ev.Raw.BlockNumber),
-					zap.Stringer("blockhash", ev.Raw.BlockHash),
-					zap.Uint64("blockTime", blockTime),
-					zap.Uint64("Sequence", ev.Sequence),
-					zap.Uint32("Nonce", ev.Nonce),
-					zap.Uint8("ConsistencyLevel", ev.ConsistencyLevel),
-					zap.String("eth_network", w.networkName))
-
-				key := pendingKey{
-					TxHash:         message.TxHash,
-					BlockHash:      ev.Raw.BlockHash,
-					EmitterAddress: message.EmitterAddress,
-					Sequence:       message.Sequence,
-				}
-
-				w.pendingMu.Lock()
-				w.pending[key] = &pendingMessage{
-					message: message,
-					height:  ev.Raw.BlockNumber,
-				}
-				w.pendingMu.Unlock()
 			}
 		}
 	})
@@ -652,9 +673,24 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 					Height:          ev.Number.Int64(),
 					ContractAddress: w.contract.Hex(),
 				})
+				// The blocks of the header events are finalized by the rules of the chain, or safe. The latest height is
+				// reported by the endpoint lag monitor.
+				if ev.Safe {
+					p2p.DefaultRegistry.SetNetworkHeights(w.chainID, p2p.NetworkHeights{Safe: ev.Number.Int64()})
+				} else {
+					p2p.DefaultRegistry.SetNetworkHeights(w.chainID, p2p.NetworkHeights{Finalized: ev.Number.Int64()})
+				}
 
 				w.pendingMu.Lock()
 
+				// The messages confirmed by this block, sent to the processor once the pending lock is released.
+				confirmed := make([]*common.MessagePublication, 0)
+				receipts := newReceiptCache(w.ethConn)
+				var proofs *receiptProofVerifier
+				if w.verifyReceipts {
+					proofs = newReceiptProofVerifier(w)
+				}
+
 				blockNumberU := ev.Number.Uint64()
 				if ev.Safe {
 					atomic.StoreUint64(&currentSafeBlockNumber, blockNumberU)
@@ -698,9 +734,7 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 
 					// Transaction is now ready
 					if pLock.height+expectedConfirmations <= blockNumberU {
-						timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
-						tx, err := w.ethConn.TransactionReceipt(timeout, pLock.message.TxHash)
-						cancel()
+						tx, err := receipts.get(ctx, pLock.message.TxHash)
 
 						// If the node returns an error after waiting expectedConfirmation blocks,
 						// it means the chain reorged and the transaction was orphaned. The
@@ -778,6 +812,33 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 							continue
 						}
 
+						if proofs != nil {
+							if err := proofs.verify(ctx, key.BlockHash, pLock.message); err != nil {
+								if errors.Is(err, errReceiptProofMismatch) {
+									logger.Error("message publication does not match the receipts root of its block, dropping it",
+										zap.Stringer("tx", pLock.message.TxHash),
+										zap.Stringer("blockhash", key.BlockHash),
+										zap.Stringer("emitter_address", key.EmitterAddress),
+										zap.Uint64("sequence", key.Sequence),
+										zap.String("eth_network", w.networkName),
+										zap.Error(err))
+									delete(w.pending, key)
+									ethMessagesOrphaned.WithLabelValues(w.networkName, "receipt_proof_mismatch").Inc()
+									continue
+								}
+
+								// Any other error is likely transient - we retry next block.
+								logger.Warn("failed to check the message publication against the receipts root of its block",
+									zap.Stringer("tx", pLock.message.TxHash),
+									zap.Stringer("blockhash", key.BlockHash),
+									zap.Stringer("emitter_address", key.EmitterAddress),
+									zap.Uint64("sequence", key.Sequence),
+									zap.String("eth_network", w.networkName),
+									zap.Error(err))
+								continue
+							}
+						}
+
 						logger.Info("observation confirmed",
 							zap.Stringer("tx", pLock.message.TxHash),
 							zap.Stringer("blockhash", key.BlockHash),
@@ -788,12 +849,13 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 							zap.Stringer("current_blockhash", currentHash),
 							zap.String("eth_network", w.networkName))
 						delete(w.pending, key)
-						w.msgC <- pLock.message
-						ethMessagesConfirmed.WithLabelValues(w.networkName).Inc()
+						confirmed = append(confirmed, pLock.message)
 					}
 				}
 
 				w.pendingMu.Unlock()
+				w.publishMessages(ctx, confirmed)
+				ethBlockProcessingLatency.WithLabelValues(w.networkName).Observe(time.Since(start).Seconds())
 				logger.Debug("processed new header",
 					zap.Stringer("current_block", ev.Number),
 					zap.Bool("is_safe_block", ev.Safe),
@@ -884,6 +946,10 @@ func (w *Watcher) getAcalaMode(ctx context.Context) (useFinalizedBlocks bool, er
 		Number *eth_hexutil.Big
 	}
 
+	limiter := ratelimit.DefaultRegistry.ForURL(w.url)
+	if errRet = limiter.Wait(ctx); errRet != nil {
+		return
+	}
 	var m Marshaller
 	err = c.CallContext(ctx, &m, "eth_getBlockByNumber", "finalized", false)
 	if err == nil {
@@ -893,6 +959,9 @@ func (w *Watcher) getAcalaMode(ctx context.Context) (useFinalizedBlocks bool, er
 
 	// If finalized blocks are not supported, then we had better be in safe mode!
 	var safe bool
+	if errRet = limiter.Wait(ctx); errRet != nil {
+		return
+	}
 	err = c.CallContext(ctx, &safe, "net_isSafeMode")
 	if err != nil {
 		errRet = fmt.Errorf("check for safe mode for url %s failed: %w", w.url, err)
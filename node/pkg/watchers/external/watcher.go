@@ -0,0 +1,471 @@
+// Package external implements a watcher adapter for watchers running outside of the guardian process. It serves the
+// ExternalWatcherService on a loopback address, which out-of-process watchers connect to in order to submit the messages
+// they observe and to receive the observation requests for their chain. This allows experimenting with new chains without
+// adding a watcher for each of them to guardiand.
+//
+// The adapter applies the same checks to the observations as to those of the built-in watchers, but it cannot verify
+// that an external watcher is honest. An external watcher is as trusted as any other code run by the guardian.
+package external
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	externalwatcherv1 "github.com/certusone/wormhole/node/pkg/proto/externalwatcher/v1"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// minTokenLength is the minimum length of the bearer token, to discourage trivially guessable tokens.
+const minTokenLength = 32
+
+// guardianMessageBufferSize is the number of observation requests buffered for a connected watcher.
+const guardianMessageBufferSize = 50
+
+var (
+	externalErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_external_watcher_errors_total",
+			Help: "Total number of errors on the external watcher adapter, by reason",
+		}, []string{"reason"})
+	messagesConfirmed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_external_watcher_messages_confirmed_total",
+			Help: "Total number of messages received from an external watcher",
+		}, []string{"chain_name"})
+	currentHeight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_external_watcher_current_height",
+			Help: "Current block height reported by an external watcher",
+		}, []string{"chain_name"})
+	watchersConnected = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_external_watcher_connected",
+			Help: "Whether an external watcher is connected for the chain",
+		}, []string{"chain_name"})
+	observationRequestsDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_external_watcher_observation_requests_dropped_total",
+			Help: "Total number of observation requests dropped because no external watcher was ready to receive them",
+		}, []string{"chain_name"})
+)
+
+type (
+	// ChainConfig is the list of chains accepted from external watchers, along with their channel data.
+	ChainConfig []ChainConfigEntry
+
+	// ChainConfigEntry defines the entry for a chain accepted from external watchers.
+	ChainConfigEntry struct {
+		ChainID  vaa.ChainID
+		MsgC     chan<- *common.MessagePublication
+		ObsvReqC <-chan *gossipv1.ObservationRequest
+	}
+)
+
+type (
+	// Watcher serves the ExternalWatcherService and publishes the messages submitted by external watchers.
+	Watcher struct {
+		externalwatcherv1.UnimplementedExternalWatcherServiceServer
+
+		listenAddr string
+		token      []byte
+		logger     *zap.Logger
+
+		// chainMap defines the data associated with all the chains accepted.
+		chainMap map[vaa.ChainID]*chainEntry
+	}
+
+	// chainEntry defines the data associated with a chain.
+	chainEntry struct {
+		chainID   vaa.ChainID
+		chainName string
+		readiness readiness.Component
+		msgC      chan<- *common.MessagePublication
+		obsvReqC  <-chan *gossipv1.ObservationRequest
+
+		// lock protects the fields below.
+		lock sync.Mutex
+
+		// guardianC is the channel of the messages to send to the connected watcher. It is nil while no watcher is connected.
+		guardianC chan *externalwatcherv1.GuardianMessage
+
+		// contractAddress is the contract address reported by the connected watcher.
+		contractAddress string
+	}
+)
+
+// LoadToken reads the bearer token from a file. Leading and trailing whitespace is ignored.
+func LoadToken(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read external watcher token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if len(token) < minTokenLength {
+		return "", fmt.Errorf("external watcher token must be at least %d characters long", minTokenLength)
+	}
+
+	return token, nil
+}
+
+// ValidateListenAddr checks that the listen address is a loopback address, so that the service is not exposed to the network.
+func ValidateListenAddr(listenAddr string) error {
+	host, _, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return fmt.Errorf("invalid listen address: %w", err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("listen address %s is not a loopback address", listenAddr)
+	}
+	return nil
+}
+
+// ParseChains parses the comma separated list of chains (names or IDs) accepted from external watchers.
+func ParseChains(config string) ([]vaa.ChainID, error) {
+	var ret []vaa.ChainID
+	if config == "" {
+		return ret, nil
+	}
+
+	for _, entry := range strings.Split(config, ",") {
+		entry = strings.TrimSpace(entry)
+		if id, err := strconv.ParseUint(entry, 10, 16); err == nil {
+			ret = append(ret, vaa.ChainID(id))
+			continue
+		}
+
+		chainID, err := vaa.ChainIDFromString(entry)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid chain "%s": %w`, entry, err)
+		}
+		ret = append(ret, chainID)
+	}
+
+	return ret, nil
+}
+
+// NewWatcher creates a new external watcher adapter listening on listenAddr and accepting the chains in chainConfig.
+func NewWatcher(
+	listenAddr string,
+	token string,
+	chainConfig ChainConfig,
+) *Watcher {
+	chainMap := make(map[vaa.ChainID]*chainEntry)
+	for _, chainToAccept := range chainConfig {
+		_, exists := chainMap[chainToAccept.ChainID]
+		if exists {
+			panic(fmt.Sprintf("detected duplicate chainID: %v", chainToAccept.ChainID))
+		}
+
+		chainMap[chainToAccept.ChainID] = &chainEntry{
+			chainID:   chainToAccept.ChainID,
+			chainName: chainToAccept.ChainID.String(),
+			readiness: common.MustConvertChainIdToReadinessSyncing(chainToAccept.ChainID),
+			msgC:      chainToAccept.MsgC,
+			obsvReqC:  chainToAccept.ObsvReqC,
+		}
+	}
+
+	return &Watcher{
+		listenAddr: listenAddr,
+		token:      []byte(token),
+		logger:     zap.NewNop(),
+		chainMap:   chainMap,
+	}
+}
+
+// Run is the runnable for the external watcher adapter.
+func (w *Watcher) Run(ctx context.Context) error {
+	w.logger = supervisor.Logger(ctx)
+
+	if err := ValidateListenAddr(w.listenAddr); err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", w.listenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", w.listenAddr, err)
+	}
+
+	for _, ce := range w.chainMap {
+		w.logger.Info("accepting chain from external watchers", zap.String("chain", ce.chainName))
+	}
+	w.logger.Info("external watcher adapter listening", zap.String("addr", lis.Addr().String()))
+
+	// Signal to the supervisor that this runnable has finished initialization.
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+	return w.serve(ctx, lis)
+}
+
+// serve serves the ExternalWatcherService on lis until ctx is done.
+func (w *Watcher) serve(ctx context.Context, lis net.Listener) error {
+	errC := make(chan error)
+
+	server := common.NewInstrumentedGRPCServer(w.logger, common.GrpcLogDetailNone)
+	externalwatcherv1.RegisterExternalWatcherServiceServer(server, w)
+	defer server.Stop()
+
+	common.RunWithScissors(ctx, errC, "external_watcher_server", func(ctx context.Context) error {
+		return server.Serve(lis)
+	})
+
+	// Start a routine for each chain to forward the observation requests.
+	for _, ce := range w.chainMap {
+		ce := ce
+		common.RunWithScissors(ctx, errC, "external_watcher_obsv_req", func(ctx context.Context) error {
+			return w.handleObservationRequests(ctx, ce)
+		})
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-errC:
+		return err
+	}
+}
+
+// handleObservationRequests forwards the observation requests of a chain to the connected watcher. Requests arriving while
+// no watcher is connected are dropped, since they would be stale by the time one connects.
+func (w *Watcher) handleObservationRequests(ctx context.Context, ce *chainEntry) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case r := <-ce.obsvReqC:
+			if vaa.ChainID(r.ChainId) != ce.chainID {
+				panic("invalid chain ID")
+			}
+
+			msg := &externalwatcherv1.GuardianMessage{
+				Message: &externalwatcherv1.GuardianMessage_ObservationRequest{
+					ObservationRequest: &externalwatcherv1.ObservationRequest{TxHash: r.TxHash},
+				},
+			}
+
+			sent := false
+			ce.lock.Lock()
+			if ce.guardianC != nil {
+				select {
+				case ce.guardianC <- msg:
+					sent = true
+				default:
+				}
+			}
+			ce.lock.Unlock()
+
+			if !sent {
+				observationRequestsDropped.WithLabelValues(ce.chainName).Inc()
+				w.logger.Warn("dropping observation request, no external watcher is ready to receive it",
+					zap.String("chain", ce.chainName), zap.String("txHash", ethCommon.BytesToHash(r.TxHash).Hex()))
+				continue
+			}
+
+			w.logger.Info("forwarded observation request to external watcher",
+				zap.String("chain", ce.chainName), zap.String("txHash", ethCommon.BytesToHash(r.TxHash).Hex()))
+		}
+	}
+}
+
+// authenticate checks the bearer token in the metadata of a stream.
+func (w *Watcher) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, auth := range md.Get("authorization") {
+		if strings.HasPrefix(auth, "Bearer ") && subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), w.token) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid or missing bearer token")
+}
+
+// Connect implements the ExternalWatcherService.
+func (w *Watcher) Connect(stream externalwatcherv1.ExternalWatcherService_ConnectServer) error {
+	ctx := stream.Context()
+	if err := w.authenticate(ctx); err != nil {
+		externalErrors.WithLabelValues("unauthenticated").Inc()
+		w.logger.Warn("rejected unauthenticated external watcher")
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	hello := first.GetHello()
+	if hello == nil {
+		externalErrors.WithLabelValues("missing_hello").Inc()
+		return status.Error(codes.InvalidArgument, "the first message must be a hello")
+	}
+	ce, exists := w.chainMap[vaa.ChainID(hello.ChainId)]
+	if !exists {
+		externalErrors.WithLabelValues("unknown_chain").Inc()
+		w.logger.Warn("rejected external watcher for a chain that is not enabled", zap.Uint32("chainID", hello.ChainId))
+		return status.Errorf(codes.PermissionDenied, "chain %d is not accepted from external watchers", hello.ChainId)
+	}
+
+	guardianC := make(chan *externalwatcherv1.GuardianMessage, guardianMessageBufferSize)
+	ce.lock.Lock()
+	if ce.guardianC != nil {
+		ce.lock.Unlock()
+		externalErrors.WithLabelValues("already_connected").Inc()
+		w.logger.Warn("rejected external watcher, another one is already connected", zap.String("chain", ce.chainName))
+		return status.Errorf(codes.AlreadyExists, "an external watcher is already connected for chain %s", ce.chainName)
+	}
+	ce.guardianC = guardianC
+	ce.contractAddress = hello.ContractAddress
+	ce.lock.Unlock()
+
+	watchersConnected.WithLabelValues(ce.chainName).Set(1)
+	w.logger.Info("external watcher connected", zap.String("chain", ce.chainName), zap.String("contract", hello.ContractAddress))
+
+	defer func() {
+		ce.lock.Lock()
+		ce.guardianC = nil
+		ce.lock.Unlock()
+		watchersConnected.WithLabelValues(ce.chainName).Set(0)
+		w.logger.Info("external watcher disconnected", zap.String("chain", ce.chainName))
+	}()
+
+	// Receive in a separate routine, so that the observation requests can be sent while waiting for a message.
+	recvErrC := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				recvErrC <- err
+				return
+			}
+			if err := w.handleWatcherMessage(ctx, ce, msg); err != nil {
+				recvErrC <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-recvErrC:
+			return err
+		case msg := <-guardianC:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// handleWatcherMessage processes a message received from the watcher of a chain.
+func (w *Watcher) handleWatcherMessage(ctx context.Context, ce *chainEntry, msg *externalwatcherv1.WatcherMessage) error {
+	switch m := msg.Message.(type) {
+	case *externalwatcherv1.WatcherMessage_Observation:
+		pub, err := observationToMessagePublication(ce.chainID, m.Observation)
+		if err != nil {
+			externalErrors.WithLabelValues("invalid_observation").Inc()
+			w.logger.Error("received invalid observation from external watcher", zap.String("chain", ce.chainName), zap.Error(err))
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		w.logger.Info("message observed",
+			zap.String("chain", ce.chainName),
+			zap.Stringer("txHash", pub.TxHash),
+			zap.Time("timestamp", pub.Timestamp),
+			zap.Uint32("nonce", pub.Nonce),
+			zap.Uint64("sequence", pub.Sequence),
+			zap.Stringer("emitter_chain", pub.EmitterChain),
+			zap.Stringer("emitter_address", pub.EmitterAddress),
+			zap.Uint8("consistencyLevel", pub.ConsistencyLevel),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ce.msgC <- pub:
+		}
+		messagesConfirmed.WithLabelValues(ce.chainName).Inc()
+	case *externalwatcherv1.WatcherMessage_Height:
+		ce.lock.Lock()
+		contractAddress := ce.contractAddress
+		ce.lock.Unlock()
+
+		currentHeight.WithLabelValues(ce.chainName).Set(float64(m.Height.Height))
+		p2p.DefaultRegistry.SetNetworkStats(ce.chainID, &gossipv1.Heartbeat_Network{
+			Height:          m.Height.Height,
+			ContractAddress: contractAddress,
+		})
+		p2p.DefaultRegistry.SetNetworkHeights(ce.chainID, p2p.NetworkHeights{
+			Latest:    m.Height.Height,
+			Safe:      m.Height.SafeHeight,
+			Finalized: m.Height.FinalizedHeight,
+		})
+		readiness.SetReady(ce.readiness)
+	case *externalwatcherv1.WatcherMessage_Hello:
+		externalErrors.WithLabelValues("unexpected_hello").Inc()
+		return status.Error(codes.InvalidArgument, "unexpected hello")
+	default:
+		externalErrors.WithLabelValues("unknown_message").Inc()
+		return status.Error(codes.InvalidArgument, "unknown message type")
+	}
+	return nil
+}
+
+// observationToMessagePublication validates an observation received from the watcher of chainID and converts it.
+func observationToMessagePublication(chainID vaa.ChainID, o *externalwatcherv1.Observation) (*common.MessagePublication, error) {
+	if len(o.TxHash) != ethCommon.HashLength {
+		return nil, fmt.Errorf("tx hash must be %d bytes long, is %d bytes", ethCommon.HashLength, len(o.TxHash))
+	}
+	if len(o.EmitterAddress) != len(vaa.Address{}) {
+		return nil, fmt.Errorf("emitter address must be %d bytes long, is %d bytes", len(vaa.Address{}), len(o.EmitterAddress))
+	}
+	if o.ConsistencyLevel > 255 {
+		return nil, fmt.Errorf("consistency level %d out of range", o.ConsistencyLevel)
+	}
+	if o.Timestamp < 0 {
+		return nil, errors.New("negative timestamp")
+	}
+
+	var emitterAddress vaa.Address
+	copy(emitterAddress[:], o.EmitterAddress)
+
+	return &common.MessagePublication{
+		TxHash:           ethCommon.BytesToHash(o.TxHash),
+		Timestamp:        time.Unix(o.Timestamp, 0),
+		Nonce:            o.Nonce,
+		Sequence:         o.Sequence,
+		ConsistencyLevel: uint8(o.ConsistencyLevel),
+		EmitterChain:     chainID,
+		EmitterAddress:   emitterAddress,
+		Payload:          o.Payload,
+		Unreliable:       o.Unreliable,
+	}, nil
+}
@@ -0,0 +1,194 @@
+package external
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	externalwatcherv1 "github.com/certusone/wormhole/node/pkg/proto/externalwatcher/v1"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testToken = "0123456789abcdef0123456789abcdef"
+
+func TestValidateListenAddr(t *testing.T) {
+	assert.NoError(t, ValidateListenAddr("127.0.0.1:7080"))
+	assert.NoError(t, ValidateListenAddr("[::1]:7080"))
+	assert.NoError(t, ValidateListenAddr("localhost:7080"))
+	assert.Error(t, ValidateListenAddr("0.0.0.0:7080"))
+	assert.Error(t, ValidateListenAddr(":7080"))
+	assert.Error(t, ValidateListenAddr("10.0.0.1:7080"))
+	assert.Error(t, ValidateListenAddr("127.0.0.1"))
+}
+
+func TestParseChains(t *testing.T) {
+	chains, err := ParseChains("")
+	require.NoError(t, err)
+	assert.Empty(t, chains)
+
+	chains, err = ParseChains("sei, 4000")
+	require.NoError(t, err)
+	assert.Equal(t, []vaa.ChainID{vaa.ChainIDSei, 4000}, chains)
+
+	_, err = ParseChains("notachain")
+	assert.Error(t, err)
+}
+
+func TestObservationToMessagePublication(t *testing.T) {
+	o := &externalwatcherv1.Observation{
+		TxHash:           make([]byte, 32),
+		Timestamp:        1700000000,
+		Nonce:            1,
+		Sequence:         2,
+		ConsistencyLevel: 3,
+		EmitterAddress:   append(make([]byte, 31), 4),
+		Payload:          []byte{5},
+	}
+	pub, err := observationToMessagePublication(vaa.ChainIDSei, o)
+	require.NoError(t, err)
+	assert.Equal(t, vaa.ChainIDSei, pub.EmitterChain)
+	assert.Equal(t, vaa.Address{31: 4}, pub.EmitterAddress)
+	assert.Equal(t, time.Unix(1700000000, 0), pub.Timestamp)
+	assert.Equal(t, uint8(3), pub.ConsistencyLevel)
+
+	o.TxHash = make([]byte, 20)
+	_, err = observationToMessagePublication(vaa.ChainIDSei, o)
+	assert.Error(t, err)
+
+	o.TxHash = make([]byte, 32)
+	o.ConsistencyLevel = 256
+	_, err = observationToMessagePublication(vaa.ChainIDSei, o)
+	assert.Error(t, err)
+}
+
+// startWatcher serves a watcher accepting Sei on a loopback listener and returns a client connected to it.
+func startWatcher(t *testing.T, ctx context.Context) (externalwatcherv1.ExternalWatcherServiceClient, chan *common.MessagePublication, chan *gossipv1.ObservationRequest) {
+	t.Helper()
+
+	msgC := make(chan *common.MessagePublication, 10)
+	obsvReqC := make(chan *gossipv1.ObservationRequest, 10)
+	w := NewWatcher("127.0.0.1:0", testToken, ChainConfig{{ChainID: vaa.ChainIDSei, MsgC: msgC, ObsvReqC: obsvReqC}})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = w.serve(ctx, lis) }()
+
+	conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return externalwatcherv1.NewExternalWatcherServiceClient(conn), msgC, obsvReqC
+}
+
+func hello(chainID vaa.ChainID) *externalwatcherv1.WatcherMessage {
+	return &externalwatcherv1.WatcherMessage{Message: &externalwatcherv1.WatcherMessage_Hello{Hello: &externalwatcherv1.Hello{ChainId: uint32(chainID)}}}
+}
+
+func TestConnect(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, msgC, obsvReqC := startWatcher(t, ctx)
+
+	authCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+testToken)
+	stream, err := client.Connect(authCtx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(hello(vaa.ChainIDSei)))
+
+	require.NoError(t, stream.Send(&externalwatcherv1.WatcherMessage{Message: &externalwatcherv1.WatcherMessage_Height{Height: &externalwatcherv1.Height{
+		Height:          30,
+		SafeHeight:      20,
+		FinalizedHeight: 10,
+	}}}))
+	require.NoError(t, stream.Send(&externalwatcherv1.WatcherMessage{Message: &externalwatcherv1.WatcherMessage_Observation{Observation: &externalwatcherv1.Observation{
+		TxHash:         append(make([]byte, 31), 1),
+		Sequence:       42,
+		EmitterAddress: append(make([]byte, 31), 2),
+		Payload:        []byte{3},
+	}}}))
+
+	select {
+	case msg := <-msgC:
+		assert.Equal(t, vaa.ChainIDSei, msg.EmitterChain)
+		assert.Equal(t, uint64(42), msg.Sequence)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the message")
+	}
+
+	// The messages of a stream are handled in order, so the heights were reported before the observation.
+	found := false
+	for _, n := range p2p.DefaultRegistry.GetNetworkStats() {
+		if n.Id == uint32(vaa.ChainIDSei) {
+			found = true
+			assert.Equal(t, int64(30), n.Height)
+			assert.Equal(t, int64(30), n.LatestHeight)
+			assert.Equal(t, int64(20), n.SafeHeight)
+			assert.Equal(t, int64(10), n.FinalizedHeight)
+		}
+	}
+	assert.True(t, found)
+
+	// A second watcher for the same chain is rejected.
+	second, err := client.Connect(authCtx)
+	require.NoError(t, err)
+	require.NoError(t, second.Send(hello(vaa.ChainIDSei)))
+	_, err = second.Recv()
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Observation requests are forwarded to the connected watcher.
+	obsvReqC <- &gossipv1.ObservationRequest{ChainId: uint32(vaa.ChainIDSei), TxHash: []byte{7}}
+	msg, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{7}, msg.GetObservationRequest().TxHash)
+
+	// Invalid observations close the stream.
+	require.NoError(t, stream.Send(&externalwatcherv1.WatcherMessage{Message: &externalwatcherv1.WatcherMessage_Observation{Observation: &externalwatcherv1.Observation{}}}))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestConnectRejected(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, _, _ := startWatcher(t, ctx)
+
+	// Without the token.
+	stream, err := client.Connect(ctx)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// With the wrong token.
+	stream, err = client.Connect(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer wrong"))
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	authCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+testToken)
+
+	// For a chain that is not accepted.
+	stream, err = client.Connect(authCtx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(hello(vaa.ChainIDEthereum)))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Without a hello.
+	stream, err = client.Connect(authCtx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&externalwatcherv1.WatcherMessage{Message: &externalwatcherv1.WatcherMessage_Height{Height: &externalwatcherv1.Height{Height: 1}}}))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
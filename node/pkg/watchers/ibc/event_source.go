@@ -0,0 +1,325 @@
+package ibc
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/cosmwasm"
+	"github.com/certusone/wormhole/node/pkg/wormconn"
+)
+
+// eventSource provides the raw tendermint event messages processed by the watcher.
+type eventSource interface {
+	// ReadEvent blocks until the next message is available and returns it.
+	ReadEvent(ctx context.Context) ([]byte, error)
+
+	// Close releases any resources held by the event source.
+	Close()
+}
+
+// websocketEventSource reads events from a tendermint websocket subscription.
+type websocketEventSource struct {
+	sub *wormconn.TendermintSubscription
+}
+
+// newWebsocketEventSource connects to the tendermint websocket and subscribes to transactions for the specified contract.
+func newWebsocketEventSource(ctx context.Context, wsUrl string, contractAddress string) (*websocketEventSource, error) {
+	sub, err := wormconn.SubscribeTendermint(ctx, wsUrl, contractTxQuery(contractAddress))
+	if err != nil {
+		ibcErrors.WithLabelValues("websocket_subscription_error").Inc()
+		return nil, err
+	}
+
+	return &websocketEventSource{sub: sub}, nil
+}
+
+func (s *websocketEventSource) ReadEvent(ctx context.Context) ([]byte, error) {
+	return s.sub.ReadRaw(ctx)
+}
+
+func (s *websocketEventSource) Close() {
+	s.sub.Close()
+}
+
+// contractTxQuery returns the tendermint query matching the transactions of the specified contract.
+func contractTxQuery(contractAddress string) string {
+	return fmt.Sprintf("tm.event='Tx' AND wasm._contract_address='%s'", contractAddress)
+}
+
+/*
+The block results event source is an alternative to the websocket for environments where the subscribe endpoint is
+disabled or proxied away. It polls the latest height of the node and reads the results of each new block with the
+block_results endpoint. For every successful transaction with a wasm event of the contract, the hash is computed from
+the raw transaction returned by the block endpoint, and a message is returned in the format of the websocket
+subscription, so the watcher processes both the same way. Like the websocket subscription, it starts with the first
+block after the one that is the latest when it is created.
+*/
+
+// contractAddressKeyBase64 is the key of the contract address attribute of wasm events, as encoded by Tendermint up to v0.36.
+var contractAddressKeyBase64 = base64.StdEncoding.EncodeToString([]byte("_contract_address"))
+
+// blockResultsEventSource reads events by polling the results of each new block.
+type blockResultsEventSource struct {
+	client          *wormconn.TendermintClient
+	contractAddress string
+	pollInterval    time.Duration
+
+	// nextHeight is the height of the next block to read.
+	nextHeight int64
+
+	// latestHeight is the latest height reported by the node.
+	latestHeight int64
+
+	// pending holds the messages of the blocks already read which have not been returned yet.
+	pending [][]byte
+}
+
+// newBlockResultsEventSource creates an event source polling the tendermint node at rpcUrl, which may be the URL of its
+// websocket or HTTP endpoint, for new blocks every pollInterval.
+func newBlockResultsEventSource(ctx context.Context, rpcUrl string, contractAddress string, pollInterval time.Duration) (*blockResultsEventSource, error) {
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval: %s", pollInterval)
+	}
+
+	client, err := wormconn.NewTendermintClient(rpcUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		ibcErrors.WithLabelValues("query_block_results_error").Inc()
+		return nil, fmt.Errorf("failed to query the latest block height: %w", err)
+	}
+
+	latestHeight := status.SyncInfo.LatestBlockHeight
+	return &blockResultsEventSource{
+		client:          client,
+		contractAddress: contractAddress,
+		pollInterval:    pollInterval,
+		nextHeight:      latestHeight + 1,
+		latestHeight:    latestHeight,
+	}, nil
+}
+
+// ReadEvent returns the next transaction of the contract, reading new blocks as needed.
+func (s *blockResultsEventSource) ReadEvent(ctx context.Context) ([]byte, error) {
+	for len(s.pending) == 0 {
+		if s.nextHeight > s.latestHeight {
+			if err := s.waitForBlock(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := s.readBlock(ctx, s.nextHeight); err != nil {
+			ibcErrors.WithLabelValues("query_block_results_error").Inc()
+			return nil, err
+		}
+		s.nextHeight++
+	}
+
+	message := s.pending[0]
+	s.pending = s.pending[1:]
+	return message, nil
+}
+
+// waitForBlock waits for the poll interval and then updates the latest height.
+func (s *blockResultsEventSource) waitForBlock(ctx context.Context) error {
+	timer := time.NewTimer(s.pollInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	status, err := s.client.Status(ctx)
+	if err != nil {
+		ibcErrors.WithLabelValues("query_block_results_error").Inc()
+		return fmt.Errorf("failed to query the latest block height: %w", err)
+	}
+	s.latestHeight = status.SyncInfo.LatestBlockHeight
+	return nil
+}
+
+// readBlock adds a message for each transaction of the contract in the block at the specified height to the pending
+// messages. The block itself is only requested if it contains such a transaction, since its results do not include
+// the transaction hashes.
+func (s *blockResultsEventSource) readBlock(ctx context.Context, height int64) error {
+	results, err := s.client.BlockResults(ctx, height)
+	if err != nil {
+		return fmt.Errorf("failed to query the results of block %d: %w", height, err)
+	}
+
+	var block *wormconn.TendermintBlock
+	for i, result := range results.TxsResults {
+		if result.Code != 0 || !emittedByContract(result.Events, s.contractAddress) {
+			continue
+		}
+
+		if block == nil {
+			block, err = s.client.Block(ctx, height)
+			if err != nil {
+				return fmt.Errorf("failed to query block %d: %w", height, err)
+			}
+			if len(block.Data.Txs) != len(results.TxsResults) {
+				return fmt.Errorf("block %d has %d transactions but %d results", height, len(block.Data.Txs), len(results.TxsResults))
+			}
+		}
+
+		message, err := wormconn.MarshalTendermintTxEvent(&wormconn.TendermintTxEvent{
+			Query:  contractTxQuery(s.contractAddress),
+			TxHash: wormconn.TendermintTxHash(block.Data.Txs[i]),
+			Height: height,
+			Index:  uint32(i),
+			Result: result,
+		})
+		if err != nil {
+			return err
+		}
+		s.pending = append(s.pending, message)
+	}
+
+	return nil
+}
+
+func (s *blockResultsEventSource) Close() {}
+
+// emittedByContract returns whether the events of a transaction include a wasm event of the specified contract, with
+// the attributes in either encoding.
+func emittedByContract(events []wormconn.TendermintEvent, contractAddress string) bool {
+	contractAddressBase64 := base64.StdEncoding.EncodeToString([]byte(contractAddress))
+	for _, event := range events {
+		if event.Type != "wasm" {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if (attribute.Key == "_contract_address" && attribute.Value == contractAddress) ||
+				(attribute.Key == contractAddressKeyBase64 && attribute.Value == contractAddressBase64) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/*
+The file event source replays tendermint events captured from the websocket subscription. The file contains one
+JSON object per line, like this:
+
+{"receivedAt": "2023-04-01T12:00:00Z", "message": {"jsonrpc": "2.0", "id": 1, "result": {...}}}
+
+The message is the raw websocket message, exactly as it was received from tendermint. The optional receivedAt
+timestamp is used to reproduce the original spacing between events. Blank lines and lines starting with # are ignored.
+*/
+
+// recordedEvent is a single line in an event file.
+type recordedEvent struct {
+	ReceivedAt *time.Time      `json:"receivedAt,omitempty"`
+	Message    json.RawMessage `json:"message"`
+}
+
+// fileEventSource replays events from a file.
+type fileEventSource struct {
+	events []recordedEvent
+	next   int
+
+	// speed is the replay speed relative to the original timing. Zero replays the events without any delay.
+	speed float64
+
+	// prevReceivedAt is the original receive time of the last event returned.
+	prevReceivedAt *time.Time
+}
+
+// newFileEventSource loads the events in the specified file. The speed parameter controls the timing of the replay.
+// One replays the events with their original spacing, two replays them twice as fast and zero replays them without delay.
+func newFileEventSource(fileName string, speed float64) (*fileEventSource, error) {
+	if speed < 0 {
+		return nil, fmt.Errorf("invalid replay speed: %f", speed)
+	}
+
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), int(cosmwasm.ReadLimitSize))
+
+	events := []recordedEvent{}
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var evt recordedEvent
+		if err := json.Unmarshal([]byte(line), &evt); err != nil {
+			return nil, fmt.Errorf("failed to parse line %d of event file: %w", lineNum, err)
+		}
+		if len(evt.Message) == 0 {
+			return nil, fmt.Errorf("line %d of event file does not contain a message", lineNum)
+		}
+
+		events = append(events, evt)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event file: %w", err)
+	}
+
+	return &fileEventSource{events: events, speed: speed}, nil
+}
+
+// ReadEvent returns the next event in the file, after waiting for the appropriate delay. Once all the events have been
+// returned, it blocks until the context is canceled, just like an idle websocket would.
+func (s *fileEventSource) ReadEvent(ctx context.Context) ([]byte, error) {
+	if s.next >= len(s.events) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	evt := s.events[s.next]
+	s.next++
+
+	if delay := s.delay(evt.ReceivedAt); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if evt.ReceivedAt != nil {
+		s.prevReceivedAt = evt.ReceivedAt
+	}
+
+	return evt.Message, nil
+}
+
+// delay returns how long to wait before returning an event received at the specified time.
+func (s *fileEventSource) delay(receivedAt *time.Time) time.Duration {
+	if s.speed == 0 || receivedAt == nil || s.prevReceivedAt == nil {
+		return 0
+	}
+
+	gap := receivedAt.Sub(*s.prevReceivedAt)
+	if gap <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(gap) / s.speed)
+}
+
+func (s *fileEventSource) Close() {}
@@ -0,0 +1,317 @@
+package ibc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/wormconn"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const testContractAddress = "wormhole1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrq0kdhcj"
+
+// newTestLcdServer returns an LCD server that maps channel-0 to Sei.
+func newTestLcdServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	channelQuery := fmt.Sprintf("/cosmwasm/wasm/v1/contract/%s/smart/", testContractAddress)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, channelQuery):
+			fmt.Fprintf(w, `{"data": {"channels_chains": [["%s", %d]]}}`, base64.StdEncoding.EncodeToString([]byte("channel-0")), vaa.ChainIDSei)
+		case r.URL.Path == "/blocks/latest":
+			fmt.Fprint(w, `{"block": {"header": {"height": "2613"}}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func writeEventFile(t *testing.T, lines ...string) string {
+	t.Helper()
+	fileName := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(fileName, []byte(strings.Join(lines, "\n")), 0600))
+	return fileName
+}
+
+func TestFileEventSourceReplaysEventsInOrder(t *testing.T) {
+	fileName := writeEventFile(t,
+		`# comment`,
+		`{"message": {"id": 1}}`,
+		``,
+		`{"message": {"id": 2}}`,
+	)
+
+	src, err := newFileEventSource(fileName, 0)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	msg, err := src.ReadEvent(ctx)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": 1}`, string(msg))
+
+	msg, err = src.ReadEvent(ctx)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": 2}`, string(msg))
+
+	// Once the file is exhausted, the source blocks until the context is canceled.
+	_, err = src.ReadEvent(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestFileEventSourceTiming(t *testing.T) {
+	fileName := writeEventFile(t,
+		`{"receivedAt": "2023-03-29T14:23:34Z", "message": {"id": 1}}`,
+		`{"receivedAt": "2023-03-29T14:23:35Z", "message": {"id": 2}}`,
+		`{"receivedAt": "2023-03-29T14:23:34Z", "message": {"id": 3}}`,
+	)
+
+	src, err := newFileEventSource(fileName, 2)
+	require.NoError(t, err)
+
+	// The first event has nothing to be spaced from.
+	assert.Equal(t, time.Duration(0), src.delay(src.events[0].ReceivedAt))
+	src.prevReceivedAt = src.events[0].ReceivedAt
+
+	// One second apart, replayed at twice the speed.
+	assert.Equal(t, 500*time.Millisecond, src.delay(src.events[1].ReceivedAt))
+	src.prevReceivedAt = src.events[1].ReceivedAt
+
+	// Events recorded out of order are not delayed.
+	assert.Equal(t, time.Duration(0), src.delay(src.events[2].ReceivedAt))
+
+	src.speed = 0
+	src.prevReceivedAt = src.events[0].ReceivedAt
+	assert.Equal(t, time.Duration(0), src.delay(src.events[1].ReceivedAt))
+}
+
+func TestFileEventSourceInvalidFile(t *testing.T) {
+	_, err := newFileEventSource(filepath.Join(t.TempDir(), "missing.jsonl"), 0)
+	assert.Error(t, err)
+
+	_, err = newFileEventSource(writeEventFile(t, `{"message": {"id": 1}}`, `not json`), 0)
+	assert.ErrorContains(t, err, "line 2")
+
+	_, err = newFileEventSource(writeEventFile(t, `{"receivedAt": "2023-03-29T14:23:34Z"}`), 0)
+	assert.ErrorContains(t, err, "does not contain a message")
+
+	_, err = newFileEventSource(writeEventFile(t, `{"message": {"id": 1}}`), -1)
+	assert.ErrorContains(t, err, "invalid replay speed")
+}
+
+// TestSimulatedWatcher runs the full watcher against the recorded events in testdata/events.jsonl. The file contains a publish on
+// channel-0, a publish on an unknown channel, an event for a different action and a second publish on channel-0.
+func TestSimulatedWatcher(t *testing.T) {
+	lcd := newTestLcdServer(t)
+
+	msgC := make(chan *common.MessagePublication, 10)
+	obsvReqC := make(chan *gossipv1.ObservationRequest)
+	w := NewSimulatedWatcher("testdata/events.jsonl", 0, lcd.URL, testContractAddress, ChainConfig{
+		{ChainID: vaa.ChainIDSei, MsgC: msgC, ObsvReqC: obsvReqC},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	supervisor.New(ctx, zap.NewNop(), w.Run)
+
+	expectedSender, err := vaa.StringToAddress("00000000000000000000000035743074956c710800e83198011ccbd4ddf1556d")
+	require.NoError(t, err)
+
+	for _, expected := range []struct {
+		txHash   string
+		sequence uint64
+	}{
+		{"82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b", 2},
+		{"5f4b3c2d1e0f5f4b3c2d1e0f5f4b3c2d1e0f5f4b3c2d1e0f5f4b3c2d1e0f5f4b", 5},
+	} {
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "timed out waiting for message", "sequence %d", expected.sequence)
+		case msg := <-msgC:
+			txHash, err := vaa.StringToHash(expected.txHash)
+			require.NoError(t, err)
+			assert.Equal(t, txHash, msg.TxHash)
+			assert.Equal(t, vaa.ChainIDSei, msg.EmitterChain)
+			assert.Equal(t, expectedSender, msg.EmitterAddress)
+			assert.Equal(t, expected.sequence, msg.Sequence)
+			assert.Equal(t, uint32(1), msg.Nonce)
+			assert.Equal(t, time.Unix(1680099814, 0), msg.Timestamp)
+		}
+	}
+
+	// The event from the unknown channel and the one for the other action should have been dropped.
+	select {
+	case msg := <-msgC:
+		assert.Fail(t, "unexpected message published", "sequence %d", msg.Sequence)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// recordedTxEvents returns the events of the transaction on the specified line of testdata/events.jsonl.
+func recordedTxEvents(t *testing.T, line int) string {
+	t.Helper()
+	data, err := os.ReadFile("testdata/events.jsonl")
+	require.NoError(t, err)
+	lines := strings.Split(string(data), "\n")
+	require.Greater(t, len(lines), line)
+	events := gjson.Get(lines[line], "message.result.data.value.TxResult.result.events")
+	require.True(t, events.Exists())
+	return events.Raw
+}
+
+// newTestTendermintServer returns a tendermint RPC server with a transaction of the contract in blocks 11 and 12, along
+// with a transaction of another contract and a failed one in block 11. The latest height is 10 for the first two status
+// queries, which are made by the watcher on startup, and 12 afterwards.
+func newTestTendermintServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	otherContractEvents := `[{"type": "wasm", "attributes": [{"key": "_contract_address", "value": "wormhole1other"}, {"key": "action", "value": "receive_publish"}]}]`
+	blocks := map[string]struct {
+		txs     []string
+		results []string
+	}{
+		"11": {
+			txs:     []string{"AQ==", "Ag==", "Aw=="},
+			results: []string{`{"code": 0, "events": ` + otherContractEvents + `}`, `{"code": 0, "events": ` + recordedTxEvents(t, 1) + `}`, `{"code": 5, "events": ` + recordedTxEvents(t, 1) + `}`},
+		},
+		"12": {
+			txs:     []string{"BA=="},
+			results: []string{`{"code": 0, "events": ` + recordedTxEvents(t, 4) + `}`},
+		},
+	}
+
+	var statusQueries atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params map[string]string `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result string
+		switch req.Method {
+		case "status":
+			height := 10
+			if statusQueries.Add(1) > 2 {
+				height = 12
+			}
+			result = fmt.Sprintf(`{"node_info": {"version": "0.34.27"}, "sync_info": {"latest_block_height": "%d"}}`, height)
+		case "block":
+			block := blocks[req.Params["height"]]
+			result = fmt.Sprintf(`{"block": {"header": {"height": "%s"}, "data": {"txs": ["%s"]}}}`, req.Params["height"], strings.Join(block.txs, `", "`))
+		case "block_results":
+			block := blocks[req.Params["height"]]
+			result = fmt.Sprintf(`{"height": "%s", "txs_results": [%s]}`, req.Params["height"], strings.Join(block.results, ", "))
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": 1, "result": %s}`, result)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// TestBlockResultsPolling runs the full watcher against the block results of a tendermint node.
+func TestBlockResultsPolling(t *testing.T) {
+	lcd := newTestLcdServer(t)
+	rpc := newTestTendermintServer(t)
+
+	msgC := make(chan *common.MessagePublication, 10)
+	obsvReqC := make(chan *gossipv1.ObservationRequest)
+	w := NewWatcher(rpc.URL, lcd.URL, testContractAddress, ChainConfig{
+		{ChainID: vaa.ChainIDSei, MsgC: msgC, ObsvReqC: obsvReqC},
+	})
+	w.SetBlockResultsPolling(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	supervisor.New(ctx, zap.NewNop(), w.Run)
+
+	for _, expected := range []struct {
+		tx       []byte
+		sequence uint64
+	}{
+		{[]byte{2}, 2},
+		{[]byte{4}, 5},
+	} {
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "timed out waiting for message", "sequence %d", expected.sequence)
+		case msg := <-msgC:
+			txHash, err := vaa.StringToHash(wormconn.TendermintTxHash(expected.tx))
+			require.NoError(t, err)
+			assert.Equal(t, txHash, msg.TxHash)
+			assert.Equal(t, vaa.ChainIDSei, msg.EmitterChain)
+			assert.Equal(t, expected.sequence, msg.Sequence)
+		}
+	}
+
+	// The transactions of the other contract and the failed one should have been skipped.
+	select {
+	case msg := <-msgC:
+		assert.Fail(t, "unexpected message published", "sequence %d", msg.Sequence)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestEmittedByContract(t *testing.T) {
+	raw := []wormconn.TendermintEvent{{Type: "wasm", Attributes: []wormconn.TendermintEventAttribute{{Key: "_contract_address", Value: testContractAddress}}}}
+	assert.True(t, emittedByContract(raw, testContractAddress))
+	assert.False(t, emittedByContract(raw, "wormhole1other"))
+
+	encoded := []wormconn.TendermintEvent{{Type: "wasm", Attributes: []wormconn.TendermintEventAttribute{{
+		Key:   base64.StdEncoding.EncodeToString([]byte("_contract_address")),
+		Value: base64.StdEncoding.EncodeToString([]byte(testContractAddress)),
+	}}}}
+	assert.True(t, emittedByContract(encoded, testContractAddress))
+
+	// Only wasm events identify the contract.
+	other := []wormconn.TendermintEvent{{Type: "execute", Attributes: []wormconn.TendermintEventAttribute{{Key: "_contract_address", Value: testContractAddress}}}}
+	assert.False(t, emittedByContract(other, testContractAddress))
+}
+
+func TestChannelMap(t *testing.T) {
+	lcd := newTestLcdServer(t)
+
+	w := NewWatcher("", lcd.URL, testContractAddress, ChainConfig{})
+	entries, err := w.ChannelMap(false)
+	require.NoError(t, err)
+	assert.Equal(t, []ChannelMapEntry{{ChannelID: "channel-0", ChainID: vaa.ChainIDSei, Monitored: false}}, entries)
+
+	w = NewWatcher("", lcd.URL, testContractAddress, ChainConfig{{ChainID: vaa.ChainIDSei}})
+	entries, err = w.ChannelMap(true)
+	require.NoError(t, err)
+	assert.Equal(t, []ChannelMapEntry{{ChannelID: "channel-0", ChainID: vaa.ChainIDSei, Monitored: true}}, entries)
+
+	// Without refresh, the cached mapping is returned.
+	lcd.Close()
+	entries, err = w.ChannelMap(false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+
+	_, err = w.ChannelMap(true)
+	assert.Error(t, err)
+}
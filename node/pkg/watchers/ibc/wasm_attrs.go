@@ -4,6 +4,9 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/tidwall/gjson"
 
@@ -56,8 +59,103 @@ func (wa *WasmAttributes) GetAsInt(key string, bitSize int) (int64, error) {
 	return value, nil
 }
 
-// Parse parses the attributes in a wasm event.
+// AttributeEncoding is the encoding of the keys and values of event attributes. Tendermint up to v0.36 base64 encodes
+// them, while CometBFT v0.37 and later emits them as they are.
+type AttributeEncoding int
+
+const (
+	// AttributeEncodingAuto detects the encoding of each event, see detectAttributeEncoding.
+	AttributeEncodingAuto AttributeEncoding = iota
+	// AttributeEncodingBase64 is the encoding of Tendermint up to v0.36.
+	AttributeEncodingBase64
+	// AttributeEncodingRaw is the encoding of CometBFT v0.37 and later.
+	AttributeEncodingRaw
+)
+
+func (e AttributeEncoding) String() string {
+	switch e {
+	case AttributeEncodingAuto:
+		return "auto"
+	case AttributeEncodingBase64:
+		return "base64"
+	case AttributeEncodingRaw:
+		return "raw"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(e))
+	}
+}
+
+// AttributeEncodingForVersion returns the attribute encoding used by the given Tendermint or CometBFT version, as
+// reported by the /status endpoint of the node. It returns an error if the version cannot be parsed.
+func AttributeEncodingForVersion(version string) (AttributeEncoding, error) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return AttributeEncodingAuto, fmt.Errorf("invalid version %q", version)
+	}
+	major, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return AttributeEncodingAuto, fmt.Errorf("invalid major version in %q: %w", version, err)
+	}
+	minor, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return AttributeEncodingAuto, fmt.Errorf("invalid minor version in %q: %w", version, err)
+	}
+
+	if major == 0 && minor < 37 {
+		return AttributeEncodingBase64, nil
+	}
+	return AttributeEncodingRaw, nil
+}
+
+// detectAttributeEncoding returns the encoding of the attributes of an event. The attributes are base64 encoded if all
+// keys and values are valid base64 and all keys decode to printable text. Raw keys of wasm events, like
+// _contract_address, are not valid base64, so the attributes of a wasm event cannot be mistaken for base64 encoded ones.
+func detectAttributeEncoding(attributes []gjson.Result) AttributeEncoding {
+	for _, attribute := range attributes {
+		keyRaw, err := base64.StdEncoding.DecodeString(attribute.Get("key").String())
+		if err != nil || len(keyRaw) == 0 || !isPrintable(keyRaw) {
+			return AttributeEncodingRaw
+		}
+		if _, err := base64.StdEncoding.DecodeString(attribute.Get("value").String()); err != nil {
+			return AttributeEncodingRaw
+		}
+	}
+	return AttributeEncodingBase64
+}
+
+// isPrintable returns whether b is valid UTF-8 text without control characters.
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeAttribute decodes an attribute key or value in the given encoding, which must not be AttributeEncodingAuto.
+func decodeAttribute(encoding AttributeEncoding, s string) (string, error) {
+	if encoding == AttributeEncodingRaw {
+		return s, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Parse parses the attributes in a wasm event, detecting their encoding.
 func (wa *WasmAttributes) Parse(logger *zap.Logger, event gjson.Result) error {
+	return wa.ParseWithEncoding(logger, event, AttributeEncodingAuto)
+}
+
+// ParseWithEncoding parses the attributes in a wasm event encoded with the given encoding. With AttributeEncodingAuto,
+// the encoding is detected from the attributes.
+func (wa *WasmAttributes) ParseWithEncoding(logger *zap.Logger, event gjson.Result, encoding AttributeEncoding) error {
 	wa.m = make(map[string]string)
 	attributes := gjson.Get(event.String(), "attributes")
 	if !attributes.Exists() {
@@ -68,26 +166,29 @@ func (wa *WasmAttributes) Parse(logger *zap.Logger, event gjson.Result) error {
 		if !attribute.IsObject() {
 			return fmt.Errorf("event attribute is invalid: %s", attribute.String())
 		}
-		keyBase := gjson.Get(attribute.String(), "key")
-		if !keyBase.Exists() {
+		if !attribute.Get("key").Exists() {
 			return fmt.Errorf("event attribute does not have a key: %s", attribute.String())
 		}
-		valueBase := gjson.Get(attribute.String(), "value")
-		if !valueBase.Exists() {
+		if !attribute.Get("value").Exists() {
 			return fmt.Errorf("event attribute does not have a value: %s", attribute.String())
 		}
-		keyRaw, err := base64.StdEncoding.DecodeString(keyBase.String())
+	}
+
+	if encoding == AttributeEncodingAuto {
+		encoding = detectAttributeEncoding(attributes.Array())
+		logger.Debug("detected event attribute encoding", zap.Stringer("encoding", encoding))
+	}
+
+	for _, attribute := range attributes.Array() {
+		key, err := decodeAttribute(encoding, attribute.Get("key").String())
 		if err != nil {
-			return fmt.Errorf("event attribute key is invalid base64: %s", attribute.String())
+			return fmt.Errorf("event attribute key is invalid %s: %s", encoding, attribute.String())
 		}
-		valueRaw, err := base64.StdEncoding.DecodeString(valueBase.String())
+		value, err := decodeAttribute(encoding, attribute.Get("value").String())
 		if err != nil {
-			return fmt.Errorf("event attribute value is invalid base64: %s", attribute.String())
+			return fmt.Errorf("event attribute value is invalid %s: %s", encoding, attribute.String())
 		}
 
-		key := string(keyRaw)
-		value := string(valueRaw)
-
 		if _, ok := wa.m[key]; ok {
 			return fmt.Errorf("duplicate key in event: %s", key)
 		}
@@ -0,0 +1,159 @@
+package ibc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// base64EventJson is a receive_publish event as emitted by Tendermint v0.34.
+const base64EventJson = `{"type": "wasm","attributes": [` +
+	`{"key": "X2NvbnRyYWN0X2FkZHJlc3M=","value": "d29ybWhvbGUxbmM1dGF0YWZ2NmV5cTdsbGtyMmd2NTBmZjllMjJtbmY3MHFnamx2NzM3a3RtdDRlc3dycTBrZGhjag==","index": true},` +
+	`{"key": "YWN0aW9u", "value": "cmVjZWl2ZV9wdWJsaXNo", "index": true},` +
+	`{"key": "Y2hhbm5lbF9pZA==", "value": "Y2hhbm5lbC0w", "index": true},` +
+	`{"key": "bWVzc2FnZS5tZXNzYWdl","value": "MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwNA==","index": true},` +
+	`{"key": "bWVzc2FnZS5zZW5kZXI=","value": "MDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMzU3NDMwNzQ5NTZjNzEwODAwZTgzMTk4MDExY2NiZDRkZGYxNTU2ZA==","index": true},` +
+	`{ "key": "bWVzc2FnZS5jaGFpbl9pZA==", "value": "MTg=", "index": true },` +
+	`{ "key": "bWVzc2FnZS5ub25jZQ==", "value": "MQ==", "index": true },` +
+	`{ "key": "bWVzc2FnZS5zZXF1ZW5jZQ==", "value": "Mg==", "index": true },` +
+	`{"key": "bWVzc2FnZS5ibG9ja190aW1l","value": "MTY4MDA5OTgxNA==","index": true},` +
+	`{"key": "bWVzc2FnZS5ibG9ja19oZWlnaHQ=","value": "MjYxMw==","index": true}` +
+	`]}`
+
+// rawEventJson is the same event as emitted by CometBFT v0.37.
+const rawEventJson = `{"type": "wasm","attributes": [` +
+	`{"key": "_contract_address","value": "wormhole1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrq0kdhcj","index": true},` +
+	`{"key": "action", "value": "receive_publish", "index": true},` +
+	`{"key": "channel_id", "value": "channel-0", "index": true},` +
+	`{"key": "message.message","value": "0000000000000000000000000000000000000000000000000000000000000004","index": true},` +
+	`{"key": "message.sender","value": "00000000000000000000000035743074956c710800e83198011ccbd4ddf1556d","index": true},` +
+	`{"key": "message.chain_id", "value": "18", "index": true },` +
+	`{"key": "message.nonce", "value": "1", "index": true },` +
+	`{"key": "message.sequence", "value": "2", "index": true },` +
+	`{"key": "message.block_time","value": "1680099814","index": true},` +
+	`{"key": "message.block_height","value": "2613","index": true}` +
+	`]}`
+
+func TestParseIbcReceivePublishEventEncodings(t *testing.T) {
+	logger := zap.NewNop()
+	contractAddress := "wormhole1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrq0kdhcj"
+	txHash, err := vaa.StringToHash("82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		eventJson string
+		encoding  AttributeEncoding
+	}{
+		{"base64", base64EventJson, AttributeEncodingBase64},
+		{"base64 detected", base64EventJson, AttributeEncodingAuto},
+		{"raw", rawEventJson, AttributeEncodingRaw},
+		{"raw detected", rawEventJson, AttributeEncodingAuto},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, gjson.Valid(tc.eventJson))
+			evt, err := parseIbcReceivePublishEvent(logger, contractAddress, gjson.Parse(tc.eventJson), txHash, tc.encoding)
+			require.NoError(t, err)
+			require.NotNil(t, evt)
+
+			assert.Equal(t, "channel-0", evt.ChannelID)
+			assert.Equal(t, vaa.ChainIDTerra2, evt.Msg.EmitterChain)
+			assert.Equal(t, uint32(1), evt.Msg.Nonce)
+			assert.Equal(t, uint64(2), evt.Msg.Sequence)
+			assert.Equal(t, time.Unix(1680099814, 0), evt.Msg.Timestamp)
+		})
+	}
+}
+
+func TestParseWithWrongEncoding(t *testing.T) {
+	var attributes WasmAttributes
+
+	// Raw attributes are not valid base64.
+	err := attributes.ParseWithEncoding(zap.NewNop(), gjson.Parse(rawEventJson), AttributeEncodingBase64)
+	assert.ErrorContains(t, err, "event attribute key is invalid base64")
+
+	// Base64 attributes parse as raw ones, but the keys are not the expected ones.
+	require.NoError(t, attributes.ParseWithEncoding(zap.NewNop(), gjson.Parse(base64EventJson), AttributeEncodingRaw))
+	_, err = attributes.GetAsString("_contract_address")
+	assert.Error(t, err)
+}
+
+func TestDetectAttributeEncoding(t *testing.T) {
+	tests := []struct {
+		attributes string
+		expected   AttributeEncoding
+	}{
+		{`[{"key": "YWN0aW9u", "value": "cmVjZWl2ZV9wdWJsaXNo"}]`, AttributeEncodingBase64},
+		{`[{"key": "YWN0aW9u", "value": ""}]`, AttributeEncodingBase64},
+		{`[{"key": "action", "value": "receive_publish"}]`, AttributeEncodingRaw},
+		// "code" is valid base64, but does not decode to text.
+		{`[{"key": "code", "value": "0000"}]`, AttributeEncodingRaw},
+		// A valid base64 key does not make the event base64 encoded if a value is not valid base64.
+		{`[{"key": "YWN0aW9u", "value": "receive_publish"}]`, AttributeEncodingRaw},
+		{`[{"key": "YWN0aW9u", "value": "cmVjZWl2ZV9wdWJsaXNo"}, {"key": "channel_id", "value": "channel-0"}]`, AttributeEncodingRaw},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, detectAttributeEncoding(gjson.Parse(tc.attributes).Array()), tc.attributes)
+	}
+}
+
+func TestAttributeEncodingForVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected AttributeEncoding
+	}{
+		{"0.34.24", AttributeEncodingBase64},
+		{"v0.34.27", AttributeEncodingBase64},
+		{"0.35.9", AttributeEncodingBase64},
+		{"0.37.2", AttributeEncodingRaw},
+		{"0.38.0-rc3", AttributeEncodingRaw},
+		{"1.0.0", AttributeEncodingRaw},
+	}
+	for _, tc := range tests {
+		encoding, err := AttributeEncodingForVersion(tc.version)
+		require.NoError(t, err, tc.version)
+		assert.Equal(t, tc.expected, encoding, tc.version)
+	}
+
+	for _, version := range []string{"", "0", "unknown", "0.x.1"} {
+		_, err := AttributeEncodingForVersion(version)
+		assert.Error(t, err, version)
+	}
+}
+
+func TestNegotiateAttributeEncoding(t *testing.T) {
+	version := "0.37.2"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc": "2.0", "id": 1, "result": {"node_info": {"version": "` + version + `"}}}`))
+	}))
+	defer server.Close()
+
+	w := NewWatcher(server.URL, "", testContractAddress, ChainConfig{})
+	w.logger = zap.NewNop()
+	assert.Equal(t, AttributeEncodingRaw, w.negotiateAttributeEncoding(context.Background()))
+
+	version = "0.34.24"
+	assert.Equal(t, AttributeEncodingBase64, w.negotiateAttributeEncoding(context.Background()))
+
+	// If the version can't be determined, the encoding is detected for each event.
+	version = "junk"
+	assert.Equal(t, AttributeEncodingAuto, w.negotiateAttributeEncoding(context.Background()))
+
+	w = NewWatcher(server.URL+"/missing", "", testContractAddress, ChainConfig{})
+	w.logger = zap.NewNop()
+	assert.Equal(t, AttributeEncodingAuto, w.negotiateAttributeEncoding(context.Background()))
+}
@@ -9,15 +9,16 @@ import (
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
-	"github.com/certusone/wormhole/node/pkg/watchers/cosmwasm"
+	"github.com/certusone/wormhole/node/pkg/wormconn"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
@@ -26,9 +27,6 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/tidwall/gjson"
 
-	"nhooyr.io/websocket"
-	"nhooyr.io/websocket/wsjson"
-
 	ethCommon "github.com/ethereum/go-ethereum/common"
 
 	"go.uber.org/zap"
@@ -73,6 +71,11 @@ var (
 			Name: "wormhole_ibc_chain_id_mismatches",
 			Help: "Total number of cases where the wormhole chain ID does not match the IBC connection ID",
 		}, []string{"ibc_channel_id"})
+	channelChainIds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_ibc_channel_chain_id",
+			Help: "Wormhole chain ID each IBC channel is mapped to by the contract on wormchain",
+		}, []string{"ibc_channel_id"})
 )
 
 type (
@@ -91,6 +94,18 @@ type (
 
 		// channelIdToChainIdLock protects channelIdToChainIdMap.
 		channelIdToChainIdLock sync.Mutex
+
+		// eventFile is the file of recorded events replayed in simulate mode. If it is empty, events are read from the websocket.
+		eventFile string
+
+		// replaySpeed controls the timing of the replay in simulate mode. See newFileEventSource.
+		replaySpeed float64
+
+		// blockPollInterval is the interval of the polling of the block results. If it is zero, events are read from the websocket.
+		blockPollInterval time.Duration
+
+		// attributeEncoding is the encoding of the event attributes, negotiated with the node on startup.
+		attributeEncoding AttributeEncoding
 	}
 
 	// chainEntry defines the data associated with a chain.
@@ -139,6 +154,7 @@ func NewWatcher(
 	Features = features
 
 	return &Watcher{
+		logger:                zap.NewNop(),
 		wsUrl:                 wsUrl,
 		lcdUrl:                lcdUrl,
 		contractAddress:       contractAddress,
@@ -147,16 +163,27 @@ func NewWatcher(
 	}
 }
 
-// clientRequest is used to subscribe for events from the contract.
-type clientRequest struct {
-	JSONRPC string `json:"jsonrpc"`
-	// A String containing the name of the method to be invoked.
-	Method string `json:"method"`
-	// Object to pass as request parameter to the method.
-	Params [1]string `json:"params"`
-	// The request id. This can be of any type. It is used to match the
-	// response with the request that it is replying to.
-	ID uint64 `json:"id"`
+// NewSimulatedWatcher creates an IBC contract watcher that replays the tendermint events recorded in eventFile rather than
+// subscribing to the wormchain websocket. Everything else, including the channel ID queries, still uses the LCD endpoint.
+// This is intended for integration testing.
+func NewSimulatedWatcher(
+	eventFile string,
+	replaySpeed float64,
+	lcdUrl string,
+	contractAddress string,
+	chainConfig ChainConfig,
+) *Watcher {
+	w := NewWatcher("", lcdUrl, contractAddress, chainConfig)
+	w.eventFile = eventFile
+	w.replaySpeed = replaySpeed
+	return w
+}
+
+// SetBlockResultsPolling makes the watcher poll the results of each new block every interval rather than subscribing to
+// the wormchain websocket, for environments where the subscribe endpoint is disabled or proxied away. The websocket URL
+// of the watcher may then be the HTTP URL of the tendermint RPC. An interval of zero selects the websocket.
+func (w *Watcher) SetBlockResultsPolling(interval time.Duration) {
+	w.blockPollInterval = interval
 }
 
 // ibcReceivePublishEvent represents the log message received from the IBC receiver contract.
@@ -182,48 +209,38 @@ func (w *Watcher) Run(ctx context.Context) error {
 		p2p.DefaultRegistry.SetNetworkStats(ce.chainID, &gossipv1.Heartbeat_Network{ContractAddress: w.contractAddress})
 	}
 
-	c, _, err := websocket.Dial(ctx, w.wsUrl, nil)
-	if err != nil {
-		ibcErrors.WithLabelValues("websocket_dial_error").Inc()
-		return fmt.Errorf("failed to establish tendermint websocket connection: %w", err)
+	// Query the channel mapping up front so it shows up in the metrics and the admin RPC. It is queried again whenever a message
+	// arrives on an unknown channel.
+	if _, err := w.ChannelMap(true); err != nil {
+		w.logger.Warn("failed to query IBC channel ID mapping, will retry when needed", zap.Error(err))
 	}
-	defer c.Close(websocket.StatusNormalClosure, "")
 
-	c.SetReadLimit(cosmwasm.ReadLimitSize)
-
-	// Subscribe to smart contract transactions.
-	params := [...]string{fmt.Sprintf("tm.event='Tx' AND wasm._contract_address='%s'", w.contractAddress)}
-	command := &clientRequest{
-		JSONRPC: "2.0",
-		Method:  "subscribe",
-		Params:  params,
-		ID:      1,
+	var src eventSource
+	var err error
+	if w.eventFile != "" {
+		w.logger.Info("replaying IBC events from file", zap.String("eventFile", w.eventFile), zap.Float64("replaySpeed", w.replaySpeed))
+		src, err = newFileEventSource(w.eventFile, w.replaySpeed)
+	} else if w.blockPollInterval > 0 {
+		w.logger.Info("polling IBC events from block results", zap.Duration("pollInterval", w.blockPollInterval))
+		w.attributeEncoding = w.negotiateAttributeEncoding(ctx)
+		src, err = newBlockResultsEventSource(ctx, w.wsUrl, w.contractAddress, w.blockPollInterval)
+	} else {
+		w.attributeEncoding = w.negotiateAttributeEncoding(ctx)
+		src, err = newWebsocketEventSource(ctx, w.wsUrl, w.contractAddress)
 	}
-	err = wsjson.Write(ctx, c, command)
 	if err != nil {
-		ibcErrors.WithLabelValues("websocket_subscription_error").Inc()
-		return fmt.Errorf("failed to subscribe to events: %w", err)
-	}
-
-	// Wait for the success response.
-	_, subResp, err := c.Read(ctx)
-	if err != nil {
-		ibcErrors.WithLabelValues("websocket_subscription_error").Inc()
-		return fmt.Errorf("failed to receive response to subscribe request: %w", err)
-	}
-	if strings.Contains(string(subResp), "error") {
-		ibcErrors.WithLabelValues("websocket_subscription_error").Inc()
-		return fmt.Errorf("failed to subscribe to events, response: %s", string(subResp))
+		return err
 	}
+	defer src.Close()
 
 	// Start a routine to listen for messages from the contract.
 	common.RunWithScissors(ctx, errC, "ibc_data_pump", func(ctx context.Context) error {
-		return w.handleEvents(ctx, c)
+		return w.handleEvents(ctx, src)
 	})
 
 	// Start a routine to periodically query the wormchain block height.
 	common.RunWithScissors(ctx, errC, "ibc_block_height", func(ctx context.Context) error {
-		return w.handleQueryBlockHeight(ctx, c)
+		return w.handleQueryBlockHeight(ctx)
 	})
 
 	// Start a routine for each chain to listen for observation requests.
@@ -245,14 +262,17 @@ func (w *Watcher) Run(ctx context.Context) error {
 }
 
 // handleEvents reads messages from the IBC receiver contract and processes them.
-func (w *Watcher) handleEvents(ctx context.Context, c *websocket.Conn) error {
+func (w *Watcher) handleEvents(ctx context.Context, src eventSource) error {
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
 		default:
-			_, message, err := c.Read(ctx)
+			message, err := src.ReadEvent(ctx)
 			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
 				w.logger.Error("failed to read socket", zap.Error(err))
 				ibcErrors.WithLabelValues("channel_read_error").Inc()
 				return fmt.Errorf("failed to read socket: %w", err)
@@ -285,7 +305,7 @@ func (w *Watcher) handleEvents(ctx context.Context, c *websocket.Conn) error {
 				}
 				eventType := gjson.Get(event.String(), "type").String()
 				if eventType == "wasm" {
-					evt, err := parseIbcReceivePublishEvent(w.logger, w.contractAddress, event, txHash)
+					evt, err := parseIbcReceivePublishEvent(w.logger, w.contractAddress, event, txHash, w.attributeEncoding)
 					if err != nil {
 						w.logger.Error("failed to parse wasm event", zap.Error(err), zap.String("event", event.String()))
 						continue
@@ -304,13 +324,40 @@ func (w *Watcher) handleEvents(ctx context.Context, c *websocket.Conn) error {
 	}
 }
 
+// negotiateAttributeEncoding determines the attribute encoding from the version of the node. If the version cannot be
+// determined, the encoding is detected for each event instead.
+func (w *Watcher) negotiateAttributeEncoding(ctx context.Context) AttributeEncoding {
+	client, err := wormconn.NewTendermintClient(w.wsUrl, &http.Client{Timeout: 5 * time.Second})
+	if err != nil {
+		w.logger.Warn("failed to create tendermint client, will detect the attribute encoding of each event", zap.Error(err))
+		return AttributeEncodingAuto
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		ibcErrors.WithLabelValues("query_node_version_error").Inc()
+		w.logger.Warn("failed to query the node version, will detect the attribute encoding of each event", zap.Error(err))
+		return AttributeEncodingAuto
+	}
+
+	encoding, err := AttributeEncodingForVersion(status.NodeInfo.Version)
+	if err != nil {
+		w.logger.Warn("failed to parse the node version, will detect the attribute encoding of each event", zap.Error(err))
+		return AttributeEncodingAuto
+	}
+
+	w.logger.Info("negotiated event attribute encoding", zap.String("version", status.NodeInfo.Version), zap.Stringer("encoding", encoding))
+	return encoding
+}
+
 // handleQueryBlockHeight gets the latest block height from wormchain each interval and updates the status on all the connected chains.
-func (w *Watcher) handleQueryBlockHeight(ctx context.Context, c *websocket.Conn) error {
+func (w *Watcher) handleQueryBlockHeight(ctx context.Context) error {
 	const latestBlockURL = "blocks/latest"
 
 	t := time.NewTicker(5 * time.Second)
 	client := &http.Client{
-		Timeout: time.Second * 5,
+		Timeout:   time.Second * 5,
+		Transport: ratelimit.DefaultRegistry.Transport(w.lcdUrl, nil),
 	}
 
 	for {
@@ -340,6 +387,7 @@ func (w *Watcher) handleQueryBlockHeight(ctx context.Context, c *websocket.Conn)
 					Height:          latestBlockAsInt,
 					ContractAddress: w.contractAddress,
 				})
+				p2p.DefaultRegistry.SetNetworkHeights(ce.chainID, p2p.InstantFinalityHeights(latestBlockAsInt))
 
 				readiness.SetReady(ce.readiness)
 			}
@@ -365,7 +413,8 @@ func (w *Watcher) handleObservationRequests(ctx context.Context, ce *chainEntry)
 			w.logger.Info("received observation request", zap.String("chain", ce.chainName), zap.String("txHash", reqTxHashStr))
 
 			client := &http.Client{
-				Timeout: time.Second * 5,
+				Timeout:   time.Second * 5,
+				Transport: ratelimit.DefaultRegistry.Transport(w.lcdUrl, nil),
 			}
 
 			// Query for tx by hash.
@@ -409,7 +458,7 @@ func (w *Watcher) handleObservationRequests(ctx context.Context, ce *chainEntry)
 				eventType := gjson.Get(event.String(), "type")
 				if eventType.String() == "wasm" {
 					w.logger.Debug("found wasm event in reobservation", zap.String("chain", ce.chainName), zap.Stringer("txHash", txHash))
-					evt, err := parseIbcReceivePublishEvent(w.logger, w.contractAddress, event, txHash)
+					evt, err := parseIbcReceivePublishEvent(w.logger, w.contractAddress, event, txHash, w.attributeEncoding)
 					if err != nil {
 						w.logger.Error("failed to parse wasm event", zap.String("chain", ce.chainName), zap.Error(err), zap.Any("event", event))
 						continue
@@ -431,9 +480,9 @@ func (w *Watcher) handleObservationRequests(ctx context.Context, ce *chainEntry)
 // parseIbcReceivePublishEvent parses a wasm event into an object. Since the watcher only subscribes to events from a single contract, this function returns an error
 // if the contract does not match the desired one. However, since the contract publishes multiple action types, this function returns nil rather than an error
 // if the event is not for the desired action.
-func parseIbcReceivePublishEvent(logger *zap.Logger, desiredContract string, event gjson.Result, txHash ethCommon.Hash) (*ibcReceivePublishEvent, error) {
+func parseIbcReceivePublishEvent(logger *zap.Logger, desiredContract string, event gjson.Result, txHash ethCommon.Hash, encoding AttributeEncoding) (*ibcReceivePublishEvent, error) {
 	var attributes WasmAttributes
-	err := attributes.Parse(logger, event)
+	err := attributes.ParseWithEncoding(logger, event, encoding)
 	if err != nil {
 		logger.Error("failed to parse event attributes", zap.Error(err), zap.String("event", event.String()))
 		return nil, fmt.Errorf("failed to parse attributes: %w", err)
@@ -503,6 +552,16 @@ func parseIbcReceivePublishEvent(logger *zap.Logger, desiredContract string, eve
 		return evt, fmt.Errorf("failed to parse message.message attribute %s: %w", str, err)
 	}
 
+	// The consistency level is optional, since older versions of the contract do not publish it. Without it, the
+	// message is considered final, which is the case for chains with instant finality.
+	if _, exists := attributes.m["message.consistency_level"]; exists {
+		unumber, err = attributes.GetAsUint("message.consistency_level", 8)
+		if err != nil {
+			return evt, err
+		}
+		evt.Msg.ConsistencyLevel = uint8(unumber)
+	}
+
 	return evt, nil
 }
 
@@ -612,7 +671,7 @@ func (w *Watcher) getChainIdFromChannelID(channelID string) (vaa.ChainID, error)
 		return vaa.ChainIDUnset, err
 	}
 
-	w.channelIdToChainIdMap = channelIdToChainIdMap
+	w.setChannelIdToChainIdMap(channelIdToChainIdMap)
 
 	chainID, exists = w.channelIdToChainIdMap[channelID]
 	if exists {
@@ -622,6 +681,61 @@ func (w *Watcher) getChainIdFromChannelID(channelID string) (vaa.ChainID, error)
 	return vaa.ChainIDUnset, nil
 }
 
+// ChainForChannel returns the chain an IBC channel of wormchain is mapped to. It is used by the wormchain watcher to
+// determine the source chain of Gateway transfers.
+func (w *Watcher) ChainForChannel(channelID string) (vaa.ChainID, bool) {
+	chainID, err := w.getChainIdFromChannelID(channelID)
+	if err != nil || chainID == vaa.ChainIDUnset {
+		return vaa.ChainIDUnset, false
+	}
+	return chainID, true
+}
+
+// setChannelIdToChainIdMap replaces the cached channel ID to chain ID mapping and updates the metrics. It assumes the caller holds the lock.
+func (w *Watcher) setChannelIdToChainIdMap(channelIdToChainIdMap map[string]vaa.ChainID) {
+	w.channelIdToChainIdMap = channelIdToChainIdMap
+
+	channelChainIds.Reset()
+	for channelID, chainID := range channelIdToChainIdMap {
+		channelChainIds.WithLabelValues(channelID).Set(float64(chainID))
+	}
+}
+
+// ChannelMapEntry describes the chain an IBC channel is mapped to.
+type ChannelMapEntry struct {
+	ChannelID string
+	ChainID   vaa.ChainID
+	// Monitored is true if the chain is enabled in the watcher. Messages from channels of chains that are not monitored are dropped.
+	Monitored bool
+}
+
+// ChannelMap returns the channel ID to chain ID mapping currently used by the watcher, sorted by channel ID. If refresh is set, or if
+// the mapping has not been queried yet, it is first queried from the contract on wormchain.
+func (w *Watcher) ChannelMap(refresh bool) ([]ChannelMapEntry, error) {
+	w.channelIdToChainIdLock.Lock()
+	defer w.channelIdToChainIdLock.Unlock()
+
+	if refresh || len(w.channelIdToChainIdMap) == 0 {
+		channelIdToChainIdMap, err := w.queryChannelIdToChainIdMapping()
+		if err != nil {
+			return nil, err
+		}
+		w.setChannelIdToChainIdMap(channelIdToChainIdMap)
+	}
+
+	ret := make([]ChannelMapEntry, 0, len(w.channelIdToChainIdMap))
+	for channelID, chainID := range w.channelIdToChainIdMap {
+		_, monitored := w.chainMap[chainID]
+		ret = append(ret, ChannelMapEntry{ChannelID: channelID, ChainID: chainID, Monitored: monitored})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ChannelID < ret[j].ChannelID
+	})
+
+	return ret, nil
+}
+
 /*
 This query:
 `"all_channel_chains"` is `ImFsbF9jaGFubmVsX2NoYWlucyI=`
@@ -653,7 +767,8 @@ var allChannelChainsQuery = url.QueryEscape(base64.StdEncoding.EncodeToString([]
 // queryChannelIdToChainIdMapping queries the contract for the set of IBC channels and their correspond chain IDs.
 func (w *Watcher) queryChannelIdToChainIdMapping() (map[string]vaa.ChainID, error) {
 	client := &http.Client{
-		Timeout: time.Second * 5,
+		Timeout:   time.Second * 5,
+		Transport: ratelimit.DefaultRegistry.Transport(w.lcdUrl, nil),
 	}
 
 	query := fmt.Sprintf(`%s/cosmwasm/wasm/v1/contract/%s/smart/%s`, w.lcdUrl, w.contractAddress, allChannelChainsQuery)
@@ -41,7 +41,7 @@ func TestParseIbcReceivePublishEvent(t *testing.T) {
 	txHash, err := vaa.StringToHash("82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b")
 	require.NoError(t, err)
 
-	evt, err := parseIbcReceivePublishEvent(logger, contractAddress, event, txHash)
+	evt, err := parseIbcReceivePublishEvent(logger, contractAddress, event, txHash, AttributeEncodingBase64)
 	require.NoError(t, err)
 	require.NotNil(t, evt)
 
@@ -91,7 +91,7 @@ func TestParseEventForWrongContract(t *testing.T) {
 	txHash, err := vaa.StringToHash("82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b")
 	require.NoError(t, err)
 
-	_, err = parseIbcReceivePublishEvent(logger, contractAddress, event, txHash)
+	_, err = parseIbcReceivePublishEvent(logger, contractAddress, event, txHash, AttributeEncodingBase64)
 	assert.Error(t, err)
 }
 
@@ -119,7 +119,7 @@ func TestParseEventForWrongAction(t *testing.T) {
 	txHash, err := vaa.StringToHash("82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b")
 	require.NoError(t, err)
 
-	evt, err := parseIbcReceivePublishEvent(logger, contractAddress, event, txHash)
+	evt, err := parseIbcReceivePublishEvent(logger, contractAddress, event, txHash, AttributeEncodingBase64)
 	require.NoError(t, err)
 	assert.Nil(t, evt)
 }
@@ -148,7 +148,7 @@ func TestParseEventForNoContractSpecified(t *testing.T) {
 	txHash, err := vaa.StringToHash("82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b")
 	require.NoError(t, err)
 
-	_, err = parseIbcReceivePublishEvent(logger, contractAddress, event, txHash)
+	_, err = parseIbcReceivePublishEvent(logger, contractAddress, event, txHash, AttributeEncodingBase64)
 	assert.Error(t, err)
 }
 
@@ -176,11 +176,45 @@ func TestParseEventForNoActionSpecified(t *testing.T) {
 	txHash, err := vaa.StringToHash("82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b")
 	require.NoError(t, err)
 
-	evt, err := parseIbcReceivePublishEvent(logger, contractAddress, event, txHash)
+	evt, err := parseIbcReceivePublishEvent(logger, contractAddress, event, txHash, AttributeEncodingBase64)
 	require.NoError(t, err)
 	assert.Nil(t, evt)
 }
 
+func TestParseIbcReceivePublishEventConsistencyLevel(t *testing.T) {
+	logger := zap.NewNop()
+	contractAddress := "wormhole1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrq0kdhcj"
+	txHash, err := vaa.StringToHash("82ea2536c5d1671830cb49120f94479e34b54596a8dd369fbc2666667a765f4b")
+	require.NoError(t, err)
+
+	eventJson := func(consistencyLevel string) string {
+		return `{"type": "wasm","attributes": [` +
+			`{"key": "_contract_address", "value": "` + contractAddress + `"},` +
+			`{"key": "action", "value": "receive_publish"},` +
+			`{"key": "channel_id", "value": "channel-0"},` +
+			`{"key": "message.message", "value": "0004"},` +
+			`{"key": "message.sender", "value": "00000000000000000000000035743074956c710800e83198011ccbd4ddf1556d"},` +
+			`{"key": "message.chain_id", "value": "18"},` +
+			`{"key": "message.nonce", "value": "1"},` +
+			`{"key": "message.sequence", "value": "2"},` +
+			`{"key": "message.block_time", "value": "1680099814"}` +
+			consistencyLevel +
+			`]}`
+	}
+
+	// Without the attribute, the message is considered final.
+	evt, err := parseIbcReceivePublishEvent(logger, contractAddress, gjson.Parse(eventJson("")), txHash, AttributeEncodingRaw)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0), evt.Msg.ConsistencyLevel)
+
+	evt, err = parseIbcReceivePublishEvent(logger, contractAddress, gjson.Parse(eventJson(`,{"key": "message.consistency_level", "value": "15"}`)), txHash, AttributeEncodingRaw)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(15), evt.Msg.ConsistencyLevel)
+
+	_, err = parseIbcReceivePublishEvent(logger, contractAddress, gjson.Parse(eventJson(`,{"key": "message.consistency_level", "value": "256"}`)), txHash, AttributeEncodingRaw)
+	assert.Error(t, err)
+}
+
 func TestParseIbcAllChannelChainsQueryResults(t *testing.T) {
 	respJson := []byte(`
 	{
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/mr-tron/base58"
 )
 
@@ -42,6 +43,8 @@ type (
 		GetFinalBlock(ctx context.Context) (Block, error)
 		GetChunk(ctx context.Context, chunkHeader ChunkHeader) (Chunk, error)
 		GetTxStatus(ctx context.Context, txHash string, senderAccountId string) ([]byte, error)
+		GetReceipt(ctx context.Context, receiptId string) ([]byte, error)
+		GetReceiptOutcome(ctx context.Context, receiptId string, receiverId string, lightClientHead string) ([]byte, error)
 	}
 	NearApiImpl struct {
 		nearRPC NearRpc
@@ -55,7 +58,7 @@ func NewHttpNearRpc(nearRPC string) HttpNearRpc {
 	t.MaxIdleConnsPerHost = nearRPCConcurrentConnections
 	var httpClient = &http.Client{
 		Timeout:   nearRPCTimeout,
-		Transport: t,
+		Transport: ratelimit.DefaultRegistry.Transport(nearRPC, t),
 	}
 
 	return HttpNearRpc{nearRPC, httpClient}
@@ -174,6 +177,21 @@ func (n NearApiImpl) GetTxStatus(ctx context.Context, txHash string, senderAccou
 	return n.nearRPC.Query(ctx, s)
 }
 
+// GetReceipt queries a receipt by its ID, returning its receiver_id and predecessor_id.
+// See https://docs.near.org/api/rpc/transactions#receipt-by-id
+func (n NearApiImpl) GetReceipt(ctx context.Context, receiptId string) ([]byte, error) {
+	s := fmt.Sprintf(`{"id": "dontcare", "jsonrpc": "2.0", "method": "EXPERIMENTAL_receipt", "params": {"receipt_id": "%s"}}`, receiptId)
+	return n.nearRPC.Query(ctx, s)
+}
+
+// GetReceiptOutcome queries the execution outcome of a receipt, returning the outcome and the hash of the block it was
+// executed in as result.outcome_proof. lightClientHead must be the hash of a block following that block.
+// See https://docs.near.org/api/rpc/setup#light-client-proof
+func (n NearApiImpl) GetReceiptOutcome(ctx context.Context, receiptId string, receiverId string, lightClientHead string) ([]byte, error) {
+	s := fmt.Sprintf(`{"id": "dontcare", "jsonrpc": "2.0", "method": "EXPERIMENTAL_light_client_proof", "params": {"type": "receipt", "receipt_id": "%s", "receiver_id": "%s", "light_client_head": "%s"}}`, receiptId, receiverId, lightClientHead)
+	return n.nearRPC.Query(ctx, s)
+}
+
 func IsWellFormedHash(hash string) error {
 	hashBytes, err := base58.Decode(hash)
 	if err != nil {
@@ -44,6 +44,7 @@ func TestNewChunkFromBytes(t *testing.T) {
 
 	assert.Equal(t, c.Transactions()[1].Hash, "Ghke9UK93vhqVburswfd7fvYk5PZ3xNvY9xP6PvufN9U")
 	assert.Equal(t, c.Transactions()[1].SignerId, "65ca40c4de59b439db917daf9f527f605b448b3f5c6d5777d0b83a78e8dcf062")
+	assert.Equal(t, c.Transactions()[1].ReceiverId, "XXXa40c4de59b439db917daf9f527f605b448b3f5c6d5777d0b83a78e8dcf062")
 }
 
 func TestNearApi(t *testing.T) {
@@ -98,12 +99,12 @@ func TestNearApi(t *testing.T) {
 	txs := c.Transactions()
 
 	expectedTxs := []nearapi.Transaction{
-		{Hash: "AFtsvPoA4zRhHY2LrD2VmFzcgWCBm351oZkHbq7D6EdH", SignerId: "1ce2567f7f49cb34cea72179223ec7fc4ba91077da3e01364d0c729dfbe26467"},
-		{Hash: "2rqzjwSCRGuSgHi2xSaZ9acXJ7XDUzzZFLJCEwY2cErf", SignerId: "app.nearcrowd.near"},
-		{Hash: "HLLcwgFb5cNKULGu2Vjb6qFqrgmFvEDfNBVbthNh552M", SignerId: "51491d5937b8e39fa2a38b8e87673ebe678c7d7f0530e1ab11579cd8d2ee185d"},
-		{Hash: "Ed5ecLLSjiXYoC7P4kZj5FtR3YDnEyyLyeXZWFxbC9z4", SignerId: "a5fa6df6a016af406bd62d4d4f1d6de2b6a678603d1f32dc39689d8693301ec4"},
-		{Hash: "C3vgRrTEGrB4cRszRhbyWyVfGyqJusH9GMmLeLipT3n6", SignerId: "32321118014239e6400ff018eef8f593aacbe93b7bc6f595ca02d2a791a2f440"},
-		{Hash: "8FWJcU2ownJ7VSh61fcTdK6P3PdcDMKR3faEkWgMQCuy", SignerId: "1488.near"},
+		{Hash: "AFtsvPoA4zRhHY2LrD2VmFzcgWCBm351oZkHbq7D6EdH", SignerId: "1ce2567f7f49cb34cea72179223ec7fc4ba91077da3e01364d0c729dfbe26467", ReceiverId: "house_nearcrash.near"},
+		{Hash: "2rqzjwSCRGuSgHi2xSaZ9acXJ7XDUzzZFLJCEwY2cErf", SignerId: "app.nearcrowd.near", ReceiverId: "app.nearcrowd.near"},
+		{Hash: "HLLcwgFb5cNKULGu2Vjb6qFqrgmFvEDfNBVbthNh552M", SignerId: "51491d5937b8e39fa2a38b8e87673ebe678c7d7f0530e1ab11579cd8d2ee185d", ReceiverId: "token.sweat"},
+		{Hash: "Ed5ecLLSjiXYoC7P4kZj5FtR3YDnEyyLyeXZWFxbC9z4", SignerId: "a5fa6df6a016af406bd62d4d4f1d6de2b6a678603d1f32dc39689d8693301ec4", ReceiverId: "token.sweat"},
+		{Hash: "C3vgRrTEGrB4cRszRhbyWyVfGyqJusH9GMmLeLipT3n6", SignerId: "32321118014239e6400ff018eef8f593aacbe93b7bc6f595ca02d2a791a2f440", ReceiverId: "31e2875cc705591e12b5a43c0bfd59df395c8656851fffa9ecb50b8cba6ba140"},
+		{Hash: "8FWJcU2ownJ7VSh61fcTdK6P3PdcDMKR3faEkWgMQCuy", SignerId: "1488.near", ReceiverId: "app.nearcrowd.near"},
 	}
 	assert.Equal(t, txs, expectedTxs)
 }
@@ -32,8 +32,9 @@ type (
 	}
 
 	Transaction struct {
-		Hash     string
-		SignerId string
+		Hash       string
+		SignerId   string
+		ReceiverId string
 	}
 )
 
@@ -125,7 +126,7 @@ func (c Chunk) Transactions() []Transaction {
 		if !hash.Exists() || !signer_id.Exists() {
 			continue
 		}
-		result = append(result, Transaction{hash.String(), signer_id.String()})
+		result = append(result, Transaction{hash.String(), signer_id.String(), r.Get("receiver_id").String()})
 	}
 	return result
 }
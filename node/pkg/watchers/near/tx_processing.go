@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -44,6 +45,10 @@ func (e *Watcher) processTx(logger *zap.Logger, ctx context.Context, job *transa
 	receiptOutcomes := gjson.ParseBytes(tx_receipts).Get("result.receipts_outcome")
 
 	if !receiptOutcomes.Exists() {
+		if job.mayBeReceiptId {
+			// The hash of an observation request may identify the receipt which emitted the message instead.
+			return e.processReceipt(logger, ctx, job)
+		}
 		// no outcomes means nothing to look at
 		logger.Debug("processTx: No receipt outcomes", zap.String("tx_hash", job.txHash))
 		return nil
@@ -58,6 +63,127 @@ func (e *Watcher) processTx(logger *zap.Logger, ctx context.Context, job *transa
 	return nil
 }
 
+// processReceipt handles an observation request identified by the ID of the receipt which emitted the message rather
+// than by a transaction hash. It resolves the transaction the receipt originates from and processes that transaction,
+// so that the observations have the same transaction hash as the ones of the regular flow.
+func (e *Watcher) processReceipt(logger *zap.Logger, ctx context.Context, job *transactionProcessingJob) error {
+	receipt, err := e.nearAPI.GetReceipt(ctx, job.txHash)
+	if err != nil {
+		return err
+	}
+
+	receiverId := gjson.ParseBytes(receipt).Get("result.receiver_id")
+	if !receiverId.Exists() {
+		// neither a transaction nor a receipt
+		logger.Debug("processReceipt: Unknown transaction or receipt", zap.String("tx_hash", job.txHash))
+		return nil
+	}
+
+	// Only receipts executed by the Wormhole core contract can emit messages.
+	// processOutcome() checks the executor_id of the outcome again.
+	if receiverId.String() != e.wormholeAccount {
+		logger.Debug("processReceipt: Receipt not executed by the Wormhole contract", zap.String("tx_hash", job.txHash), zap.String("receiver_id", receiverId.String()))
+		return nil
+	}
+
+	// The outcome is proven against a light client head, which has to follow the block the receipt was executed in.
+	head, err := e.nearAPI.GetFinalBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	proof, err := e.nearAPI.GetReceiptOutcome(ctx, job.txHash, receiverId.String(), head.Header.Hash)
+	if err != nil {
+		return err
+	}
+
+	outcomeProof := gjson.ParseBytes(proof).Get("result.outcome_proof")
+	if !outcomeProof.Exists() {
+		// the receipt has most likely not been executed in a final block yet
+		return errors.New("receipt outcome not available")
+	}
+
+	// SECURITY defense-in-depth: check that the outcome belongs to the queried receipt.
+	if outcomeProof.Get("id").String() != job.txHash {
+		logger.Warn("NEAR RPC malformed response: outcome_proof.id does not match the receipt ID", zap.String("error_type", "nearapi_inconsistent"), zap.String("tx_hash", job.txHash))
+		return errors.New("NEAR RPC malformed response: outcome_proof.id does not match the receipt ID")
+	}
+
+	outcomeBlockHash := outcomeProof.Get("block_hash").String()
+	if _, isFinalized := e.finalizer.isFinalized(logger, ctx, outcomeBlockHash); !isFinalized {
+		return errors.New("block not finalized yet")
+	}
+
+	tx, err := e.findReceiptTransaction(ctx, job, gjson.ParseBytes(receipt).Get("result.predecessor_id").String(), outcomeBlockHash)
+	if errors.Is(err, errReceiptTransactionNotFound) {
+		// Retrying would only repeat the same search.
+		logger.Warn("transaction of re-observed receipt not found, dropping the request", zap.String("receipt_id", job.txHash), zap.Error(err))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Info("resolved the transaction of a re-observed receipt", zap.String("receipt_id", job.txHash), zap.String("tx_hash", tx.Hash))
+
+	// Retries of the job process the transaction directly.
+	job.txHash = tx.Hash
+	job.senderAccountId = tx.SignerId
+	job.mayBeReceiptId = false
+	return e.processTx(logger, ctx, job)
+}
+
+// errReceiptTransactionNotFound is returned by findReceiptTransaction once the search is exhausted.
+var errReceiptTransactionNotFound = errors.New("transaction of receipt not found")
+
+// findReceiptTransaction returns the transaction the receipt of job originates from. The RPC API cannot map a receipt
+// to its transaction, so the transactions of the block the receipt was executed in and of up to
+// maxReceiptTransactionBlocks blocks before it are searched for one whose receipt outcomes include the receipt. Only
+// the transactions sent by or to the predecessor of the receipt are looked up, and at most maxReceiptTransactionLookups
+// of them over all the attempts of the job, since observation requests are not trusted.
+func (e *Watcher) findReceiptTransaction(ctx context.Context, job *transactionProcessingJob, predecessorId string, blockHash string) (nearapi.Transaction, error) {
+	for i := 0; i <= maxReceiptTransactionBlocks; i++ {
+		block, err := e.nearAPI.GetBlock(ctx, blockHash)
+		if err != nil {
+			return nearapi.Transaction{}, err
+		}
+
+		for _, chunkHeader := range block.ChunkHashes() {
+			chunk, err := e.nearAPI.GetChunk(ctx, chunkHeader)
+			if err != nil {
+				return nearapi.Transaction{}, err
+			}
+			for _, tx := range chunk.Transactions() {
+				if tx.SignerId != predecessorId && tx.ReceiverId != predecessorId {
+					continue
+				}
+
+				if job.receiptTxLookups >= maxReceiptTransactionLookups {
+					return nearapi.Transaction{}, fmt.Errorf("%w: %s, gave up after %d transactions", errReceiptTransactionNotFound, job.txHash, job.receiptTxLookups)
+				}
+				job.receiptTxLookups++
+
+				txStatus, err := e.nearAPI.GetTxStatus(ctx, tx.Hash, tx.SignerId)
+				if err != nil {
+					return nearapi.Transaction{}, err
+				}
+				for _, id := range gjson.ParseBytes(txStatus).Get("result.receipts_outcome.#.id").Array() {
+					if id.String() == job.txHash {
+						return tx, nil
+					}
+				}
+			}
+		}
+
+		if block.Header.PrevBlockHash == "" {
+			break
+		}
+		blockHash = block.Header.PrevBlockHash
+	}
+
+	return nearapi.Transaction{}, fmt.Errorf("%w: %s", errReceiptTransactionNotFound, job.txHash)
+}
+
 func (e *Watcher) processOutcome(logger *zap.Logger, ctx context.Context, job *transactionProcessingJob, receiptOutcome gjson.Result) error {
 	outcome := receiptOutcome.Get("outcome")
 	if !outcome.Exists() {
@@ -47,6 +47,14 @@ var (
 	// lower values yields better performance, but can lead to missed observations if NEAR has larger gaps.
 	// During testing, gaps on NEAR were at most 1 block long.
 	nearBlockchainMaxGaps = 5
+
+	// how many blocks before the block a receipt was executed in are searched for its transaction when re-observing a
+	// receipt. A receipt is typically executed one or two blocks after its transaction, or later for cross-contract calls.
+	maxReceiptTransactionBlocks = 10
+
+	// how many transactions are looked up at most when searching the transaction of a re-observed receipt, across all
+	// attempts of the job. Observation requests are not trusted, so the search must not be able to exhaust the RPC node.
+	maxReceiptTransactionLookups = 20
 )
 
 type (
@@ -56,6 +64,10 @@ type (
 		creationTime    time.Time
 		retryCounter    uint
 		delay           time.Duration
+		// set for observation requests, whose hash may be the ID of the receipt emitting the message instead of a transaction hash
+		mayBeReceiptId bool
+		// number of transactions looked up so far while searching the transaction of the receipt
+		receiptTxLookups int
 
 		// set during processing
 		hasWormholeMsg bool // set during processing; whether this transaction emitted a Wormhole message
@@ -119,6 +131,8 @@ func newTransactionProcessingJob(txHash string, senderAccountId string) *transac
 		0,
 		initialTxProcDelay,
 		false,
+		0,
+		false,
 	}
 }
 
@@ -151,6 +165,8 @@ func (e *Watcher) runBlockPoll(ctx context.Context) error {
 				Height:          int64(highestFinalBlockHeightObserved),
 				ContractAddress: e.wormholeAccount,
 			})
+			// The watcher only follows the final blocks.
+			p2p.DefaultRegistry.SetNetworkHeights(vaa.ChainIDNear, p2p.NetworkHeights{Finalized: int64(highestFinalBlockHeightObserved)})
 			readiness.SetReady(e.readinessSync)
 
 			timer.Reset(blockPollInterval)
@@ -201,6 +217,7 @@ func (e *Watcher) runObsvReqProcessor(ctx context.Context) error {
 			// Guardians currently run nodes for all shards and the API seems to be returning the correct results independent of the set senderAccountId but this could change in the future.
 			// Fixing this would require adding the transaction sender account ID to the observation request.
 			job := newTransactionProcessingJob(txHash, e.wormholeAccount)
+			job.mayBeReceiptId = true
 			e.schedule(ctx, job, time.Nanosecond)
 		}
 	}
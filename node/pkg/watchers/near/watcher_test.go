@@ -4,17 +4,23 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers/near/nearapi"
 	mockserver "github.com/certusone/wormhole/node/pkg/watchers/near/nearapi/mock"
 	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/mr-tron/base58"
 	"github.com/test-go/testify/assert"
+	"github.com/test-go/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 )
@@ -594,3 +600,152 @@ func TestSuccessValueToInt(t *testing.T) {
 		})
 	}
 }
+
+// receiptTestApi serves a single Wormhole receipt, which is not a transaction, and the blocks leading to it.
+type receiptTestApi struct {
+	nearapi.NearApi
+	receiptId string
+	outcome   string
+	blocks    map[string]string
+	chunks    map[string]string
+	txs       map[string]string
+	// txStatusCalls counts the calls of GetTxStatus, if not nil.
+	txStatusCalls *int
+}
+
+func (a receiptTestApi) GetTxStatus(ctx context.Context, txHash string, senderAccountId string) ([]byte, error) {
+	if a.txStatusCalls != nil {
+		*a.txStatusCalls++
+	}
+	if tx, exists := a.txs[txHash]; exists {
+		return []byte(tx), nil
+	}
+	return []byte(`{"jsonrpc": "2.0", "error": {"name": "HANDLER_ERROR", "cause": {"name": "UNKNOWN_TRANSACTION"}}, "id": "dontcare"}`), nil
+}
+
+func (a receiptTestApi) GetReceipt(ctx context.Context, receiptId string) ([]byte, error) {
+	if receiptId != a.receiptId {
+		return []byte(`{"jsonrpc": "2.0", "error": {"name": "HANDLER_ERROR", "cause": {"name": "UNKNOWN_RECEIPT"}}, "id": "dontcare"}`), nil
+	}
+	return []byte(fmt.Sprintf(`{"jsonrpc": "2.0", "result": {"receipt_id": "%s", "receiver_id": "%s", "predecessor_id": "user.near"}, "id": "dontcare"}`, receiptId, WORMHOLE_CONTRACT)), nil
+}
+
+func (a receiptTestApi) GetFinalBlock(ctx context.Context) (nearapi.Block, error) {
+	return nearapi.Block{Header: nearapi.BlockHeader{Hash: "ARo7pHDH5hk1qpfdwRYtcuWh5dEjTHXwNw8wCTJb78jf", Height: 102}}, nil
+}
+
+func (a receiptTestApi) GetBlock(ctx context.Context, blockId string) (nearapi.Block, error) {
+	block, exists := a.blocks[blockId]
+	if !exists {
+		return nearapi.Block{}, errors.New("unknown block")
+	}
+	return nearapi.NewBlockFromBytes([]byte(block))
+}
+
+func (a receiptTestApi) GetChunk(ctx context.Context, chunkHeader nearapi.ChunkHeader) (nearapi.Chunk, error) {
+	chunk, exists := a.chunks[chunkHeader.Hash]
+	if !exists {
+		return nearapi.Chunk{}, errors.New("unknown chunk")
+	}
+	return nearapi.NewChunkFromBytes([]byte(chunk))
+}
+
+func (a receiptTestApi) GetReceiptOutcome(ctx context.Context, receiptId string, receiverId string, lightClientHead string) ([]byte, error) {
+	return []byte(a.outcome), nil
+}
+
+func TestReobservationByReceiptId(t *testing.T) {
+	receiptId := "Ghke9UK93vhqVburswfd7fvYk5PZ3xNvY9xP6PvufN9U"
+	txHash := "AFtsvPoA4zRhHY2LrD2VmFzcgWCBm351oZkHbq7D6EdH"
+	otherTxHash := "2rqzjwSCRGuSgHi2xSaZ9acXJ7XDUzzZFLJCEwY2cErf"
+	blockHash := "NSM5RDZDF7uxGWiUwhBqJcqCEw6g7axx4TxGYB7XZVt"
+	prevBlockHash := "FqPKohapMjpemtYh8nuQAB7iVJ3rDWtAZQnRjsXVFVbB"
+	emitter := hex.EncodeToString(make([]byte, 32))
+	event := fmt.Sprintf(`EVENT_JSON:{\"standard\":\"wormhole\",\"event\":\"publish\",\"data\":\"0102\",\"nonce\":7,\"emitter\":\"%s\",\"seq\":42,\"block\":100}`, emitter)
+	receiptOutcome := fmt.Sprintf(`{"id": "%s", "block_hash": "%s", "outcome": {"executor_id": "%s", "logs": ["%s"], "status": {"SuccessValue": "NDI="}}}`,
+		receiptId, blockHash, WORMHOLE_CONTRACT, event)
+	outcome := fmt.Sprintf(`{"jsonrpc": "2.0", "result": {"outcome_proof": %s}, "id": "dontcare"}`, receiptOutcome)
+
+	block := func(hash string, prevHash string, height uint64, chunkHash string) string {
+		return fmt.Sprintf(`{"jsonrpc": "2.0", "result": {"header": {"hash": "%s", "prev_hash": "%s", "height": %d, "timestamp": 1664754166351210892}, "chunks": [{"chunk_hash": "%s"}]}, "id": "dontcare"}`,
+			hash, prevHash, height, chunkHash)
+	}
+	api := receiptTestApi{
+		receiptId: receiptId,
+		outcome:   outcome,
+		blocks: map[string]string{
+			blockHash:     block(blockHash, prevBlockHash, 100, "CMUBdbgha1cK8zmjMnR9y9d1XjQ3rWGDqMhJWwWMQQ6c"),
+			prevBlockHash: block(prevBlockHash, "", 99, "6R6hRUHSQh6BAXFVMYiSnc4gEH5ncjCUSGF3wYB66eeV"),
+		},
+		chunks: map[string]string{
+			"CMUBdbgha1cK8zmjMnR9y9d1XjQ3rWGDqMhJWwWMQQ6c": `{"jsonrpc": "2.0", "result": {"header": {"chunk_hash": "CMUBdbgha1cK8zmjMnR9y9d1XjQ3rWGDqMhJWwWMQQ6c"}, "transactions": []}, "id": "dontcare"}`,
+			"6R6hRUHSQh6BAXFVMYiSnc4gEH5ncjCUSGF3wYB66eeV": fmt.Sprintf(`{"jsonrpc": "2.0", "result": {"header": {"chunk_hash": "6R6hRUHSQh6BAXFVMYiSnc4gEH5ncjCUSGF3wYB66eeV"}, "transactions": [{"hash": "%s", "signer_id": "other.near", "receiver_id": "app.near"}, {"hash": "%s", "signer_id": "user.near", "receiver_id": "token.bridge.near"}]}, "id": "dontcare"}`,
+				otherTxHash, txHash),
+		},
+		txs: map[string]string{
+			otherTxHash: `{"jsonrpc": "2.0", "result": {"receipts_outcome": [{"id": "CMUBdbgha1cK8zmjMnR9y9d1XjQ3rWGDqMhJWwWMQQ6c", "block_hash": "NSM5RDZDF7uxGWiUwhBqJcqCEw6g7axx4TxGYB7XZVt", "outcome": {"executor_id": "app.near", "logs": []}}]}, "id": "dontcare"}`,
+			txHash:      fmt.Sprintf(`{"jsonrpc": "2.0", "result": {"receipts_outcome": [%s]}, "id": "dontcare"}`, receiptOutcome),
+		},
+	}
+
+	msgC := make(chan *common.MessagePublication, 1)
+	w := NewWatcher("", WORMHOLE_CONTRACT, msgC, nil, false)
+	w.nearAPI = api
+	w.finalizer = newFinalizer(w.eventChan, w.nearAPI, false)
+	logger := zap.NewNop()
+	w.finalizer.setFinalized(logger, context.Background(), nearapi.BlockHeader{Hash: blockHash, Height: 100, Timestamp: 1664754166})
+
+	// Without the flag set for observation requests, an unknown transaction hash is not looked up as a receipt.
+	job := newTransactionProcessingJob(receiptId, WORMHOLE_CONTRACT)
+	assert.NoError(t, w.processTx(logger, context.Background(), job))
+	assert.Empty(t, msgC)
+
+	// The message is observed with the hash of the transaction the receipt originates from.
+	job.mayBeReceiptId = true
+	assert.NoError(t, w.processTx(logger, context.Background(), job))
+	require.Len(t, msgC, 1)
+	msg := <-msgC
+	assert.Equal(t, uint64(42), msg.Sequence)
+	assert.Equal(t, uint32(7), msg.Nonce)
+	assert.Equal(t, []byte{1, 2}, msg.Payload)
+	assert.Equal(t, time.Unix(1664754166, 0), msg.Timestamp)
+	txHashBytes, err := base58.Decode(txHash)
+	require.NoError(t, err)
+	assert.Equal(t, eth_common.BytesToHash(txHashBytes), msg.TxHash)
+	assert.Equal(t, txHash, job.txHash)
+	assert.False(t, job.mayBeReceiptId)
+
+	// Nothing is observed if the transaction of the receipt cannot be found, and the job is not retried. Transactions
+	// which do not involve the predecessor of the receipt are not looked up.
+	delete(api.txs, txHash)
+	txStatusCalls := 0
+	api.txStatusCalls = &txStatusCalls
+	w.nearAPI = api
+	job = newTransactionProcessingJob(receiptId, WORMHOLE_CONTRACT)
+	job.mayBeReceiptId = true
+	assert.NoError(t, w.processTx(logger, context.Background(), job))
+	assert.Empty(t, msgC)
+	assert.Equal(t, 1, job.receiptTxLookups)
+	// The receipt itself is looked up as a transaction first.
+	assert.Equal(t, 2, txStatusCalls)
+
+	// The number of transactions looked up is capped.
+	var txs []string
+	for i := 0; i < 2*maxReceiptTransactionLookups; i++ {
+		txs = append(txs, fmt.Sprintf(`{"hash": "tx%d", "signer_id": "user.near", "receiver_id": "token.bridge.near"}`, i))
+	}
+	api.chunks["6R6hRUHSQh6BAXFVMYiSnc4gEH5ncjCUSGF3wYB66eeV"] = fmt.Sprintf(`{"jsonrpc": "2.0", "result": {"header": {"chunk_hash": "6R6hRUHSQh6BAXFVMYiSnc4gEH5ncjCUSGF3wYB66eeV"}, "transactions": [%s]}, "id": "dontcare"}`, strings.Join(txs, ","))
+	txStatusCalls = 0
+	job = newTransactionProcessingJob(receiptId, WORMHOLE_CONTRACT)
+	job.mayBeReceiptId = true
+	assert.NoError(t, w.processTx(logger, context.Background(), job))
+	assert.Empty(t, msgC)
+	assert.Equal(t, maxReceiptTransactionLookups, job.receiptTxLookups)
+	assert.Equal(t, 1+maxReceiptTransactionLookups, txStatusCalls)
+
+	// Unknown receipts are ignored.
+	job = newTransactionProcessingJob("CMUBdbgha1cK8zmjMnR9y9d1XjQ3rWGDqMhJWwWMQQ6c", WORMHOLE_CONTRACT)
+	job.mayBeReceiptId = true
+	assert.NoError(t, w.processTx(logger, context.Background(), job))
+	assert.Empty(t, msgC)
+}
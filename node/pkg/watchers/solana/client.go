@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	eth_common "github.com/ethereum/go-ethereum/common"
@@ -55,6 +57,17 @@ type (
 		// latestFinalizedBlockNumber is the latest block processed by this watcher.
 		latestBlockNumber   uint64
 		latestBlockNumberMu sync.Mutex
+
+		// The programs whose message accounts are observed, starting with the core bridge. Additional programs are set via SetAdditionalPrograms().
+		programs []*MessageProgram
+
+		// The RPC budgets of the new slots and of the observation requests, see pools.go.
+		livePoolConfig          PoolConfig
+		reobservationPoolConfig PoolConfig
+		livePool                *rpcPool
+		reobservationPool       *rpcPool
+		// reobservationC queues the observation requests for the workers of the reobservation pool.
+		reobservationC chan *gossipv1.ObservationRequest
 	}
 
 	EventSubscriptionError struct {
@@ -190,11 +203,27 @@ func NewSolanaWatcher(
 		msgC:          msgC,
 		obsvReqC:      obsvReqC,
 		commitment:    commitment,
-		rpcClient:     rpc.New(rpcUrl),
+		rpcClient:     newRPCClient(rpcUrl),
 		readinessSync: common.MustConvertChainIdToReadinessSyncing(chainID),
 		chainID:       chainID,
 		networkName:   vaa.ChainID(chainID).String(),
+		programs:      []*MessageProgram{coreBridgeProgram(contractAddress)},
+
+		livePoolConfig:          DefaultLivePoolConfig,
+		reobservationPoolConfig: DefaultReobservationPoolConfig,
+	}
+}
+
+// newRPCClient returns an RPC client for rpcUrl which is subject to the rate limit of its endpoint, if any.
+func newRPCClient(rpcUrl string) *rpc.Client {
+	transport := ratelimit.DefaultRegistry.Transport(rpcUrl, nil)
+	if transport == nil {
+		return rpc.New(rpcUrl)
 	}
+
+	// Same timeout as the client returned by rpc.New.
+	httpClient := &http.Client{Timeout: 5 * time.Minute, Transport: transport}
+	return rpc.NewWithCustomRPCClient(jsonrpc.NewClientWithOpts(rpcUrl, &jsonrpc.RPCClientOpts{HTTPClient: httpClient}))
 }
 
 func (s *SolanaWatcher) SetupSubscription(ctx context.Context) (error, *websocket.Conn) {
@@ -212,14 +241,16 @@ func (s *SolanaWatcher) SetupSubscription(ctx context.Context) (error, *websocke
 
 	s.pumpData = make(chan []byte)
 
-	const temp = `{"jsonrpc": "2.0", "id": "%s", "method": "programSubscribe", "params": ["%s", {"encoding": "base64", "commitment": "%s", "filters": []}]}`
-	var p = fmt.Sprintf(temp, s.subId, s.rawContract, string(s.commitment))
+	const temp = `{"jsonrpc": "2.0", "id": "%s-%d", "method": "programSubscribe", "params": ["%s", {"encoding": "base64", "commitment": "%s", "filters": []}]}`
+	for i, program := range s.programs {
+		var p = fmt.Sprintf(temp, s.subId, i, program.ProgramID.String(), string(s.commitment))
 
-	logger.Info("Subscribing using", zap.String("filter", p))
+		logger.Info("Subscribing using", zap.String("filter", p))
 
-	if err := ws.Write(ctx, websocket.MessageText, []byte(p)); err != nil {
-		logger.Error(fmt.Sprintf("write: %s", err.Error()))
-		return err, nil
+		if err := ws.Write(ctx, websocket.MessageText, []byte(p)); err != nil {
+			logger.Error(fmt.Sprintf("write: %s", err.Error()))
+			return err, nil
+		}
 	}
 	return nil, ws
 }
@@ -257,6 +288,18 @@ func (s *SolanaWatcher) SetupWebSocket(ctx context.Context) error {
 	return nil
 }
 
+// setNetworkHeights reports the slot polled by the watcher in the heartbeat. The watcher of the confirmed commitment
+// reports the safe height and the one of the finalized commitment the finalized height. The processed slots are not
+// followed, so the latest height is not reported.
+func (s *SolanaWatcher) setNetworkHeights(slot uint64) {
+	switch s.commitment {
+	case rpc.CommitmentConfirmed:
+		p2p.DefaultRegistry.SetNetworkHeights(s.chainID, p2p.NetworkHeights{Safe: int64(slot)})
+	case rpc.CommitmentFinalized:
+		p2p.DefaultRegistry.SetNetworkHeights(s.chainID, p2p.NetworkHeights{Finalized: int64(slot)})
+	}
+}
+
 func (s *SolanaWatcher) readWebSocketWithTimeout(ctx context.Context, ws *websocket.Conn) ([]byte, error) {
 	rCtx, cancel := context.WithTimeout(ctx, time.Second*300) // 5 minute
 	defer cancel()
@@ -278,6 +321,29 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 	s.errC = make(chan error)
 	s.pumpData = make(chan []byte)
 
+	s.livePool = newRPCPool(s.livePoolConfig, s.networkName, string(s.commitment), livePoolName)
+	if s.obsvReqC != nil {
+		s.reobservationPool = newRPCPool(s.reobservationPoolConfig, s.networkName, string(s.commitment), reobservationPoolName)
+		s.reobservationC = make(chan *gossipv1.ObservationRequest, reobservationQueueSize)
+		queueLength := solanaReobservationQueueLength.WithLabelValues(s.networkName, string(s.commitment))
+		queueLength.Set(0)
+		for i := 0; i < s.reobservationPoolConfig.MaxConcurrentRequests; i++ {
+			common.RunWithScissors(ctx, s.errC, "SolanaReobservationWorker", func(ctx context.Context) error {
+				for {
+					select {
+					case <-ctx.Done():
+						return nil
+					case m := <-s.reobservationC:
+						queueLength.Set(float64(len(s.reobservationC)))
+						acc := solana.PublicKeyFromBytes(m.TxHash)
+						logger.Info("received observation request", zap.String("account", acc.String()))
+						s.fetchMessageAccount(ctx, logger, acc, 0, s.reobservationPool)
+					}
+				}
+			})
+		}
+	}
+
 	useWs := false
 	if s.wsUrl != nil && *s.wsUrl != "" {
 		useWs = true
@@ -308,12 +374,15 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 					panic("unexpected chain id")
 				}
 
-				acc := solana.PublicKeyFromBytes(m.TxHash)
-				logger.Info("received observation request", zap.String("account", acc.String()))
-
-				rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
-				s.fetchMessageAccount(rCtx, logger, acc, 0)
-				cancel()
+				// The request is handled by the workers of the reobservation pool, so that it does not hold up new slots.
+				select {
+				case s.reobservationC <- m:
+					solanaReobservationQueueLength.WithLabelValues(s.networkName, string(s.commitment)).Set(float64(len(s.reobservationC)))
+				default:
+					solanaReobservationsDropped.WithLabelValues(s.networkName, string(s.commitment)).Inc()
+					logger.Warn("dropping observation request because the queue is full",
+						zap.String("account", solana.PublicKeyFromBytes(m.TxHash).String()))
+				}
 			case <-timer.C:
 				// Get current slot height
 				rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
@@ -338,6 +407,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 					Height:          int64(slot),
 					ContractAddress: contractAddr,
 				})
+				s.setNetworkHeights(slot)
 
 				if !useWs {
 					rangeStart := lastSlot + 1
@@ -405,8 +475,12 @@ func (s *SolanaWatcher) fetchBlock(ctx context.Context, logger *zap.Logger, slot
 		zap.Uint64("slot", slot),
 		zap.String("commitment", string(s.commitment)),
 		zap.Uint("empty_retry", emptyRetry))
+
+	release, err := s.livePool.acquire(ctx)
+	if err != nil {
+		return false
+	}
 	rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
-	defer cancel()
 	start := time.Now()
 	rewards := false
 
@@ -418,6 +492,8 @@ func (s *SolanaWatcher) fetchBlock(ctx context.Context, logger *zap.Logger, slot
 		Commitment:                     s.commitment,
 		MaxSupportedTransactionVersion: &maxSupportedTransactionVersion,
 	})
+	cancel()
+	release()
 
 	queryLatency.WithLabelValues(s.networkName, "get_confirmed_block", string(s.commitment)).Observe(time.Since(start).Seconds())
 	if err != nil {
@@ -489,13 +565,17 @@ OUTER:
 			continue
 		}
 		signature := tx.Signatures[0]
-		var programIndex uint16
+		programIndexes := make(map[uint16]*MessageProgram)
 		for n, key := range tx.Message.AccountKeys {
-			if key.Equals(s.contract) {
-				programIndex = uint16(n)
+			// The first account is the fee payer, which cannot be a program.
+			if n == 0 {
+				continue
+			}
+			if program := s.programByID(key); program != nil {
+				programIndexes[uint16(n)] = program
 			}
 		}
-		if programIndex == 0 {
+		if len(programIndexes) == 0 {
 			continue
 		}
 
@@ -514,7 +594,7 @@ OUTER:
 
 		// Find top-level instructions
 		for i, inst := range tx.Message.Instructions {
-			found, err := s.processInstruction(ctx, logger, slot, inst, programIndex, tx, signature, i)
+			found, err := s.processInstruction(ctx, logger, slot, inst, programIndexes, tx, signature, i)
 			if err != nil {
 				logger.Error("malformed Wormhole instruction",
 					zap.Error(err),
@@ -531,6 +611,10 @@ OUTER:
 		}
 
 		// Call GetConfirmedTransaction to get at innerTransactions
+		release, err := s.livePool.acquire(ctx)
+		if err != nil {
+			return false
+		}
 		rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
 		start := time.Now()
 		maxSupportedTransactionVersion := uint64(0)
@@ -540,6 +624,7 @@ OUTER:
 			MaxSupportedTransactionVersion: &maxSupportedTransactionVersion,
 		})
 		cancel()
+		release()
 		queryLatency.WithLabelValues(s.networkName, "get_confirmed_transaction", string(s.commitment)).Observe(time.Since(start).Seconds())
 		if err != nil {
 			p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
@@ -560,7 +645,7 @@ OUTER:
 
 		for _, inner := range tr.Meta.InnerInstructions {
 			for i, inst := range inner.Instructions {
-				_, err = s.processInstruction(ctx, logger, slot, inst, programIndex, tx, signature, i)
+				_, err = s.processInstruction(ctx, logger, slot, inst, programIndexes, tx, signature, i)
 				if err != nil {
 					logger.Error("malformed Wormhole instruction",
 						zap.Error(err),
@@ -583,27 +668,25 @@ OUTER:
 	return true
 }
 
-func (s *SolanaWatcher) processInstruction(ctx context.Context, logger *zap.Logger, slot uint64, inst solana.CompiledInstruction, programIndex uint16, tx *solana.Transaction, signature solana.Signature, idx int) (bool, error) {
-	if inst.ProgramIDIndex != programIndex {
+func (s *SolanaWatcher) processInstruction(ctx context.Context, logger *zap.Logger, slot uint64, inst solana.CompiledInstruction, programIndexes map[uint16]*MessageProgram, tx *solana.Transaction, signature solana.Signature, idx int) (bool, error) {
+	program, exists := programIndexes[inst.ProgramIDIndex]
+	if !exists {
 		return false, nil
 	}
 
-	if len(inst.Data) == 0 {
-		return false, nil
-	}
-
-	if inst.Data[0] != postMessageInstructionID && inst.Data[0] != postMessageUnreliableInstructionID {
+	instData, ok := program.parseInstructionData(inst.Data)
+	if !ok {
 		return false, nil
 	}
 
-	if len(inst.Accounts) != postMessageInstructionNumAccounts {
+	if len(inst.Accounts) != program.NumAccounts {
 		return false, fmt.Errorf("invalid number of accounts: %d instead of %d",
-			len(inst.Accounts), postMessageInstructionNumAccounts)
+			len(inst.Accounts), program.NumAccounts)
 	}
 
 	// Decode instruction data (UNTRUSTED)
 	var data PostMessageData
-	if err := borsh.Deserialize(&data, inst.Data[1:]); err != nil {
+	if err := borsh.Deserialize(&data, instData); err != nil {
 		return false, fmt.Errorf("failed to deserialize instruction data: %w", err)
 	}
 
@@ -619,8 +702,12 @@ func (s *SolanaWatcher) processInstruction(ctx context.Context, logger *zap.Logg
 		return true, nil
 	}
 
-	// The second account in a well-formed Wormhole instruction is the VAA program account.
-	acc := tx.Message.AccountKeys[inst.Accounts[1]]
+	// The second account in a well-formed Wormhole instruction is the VAA program account. Other programs configure the index.
+	accIndex := inst.Accounts[program.MessageAccountIndex]
+	if int(accIndex) >= len(tx.Message.AccountKeys) {
+		return false, fmt.Errorf("invalid message account index: %d", accIndex)
+	}
+	acc := tx.Message.AccountKeys[accIndex]
 
 	logger.Debug("fetching VAA account", zap.Stringer("acc", acc),
 		zap.Stringer("signature", signature), zap.Uint64("slot", slot), zap.Int("idx", idx))
@@ -634,7 +721,7 @@ func (s *SolanaWatcher) processInstruction(ctx context.Context, logger *zap.Logg
 }
 
 func (s *SolanaWatcher) retryFetchMessageAccount(ctx context.Context, logger *zap.Logger, acc solana.PublicKey, slot uint64, retry uint) {
-	retryable := s.fetchMessageAccount(ctx, logger, acc, slot)
+	retryable := s.fetchMessageAccount(ctx, logger, acc, slot, s.livePool)
 
 	if retryable {
 		if retry >= maxRetries {
@@ -661,15 +748,22 @@ func (s *SolanaWatcher) retryFetchMessageAccount(ctx context.Context, logger *za
 	}
 }
 
-func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Logger, acc solana.PublicKey, slot uint64) (retryable bool) {
+// fetchMessageAccount fetches a message account within the RPC budget of pool and publishes its message.
+func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Logger, acc solana.PublicKey, slot uint64, pool *rpcPool) (retryable bool) {
+	release, err := pool.acquire(ctx)
+	if err != nil {
+		return false
+	}
+
 	// Fetching account
 	rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
-	defer cancel()
 	start := time.Now()
 	info, err := s.rpcClient.GetAccountInfoWithOpts(rCtx, acc, &rpc.GetAccountInfoOpts{
 		Encoding:   solana.EncodingBase64,
 		Commitment: s.commitment,
 	})
+	cancel()
+	release()
 	queryLatency.WithLabelValues(s.networkName, "get_account_info", string(s.commitment)).Observe(time.Since(start).Seconds())
 	if err != nil {
 		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
@@ -682,7 +776,8 @@ func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Log
 		return true
 	}
 
-	if !info.Value.Owner.Equals(s.contract) {
+	program := s.programByID(info.Value.Owner)
+	if program == nil {
 		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
 		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "account_owner_mismatch").Inc()
 		logger.Error("account has invalid owner",
@@ -694,7 +789,7 @@ func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Log
 	}
 
 	data := info.Value.Data.GetBinary()
-	if string(data[:3]) != accountPrefixReliable && string(data[:3]) != accountPrefixUnreliable {
+	if _, _, ok := program.parseAccountPrefix(data); !ok {
 		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
 		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "bad_account_data").Inc()
 		logger.Error("account is not a message account",
@@ -710,7 +805,7 @@ func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, logger *zap.Log
 		zap.Stringer("account", acc),
 		zap.Binary("data", data))
 
-	s.processMessageAccount(logger, data, acc)
+	s.processMessageAccount(logger, data, acc, program)
 	return false
 }
 
@@ -742,7 +837,13 @@ func (s *SolanaWatcher) processAccountSubscriptionData(ctx context.Context, logg
 
 	value := (*res.Params).Result.Value
 
-	if value.Account.Owner != s.rawContract {
+	owner, err := solana.PublicKeyFromBase58(value.Account.Owner)
+	if err != nil {
+		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "invalid_websocket_account").Inc()
+		return fmt.Errorf("Update for account with invalid owner: %w", err)
+	}
+	program := s.programByID(owner)
+	if program == nil {
 		// We got a message for the wrong contract on the websocket... uncomfortable...
 		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "invalid_websocket_account").Inc()
 		return errors.New("Update for account with wrong owner")
@@ -755,25 +856,22 @@ func (s *SolanaWatcher) processAccountSubscriptionData(ctx context.Context, logg
 		return err
 	}
 
-	// ignore truncated messages
-	if len(data) < 3 {
-		return nil
-	}
-
 	// Other accounts owned by the wormhole contract seem to send updates...
-	switch string(data[:3]) {
-	case accountPrefixReliable, accountPrefixUnreliable:
+	if _, _, ok := program.parseAccountPrefix(data); ok {
 		acc := solana.PublicKeyFromBytes([]byte(value.Pubkey))
-		s.processMessageAccount(logger, data, acc)
-	default:
-		break
+		s.processMessageAccount(logger, data, acc, program)
 	}
 
 	return nil
 }
 
-func (s *SolanaWatcher) processMessageAccount(logger *zap.Logger, data []byte, acc solana.PublicKey) {
-	proposal, err := ParseMessagePublicationAccount(data)
+func (s *SolanaWatcher) processMessageAccount(logger *zap.Logger, data []byte, acc solana.PublicKey, program *MessageProgram) {
+	prefixLen, reliable, ok := program.parseAccountPrefix(data)
+	if !ok {
+		panic("invalid prefix")
+	}
+
+	proposal, err := parseMessagePublicationAccount(data, prefixLen)
 	if err != nil {
 		solanaAccountSkips.WithLabelValues(s.networkName, "parse_transfer_out").Inc()
 		logger.Error(
@@ -787,16 +885,6 @@ func (s *SolanaWatcher) processMessageAccount(logger *zap.Logger, data []byte, a
 	var txHash eth_common.Hash
 	copy(txHash[:], acc[:])
 
-	var reliable bool
-	switch string(data[:3]) {
-	case accountPrefixReliable:
-		reliable = true
-	case accountPrefixUnreliable:
-		reliable = false
-	default:
-		panic("invalid prefix")
-	}
-
 	observation := &common.MessagePublication{
 		TxHash:           txHash,
 		Timestamp:        time.Unix(int64(proposal.SubmissionTime), 0),
@@ -843,9 +931,14 @@ func (s *SolanaWatcher) GetLatestFinalizedBlockNumber() uint64 {
 }
 
 func ParseMessagePublicationAccount(data []byte) (*MessagePublicationAccount, error) {
-	prop := &MessagePublicationAccount{}
 	// Skip the b"msg" prefix
-	if err := borsh.Deserialize(prop, data[3:]); err != nil {
+	return parseMessagePublicationAccount(data, len(accountPrefixReliable))
+}
+
+// parseMessagePublicationAccount parses a message account whose discriminator has the given length.
+func parseMessagePublicationAccount(data []byte, prefixLen int) (*MessagePublicationAccount, error) {
+	prop := &MessagePublicationAccount{}
+	if err := borsh.Deserialize(prop, data[prefixLen:]); err != nil {
 		return nil, err
 	}
 
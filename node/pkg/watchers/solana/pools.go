@@ -0,0 +1,137 @@
+package solana
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+)
+
+/*
+The RPC requests of the watcher are split between two pools, so that a flood of observation requests cannot delay the
+observation of new slots, and a backlog of slots cannot starve the observation requests:
+
+- The live pool serves the blocks, transactions and message accounts of the new slots. Each slot is fetched by a
+  goroutine of its own, as before, but only MaxConcurrentRequests of their requests are in flight at once.
+- The reobservation pool serves the observation requests of the network. They are queued and handled by
+  MaxConcurrentRequests workers, and dropped if the queue is full, since the network sends them again.
+
+Each pool may also limit the rate of its requests. The budget of the endpoint configured with --rpcRateLimits applies
+to both pools on top of that. The slot height is polled outside of the pools, so the watcher keeps up with the chain
+even when both pools are saturated.
+*/
+
+const (
+	livePoolName          = "live"
+	reobservationPoolName = "reobservation"
+
+	// reobservationQueueSize is the number of observation requests waiting for a worker of the reobservation pool.
+	reobservationQueueSize = 100
+)
+
+// PoolConfig is the RPC budget of a pool of the watcher. Every watcher has pools of its own, so the budget applies per
+// watcher: the confirmed and finalized watchers of a chain share the endpoint with twice the budget.
+type PoolConfig struct {
+	// MaxConcurrentRequests is the maximum number of RPC requests of the pool in flight.
+	MaxConcurrentRequests int
+	// RequestRate is the maximum number of RPC requests of the pool per second, or zero for no limit.
+	RequestRate float64
+}
+
+var (
+	// DefaultLivePoolConfig is the budget of the requests for new slots.
+	DefaultLivePoolConfig = PoolConfig{MaxConcurrentRequests: 64}
+	// DefaultReobservationPoolConfig is the budget of the requests for observation requests.
+	DefaultReobservationPoolConfig = PoolConfig{MaxConcurrentRequests: 4, RequestRate: 10}
+)
+
+var (
+	solanaPoolRequestsInFlight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_solana_pool_requests_in_flight",
+			Help: "Current number of Solana RPC requests in flight, by pool",
+		}, []string{"solana_network", "commitment", "pool"})
+	solanaPoolWaitTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wormhole_solana_pool_wait_seconds",
+			Help:    "Time Solana RPC requests waited for the budget of their pool",
+			Buckets: []float64{0.001, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60},
+		}, []string{"solana_network", "commitment", "pool"})
+	solanaReobservationQueueLength = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_solana_reobservation_queue_length",
+			Help: "Current number of Solana observation requests waiting for a worker",
+		}, []string{"solana_network", "commitment"})
+	solanaReobservationsDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_solana_reobservation_requests_dropped_total",
+			Help: "Total number of Solana observation requests dropped because the queue was full",
+		}, []string{"solana_network", "commitment"})
+)
+
+// rpcPool enforces the RPC budget of a pool.
+type rpcPool struct {
+	config PoolConfig
+	// limiter limits the rate of the requests, or is nil if it is not limited.
+	limiter *rate.Limiter
+	// slots holds a token for each request in flight.
+	slots chan struct{}
+
+	inFlight prometheus.Gauge
+	waitTime prometheus.Observer
+}
+
+func newRPCPool(config PoolConfig, networkName string, commitment string, name string) *rpcPool {
+	if config.MaxConcurrentRequests <= 0 {
+		config.MaxConcurrentRequests = 1
+	}
+
+	p := &rpcPool{
+		config:   config,
+		slots:    make(chan struct{}, config.MaxConcurrentRequests),
+		inFlight: solanaPoolRequestsInFlight.WithLabelValues(networkName, commitment, name),
+		waitTime: solanaPoolWaitTime.WithLabelValues(networkName, commitment, name),
+	}
+	if config.RequestRate > 0 {
+		burst := int(config.RequestRate)
+		if burst < 1 {
+			burst = 1
+		}
+		p.limiter = rate.NewLimiter(rate.Limit(config.RequestRate), burst)
+	}
+	return p
+}
+
+// acquire blocks until a request fits in the budget of the pool and returns the function to call once the request is
+// done, or returns an error if ctx is done first.
+func (p *rpcPool) acquire(ctx context.Context) (func(), error) {
+	start := time.Now()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case p.slots <- struct{}{}:
+	}
+
+	if p.limiter != nil {
+		if err := p.limiter.Wait(ctx); err != nil {
+			<-p.slots
+			return nil, err
+		}
+	}
+
+	p.waitTime.Observe(time.Since(start).Seconds())
+	p.inFlight.Inc()
+	return func() {
+		p.inFlight.Dec()
+		<-p.slots
+	}, nil
+}
+
+// SetPools configures the RPC budgets of the live and reobservation pools. It must be called before the watcher is run.
+func (s *SolanaWatcher) SetPools(live PoolConfig, reobservation PoolConfig) {
+	s.livePoolConfig = live
+	s.reobservationPoolConfig = reobservation
+}
@@ -0,0 +1,52 @@
+package solana
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCPoolConcurrency(t *testing.T) {
+	pool := newRPCPool(PoolConfig{MaxConcurrentRequests: 2}, "test", "finalized", "test_concurrency")
+
+	ctx := context.Background()
+	release1, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	release2, err := pool.acquire(ctx)
+	require.NoError(t, err)
+
+	// The pool is full, so the next request waits until its context is done.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.acquire(timeoutCtx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release1()
+	release3, err := pool.acquire(ctx)
+	require.NoError(t, err)
+
+	release2()
+	release3()
+	assert.Equal(t, 0, len(pool.slots))
+}
+
+func TestRPCPoolRate(t *testing.T) {
+	pool := newRPCPool(PoolConfig{MaxConcurrentRequests: 10, RequestRate: 1}, "test", "finalized", "test_rate")
+
+	ctx := context.Background()
+	release, err := pool.acquire(ctx)
+	require.NoError(t, err)
+	release()
+
+	// The burst is used up, so the next request would wait for a second.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.acquire(timeoutCtx)
+	assert.Error(t, err)
+
+	// A request failing to get the budget does not hold a slot of the pool.
+	assert.Equal(t, 0, len(pool.slots))
+}
@@ -0,0 +1,203 @@
+// This file contains the configuration of the programs whose message accounts are observed by the Solana watcher. The
+// core bridge is always observed. Additional programs, like shim or integrity pool programs publishing messages with
+// their own account layout discriminators, can be configured without forking the watcher.
+//
+// SECURITY: Messages from the configured programs are trusted like the ones from the core bridge. Their message
+// accounts must use the layout of the core bridge after the discriminator, and the program must set the emitter to the
+// account that signed the instruction, since it becomes the emitter address of the VAA.
+
+package solana
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// MessageProgram is a program whose instructions post messages into message accounts owned by the program.
+type MessageProgram struct {
+	ProgramID solana.PublicKey
+
+	// ReliableInstruction and UnreliableInstruction are the prefixes of the instruction data of the instructions posting
+	// a message. They are followed by the borsh encoded PostMessageData. UnreliableInstruction is empty if the program
+	// does not post unreliable messages.
+	ReliableInstruction   []byte
+	UnreliableInstruction []byte
+
+	// NumAccounts is the number of accounts of the instructions posting a message, and MessageAccountIndex is the index
+	// of the message account among them.
+	NumAccounts         int
+	MessageAccountIndex int
+
+	// ReliableAccountPrefix and UnreliableAccountPrefix are the discriminators of the reliable and unreliable message
+	// accounts. They are followed by the borsh encoded MessagePublicationAccount.
+	ReliableAccountPrefix   []byte
+	UnreliableAccountPrefix []byte
+}
+
+// coreBridgeProgram returns the configuration of the core bridge program.
+func coreBridgeProgram(contract solana.PublicKey) *MessageProgram {
+	return &MessageProgram{
+		ProgramID:               contract,
+		ReliableInstruction:     []byte{postMessageInstructionID},
+		UnreliableInstruction:   []byte{postMessageUnreliableInstructionID},
+		NumAccounts:             postMessageInstructionNumAccounts,
+		MessageAccountIndex:     1,
+		ReliableAccountPrefix:   []byte(accountPrefixReliable),
+		UnreliableAccountPrefix: []byte(accountPrefixUnreliable),
+	}
+}
+
+// parseInstructionData returns the data following the discriminator if the instruction data is a post message
+// instruction of the program. ok is false if it is another instruction.
+func (p *MessageProgram) parseInstructionData(data []byte) (rest []byte, ok bool) {
+	if len(p.ReliableInstruction) != 0 && bytes.HasPrefix(data, p.ReliableInstruction) {
+		return data[len(p.ReliableInstruction):], true
+	}
+	if len(p.UnreliableInstruction) != 0 && bytes.HasPrefix(data, p.UnreliableInstruction) {
+		return data[len(p.UnreliableInstruction):], true
+	}
+	return nil, false
+}
+
+// parseAccountPrefix returns the length of the discriminator of a message account of the program and whether the
+// message is reliable. ok is false if the account is not a message account.
+func (p *MessageProgram) parseAccountPrefix(data []byte) (prefixLen int, reliable bool, ok bool) {
+	if len(p.ReliableAccountPrefix) != 0 && bytes.HasPrefix(data, p.ReliableAccountPrefix) {
+		return len(p.ReliableAccountPrefix), true, true
+	}
+	if len(p.UnreliableAccountPrefix) != 0 && bytes.HasPrefix(data, p.UnreliableAccountPrefix) {
+		return len(p.UnreliableAccountPrefix), false, true
+	}
+	return 0, false, false
+}
+
+// messageProgramConfig is an entry of the additional programs file. Discriminators are hex encoded.
+type messageProgramConfig struct {
+	ChainID                 uint16 `json:"chainId"`
+	ProgramID               string `json:"programId"`
+	ReliableInstruction     string `json:"reliableInstruction"`
+	UnreliableInstruction   string `json:"unreliableInstruction"`
+	NumAccounts             int    `json:"numAccounts"`
+	MessageAccountIndex     int    `json:"messageAccountIndex"`
+	ReliableAccountPrefix   string `json:"reliableAccountPrefix"`
+	UnreliableAccountPrefix string `json:"unreliableAccountPrefix"`
+}
+
+// ReadAdditionalProgramsFile reads the configuration of the programs, other than the core bridge, observed by the Solana
+// watchers from a JSON file. The file contains a list of objects with the chainId, the base58 programId, the hex encoded
+// reliableInstruction and unreliableInstruction discriminators, the numAccounts of the instructions, the
+// messageAccountIndex and the hex encoded reliableAccountPrefix and unreliableAccountPrefix discriminators. An empty
+// path means no additional programs.
+func ReadAdditionalProgramsFile(path string) (map[vaa.ChainID][]*MessageProgram, error) {
+	if path == "" {
+		return map[vaa.ChainID][]*MessageProgram{}, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read additional programs file: %w", err)
+	}
+
+	return ParseAdditionalPrograms(b)
+}
+
+// ParseAdditionalPrograms parses the additional programs configuration, see ReadAdditionalProgramsFile.
+func ParseAdditionalPrograms(config []byte) (map[vaa.ChainID][]*MessageProgram, error) {
+	var entries []messageProgramConfig
+	if err := json.Unmarshal(config, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse additional programs: %w", err)
+	}
+
+	ret := make(map[vaa.ChainID][]*MessageProgram)
+	for _, entry := range entries {
+		chainID := vaa.ChainID(entry.ChainID)
+		if chainID != vaa.ChainIDSolana && chainID != vaa.ChainIDPythNet {
+			return nil, fmt.Errorf("additional program %s configured for chain %v, which is not watched by the Solana watcher", entry.ProgramID, chainID)
+		}
+
+		programID, err := solana.PublicKeyFromBase58(entry.ProgramID)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid program id "%s" for additional program on chain %v: %w`, entry.ProgramID, chainID, err)
+		}
+
+		for _, other := range ret[chainID] {
+			if other.ProgramID.Equals(programID) {
+				return nil, fmt.Errorf("duplicate additional program %s on chain %v", programID, chainID)
+			}
+		}
+
+		program, err := entry.program(programID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid additional program %s on chain %v: %w", programID, chainID, err)
+		}
+
+		ret[chainID] = append(ret[chainID], program)
+	}
+
+	return ret, nil
+}
+
+// program validates the entry and converts it into a MessageProgram.
+func (c *messageProgramConfig) program(programID solana.PublicKey) (*MessageProgram, error) {
+	p := &MessageProgram{
+		ProgramID:           programID,
+		NumAccounts:         c.NumAccounts,
+		MessageAccountIndex: c.MessageAccountIndex,
+	}
+
+	var err error
+	if p.ReliableInstruction, err = hex.DecodeString(c.ReliableInstruction); err != nil {
+		return nil, fmt.Errorf("invalid reliableInstruction: %w", err)
+	}
+	if p.UnreliableInstruction, err = hex.DecodeString(c.UnreliableInstruction); err != nil {
+		return nil, fmt.Errorf("invalid unreliableInstruction: %w", err)
+	}
+	if p.ReliableAccountPrefix, err = hex.DecodeString(c.ReliableAccountPrefix); err != nil {
+		return nil, fmt.Errorf("invalid reliableAccountPrefix: %w", err)
+	}
+	if p.UnreliableAccountPrefix, err = hex.DecodeString(c.UnreliableAccountPrefix); err != nil {
+		return nil, fmt.Errorf("invalid unreliableAccountPrefix: %w", err)
+	}
+
+	if len(p.ReliableInstruction) == 0 || len(p.ReliableAccountPrefix) == 0 {
+		return nil, fmt.Errorf("reliableInstruction and reliableAccountPrefix are required")
+	}
+	if (len(p.UnreliableInstruction) == 0) != (len(p.UnreliableAccountPrefix) == 0) {
+		return nil, fmt.Errorf("unreliableInstruction and unreliableAccountPrefix must be set together")
+	}
+	// A discriminator that is a prefix of the other one would make the kind of the message ambiguous.
+	if len(p.UnreliableInstruction) != 0 &&
+		(bytes.HasPrefix(p.ReliableInstruction, p.UnreliableInstruction) || bytes.HasPrefix(p.UnreliableInstruction, p.ReliableInstruction)) {
+		return nil, fmt.Errorf("reliableInstruction and unreliableInstruction are ambiguous")
+	}
+	if len(p.UnreliableAccountPrefix) != 0 &&
+		(bytes.HasPrefix(p.ReliableAccountPrefix, p.UnreliableAccountPrefix) || bytes.HasPrefix(p.UnreliableAccountPrefix, p.ReliableAccountPrefix)) {
+		return nil, fmt.Errorf("reliableAccountPrefix and unreliableAccountPrefix are ambiguous")
+	}
+	if p.NumAccounts <= 0 || p.MessageAccountIndex < 0 || p.MessageAccountIndex >= p.NumAccounts {
+		return nil, fmt.Errorf("messageAccountIndex %d is not an account of the %d accounts of the instruction", p.MessageAccountIndex, p.NumAccounts)
+	}
+
+	return p, nil
+}
+
+// SetAdditionalPrograms configures programs other than the core bridge whose message accounts are observed as message publications.
+func (s *SolanaWatcher) SetAdditionalPrograms(programs []*MessageProgram) {
+	s.programs = append([]*MessageProgram{coreBridgeProgram(s.contract)}, programs...)
+}
+
+// programByID returns the observed program with the given ID, or nil if the program is not observed.
+func (s *SolanaWatcher) programByID(programID solana.PublicKey) *MessageProgram {
+	for _, p := range s.programs {
+		if p.ProgramID.Equals(programID) {
+			return p
+		}
+	}
+	return nil
+}
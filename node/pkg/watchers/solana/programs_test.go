@@ -0,0 +1,128 @@
+package solana
+
+import (
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/near/borsh-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	testCoreBridge = solana.MustPublicKeyFromBase58("worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth")
+	testShim       = solana.MustPublicKeyFromBase58("EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX")
+)
+
+const testShimConfig = `[{
+	"chainId": 1,
+	"programId": "EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX",
+	"reliableInstruction": "d63264d12622074c",
+	"numAccounts": 8,
+	"messageAccountIndex": 2,
+	"reliableAccountPrefix": "4d657373616765"
+}]`
+
+func TestParseAdditionalPrograms(t *testing.T) {
+	programs, err := ParseAdditionalPrograms([]byte(testShimConfig))
+	require.NoError(t, err)
+	require.Len(t, programs[vaa.ChainIDSolana], 1)
+
+	p := programs[vaa.ChainIDSolana][0]
+	assert.Equal(t, testShim, p.ProgramID)
+	assert.Equal(t, []byte{0xd6, 0x32, 0x64, 0xd1, 0x26, 0x22, 0x07, 0x4c}, p.ReliableInstruction)
+	assert.Empty(t, p.UnreliableInstruction)
+	assert.Equal(t, 8, p.NumAccounts)
+	assert.Equal(t, 2, p.MessageAccountIndex)
+	assert.Equal(t, []byte("Message"), p.ReliableAccountPrefix)
+
+	programs, err = ReadAdditionalProgramsFile("")
+	require.NoError(t, err)
+	assert.Empty(t, programs)
+}
+
+func TestParseAdditionalProgramsInvalid(t *testing.T) {
+	tests := []struct {
+		config string
+		err    string
+	}{
+		{`[{"chainId": 2, "programId": "EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX"}]`, "not watched by the Solana watcher"},
+		{`[{"chainId": 1, "programId": "invalid"}]`, "invalid program id"},
+		{`[{"chainId": 1, "programId": "EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX", "reliableInstruction": "zz"}]`, "invalid reliableInstruction"},
+		{`[{"chainId": 1, "programId": "EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX", "reliableInstruction": "01", "numAccounts": 1}]`, "reliableInstruction and reliableAccountPrefix are required"},
+		{`[{"chainId": 1, "programId": "EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX", "reliableInstruction": "01", "reliableAccountPrefix": "01", "unreliableInstruction": "02", "numAccounts": 1}]`, "must be set together"},
+		{`[{"chainId": 1, "programId": "EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX", "reliableInstruction": "01", "reliableAccountPrefix": "0102", "unreliableInstruction": "02", "unreliableAccountPrefix": "01", "numAccounts": 1}]`, "reliableAccountPrefix and unreliableAccountPrefix are ambiguous"},
+		{`[{"chainId": 1, "programId": "EtZMZM22ViKMo4r5y4Anovs3wKQ2owUmDpjygnMMcdEX", "reliableInstruction": "01", "reliableAccountPrefix": "01", "numAccounts": 2, "messageAccountIndex": 2}]`, "messageAccountIndex 2 is not an account"},
+		{"[" + testShimConfig[1:len(testShimConfig)-1] + "," + testShimConfig[1:], "duplicate additional program"},
+	}
+
+	for _, tc := range tests {
+		_, err := ParseAdditionalPrograms([]byte(tc.config))
+		assert.ErrorContains(t, err, tc.err, tc.config)
+	}
+}
+
+func TestCoreBridgeProgram(t *testing.T) {
+	p := coreBridgeProgram(testCoreBridge)
+
+	rest, ok := p.parseInstructionData([]byte{postMessageInstructionID, 0xaa})
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0xaa}, rest)
+	_, ok = p.parseInstructionData([]byte{postMessageUnreliableInstructionID})
+	assert.True(t, ok)
+	_, ok = p.parseInstructionData([]byte{0x02})
+	assert.False(t, ok)
+	_, ok = p.parseInstructionData(nil)
+	assert.False(t, ok)
+
+	prefixLen, reliable, ok := p.parseAccountPrefix([]byte("msg..."))
+	assert.Equal(t, 3, prefixLen)
+	assert.True(t, reliable)
+	assert.True(t, ok)
+	_, reliable, ok = p.parseAccountPrefix([]byte("msu..."))
+	assert.False(t, reliable)
+	assert.True(t, ok)
+	_, _, ok = p.parseAccountPrefix([]byte("ms"))
+	assert.False(t, ok)
+}
+
+func TestProcessMessageAccountOfAdditionalProgram(t *testing.T) {
+	programs, err := ParseAdditionalPrograms([]byte(testShimConfig))
+	require.NoError(t, err)
+
+	msgC := make(chan *common.MessagePublication, 1)
+	s := NewSolanaWatcher("http://localhost:8899", nil, testCoreBridge, testCoreBridge.String(), msgC, nil, rpc.CommitmentFinalized, vaa.ChainIDSolana)
+	s.SetAdditionalPrograms(programs[vaa.ChainIDSolana])
+	require.Len(t, s.programs, 2)
+	assert.Equal(t, testCoreBridge, s.programByID(testCoreBridge).ProgramID)
+	assert.Nil(t, s.programByID(solana.SystemProgramID))
+
+	emitter := vaa.Address{1, 2, 3}
+	account, err := borsh.Serialize(MessagePublicationAccount{
+		ConsistencyLevel: 1,
+		SubmissionTime:   1700000000,
+		Nonce:            42,
+		Sequence:         7,
+		EmitterChain:     uint16(vaa.ChainIDSolana),
+		EmitterAddress:   emitter,
+		Payload:          []byte("payload"),
+	})
+	require.NoError(t, err)
+	data := append([]byte("Message"), account...)
+
+	acc := solana.MustPublicKeyFromBase58("11111111111111111111111111111112")
+	s.processMessageAccount(zap.NewNop(), data, acc, s.programByID(testShim))
+
+	msg := <-msgC
+	assert.Equal(t, vaa.ChainIDSolana, msg.EmitterChain)
+	assert.Equal(t, emitter, msg.EmitterAddress)
+	assert.Equal(t, uint64(7), msg.Sequence)
+	assert.Equal(t, uint32(42), msg.Nonce)
+	assert.Equal(t, []byte("payload"), msg.Payload)
+	assert.False(t, msg.Unreliable)
+	assert.Equal(t, acc[:], msg.TxHash[:])
+}
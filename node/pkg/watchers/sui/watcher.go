@@ -20,6 +20,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 
@@ -38,6 +39,7 @@ type (
 		suiRPC           string
 		suiWS            string
 		suiMoveEventType string
+		httpClient       *http.Client
 
 		unsafeDevMode bool
 
@@ -87,23 +89,13 @@ type (
 		} `json:"params"`
 	}
 
-	SuiTxnQueryError struct {
-		Jsonrpc string `json:"jsonrpc"`
-		Error   struct {
-			Code    int     `json:"code"`
-			Message *string `json:"message"`
-		} `json:"error"`
-		ID int `json:"id"`
-	}
-
-	SuiTxnQuery struct {
-		Jsonrpc string      `json:"jsonrpc"`
-		Result  []SuiResult `json:"result"`
-		ID      int         `json:"id"`
-	}
+	// SuiTxnBlockQuery is the response to sui_getTransactionBlock with the showEvents and showEffects options.
 	// 	{
 	//   "jsonrpc": "2.0",
-	//   "result": [
+	//   "result": {
+	//     "digest": "6Yff8smmPZMandj6Psjy6wgZv5Deii78o1Sbghh5sHPA",
+	//     "effects": {"status": {"status": "success"}, ...},
+	//     "events": [
 	//     {
 	//       "id": {
 	//         "txDigest": "6Yff8smmPZMandj6Psjy6wgZv5Deii78o1Sbghh5sHPA",
@@ -123,9 +115,31 @@ type (
 	//       },
 	//       "bcs": "5ZuknLT3Xsicr2D8zyk828thPByMBfR1cPJyEHF67k16AcEotDWhrpCDCTbk6BBbpSSs3bUk3msfADzrs"
 	//     }
-	//   ],
+	//     ],
+	//     "timestampMs": "1681411389000",
+	//     "checkpoint": "1234"
+	//   },
 	//   "id": 1
 	// }
+	SuiTxnBlockQuery struct {
+		Jsonrpc string `json:"jsonrpc"`
+		Result  *struct {
+			Digest     *string `json:"digest"`
+			Checkpoint *string `json:"checkpoint"`
+			Effects    *struct {
+				Status struct {
+					Status string  `json:"status"`
+					Error  *string `json:"error"`
+				} `json:"status"`
+			} `json:"effects"`
+			Events []SuiResult `json:"events"`
+		} `json:"result"`
+		Error *struct {
+			Code    int     `json:"code"`
+			Message *string `json:"message"`
+		} `json:"error"`
+		ID int `json:"id"`
+	}
 
 	SuiCheckpointSN struct {
 		Jsonrpc string `json:"jsonrpc"`
@@ -165,6 +179,7 @@ func NewWatcher(
 		suiRPC:           suiRPC,
 		suiWS:            suiWS,
 		suiMoveEventType: suiMoveEventType,
+		httpClient:       &http.Client{Transport: ratelimit.DefaultRegistry.Transport(suiRPC, nil)},
 		unsafeDevMode:    unsafeDevMode,
 		msgChan:          messageEvents,
 		obsvReqC:         obsvReqC,
@@ -260,6 +275,65 @@ func (e *Watcher) inspectBody(logger *zap.Logger, body SuiResult) error {
 	return nil
 }
 
+// reobserve fetches the transaction block with the given digest and republishes the wormhole messages it emitted.
+// Only transactions that succeeded and are included in a checkpoint are considered.
+func (e *Watcher) reobserve(ctx context.Context, logger *zap.Logger, digest []byte) error {
+	tx58 := base58.Encode(digest)
+
+	buf := fmt.Sprintf(`{"jsonrpc":"2.0", "id": 1, "method": "sui_getTransactionBlock", "params": ["%s", {"showEvents": true, "showEffects": true}]}`, tx58)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.suiRPC, strings.NewReader(buf))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sui_getTransactionBlock failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read sui_getTransactionBlock response: %w", err)
+	}
+
+	logger.Debug("receive", zap.String("body", string(body)))
+
+	var res SuiTxnBlockQuery
+	if err := json.Unmarshal(body, &res); err != nil {
+		return fmt.Errorf("failed to unmarshal sui_getTransactionBlock response: %w", err)
+	}
+	if res.Error != nil {
+		msg := ""
+		if res.Error.Message != nil {
+			msg = *res.Error.Message
+		}
+		return fmt.Errorf("sui_getTransactionBlock returned error %d: %s", res.Error.Code, msg)
+	}
+	if res.Result == nil {
+		return errors.New("sui_getTransactionBlock returned no result")
+	}
+	if res.Result.Checkpoint == nil {
+		return errors.New("transaction is not included in a checkpoint yet")
+	}
+	if res.Result.Effects == nil || res.Result.Effects.Status.Status != "success" {
+		return errors.New("transaction did not succeed")
+	}
+
+	for i, event := range res.Result.Events {
+		// Transactions usually emit events of other modules as well.
+		if event.Type == nil || *event.Type != e.suiMoveEventType {
+			continue
+		}
+		if err := e.inspectBody(logger, event); err != nil {
+			logger.Info("skipping event data in result", zap.String("txhash", tx58), zap.Int("index", i), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
 func (e *Watcher) Run(ctx context.Context) error {
 	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDSui, &gossipv1.Heartbeat_Network{
 		ContractAddress: e.suiMoveEventType,
@@ -373,7 +447,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 				return ctx.Err()
 
 			case <-timer.C:
-				resp, err := http.Post(e.suiRPC, "application/json", strings.NewReader(`{"jsonrpc":"2.0", "id": 1, "method": "sui_getLatestCheckpointSequenceNumber", "params": []}`))
+				resp, err := e.httpClient.Post(e.suiRPC, "application/json", strings.NewReader(`{"jsonrpc":"2.0", "id": 1, "method": "sui_getLatestCheckpointSequenceNumber", "params": []}`))
 				if err != nil {
 					logger.Error("sui_getLatestCheckpointSequenceNumber failed", zap.Error(err))
 					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
@@ -407,6 +481,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 						Height:          int64(height),
 						ContractAddress: e.suiMoveEventType,
 					})
+					p2p.DefaultRegistry.SetNetworkHeights(vaa.ChainIDSui, p2p.InstantFinalityHeights(int64(height)))
 				}
 
 				readiness.SetReady(e.readinessSync)
@@ -425,57 +500,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 					panic("invalid chain ID")
 				}
 
-				tx58 := base58.Encode(r.TxHash)
-
-				buf := fmt.Sprintf(`{"jsonrpc":"2.0", "id": 1, "method": "sui_getEvents", "params": ["%s"]}`, tx58)
-
-				resp, err := http.Post(e.suiRPC, "application/json", strings.NewReader(buf))
-				if err != nil {
-					logger.Error("getEvents API failed", zap.String("suiRPC", e.suiRPC), zap.Error(err))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
-					continue
-				}
-
-				body, err := io.ReadAll(resp.Body)
-				if err != nil {
-					logger.Error("unexpected truncated body when calling getEvents", zap.Error(err))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
-					return fmt.Errorf("sui__fetch_obvs_req failed to post: %w", err)
-
-				}
-				resp.Body.Close()
-
-				logger.Debug("receive", zap.String("body", string(body)))
-
-				// Do we have an error?
-				var err_res SuiTxnQueryError
-				err = json.Unmarshal(body, &err_res)
-				if err != nil {
-					logger.Error("Failed to unmarshal event error message", zap.String("Result", string(body)))
+				if err := e.reobserve(ctx, logger, r.TxHash); err != nil {
+					logger.Error("failed to process observation request", zap.String("txhash", base58.Encode(r.TxHash)), zap.Error(err))
 					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
-					return err
-				}
-
-				if err_res.Error.Message != nil {
-					logger.Error("Failed to get events for re-observation request, detected error", zap.String("Result", string(body)))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
-					// Don't need to kill the watcher on this error. So, just continue.
-					continue
-				}
-				var res SuiTxnQuery
-				err = json.Unmarshal(body, &res)
-				if err != nil {
-					logger.Error("failed to unmarshal event message", zap.Error(err))
-					p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
-					return fmt.Errorf("sui__fetch_obvs_req failed to unmarshal: %w", err)
-
-				}
-
-				for i, chunk := range res.Result {
-					err := e.inspectBody(logger, chunk)
-					if err != nil {
-						logger.Info("skipping event data in result", zap.String("txhash", tx58), zap.Int("index", i), zap.Error(err))
-					}
 				}
 			}
 		}
@@ -0,0 +1,104 @@
+package sui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	testEventType = "0x7483d0db53a140eed72bd6cb133daa59c539844f4c053924b9e3f0d2d7ba146d::publish_message::WormholeMessage"
+	testDigest    = "6Yff8smmPZMandj6Psjy6wgZv5Deii78o1Sbghh5sHPA"
+)
+
+func txBlockResponse(status string, checkpoint string) string {
+	return fmt.Sprintf(`{
+  "jsonrpc": "2.0",
+  "result": {
+    "digest": "%[1]s",
+    "effects": {"status": {"status": "%[3]s"}},
+    "events": [
+      {
+        "id": {"txDigest": "%[1]s", "eventSeq": "0"},
+        "type": "0x2::coin::CoinEvent",
+        "parsedJson": {}
+      },
+      {
+        "id": {"txDigest": "%[1]s", "eventSeq": "1"},
+        "type": "%[2]s",
+        "parsedJson": {
+          "consistency_level": 0,
+          "nonce": 7,
+          "payload": [104, 101, 108, 108, 111],
+          "sender": "0x71c2aa2c549bb7381e88fbeca7eeb791be0afd455c8af9184613ce5db5ddba47",
+          "sequence": "42",
+          "timestamp": "1681411389"
+        }
+      }
+    ],
+    %[4]s
+    "timestampMs": "1681411389000"
+  },
+  "id": 1
+}`, testDigest, testEventType, status, checkpoint)
+}
+
+func newTestWatcher(t *testing.T, response string) (*Watcher, chan *common.MessagePublication) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.True(t, strings.Contains(string(b), `"sui_getTransactionBlock"`))
+		assert.True(t, strings.Contains(string(b), testDigest))
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+
+	msgC := make(chan *common.MessagePublication, 10)
+	return NewWatcher(srv.URL, "", testEventType, false, msgC, make(chan *gossipv1.ObservationRequest)), msgC
+}
+
+func TestReobserve(t *testing.T) {
+	w, msgC := newTestWatcher(t, txBlockResponse("success", `"checkpoint": "1234",`))
+
+	digest, err := base58.Decode(testDigest)
+	require.NoError(t, err)
+	require.NoError(t, w.reobserve(context.Background(), zap.NewNop(), digest))
+
+	require.Equal(t, 1, len(msgC))
+	msg := <-msgC
+	assert.Equal(t, vaa.ChainIDSui, msg.EmitterChain)
+	assert.Equal(t, uint64(42), msg.Sequence)
+	assert.Equal(t, uint32(7), msg.Nonce)
+	assert.Equal(t, []byte("hello"), msg.Payload)
+	assert.Equal(t, digest, msg.TxHash.Bytes())
+}
+
+func TestReobserveRejectsUnfinalizedOrFailedTransactions(t *testing.T) {
+	digest, err := base58.Decode(testDigest)
+	require.NoError(t, err)
+
+	w, msgC := newTestWatcher(t, txBlockResponse("success", ""))
+	assert.Error(t, w.reobserve(context.Background(), zap.NewNop(), digest))
+	assert.Equal(t, 0, len(msgC))
+
+	w, msgC = newTestWatcher(t, txBlockResponse("failure", `"checkpoint": "1234",`))
+	assert.Error(t, w.reobserve(context.Background(), zap.NewNop(), digest))
+	assert.Equal(t, 0, len(msgC))
+
+	w, msgC = newTestWatcher(t, `{"jsonrpc": "2.0", "error": {"code": -32602, "message": "Could not find the referenced transaction"}, "id": 1}`)
+	assert.Error(t, w.reobserve(context.Background(), zap.NewNop(), digest))
+	assert.Equal(t, 0, len(msgC))
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -41,6 +42,15 @@ type (
 		obsvReqC <-chan *gossipv1.ObservationRequest
 
 		readinessSync readiness.Component
+
+		// gatewayChannels maps the IBC channels of wormchain to the chains they connect to. It is used to set the Gateway
+		// source chain of the messages, and may be nil.
+		gatewayChannels GatewayChannelResolver
+	}
+
+	// GatewayChannelResolver returns the chain connected to wormchain by an IBC channel.
+	GatewayChannelResolver interface {
+		ChainForChannel(channelID string) (vaa.ChainID, bool)
 	}
 )
 
@@ -88,6 +98,12 @@ func NewWatcher(
 	}
 }
 
+// SetGatewayChannels sets the IBC channel mapping used to determine the Gateway source chain of messages. It must be
+// called before the watcher is started.
+func (e *Watcher) SetGatewayChannels(channels GatewayChannelResolver) {
+	e.gatewayChannels = channels
+}
+
 func (e *Watcher) Run(ctx context.Context) error {
 	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDWormchain, &gossipv1.Heartbeat_Network{})
 
@@ -96,6 +112,9 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 	logger.Info("connecting to websocket", zap.String("url", e.urlWS))
 
+	if err := ratelimit.DefaultRegistry.ForURL(e.urlWS).Wait(ctx); err != nil {
+		return err
+	}
 	c, _, err := websocket.DefaultDialer.DialContext(ctx, e.urlWS, nil)
 	if err != nil {
 		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDWormchain, 1)
@@ -134,7 +153,8 @@ func (e *Watcher) Run(ctx context.Context) error {
 	go func() {
 		t := time.NewTicker(5 * time.Second)
 		client := &http.Client{
-			Timeout: time.Second * 5,
+			Timeout:   time.Second * 5,
+			Transport: ratelimit.DefaultRegistry.Transport(e.urlLCD, nil),
 		}
 
 		for {
@@ -162,6 +182,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 			p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDWormchain, &gossipv1.Heartbeat_Network{
 				Height: latestBlock.Int(),
 			})
+			p2p.DefaultRegistry.SetNetworkHeights(vaa.ChainIDWormchain, p2p.InstantFinalityHeights(latestBlock.Int()))
 
 			readiness.SetReady(e.readinessSync)
 		}
@@ -184,7 +205,8 @@ func (e *Watcher) Run(ctx context.Context) error {
 					zap.String("tx_hash", tx))
 
 				client := &http.Client{
-					Timeout: time.Second * 5,
+					Timeout:   time.Second * 5,
+					Transport: ratelimit.DefaultRegistry.Transport(e.urlLCD, nil),
 				}
 
 				// Query for tx by hash
@@ -217,6 +239,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 				}
 
 				msgs := EventsToMessagePublications(txHash, events.Array(), logger)
+				e.setGatewaySourceChain(txHash, events.Array(), msgs, logger)
 				for _, msg := range msgs {
 					e.msgC <- msg
 					wormchainMessagesConfirmed.Inc()
@@ -255,6 +278,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 			}
 
 			msgs := EventsToMessagePublications(txHash, events.Array(), logger)
+			e.setGatewaySourceChain(txHash, events.Array(), msgs, logger)
 			for _, msg := range msgs {
 				e.msgC <- msg
 				wormchainMessagesConfirmed.Inc()
@@ -292,41 +316,7 @@ func EventsToMessagePublications(txHash string, events []gjson.Result, logger *z
 			logger.Warn("wormchain message event has no attributes", zap.String("tx_hash", txHash), zap.String("event", event.String()))
 			continue
 		}
-		mappedAttributes := map[string]string{}
-		for _, attribute := range attributes.Array() {
-			if !attribute.IsObject() {
-				logger.Warn("wormchain event attribute is invalid", zap.String("tx_hash", txHash), zap.String("attribute", attribute.String()))
-				continue
-			}
-			keyBase := gjson.Get(attribute.String(), "key")
-			if !keyBase.Exists() {
-				logger.Warn("wormchain event attribute does not have key", zap.String("tx_hash", txHash), zap.String("attribute", attribute.String()))
-				continue
-			}
-			valueBase := gjson.Get(attribute.String(), "value")
-			if !valueBase.Exists() {
-				logger.Warn("wormchain event attribute does not have value", zap.String("tx_hash", txHash), zap.String("attribute", attribute.String()))
-				continue
-			}
-
-			key, err := base64.StdEncoding.DecodeString(keyBase.String())
-			if err != nil {
-				logger.Warn("wormchain event key attribute is invalid", zap.String("tx_hash", txHash), zap.String("key", keyBase.String()))
-				continue
-			}
-			value, err := base64.StdEncoding.DecodeString(valueBase.String())
-			if err != nil {
-				logger.Warn("wormchain event value attribute is invalid", zap.String("tx_hash", txHash), zap.String("key", keyBase.String()), zap.String("value", valueBase.String()))
-				continue
-			}
-
-			if _, ok := mappedAttributes[string(key)]; ok {
-				logger.Debug("duplicate key in events", zap.String("tx_hash", txHash), zap.String("key", keyBase.String()), zap.String("value", valueBase.String()))
-				continue
-			}
-
-			mappedAttributes[string(key)] = string(value)
-		}
+		mappedAttributes := eventAttributes(txHash, attributes, logger)
 
 		payload, ok := mappedAttributes["payload"]
 		if !ok {
@@ -420,6 +410,92 @@ func EventsToMessagePublications(txHash string, events []gjson.Result, logger *z
 	return msgs
 }
 
+// eventAttributes returns the decoded attributes of a wormchain event. Attributes which cannot be decoded are skipped.
+func eventAttributes(txHash string, attributes gjson.Result, logger *zap.Logger) map[string]string {
+	mappedAttributes := map[string]string{}
+	for _, attribute := range attributes.Array() {
+		if !attribute.IsObject() {
+			logger.Warn("wormchain event attribute is invalid", zap.String("tx_hash", txHash), zap.String("attribute", attribute.String()))
+			continue
+		}
+		keyBase := gjson.Get(attribute.String(), "key")
+		if !keyBase.Exists() {
+			logger.Warn("wormchain event attribute does not have key", zap.String("tx_hash", txHash), zap.String("attribute", attribute.String()))
+			continue
+		}
+		valueBase := gjson.Get(attribute.String(), "value")
+		if !valueBase.Exists() {
+			logger.Warn("wormchain event attribute does not have value", zap.String("tx_hash", txHash), zap.String("attribute", attribute.String()))
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(keyBase.String())
+		if err != nil {
+			logger.Warn("wormchain event key attribute is invalid", zap.String("tx_hash", txHash), zap.String("key", keyBase.String()))
+			continue
+		}
+		value, err := base64.StdEncoding.DecodeString(valueBase.String())
+		if err != nil {
+			logger.Warn("wormchain event value attribute is invalid", zap.String("tx_hash", txHash), zap.String("key", keyBase.String()), zap.String("value", valueBase.String()))
+			continue
+		}
+
+		if _, ok := mappedAttributes[string(key)]; ok {
+			logger.Debug("duplicate key in events", zap.String("tx_hash", txHash), zap.String("key", keyBase.String()), zap.String("value", valueBase.String()))
+			continue
+		}
+
+		mappedAttributes[string(key)] = string(value)
+	}
+	return mappedAttributes
+}
+
+// gatewaySourceChain returns the chain a wormchain transaction relayed IBC packets from, based on the recv_packet events
+// emitted by the IBC module. Unlike the payload of a message, these events cannot be chosen by the sender of a transfer,
+// since contracts can only emit events prefixed with "wasm-". ChainIDUnset is returned if the transaction did not
+// receive a packet, if a channel is not known, or if the packets came from different chains.
+func gatewaySourceChain(txHash string, events []gjson.Result, channels GatewayChannelResolver, logger *zap.Logger) vaa.ChainID {
+	sourceChain := vaa.ChainIDUnset
+	for _, event := range events {
+		if !event.IsObject() || gjson.Get(event.String(), "type").String() != "recv_packet" {
+			continue
+		}
+
+		attributes := eventAttributes(txHash, gjson.Get(event.String(), "attributes"), logger)
+		channelID, ok := attributes["packet_dst_channel"]
+		if !ok {
+			logger.Warn("wormchain recv_packet event does not have a packet_dst_channel field", zap.String("tx_hash", txHash))
+			return vaa.ChainIDUnset
+		}
+
+		chainID, ok := channels.ChainForChannel(channelID)
+		if !ok {
+			logger.Warn("wormchain received a packet over an unknown IBC channel", zap.String("tx_hash", txHash), zap.String("channel_id", channelID))
+			return vaa.ChainIDUnset
+		}
+
+		if sourceChain != vaa.ChainIDUnset && sourceChain != chainID {
+			logger.Warn("wormchain transaction received packets from multiple chains", zap.String("tx_hash", txHash))
+			return vaa.ChainIDUnset
+		}
+		sourceChain = chainID
+	}
+
+	return sourceChain
+}
+
+// setGatewaySourceChain sets the Gateway source chain of the messages of a wormchain transaction.
+func (e *Watcher) setGatewaySourceChain(txHash string, events []gjson.Result, msgs []*common.MessagePublication, logger *zap.Logger) {
+	if e.gatewayChannels == nil || len(msgs) == 0 {
+		return
+	}
+
+	sourceChain := gatewaySourceChain(txHash, events, e.gatewayChannels, logger)
+	for _, msg := range msgs {
+		msg.GatewaySourceChain = sourceChain
+	}
+}
+
 // TODO this encoding comes out of the logs oddly, and probably requires a change on the chain
 // StringToAddress convert string into address
 func StringToAddress(value string) (vaa.Address, error) {
@@ -1,11 +1,15 @@
 package wormchain
 
 import (
+	"encoding/base64"
+	"fmt"
 	"testing"
 
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
 )
 
 func TestStringToUInt(t *testing.T) {
@@ -116,3 +120,43 @@ func TestStringToHash(t *testing.T) {
 		})
 	}
 }
+
+type mockGatewayChannels map[string]vaa.ChainID
+
+func (m mockGatewayChannels) ChainForChannel(channelID string) (vaa.ChainID, bool) {
+	chainID, exists := m[channelID]
+	return chainID, exists
+}
+
+func recvPacketEvent(eventType string, channelID string) string {
+	b64 := base64.StdEncoding.EncodeToString
+	return fmt.Sprintf(`{"type":%q,"attributes":[{"key":%q,"value":%q},{"key":%q,"value":%q}]}`,
+		eventType, b64([]byte("packet_src_channel")), b64([]byte("channel-0")), b64([]byte("packet_dst_channel")), b64([]byte(channelID)))
+}
+
+func TestGatewaySourceChain(t *testing.T) {
+	channels := mockGatewayChannels{"channel-1": vaa.ChainIDSei, "channel-2": vaa.ChainIDSei, "channel-3": vaa.ChainIDInjective}
+
+	tests := []struct {
+		name   string
+		events []string
+		chain  vaa.ChainID
+	}{
+		{name: "one packet", events: []string{recvPacketEvent("recv_packet", "channel-1")}, chain: vaa.ChainIDSei},
+		{name: "packets from the same chain", events: []string{recvPacketEvent("recv_packet", "channel-1"), recvPacketEvent("recv_packet", "channel-2")}, chain: vaa.ChainIDSei},
+		{name: "packets from different chains", events: []string{recvPacketEvent("recv_packet", "channel-1"), recvPacketEvent("recv_packet", "channel-3")}},
+		{name: "unknown channel", events: []string{recvPacketEvent("recv_packet", "channel-4")}},
+		{name: "event emitted by a contract", events: []string{recvPacketEvent("wasm-recv_packet", "channel-1")}},
+		{name: "no packet"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			events := make([]gjson.Result, 0, len(tc.events))
+			for _, event := range tc.events {
+				events = append(events, gjson.Parse(event))
+			}
+			assert.Equal(t, tc.chain, gatewaySourceChain("tx", events, channels, zap.NewNop()))
+		})
+	}
+}
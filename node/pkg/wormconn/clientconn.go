@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 
-	"github.com/btcsuite/btcutil/bech32"
 	wormchain "github.com/wormhole-foundation/wormchain/app"
 
 	"google.golang.org/grpc"
@@ -29,30 +31,75 @@ type ClientConn struct {
 	encCfg        EncodingConfig
 	privateKey    cryptotypes.PrivKey
 	senderAddress string
+	chainID       string
+	feeGranter    string
 	mutex         sync.Mutex // Protects the account / sequence number
 }
 
-// NewConn creates a new connection to the wormhole-chain instance at `target`.
-func NewConn(ctx context.Context, target string, privateKey cryptotypes.PrivKey) (*ClientConn, error) {
+// NewConn creates a new connection to the wormhole-chain instance at `target`. It queries the chain ID of the node and
+// returns an error if it does not match `expectedChainID`, so that a guardian pointed at the wrong network fails fast.
+// If `privateKey` is nil, the connection can only broadcast transactions signed elsewhere with SignTx.
+func NewConn(ctx context.Context, target string, privateKey cryptotypes.PrivKey, expectedChainID string) (*ClientConn, error) {
+	if expectedChainID == "" {
+		return nil, fmt.Errorf("expected chain ID must be specified")
+	}
+
 	c, err := grpc.DialContext(
 		ctx,
 		target,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(metricsUnaryInterceptor),
+		grpc.WithChainStreamInterceptor(metricsStreamInterceptor),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	chainID, err := queryChainID(ctx, c)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	if chainID != expectedChainID {
+		c.Close()
+		return nil, fmt.Errorf("wormchain node at %s is on chain ID %s, expected %s, check that you are connecting to the correct network", target, chainID, expectedChainID)
+	}
+
 	encCfg := MakeEncodingConfig(wormchain.ModuleBasics)
 
-	senderAddress, err := generateSenderAddress(privateKey)
+	var senderAddress string
+	if privateKey != nil {
+		senderAddress, err = generateSenderAddress(privateKey)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+
+	return &ClientConn{c: c, encCfg: encCfg, privateKey: privateKey, senderAddress: senderAddress, chainID: chainID}, nil
+}
+
+// queryChainID returns the chain ID (the tendermint network name) reported by the node.
+func queryChainID(ctx context.Context, c *grpc.ClientConn) (string, error) {
+	resp, err := tmservice.NewServiceClient(c).GetNodeInfo(ctx, &tmservice.GetNodeInfoRequest{})
 	if err != nil {
-		return nil, err
+		return "", fmt.Errorf("failed to query wormchain node info: %w", err)
 	}
 
-	return &ClientConn{c: c, encCfg: encCfg, privateKey: privateKey, senderAddress: senderAddress}, nil
+	nodeInfo := resp.GetDefaultNodeInfo()
+	if nodeInfo == nil || nodeInfo.Network == "" {
+		return "", fmt.Errorf("wormchain node info does not contain a chain ID")
+	}
+
+	return nodeInfo.Network, nil
+}
+
+// ChainID returns the chain ID of the wormchain instance, as validated when the connection was established.
+func (c *ClientConn) ChainID() string {
+	return c.chainID
 }
 
+// SenderAddress returns the wormchain address of the signing key, or an empty string if the connection has none.
 func (c *ClientConn) SenderAddress() string {
 	return c.senderAddress
 }
@@ -77,19 +124,43 @@ func (c *ClientConn) BroadcastTxResponseToString(txResp *sdktx.BroadcastTxRespon
 	return string(out)
 }
 
-// generateSenderAddress creates the sender address from the private key. It is based on https://pkg.go.dev/github.com/btcsuite/btcutil/bech32#Encode
-func generateSenderAddress(privateKey cryptotypes.PrivKey) (string, error) {
-	data, err := hex.DecodeString(privateKey.PubKey().Address().String())
+// SetFeeGranter makes the transactions signed by the connection use the fee allowance granted by the account at
+// address with the feegrant module, so that the key of the connection can run with a zero balance while a central
+// account pays the fees. It must be called before any transaction is sent.
+func (c *ClientConn) SetFeeGranter(address string) error {
+	if _, err := decodeWormchainAddress(address); err != nil {
+		return fmt.Errorf("invalid fee granter: %w", err)
+	}
+	c.feeGranter = address
+	return nil
+}
+
+// FeeGranter returns the address of the account paying the fees of the transactions, or an empty string if the sender pays them.
+func (c *ClientConn) FeeGranter() string {
+	return c.feeGranter
+}
+
+// decodeWormchainAddress decodes a bech32 wormchain account address, like the ones created by generateSenderAddress.
+func decodeWormchainAddress(address string) (sdktypes.AccAddress, error) {
+	hrp, data, err := bech32.DecodeAndConvert(address)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate public key, failed to hex decode string: %w", err)
+		return nil, fmt.Errorf("failed to decode address %s: %w", address, err)
+	}
+	if hrp != "wormhole" {
+		return nil, fmt.Errorf("address %s is not a wormchain address", address)
 	}
 
-	conv, err := bech32.ConvertBits(data, 8, 5, true)
+	return sdktypes.AccAddress(data), nil
+}
+
+// generateSenderAddress creates the sender address from the private key.
+func generateSenderAddress(privateKey cryptotypes.PrivKey) (string, error) {
+	data, err := hex.DecodeString(privateKey.PubKey().Address().String())
 	if err != nil {
-		return "", fmt.Errorf("failed to generate public key, failed to convert bits: %w", err)
+		return "", fmt.Errorf("failed to generate public key, failed to hex decode string: %w", err)
 	}
 
-	encoded, err := bech32.Encode("wormhole", conv)
+	encoded, err := bech32.ConvertAndEncode("wormhole", data)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate public key, bech32 encode failed: %w", err)
 	}
@@ -0,0 +1,91 @@
+package wormconn
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/proto/tendermint/p2p"
+	"google.golang.org/grpc"
+)
+
+type mockNodeInfoServer struct {
+	tmservice.UnimplementedServiceServer
+	network string
+}
+
+func (s *mockNodeInfoServer) GetNodeInfo(ctx context.Context, req *tmservice.GetNodeInfoRequest) (*tmservice.GetNodeInfoResponse, error) {
+	return &tmservice.GetNodeInfoResponse{DefaultNodeInfo: &p2p.DefaultNodeInfo{Network: s.network}}, nil
+}
+
+// startMockNode starts a gRPC server that reports the specified chain ID and returns its address.
+func startMockNode(t *testing.T, network string) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	tmservice.RegisterServiceServer(s, &mockNodeInfoServer{network: network})
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestNewConnValidatesChainID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	target := startMockNode(t, "wormchain")
+	privKey := secp256k1.GenPrivKey()
+
+	conn, err := NewConn(ctx, target, privKey, "wormchain")
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Equal(t, "wormchain", conn.ChainID())
+
+	_, err = NewConn(ctx, target, privKey, "wormchain-testnet-0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is on chain ID wormchain, expected wormchain-testnet-0")
+
+	_, err = NewConn(ctx, target, privKey, "")
+	assert.Error(t, err)
+}
+
+func TestNewConnFailsIfChainIDIsMissing(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	target := startMockNode(t, "")
+	_, err := NewConn(ctx, target, secp256k1.GenPrivKey(), "wormchain")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not contain a chain ID")
+}
+
+func TestSetFeeGranter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := NewConn(ctx, startMockNode(t, "wormchain"), secp256k1.GenPrivKey(), "wormchain")
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Empty(t, conn.FeeGranter())
+
+	granter, err := generateSenderAddress(secp256k1.GenPrivKey())
+	require.NoError(t, err)
+	require.NoError(t, conn.SetFeeGranter(granter))
+	assert.Equal(t, granter, conn.FeeGranter())
+
+	// Addresses of other chains are rejected, and the previous granter is kept.
+	cosmosAddress, err := bech32.ConvertAndEncode("cosmos", secp256k1.GenPrivKey().PubKey().Address())
+	require.NoError(t, err)
+	assert.ErrorContains(t, conn.SetFeeGranter(cosmosAddress), "not a wormchain address")
+	assert.ErrorContains(t, conn.SetFeeGranter("not an address"), "invalid fee granter")
+	assert.Equal(t, granter, conn.FeeGranter())
+}
@@ -0,0 +1,191 @@
+package wormconn
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// Components reading the events of a wormchain contract, like the accountant, use SubscribeContractEvents instead of
+// managing a tendermint subscription of their own. The subscription is restarted whenever the websocket fails, so
+// events emitted while it is down are missed and must be recovered by other means, like the audit of the accountant.
+
+const (
+	// contractEventsCapacity is the capacity of the channel returned by SubscribeContractEvents.
+	contractEventsCapacity = 64
+
+	// minResubscribeDelay and maxResubscribeDelay bound the delay before a failed subscription is restarted. The delay
+	// doubles after each consecutive failure and is reset once a subscription succeeds.
+	minResubscribeDelay = time.Second
+	maxResubscribeDelay = time.Minute
+)
+
+var contractEventSubscriptionErrors = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormconn_contract_event_subscription_errors_total",
+		Help: "Total number of failures of the wormchain contract event subscriptions, by contract",
+	}, []string{"contract"})
+
+// ContractEvent is an event emitted by a contract in a successful transaction.
+type ContractEvent struct {
+	// TxHash is the hex encoded hash of the transaction.
+	TxHash string
+	Height int64
+	// Type is the type of the event, like "wasm-Observation" for an "Observation" event emitted by the contract.
+	Type string
+	// Attributes are the decoded attributes of the event, except for the contract address.
+	Attributes []TendermintEventAttribute
+}
+
+// ContractEventFilter returns whether the events of a type should be delivered.
+type ContractEventFilter func(eventType string) bool
+
+// EventTypes returns a filter accepting the events of the given types.
+func EventTypes(types ...string) ContractEventFilter {
+	accepted := make(map[string]struct{}, len(types))
+	for _, t := range types {
+		accepted[t] = struct{}{}
+	}
+	return func(eventType string) bool {
+		_, exists := accepted[eventType]
+		return exists
+	}
+}
+
+// SubscribeContractEvents subscribes to the events emitted by the contract at contractAddr through the tendermint
+// websocket at wsURL and returns the events accepted by eventFilter, or all of them if it is nil. The subscription
+// reconnects on its own until ctx is canceled, and then the channel is closed.
+func SubscribeContractEvents(ctx context.Context, logger *zap.Logger, wsURL string, contractAddr string, eventFilter ContractEventFilter) <-chan ContractEvent {
+	eventsC := make(chan ContractEvent, contractEventsCapacity)
+
+	go func() {
+		defer close(eventsC)
+
+		delay := minResubscribeDelay
+		for {
+			subscribed, err := readContractEvents(ctx, logger, wsURL, contractAddr, eventFilter, eventsC)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if subscribed {
+				delay = minResubscribeDelay
+			}
+			contractEventSubscriptionErrors.WithLabelValues(contractAddr).Inc()
+			logger.Warn("contract event subscription failed, resubscribing",
+				zap.String("contract", contractAddr),
+				zap.Duration("delay", delay),
+				zap.Error(err),
+			)
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			delay *= 2
+			if delay > maxResubscribeDelay {
+				delay = maxResubscribeDelay
+			}
+		}
+	}()
+
+	return eventsC
+}
+
+// readContractEvents subscribes to the transactions of the contract and sends their events to eventsC until the
+// subscription fails. It returns whether the subscription was established.
+func readContractEvents(ctx context.Context, logger *zap.Logger, wsURL string, contractAddr string, eventFilter ContractEventFilter, eventsC chan<- ContractEvent) (bool, error) {
+	sub, err := SubscribeTendermint(ctx, wsURL, fmt.Sprintf("tm.event='Tx' AND wasm._contract_address='%s'", contractAddr))
+	if err != nil {
+		return false, err
+	}
+	defer sub.Close()
+
+	logger.Info("subscribed to contract events", zap.String("contract", contractAddr))
+
+	for {
+		tx, err := sub.Next(ctx)
+		if err != nil {
+			return true, err
+		}
+		if tx.Result.Code != 0 {
+			continue
+		}
+
+		for _, event := range tx.Result.Events {
+			contractEvent, emitted := contractEventOf(tx, event.Type, decodeEventAttributes(event.Attributes), contractAddr)
+			if !emitted || (eventFilter != nil && !eventFilter(event.Type)) {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return true, ctx.Err()
+			case eventsC <- contractEvent:
+			}
+		}
+	}
+}
+
+// contractEventOf returns the event with the given decoded attributes as a ContractEvent, and whether it was emitted by
+// the contract at contractAddr. A transaction may include events of other contracts, for instance if it executes several
+// messages.
+func contractEventOf(tx *TendermintTxEvent, eventType string, attributes []TendermintEventAttribute, contractAddr string) (ContractEvent, bool) {
+	evt := ContractEvent{TxHash: tx.TxHash, Height: tx.Height, Type: eventType}
+	emitted := false
+	for _, attribute := range attributes {
+		if attribute.Key == "_contract_address" {
+			if attribute.Value != contractAddr {
+				return ContractEvent{}, false
+			}
+			emitted = true
+			continue
+		}
+		evt.Attributes = append(evt.Attributes, attribute)
+	}
+	return evt, emitted
+}
+
+// decodeEventAttributes returns the attributes of an event with their keys and values decoded. The attributes are
+// base64 encoded by tendermint up to v0.36 and emitted as they are by CometBFT v0.37 and later. They are considered base64
+// encoded if all keys and values are valid base64 and all keys decode to printable text. The keys of wasm events, like
+// _contract_address, are not valid base64, so they cannot be mistaken for encoded ones.
+func decodeEventAttributes(attributes []TendermintEventAttribute) []TendermintEventAttribute {
+	decoded := make([]TendermintEventAttribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		key, err := base64.StdEncoding.DecodeString(attribute.Key)
+		if err != nil || len(key) == 0 || !isPrintable(key) {
+			return attributes
+		}
+		value, err := base64.StdEncoding.DecodeString(attribute.Value)
+		if err != nil {
+			return attributes
+		}
+		decoded = append(decoded, TendermintEventAttribute{Key: string(key), Value: string(value), Index: attribute.Index})
+	}
+	return decoded
+}
+
+// isPrintable returns whether b is valid UTF-8 text without control characters.
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
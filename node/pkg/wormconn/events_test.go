@@ -0,0 +1,134 @@
+package wormconn
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+const testContract = "wormhole1466nf3zuxpya8q9emxukd7vftaf6h4psr0a07srl5zw74zh84yjq4lyjmh"
+
+func base64Attribute(key string, value string) TendermintEventAttribute {
+	return TendermintEventAttribute{
+		Key:   base64.StdEncoding.EncodeToString([]byte(key)),
+		Value: base64.StdEncoding.EncodeToString([]byte(value)),
+		Index: true,
+	}
+}
+
+func TestDecodeEventAttributes(t *testing.T) {
+	decoded := []TendermintEventAttribute{{Key: "_contract_address", Value: testContract, Index: true}, {Key: "sequence", Value: "3", Index: true}}
+
+	// Tendermint up to v0.36.
+	assert.Equal(t, decoded, decodeEventAttributes([]TendermintEventAttribute{
+		base64Attribute("_contract_address", testContract),
+		base64Attribute("sequence", "3"),
+	}))
+
+	// CometBFT v0.37 and later.
+	assert.Equal(t, decoded, decodeEventAttributes(decoded))
+}
+
+func TestEventTypes(t *testing.T) {
+	filter := EventTypes("wasm-Observation", "wasm-Modification")
+	assert.True(t, filter("wasm-Observation"))
+	assert.True(t, filter("wasm-Modification"))
+	assert.False(t, filter("wasm-ObservationError"))
+	assert.False(t, filter("wasm"))
+}
+
+func TestSubscribeContractEvents(t *testing.T) {
+	// The first transaction has base64 encoded attributes and includes events of another contract and of a type which
+	// is filtered out. The second one has raw attributes and is sent on the second connection.
+	firstTx, err := MarshalTendermintTxEvent(&TendermintTxEvent{
+		TxHash: "82EA2536C5D1671830CB49120F94479E34B54596A8DD369FBC2666667A765F4B",
+		Height: 10,
+		Result: TendermintTxResult{Events: []TendermintEvent{
+			{Type: "wasm-Observation", Attributes: []TendermintEventAttribute{base64Attribute("_contract_address", "wormhole1other"), base64Attribute("sequence", "1")}},
+			{Type: "wasm-Observation", Attributes: []TendermintEventAttribute{base64Attribute("_contract_address", testContract), base64Attribute("sequence", "2")}},
+			{Type: "wasm-Other", Attributes: []TendermintEventAttribute{base64Attribute("_contract_address", testContract)}},
+		}},
+	})
+	require.NoError(t, err)
+	failedTx, err := MarshalTendermintTxEvent(&TendermintTxEvent{
+		TxHash: "00",
+		Height: 11,
+		Result: TendermintTxResult{Code: 5, Events: []TendermintEvent{
+			{Type: "wasm-Observation", Attributes: []TendermintEventAttribute{{Key: "_contract_address", Value: testContract}}},
+		}},
+	})
+	require.NoError(t, err)
+	secondTx, err := MarshalTendermintTxEvent(&TendermintTxEvent{
+		TxHash: "9A1F4A7A6A0DD7B0A0E08E6AE4A0C0BDB6F2AB4C7C3E8A4F2C25E0E1A6C2F3D1",
+		Height: 12,
+		Result: TendermintTxResult{Events: []TendermintEvent{
+			{Type: "wasm-Modification", Attributes: []TendermintEventAttribute{{Key: "_contract_address", Value: testContract}, {Key: "sequence", Value: "3"}}},
+		}},
+	})
+	require.NoError(t, err)
+
+	var connections atomic.Int32
+	var query atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := websocket.Accept(w, r, nil)
+		require.NoError(t, err)
+		defer c.Close(websocket.StatusNormalClosure, "")
+
+		ctx := r.Context()
+		var req tendermintRequest
+		require.NoError(t, wsjson.Read(ctx, c, &req))
+		query.Store(req.Params["query"])
+		require.NoError(t, c.Write(ctx, websocket.MessageText, []byte(`{"jsonrpc": "2.0", "id": 1, "result": {}}`)))
+
+		if connections.Add(1) == 1 {
+			// Drop the first connection after a transaction.
+			require.NoError(t, c.Write(ctx, websocket.MessageText, firstTx))
+			require.NoError(t, c.Write(ctx, websocket.MessageText, failedTx))
+			return
+		}
+
+		require.NoError(t, c.Write(ctx, websocket.MessageText, secondTx))
+		_, _, _ = c.Read(ctx)
+	}))
+	defer server.Close()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/websocket"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	events := SubscribeContractEvents(ctx, zap.NewNop(), wsURL, testContract, EventTypes("wasm-Observation", "wasm-Modification"))
+
+	evt := <-events
+	assert.Equal(t, ContractEvent{
+		TxHash:     "82EA2536C5D1671830CB49120F94479E34B54596A8DD369FBC2666667A765F4B",
+		Height:     10,
+		Type:       "wasm-Observation",
+		Attributes: []TendermintEventAttribute{{Key: "sequence", Value: "2", Index: true}},
+	}, evt)
+	assert.Equal(t, "tm.event='Tx' AND wasm._contract_address='"+testContract+"'", query.Load())
+
+	evt = <-events
+	assert.Equal(t, ContractEvent{
+		TxHash:     "9A1F4A7A6A0DD7B0A0E08E6AE4A0C0BDB6F2AB4C7C3E8A4F2C25E0E1A6C2F3D1",
+		Height:     12,
+		Type:       "wasm-Modification",
+		Attributes: []TendermintEventAttribute{{Key: "sequence", Value: "3"}},
+	}, evt)
+	assert.Equal(t, int32(2), connections.Load())
+
+	// The channel is closed once the context is canceled.
+	cancel()
+	for range events {
+	}
+}
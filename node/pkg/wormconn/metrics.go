@@ -0,0 +1,59 @@
+package wormconn
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wormconn_grpc_request_duration_seconds",
+			Help:    "Latency of the gRPC requests to wormchain, by method",
+			Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		}, []string{"method"})
+	grpcRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormconn_grpc_requests_total",
+			Help: "Total number of gRPC requests to wormchain, by method and status code",
+		}, []string{"method", "code"})
+	grpcRequestsInFlight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormconn_grpc_requests_in_flight",
+			Help: "Current number of gRPC requests to wormchain awaiting a response, by method",
+		}, []string{"method"})
+)
+
+// observeRequest starts tracking a request and returns the function recording its outcome.
+func observeRequest(method string) func(err error) {
+	start := time.Now()
+	grpcRequestsInFlight.WithLabelValues(method).Inc()
+	return func(err error) {
+		grpcRequestsInFlight.WithLabelValues(method).Dec()
+		grpcRequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	}
+}
+
+// metricsUnaryInterceptor records the latency, the status code and the in-flight count of the unary requests, which
+// cover all the queries and broadcasts of the connection.
+func metricsUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	done := observeRequest(method)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	done(err)
+	return err
+}
+
+// metricsStreamInterceptor records the establishment of the streaming requests. The lifetime of the stream itself is
+// not tracked.
+func metricsStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	done := observeRequest(method)
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	done(err)
+	return stream, err
+}
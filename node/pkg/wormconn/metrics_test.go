@@ -0,0 +1,37 @@
+package wormconn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnRecordsRequestMetrics(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	const nodeInfo = "/cosmos.base.tendermint.v1beta1.Service/GetNodeInfo"
+	const broadcast = "/cosmos.tx.v1beta1.Service/BroadcastTx"
+	okBefore := testutil.ToFloat64(grpcRequests.WithLabelValues(nodeInfo, "OK"))
+	unimplementedBefore := testutil.ToFloat64(grpcRequests.WithLabelValues(broadcast, "Unimplemented"))
+
+	target := startMockNode(t, "wormchain")
+	conn, err := NewConn(ctx, target, secp256k1.GenPrivKey(), "wormchain")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The chain ID query of NewConn goes through the interceptor.
+	assert.Equal(t, okBefore+1, testutil.ToFloat64(grpcRequests.WithLabelValues(nodeInfo, "OK")))
+
+	// The mock node does not implement the tx service, so broadcasts fail.
+	_, err = conn.BroadcastSignedTx(ctx, []byte{1, 2, 3})
+	require.Error(t, err)
+	assert.Equal(t, unimplementedBefore+1, testutil.ToFloat64(grpcRequests.WithLabelValues(broadcast, "Unimplemented")))
+
+	assert.Equal(t, float64(0), testutil.ToFloat64(grpcRequestsInFlight.WithLabelValues(broadcast)))
+}
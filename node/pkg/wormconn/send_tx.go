@@ -5,66 +5,105 @@ import (
 	"fmt"
 
 	txclient "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	wormchain "github.com/wormhole-foundation/wormchain/app"
 )
 
-func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
-	// Lock to protect the wallet sequence number.
-	c.mutex.Lock()
-	defer c.mutex.Unlock()
+// Transactions can be signed and broadcast in one go with SignAndBroadcastTx, or in two steps so that the wormchain key
+// never has to be on a networked host: the account state is fetched with SignerAccount on the networked host, the
+// transaction is signed with SignTx on an air-gapped host, and the raw signed bytes are submitted with BroadcastSignedTx.
+
+// txGasLimit is the gas limit of the transactions we sign.
+const txGasLimit = 2000000 // TODO: Maybe simulate and use the result
 
+// SignerAccount is the account state needed to sign a transaction offline.
+type SignerAccount struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+
+	// FeeGranter is the address of an account that granted the signer a fee allowance with the feegrant module, and
+	// pays the fees of its transactions. If it is empty, the signer pays the fees itself.
+	FeeGranter string
+}
+
+// SignerAccount fetches the chain ID, account number and next sequence number of the account with the given address.
+func (c *ClientConn) SignerAccount(ctx context.Context, address string) (SignerAccount, error) {
 	authClient := auth.NewQueryClient(c.c)
-	accountQuery := &auth.QueryAccountRequest{
-		Address: c.senderAddress,
-	}
-	resp, err := authClient.Account(ctx, accountQuery)
+	resp, err := authClient.Account(ctx, &auth.QueryAccountRequest{Address: address})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch account: %w", err)
+		return SignerAccount{}, fmt.Errorf("failed to fetch account: %w", err)
 	}
 
 	var account auth.AccountI
 	if err := c.encCfg.InterfaceRegistry.UnpackAny(resp.Account, &account); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal account info: %w", err)
+		return SignerAccount{}, fmt.Errorf("failed to unmarshal account info: %w", err)
 	}
 
-	builder := c.encCfg.TxConfig.NewTxBuilder()
+	return SignerAccount{
+		ChainID:       c.chainID,
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+		FeeGranter:    c.feeGranter,
+	}, nil
+}
+
+// SignTx signs a transaction containing msg with the given key and returns its raw bytes, ready to be passed to
+// BroadcastSignedTx. It does not require a connection to wormchain, so it can be used on an air-gapped host.
+func SignTx(privateKey cryptotypes.PrivKey, account SignerAccount, msg sdktypes.Msg) ([]byte, error) {
+	return signTx(MakeEncodingConfig(wormchain.ModuleBasics), privateKey, account, msg)
+}
+
+func signTx(encCfg EncodingConfig, privateKey cryptotypes.PrivKey, account SignerAccount, msg sdktypes.Msg) ([]byte, error) {
+	if account.ChainID == "" {
+		return nil, fmt.Errorf("chain ID must be specified")
+	}
+
+	builder := encCfg.TxConfig.NewTxBuilder()
 	if err := builder.SetMsgs(msg); err != nil {
 		return nil, fmt.Errorf("failed to add message to builder: %w", err)
 	}
-	builder.SetGasLimit(2000000) // TODO: Maybe simulate and use the result
+	builder.SetGasLimit(txGasLimit)
+	if account.FeeGranter != "" {
+		feeGranter, err := decodeWormchainAddress(account.FeeGranter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee granter: %w", err)
+		}
+		builder.SetFeeGranter(feeGranter)
+	}
 
 	// The tx needs to be signed in 2 passes: first we populate the SignerInfo
 	// inside the TxBuilder and then sign the payload.
-	sequence := account.GetSequence()
 	sig := signing.SignatureV2{
-		PubKey: c.privateKey.PubKey(),
+		PubKey: privateKey.PubKey(),
 		Data: &signing.SingleSignatureData{
-			SignMode:  c.encCfg.TxConfig.SignModeHandler().DefaultMode(),
+			SignMode:  encCfg.TxConfig.SignModeHandler().DefaultMode(),
 			Signature: nil,
 		},
-		Sequence: sequence,
+		Sequence: account.Sequence,
 	}
 	if err := builder.SetSignatures(sig); err != nil {
 		return nil, fmt.Errorf("failed to set SignerInfo: %w", err)
 	}
 
 	signerData := authsigning.SignerData{
-		ChainID:       "wormchain",
-		AccountNumber: account.GetAccountNumber(),
-		Sequence:      sequence,
+		ChainID:       account.ChainID,
+		AccountNumber: account.AccountNumber,
+		Sequence:      account.Sequence,
 	}
 
-	sig, err = txclient.SignWithPrivKey(
-		c.encCfg.TxConfig.SignModeHandler().DefaultMode(),
+	sig, err := txclient.SignWithPrivKey(
+		encCfg.TxConfig.SignModeHandler().DefaultMode(),
 		signerData,
 		builder,
-		c.privateKey,
-		c.encCfg.TxConfig,
-		sequence,
+		privateKey,
+		encCfg.TxConfig,
+		account.Sequence,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign tx: %w", err)
@@ -73,11 +112,16 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 		return nil, fmt.Errorf("failed to update tx signature: %w", err)
 	}
 
-	txBytes, err := c.encCfg.TxConfig.TxEncoder()(builder.GetTx())
+	txBytes, err := encCfg.TxConfig.TxEncoder()(builder.GetTx())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal tx: %w", err)
 	}
 
+	return txBytes, nil
+}
+
+// BroadcastSignedTx broadcasts a transaction signed with SignTx and waits for it to be included in a block.
+func (c *ClientConn) BroadcastSignedTx(ctx context.Context, txBytes []byte) (*sdktx.BroadcastTxResponse, error) {
 	client := sdktx.NewServiceClient(c.c)
 
 	// Returns *BroadcastTxResponse
@@ -94,3 +138,26 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 
 	return txResp, nil
 }
+
+// SignAndBroadcastTx signs a transaction containing msg with the key of the connection and broadcasts it.
+func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
+	if c.privateKey == nil {
+		return nil, fmt.Errorf("connection has no signing key, sign the transaction with SignTx and use BroadcastSignedTx instead")
+	}
+
+	// Lock to protect the wallet sequence number.
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	account, err := c.SignerAccount(ctx, c.senderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := signTx(c.encCfg, c.privateKey, account, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.BroadcastSignedTx(ctx, txBytes)
+}
@@ -0,0 +1,123 @@
+package wormconn
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	wormchain "github.com/wormhole-foundation/wormchain/app"
+	"google.golang.org/grpc"
+)
+
+type mockTxServer struct {
+	sdktx.UnimplementedServiceServer
+	txBytes []byte
+}
+
+func (s *mockTxServer) BroadcastTx(ctx context.Context, req *sdktx.BroadcastTxRequest) (*sdktx.BroadcastTxResponse, error) {
+	s.txBytes = req.TxBytes
+	return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{TxHash: "ABCD"}}, nil
+}
+
+func TestSignTxOfflineAndBroadcast(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	privKey := secp256k1.GenPrivKey()
+	msg := banktypes.NewMsgSend(
+		sdktypes.AccAddress(privKey.PubKey().Address()),
+		sdktypes.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdktypes.NewCoins(sdktypes.NewInt64Coin("uworm", 1)),
+	)
+
+	// Sign without any connection to wormchain.
+	account := SignerAccount{ChainID: "wormchain", AccountNumber: 7, Sequence: 42}
+	txBytes, err := SignTx(privKey, account, msg)
+	require.NoError(t, err)
+
+	encCfg := MakeEncodingConfig(wormchain.ModuleBasics)
+	decoded, err := encCfg.TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	sigTx, ok := decoded.(authsigning.SigVerifiableTx)
+	require.True(t, ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	assert.Equal(t, uint64(42), sigs[0].Sequence)
+	assert.True(t, sigs[0].PubKey.Equals(privKey.PubKey()))
+
+	// Broadcast from a connection that does not hold the key.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	tmservice.RegisterServiceServer(s, &mockNodeInfoServer{network: "wormchain"})
+	txServer := &mockTxServer{}
+	sdktx.RegisterServiceServer(s, txServer)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := NewConn(ctx, lis.Addr().String(), nil, "wormchain")
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Empty(t, conn.SenderAddress())
+
+	resp, err := conn.BroadcastSignedTx(ctx, txBytes)
+	require.NoError(t, err)
+	assert.Equal(t, "ABCD", resp.TxResponse.TxHash)
+	assert.Equal(t, txBytes, txServer.txBytes)
+
+	_, err = conn.SignAndBroadcastTx(ctx, msg)
+	assert.ErrorContains(t, err, "no signing key")
+}
+
+func TestSignTxRequiresChainID(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	msg := banktypes.NewMsgSend(
+		sdktypes.AccAddress(privKey.PubKey().Address()),
+		sdktypes.AccAddress(privKey.PubKey().Address()),
+		sdktypes.NewCoins(sdktypes.NewInt64Coin("uworm", 1)),
+	)
+	_, err := SignTx(privKey, SignerAccount{}, msg)
+	assert.ErrorContains(t, err, "chain ID")
+}
+
+func TestSignTxWithFeeGranter(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	msg := banktypes.NewMsgSend(
+		sdktypes.AccAddress(privKey.PubKey().Address()),
+		sdktypes.AccAddress(privKey.PubKey().Address()),
+		sdktypes.NewCoins(sdktypes.NewInt64Coin("uworm", 1)),
+	)
+
+	granterKey := secp256k1.GenPrivKey()
+	granter, err := generateSenderAddress(granterKey)
+	require.NoError(t, err)
+
+	txBytes, err := SignTx(privKey, SignerAccount{ChainID: "wormchain", FeeGranter: granter}, msg)
+	require.NoError(t, err)
+
+	decoded, err := MakeEncodingConfig(wormchain.ModuleBasics).TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	feeTx, ok := decoded.(sdktypes.FeeTx)
+	require.True(t, ok)
+	assert.Equal(t, sdktypes.AccAddress(granterKey.PubKey().Address()), feeTx.FeeGranter())
+
+	// Without a fee granter, the signer pays.
+	txBytes, err = SignTx(privKey, SignerAccount{ChainID: "wormchain"}, msg)
+	require.NoError(t, err)
+	decoded, err = MakeEncodingConfig(wormchain.ModuleBasics).TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	assert.Empty(t, decoded.(sdktypes.FeeTx).FeeGranter())
+
+	_, err = SignTx(privKey, SignerAccount{ChainID: "wormchain", FeeGranter: "wormhole1invalid"}, msg)
+	assert.ErrorContains(t, err, "invalid fee granter")
+}
@@ -0,0 +1,412 @@
+package wormconn
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/ratelimit"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+// TendermintReadLimit is the maximum size of a message read from the tendermint websocket. The default limit of the
+// websocket library is not large enough for transactions with many events. The cosmwasm watchers use it too.
+const TendermintReadLimit = 524288
+
+type (
+	// TendermintEventAttribute is an attribute of a tendermint event. The key and value are returned as the node emits
+	// them, which is base64 encoded up to tendermint v0.36 and as is for CometBFT v0.37 and later.
+	TendermintEventAttribute struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+		Index bool   `json:"index"`
+	}
+
+	// TendermintEvent is an event emitted by a transaction or a block.
+	TendermintEvent struct {
+		Type       string                     `json:"type"`
+		Attributes []TendermintEventAttribute `json:"attributes"`
+	}
+
+	// TendermintTxResult is the result of the execution of a transaction.
+	TendermintTxResult struct {
+		Code      uint32            `json:"code"`
+		Codespace string            `json:"codespace"`
+		Log       string            `json:"log"`
+		GasWanted int64             `json:"gas_wanted,string"`
+		GasUsed   int64             `json:"gas_used,string"`
+		Events    []TendermintEvent `json:"events"`
+	}
+
+	// TendermintTx is a transaction included in a block, as returned by the tx and tx_search endpoints.
+	TendermintTx struct {
+		Hash     string             `json:"hash"`
+		Height   int64              `json:"height,string"`
+		Index    uint32             `json:"index"`
+		TxResult TendermintTxResult `json:"tx_result"`
+		Tx       []byte             `json:"tx"`
+	}
+
+	// TendermintTxSearchResult is a page of the transactions matching a tx_search query.
+	TendermintTxSearchResult struct {
+		Txs        []TendermintTx `json:"txs"`
+		TotalCount int            `json:"total_count,string"`
+	}
+
+	// TendermintBlockResults are the results of the transactions of a block and the events emitted by the block itself.
+	TendermintBlockResults struct {
+		Height           int64                `json:"height,string"`
+		TxsResults       []TendermintTxResult `json:"txs_results"`
+		BeginBlockEvents []TendermintEvent    `json:"begin_block_events"`
+		EndBlockEvents   []TendermintEvent    `json:"end_block_events"`
+	}
+
+	// TendermintBlock is a block, as returned by the block endpoint. Only the fields needed to identify its
+	// transactions are decoded.
+	TendermintBlock struct {
+		Header struct {
+			Height int64     `json:"height,string"`
+			Time   time.Time `json:"time"`
+		} `json:"header"`
+		Data struct {
+			// Txs are the raw transactions of the block, in the order of their results in TendermintBlockResults.
+			Txs [][]byte `json:"txs"`
+		} `json:"data"`
+	}
+
+	// TendermintStatus is the status of a tendermint node.
+	TendermintStatus struct {
+		NodeInfo struct {
+			Network string `json:"network"`
+			Version string `json:"version"`
+			Moniker string `json:"moniker"`
+		} `json:"node_info"`
+		SyncInfo struct {
+			LatestBlockHeight int64     `json:"latest_block_height,string"`
+			LatestBlockTime   time.Time `json:"latest_block_time"`
+			CatchingUp        bool      `json:"catching_up"`
+		} `json:"sync_info"`
+	}
+
+	// TendermintTxEvent is a transaction event received from a subscription.
+	TendermintTxEvent struct {
+		// Query is the query of the subscription.
+		Query string
+		// TxHash is the hex encoded hash of the transaction.
+		TxHash string
+		Height int64
+		Index  uint32
+		Result TendermintTxResult
+	}
+
+	// TendermintRPCError is an error returned by a tendermint JSON-RPC endpoint.
+	TendermintRPCError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	}
+)
+
+func (e *TendermintRPCError) Error() string {
+	if e.Data != "" {
+		return fmt.Sprintf("tendermint RPC error %d: %s: %s", e.Code, e.Message, e.Data)
+	}
+	return fmt.Sprintf("tendermint RPC error %d: %s", e.Code, e.Message)
+}
+
+// tendermintRequest is a JSON-RPC request. Tendermint expects integer parameters as strings.
+type tendermintRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      uint64            `json:"id"`
+	Method  string            `json:"method"`
+	Params  map[string]string `json:"params"`
+}
+
+// tendermintResponse is a JSON-RPC response.
+type tendermintResponse struct {
+	JSONRPC string              `json:"jsonrpc"`
+	ID      json.RawMessage     `json:"id"`
+	Result  json.RawMessage     `json:"result"`
+	Error   *TendermintRPCError `json:"error"`
+}
+
+// TendermintHTTPURL returns the URL of the JSON-RPC HTTP endpoint of a tendermint node from the URL of its websocket or
+// HTTP endpoint, for instance http://wormchain:26657 for ws://wormchain:26657/websocket.
+func TendermintHTTPURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse tendermint URL: %w", err)
+	}
+
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
+	case "wss":
+		u.Scheme = "https"
+	case "http", "https":
+	default:
+		return "", fmt.Errorf("unsupported tendermint URL scheme %q", u.Scheme)
+	}
+
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/websocket")
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
+// TendermintClient queries the JSON-RPC endpoints of a tendermint node over HTTP.
+type TendermintClient struct {
+	url    string
+	client *http.Client
+	nextID atomic.Uint64
+}
+
+// NewTendermintClient creates a client for the tendermint node at rawURL, which may be the URL of its websocket or HTTP
+// endpoint. If client is nil, a client with a ten second timeout is used. The requests are subject to the rate limit of
+// the endpoint in ratelimit.DefaultRegistry.
+func NewTendermintClient(rawURL string, client *http.Client) (*TendermintClient, error) {
+	httpURL, err := TendermintHTTPURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	limited := &http.Client{Timeout: 10 * time.Second}
+	if client != nil {
+		*limited = *client
+	}
+	limited.Transport = ratelimit.DefaultRegistry.Transport(httpURL, limited.Transport)
+	return &TendermintClient{url: httpURL, client: limited}, nil
+}
+
+// call sends a JSON-RPC request and unmarshals its result into result.
+func (c *TendermintClient) call(ctx context.Context, method string, params map[string]string, result interface{}) error {
+	body, err := json.Marshal(tendermintRequest{JSONRPC: "2.0", ID: c.nextID.Add(1), Method: method, Params: params})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s request: %w", method, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create %s request: %w", method, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %w", method, err)
+	}
+
+	var rpcResp tendermintResponse
+	if err := json.Unmarshal(respBody, &rpcResp); err != nil {
+		return fmt.Errorf("failed to parse %s response (status %d): %w", method, resp.StatusCode, err)
+	}
+	if rpcResp.Error != nil {
+		return fmt.Errorf("%s request failed: %w", method, rpcResp.Error)
+	}
+	if err := json.Unmarshal(rpcResp.Result, result); err != nil {
+		return fmt.Errorf("failed to parse %s result: %w", method, err)
+	}
+	return nil
+}
+
+// Status returns the status of the node, including its version and latest block.
+func (c *TendermintClient) Status(ctx context.Context) (*TendermintStatus, error) {
+	var status TendermintStatus
+	if err := c.call(ctx, "status", map[string]string{}, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// Tx returns the transaction with the given hash.
+func (c *TendermintClient) Tx(ctx context.Context, hash []byte) (*TendermintTx, error) {
+	var tx TendermintTx
+	if err := c.call(ctx, "tx", map[string]string{"hash": base64.StdEncoding.EncodeToString(hash)}, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// TxSearch returns a page of the transactions matching a query, like "wasm._contract_address='wormhole1...'". Pages
+// start at one.
+func (c *TendermintClient) TxSearch(ctx context.Context, query string, page int, perPage int) (*TendermintTxSearchResult, error) {
+	params := map[string]string{
+		"query":    query,
+		"page":     strconv.Itoa(page),
+		"per_page": strconv.Itoa(perPage),
+		"order_by": "asc",
+	}
+	var result TendermintTxSearchResult
+	if err := c.call(ctx, "tx_search", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BlockResults returns the results of the block at the given height.
+func (c *TendermintClient) BlockResults(ctx context.Context, height int64) (*TendermintBlockResults, error) {
+	var result TendermintBlockResults
+	if err := c.call(ctx, "block_results", map[string]string{"height": strconv.FormatInt(height, 10)}, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Block returns the block at the given height.
+func (c *TendermintClient) Block(ctx context.Context, height int64) (*TendermintBlock, error) {
+	var result struct {
+		Block TendermintBlock `json:"block"`
+	}
+	if err := c.call(ctx, "block", map[string]string{"height": strconv.FormatInt(height, 10)}, &result); err != nil {
+		return nil, err
+	}
+	return &result.Block, nil
+}
+
+// TendermintTxHash returns the hash of a raw transaction, hex encoded in upper case like tendermint does.
+func TendermintTxHash(tx []byte) string {
+	hash := sha256.Sum256(tx)
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+// TendermintSubscription receives the events matching a query from the tendermint websocket.
+type TendermintSubscription struct {
+	c *websocket.Conn
+}
+
+// SubscribeTendermint connects to the tendermint websocket at wsURL and subscribes to the events matching a query, like
+// "tm.event='Tx' AND wasm._contract_address='wormhole1...'".
+func SubscribeTendermint(ctx context.Context, wsURL string, query string) (*TendermintSubscription, error) {
+	if err := ratelimit.DefaultRegistry.ForURL(wsURL).Wait(ctx); err != nil {
+		return nil, err
+	}
+	c, _, err := websocket.Dial(ctx, wsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to establish tendermint websocket connection: %w", err)
+	}
+	c.SetReadLimit(TendermintReadLimit)
+
+	req := tendermintRequest{JSONRPC: "2.0", ID: 1, Method: "subscribe", Params: map[string]string{"query": query}}
+	if err := wsjson.Write(ctx, c, req); err != nil {
+		c.Close(websocket.StatusNormalClosure, "")
+		return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+	}
+
+	// Wait for the response to the subscription request.
+	var resp tendermintResponse
+	if err := wsjson.Read(ctx, c, &resp); err != nil {
+		c.Close(websocket.StatusNormalClosure, "")
+		return nil, fmt.Errorf("failed to receive response to subscribe request: %w", err)
+	}
+	if resp.Error != nil {
+		c.Close(websocket.StatusNormalClosure, "")
+		return nil, fmt.Errorf("failed to subscribe to events: %w", resp.Error)
+	}
+
+	return &TendermintSubscription{c: c}, nil
+}
+
+// ReadRaw blocks until the next message is received and returns it as it was received.
+func (s *TendermintSubscription) ReadRaw(ctx context.Context) ([]byte, error) {
+	_, message, err := s.c.Read(ctx)
+	return message, err
+}
+
+// Next blocks until the next transaction event is received and returns it.
+func (s *TendermintSubscription) Next(ctx context.Context) (*TendermintTxEvent, error) {
+	message, err := s.ReadRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTendermintTxEvent(message)
+}
+
+// Close closes the websocket connection, which ends the subscription.
+func (s *TendermintSubscription) Close() {
+	s.c.Close(websocket.StatusNormalClosure, "")
+}
+
+// tendermintTxEventResult is the result of a transaction event message.
+type tendermintTxEventResult struct {
+	Query string `json:"query"`
+	Data  struct {
+		Type  string `json:"type"`
+		Value struct {
+			TxResult struct {
+				Height int64              `json:"height,string"`
+				Index  uint32             `json:"index"`
+				Result TendermintTxResult `json:"result"`
+			} `json:"TxResult"`
+		} `json:"value"`
+	} `json:"data"`
+	Events map[string][]string `json:"events"`
+}
+
+// ParseTendermintTxEvent parses a transaction event message received from a tendermint subscription.
+func ParseTendermintTxEvent(message []byte) (*TendermintTxEvent, error) {
+	var resp tendermintResponse
+	if err := json.Unmarshal(message, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse event message: %w", err)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("subscription failed: %w", resp.Error)
+	}
+
+	var result tendermintTxEventResult
+	if err := json.Unmarshal(resp.Result, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+	if result.Data.Type != "tendermint/event/Tx" {
+		return nil, fmt.Errorf("unexpected event type %q", result.Data.Type)
+	}
+
+	txHashes := result.Events["tx.hash"]
+	if len(txHashes) == 0 {
+		return nil, fmt.Errorf("event does not have a tx hash")
+	}
+
+	return &TendermintTxEvent{
+		Query:  result.Query,
+		TxHash: txHashes[0],
+		Height: result.Data.Value.TxResult.Height,
+		Index:  result.Data.Value.TxResult.Index,
+		Result: result.Data.Value.TxResult.Result,
+	}, nil
+}
+
+// MarshalTendermintTxEvent returns the message a tendermint subscription to evt.Query would have sent for evt. It is the
+// inverse of ParseTendermintTxEvent, for sources of events other than the websocket.
+func MarshalTendermintTxEvent(evt *TendermintTxEvent) ([]byte, error) {
+	var result tendermintTxEventResult
+	result.Query = evt.Query
+	result.Data.Type = "tendermint/event/Tx"
+	result.Data.Value.TxResult.Height = evt.Height
+	result.Data.Value.TxResult.Index = evt.Index
+	result.Data.Value.TxResult.Result = evt.Result
+	result.Events = map[string][]string{
+		"tx.hash":   {evt.TxHash},
+		"tx.height": {strconv.FormatInt(evt.Height, 10)},
+	}
+
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return json.Marshal(tendermintResponse{JSONRPC: "2.0", ID: json.RawMessage("0"), Result: resultBytes})
+}
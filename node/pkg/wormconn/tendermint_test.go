@@ -0,0 +1,212 @@
+package wormconn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+const testTxEventMessage = `{"jsonrpc": "2.0", "id": 1, "result": {"query": "tm.event='Tx'", "data": {"type": "tendermint/event/Tx", "value": {"TxResult": {"height": "2613", "index": 1, "tx": "", "result": {"gas_wanted": "200000", "gas_used": "150000", "events": [{"type": "wasm", "attributes": [{"key": "YWN0aW9u", "value": "cmVjZWl2ZV9wdWJsaXNo", "index": true}]}]}}}}, "events": {"tx.hash": ["82EA2536C5D1671830CB49120F94479E34B54596A8DD369FBC2666667A765F4B"]}}}`
+
+// startMockTendermint starts an HTTP server answering the JSON-RPC requests with the given results by method, and
+// returns its URL along with the requests it received.
+func startMockTendermint(t *testing.T, results map[string]string) (string, *[]tendermintRequest) {
+	t.Helper()
+	var requests []tendermintRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req tendermintRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+
+		result, exists := results[req.Method]
+		if !exists {
+			_, _ = w.Write([]byte(`{"jsonrpc": "2.0", "id": 1, "error": {"code": -32601, "message": "Method not found"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc": "2.0", "id": 1, "result": ` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, &requests
+}
+
+func TestTendermintHTTPURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"ws://wormchain:26657/websocket", "http://wormchain:26657"},
+		{"wss://wormchain.example.com/rpc/websocket/", "https://wormchain.example.com/rpc"},
+		{"ws://wormchain:26657", "http://wormchain:26657"},
+		{"http://wormchain:26657", "http://wormchain:26657"},
+	}
+	for _, tc := range tests {
+		httpURL, err := TendermintHTTPURL(tc.url)
+		require.NoError(t, err, tc.url)
+		assert.Equal(t, tc.expected, httpURL, tc.url)
+	}
+
+	_, err := TendermintHTTPURL("tcp://wormchain:26657")
+	assert.Error(t, err)
+}
+
+func TestTendermintClientStatus(t *testing.T) {
+	url, requests := startMockTendermint(t, map[string]string{
+		"status": `{"node_info": {"network": "wormchain", "version": "0.37.2"}, "sync_info": {"latest_block_height": "2613", "latest_block_time": "2023-03-29T14:23:34Z", "catching_up": false}}`,
+	})
+	client, err := NewTendermintClient(url, nil)
+	require.NoError(t, err)
+
+	status, err := client.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "wormchain", status.NodeInfo.Network)
+	assert.Equal(t, "0.37.2", status.NodeInfo.Version)
+	assert.Equal(t, int64(2613), status.SyncInfo.LatestBlockHeight)
+	assert.Equal(t, time.Date(2023, 3, 29, 14, 23, 34, 0, time.UTC), status.SyncInfo.LatestBlockTime)
+	require.Len(t, *requests, 1)
+	assert.Equal(t, "2.0", (*requests)[0].JSONRPC)
+}
+
+func TestTendermintClientTxQueries(t *testing.T) {
+	tx := `{"hash": "82EA2536C5D1671830CB49120F94479E34B54596A8DD369FBC2666667A765F4B", "height": "2613", "index": 1, "tx_result": {"code": 0, "gas_wanted": "200000", "gas_used": "150000", "events": [{"type": "wasm", "attributes": [{"key": "action", "value": "receive_publish", "index": true}]}]}, "tx": "AQI="}`
+	url, requests := startMockTendermint(t, map[string]string{
+		"tx":            tx,
+		"tx_search":     `{"txs": [` + tx + `], "total_count": "1"}`,
+		"block_results": `{"height": "2613", "txs_results": [{"code": 5, "codespace": "wasm", "log": "failed", "events": []}], "begin_block_events": [{"type": "mint", "attributes": []}], "end_block_events": null}`,
+	})
+	client, err := NewTendermintClient(url, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	result, err := client.Tx(ctx, []byte{0x82, 0xea})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2613), result.Height)
+	assert.Equal(t, uint32(1), result.Index)
+	assert.Equal(t, int64(150000), result.TxResult.GasUsed)
+	assert.Equal(t, []byte{1, 2}, result.Tx)
+	require.Len(t, result.TxResult.Events, 1)
+	assert.Equal(t, TendermintEventAttribute{Key: "action", Value: "receive_publish", Index: true}, result.TxResult.Events[0].Attributes[0])
+	assert.Equal(t, "guo=", (*requests)[0].Params["hash"])
+
+	search, err := client.TxSearch(ctx, "wasm.action='receive_publish'", 2, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 1, search.TotalCount)
+	require.Len(t, search.Txs, 1)
+	assert.Equal(t, map[string]string{"query": "wasm.action='receive_publish'", "page": "2", "per_page": "50", "order_by": "asc"}, (*requests)[1].Params)
+
+	block, err := client.BlockResults(ctx, 2613)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2613), block.Height)
+	require.Len(t, block.TxsResults, 1)
+	assert.Equal(t, uint32(5), block.TxsResults[0].Code)
+	assert.Equal(t, "mint", block.BeginBlockEvents[0].Type)
+	assert.Equal(t, "2613", (*requests)[2].Params["height"])
+}
+
+func TestTendermintClientBlock(t *testing.T) {
+	url, requests := startMockTendermint(t, map[string]string{
+		"block": `{"block_id": {"hash": "AB"}, "block": {"header": {"height": "2613", "time": "2023-03-29T14:23:34Z"}, "data": {"txs": ["AQI=", "AwQ="]}}}`,
+	})
+	client, err := NewTendermintClient(url, nil)
+	require.NoError(t, err)
+
+	block, err := client.Block(context.Background(), 2613)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2613), block.Header.Height)
+	assert.Equal(t, time.Date(2023, 3, 29, 14, 23, 34, 0, time.UTC), block.Header.Time)
+	assert.Equal(t, [][]byte{{1, 2}, {3, 4}}, block.Data.Txs)
+	assert.Equal(t, "2613", (*requests)[0].Params["height"])
+}
+
+func TestTendermintTxHash(t *testing.T) {
+	// The hash of an empty transaction is the SHA-256 of nothing.
+	assert.Equal(t, "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855", TendermintTxHash(nil))
+}
+
+func TestMarshalTendermintTxEvent(t *testing.T) {
+	evt, err := ParseTendermintTxEvent([]byte(testTxEventMessage))
+	require.NoError(t, err)
+
+	message, err := MarshalTendermintTxEvent(evt)
+	require.NoError(t, err)
+	roundTrip, err := ParseTendermintTxEvent(message)
+	require.NoError(t, err)
+	assert.Equal(t, evt, roundTrip)
+}
+
+func TestTendermintClientRPCError(t *testing.T) {
+	url, _ := startMockTendermint(t, map[string]string{})
+	client, err := NewTendermintClient(url, nil)
+	require.NoError(t, err)
+
+	_, err = client.Status(context.Background())
+	var rpcErr *TendermintRPCError
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, -32601, rpcErr.Code)
+	assert.ErrorContains(t, err, "status request failed: tendermint RPC error -32601: Method not found")
+}
+
+func TestParseTendermintTxEvent(t *testing.T) {
+	evt, err := ParseTendermintTxEvent([]byte(testTxEventMessage))
+	require.NoError(t, err)
+	assert.Equal(t, "tm.event='Tx'", evt.Query)
+	assert.Equal(t, "82EA2536C5D1671830CB49120F94479E34B54596A8DD369FBC2666667A765F4B", evt.TxHash)
+	assert.Equal(t, int64(2613), evt.Height)
+	assert.Equal(t, uint32(1), evt.Index)
+	assert.Equal(t, int64(200000), evt.Result.GasWanted)
+	require.Len(t, evt.Result.Events, 1)
+	assert.Equal(t, "wasm", evt.Result.Events[0].Type)
+
+	_, err = ParseTendermintTxEvent([]byte(`{"jsonrpc": "2.0", "id": 1, "result": {"query": "tm.event='NewBlock'", "data": {"type": "tendermint/event/NewBlock", "value": {}}}}`))
+	assert.ErrorContains(t, err, "unexpected event type")
+
+	_, err = ParseTendermintTxEvent([]byte(strings.Replace(testTxEventMessage, `"tx.hash"`, `"tx.height"`, 1)))
+	assert.ErrorContains(t, err, "event does not have a tx hash")
+}
+
+func TestSubscribeTendermint(t *testing.T) {
+	var subscribeReq tendermintRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := websocket.Accept(w, r, nil)
+		require.NoError(t, err)
+		defer c.Close(websocket.StatusNormalClosure, "")
+
+		ctx := r.Context()
+		require.NoError(t, wsjson.Read(ctx, c, &subscribeReq))
+		if strings.Contains(subscribeReq.Params["query"], "invalid") {
+			require.NoError(t, c.Write(ctx, websocket.MessageText, []byte(`{"jsonrpc": "2.0", "id": 1, "error": {"code": -32603, "message": "Internal error", "data": "failed to parse query"}}`)))
+			return
+		}
+		require.NoError(t, c.Write(ctx, websocket.MessageText, []byte(`{"jsonrpc": "2.0", "id": 1, "result": {}}`)))
+		require.NoError(t, c.Write(ctx, websocket.MessageText, []byte(testTxEventMessage)))
+
+		// Wait for the client to close the connection.
+		_, _, _ = c.Read(ctx)
+	}))
+	defer server.Close()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/websocket"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	sub, err := SubscribeTendermint(ctx, wsURL, "tm.event='Tx'")
+	require.NoError(t, err)
+	defer sub.Close()
+	assert.Equal(t, "subscribe", subscribeReq.Method)
+	assert.Equal(t, "tm.event='Tx'", subscribeReq.Params["query"])
+
+	evt, err := sub.Next(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2613), evt.Height)
+
+	_, err = SubscribeTendermint(ctx, wsURL, "invalid")
+	assert.ErrorContains(t, err, "failed to subscribe to events: tendermint RPC error -32603: Internal error: failed to parse query")
+}
@@ -0,0 +1,103 @@
+// explain-vaa prints an annotated, human-readable description of a VAA: the named emitter chain and address, the
+// detected payload type and its decoded fields.
+//
+// Usage:
+//
+//	go run ./cmd/explain-vaa -network=mainnet <hex or base64 VAA>
+//	echo <hex or base64 VAA> | go run ./cmd/explain-vaa -json -
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	flagNetwork = flag.String("network", "mainnet", "Network whose well-known emitters are named (mainnet, testnet or devnet)")
+	flagJSON    = flag.Bool("json", false, "Print the explanation as JSON")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <hex or base64 VAA, or - for stdin>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var emitters []sdk.EmitterInfo
+	switch *flagNetwork {
+	case "mainnet":
+		emitters = sdk.KnownEmitters
+	case "testnet":
+		emitters = sdk.KnownTestnetEmitters
+	case "devnet":
+		emitters = sdk.KnownDevnetEmitters
+	default:
+		log.Fatalf("unknown network %q", *flagNetwork)
+	}
+
+	input := flag.Arg(0)
+	if input == "-" {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatalf("failed to read stdin: %v", err)
+		}
+		input = string(b)
+	}
+
+	b, err := decodeVAABytes(strings.TrimSpace(input))
+	if err != nil {
+		log.Fatal(err)
+	}
+	v, err := vaa.Unmarshal(b)
+	if err != nil {
+		log.Fatalf("failed to parse VAA: %v", err)
+	}
+
+	e := vaa.Explain(v, vaa.ExplainOptions{EmitterName: emitterNames(emitters)})
+	if *flagJSON {
+		out, err := json.MarshalIndent(e, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(out))
+		return
+	}
+	fmt.Print(e.String())
+}
+
+// decodeVAABytes decodes a hex VAA, with or without a 0x prefix, or a base64 VAA.
+func decodeVAABytes(s string) ([]byte, error) {
+	if b, err := hex.DecodeString(strings.TrimPrefix(s, "0x")); err == nil {
+		return b, nil
+	}
+	if b, err := base64.StdEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return nil, fmt.Errorf("the VAA is neither hex nor base64")
+}
+
+// emitterNames returns an EmitterName function naming the given emitters.
+func emitterNames(emitters []sdk.EmitterInfo) func(vaa.ChainID, vaa.Address) string {
+	names := make(map[string]string, len(emitters))
+	for _, e := range emitters {
+		names[fmt.Sprintf("%d/%s", e.ChainID, strings.ToLower(e.Emitter))] = e.BridgeType.String()
+	}
+	return func(chainID vaa.ChainID, emitter vaa.Address) string {
+		return names[fmt.Sprintf("%d/%s", chainID, emitter.String())]
+	}
+}
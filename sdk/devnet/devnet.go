@@ -0,0 +1,111 @@
+// Package devnet provides the deterministic guardian keys of the local devnet, along with helpers to build guardian sets
+// and signed VAAs from them, so that the integration tests of applications built on Wormhole can mint VAAs which are
+// valid on devnet. The keys are public knowledge, so these VAAs are worthless anywhere else.
+package devnet
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"fmt"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// GuardianSetIndex is the index of the guardian set the devnet contracts are deployed with.
+const GuardianSetIndex = 0
+
+// maxGuardians is the maximum number of guardians of a guardian set, which is limited by the one byte signature index of VAAs.
+const maxGuardians = 256
+
+// InsecureDeterministicEcdsaKeyByIndex generates a deterministic ecdsa.PrivateKey from a given index.
+func InsecureDeterministicEcdsaKeyByIndex(c elliptic.Curve, idx uint64) *ecdsa.PrivateKey {
+	// use 555 as offset to deterministically generate key 0 to match vaa-test such that
+	// we generate the same key.
+	r := mathrand.New(mathrand.NewSource(int64(555 + idx))) //#nosec G404 Testnet/devnet keys are not secret.
+	key, err := ecdsa.GenerateKey(c, r)
+	if err != nil {
+		panic(err)
+	}
+
+	return key
+}
+
+// GuardianKey returns the key of the devnet guardian with the given index, i.e. the key of guardian-<idx>.
+func GuardianKey(idx int) *ecdsa.PrivateKey {
+	return InsecureDeterministicEcdsaKeyByIndex(crypto.S256(), uint64(idx))
+}
+
+// GuardianSet is a guardian set of devnet guardians, whose keys are known and can sign VAAs.
+type GuardianSet struct {
+	Index uint32
+	Keys  []*ecdsa.PrivateKey
+}
+
+// NewGuardianSet returns the devnet guardian set with the given number of guardians, which is the number of guardians
+// the devnet was started with.
+func NewGuardianSet(numGuardians int) (*GuardianSet, error) {
+	if numGuardians <= 0 || numGuardians > maxGuardians {
+		return nil, fmt.Errorf("invalid number of guardians: %d", numGuardians)
+	}
+
+	keys := make([]*ecdsa.PrivateKey, numGuardians)
+	for i := range keys {
+		keys[i] = GuardianKey(i)
+	}
+	return &GuardianSet{Index: GuardianSetIndex, Keys: keys}, nil
+}
+
+// Addresses returns the addresses of the guardians, as they are registered in the core contracts.
+func (gs *GuardianSet) Addresses() []common.Address {
+	addrs := make([]common.Address, len(gs.Keys))
+	for i, key := range gs.Keys {
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return addrs
+}
+
+// Sign replaces the signatures of v with the signatures of all the guardians and sets its guardian set index.
+func (gs *GuardianSet) Sign(v *vaa.VAA) {
+	gs.signWith(v, len(gs.Keys))
+}
+
+// SignQuorum is like Sign, but only the first guardians needed to reach quorum sign v.
+func (gs *GuardianSet) SignQuorum(v *vaa.VAA) {
+	gs.signWith(v, vaa.CalculateQuorum(len(gs.Keys)))
+}
+
+// signWith replaces the signatures of v with the signatures of the first n guardians.
+func (gs *GuardianSet) signWith(v *vaa.VAA, n int) {
+	v.GuardianSetIndex = gs.Index
+	v.Signatures = nil
+	for i := 0; i < n; i++ {
+		v.AddSignature(gs.Keys[i], uint8(i))
+	}
+}
+
+// NewVAA returns an unsigned VAA with the given emitter, sequence and payload, and defaults suitable for tests for the
+// other fields: the current time rounded down to the second as timestamp, a nonce of zero and a consistency level of 1.
+func NewVAA(emitterChain vaa.ChainID, emitterAddress vaa.Address, sequence uint64, payload []byte) *vaa.VAA {
+	return &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: GuardianSetIndex,
+		Timestamp:        time.Now().Truncate(time.Second),
+		Nonce:            0,
+		Sequence:         sequence,
+		ConsistencyLevel: 1,
+		EmitterChain:     emitterChain,
+		EmitterAddress:   emitterAddress,
+		Payload:          payload,
+	}
+}
+
+// SignedVAA returns a VAA with the given emitter, sequence and payload, signed by all the guardians, in its wire format.
+func (gs *GuardianSet) SignedVAA(emitterChain vaa.ChainID, emitterAddress vaa.Address, sequence uint64, payload []byte) ([]byte, error) {
+	v := NewVAA(emitterChain, emitterAddress, sequence, payload)
+	gs.Sign(v)
+	return v.Marshal()
+}
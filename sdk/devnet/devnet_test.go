@@ -0,0 +1,54 @@
+package devnet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestGuardianKey(t *testing.T) {
+	// The key of guardian-0, which is configured in the devnet contracts.
+	assert.Equal(t, "cfb12303a19cde580bb4dd771639b0d26bc68353645571a8cff516ab2ee113a0", hex.EncodeToString(crypto.FromECDSA(GuardianKey(0))))
+	assert.Equal(t, "0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe", crypto.PubkeyToAddress(GuardianKey(0).PublicKey).Hex())
+	assert.Equal(t, "c3b2e45c422a1602333a64078aeb42637370b0f48fe385f9cfa6ad54a8e0c47e", hex.EncodeToString(crypto.FromECDSA(GuardianKey(1))))
+}
+
+func TestNewGuardianSet(t *testing.T) {
+	gs, err := NewGuardianSet(3)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(GuardianSetIndex), gs.Index)
+	require.Len(t, gs.Keys, 3)
+	assert.Equal(t, crypto.PubkeyToAddress(GuardianKey(2).PublicKey), gs.Addresses()[2])
+
+	_, err = NewGuardianSet(0)
+	assert.Error(t, err)
+	_, err = NewGuardianSet(257)
+	assert.Error(t, err)
+}
+
+func TestSignedVAA(t *testing.T) {
+	gs, err := NewGuardianSet(4)
+	require.NoError(t, err)
+
+	b, err := gs.SignedVAA(vaa.ChainIDEthereum, vaa.Address{31: 4}, 42, []byte("hello"))
+	require.NoError(t, err)
+	v, err := vaa.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Len(t, v.Signatures, 4)
+	assert.Equal(t, uint64(42), v.Sequence)
+	assert.Equal(t, []byte("hello"), v.Payload)
+	require.NoError(t, v.Verify(gs.Addresses()))
+
+	// A quorum of signatures is enough to verify.
+	gs.SignQuorum(v)
+	assert.Len(t, v.Signatures, 3)
+	require.NoError(t, v.Verify(gs.Addresses()))
+
+	// Signing again replaces the signatures rather than adding to them.
+	gs.Sign(v)
+	assert.Len(t, v.Signatures, 4)
+}
@@ -3,18 +3,20 @@ module github.com/wormhole-foundation/wormhole/sdk
 go 1.19
 
 require (
+	github.com/cosmos/btcutil v1.0.5
 	github.com/ethereum/go-ethereum v1.10.21
+	github.com/holiman/uint256 v1.2.1
+	github.com/mr-tron/base58 v1.2.0
 	github.com/stretchr/testify v1.8.0
+	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
 )
 
 require (
 	github.com/btcsuite/btcd/btcec/v2 v2.2.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1 // indirect
-	github.com/holiman/uint256 v1.2.1 // indirect
 	github.com/kr/text v0.2.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519 // indirect
 	golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
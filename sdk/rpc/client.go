@@ -0,0 +1,274 @@
+// Package rpc implements a client for the guardian public RPC REST API (the grpc-gateway endpoints
+// served by guardiand's publicrpc, and by the public Wormhole API hosts).
+//
+// The client is configured with several endpoints and transparently fails over between them,
+// so a single unavailable or lagging guardian does not prevent a VAA from being fetched.
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	// ErrNotFound is returned if none of the endpoints knows about the requested VAA.
+	ErrNotFound = errors.New("VAA not found")
+
+	// ErrNoEndpoints is returned by NewClient if no endpoints are specified.
+	ErrNoEndpoints = errors.New("no endpoints specified")
+)
+
+const (
+	// DefaultMaxAttempts is the default number of passes over the endpoint list before giving up.
+	DefaultMaxAttempts = 3
+	// DefaultRetryDelay is the default delay between two passes over the endpoint list.
+	DefaultRetryDelay = time.Second
+	// DefaultRequestTimeout is the default timeout of a single HTTP request.
+	DefaultRequestTimeout = 10 * time.Second
+
+	// maxResponseSize bounds the size of a response body we are willing to read.
+	maxResponseSize = 1 << 20
+)
+
+// Client fetches data from a list of guardian public RPC endpoints with retries and failover.
+type Client struct {
+	endpoints  []string
+	httpClient *http.Client
+
+	// MaxAttempts is the number of passes over the endpoint list before a request fails.
+	MaxAttempts int
+	// RetryDelay is the delay between two passes over the endpoint list.
+	RetryDelay time.Duration
+}
+
+// GuardianSet is a guardian set as returned by the public RPC.
+type GuardianSet struct {
+	Index uint32
+	Keys  []common.Address
+}
+
+// NewClient returns a client for the given endpoints, e.g. "https://wormhole-v2-mainnet-api.certus.one".
+// Endpoints are tried in the order they are given. If httpClient is nil, a client with DefaultRequestTimeout is used.
+func NewClient(endpoints []string, httpClient *http.Client) (*Client, error) {
+	if len(endpoints) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	eps := make([]string, 0, len(endpoints))
+	for _, ep := range endpoints {
+		ep = strings.TrimRight(strings.TrimSpace(ep), "/")
+		if ep == "" {
+			return nil, fmt.Errorf("empty endpoint")
+		}
+		if !strings.HasPrefix(ep, "http://") && !strings.HasPrefix(ep, "https://") {
+			return nil, fmt.Errorf("endpoint %s must start with http:// or https://", ep)
+		}
+		eps = append(eps, ep)
+	}
+
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultRequestTimeout}
+	}
+
+	return &Client{
+		endpoints:   eps,
+		httpClient:  httpClient,
+		MaxAttempts: DefaultMaxAttempts,
+		RetryDelay:  DefaultRetryDelay,
+	}, nil
+}
+
+// GetSignedVAABytes returns the raw signed VAA with the given ID. The VAA is not verified.
+func (c *Client) GetSignedVAABytes(ctx context.Context, chain vaa.ChainID, emitter vaa.Address, sequence uint64) ([]byte, error) {
+	return c.getSignedVAABytes(ctx, chain, emitter, sequence, nil)
+}
+
+// getSignedVAABytes returns the raw signed VAA with the given ID. If check is not nil, the VAA of an endpoint is only
+// returned if check accepts it, otherwise the next endpoint is tried.
+func (c *Client) getSignedVAABytes(ctx context.Context, chain vaa.ChainID, emitter vaa.Address, sequence uint64, check func([]byte) error) ([]byte, error) {
+	path := fmt.Sprintf("/v1/signed_vaa/%d/%s/%d", uint16(chain), emitter.String(), sequence)
+
+	var resp struct {
+		VaaBytes []byte `json:"vaaBytes"`
+	}
+	err := c.getChecked(ctx, path, &resp, func() error {
+		if len(resp.VaaBytes) == 0 {
+			return ErrNotFound
+		}
+		if check != nil {
+			return check(resp.VaaBytes)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.VaaBytes, nil
+}
+
+// GetSignedVAA returns the parsed VAA with the given ID. The VAA is not verified, use GetVerifiedVAA for that.
+func (c *Client) GetSignedVAA(ctx context.Context, chain vaa.ChainID, emitter vaa.Address, sequence uint64) (*vaa.VAA, error) {
+	b, err := c.GetSignedVAABytes(ctx, chain, emitter, sequence)
+	if err != nil {
+		return nil, err
+	}
+	v, err := vaa.Unmarshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal VAA: %w", err)
+	}
+	return v, nil
+}
+
+// GetVerifiedVAA returns the parsed VAA with the given ID after checking that it is signed by a quorum of the
+// given guardian set. The guardian set must be complete and should come from a trusted source, not from the
+// same endpoints the VAA is fetched from. If an endpoint returns a VAA that fails verification, the next one is tried.
+func (c *Client) GetVerifiedVAA(ctx context.Context, chain vaa.ChainID, emitter vaa.Address, sequence uint64, gs *GuardianSet) (*vaa.VAA, error) {
+	if gs == nil {
+		return nil, errors.New("no guardian set specified")
+	}
+
+	var v *vaa.VAA
+	_, err := c.getSignedVAABytes(ctx, chain, emitter, sequence, func(b []byte) error {
+		var err error
+		v, err = verifyVAA(b, chain, emitter, sequence, gs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// verifyVAA parses b and checks that it is the VAA with the given ID, signed by a quorum of the guardian set.
+func verifyVAA(b []byte, chain vaa.ChainID, emitter vaa.Address, sequence uint64, gs *GuardianSet) (*vaa.VAA, error) {
+	v, err := vaa.Unmarshal(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal VAA: %w", err)
+	}
+	if v.GuardianSetIndex != gs.Index {
+		return nil, fmt.Errorf("VAA is signed by guardian set %d, expected %d", v.GuardianSetIndex, gs.Index)
+	}
+	if v.EmitterChain != chain || v.EmitterAddress != emitter || v.Sequence != sequence {
+		return nil, fmt.Errorf("endpoint returned the wrong VAA: %s", v.MessageID())
+	}
+	if err := v.Verify(gs.Keys); err != nil {
+		return nil, fmt.Errorf("failed to verify VAA: %w", err)
+	}
+	return v, nil
+}
+
+// GetCurrentGuardianSet returns the current guardian set as reported by the first endpoint that answers.
+// Since the result is not authenticated, it should only be used if the endpoints are trusted.
+func (c *Client) GetCurrentGuardianSet(ctx context.Context) (*GuardianSet, error) {
+	var resp struct {
+		GuardianSet struct {
+			Index     uint32   `json:"index"`
+			Addresses []string `json:"addresses"`
+		} `json:"guardianSet"`
+	}
+	if err := c.get(ctx, "/v1/guardianset/current", &resp); err != nil {
+		return nil, err
+	}
+
+	gs := &GuardianSet{
+		Index: resp.GuardianSet.Index,
+		Keys:  make([]common.Address, 0, len(resp.GuardianSet.Addresses)),
+	}
+	for _, addr := range resp.GuardianSet.Addresses {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid guardian address %s", addr)
+		}
+		gs.Keys = append(gs.Keys, common.HexToAddress(addr))
+	}
+	return gs, nil
+}
+
+// get queries path on each endpoint in turn until one of them succeeds, retrying up to MaxAttempts times.
+// If every endpoint returned 404 in the last pass, ErrNotFound is returned.
+func (c *Client) get(ctx context.Context, path string, result interface{}) error {
+	return c.getChecked(ctx, path, result, nil)
+}
+
+// getChecked is like get, but an answer is only accepted if check, which inspects result, returns nil. Otherwise the
+// error is recorded like a failed request and the next endpoint is tried.
+func (c *Client) getChecked(ctx context.Context, path string, result interface{}, check func() error) error {
+	attempts := c.MaxAttempts
+	if attempts <= 0 {
+		attempts = 1
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt != 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(c.RetryDelay):
+			}
+		}
+
+		notFound := 0
+		for _, ep := range c.endpoints {
+			err := c.getOne(ctx, ep+path, result)
+			if err == nil && check != nil {
+				err = check()
+			}
+			if err == nil {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if errors.Is(err, ErrNotFound) {
+				notFound++
+			}
+			lastErr = fmt.Errorf("%s: %w", ep, err)
+		}
+
+		if notFound == len(c.endpoints) {
+			return ErrNotFound
+		}
+	}
+
+	return fmt.Errorf("all endpoints failed after %d attempts, last error: %w", attempts, lastErr)
+}
+
+func (c *Client) getOne(ctx context.Context, url string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
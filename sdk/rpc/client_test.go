@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var testEmitter = vaa.Address{0: 1, 31: 4}
+
+func signedTestVAA(t *testing.T, keys []*ecdsa.PrivateKey) []byte {
+	t.Helper()
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 1,
+		Timestamp:        time.Unix(1000, 0),
+		Nonce:            1,
+		Sequence:         42,
+		ConsistencyLevel: 32,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   testEmitter,
+		Payload:          []byte("hello"),
+	}
+	for i, k := range keys {
+		v.AddSignature(k, uint8(i))
+	}
+	b, err := v.Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+func newTestGuardianSet(t *testing.T, n int) ([]*ecdsa.PrivateKey, *GuardianSet) {
+	t.Helper()
+	keys := make([]*ecdsa.PrivateKey, n)
+	gs := &GuardianSet{Index: 1, Keys: make([]common.Address, n)}
+	for i := range keys {
+		k, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = k
+		gs.Keys[i] = crypto.PubkeyToAddress(k.PublicKey)
+	}
+	return keys, gs
+}
+
+func vaaServer(t *testing.T, status int, vaaBytes []byte, hits *int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		assert.Equal(t, "/v1/signed_vaa/2/"+testEmitter.String()+"/42", r.URL.Path)
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"vaaBytes": vaaBytes})
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(nil, nil)
+	assert.ErrorIs(t, err, ErrNoEndpoints)
+
+	_, err = NewClient([]string{"localhost:7071"}, nil)
+	assert.Error(t, err)
+
+	c, err := NewClient([]string{"http://localhost:7071/"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://localhost:7071"}, c.endpoints)
+}
+
+func TestGetVerifiedVAAFailover(t *testing.T) {
+	keys, gs := newTestGuardianSet(t, 4)
+	b := signedTestVAA(t, keys)
+
+	var downHits, okHits int32
+	down := vaaServer(t, http.StatusServiceUnavailable, nil, &downHits)
+	ok := vaaServer(t, http.StatusOK, b, &okHits)
+
+	c, err := NewClient([]string{down.URL, ok.URL}, nil)
+	require.NoError(t, err)
+
+	v, err := c.GetVerifiedVAA(context.Background(), vaa.ChainIDEthereum, testEmitter, 42, gs)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), v.Payload)
+	assert.Equal(t, int32(1), downHits)
+	assert.Equal(t, int32(1), okHits)
+}
+
+func TestGetVerifiedVAANoQuorum(t *testing.T) {
+	keys, gs := newTestGuardianSet(t, 4)
+	b := signedTestVAA(t, keys[:2])
+
+	var hits int32
+	srv := vaaServer(t, http.StatusOK, b, &hits)
+
+	c, err := NewClient([]string{srv.URL}, nil)
+	require.NoError(t, err)
+	c.RetryDelay = time.Millisecond
+
+	_, err = c.GetVerifiedVAA(context.Background(), vaa.ChainIDEthereum, testEmitter, 42, gs)
+	assert.ErrorContains(t, err, "quorum")
+}
+
+func TestGetVerifiedVAASkipsUnverifiedEndpoint(t *testing.T) {
+	keys, gs := newTestGuardianSet(t, 4)
+
+	var badHits, okHits int32
+	bad := vaaServer(t, http.StatusOK, signedTestVAA(t, keys[:2]), &badHits)
+	ok := vaaServer(t, http.StatusOK, signedTestVAA(t, keys), &okHits)
+
+	c, err := NewClient([]string{bad.URL, ok.URL}, nil)
+	require.NoError(t, err)
+
+	v, err := c.GetVerifiedVAA(context.Background(), vaa.ChainIDEthereum, testEmitter, 42, gs)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), v.Payload)
+	assert.Equal(t, int32(1), badHits)
+	assert.Equal(t, int32(1), okHits)
+}
+
+func TestGetSignedVAANotFound(t *testing.T) {
+	var hits1, hits2 int32
+	srv1 := vaaServer(t, http.StatusNotFound, nil, &hits1)
+	srv2 := vaaServer(t, http.StatusNotFound, nil, &hits2)
+
+	c, err := NewClient([]string{srv1.URL, srv2.URL}, nil)
+	require.NoError(t, err)
+
+	_, err = c.GetSignedVAABytes(context.Background(), vaa.ChainIDEthereum, testEmitter, 42)
+	assert.ErrorIs(t, err, ErrNotFound)
+	// NotFound from every endpoint is final and should not be retried.
+	assert.Equal(t, int32(1), hits1)
+	assert.Equal(t, int32(1), hits2)
+}
+
+func TestGetSignedVAARetries(t *testing.T) {
+	var hits int32
+	srv := vaaServer(t, http.StatusInternalServerError, nil, &hits)
+
+	c, err := NewClient([]string{srv.URL}, nil)
+	require.NoError(t, err)
+	c.RetryDelay = time.Millisecond
+
+	_, err = c.GetSignedVAABytes(context.Background(), vaa.ChainIDEthereum, testEmitter, 42)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, int32(DefaultMaxAttempts), hits)
+}
+
+func TestGetCurrentGuardianSet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/guardianset/current", r.URL.Path)
+		_, _ = w.Write([]byte(`{"guardianSet":{"index":3,"addresses":["0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5"]}}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient([]string{srv.URL}, nil)
+	require.NoError(t, err)
+
+	gs, err := c.GetCurrentGuardianSet(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), gs.Index)
+	assert.Equal(t, []common.Address{common.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")}, gs.Keys)
+}
@@ -0,0 +1,256 @@
+package vaa
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mr-tron/base58"
+)
+
+// Wormhole addresses are 32 bytes long. Native addresses shorter than that are left-padded with zeros, and chains with
+// variable length account names (NEAR) use the sha256 hash of the name, which can't be converted back.
+
+// ErrAddressNotReversible is returned when converting a Wormhole address to the native format of a chain where the
+// Wormhole address is a hash of the native address.
+var ErrAddressNotReversible = errors.New("the wormhole address is a hash of the native address and can't be converted back")
+
+// addressFormat is the native address format of a chain.
+type addressFormat int
+
+const (
+	addressFormatUnknown addressFormat = iota
+	// addressFormatEvm is a 20 byte hex address with a 0x prefix.
+	addressFormatEvm
+	// addressFormatBase58 is a 32 byte base58 address (Solana).
+	addressFormatBase58
+	// addressFormatBech32 is a bech32 address of 20 (accounts) or 32 (contracts) bytes (Cosmos SDK chains).
+	addressFormatBech32
+	// addressFormatHex32 is a 32 byte hex address with a 0x prefix, where leading zeros may be omitted (Sui, Aptos).
+	addressFormatHex32
+	// addressFormatNear is a NEAR account name, whose Wormhole address is its sha256 hash.
+	addressFormatNear
+	// addressFormatAlgorand is a 32 byte public key encoded in base32 with a 4 byte checksum.
+	addressFormatAlgorand
+)
+
+// bech32Prefixes are the human readable parts of the bech32 addresses of the Cosmos SDK chains.
+var bech32Prefixes = map[ChainID]string{
+	ChainIDTerra:     "terra",
+	ChainIDTerra2:    "terra",
+	ChainIDInjective: "inj",
+	ChainIDXpla:      "xpla",
+	ChainIDSei:       "sei",
+	ChainIDWormchain: "wormhole",
+}
+
+func chainAddressFormat(chainID ChainID) addressFormat {
+	switch chainID {
+	case ChainIDEthereum, ChainIDBSC, ChainIDPolygon, ChainIDAvalanche, ChainIDOasis, ChainIDAurora, ChainIDFantom,
+		ChainIDKarura, ChainIDAcala, ChainIDKlaytn, ChainIDCelo, ChainIDMoonbeam, ChainIDNeon, ChainIDArbitrum,
+		ChainIDOptimism, ChainIDBase, ChainIDSepolia:
+		return addressFormatEvm
+	case ChainIDSolana, ChainIDPythNet:
+		return addressFormatBase58
+	case ChainIDTerra, ChainIDTerra2, ChainIDInjective, ChainIDXpla, ChainIDSei, ChainIDWormchain:
+		return addressFormatBech32
+	case ChainIDSui, ChainIDAptos:
+		return addressFormatHex32
+	case ChainIDNear:
+		return addressFormatNear
+	case ChainIDAlgorand:
+		return addressFormatAlgorand
+	default:
+		return addressFormatUnknown
+	}
+}
+
+// AddressFromNative converts the native representation of an address on the given chain to a Wormhole address.
+func AddressFromNative(chainID ChainID, native string) (Address, error) {
+	switch chainAddressFormat(chainID) {
+	case addressFormatEvm:
+		if !common.IsHexAddress(native) || !strings.HasPrefix(strings.ToLower(native), "0x") {
+			return Address{}, fmt.Errorf("invalid EVM address %q", native)
+		}
+		return BytesToAddress(common.HexToAddress(native).Bytes())
+
+	case addressFormatBase58:
+		b, err := base58.Decode(native)
+		if err != nil {
+			return Address{}, fmt.Errorf("invalid base58 address %q: %w", native, err)
+		}
+		if len(b) != 32 {
+			return Address{}, fmt.Errorf("invalid base58 address %q: expected 32 bytes, got %d", native, len(b))
+		}
+		return BytesToAddress(b)
+
+	case addressFormatBech32:
+		hrp, b, err := bech32DecodeAndConvert(native)
+		if err != nil {
+			return Address{}, fmt.Errorf("invalid bech32 address %q: %w", native, err)
+		}
+		if hrp != bech32Prefixes[chainID] {
+			return Address{}, fmt.Errorf("invalid bech32 address %q: expected prefix %s, got %s", native, bech32Prefixes[chainID], hrp)
+		}
+		if len(b) != 20 && len(b) != 32 {
+			return Address{}, fmt.Errorf("invalid bech32 address %q: expected 20 or 32 bytes, got %d", native, len(b))
+		}
+		return BytesToAddress(b)
+
+	case addressFormatHex32:
+		if !strings.HasPrefix(native, "0x") {
+			return Address{}, fmt.Errorf("invalid address %q: missing 0x prefix", native)
+		}
+		s := native[2:]
+		if len(s) == 0 || len(s) > 64 {
+			return Address{}, fmt.Errorf("invalid address %q: expected up to 32 hex encoded bytes", native)
+		}
+		if len(s)%2 == 1 {
+			s = "0" + s
+		}
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return Address{}, fmt.Errorf("invalid address %q: %w", native, err)
+		}
+		return BytesToAddress(b)
+
+	case addressFormatNear:
+		if !isValidNearAccount(native) {
+			return Address{}, fmt.Errorf("invalid NEAR account %q", native)
+		}
+		return Address(sha256.Sum256([]byte(native))), nil
+
+	case addressFormatAlgorand:
+		return algorandAddressDecode(native)
+
+	default:
+		return Address{}, fmt.Errorf("address format of chain %v is not supported", chainID)
+	}
+}
+
+// NativeAddress converts a Wormhole address to its native representation on the given chain. It returns
+// ErrAddressNotReversible for chains where the Wormhole address is a hash of the native address.
+func (a Address) NativeAddress(chainID ChainID) (string, error) {
+	switch chainAddressFormat(chainID) {
+	case addressFormatEvm:
+		if !isLeftPadded(a, 20) {
+			return "", fmt.Errorf("address %s is longer than 20 bytes", a)
+		}
+		return common.BytesToAddress(a[12:]).Hex(), nil
+
+	case addressFormatBase58:
+		return base58.Encode(a[:]), nil
+
+	case addressFormatBech32:
+		// Accounts are 20 bytes long and contracts 32 bytes. A contract address with 12 leading zero bytes can't
+		// be told apart from an account, but finding one is not computationally feasible.
+		b := a[:]
+		if isLeftPadded(a, 20) {
+			b = a[12:]
+		}
+		return bech32ConvertAndEncode(bech32Prefixes[chainID], b)
+
+	case addressFormatHex32:
+		return "0x" + hex.EncodeToString(a[:]), nil
+
+	case addressFormatNear:
+		return "", ErrAddressNotReversible
+
+	case addressFormatAlgorand:
+		return algorandAddressEncode(a), nil
+
+	default:
+		return "", fmt.Errorf("address format of chain %v is not supported", chainID)
+	}
+}
+
+// isLeftPadded returns true if the address fits in the given number of bytes.
+func isLeftPadded(a Address, size int) bool {
+	for _, b := range a[:32-size] {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidNearAccount checks a NEAR account name, see https://nomicon.io/DataStructures/Account#account-id-rules.
+func isValidNearAccount(s string) bool {
+	if len(s) < 2 || len(s) > 64 {
+		return false
+	}
+	separator := true
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			separator = false
+		case c == '.' || c == '-' || c == '_':
+			// Separators can't be at the start or the end, or next to each other.
+			if separator {
+				return false
+			}
+			separator = true
+		default:
+			return false
+		}
+	}
+	return !separator
+}
+
+// bech32ConvertAndEncode encodes b as a bech32 string with the given human readable part.
+func bech32ConvertAndEncode(hrp string, b []byte) (string, error) {
+	data, err := bech32.ConvertBits(b, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(hrp, data)
+}
+
+// bech32DecodeAndConvert decodes a bech32 string into its human readable part and data bytes. The length limit is the
+// one of the Cosmos SDK, which allows longer strings than BIP-173.
+func bech32DecodeAndConvert(s string) (string, []byte, error) {
+	hrp, data, err := bech32.Decode(s, 1023)
+	if err != nil {
+		return "", nil, err
+	}
+	b, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, b, nil
+}
+
+var algorandEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// algorandChecksum returns the last 4 bytes of the sha512/256 hash of the public key.
+func algorandChecksum(a Address) []byte {
+	h := sha512.Sum512_256(a[:])
+	return h[len(h)-4:]
+}
+
+func algorandAddressEncode(a Address) string {
+	return algorandEncoding.EncodeToString(append(a[:], algorandChecksum(a)...))
+}
+
+func algorandAddressDecode(s string) (Address, error) {
+	b, err := algorandEncoding.DecodeString(s)
+	if err != nil {
+		return Address{}, fmt.Errorf("invalid algorand address %q: %w", s, err)
+	}
+	if len(b) != 36 {
+		return Address{}, fmt.Errorf("invalid algorand address %q: expected 36 bytes, got %d", s, len(b))
+	}
+
+	var a Address
+	copy(a[:], b[:32])
+	if string(algorandChecksum(a)) != string(b[32:]) {
+		return Address{}, fmt.Errorf("invalid algorand address %q: invalid checksum", s)
+	}
+	return a, nil
+}
@@ -0,0 +1,91 @@
+package vaa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressNativeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		chainID   ChainID
+		native    string
+		universal string
+	}{
+		{name: "evm", chainID: ChainIDEthereum, native: "0x3ee18B2214AFF97000D974cf647E7C347E8fa585", universal: "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585"},
+		{name: "solana", chainID: ChainIDSolana, native: "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA", universal: "06ddf6e1d765a193d9cbe146ceeb79ac1cb485ed5f5b37913a8cf5857eff00a9"},
+		{name: "solana leading zeros", chainID: ChainIDSolana, native: "11111111111111111111111111111111", universal: "0000000000000000000000000000000000000000000000000000000000000000"},
+		{name: "terra account", chainID: ChainIDTerra, native: "terra15r3wep9tp5ky5ry0x0rur37vnrf2tlvm2wwuuj", universal: "000000000000000000000000a0e2ec84ab0d2c4a0c8f33c7c1c7cc98d2a5fd9b"},
+		{name: "terra2 contract", chainID: ChainIDTerra2, native: "terra153366q50k7t8nn7gec00hg66crnhkdggpgdtaxltaq6xrutkkz3s992fw9", universal: "a463ad028fb79679cfc8ce1efba35ac0e77b35080a1abe9bebe83461f176b0a3"},
+		{name: "wormchain", chainID: ChainIDWormchain, native: "wormhole153366q50k7t8nn7gec00hg66crnhkdggpgdtaxltaq6xrutkkz3sk96mfd", universal: "a463ad028fb79679cfc8ce1efba35ac0e77b35080a1abe9bebe83461f176b0a3"},
+		{name: "aptos", chainID: ChainIDAptos, native: "0x0000000000000000000000000000000000000000000000000000000000000001", universal: "0000000000000000000000000000000000000000000000000000000000000001"},
+		{name: "algorand", chainID: ChainIDAlgorand, native: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ", universal: "0000000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := AddressFromNative(tc.chainID, tc.native)
+			require.NoError(t, err)
+			assert.Equal(t, tc.universal, a.String())
+
+			native, err := a.NativeAddress(tc.chainID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.native, native)
+		})
+	}
+}
+
+func TestAddressFromNativeShortHex(t *testing.T) {
+	a, err := AddressFromNative(ChainIDSui, "0x2")
+	require.NoError(t, err)
+	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000002", a.String())
+}
+
+func TestAddressFromNativeNear(t *testing.T) {
+	a, err := AddressFromNative(ChainIDNear, "contract.portalbridge.near")
+	require.NoError(t, err)
+	assert.Equal(t, "148410499d3fcda4dcfd68a1ebfcdddda16ab28326448d4aae4d2f0465cdfcb7", a.String())
+
+	_, err = a.NativeAddress(ChainIDNear)
+	assert.ErrorIs(t, err, ErrAddressNotReversible)
+
+	for _, invalid := range []string{"a", "Contract.near", "contract..near", ".near", "near-", "contract near"} {
+		_, err = AddressFromNative(ChainIDNear, invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestAddressFromNativeInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID ChainID
+		native  string
+	}{
+		{name: "evm without prefix", chainID: ChainIDEthereum, native: "3ee18B2214AFF97000D974cf647E7C347E8fa585"},
+		{name: "evm too short", chainID: ChainIDEthereum, native: "0x3ee18B2214AFF97000D974cf647E7C347E8fa5"},
+		{name: "solana bad character", chainID: ChainIDSolana, native: "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ50A"},
+		{name: "solana too short", chainID: ChainIDSolana, native: "Tokenkeg"},
+		{name: "bech32 wrong prefix", chainID: ChainIDInjective, native: "terra15r3wep9tp5ky5ry0x0rur37vnrf2tlvm2wwuuj"},
+		{name: "bech32 bad checksum", chainID: ChainIDTerra, native: "terra15r3wep9tp5ky5ry0x0rur37vnrf2tlvm2wwuuq"},
+		{name: "bech32 mixed case", chainID: ChainIDTerra, native: "terra15R3wep9tp5ky5ry0x0rur37vnrf2tlvm2wwuuj"},
+		{name: "aptos too long", chainID: ChainIDAptos, native: "0x000000000000000000000000000000000000000000000000000000000000000001"},
+		{name: "algorand bad checksum", chainID: ChainIDAlgorand, native: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKA"},
+		{name: "unsupported chain", chainID: ChainIDBtc, native: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := AddressFromNative(tc.chainID, tc.native)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNativeAddressEvmTooLong(t *testing.T) {
+	a, err := StringToAddress("0100000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585")
+	require.NoError(t, err)
+	_, err = a.NativeAddress(ChainIDEthereum)
+	assert.Error(t, err)
+}
@@ -0,0 +1,461 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Explain renders a VAA as an annotated description for ops and support use: chain IDs are named, addresses are shown in
+// their native format where possible, and the payload type is detected and its fields decoded. The detection relies on
+// the shape of the payload unless the emitter is known, so a payload of an unknown emitter may be misidentified; the raw
+// payload is always included.
+
+// Payload types detected by Explain.
+const (
+	PayloadTypeUnknown                  = "unknown"
+	PayloadTypeGovernance               = "governance"
+	PayloadTypeTokenTransfer            = "token_transfer"
+	PayloadTypeTokenTransferWithPayload = "token_transfer_with_payload"
+	PayloadTypeAssetMeta                = "asset_meta"
+	PayloadTypeNFTTransfer              = "nft_transfer"
+	PayloadTypeNTTTransfer              = "ntt_transfer"
+)
+
+// Known emitter names returned by ExplainOptions.EmitterName that drive the payload detection.
+const (
+	EmitterNameTokenBridge = "TokenBridge"
+	EmitterNameNFTBridge   = "NFTBridge"
+)
+
+// Prefixes of the messages of the native token transfers (NTT) framework.
+var (
+	nttTransceiverMessagePrefix  = []byte{0x99, 0x45, 0xFF, 0x10}
+	nttNativeTokenTransferPrefix = []byte{0x99, 0x4E, 0x54, 0x54}
+)
+
+// ExplainOptions configures Explain.
+type ExplainOptions struct {
+	// EmitterName returns the name of a known emitter, or an empty string if the emitter is not known. The emitter of
+	// governance VAAs is always known. Using EmitterNameTokenBridge or EmitterNameNFTBridge selects the payload format.
+	EmitterName func(chainID ChainID, emitter Address) string
+}
+
+// ExplainedField is a field of a VAA or of its payload, with its value formatted for humans.
+type ExplainedField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Explanation is the annotated description of a VAA returned by Explain.
+type Explanation struct {
+	MessageID     string           `json:"messageId"`
+	Digest        string           `json:"digest"`
+	Fields        []ExplainedField `json:"fields"`
+	PayloadType   string           `json:"payloadType"`
+	PayloadFields []ExplainedField `json:"payloadFields"`
+	// PayloadError is set if the payload looked like PayloadType but could not be fully decoded.
+	PayloadError string `json:"payloadError,omitempty"`
+}
+
+// Explain returns the annotated description of v.
+func Explain(v *VAA, opts ExplainOptions) *Explanation {
+	emitterName := ""
+	if v.EmitterChain == GovernanceChain && v.EmitterAddress == GovernanceEmitter {
+		emitterName = "Governance"
+	} else if opts.EmitterName != nil {
+		emitterName = opts.EmitterName(v.EmitterChain, v.EmitterAddress)
+	}
+
+	indices := make([]string, len(v.Signatures))
+	for i, sig := range v.Signatures {
+		indices[i] = fmt.Sprint(sig.Index)
+	}
+
+	emitter := explainAddress(v.EmitterChain, v.EmitterAddress)
+	if emitterName != "" {
+		emitter += " [" + emitterName + "]"
+	}
+
+	e := &Explanation{
+		MessageID: v.MessageID(),
+		Digest:    v.SigningDigest().Hex(),
+		Fields: []ExplainedField{
+			{"Version", fmt.Sprint(v.Version)},
+			{"Guardian set index", fmt.Sprint(v.GuardianSetIndex)},
+			{"Signatures", fmt.Sprintf("%d (guardians %s)", len(v.Signatures), strings.Join(indices, ", "))},
+			{"Timestamp", fmt.Sprintf("%s (%d)", v.Timestamp.UTC().Format(time.RFC3339), v.Timestamp.Unix())},
+			{"Nonce", fmt.Sprint(v.Nonce)},
+			{"Sequence", fmt.Sprint(v.Sequence)},
+			{"Consistency level", explainConsistencyLevel(v.EmitterChain, v.ConsistencyLevel)},
+			{"Emitter chain", explainChain(v.EmitterChain)},
+			{"Emitter address", emitter},
+			{"Payload", fmt.Sprintf("%d bytes", len(v.Payload))},
+		},
+	}
+
+	e.PayloadType, e.PayloadFields, e.PayloadError = explainPayload(emitterName, v.Payload)
+	e.PayloadFields = append(e.PayloadFields, ExplainedField{"Raw", hex.EncodeToString(v.Payload)})
+	return e
+}
+
+// String renders the explanation as indented text.
+func (e *Explanation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "VAA %s\n", e.MessageID)
+	fmt.Fprintf(&b, "  Digest: %s\n", e.Digest)
+	writeExplainedFields(&b, e.Fields)
+	fmt.Fprintf(&b, "Payload: %s\n", e.PayloadType)
+	if e.PayloadError != "" {
+		fmt.Fprintf(&b, "  Error: %s\n", e.PayloadError)
+	}
+	writeExplainedFields(&b, e.PayloadFields)
+	return b.String()
+}
+
+func writeExplainedFields(b *strings.Builder, fields []ExplainedField) {
+	width := 0
+	for _, f := range fields {
+		if len(f.Name) > width {
+			width = len(f.Name)
+		}
+	}
+	for _, f := range fields {
+		fmt.Fprintf(b, "  %-*s %s\n", width+1, f.Name+":", f.Value)
+	}
+}
+
+// explainPayload detects the type of a payload and decodes its fields.
+func explainPayload(emitterName string, payload []byte) (string, []ExplainedField, string) {
+	r := &explainReader{b: payload}
+	var payloadType string
+	switch {
+	case emitterName == "Governance":
+		payloadType = PayloadTypeGovernance
+		explainGovernance(r)
+	case bytes.HasPrefix(payload, nttTransceiverMessagePrefix):
+		payloadType = PayloadTypeNTTTransfer
+		explainNTTTransfer(r)
+	case emitterName == EmitterNameNFTBridge && len(payload) > 0 && payload[0] == 1:
+		payloadType = PayloadTypeNFTTransfer
+		explainNFTTransfer(r)
+	case emitterName == EmitterNameTokenBridge || emitterName == "":
+		// Without a known emitter, only payloads of the exact size of a token bridge message are taken for one.
+		switch {
+		case len(payload) > 0 && payload[0] == 1 && (emitterName != "" || len(payload) == 133):
+			payloadType = PayloadTypeTokenTransfer
+		case len(payload) > 0 && payload[0] == 2 && (emitterName != "" || len(payload) == 100):
+			payloadType = PayloadTypeAssetMeta
+		case len(payload) > 0 && payload[0] == 3 && (emitterName != "" || len(payload) >= 133):
+			payloadType = PayloadTypeTokenTransferWithPayload
+		default:
+			return PayloadTypeUnknown, explainUnknown(payload), ""
+		}
+		explainTokenBridge(r)
+	default:
+		return PayloadTypeUnknown, explainUnknown(payload), ""
+	}
+
+	if r.err != nil {
+		return payloadType, r.fields, r.err.Error()
+	}
+	return payloadType, r.fields, ""
+}
+
+func explainUnknown(payload []byte) []ExplainedField {
+	if len(payload) != 0 && isPrintable(payload) {
+		return []ExplainedField{{"Text", fmt.Sprintf("%q", payload)}}
+	}
+	return nil
+}
+
+// explainTokenBridge decodes a transfer, an asset meta or a transfer with payload of the token bridge.
+func explainTokenBridge(r *explainReader) {
+	payloadID := r.uint8("Payload ID")
+	if payloadID == 2 {
+		tokenAddress := r.address()
+		tokenChain := r.chain("Token chain")
+		r.add("Token address", explainAddress(tokenChain, tokenAddress))
+		r.add("Decimals", fmt.Sprint(r.uint8("")))
+		r.add("Symbol", r.string32())
+		r.add("Name", r.string32())
+		return
+	}
+
+	r.add("Amount", r.amount()+" (normalized to at most 8 decimals)")
+	tokenAddress := r.address()
+	tokenChain := r.chain("Token chain")
+	r.add("Token address", explainAddress(tokenChain, tokenAddress))
+	to := r.address()
+	toChain := r.chain("To chain")
+	r.add("To", explainAddress(toChain, to))
+
+	if payloadID == 1 {
+		r.add("Fee", r.amount())
+		return
+	}
+
+	r.add("From", r.address().String())
+	rest := r.rest()
+	r.add("Transfer payload", fmt.Sprintf("%d bytes", len(rest)))
+}
+
+// explainNFTTransfer decodes a transfer of the NFT bridge.
+func explainNFTTransfer(r *explainReader) {
+	r.uint8("Payload ID")
+	tokenAddress := r.address()
+	tokenChain := r.chain("Token chain")
+	r.add("Token address", explainAddress(tokenChain, tokenAddress))
+	r.add("Symbol", r.string32())
+	r.add("Name", r.string32())
+	r.add("Token ID", r.amount())
+	uriLength := r.uint8("")
+	r.add("URI", fmt.Sprintf("%q", r.bytes(int(uriLength))))
+	to := r.address()
+	toChain := r.chain("To chain")
+	r.add("To", explainAddress(toChain, to))
+}
+
+// explainNTTTransfer decodes a native token transfer sent through the wormhole transceiver of the NTT framework.
+func explainNTTTransfer(r *explainReader) {
+	r.bytes(len(nttTransceiverMessagePrefix))
+	r.add("Source NTT manager", r.address().String())
+	r.add("Recipient NTT manager", r.address().String())
+
+	managerPayload := r.bytes(int(r.uint16()))
+	transceiverPayload := r.bytes(int(r.uint16()))
+	if r.err != nil {
+		return
+	}
+	r.add("Transceiver payload", fmt.Sprintf("%d bytes", len(transceiverPayload)))
+
+	m := &explainReader{b: managerPayload, fields: r.fields}
+	m.add("Message ID", hex.EncodeToString(m.bytes(32)))
+	m.add("Sender", m.address().String())
+	transfer := m.bytes(int(m.uint16()))
+	r.fields, r.err = m.fields, m.err
+	if r.err != nil {
+		return
+	}
+	if !bytes.HasPrefix(transfer, nttNativeTokenTransferPrefix) {
+		r.add("Manager payload", hex.EncodeToString(transfer))
+		return
+	}
+
+	t := &explainReader{b: transfer[len(nttNativeTokenTransferPrefix):], fields: r.fields}
+	decimals := t.uint8("Decimals")
+	amount := t.uint64()
+	t.add("Amount", fmt.Sprintf("%d (%s)", amount, formatDecimals(new(big.Int).SetUint64(amount), int(decimals))))
+	t.add("Source token", t.address().String())
+	to := t.address()
+	toChain := t.chain("To chain")
+	t.add("To", explainAddress(toChain, to))
+	r.fields, r.err = t.fields, t.err
+}
+
+// governanceActions names the governance actions of each module.
+var governanceActions = map[string]map[GovernanceAction]string{
+	"Core": {
+		ActionContractUpgrade:    "ContractUpgrade",
+		ActionGuardianSetUpdate:  "GuardianSetUpdate",
+		ActionCoreSetMessageFee:  "SetMessageFee",
+		ActionCoreTransferFees:   "TransferFees",
+		ActionCoreRecoverChainId: "RecoverChainId",
+	},
+	"TokenBridge": {
+		ActionRegisterChain:             "RegisterChain",
+		ActionUpgradeTokenBridge:        "ContractUpgrade",
+		ActionTokenBridgeRecoverChainId: "RecoverChainId",
+	},
+	"NFTBridge": {
+		ActionRegisterChain:             "RegisterChain",
+		ActionUpgradeTokenBridge:        "ContractUpgrade",
+		ActionTokenBridgeRecoverChainId: "RecoverChainId",
+	},
+	WasmdModuleStr: {
+		ActionStoreCode:           "StoreCode",
+		ActionInstantiateContract: "InstantiateContract",
+		ActionMigrateContract:     "MigrateContract",
+	},
+	"GlobalAccountant": {
+		ActionModifyBalance: "ModifyBalance",
+	},
+	"CircleIntegration": {
+		CircleIntegrationActionUpdateWormholeFinality:        "UpdateWormholeFinality",
+		CircleIntegrationActionRegisterEmitterAndDomain:      "RegisterEmitterAndDomain",
+		CircleIntegrationActionUpgradeContractImplementation: "UpgradeContractImplementation",
+	},
+}
+
+// explainGovernance decodes the header of a governance message, and the body of the well-known actions.
+func explainGovernance(r *explainReader) {
+	module := strings.TrimLeft(string(r.bytes(32)), "\x00")
+	action := GovernanceAction(r.uint8(""))
+	name, known := governanceActions[module][action]
+	if !known {
+		name = "unknown"
+	}
+	r.add("Module", fmt.Sprintf("%q", module))
+	r.add("Action", fmt.Sprintf("%s (%d)", name, action))
+	targetChain := r.chain("Target chain")
+	if r.err != nil {
+		return
+	}
+
+	switch module + "." + name {
+	case "Core.ContractUpgrade", "TokenBridge.ContractUpgrade", "NFTBridge.ContractUpgrade":
+		r.add("New contract", explainAddress(targetChain, r.address()))
+	case "Core.GuardianSetUpdate":
+		r.add("New guardian set index", fmt.Sprint(r.uint32()))
+		numKeys := int(r.uint8(""))
+		for i := 0; i < numKeys; i++ {
+			r.add(fmt.Sprintf("Guardian %d", i), "0x"+hex.EncodeToString(r.bytes(20)))
+		}
+	case "Core.SetMessageFee":
+		r.add("Message fee", r.amount())
+	case "Core.TransferFees":
+		r.add("Amount", r.amount())
+		r.add("Recipient", explainAddress(targetChain, r.address()))
+	case "TokenBridge.RegisterChain", "NFTBridge.RegisterChain":
+		chainID := r.chain("Emitter chain")
+		r.add("Emitter address", explainAddress(chainID, r.address()))
+	default:
+		if rest := r.rest(); len(rest) != 0 {
+			r.add("Body", hex.EncodeToString(rest))
+		}
+	}
+}
+
+// explainChain names a chain ID.
+func explainChain(chainID ChainID) string {
+	if name := chainID.String(); !strings.HasPrefix(name, "unknown") {
+		return fmt.Sprintf("%s (%d)", name, chainID)
+	}
+	return fmt.Sprintf("unknown (%d)", chainID)
+}
+
+// explainAddress returns the hex of a Wormhole address, followed by its native representation where it differs.
+func explainAddress(chainID ChainID, a Address) string {
+	native, err := a.NativeAddress(chainID)
+	if err != nil || strings.EqualFold(strings.TrimPrefix(native, "0x"), a.String()) {
+		return a.String()
+	}
+	return fmt.Sprintf("%s (%s)", a.String(), native)
+}
+
+// explainConsistencyLevel annotates the consistency levels with a special meaning on EVM chains.
+func explainConsistencyLevel(chainID ChainID, level uint8) string {
+	if chainAddressFormat(chainID) == addressFormatEvm {
+		switch level {
+		case 200:
+			return "200 (instant)"
+		case 201:
+			return "201 (safe)"
+		}
+	}
+	return fmt.Sprint(level)
+}
+
+// formatDecimals formats an integer amount of the smallest unit of a token with the given decimals.
+func formatDecimals(amount *big.Int, decimals int) string {
+	s := amount.String()
+	if decimals <= 0 {
+		return s
+	}
+	if len(s) <= decimals {
+		s = strings.Repeat("0", decimals-len(s)+1) + s
+	}
+	whole, frac := s[:len(s)-decimals], strings.TrimRight(s[len(s)-decimals:], "0")
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+func isPrintable(b []byte) bool {
+	for _, r := range string(b) {
+		if r == unicode.ReplacementChar || (!unicode.IsPrint(r) && !unicode.IsSpace(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// explainReader reads the fields of a payload, recording the first read past its end.
+type explainReader struct {
+	b      []byte
+	fields []ExplainedField
+	err    error
+}
+
+func (r *explainReader) add(name string, value string) {
+	if r.err == nil {
+		r.fields = append(r.fields, ExplainedField{name, value})
+	}
+}
+
+func (r *explainReader) bytes(n int) []byte {
+	if r.err != nil {
+		return make([]byte, n)
+	}
+	if len(r.b) < n {
+		r.err = fmt.Errorf("payload too short: %d bytes left, %d needed", len(r.b), n)
+		return make([]byte, n)
+	}
+	b := r.b[:n]
+	r.b = r.b[n:]
+	return b
+}
+
+func (r *explainReader) rest() []byte {
+	return r.bytes(len(r.b))
+}
+
+// uint8 reads a uint8 and adds it as a field, unless name is empty.
+func (r *explainReader) uint8(name string) uint8 {
+	v := r.bytes(1)[0]
+	if name != "" {
+		r.add(name, fmt.Sprint(v))
+	}
+	return v
+}
+
+func (r *explainReader) uint16() uint16 {
+	return binary.BigEndian.Uint16(r.bytes(2))
+}
+
+func (r *explainReader) uint32() uint32 {
+	return binary.BigEndian.Uint32(r.bytes(4))
+}
+
+func (r *explainReader) uint64() uint64 {
+	return binary.BigEndian.Uint64(r.bytes(8))
+}
+
+// chain reads a chain ID and adds it as a field.
+func (r *explainReader) chain(name string) ChainID {
+	c := ChainID(r.uint16())
+	r.add(name, explainChain(c))
+	return c
+}
+
+func (r *explainReader) address() Address {
+	var a Address
+	copy(a[:], r.bytes(32))
+	return a
+}
+
+// amount reads a 256 bit integer.
+func (r *explainReader) amount() string {
+	return new(big.Int).SetBytes(r.bytes(32)).String()
+}
+
+// string32 reads a string right padded to 32 bytes with zeroes.
+func (r *explainReader) string32() string {
+	return fmt.Sprintf("%q", strings.TrimRight(string(r.bytes(32)), "\x00"))
+}
@@ -0,0 +1,146 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// explainedValue returns the value of the field with the given name.
+func explainedValue(t *testing.T, fields []ExplainedField, name string) string {
+	t.Helper()
+	for _, f := range fields {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+	require.Failf(t, "missing field", "no field %q in %v", name, fields)
+	return ""
+}
+
+func explainTestVAA(chainID ChainID, emitter Address, payload []byte) *VAA {
+	return &VAA{
+		Version:          1,
+		GuardianSetIndex: 3,
+		Signatures:       []*Signature{{Index: 0}, {Index: 2}},
+		Timestamp:        time.Unix(1700000000, 0),
+		Sequence:         42,
+		ConsistencyLevel: 200,
+		EmitterChain:     chainID,
+		EmitterAddress:   emitter,
+		Payload:          payload,
+	}
+}
+
+func TestExplainTokenTransfer(t *testing.T) {
+	tokenAddress, err := StringToAddress("000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+	require.NoError(t, err)
+
+	payload := new(bytes.Buffer)
+	payload.WriteByte(1)
+	payload.Write(common.LeftPadBytes([]byte{0x05, 0xf5, 0xe1, 0x00}, 32)) // 1e8
+	payload.Write(tokenAddress[:])
+	MustWrite(payload, binary.BigEndian, ChainIDEthereum)
+	payload.Write(addr[:])
+	MustWrite(payload, binary.BigEndian, ChainIDSolana)
+	payload.Write(make([]byte, 32))
+
+	v := explainTestVAA(ChainIDEthereum, addr, payload.Bytes())
+
+	// The shape of the payload is enough to detect a transfer of an unknown emitter.
+	e := Explain(v, ExplainOptions{})
+	assert.Equal(t, PayloadTypeTokenTransfer, e.PayloadType)
+	assert.Empty(t, e.PayloadError)
+	assert.Equal(t, "2/0000000000000000000000000000000000000000000000000000000000000004/42", e.MessageID)
+	assert.Equal(t, "200 (instant)", explainedValue(t, e.Fields, "Consistency level"))
+	assert.Equal(t, "ethereum (2)", explainedValue(t, e.Fields, "Emitter chain"))
+	assert.Equal(t, "100000000 (normalized to at most 8 decimals)", explainedValue(t, e.PayloadFields, "Amount"))
+	assert.Equal(t, "000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2 (0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2)", explainedValue(t, e.PayloadFields, "Token address"))
+	assert.Equal(t, "solana (1)", explainedValue(t, e.PayloadFields, "To chain"))
+	assert.Equal(t, "0", explainedValue(t, e.PayloadFields, "Fee"))
+
+	// Known emitters are named.
+	e = Explain(v, ExplainOptions{EmitterName: func(ChainID, Address) string { return EmitterNameTokenBridge }})
+	assert.Contains(t, explainedValue(t, e.Fields, "Emitter address"), "[TokenBridge]")
+	assert.Contains(t, e.String(), "Payload: token_transfer\n")
+
+	// A truncated transfer of a known emitter is reported.
+	v.Payload = v.Payload[:100]
+	e = Explain(v, ExplainOptions{EmitterName: func(ChainID, Address) string { return EmitterNameTokenBridge }})
+	assert.Equal(t, PayloadTypeTokenTransfer, e.PayloadType)
+	assert.Contains(t, e.PayloadError, "payload too short")
+
+	// But not taken for a transfer if the emitter is unknown.
+	e = Explain(v, ExplainOptions{})
+	assert.Equal(t, PayloadTypeUnknown, e.PayloadType)
+}
+
+func TestExplainGovernance(t *testing.T) {
+	keys := []common.Address{
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee"),
+	}
+	v := explainTestVAA(GovernanceChain, GovernanceEmitter, BodyGuardianSetUpdate{Keys: keys, NewIndex: 4}.Serialize())
+
+	e := Explain(v, ExplainOptions{})
+	assert.Equal(t, PayloadTypeGovernance, e.PayloadType)
+	assert.Empty(t, e.PayloadError)
+	assert.Equal(t, `"Core"`, explainedValue(t, e.PayloadFields, "Module"))
+	assert.Equal(t, "GuardianSetUpdate (2)", explainedValue(t, e.PayloadFields, "Action"))
+	assert.Equal(t, "4", explainedValue(t, e.PayloadFields, "New guardian set index"))
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee", explainedValue(t, e.PayloadFields, "Guardian 1"))
+
+	v.Payload = BodyTokenBridgeRegisterChain{Module: "TokenBridge", ChainID: ChainIDEthereum, EmitterAddress: addr}.Serialize()
+	e = Explain(v, ExplainOptions{})
+	assert.Equal(t, "RegisterChain (1)", explainedValue(t, e.PayloadFields, "Action"))
+	assert.Equal(t, "ethereum (2)", explainedValue(t, e.PayloadFields, "Emitter chain"))
+}
+
+func TestExplainNTTTransfer(t *testing.T) {
+	transfer := new(bytes.Buffer)
+	transfer.Write(nttNativeTokenTransferPrefix)
+	transfer.WriteByte(6)
+	MustWrite(transfer, binary.BigEndian, uint64(1500000))
+	transfer.Write(make([]byte, 32))
+	transfer.Write(addr[:])
+	MustWrite(transfer, binary.BigEndian, ChainIDEthereum)
+
+	manager := new(bytes.Buffer)
+	manager.Write(make([]byte, 64))
+	MustWrite(manager, binary.BigEndian, uint16(transfer.Len()))
+	manager.Write(transfer.Bytes())
+
+	payload := new(bytes.Buffer)
+	payload.Write(nttTransceiverMessagePrefix)
+	payload.Write(make([]byte, 64))
+	MustWrite(payload, binary.BigEndian, uint16(manager.Len()))
+	payload.Write(manager.Bytes())
+	MustWrite(payload, binary.BigEndian, uint16(0))
+
+	e := Explain(explainTestVAA(ChainIDSolana, Address(dummyBytes), payload.Bytes()), ExplainOptions{})
+	assert.Equal(t, PayloadTypeNTTTransfer, e.PayloadType)
+	assert.Empty(t, e.PayloadError)
+	assert.Equal(t, "1500000 (1.5)", explainedValue(t, e.PayloadFields, "Amount"))
+	assert.Equal(t, "ethereum (2)", explainedValue(t, e.PayloadFields, "To chain"))
+}
+
+func TestExplainUnknownPayload(t *testing.T) {
+	e := Explain(explainTestVAA(ChainIDSolana, Address(dummyBytes), []byte("hello")), ExplainOptions{})
+	assert.Equal(t, PayloadTypeUnknown, e.PayloadType)
+	assert.Equal(t, `"hello"`, explainedValue(t, e.PayloadFields, "Text"))
+	assert.Equal(t, "68656c6c6f", explainedValue(t, e.PayloadFields, "Raw"))
+	assert.Equal(t, "200", explainedValue(t, e.Fields, "Consistency level"))
+}
+
+func TestFormatDecimals(t *testing.T) {
+	assert.Equal(t, "0.000001", formatDecimals(common.Big1, 6))
+	assert.Equal(t, "12.5", formatDecimals(big.NewInt(12500), 3))
+	assert.Equal(t, "12", formatDecimals(big.NewInt(12000), 3))
+	assert.Equal(t, "12", formatDecimals(big.NewInt(12), 0))
+}
@@ -0,0 +1,128 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// The canonical JSON encoding of a VAA follows the conventions used by the explorer: byte strings are hex-encoded
+// without a leading "0x", the timestamp is an RFC3339 string in UTC and the sequence is a decimal string, since it
+// does not fit into a JavaScript number. Fields are always written in the same order, so the encoding of a given VAA is
+// deterministic.
+type (
+	vaaJSON struct {
+		Version          uint8           `json:"version"`
+		GuardianSetIndex uint32          `json:"guardianSetIndex"`
+		Signatures       []signatureJSON `json:"signatures"`
+		Timestamp        string          `json:"timestamp"`
+		Nonce            uint32          `json:"nonce"`
+		Sequence         string          `json:"sequence"`
+		ConsistencyLevel uint8           `json:"consistencyLevel"`
+		EmitterChain     ChainID         `json:"emitterChain"`
+		EmitterAddress   string          `json:"emitterAddress"`
+		Payload          string          `json:"payload"`
+	}
+
+	signatureJSON struct {
+		Index     uint8  `json:"index"`
+		Signature string `json:"signature"`
+	}
+)
+
+// MarshalJSON implements json.Marshaler using the canonical JSON encoding of a VAA.
+func (v VAA) MarshalJSON() ([]byte, error) {
+	if v.Timestamp.Unix() < 0 || v.Timestamp.Unix() > math.MaxUint32 {
+		return nil, fmt.Errorf("timestamp out of range: %v", v.Timestamp)
+	}
+
+	j := vaaJSON{
+		Version:          v.Version,
+		GuardianSetIndex: v.GuardianSetIndex,
+		Signatures:       make([]signatureJSON, len(v.Signatures)),
+		Timestamp:        v.Timestamp.UTC().Format(time.RFC3339),
+		Nonce:            v.Nonce,
+		Sequence:         strconv.FormatUint(v.Sequence, 10),
+		ConsistencyLevel: v.ConsistencyLevel,
+		EmitterChain:     v.EmitterChain,
+		EmitterAddress:   v.EmitterAddress.String(),
+		Payload:          hex.EncodeToString(v.Payload),
+	}
+
+	for i, sig := range v.Signatures {
+		if sig == nil {
+			return nil, fmt.Errorf("signature %d is nil", i)
+		}
+		j.Signatures[i] = signatureJSON{Index: sig.Index, Signature: sig.Signature.String()}
+	}
+
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON implements json.Unmarshaler for the canonical JSON encoding of a VAA. Unknown fields are rejected.
+func (v *VAA) UnmarshalJSON(data []byte) error {
+	var j vaaJSON
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&j); err != nil {
+		return err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, j.Timestamp)
+	if err != nil {
+		return fmt.Errorf("failed to parse timestamp: %w", err)
+	}
+	if timestamp.Nanosecond() != 0 || timestamp.Unix() < 0 || timestamp.Unix() > math.MaxUint32 {
+		return fmt.Errorf("invalid timestamp: %s", j.Timestamp)
+	}
+
+	sequence, err := strconv.ParseUint(j.Sequence, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse sequence: %w", err)
+	}
+
+	emitterAddress, err := hex.DecodeString(j.EmitterAddress)
+	if err != nil {
+		return fmt.Errorf("failed to decode emitter address: %w", err)
+	}
+	if len(emitterAddress) != 32 {
+		return fmt.Errorf("emitter address must be 32 bytes, is %d", len(emitterAddress))
+	}
+
+	payload, err := hex.DecodeString(j.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	signatures := make([]*Signature, len(j.Signatures))
+	for i, sig := range j.Signatures {
+		b, err := hex.DecodeString(sig.Signature)
+		if err != nil {
+			return fmt.Errorf("failed to decode signature %d: %w", i, err)
+		}
+		if len(b) != 65 {
+			return fmt.Errorf("signature %d must be 65 bytes, is %d", i, len(b))
+		}
+		signatures[i] = &Signature{Index: sig.Index}
+		copy(signatures[i].Signature[:], b)
+	}
+
+	*v = VAA{
+		Version:          j.Version,
+		GuardianSetIndex: j.GuardianSetIndex,
+		Signatures:       signatures,
+		Timestamp:        time.Unix(timestamp.Unix(), 0),
+		Nonce:            j.Nonce,
+		Sequence:         sequence,
+		ConsistencyLevel: j.ConsistencyLevel,
+		EmitterChain:     j.EmitterChain,
+		Payload:          payload,
+	}
+	copy(v.EmitterAddress[:], emitterAddress)
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package vaa
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getSignedVaaForJSON(t *testing.T) *VAA {
+	t.Helper()
+	v := getVaa()
+	v.Timestamp = time.Unix(1654543099, 0)
+	v.Sequence = 1 << 60
+
+	key, err := ecdsa.GenerateKey(crypto.S256(), deterministicReader{})
+	require.NoError(t, err)
+	v.AddSignature(key, 0)
+	v.AddSignature(key, 3)
+	return &v
+}
+
+// deterministicReader returns an endless stream of the same byte, so the generated key and signatures are stable.
+type deterministicReader struct{}
+
+func (deterministicReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0x42
+	}
+	return len(p), nil
+}
+
+func TestVAAMarshalJSON(t *testing.T) {
+	v := getVaa()
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"version":1,"guardianSetIndex":1,"signatures":[],"timestamp":"1970-01-01T00:00:00Z","nonce":1,"sequence":"1","consistencyLevel":32,"emitterChain":1,"emitterAddress":"0000000000000000000000000000000000000000000000000000000000000004","payload":"616161616161"}`, string(b))
+
+	// Pointers and values encode the same way.
+	b2, err := json.Marshal(&v)
+	require.NoError(t, err)
+	assert.Equal(t, b, b2)
+}
+
+func TestVAAJSONRoundTrip(t *testing.T) {
+	v := getSignedVaaForJSON(t)
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var v2 VAA
+	require.NoError(t, json.Unmarshal(b, &v2))
+	assert.Equal(t, v, &v2)
+
+	// The encoding is deterministic.
+	b2, err := json.Marshal(&v2)
+	require.NoError(t, err)
+	assert.Equal(t, b, b2)
+
+	// And the binary encoding is unchanged, so signatures still verify.
+	bin1, err := v.Marshal()
+	require.NoError(t, err)
+	bin2, err := v2.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, bin1, bin2)
+	assert.Equal(t, v.SigningDigest(), v2.SigningDigest())
+}
+
+func TestVAAJSONEmptyPayload(t *testing.T) {
+	v := getEmptyPayloadVaa()
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	var v2 VAA
+	require.NoError(t, json.Unmarshal(b, &v2))
+	assert.Equal(t, v, v2)
+}
+
+func TestVAAMarshalJSONTimestampOutOfRange(t *testing.T) {
+	v := getVaa()
+	v.Timestamp = time.Unix(1<<33, 0)
+	_, err := json.Marshal(v)
+	assert.Error(t, err)
+}
+
+func TestVAAUnmarshalJSONErrors(t *testing.T) {
+	valid := map[string]interface{}{
+		"version":          1,
+		"guardianSetIndex": 1,
+		"signatures":       []interface{}{},
+		"timestamp":        "2022-06-06T19:18:19Z",
+		"nonce":            1,
+		"sequence":         "1",
+		"consistencyLevel": 32,
+		"emitterChain":     1,
+		"emitterAddress":   "0000000000000000000000000000000000000000000000000000000000000004",
+		"payload":          "",
+	}
+
+	b, err := json.Marshal(valid)
+	require.NoError(t, err)
+	var v VAA
+	require.NoError(t, json.Unmarshal(b, &v))
+
+	tests := []struct {
+		name  string
+		field string
+		value interface{}
+	}{
+		{"unknown field", "extra", 1},
+		{"bad timestamp", "timestamp", "yesterday"},
+		{"fractional timestamp", "timestamp", "2022-06-06T19:18:19.5Z"},
+		{"negative timestamp", "timestamp", "1960-06-06T19:18:19Z"},
+		{"numeric sequence", "sequence", 1},
+		{"bad sequence", "sequence", "-1"},
+		{"short emitter address", "emitterAddress", "04"},
+		{"bad emitter address", "emitterAddress", "zz"},
+		{"bad payload", "payload", "0x6161"},
+		{"short signature", "signatures", []interface{}{map[string]interface{}{"index": 0, "signature": "00"}}},
+		{"bad signature", "signatures", []interface{}{map[string]interface{}{"index": 0, "signature": "zz"}}},
+		{"version overflow", "version", 256},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := map[string]interface{}{}
+			for k, v := range valid {
+				m[k] = v
+			}
+			m[tc.field] = tc.value
+
+			b, err := json.Marshal(m)
+			require.NoError(t, err)
+			var v VAA
+			assert.Error(t, json.Unmarshal(b, &v))
+		})
+	}
+}
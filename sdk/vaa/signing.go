@@ -0,0 +1,57 @@
+package vaa
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Guardians sign several kinds of messages with the same key. Each kind is hashed in its own signing domain, so that a
+// signature over one kind can never be passed off as a signature over another (see whitepapers/0009_guardian_key.md):
+//
+//   - Observations, i.e. VAA bodies, are hashed twice with keccak256, without a prefix.
+//   - Every other kind of message is hashed once with keccak256, after a prefix of at least 32 bytes naming its kind.
+//
+// The functions below compute the exact digests guardians sign, so that verifiers outside of the node hash the same bytes.
+
+var (
+	// AccountantObservationPrefix is the signing domain of the batches of observations submitted to the accountant
+	// contract on wormchain. The signed data is the JSON encoded array of observations.
+	AccountantObservationPrefix = []byte("acct_sub_obsfig_000000000000000000|")
+
+	// QueryResponsePrefix is the signing domain of cross-chain query responses. The signed data is the serialized
+	// response.
+	QueryResponsePrefix = []byte("query_response_0000000000000000000|")
+)
+
+// DoubleKeccak returns keccak256(keccak256(data)).
+func DoubleKeccak(data []byte) common.Hash {
+	return doubleKeccak(data)
+}
+
+// ObservationSigningDigest returns the digest guardians sign for an observation, given the body of the VAA, i.e. the bytes
+// following the signatures in the serialized VAA. It is the same as VAA.SigningDigest, for verifiers that only have the
+// serialized body.
+func ObservationSigningDigest(body []byte) common.Hash {
+	return doubleKeccak(body)
+}
+
+// AccountantObservationSigningDigest returns the digest guardians sign when submitting a batch of observations to the
+// accountant contract, given the JSON encoded observations.
+func AccountantObservationSigningDigest(observations []byte) common.Hash {
+	digest, err := MessageSigningDigest(AccountantObservationPrefix, observations)
+	if err != nil {
+		// The prefix is a constant of sufficient length.
+		panic(err)
+	}
+	return digest
+}
+
+// QueryResponseSigningDigest returns the digest guardians sign for a cross-chain query response, given the serialized
+// response.
+func QueryResponseSigningDigest(response []byte) common.Hash {
+	digest, err := MessageSigningDigest(QueryResponsePrefix, response)
+	if err != nil {
+		// The prefix is a constant of sufficient length.
+		panic(err)
+	}
+	return digest
+}
@@ -0,0 +1,50 @@
+package vaa
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningPrefixes(t *testing.T) {
+	for _, prefix := range [][]byte{AccountantObservationPrefix, QueryResponsePrefix} {
+		assert.GreaterOrEqual(t, len(prefix), 32)
+	}
+	assert.NotEqual(t, AccountantObservationPrefix, QueryResponsePrefix)
+}
+
+func TestDoubleKeccak(t *testing.T) {
+	assert.Equal(t, common.HexToHash("10ca3eff73ebec87d2394fc58560afeab86dac7a21f5e402ea0a55e5c8a6758f"), DoubleKeccak([]byte{}))
+}
+
+func TestObservationSigningDigest(t *testing.T) {
+	v := getVaa()
+	marshalled, err := v.Marshal()
+	require.NoError(t, err)
+
+	// The body follows the version, the guardian set index and the (empty) signatures.
+	body := marshalled[6:]
+	expected := common.HexToHash("4fae136bb1fd782fe1b5180ba735cdc83bcece3f9b7fd0e5e35300a61c8acd8f")
+	assert.Equal(t, expected, ObservationSigningDigest(body))
+	assert.Equal(t, v.SigningDigest(), ObservationSigningDigest(body))
+}
+
+func TestAccountantObservationSigningDigest(t *testing.T) {
+	observations := []byte(`[{"tx_hash":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAE=","timestamp":0,"nonce":0,"emitter_chain":2,"emitter_address":"0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16","sequence":1,"consistency_level":15,"payload":"AQ=="}]`)
+	expected := common.HexToHash("1e50dd67fa0fd4809c593bacaf789bb08c445678f8f23fca11ab9f96d5d2f35c")
+	assert.Equal(t, expected, AccountantObservationSigningDigest(observations))
+
+	digest, err := MessageSigningDigest(AccountantObservationPrefix, observations)
+	require.NoError(t, err)
+	assert.Equal(t, digest, AccountantObservationSigningDigest(observations))
+}
+
+func TestQueryResponseSigningDigest(t *testing.T) {
+	response, err := hex.DecodeString("01000000")
+	require.NoError(t, err)
+	expected := common.HexToHash("a7293286ee998aa7da8dd1ae6f6a71cf0058fbe780c1aac7929ad806d12ce5f6")
+	assert.Equal(t, expected, QueryResponseSigningDigest(response))
+}
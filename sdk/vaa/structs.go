@@ -421,206 +421,217 @@ const (
 	BatchVAAVersion     = 0x02
 )
 
+// UnmarshalOptions enables stricter checks when deserializing BatchVAAs, for services parsing untrusted bytes.
+type UnmarshalOptions struct {
+	// RejectTrailingBytes rejects the bytes left after the last observation of a BatchVAA. The payload of a v1 VAA
+	// extends to the end of the data, so a v1 VAA never has trailing bytes.
+	RejectTrailingBytes bool
+}
+
 // UnmarshalBody deserializes the binary representation of a VAA's "BODY" properties
 // The BODY fields are common among multiple types of VAA - v1, v2 (BatchVAA), etc
 func UnmarshalBody(data []byte, reader *bytes.Reader, v *VAA) (*VAA, error) {
+	return unmarshalBody(reader, v)
+}
+
+func unmarshalBody(reader *bytes.Reader, v *VAA) (*VAA, error) {
 	unixSeconds := uint32(0)
 	if err := binary.Read(reader, binary.BigEndian, &unixSeconds); err != nil {
-		return nil, fmt.Errorf("failed to read timestamp: %w", err)
+		return nil, readError(FieldTimestamp, -1, err, "failed to read timestamp: %w", err)
 	}
 	v.Timestamp = time.Unix(int64(unixSeconds), 0)
 
 	if err := binary.Read(reader, binary.BigEndian, &v.Nonce); err != nil {
-		return nil, fmt.Errorf("failed to read nonce: %w", err)
+		return nil, readError(FieldNonce, -1, err, "failed to read nonce: %w", err)
 	}
 
 	if err := binary.Read(reader, binary.BigEndian, &v.EmitterChain); err != nil {
-		return nil, fmt.Errorf("failed to read emitter chain: %w", err)
+		return nil, readError(FieldEmitterChain, -1, err, "failed to read emitter chain: %w", err)
 	}
 
 	emitterAddress := Address{}
-	if n, err := reader.Read(emitterAddress[:]); err != nil || n != 32 {
-		return nil, fmt.Errorf("failed to read emitter address [%d]: %w", n, err)
+	if n, err := io.ReadFull(reader, emitterAddress[:]); err != nil {
+		return nil, readError(FieldEmitterAddress, -1, err, "failed to read emitter address [%d]: %w", n, err)
 	}
 	v.EmitterAddress = emitterAddress
 
 	if err := binary.Read(reader, binary.BigEndian, &v.Sequence); err != nil {
-		return nil, fmt.Errorf("failed to read sequence: %w", err)
+		return nil, readError(FieldSequence, -1, err, "failed to read sequence: %w", err)
 	}
 
 	if err := binary.Read(reader, binary.BigEndian, &v.ConsistencyLevel); err != nil {
-		return nil, fmt.Errorf("failed to read commitment: %w", err)
+		return nil, readError(FieldConsistencyLevel, -1, err, "failed to read commitment: %w", err)
 	}
 
 	// Make sure to only read the payload if the VAA has one; VAAs may have a 0 length payload
-	if reader.Len() != 0 {
-		payload := make([]byte, reader.Len())
-		n, err := reader.Read(payload)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read payload [%d]: %w", n, err)
-		}
-
-		v.Payload = payload[:n]
-	} else {
-		v.Payload = []byte{}
+	v.Payload = make([]byte, reader.Len())
+	if n, err := io.ReadFull(reader, v.Payload); err != nil {
+		return nil, readError(FieldPayload, -1, err, "failed to read payload [%d]: %w", n, err)
 	}
 
 	return v, nil
 }
 
-// Unmarshal deserializes the binary representation of a VAA
+// Unmarshal deserializes the binary representation of a VAA. The errors are of type *UnmarshalError.
 func Unmarshal(data []byte) (*VAA, error) {
 	if len(data) < minVAALength {
-		return nil, fmt.Errorf("VAA is too short")
+		return nil, readError("", -1, nil, "VAA is too short")
 	}
 	v := &VAA{}
 
 	v.Version = data[0]
 	if v.Version != SupportedVAAVersion {
-		return nil, fmt.Errorf("unsupported VAA version: %d", v.Version)
+		return nil, newUnmarshalError(ErrUnsupportedVersion, FieldVersion, -1, nil, "unsupported VAA version: %d", v.Version)
 	}
 
 	reader := bytes.NewReader(data[1:])
 
 	if err := binary.Read(reader, binary.BigEndian, &v.GuardianSetIndex); err != nil {
-		return nil, fmt.Errorf("failed to read guardian set index: %w", err)
+		return nil, readError(FieldGuardianSetIndex, -1, err, "failed to read guardian set index: %w", err)
 	}
 
-	lenSignatures, er := reader.ReadByte()
-	if er != nil {
-		return nil, fmt.Errorf("failed to read signature length")
+	signatures, err := unmarshalSignatures(reader)
+	if err != nil {
+		return nil, err
 	}
+	v.Signatures = signatures
+
+	return unmarshalBody(reader, v)
+}
 
-	v.Signatures = make([]*Signature, lenSignatures)
+// unmarshalSignatures reads the number of signatures, checking that the data is long enough for them before allocating
+// them, and the signatures.
+func unmarshalSignatures(reader *bytes.Reader) ([]*Signature, error) {
+	lenSignatures, err := reader.ReadByte()
+	if err != nil {
+		return nil, readError(FieldNumSignatures, -1, err, "failed to read signature length")
+	}
+	// Each signature is preceded by the index of its guardian.
+	if int(lenSignatures)*(1+65) > reader.Len() {
+		return nil, readError(FieldNumSignatures, -1, nil,
+			"VAA is too short for %d signatures: %d bytes left", lenSignatures, reader.Len())
+	}
+
+	signatures := make([]*Signature, lenSignatures)
 	for i := 0; i < int(lenSignatures); i++ {
 		index, err := reader.ReadByte()
 		if err != nil {
-			return nil, fmt.Errorf("failed to read validator index [%d]", i)
+			return nil, readError(FieldSignatureIndex, i, err, "failed to read validator index [%d]", i)
 		}
 
 		signature := [65]byte{}
-		if n, err := reader.Read(signature[:]); err != nil || n != 65 {
-			return nil, fmt.Errorf("failed to read signature [%d]: %w", i, err)
+		if _, err := io.ReadFull(reader, signature[:]); err != nil {
+			return nil, readError(FieldSignature, i, err, "failed to read signature [%d]: %w", i, err)
 		}
 
-		v.Signatures[i] = &Signature{
+		signatures[i] = &Signature{
 			Index:     index,
 			Signature: signature,
 		}
 	}
 
-	return UnmarshalBody(data, reader, v)
+	return signatures, nil
 }
 
 // UnmarshalBatch deserializes the binary representation of a BatchVAA
 func UnmarshalBatch(data []byte) (*BatchVAA, error) {
+	return UnmarshalBatchWithOptions(data, UnmarshalOptions{})
+}
+
+// UnmarshalBatchWithOptions deserializes the binary representation of a BatchVAA with the checks enabled by opts. The
+// errors are of type *UnmarshalError.
+func UnmarshalBatchWithOptions(data []byte, opts UnmarshalOptions) (*BatchVAA, error) {
 	if len(data) < minBatchVAALength {
-		return nil, fmt.Errorf("BatchVAA.Observation is too short")
+		return nil, readError("", -1, nil, "BatchVAA.Observation is too short")
 	}
 	v := &BatchVAA{}
 
 	v.Version = data[0]
 	if v.Version != BatchVAAVersion {
-		return nil, fmt.Errorf("unsupported VAA version: %d", v.Version)
+		return nil, newUnmarshalError(ErrUnsupportedVersion, FieldVersion, -1, nil, "unsupported VAA version: %d", v.Version)
 	}
 
 	reader := bytes.NewReader(data[1:])
 
 	if err := binary.Read(reader, binary.BigEndian, &v.GuardianSetIndex); err != nil {
-		return nil, fmt.Errorf("failed to read guardian set index: %w", err)
-	}
-
-	lenSignatures, er := reader.ReadByte()
-	if er != nil {
-		return nil, fmt.Errorf("failed to read signature length")
+		return nil, readError(FieldGuardianSetIndex, -1, err, "failed to read guardian set index: %w", err)
 	}
 
-	v.Signatures = make([]*Signature, int(lenSignatures))
-	for i := 0; i < int(lenSignatures); i++ {
-		index, err := reader.ReadByte()
-		if err != nil {
-			return nil, fmt.Errorf("failed to read validator index [%d]", i)
-		}
-
-		signature := [65]byte{}
-		if n, err := reader.Read(signature[:]); err != nil || n != 65 {
-			return nil, fmt.Errorf("failed to read signature [%d]: %w", i, err)
-		}
-
-		v.Signatures[i] = &Signature{
-			Index:     uint8(index),
-			Signature: signature,
-		}
+	signatures, err := unmarshalSignatures(reader)
+	if err != nil {
+		return nil, err
 	}
+	v.Signatures = signatures
 
 	lenHashes, err := reader.ReadByte()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read hashes length [%w]", err)
+		return nil, readError(FieldNumHashes, -1, err, "failed to read hashes length [%w]", err)
 	}
 	numHashes := int(lenHashes)
 
 	v.Hashes = make([]common.Hash, numHashes)
-	for i := 0; i < int(lenHashes); i++ {
+	for i := 0; i < numHashes; i++ {
 		hash := [32]byte{}
-		if n, err := reader.Read(hash[:]); err != nil || n != 32 {
-			return nil, fmt.Errorf("failed to read hash [%d]: %w", i, err)
+		if _, err := io.ReadFull(reader, hash[:]); err != nil {
+			return nil, readError(FieldHash, i, err, "failed to read hash [%d]: %w", i, err)
 		}
 		v.Hashes[i] = common.BytesToHash(hash[:])
 	}
 
 	lenObservations, err := reader.ReadByte()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read observations length: %w", err)
+		return nil, readError(FieldNumObservations, -1, err, "failed to read observations length: %w", err)
 	}
 	numObservations := int(lenObservations)
 
 	if numHashes != numObservations {
 		// should never happen, check anyway
-		return nil, fmt.Errorf(
+		return nil, newUnmarshalError(ErrInvalidField, FieldNumObservations, -1, nil,
 			"failed unmarshaling BatchVAA, observations differs from hashes")
 	}
 
 	v.Observations = make([]*Observation, numObservations)
-	for i := 0; i < int(lenObservations); i++ {
-		index, err := reader.ReadByte()
+	for i := 0; i < numObservations; i++ {
+		obsvIndex, err := reader.ReadByte()
 		if err != nil {
-			return nil, fmt.Errorf("failed to read Observation index [%d]: %w", i, err)
+			return nil, readError(FieldObservationIndex, i, err, "failed to read Observation index [%d]: %w", i, err)
+		}
+		if int(obsvIndex) >= numHashes {
+			return nil, newUnmarshalError(ErrInvalidField, FieldObservationIndex, i, nil,
+				"failed to read Observation index: %v, index %d is out of range", i, obsvIndex)
 		}
-		obsvIndex := uint8(index)
 
 		obsvLength := uint32(0)
 		if err := binary.Read(reader, binary.BigEndian, &obsvLength); err != nil {
-			return nil, fmt.Errorf("failed to read Observation length: %w", err)
+			return nil, readError(FieldObservationLength, i, err, "failed to read Observation length: %w", err)
 		}
-		numBytes := int(obsvLength)
 
-		// ensure numBytes is within expected bounds before allocating arrays
-		// cannot be negative
-		if numBytes < 0 {
-			return nil, fmt.Errorf(
-				"failed to read Observation index: %v, byte length is negative", i)
-		}
-		// cannot be longer than what is left in the array
-		if numBytes > reader.Len() {
-			return nil, fmt.Errorf(
+		// ensure the length is within expected bounds before allocating arrays,
+		// it cannot be longer than what is left in the array
+		if int64(obsvLength) > int64(reader.Len()) {
+			return nil, readError(FieldObservationLength, i, nil,
 				"failed to read Observation index: %v, byte length is erroneous", i)
 		}
 
-		obs := make([]byte, numBytes)
-		if n, err := reader.Read(obs[:]); err != nil || n == 0 {
-			return nil, fmt.Errorf("failed to read Observation bytes [%d]: %w", n, err)
-		}
-
 		// ensure the observation meets the minimum length of headless VAAs
-		if len(obs) < minHeadlessVAALength {
-			return nil, fmt.Errorf(
+		if obsvLength < minHeadlessVAALength {
+			return nil, newUnmarshalError(ErrInvalidField, FieldObservationLength, i, nil,
 				"BatchVAA.Observation is too short. Index: %v", obsvIndex)
 		}
 
-		// decode the observation, which is just the "BODY" fields of a v1 VAA
-		headless, err := UnmarshalBody(data, bytes.NewReader(obs[:]), &VAA{})
+		obs := make([]byte, obsvLength)
+		if n, err := io.ReadFull(reader, obs); err != nil {
+			return nil, readError(FieldObservation, i, err, "failed to read Observation bytes [%d]: %w", n, err)
+		}
 
+		// decode the observation, which is just the "BODY" fields of a v1 VAA
+		headless, err := unmarshalBody(bytes.NewReader(obs), &VAA{})
 		if err != nil {
+			var unmarshalErr *UnmarshalError
+			if errors.As(err, &unmarshalErr) {
+				// Report the position of the observation along with the field of the body.
+				return nil, newUnmarshalError(unmarshalErr.Kind, unmarshalErr.Field, i, err, "failed to unmarshal Observation VAA. %w", err)
+			}
 			return nil, fmt.Errorf("failed to unmarshal Observation VAA. %w", err)
 		}
 
@@ -628,7 +639,7 @@ func UnmarshalBatch(data []byte) (*BatchVAA, error) {
 		// the guardian has no interest in or use for observations after the batch has been signed, but still check
 		obsHash := headless.SigningDigest()
 		if obsHash != v.Hashes[obsvIndex] {
-			return nil, fmt.Errorf(
+			return nil, newUnmarshalError(ErrInvalidField, FieldObservation, i, nil,
 				"BatchVAA Observation %v does not match supplied hash", obsvIndex)
 		}
 
@@ -638,6 +649,10 @@ func UnmarshalBatch(data []byte) (*BatchVAA, error) {
 		}
 	}
 
+	if opts.RejectTrailingBytes && reader.Len() != 0 {
+		return nil, newUnmarshalError(ErrTrailingBytes, "", -1, nil, "%d bytes left after the last observation", reader.Len())
+	}
+
 	return v, nil
 }
 
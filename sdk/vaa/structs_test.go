@@ -988,8 +988,11 @@ func TestUnmarshalBody(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testBytes := testCase.dataFunc()
 			body, err := UnmarshalBody(testCase.data, bytes.NewReader(testBytes), testCase.vaa)
-			require.Equal(t, testCase.err, err)
-			if err == nil {
+			if testCase.err != nil {
+				require.EqualError(t, err, testCase.err.Error())
+				assert.ErrorIs(t, err, ErrTruncated)
+			} else {
+				require.NoError(t, err)
 				assert.Equal(t, testCase.expectedVAA, body)
 			}
 		})
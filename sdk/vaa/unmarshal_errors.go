@@ -0,0 +1,82 @@
+package vaa
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrTruncated is the kind of the errors returned when the data ends before a field of the VAA.
+	ErrTruncated = errors.New("truncated VAA")
+	// ErrUnsupportedVersion is the kind of the errors returned for a VAA of an unsupported version.
+	ErrUnsupportedVersion = errors.New("unsupported VAA version")
+	// ErrInvalidField is the kind of the errors returned when a field has an invalid value, like an observation index
+	// out of range.
+	ErrInvalidField = errors.New("invalid VAA field")
+	// ErrTrailingBytes is the kind of the errors returned when bytes are left after a VAA parsed with
+	// UnmarshalOptions.RejectTrailingBytes.
+	ErrTrailingBytes = errors.New("trailing bytes after VAA")
+)
+
+// Fields of a VAA reported in UnmarshalError.
+const (
+	FieldVersion           = "version"
+	FieldGuardianSetIndex  = "guardian set index"
+	FieldNumSignatures     = "number of signatures"
+	FieldSignatureIndex    = "signature index"
+	FieldSignature         = "signature"
+	FieldTimestamp         = "timestamp"
+	FieldNonce             = "nonce"
+	FieldEmitterChain      = "emitter chain"
+	FieldEmitterAddress    = "emitter address"
+	FieldSequence          = "sequence"
+	FieldConsistencyLevel  = "consistency level"
+	FieldPayload           = "payload"
+	FieldNumHashes         = "number of hashes"
+	FieldHash              = "hash"
+	FieldNumObservations   = "number of observations"
+	FieldObservationIndex  = "observation index"
+	FieldObservationLength = "observation length"
+	FieldObservation       = "observation"
+)
+
+// UnmarshalError is the error returned when a VAA cannot be deserialized. errors.Is reports whether it is of one of the
+// ErrX kinds above, and the error it wraps, like io.EOF, is available through errors.Unwrap.
+type UnmarshalError struct {
+	// Kind is one of ErrTruncated, ErrUnsupportedVersion, ErrInvalidField or ErrTrailingBytes.
+	Kind error
+	// Field is the malformed field, one of the FieldX constants. It is empty for ErrTrailingBytes.
+	Field string
+	// Index is the position of the malformed element for the repeated fields (signatures, hashes and observations),
+	// and -1 for the other fields.
+	Index int
+	// Err is the underlying error, if any.
+	Err error
+
+	msg string
+}
+
+// newUnmarshalError creates an error of the given kind for a field. The message is formatted like fmt.Errorf, so it
+// can refer to the underlying error with %w.
+func newUnmarshalError(kind error, field string, index int, err error, format string, args ...interface{}) *UnmarshalError {
+	return &UnmarshalError{Kind: kind, Field: field, Index: index, Err: err, msg: fmt.Errorf(format, args...).Error()}
+}
+
+// readError creates the error of a field which could not be read from the data, which can only happen because the data
+// is too short.
+func readError(field string, index int, err error, format string, args ...interface{}) *UnmarshalError {
+	return newUnmarshalError(ErrTruncated, field, index, err, format, args...)
+}
+
+func (e *UnmarshalError) Error() string {
+	return e.msg
+}
+
+// Is reports whether target is the kind of the error.
+func (e *UnmarshalError) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *UnmarshalError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,202 @@
+package vaa
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getSignedVaa returns the test VAA signed by numGuardians guardians.
+func getSignedVaa(t testing.TB, numGuardians int) VAA {
+	v := getVaa()
+	for i := 0; i < numGuardians; i++ {
+		key, err := ecdsa.GenerateKey(crypto.S256(), bytes.NewReader(bytes.Repeat([]byte{byte(i + 1)}, 64)))
+		require.NoError(t, err)
+		v.AddSignature(key, uint8(i))
+	}
+	return v
+}
+
+// getBatchVaa returns a BatchVAA with two observations.
+func getBatchVaa() BatchVAA {
+	first := getVaa()
+	second := getEmptyPayloadVaa()
+	second.Sequence = 2
+	batch := BatchVAA{
+		Version:          BatchVAAVersion,
+		GuardianSetIndex: 1,
+		Signatures:       []*Signature{},
+		Observations: []*Observation{
+			{Index: 0, Observation: &first},
+			{Index: 1, Observation: &second},
+		},
+	}
+	batch.Hashes = batch.ObsvHashArray()
+	return batch
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	signed := getSignedVaa(t, 2)
+	data, err := signed.Marshal()
+	require.NoError(t, err)
+	// The body starts after the version, guardian set index, number of signatures and signatures.
+	bodyOffset := 1 + 4 + 1 + 2*66
+
+	tests := []struct {
+		name  string
+		data  []byte
+		kind  error
+		field string
+		index int
+		msg   string
+	}{
+		{"empty", nil, ErrTruncated, "", -1, "VAA is too short"},
+		{"version", append([]byte{3}, data[1:]...), ErrUnsupportedVersion, FieldVersion, -1, "unsupported VAA version: 3"},
+		{"too many signatures", append(append([]byte{}, data[:5]...), append([]byte{200}, data[6:]...)...), ErrTruncated, FieldNumSignatures, -1, "VAA is too short for 200 signatures: 189 bytes left"},
+		{"timestamp", data[:bodyOffset+2], ErrTruncated, FieldTimestamp, -1, "failed to read timestamp: unexpected EOF"},
+		{"emitter address", data[:bodyOffset+20], ErrTruncated, FieldEmitterAddress, -1, "failed to read emitter address [10]: unexpected EOF"},
+		{"consistency level", data[:bodyOffset+50], ErrTruncated, FieldConsistencyLevel, -1, "failed to read commitment: EOF"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Unmarshal(tc.data)
+			require.EqualError(t, err, tc.msg)
+			assert.ErrorIs(t, err, tc.kind)
+
+			var unmarshalErr *UnmarshalError
+			require.ErrorAs(t, err, &unmarshalErr)
+			assert.Equal(t, tc.field, unmarshalErr.Field)
+			assert.Equal(t, tc.index, unmarshalErr.Index)
+		})
+	}
+
+	// The underlying error is available.
+	_, err = Unmarshal(data[:bodyOffset+20])
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestUnmarshalBatchRoundTrip(t *testing.T) {
+	batch := getBatchVaa()
+	data, err := batch.Marshal()
+	require.NoError(t, err)
+
+	batch2, err := UnmarshalBatch(data)
+	require.NoError(t, err)
+	assert.Equal(t, batch.Hashes, batch2.Hashes)
+	require.Len(t, batch2.Observations, 2)
+	assert.Equal(t, batch.Observations[1].Observation.SigningDigest(), batch2.Observations[1].Observation.SigningDigest())
+	assert.Equal(t, uint64(2), batch2.Observations[1].Observation.Sequence)
+
+	// Trailing bytes are only rejected on request.
+	withTrailingBytes := append(append([]byte{}, data...), 0, 0)
+	_, err = UnmarshalBatch(withTrailingBytes)
+	require.NoError(t, err)
+	_, err = UnmarshalBatchWithOptions(withTrailingBytes, UnmarshalOptions{RejectTrailingBytes: true})
+	assert.ErrorIs(t, err, ErrTrailingBytes)
+	assert.EqualError(t, err, "2 bytes left after the last observation")
+}
+
+func TestUnmarshalBatchErrors(t *testing.T) {
+	batch := getBatchVaa()
+	data, err := batch.Marshal()
+	require.NoError(t, err)
+	// The observations start after the header, the hashes and the number of observations.
+	observationsOffset := 1 + 4 + 1 + 1 + 2*32 + 1
+
+	// An observation index out of range used to make UnmarshalBatch panic.
+	outOfRange := append([]byte{}, data...)
+	outOfRange[observationsOffset] = 5
+	_, err = UnmarshalBatch(outOfRange)
+	assert.ErrorIs(t, err, ErrInvalidField)
+	assert.EqualError(t, err, "failed to read Observation index: 0, index 5 is out of range")
+
+	tooLong := append([]byte{}, data...)
+	binary.BigEndian.PutUint32(tooLong[observationsOffset+1:], 0xffffffff)
+	_, err = UnmarshalBatch(tooLong)
+	assert.ErrorIs(t, err, ErrTruncated)
+
+	wrongHash := append([]byte{}, data...)
+	wrongHash[7] ^= 0xff
+	_, err = UnmarshalBatch(wrongHash)
+	assert.ErrorIs(t, err, ErrInvalidField)
+	assert.EqualError(t, err, "BatchVAA Observation 0 does not match supplied hash")
+
+	_, err = UnmarshalBatch(append([]byte{BatchVAAVersion + 1}, data[1:]...))
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+// TestUnmarshalTruncated checks that every truncation of a VAA before its payload is reported as such, and that
+// truncations within the payload are valid VAAs with a shorter payload.
+func TestUnmarshalTruncated(t *testing.T) {
+	v := getSignedVaa(t, 3)
+	data, err := v.Marshal()
+	require.NoError(t, err)
+	payloadOffset := len(data) - len(v.Payload)
+
+	for length := 0; length < len(data); length++ {
+		parsed, err := Unmarshal(data[:length])
+		if length < payloadOffset {
+			assert.ErrorIs(t, err, ErrTruncated, "length %d", length)
+			continue
+		}
+		require.NoError(t, err, "length %d", length)
+		assert.Equal(t, v.Payload[:length-payloadOffset], parsed.Payload)
+	}
+}
+
+// checkUnmarshalError checks the properties every error returned by the unmarshal functions must have.
+func checkUnmarshalError(t *testing.T, err error) {
+	var unmarshalErr *UnmarshalError
+	require.ErrorAs(t, err, &unmarshalErr)
+	kinds := []error{ErrTruncated, ErrUnsupportedVersion, ErrInvalidField, ErrTrailingBytes}
+	assert.Contains(t, kinds, unmarshalErr.Kind)
+	assert.NotEmpty(t, err.Error())
+}
+
+func FuzzUnmarshal(f *testing.F) {
+	for _, numGuardians := range []int{0, 1, 19} {
+		v := getSignedVaa(f, numGuardians)
+		data, err := v.Marshal()
+		require.NoError(f, err)
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v, err := Unmarshal(data)
+		if err != nil {
+			checkUnmarshalError(t, err)
+			return
+		}
+
+		// A valid VAA serializes back to the same bytes.
+		marshaled, err := v.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, data, marshaled)
+	})
+}
+
+func FuzzUnmarshalBatch(f *testing.F) {
+	batch := getBatchVaa()
+	data, err := batch.Marshal()
+	require.NoError(f, err)
+	f.Add(data)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v, err := UnmarshalBatchWithOptions(data, UnmarshalOptions{RejectTrailingBytes: true})
+		if err != nil {
+			checkUnmarshalError(t, err)
+			return
+		}
+
+		assert.Equal(t, len(v.Hashes), len(v.Observations))
+		for _, o := range v.Observations {
+			assert.Equal(t, v.Hashes[o.Index], o.Observation.SigningDigest())
+		}
+	})
+}
@@ -94,7 +94,6 @@ afterAll(async () => {
 
 // Guardian metrics are prometheus data
 const fetchGlobalAccountantMetrics = async (): Promise<{
-  global_accountant_connection_errors_total: number;
   global_accountant_error_events_received: number;
   global_accountant_events_received: number;
   global_accountant_submit_failures: number;